@@ -25,7 +25,13 @@ import (
 )
 
 type GoGLL struct {
-	Package        *Package
+	Package *Package
+	// CaseInsensitive is true if all string literals of the grammar are
+	// matched case-insensitively
+	CaseInsensitive bool
+	// Start contains the start symbols of the start declaration, if any
+	Start []*NT
+
 	LexRules       []*LexRule
 	SyntaxRules    []*SyntaxRule
 	Terminals      *stringset.StringSet
@@ -35,6 +41,8 @@ type GoGLL struct {
 
 type NT struct {
 	tok *token.Token
+	// id is the new ID of an NT renamed by an import
+	id string
 }
 
 type Package struct {
@@ -43,6 +51,8 @@ type Package struct {
 
 type TokID struct {
 	tok *token.Token
+	// id is the unescaped ID of a TokID made from a string literal
+	id string
 }
 
 type Terminal interface {
@@ -93,12 +103,33 @@ func (g *GoGLL) GetSymbols() []string {
 	return append(g.Terminals.Elements(), g.NonTerminals.Elements()...)
 }
 
+// StartSymbol returns the default start symbol of the grammar, which is the
+// first declared start symbol or the head of the first syntax rule.
 func (g *GoGLL) StartSymbol() string {
+	if len(g.Start) > 0 {
+		return g.Start[0].ID()
+	}
 	return g.SyntaxRules[0].Head.ID()
 }
 
+// StartSymbols returns the start symbols of the grammar. The first is the
+// default start symbol. A grammar without syntax rules has no start symbols.
+func (g *GoGLL) StartSymbols() []string {
+	if len(g.Start) == 0 {
+		if len(g.SyntaxRules) == 0 {
+			return nil
+		}
+		return []string{g.StartSymbol()}
+	}
+	starts := make([]string, len(g.Start))
+	for i, nt := range g.Start {
+		starts[i] = nt.ID()
+	}
+	return starts
+}
+
 func (n *NT) String() string {
-	return n.tok.LiteralString()
+	return n.ID()
 }
 
 func (n *NT) Lext() int {
@@ -107,6 +138,9 @@ func (n *NT) Lext() int {
 
 // ID returns the identifier of n
 func (n *NT) ID() string {
+	if n.id != "" {
+		return n.id
+	}
 	return n.tok.LiteralString()
 }
 
@@ -131,5 +165,8 @@ func (t *TokID) Lext() int {
 }
 
 func (t *TokID) ID() string {
+	if t.id != "" {
+		return t.id
+	}
 	return t.tok.LiteralStringStripEscape()
 }
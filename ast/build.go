@@ -35,6 +35,16 @@ type builder struct {
 	lex          *lexer.Lexer
 	charLiterals *stringset.StringSet
 	gogll        *GoGLL
+	imports      *imports
+	templates    *templates
+	// params maps the parameters of the template rule being expanded to
+	// their arguments
+	params map[string]SyntaxSymbol
+	// imported is true if the builder builds an imported grammar file
+	imported bool
+	// rename maps the IDs of the rules declared in an imported grammar file
+	// to their new IDs
+	rename map[string]string
 }
 
 // builder rule
@@ -45,42 +55,88 @@ type brule interface {
 func (*LexRule) isBrule()    {}
 func (*SyntaxRule) isBrule() {}
 
-// Build builds an AST from the BSR root. `root` is the root of a disambiguated BSR forest
+// Build builds an AST from the BSR root. `root` is the root of a disambiguated BSR forest.
+// Build prints the first error in the grammar and exits.
 func Build(root bsr.BSR, l *lexer.Lexer, file string) *GoGLL {
+	g, err := TryBuild(root, l, file)
+	if err != nil {
+		fmt.Printf("AST Error: %s\n", err)
+		os.Exit(1)
+	}
+	return g
+}
+
+// TryBuild is like Build but returns the first error in the grammar, which is
+// an *Error, instead of exiting.
+func TryBuild(root bsr.BSR, l *lexer.Lexer, file string) (g *GoGLL, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(*Error)
+			if !ok {
+				panic(r)
+			}
+			g, err = nil, e
+		}
+	}()
 	bld := &builder{
 		file:         file,
 		lex:          l,
 		charLiterals: stringset.New(),
+		imports:      newImports(file),
+		templates:    newTemplates(),
 	}
 	bld.goGLL(root)
+	bld.expandTemplates()
+	bld.gogll.SyntaxRules = append(bld.gogll.SyntaxRules, bld.imports.syntaxRules...)
+	bld.checkStart()
+	bld.checkAlternateNames()
 	bld.gogll.NonTerminals = bld.nonTerminals()
 	bld.gogll.StringLiterals = bld.getStringLiterals()
 	bld.gogll.Terminals = bld.terminals()
-	return bld.gogll
+	return bld.gogll, nil
 }
 
-// GoGLL : Package Rules ;
+// GoGLL : Package Rules | Rules ;
 func (bld *builder) goGLL(b bsr.BSR) {
+	if b.Alternate() == 1 {
+		bld.fail(fmt.Errorf("missing package specification"),
+			bld.lex.Tokens[b.LeftExtent()].Lext())
+	}
+	pkg := b.GetNTChild(symbols.NT_Package, 0)
 	bld.gogll = &GoGLL{
-		Package: bld.packge(b.GetNTChild(symbols.NT_Package, 0)),
+		Package:         bld.packge(pkg),
+		CaseInsensitive: pkg.Alternate() == 1,
 	}
 	bld.rules(b.GetNTChildI(1))
 }
 
-// Package : "package" string_lit ;
+// Package
+//
+//	:   "package" string_lit
+//	|   "package" string_lit "case_insensitive"
+//	;
 func (bld *builder) packge(b bsr.BSR) *Package {
 	return &Package{
 		tok: b.GetTChildI(1),
 	}
 }
 
-// Rule : LexRule | SyntaxRule ;
+// Rule : LexRule | SyntaxRule | Import | Start ;
 func (bld *builder) rule(b bsr.BSR) {
 	// fmt.Printf("build.rule: %s\n", b)
-	if b.Alternate() == 0 {
+	switch b.Alternate() {
+	case 0:
 		bld.addLexRule(bld.lexRule(b.GetNTChildI(0)))
-	} else {
-		bld.addSyntaxRule(bld.syntaxRule(b.GetNTChildI(0)))
+	case 1:
+		if sr := b.GetNTChildI(0); sr.Alternate() == 1 {
+			bld.addTemplate(sr)
+		} else {
+			bld.addSyntaxRule(bld.syntaxRule(sr))
+		}
+	case 2:
+		bld.importRule(b.GetNTChildI(0))
+	default:
+		bld.start(b.GetNTChildI(0))
 	}
 }
 
@@ -101,7 +157,7 @@ func (bld *builder) nonTerminals() *stringset.StringSet {
 	nts := stringset.New()
 	for _, r := range bld.gogll.SyntaxRules {
 		if nts.Contain(r.Head.ID()) {
-			bld.fail(fmt.Errorf("Duplicate rule %s", r.Head.ID()), r.Head.Lext())
+			failAt(fmt.Errorf("Duplicate rule %s", r.Head.ID()), r.Pos)
 		} else {
 			nts.Add(r.Head.ID())
 		}
@@ -119,19 +175,30 @@ func (bld *builder) getLexRuleIDs(rules []*LexRule) *stringset.StringSet {
 	terminals := stringset.New()
 	for _, rule := range rules {
 		if terminals.Contain(rule.ID()) {
-			bld.fail(fmt.Errorf("duplicate lex rule %s", rule.ID()), rule.Lext())
+			failAt(fmt.Errorf("duplicate lex rule %s", rule.ID()), rule.Pos)
 		}
 		terminals.Add(rule.ID())
 	}
 	return terminals
 }
 
+/*
+getStringLiterals returns the string literals of the syntax rules. A string
+literal and its case-insensitive variant, e.g.: "ab" and i"ab", have the same
+token type, so they cannot both be used in a grammar that is not
+case_insensitive.
+*/
 func (bld *builder) getStringLiterals() map[string]*StringLit {
 	slits := make(map[string]*StringLit)
 	for _, r := range bld.gogll.SyntaxRules {
 		for _, a := range r.Alternates {
 			for _, s := range a.Symbols {
 				if sl, ok := s.(*StringLit); ok {
+					sl.CaseInsensitive = sl.CaseInsensitive || bld.gogll.CaseInsensitive
+					if sl1, exist := slits[sl.ID()]; exist && sl1.CaseInsensitive != sl.CaseInsensitive {
+						failAt(fmt.Errorf("string literal %q is used both case-sensitive and case-insensitive (i%q)",
+							sl.ID(), sl.ID()), r.SymbolPosition(sl))
+					}
 					slits[sl.ID()] = sl
 				}
 			}
@@ -140,33 +207,107 @@ func (bld *builder) getStringLiterals() map[string]*StringLit {
 	return slits
 }
 
+// Start : "start" StartSymbols ";" ;
+//
+// StartSymbols : nt | nt "," StartSymbols ;
+func (bld *builder) start(b bsr.BSR) {
+	if bld.imported {
+		return
+	}
+	if len(bld.gogll.Start) > 0 {
+		bld.fail(fmt.Errorf("duplicate start declaration"), b.GetTChildI(0).Lext())
+	}
+	for ss := b.GetNTChild(symbols.NT_StartSymbols, 0); ; ss = ss.GetNTChild(symbols.NT_StartSymbols, 0) {
+		nt := bld.nt(ss.GetTChildI(0))
+		for _, nt1 := range bld.gogll.Start {
+			if nt1.ID() == nt.ID() {
+				bld.fail(fmt.Errorf("duplicate start symbol %s", nt.ID()), nt.Lext())
+			}
+		}
+		bld.gogll.Start = append(bld.gogll.Start, nt)
+		if ss.Alternate() == 0 {
+			break
+		}
+	}
+}
+
+// checkStart checks that the start symbols are declared
+func (bld *builder) checkStart() {
+	for _, nt := range bld.gogll.Start {
+		if bld.gogll.GetSyntaxRule(nt.ID()) == nil {
+			bld.fail(fmt.Errorf("No declaration of start symbol %s", nt.ID()), nt.Lext())
+		}
+	}
+}
+
+/*
+checkAlternateNames names the single alternate of a rule with labelled symbols
+after the rule and checks that the alternate names are unique and that
+labelled symbols are in named alternates.
+*/
+func (bld *builder) checkAlternateNames() {
+	names := make(map[string]*SyntaxRule)
+	for _, r := range bld.gogll.SyntaxRules {
+		if len(r.Alternates) == 1 && r.Alternates[0].Name == "" && r.Alternates[0].HasLabels() {
+			r.Alternates[0].Name = r.ID()
+		}
+		for _, a := range r.Alternates {
+			switch {
+			case a.Name == "":
+				if a.HasLabels() {
+					failAt(fmt.Errorf("labelled symbols in unnamed alternate of %s", r.ID()), r.Pos)
+				}
+			case names[a.Name] != nil:
+				failAt(fmt.Errorf("duplicate alternate name %s in %s and %s",
+					a.Name, names[a.Name].ID(), r.ID()), r.Pos)
+			case a.Name != r.ID() && bld.gogll.GetSyntaxRule(a.Name) != nil:
+				failAt(fmt.Errorf("alternate name %s of %s is a syntax rule", a.Name, r.ID()), r.Pos)
+			default:
+				names[a.Name] = r
+			}
+		}
+	}
+}
+
 /*** Lex Rules ***/
 
 // LexRule
 //
-//	: tokid ":" RegExp ";"
-//	| "!" tokid ":" RegExp ";"
+//	: TokID ":" RegExp ";"
+//	| "!" TokID ":" RegExp ";"
+//	| "@" TokID ":" RegExp ";"
 //	;
 func (bld *builder) lexRule(b bsr.BSR) *LexRule {
-	if b.Alternate() == 0 {
-		return &LexRule{
-			TokID:  bld.tokID(b.GetTChildI(0)),
+	var r *LexRule
+	switch b.Alternate() {
+	case 0:
+		r = &LexRule{
+			TokID:  bld.tokID(tokIDToken(b.GetNTChild(symbols.NT_TokID, 0))),
 			RegExp: bld.regexp(b.GetNTChildI(2)),
 		}
+	case 1:
+		r = &LexRule{
+			Suppress: true,
+			TokID:    bld.tokID(tokIDToken(b.GetNTChild(symbols.NT_TokID, 0))),
+			RegExp:   bld.regexp(b.GetNTChildI(3)),
+		}
+	default:
+		r = &LexRule{
+			Identifier: true,
+			TokID:      bld.tokID(tokIDToken(b.GetNTChild(symbols.NT_TokID, 0))),
+			RegExp:     bld.regexp(b.GetNTChildI(3)),
+		}
 	}
-	return &LexRule{
-		Suppress: true,
-		TokID:    bld.tokID(b.GetTChildI(1)),
-		RegExp:   bld.regexp(b.GetNTChildI(3)),
-	}
+	r.Pos = bld.getPosition(r.Lext())
+	return r
 }
 
 // RegExp
 //
 //	: LexSymbol
-//	| tokid
+//	| TokID
 //	| LexSymbol RegExp
-//	| tokid RegExp
+//	| TokID RegExp
 //	;
 func (bld *builder) regexp(b bsr.BSR) *RegExp {
 	re := &RegExp{
@@ -176,13 +317,13 @@ func (bld *builder) regexp(b bsr.BSR) *RegExp {
 	case 0:
 		re.Symbols = []LexSymbol{bld.lexSymbol(b.GetNTChildI(0))}
 	case 1:
-		re.Symbols = bld.getLexRuleBody(b.GetTChildI(0))
+		re.Symbols = bld.getLexRuleBody(tokIDToken(b.GetNTChildI(0)))
 	case 2:
 		re.Symbols = []LexSymbol{bld.lexSymbol(b.GetNTChildI(0))}
 		re1 := bld.regexp(b.GetNTChild(symbols.NT_RegExp, 0))
 		re.Symbols = append(re.Symbols, re1.Symbols...)
 	case 3:
-		re.Symbols = bld.getLexRuleBody(b.GetTChildI(0))
+		re.Symbols = bld.getLexRuleBody(tokIDToken(b.GetNTChildI(0)))
 		re1 := bld.regexp(b.GetNTChild(symbols.NT_RegExp, 0))
 		re.Symbols = append(re.Symbols, re1.Symbols...)
 	default:
@@ -193,9 +334,10 @@ func (bld *builder) regexp(b bsr.BSR) *RegExp {
 }
 
 func (bld *builder) getLexRuleBody(tokid *token.Token) []LexSymbol {
-	lexRule := bld.gogll.GetLexRule(tokid.LiteralString())
+	id := bld.tokID(tokid).ID()
+	lexRule := bld.gogll.GetLexRule(id)
 	if lexRule == nil {
-		bld.fail(fmt.Errorf("token %s not defined yet", tokid.LiteralString()), tokid.Lext())
+		bld.fail(fmt.Errorf("token %s not defined yet", id), tokid.Lext())
 	}
 	return lexRule.RegExp.Symbols
 }
@@ -205,6 +347,7 @@ func (bld *builder) getLexRuleBody(tokid *token.Token) []LexSymbol {
 //	:   "." | "any" string_lit | char_lit | LexBracket | "not" string_lit
 //	|   UnicodeClass
 //	|   UnicodeSet
+//	|   CharRange
 //	;
 func (bld *builder) lexSymbol(b bsr.BSR) LexSymbol {
 	switch b.Alternate() {
@@ -222,6 +365,8 @@ func (bld *builder) lexSymbol(b bsr.BSR) LexSymbol {
 		return bld.unicodeClass(b.GetNTChildI(0))
 	case 6:
 		return bld.unicodeSet(b.GetNTChild(symbols.NT_UnicodeSet, 0))
+	case 7:
+		return bld.charRange(b.GetNTChild(symbols.NT_CharRange, 0))
 		// case 6:
 		// 	return bld.littleU(b.GetTChildI(0))
 		// case 7:
@@ -245,13 +390,32 @@ func (bld *builder) anyOf(any, strLit *token.Token) *AnyOf {
 	}
 }
 
+// CharRange : char_lit "-" char_lit ;
+func (bld *builder) charRange(b bsr.BSR) *CharRange {
+	return bld.newCharRange(b.GetTChildI(0), b.GetTChildI(2))
+}
+
+func (bld *builder) newCharRange(from, to *token.Token) *CharRange {
+	cr := &CharRange{
+		From: bld.charLiteral(from),
+		To:   bld.charLiteral(to),
+	}
+	if cr.From.Char() > cr.To.Char() {
+		bld.fail(fmt.Errorf("empty character range %s", cr), cr.Lext())
+	}
+	return cr
+}
+
 func (bld *builder) charLiteral(tok *token.Token) *CharLiteral {
 	switch tok.Literal()[1] {
 	case '\\':
+		if _, _, err := Unescape(tok.Literal()[1:]); err != nil {
+			bld.fail(err, tok.Lext())
+		}
 		if tok.Literal()[2] == '\'' {
 			bld.charLiterals.Add("'")
 		} else {
-			bld.charLiterals.Add(string(tok.Literal()[1:3]))
+			bld.charLiterals.Add(string(tok.Literal()[1 : len(tok.Literal())-1]))
 		}
 	case '"':
 		bld.charLiterals.Add("\\\"")
@@ -355,12 +519,24 @@ func (bld *builder) unicodeClass(b bsr.BSR) *UnicodeClass {
 //
 //	:   SyntaxSymbols
 //	|   "empty"
+//	|   SyntaxSymbols "#" nt
+//	|   "empty" "#" nt
 //	;
 func (bld *builder) syntaxAlternate(b bsr.BSR) *SyntaxAlternate {
 	alt := &SyntaxAlternate{}
-	if b.Alternate() == 0 {
-		alt.Symbols = bld.syntaxSymbols(b.GetNTChildI(0))
+	switch b.Alternate() {
+	case 0:
+		alt.Symbols, alt.Labels = bld.syntaxSymbols(b.GetNTChildI(0))
+	case 2:
+		alt.Symbols, alt.Labels = bld.syntaxSymbols(b.GetNTChildI(0))
+		alt.Name = b.GetTChildI(2).LiteralString()
+	case 3:
+		alt.Name = b.GetTChildI(2).LiteralString()
 	} // if alt = empty return alt with empty Symbols
+	if alt.Name != "" && bld.params != nil {
+		bld.fail(fmt.Errorf("the alternates of template rules cannot be named"),
+			b.GetTChildI(2).Lext())
+	}
 	return alt
 }
 
@@ -381,19 +557,28 @@ func (bld *builder) syntaxAlternates(b bsr.BSR) []*SyntaxAlternate {
 
 // SyntaxRule : nt ":" SyntaxAlternates ";"  ;
 func (bld *builder) syntaxRule(b bsr.BSR) *SyntaxRule {
+	head := b.GetTChildI(0)
 	return &SyntaxRule{
-		Head:       bld.nt(b.GetTChildI(0)),
+		Head:       bld.nt(head),
 		Alternates: bld.syntaxAlternates(b.GetNTChild(symbols.NT_SyntaxAlternates, 0)),
+		Pos:        bld.getPosition(head.Lext()),
 	}
 }
 
-// SyntaxSymbol : nt | tokid | string_lit ;
+// SyntaxSymbol
+//
+//	:   nt | TokID | string_lit | istring_lit
+//	|   nt "<" TemplateArgs ">"
+//	;
 func (bld *builder) symbol(b bsr.BSR) SyntaxSymbol {
 	switch b.Alternate() {
 	case 0:
+		if arg, isParam := bld.params[b.GetTChildI(0).LiteralString()]; isParam {
+			return arg
+		}
 		return bld.nt(b.GetTChildI(0))
 	case 1:
-		return bld.tokID(b.GetTChildI(0))
+		return bld.tokID(tokIDToken(b.GetNTChildI(0)))
 	case 2:
 		sl := bld.stringLit(b.GetTChildI(0))
 		if sl.ContainsWhiteSpace() {
@@ -402,21 +587,47 @@ func (bld *builder) symbol(b bsr.BSR) SyntaxSymbol {
 				b.GetTChildI(0).Lext())
 		}
 		return sl
+	case 3:
+		sl := bld.istringLit(b.GetTChildI(0))
+		if sl.ContainsWhiteSpace() {
+			bld.fail(
+				fmt.Errorf("An istring_lit SyntaxSymbol may not contain whitespace"),
+				b.GetTChildI(0).Lext())
+		}
+		return sl
+	case 4:
+		return bld.instance(b)
 	}
 	panic(fmt.Sprintf("invalid alternate %d", b.Alternate()))
 }
 
-// SyntaxSyntaxSymbols
+// SyntaxSymbols
 //
-//	:   SyntaxSymbol
-//	|   SyntaxSymbol SyntaxSymbols
+//	:   LabelledSymbol
+//	|   LabelledSymbol SyntaxSymbols
 //	;
-func (bld *builder) syntaxSymbols(b bsr.BSR) []SyntaxSymbol {
-	symbols := []SyntaxSymbol{bld.symbol(b.GetNTChildI(0))}
-	if b.Alternate() == 1 {
-		symbols = append(symbols, bld.syntaxSymbols(b.GetNTChildI(1))...)
+//
+// LabelledSymbol : SyntaxSymbol | TokID ":" SyntaxSymbol ;
+func (bld *builder) syntaxSymbols(b bsr.BSR) (syms []SyntaxSymbol, labels []string) {
+	for {
+		ls := b.GetNTChildI(0)
+		label := ""
+		if ls.Alternate() == 1 {
+			tok := tokIDToken(ls.GetNTChild(symbols.NT_TokID, 0))
+			label = tok.LiteralString()
+			for _, l := range labels {
+				if l == label {
+					bld.fail(fmt.Errorf("duplicate label %s", label), tok.Lext())
+				}
+			}
+		}
+		syms = append(syms, bld.symbol(ls.GetNTChild(symbols.NT_SyntaxSymbol, 0)))
+		labels = append(labels, label)
+		if b.Alternate() == 0 {
+			return
+		}
+		b = b.GetNTChildI(1)
 	}
-	return symbols
 }
 
 /*** Shared ***/
@@ -425,6 +636,7 @@ func (bld *builder) syntaxSymbols(b bsr.BSR) []SyntaxSymbol {
 func (bld *builder) nt(tok *token.Token) *NT {
 	return &NT{
 		tok: tok,
+		id:  bld.rename[tok.LiteralString()],
 	}
 }
 
@@ -434,16 +646,49 @@ func (bld *builder) stringLit(tok *token.Token) *StringLit {
 	}
 }
 
+// istringLit returns the case-insensitive StringLit of the istring_lit token,
+// without the leading 'i'
+func (bld *builder) istringLit(tok *token.Token) *StringLit {
+	return &StringLit{
+		tok: token.New(token.StringToType["string_lit"],
+			tok.Lext()+1, tok.Rext(), tok.GetInput()),
+		CaseInsensitive: true,
+	}
+}
+
 // TokID : id ;
+/*
+tokIDToken returns the token of the TokID b:
+
+	TokID
+	    :   tokid
+	    |   "as" | "case_insensitive" | "import" | "prefix" | "rename" | "start"
+	    ;
+*/
+func tokIDToken(b bsr.BSR) *token.Token {
+	return b.GetTChildI(0)
+}
+
 func (bld *builder) tokID(tok *token.Token) *TokID {
 	return &TokID{
 		tok: tok,
+		id:  bld.rename[tok.LiteralString()],
 	}
 }
 
-// UnicodeSet : "'[" UnicodeSetSpec UnicodeSetSpecs "]'" ;
+// UnicodeSet
+//
+//	:   "'[" UnicodeSetSpec UnicodeSetSpecs "]'"
+//	|   set_char_lit "-" char_lit UnicodeSetSpecs "]'"
+//	;
 func (bld *builder) unicodeSet(b bsr.BSR) *UnicodeSet {
-	ranges := UnicodeRanges{bld.unicodeSetSpec(b.GetNTChild(symbols.NT_UnicodeSetSpec, 0))}
+	var ranges UnicodeRanges
+	if b.Alternate() == 0 {
+		ranges = UnicodeRanges{bld.unicodeSetSpec(b.GetNTChild(symbols.NT_UnicodeSetSpec, 0))}
+	} else {
+		from := bld.setCharLiteral(b.GetTChildI(0))
+		ranges = UnicodeRanges{bld.charRangeSpec(bld.newCharRange(from, b.GetTChildI(2)))}
+	}
 	ranges = append(ranges, bld.unicodeSetSpecs(b.GetNTChild(symbols.NT_UnicodeSetSpecs, 0))...)
 	return &UnicodeSet{
 		lext:   b.GetTChildI(0).Lext(),
@@ -452,17 +697,35 @@ func (bld *builder) unicodeSet(b bsr.BSR) *UnicodeSet {
 	}
 }
 
-// UnicodeSetSpec : UnicodeCategory | UnicodeProperty ;
+// UnicodeSetSpec : UnicodeCategory | UnicodeProperty | CharRange ;
 func (bld *builder) unicodeSetSpec(b bsr.BSR) *UnicodeRange {
 	switch b.Alternate() {
 	case 0:
 		return bld.unicodeCategory(b.GetNTChild(symbols.NT_UnicodeCategory, 0))
 	case 1:
 		return bld.unicodeProperty(b.GetNTChild(symbols.NT_UnicodeProperty, 0))
+	case 2:
+		return bld.charRangeSpec(bld.charRange(b.GetNTChild(symbols.NT_CharRange, 0)))
 	}
 	panic("impossible")
 }
 
+func (bld *builder) charRangeSpec(cr *CharRange) *UnicodeRange {
+	return &UnicodeRange{
+		lext:      cr.Lext(),
+		Pos:       bld.getPosition(cr.Lext()),
+		Type:      CharacterRange,
+		Range:     cr.String(),
+		CharRange: cr,
+	}
+}
+
+// setCharLiteral returns the char_lit of the set_char_lit tok, e.g.: '0' of
+// '['0'
+func (bld *builder) setCharLiteral(tok *token.Token) *token.Token {
+	return token.New(token.IDToType["char_lit"], tok.Lext()+2, tok.Rext(), tok.GetInput())
+}
+
 // UnicodeSetSpecs
 //
 //	:   empty
@@ -633,10 +896,15 @@ func (bld *builder) addLexRule(r *LexRule) {
 }
 
 func (bld *builder) addSyntaxRule(r *SyntaxRule) {
-	if nil != bld.gogll.GetSyntaxRule(r.ID()) {
+	if nil != bld.gogll.GetSyntaxRule(r.ID()) || nil != bld.imports.getSyntaxRule(r.ID()) ||
+		bld.templates.rules[r.ID()] != nil {
 		bld.fail(fmt.Errorf("duplicate syntax rule %s", r.ID()), r.Lext())
 	}
-	bld.gogll.SyntaxRules = append(bld.gogll.SyntaxRules, r)
+	if bld.imported {
+		bld.imports.syntaxRules = append(bld.imports.syntaxRules, r)
+	} else {
+		bld.gogll.SyntaxRules = append(bld.gogll.SyntaxRules, r)
+	}
 }
 
 func (bld *builder) getPosition(lext int) *Position {
@@ -653,21 +921,12 @@ func (bld *builder) parseStringSet(strLit *token.Token) *runeset.RuneSet {
 	rs := runeset.New()
 	for i := 1; i < len(strLit.Literal())-1; i++ {
 		if strLit.Literal()[i] == '\\' {
-			i++
-			switch strLit.Literal()[i] {
-			case '\\':
-				rs.Add('\\')
-			case '"':
-				rs.Add('"')
-			case 'r':
-				rs.Add('\r')
-			case 'n':
-				rs.Add('\n')
-			case 't':
-				rs.Add('\t')
-			default:
+			r, n, err := Unescape(strLit.Literal()[i:])
+			if err != nil || strLit.Literal()[i+1] == '\'' {
 				bld.fail(fmt.Errorf("invalid escape char"), strLit.Lext())
 			}
+			rs.Add(r)
+			i += n - 1
 		} else {
 			rs.Add(strLit.Literal()[i])
 		}
@@ -679,7 +938,20 @@ func (bld *builder) parseStringSet(strLit *token.Token) *runeset.RuneSet {
 
 // i is the position of the failure in input slice of runes
 func (bld *builder) fail(err error, i int) {
-	ln, col := bld.lex.GetLineColumn(i)
-	fmt.Printf("AST Error: %s:%d:%d: %s\n", bld.file, ln, col, err)
-	os.Exit(1)
+	failAt(err, bld.getPosition(i))
+}
+
+// Error is an error at a position in a grammar file
+type Error struct {
+	Pos *Position
+	Err error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s", e.Pos.File, e.Pos.Line, e.Pos.Column, e.Err)
+}
+
+// failAt reports err at pos, which may be in an imported grammar file
+func failAt(err error, pos *Position) {
+	panic(&Error{Pos: pos, Err: err})
 }
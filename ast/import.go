@@ -0,0 +1,207 @@
+/*
+Copyright 2020 Marius Ackerman
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Builds the rules of imported grammar files.
+
+package ast
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/goccmack/gogll/v3/lexer"
+	"github.com/goccmack/gogll/v3/parser"
+	"github.com/goccmack/gogll/v3/parser/bsr"
+	"github.com/goccmack/gogll/v3/parser/symbols"
+	"github.com/goccmack/gogll/v3/token"
+	"github.com/goccmack/goutil/stringset"
+)
+
+// imports is the state shared by the builders of a grammar and the grammar
+// files it imports.
+type imports struct {
+	// files is the chain of files being built, used to detect cyclic imports
+	files []string
+	// done contains the keys of the imports that have been built
+	done *stringset.StringSet
+	// syntaxRules contains the syntax rules of the imported files, which are
+	// added after the syntax rules of the importing grammar.
+	syntaxRules []*SyntaxRule
+}
+
+func newImports(file string) *imports {
+	return &imports{
+		files: []string{filepath.Clean(file)},
+		done:  stringset.New(),
+	}
+}
+
+func (imp *imports) getSyntaxRule(nt string) *SyntaxRule {
+	for _, r := range imp.syntaxRules {
+		if r.Head.ID() == nt {
+			return r
+		}
+	}
+	return nil
+}
+
+// Import
+//
+//	:   "import" string_lit ";"
+//	|   "import" string_lit "prefix" nt ";"
+//	|   "import" string_lit "rename" Renames ";"
+//	;
+func (bld *builder) importRule(b bsr.BSR) {
+	strLit := b.GetTChildI(1)
+	file := string(bld.stringLit(strLit).Value())
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(filepath.Dir(bld.file), file)
+	}
+	file = filepath.Clean(file)
+	for _, f := range bld.imports.files {
+		if f == file {
+			bld.fail(fmt.Errorf("cyclic import of %s", file), strLit.Lext())
+		}
+	}
+
+	root, lex := bld.parseImport(file, strLit.Lext())
+	rules := root.GetNTChild(symbols.NT_Rules, 0)
+	nts, tokids := declarations(rules)
+	var rename map[string]string
+	switch b.Alternate() {
+	case 1:
+		rename = prefixRenames(b.GetTChildI(3).LiteralString(), nts, tokids)
+	case 2:
+		rename = bld.renames(b.GetNTChild(symbols.NT_Renames, 0), file, nts, tokids)
+	}
+
+	key := importKey(file, rename)
+	if bld.imports.done.Contain(key) {
+		return
+	}
+	bld.imports.done.Add(key)
+
+	imp := &builder{
+		file:         file,
+		lex:          lex,
+		charLiterals: bld.charLiterals,
+		gogll:        bld.gogll,
+		imports:      bld.imports,
+		templates:    bld.templates,
+		imported:     true,
+		rename:       rename,
+	}
+	bld.imports.files = append(bld.imports.files, file)
+	imp.rules(rules)
+	bld.imports.files = bld.imports.files[:len(bld.imports.files)-1]
+}
+
+// parseImport parses the imported grammar file. lext is the position of the
+// import in the importing file.
+func (bld *builder) parseImport(file string, lext int) (bsr.BSR, *lexer.Lexer) {
+	if _, err := os.Stat(file); err != nil {
+		bld.fail(err, lext)
+	}
+	lex := lexer.NewFile(file)
+	bsrSet, errs := parser.Parse(lex)
+	if errs != nil {
+		bld.fail(fmt.Errorf("%s: %s", file, errs[0]), lext)
+	}
+	if bsrSet.IsAmbiguous() {
+		bld.fail(fmt.Errorf("ambiguous parse forest in %s", file), lext)
+	}
+	return bsrSet.GetRoot(), lex
+}
+
+// Renames : Rename | Rename "," Renames ;
+//
+// Rename : nt "as" nt | TokID "as" TokID ;
+func (bld *builder) renames(b bsr.BSR, file string, nts, tokids *stringset.StringSet) map[string]string {
+	rename := make(map[string]string)
+	for {
+		r := b.GetNTChild(symbols.NT_Rename, 0)
+		var from, to *token.Token
+		decls := tokids
+		if r.Alternate() == 0 {
+			from, to = r.GetTChildI(0), r.GetTChildI(2)
+			decls = nts
+		} else {
+			from, to = tokIDToken(r.GetNTChildI(0)), tokIDToken(r.GetNTChildI(2))
+		}
+		if !decls.Contain(from.LiteralString()) {
+			bld.fail(fmt.Errorf("%s is not declared in %s", from.LiteralString(), file), from.Lext())
+		}
+		if _, exist := rename[from.LiteralString()]; exist {
+			bld.fail(fmt.Errorf("duplicate rename of %s", from.LiteralString()), from.Lext())
+		}
+		rename[from.LiteralString()] = to.LiteralString()
+		if b.Alternate() == 0 {
+			return rename
+		}
+		b = b.GetNTChild(symbols.NT_Renames, 0)
+	}
+}
+
+// prefixRenames returns the renaming of the nonterminals nts and token IDs
+// tokids by prefix. `Expr` becomes `PrefixExpr` and `id` becomes `prefix_id`.
+func prefixRenames(prefix string, nts, tokids *stringset.StringSet) map[string]string {
+	rename := make(map[string]string)
+	for _, nt := range nts.Elements() {
+		rename[nt] = prefix + nt
+	}
+	p := []rune(prefix)
+	p[0] = unicode.ToLower(p[0])
+	for _, id := range tokids.Elements() {
+		rename[id] = string(p) + "_" + id
+	}
+	return rename
+}
+
+// declarations returns the nonterminals and token IDs declared by rules,
+// excluding those of imported files.
+//
+// Rules : Rule | Rule Rules ;
+func declarations(rules bsr.BSR) (nts, tokids *stringset.StringSet) {
+	nts, tokids = stringset.New(), stringset.New()
+	for {
+		r := rules.GetNTChildI(0)
+		switch r.Alternate() {
+		case 0:
+			lr := r.GetNTChildI(0)
+			tokids.Add(tokIDToken(lr.GetNTChild(symbols.NT_TokID, 0)).LiteralString())
+		case 1:
+			nts.Add(r.GetNTChildI(0).GetTChildI(0).LiteralString())
+		}
+		if rules.Alternate() == 0 {
+			return
+		}
+		rules = rules.GetNTChild(symbols.NT_Rules, 0)
+	}
+}
+
+// importKey identifies an import of file with renaming rename
+func importKey(file string, rename map[string]string) string {
+	renames := make([]string, 0, len(rename))
+	for from, to := range rename {
+		renames = append(renames, from+" as "+to)
+	}
+	sort.Strings(renames)
+	return file + ": " + strings.Join(renames, ", ")
+}
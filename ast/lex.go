@@ -21,6 +21,7 @@ package ast
 import (
 	"bytes"
 	"fmt"
+	"strconv"
 	"unicode"
 
 	"github.com/goccmack/gogll/v3/token"
@@ -56,6 +57,11 @@ type CharLiteral struct {
 	Literal []rune
 }
 
+// CharRange is the range of characters From-To, e.g.: 'a'-'f'
+type CharRange struct {
+	From, To *CharLiteral
+}
+
 type LexBracket struct {
 	leftBracket *token.Token
 	Type        BracketType
@@ -80,14 +86,20 @@ type LexBase interface {
 func (*Any) isLexBase()          {}
 func (*AnyOf) isLexBase()        {}
 func (*CharLiteral) isLexBase()  {}
+func (*CharRange) isLexBase()    {}
 func (*Not) isLexBase()          {}
 func (*UnicodeClass) isLexBase() {}
 func (*UnicodeSet) isLexBase()   {}
 
 type LexRule struct {
 	Suppress bool
-	TokID    *TokID
-	RegExp   *RegExp
+	// Identifier is true if the tokens scanned by this rule are looked up
+	// in the keyword table of the lexer
+	Identifier bool
+	TokID      *TokID
+	RegExp     *RegExp
+	// Pos is the position of the rule in its grammar file
+	Pos *Position
 }
 
 type LexSymbol interface {
@@ -99,6 +111,7 @@ type LexSymbol interface {
 func (*Any) isLexSymbol()          {}
 func (*AnyOf) isLexSymbol()        {}
 func (*CharLiteral) isLexSymbol()  {}
+func (*CharRange) isLexSymbol()    {}
 func (*LexBracket) isLexSymbol()   {}
 func (*Not) isLexSymbol()          {}
 func (*UnicodeClass) isLexSymbol() {}
@@ -116,6 +129,9 @@ type RegExp struct {
 
 type StringLit struct {
 	tok *token.Token
+	// CaseInsensitive is true if the lexer matches all case variants of
+	// the literal
+	CaseInsensitive bool
 }
 
 type UnicodeClass struct {
@@ -146,6 +162,9 @@ type UnicodeRange struct {
 	Type    UnicodeRangeType
 	Exclude bool
 	Range   string
+
+	// CharRange is the range of a UnicodeRange of Type CharacterRange
+	CharRange *CharRange
 }
 
 type UnicodeRangeType int
@@ -153,6 +172,7 @@ type UnicodeRangeType int
 const (
 	UnicodeCategory UnicodeRangeType = iota
 	UnicodeProperty
+	CharacterRange
 )
 
 /*** Methods ***/
@@ -193,22 +213,11 @@ func NewCharLiteral(tok *token.Token, literal []rune) *CharLiteral {
 
 func (c *CharLiteral) Char() rune {
 	if c.Literal[1] == '\\' {
-		switch c.Literal[2] {
-		case '\'':
-			return '\''
-		case '"':
-			return '"'
-		case '\\':
-			return '\\'
-		case 't':
-			return '\t'
-		case 'n':
-			return '\n'
-		case 'r':
-			return '\r'
-		default:
-			panic(fmt.Sprintf("invalid '%c'", c.Literal[2]))
+		r, _, err := Unescape(c.Literal[1:])
+		if err != nil {
+			panic(err)
 		}
+		return r
 	} else {
 		return c.Literal[1]
 	}
@@ -230,6 +239,32 @@ func (c *CharLiteral) Lext() int {
 	return c.tok.Lext()
 }
 
+func (c *CharRange) Equal(other LexBase) bool {
+	if other == nil {
+		return false
+	}
+	c1, ok := other.(*CharRange)
+	if !ok {
+		return false
+	}
+	return c.From.Char() == c1.From.Char() && c.To.Char() == c1.To.Char()
+}
+
+// GetRangeTable returns the Unicode range table containing the characters of c
+func (c *CharRange) GetRangeTable() *unicode.RangeTable {
+	return &unicode.RangeTable{
+		R32: []unicode.Range32{{Lo: uint32(c.From.Char()), Hi: uint32(c.To.Char()), Stride: 1}},
+	}
+}
+
+func (c *CharRange) Lext() int {
+	return c.From.Lext()
+}
+
+func (c *CharRange) String() string {
+	return fmt.Sprintf("%s-%s", c.From, c.To)
+}
+
 func (l *LexBracket) LeftBracket() string {
 	switch l.Type {
 	case LexGroup:
@@ -343,7 +378,7 @@ func (n *Not) String() string {
 }
 
 func (sl *StringLit) ContainsWhiteSpace() bool {
-	for _, r := range sl.tok.LiteralStripEscape() {
+	for _, r := range sl.Value() {
 		switch r {
 		case ' ', '\t', '\n', '\r':
 			return true
@@ -361,9 +396,20 @@ func (sl *StringLit) Literal() []rune {
 }
 
 func (sl *StringLit) Value() []rune {
-	slit := sl.tok.LiteralStripEscape()
-	value := slit[1 : len(slit)-1]
-	// fmt.Printf("*StringLit.Value %s %s\n", string(slit), string(value))
+	slit := sl.tok.Literal()
+	value := make([]rune, 0, len(slit))
+	for i := 1; i < len(slit)-1; i++ {
+		if slit[i] == '\\' {
+			r, n, err := Unescape(slit[i:])
+			if err != nil {
+				panic(err)
+			}
+			value = append(value, r)
+			i += n - 1
+		} else {
+			value = append(value, slit[i])
+		}
+	}
 	return value
 }
 
@@ -412,6 +458,14 @@ func (u *UnicodeRange) String() string {
 	return u.Range
 }
 
+// SpecString returns the specification of u in a UnicodeSet
+func (u *UnicodeRange) SpecString() string {
+	if u.Type == CharacterRange {
+		return u.Range
+	}
+	return fmt.Sprintf("\\p{%s}", u.Range)
+}
+
 func (u UnicodeRanges) Contain(rng *UnicodeRange) bool {
 	for _, rng1 := range u {
 		if rng1.Equals(rng) {
@@ -469,7 +523,7 @@ func (u UnicodeRanges) String() string {
 		if rng.Exclude {
 			fmt.Fprint(w, "-")
 		}
-		fmt.Fprintf(w, "\\p{%s}", rng)
+		fmt.Fprint(w, rng.SpecString())
 	}
 	return w.String()
 }
@@ -517,6 +571,8 @@ func (u *UnicodeRange) GetRangeTable() *unicode.RangeTable {
 			panic(u.Range)
 		}
 		return unicode.Properties[u.Range]
+	case CharacterRange:
+		return u.CharRange.GetRangeTable()
 	}
 	panic("impossible")
 }
@@ -577,6 +633,16 @@ func (u *UnicodeSet) ContainsSet(u1 *UnicodeSet) bool {
 	return true
 }
 
+// HasCharRange returns true iff u contains a CharacterRange
+func (u *UnicodeSet) HasCharRange() bool {
+	for _, rng := range u.Ranges {
+		if rng.Type == CharacterRange {
+			return true
+		}
+	}
+	return false
+}
+
 func (u *UnicodeSet) Equal(other LexBase) bool {
 	if other == nil {
 		return false
@@ -622,30 +688,32 @@ func (u *UnicodeSet) Subset(sup *UnicodeSet) bool {
 // StringLitToTokID returns a dummy TokID with ID = id
 func StringLitToTokID(id *StringLit) *TokID {
 	return &TokID{
-		token.New(token.StringToType["tokid"],
+		tok: token.New(token.StringToType["tokid"],
 			id.tok.Lext()+1, id.tok.Rext()-1, id.tok.GetInput()),
+		id: id.ID(),
 	}
 }
 
 // CharLitFromStringLit returns a dummy CharLiteral with Literal sl.Literal[i]
-// If escaped sl.Literal[i] == '\\' and sl.Literal[i+1] is the escaped char.
+// If escaped sl.Literal[i] == '\\' and sl.Literal[i+1:] is the escape
+// sequence, which may be a hex or Unicode code point escape.
 func CharLitFromStringLit(sl *StringLit, i int, escaped bool) *CharLiteral {
 	// Make char literal
 	lit := []rune{'\''}
+	n := 1
 	if escaped {
-		if sl.Literal()[i+1] != '"' {
-			lit = append(lit, '\\')
+		_, n, _ = Unescape(sl.Literal()[i:])
+		if sl.Literal()[i+1] == '"' {
+			lit = append(lit, '"')
+		} else {
+			lit = append(lit, sl.Literal()[i:i+n]...)
 		}
-		lit = append(lit, sl.Literal()[i+1])
 	} else {
 		lit = append(lit, sl.Literal()[i])
 	}
 	lit = append(lit, '\'')
 
-	rext := sl.Lext() + i + 1
-	if escaped {
-		rext++
-	}
+	rext := sl.Lext() + i + n
 
 	cl := NewCharLiteral(
 		token.New(
@@ -654,3 +722,81 @@ func CharLitFromStringLit(sl *StringLit, i int, escaped bool) *CharLiteral {
 		lit)
 	return cl
 }
+
+// CaseInsensitive returns a LexSymbol matching c and the upper and lower case
+// variants of c, e.g.: ('s' | 'S'). It returns c if c has no case variants.
+func CaseInsensitive(c *CharLiteral) LexSymbol {
+	variants := caseVariants(c.Char())
+	if len(variants) == 1 {
+		return c
+	}
+	brkt := &LexBracket{
+		leftBracket: c.tok,
+		Type:        LexGroup,
+		Alternates:  []*RegExp{{Symbols: []LexSymbol{c}}},
+	}
+	for _, r := range variants[1:] {
+		brkt.Alternates = append(brkt.Alternates,
+			&RegExp{Symbols: []LexSymbol{NewCharLiteral(c.tok, []rune(fmt.Sprintf("%q", r)))}})
+	}
+	return brkt
+}
+
+// caseVariants returns r followed by its upper and lower case variants
+func caseVariants(r rune) []rune {
+	variants := []rune{r}
+	for _, v := range []rune{unicode.ToUpper(r), unicode.ToLower(r)} {
+		if v != variants[0] && (len(variants) == 1 || v != variants[1]) {
+			variants = append(variants, v)
+		}
+	}
+	return variants
+}
+
+// EscapeLen returns the number of runes of the escape sequence at the start of
+// lit, which must start with '\\'.
+func EscapeLen(lit []rune) int {
+	if len(lit) < 2 {
+		return len(lit)
+	}
+	switch lit[1] {
+	case 'x':
+		return 4
+	case 'u':
+		return 6
+	case 'U':
+		return 10
+	}
+	return 2
+}
+
+/*
+Unescape returns the character of the escape sequence at the start of esc,
+which must start with '\\', and the number of runes in the escape sequence.
+
+The escape sequence may be one of \\, \', \", \n, \r, \t or a
+hex or Unicode code point: \xhh, \uhhhh or \Uhhhhhhhh.
+*/
+func Unescape(esc []rune) (r rune, n int, err error) {
+	n = EscapeLen(esc)
+	if len(esc) < n || n < 2 {
+		return 0, len(esc), fmt.Errorf("invalid escape %s", string(esc))
+	}
+	switch esc[1] {
+	case '\\', '\'', '"':
+		return esc[1], n, nil
+	case 'n':
+		return '\n', n, nil
+	case 'r':
+		return '\r', n, nil
+	case 't':
+		return '\t', n, nil
+	case 'x', 'u', 'U':
+		cp, err := strconv.ParseUint(string(esc[2:n]), 16, 32)
+		if err != nil || cp > unicode.MaxRune {
+			return 0, n, fmt.Errorf("invalid code point %s", string(esc[:n]))
+		}
+		return rune(cp), n, nil
+	}
+	return 0, n, fmt.Errorf("invalid escape %s", string(esc[:n]))
+}
@@ -16,15 +16,29 @@ limitations under the License.
 
 package ast
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goccmack/gogll/v3/token"
+)
+
 // The syntax part of the AST
 
 type SyntaxAlternate struct {
 	Symbols []SyntaxSymbol
+	// Labels contains the labels of the symbols. Labels[i] is "" if
+	// Symbols[i] has no label.
+	Labels []string
+	// Name is the name of the alternate declared by #Name, or ""
+	Name string
 }
 
 type SyntaxRule struct {
 	Head       *NT
 	Alternates []*SyntaxAlternate
+	// Pos is the position of the rule in its grammar file
+	Pos *Position
 }
 
 type SyntaxSymbol interface {
@@ -57,6 +71,56 @@ func (a *SyntaxAlternate) Empty() bool {
 	return len(a.Symbols) == 0
 }
 
+// HasLabels returns true if any of the symbols of a is labelled
+func (a *SyntaxAlternate) HasLabels() bool {
+	for _, l := range a.Labels {
+		if l != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Label returns the label of symbol i of a, or "" if the symbol has no label
+func (a *SyntaxAlternate) Label(i int) string {
+	if i < len(a.Labels) {
+		return a.Labels[i]
+	}
+	return ""
+}
+
+// AlternateID returns the ID of alternate i of rule head, which is the head
+// followed by the alternate name, e.g.: Expr_Binary, or by the alternate
+// number, e.g.: Expr0.
+func AlternateID(head string, i int, a *SyntaxAlternate) string {
+	if a.Name != "" {
+		return head + "_" + a.Name
+	}
+	return fmt.Sprintf("%s%d", head, i)
+}
+
+// AlternateString returns alternate a of rule head with its labels, e.g.:
+// `Expr : lhs:Expr op:Op rhs:Expr`
+func AlternateString(head string, a *SyntaxAlternate) string {
+	w := new(strings.Builder)
+	fmt.Fprintf(w, "%s :", head)
+	if a.Empty() {
+		w.WriteString(" empty")
+	}
+	for i, sym := range a.Symbols {
+		w.WriteString(" ")
+		if l := a.Label(i); l != "" {
+			fmt.Fprintf(w, "%s:", l)
+		}
+		if _, ok := sym.(*StringLit); ok {
+			fmt.Fprintf(w, "%q", sym.ID())
+		} else {
+			w.WriteString(sym.ID())
+		}
+	}
+	return w.String()
+}
+
 // ID returns the head of rule r
 func (r *SyntaxRule) ID() string {
 	return r.Head.ID()
@@ -65,3 +129,23 @@ func (r *SyntaxRule) ID() string {
 func (r *SyntaxRule) Lext() int {
 	return r.Head.Lext()
 }
+
+// SymbolPosition returns the position of symbol s of rule r in the grammar
+// file of r
+func (r *SyntaxRule) SymbolPosition(s SyntaxSymbol) *Position {
+	var tok *token.Token
+	switch s1 := s.(type) {
+	case *NT:
+		tok = s1.tok
+	case *TokID:
+		tok = s1.tok
+	case *StringLit:
+		tok = s1.tok
+	}
+	ln, col := tok.GetLineColumn()
+	return &Position{
+		Line:   ln,
+		Column: col,
+		File:   r.Pos.File,
+	}
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2020 Marius Ackerman
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Expands the instances of parameterised syntax rules.
+
+package ast
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/goccmack/gogll/v3/parser/bsr"
+	"github.com/goccmack/gogll/v3/parser/symbols"
+	"github.com/goccmack/gogll/v3/token"
+)
+
+// maxInstances limits the number of instances of template rules, to stop the
+// expansion of templates that instantiate themselves with ever larger arguments.
+const maxInstances = 10000
+
+// templates contains the parameterised syntax rules of a grammar and their
+// instances. It is shared by the builders of a grammar and its imports.
+type templates struct {
+	rules map[string]*template
+	// instances maps the ID of an instance to the instance
+	instances map[string]*instance
+	// pending contains the instances that have not been expanded yet
+	pending []*instance
+}
+
+// template is a parameterised syntax rule
+type template struct {
+	// bld is the builder of the file declaring the template
+	bld    *builder
+	b      bsr.BSR
+	params []string
+}
+
+// instance is a template rule with arguments
+type instance struct {
+	id       string
+	template string
+	args     []SyntaxSymbol
+	// the reference to the instance for error reporting
+	bld *builder
+	tok *token.Token
+}
+
+func newTemplates() *templates {
+	return &templates{
+		rules:     make(map[string]*template),
+		instances: make(map[string]*instance),
+	}
+}
+
+// SyntaxRule : nt "<" TemplateParams ">" ":" SyntaxAlternates ";" ;
+func (bld *builder) addTemplate(b bsr.BSR) {
+	head := b.GetTChildI(0)
+	id := bld.nt(head).ID()
+	if _, exist := bld.templates.rules[id]; exist ||
+		nil != bld.gogll.GetSyntaxRule(id) || nil != bld.imports.getSyntaxRule(id) {
+		bld.fail(fmt.Errorf("duplicate template rule %s", id), head.Lext())
+	}
+	bld.templates.rules[id] = &template{
+		bld:    bld,
+		b:      b,
+		params: bld.templateParams(b.GetNTChild(symbols.NT_TemplateParams, 0)),
+	}
+}
+
+// TemplateParams : nt | nt "," TemplateParams ;
+func (bld *builder) templateParams(b bsr.BSR) (params []string) {
+	for {
+		p := b.GetTChildI(0)
+		for _, p1 := range params {
+			if p1 == p.LiteralString() {
+				bld.fail(fmt.Errorf("duplicate parameter %s", p1), p.Lext())
+			}
+		}
+		params = append(params, p.LiteralString())
+		if b.Alternate() == 0 {
+			return
+		}
+		b = b.GetNTChild(symbols.NT_TemplateParams, 0)
+	}
+}
+
+// TemplateArgs : SyntaxSymbol | SyntaxSymbol "," TemplateArgs ;
+func (bld *builder) templateArgs(b bsr.BSR) (args []SyntaxSymbol) {
+	for {
+		args = append(args, bld.symbol(b.GetNTChild(symbols.NT_SyntaxSymbol, 0)))
+		if b.Alternate() == 0 {
+			return
+		}
+		b = b.GetNTChild(symbols.NT_TemplateArgs, 0)
+	}
+}
+
+// SyntaxSymbol : nt "<" TemplateArgs ">" ;
+//
+// instance returns the NT of the instance, which is expanded by expandTemplates.
+func (bld *builder) instance(b bsr.BSR) *NT {
+	tok := b.GetTChildI(0)
+	if _, isParam := bld.params[tok.LiteralString()]; isParam {
+		bld.fail(fmt.Errorf("parameter %s cannot have arguments", tok.LiteralString()), tok.Lext())
+	}
+	inst := &instance{
+		template: bld.nt(tok).ID(),
+		args:     bld.templateArgs(b.GetNTChild(symbols.NT_TemplateArgs, 0)),
+		bld:      bld,
+		tok:      tok,
+	}
+	inst.id = inst.getID()
+	if inst1, exist := bld.templates.instances[inst.id]; exist {
+		if !inst.equal(inst1) {
+			bld.fail(fmt.Errorf("instance %s has the same ID as %s", inst, inst1), tok.Lext())
+		}
+	} else {
+		if len(bld.templates.instances) >= maxInstances {
+			bld.fail(fmt.Errorf("too many template instances: %s", inst), tok.Lext())
+		}
+		bld.templates.instances[inst.id] = inst
+		bld.templates.pending = append(bld.templates.pending, inst)
+	}
+	return &NT{
+		tok: tok,
+		id:  inst.id,
+	}
+}
+
+// expandTemplates declares the syntax rules of the pending instances, which
+// may instantiate further templates.
+func (bld *builder) expandTemplates() {
+	for len(bld.templates.pending) > 0 {
+		inst := bld.templates.pending[0]
+		bld.templates.pending = bld.templates.pending[1:]
+		inst.expand()
+	}
+}
+
+func (inst *instance) expand() {
+	t, exist := inst.bld.templates.rules[inst.template]
+	if !exist {
+		inst.bld.fail(fmt.Errorf("No declaration of template rule %s", inst.template), inst.tok.Lext())
+	}
+	if len(t.params) != len(inst.args) {
+		inst.bld.fail(fmt.Errorf("%s has %d arguments, template %s has %d parameters",
+			inst, len(inst.args), inst.template, len(t.params)), inst.tok.Lext())
+	}
+	tbld := *t.bld
+	tbld.params = make(map[string]SyntaxSymbol)
+	for i, p := range t.params {
+		tbld.params[p] = inst.args[i]
+	}
+	head := t.b.GetTChildI(0)
+	tbld.addSyntaxRule(&SyntaxRule{
+		Head: &NT{
+			tok: head,
+			id:  inst.id,
+		},
+		Alternates: tbld.syntaxAlternates(t.b.GetNTChild(symbols.NT_SyntaxAlternates, 0)),
+		Pos:        tbld.getPosition(head.Lext()),
+	})
+}
+
+// getID returns the template ID followed by the IDs of the arguments,
+// separated by `_`. A string literal argument that is not an identifier is
+// written as `x` followed by the hex codes of its characters.
+func (inst *instance) getID() string {
+	w := new(strings.Builder)
+	w.WriteString(inst.template)
+	for _, arg := range inst.args {
+		w.WriteString("_")
+		id := arg.ID()
+		if _, ok := arg.(*StringLit); ok && !isIdentifier(id) {
+			w.WriteString("x")
+			for _, r := range id {
+				fmt.Fprintf(w, "%x", r)
+			}
+		} else {
+			w.WriteString(id)
+		}
+	}
+	return w.String()
+}
+
+func (inst *instance) equal(inst1 *instance) bool {
+	if inst.template != inst1.template || len(inst.args) != len(inst1.args) {
+		return false
+	}
+	for i, arg := range inst.args {
+		if fmt.Sprintf("%T", arg) != fmt.Sprintf("%T", inst1.args[i]) || arg.ID() != inst1.args[i].ID() {
+			return false
+		}
+	}
+	return true
+}
+
+func (inst *instance) String() string {
+	args := make([]string, len(inst.args))
+	for i, arg := range inst.args {
+		if _, ok := arg.(*StringLit); ok {
+			args[i] = fmt.Sprintf("%q", arg.ID())
+		} else {
+			args[i] = arg.ID()
+		}
+	}
+	return fmt.Sprintf("%s<%s>", inst.template, strings.Join(args, ", "))
+}
+
+func isIdentifier(s string) bool {
+	for _, r := range s {
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strconv"
+	"strings"
 )
 
 // Version is the version of this compiler
@@ -30,6 +32,45 @@ var (
 	SrcFile string
 	Verbose bool
 
+	// Command is the gogll command, e.g.: "parse". It is empty if gogll
+	// generates a lexer and parser.
+	Command string
+
+	// Options of the parse and test commands. SrcFile is the grammar.
+	InputFile string
+	Start     string
+
+	// Options of the test command. InputFile is the test data directory.
+	Update bool
+
+	// Options of the gen-sentences command
+	NumSentences int
+	MaxDepth     int
+	Seed         int64
+	CorpusDir    string
+
+	// Options of the coverage command. HTMLFile is also the output of the doc
+	// command.
+	InputFiles []string
+	HTMLFile   string
+
+	// Options of the doc command
+	SVGDir string
+
+	// Options of the export command. SrcFile is the grammar.
+	ExportFormat string
+	ExportFile   string
+	ExportScopes map[string]string
+
+	// Options of the import command. SrcFile is the imported grammar.
+	ImportFormat  string
+	ImportFile    string
+	ImportPackage string
+
+	// Options of the fmt command. InputFiles are the grammar files.
+	Diff      bool
+	WriteBack bool
+
 	All        = flag.Bool("a", false, "Regenerate all files")
 	BSRStats   = flag.Bool("bs", false, "Print BSR stats")
 	help       = flag.Bool("h", false, "Print help")
@@ -44,12 +85,45 @@ var (
 	target = flag.String("t", "go", "Target Language")
 
 	GLL               = flag.Bool("gll", true, "Generate GLL parser")
+	GLR               = flag.Bool("glr", false, "Generate GLR parser")
 	Knuth             = flag.Bool("knuth", false, "Generate Knuth LR(1) parser")
 	Pager             = flag.Bool("pager", false, "Generate Pager's PGM parser")
 	AutoResolveLRConf = flag.Bool("resolve_conflicts", false, "Auto resolve LR(1) conflicts")
+	Coverage          = flag.Bool("coverage", false, "Generate grammar coverage recording")
 )
 
 func GetParams() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "parse":
+			getParseParams(os.Args[2:])
+			return
+		case "test":
+			getTestParams(os.Args[2:])
+			return
+		case "gen-sentences":
+			getGenSentencesParams(os.Args[2:])
+			return
+		case "coverage":
+			getCoverageParams(os.Args[2:])
+			return
+		case "fmt":
+			getFmtParams(os.Args[2:])
+			return
+		case "lsp":
+			Command = "lsp"
+			return
+		case "doc":
+			getDocParams(os.Args[2:])
+			return
+		case "export":
+			getExportParams(os.Args[2:])
+			return
+		case "import":
+			getImportParams(os.Args[2:])
+			return
+		}
+	}
 	flag.Parse()
 	if *help {
 		usage()
@@ -63,14 +137,202 @@ func GetParams() {
 	getFileBase()
 	getParserType()
 	if *Rust {
-		fmt.Printf("Version %s does not support Rust\n", Version)
-		fmt.Println("Please log an issue if you need Rust support")
-
 		*Go = false
 	}
 	Verbose = *verbose
 }
 
+func getParseParams(args []string) {
+	fs := newCommandFlags("parse")
+	fs.Parse(args)
+	if fs.NArg() < 1 {
+		fail("Input file required")
+	}
+	InputFile = fs.Arg(0)
+}
+
+func getTestParams(args []string) {
+	fs := newCommandFlags("test")
+	update := fs.Bool("update", false, "Update the golden files")
+	fs.Parse(args)
+	if fs.NArg() > 0 {
+		InputFile = fs.Arg(0)
+	} else {
+		InputFile = path.Join(path.Dir(SrcFile), "testdata")
+	}
+	Update = *update
+}
+
+func getGenSentencesParams(args []string) {
+	fs := newCommandFlags("gen-sentences")
+	n := fs.Int("n", 10, "Number of sentences")
+	depth := fs.Int("depth", 10, "Maximum derivation depth")
+	seed := fs.Int64("seed", 1, "Random seed")
+	corpus := fs.String("corpus", "", "Fuzz corpus directory")
+	fs.Parse(args)
+	if *n < 1 {
+		fail("-n must be at least 1")
+	}
+	NumSentences, MaxDepth, Seed, CorpusDir = *n, *depth, *seed, *corpus
+}
+
+func getCoverageParams(args []string) {
+	fs := newCommandFlags("coverage")
+	html := fs.String("html", "", "HTML report file")
+	fs.Parse(args)
+	if fs.NArg() < 1 {
+		fail("Coverage profile or input file required")
+	}
+	InputFiles, HTMLFile = fs.Args(), *html
+}
+
+func getFmtParams(args []string) {
+	Command = "fmt"
+	fs := flag.NewFlagSet("fmt", flag.ExitOnError)
+	fs.Usage = usage
+	diff := fs.Bool("d", false, "Print the diffs")
+	write := fs.Bool("w", false, "Write the result to the grammar file")
+	fs.Parse(args)
+	if fs.NArg() < 1 {
+		fail("Grammar file required")
+	}
+	InputFiles, Diff, WriteBack = fs.Args(), *diff, *write
+}
+
+func getDocParams(args []string) {
+	Command = "doc"
+	fs := flag.NewFlagSet("doc", flag.ExitOnError)
+	fs.Usage = usage
+	html := fs.String("o", "", "HTML file")
+	svg := fs.String("svg", "", "SVG directory")
+	fs.Parse(args)
+	if fs.NArg() < 1 {
+		fail("Grammar file required")
+	}
+	SrcFile, HTMLFile, SVGDir = fs.Arg(0), *html, *svg
+	if HTMLFile == "" {
+		HTMLFile = strings.TrimSuffix(SrcFile, path.Ext(SrcFile)) + ".html"
+	}
+	getFileBase()
+}
+
+func getExportParams(args []string) {
+	Command = "export"
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	fs.Usage = usage
+	format := fs.String("f", "ebnf", "Export format")
+	out := fs.String("o", "", "Output file")
+	scopes := fs.String("scope", "", "TextMate scopes")
+	fs.Parse(args)
+	if fs.NArg() < 1 {
+		fail("Grammar file required")
+	}
+	SrcFile, ExportFormat, ExportFile = fs.Arg(0), *format, *out
+	ExportScopes = getScopes(*scopes)
+	if ExportFile == "" {
+		base := strings.TrimSuffix(SrcFile, path.Ext(SrcFile))
+		switch ExportFormat {
+		case "ebnf":
+			ExportFile = base + ".ebnf"
+		case "antlr":
+			ExportFile = base + ".g4"
+		case "tree-sitter":
+			ExportFile = path.Join(path.Dir(SrcFile), "grammar.js")
+		case "textmate":
+			ExportFile = base + ".tmLanguage.json"
+		default:
+			fail("Unknown export format " + ExportFormat)
+		}
+	}
+	getFileBase()
+}
+
+/*
+getScopes returns the TextMate scopes of a comma separated list of
+<key>=<scope>. A key is a token ID or a string literal in double quotes,
+which may contain commas.
+*/
+func getScopes(list string) map[string]string {
+	scopes := map[string]string{}
+	for list != "" {
+		i, quoted, escaped := 0, false, false
+		for ; i < len(list) && (quoted || list[i] != ','); i++ {
+			switch {
+			case escaped:
+				escaped = false
+			case list[i] == '\\':
+				escaped = quoted
+			case list[i] == '"':
+				quoted = !quoted
+			}
+		}
+		item := list[:i]
+		if list = list[i:]; list != "" {
+			list = list[1:]
+		}
+		eq := strings.LastIndex(item, "=")
+		if eq <= 0 || eq == len(item)-1 {
+			fail("Invalid scope " + item + ": expected <key>=<scope>")
+		}
+		key := item[:eq]
+		if strings.HasPrefix(key, `"`) {
+			lit, err := strconv.Unquote(key)
+			if err != nil {
+				fail("Invalid string literal " + key)
+			}
+			key = strconv.Quote(lit)
+		}
+		scopes[key] = item[eq+1:]
+	}
+	return scopes
+}
+
+func getImportParams(args []string) {
+	Command = "import"
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	fs.Usage = usage
+	format := fs.String("f", "", "Import format")
+	out := fs.String("o", "", "Output file")
+	pkg := fs.String("p", "", "Package")
+	fs.Parse(args)
+	if fs.NArg() < 1 {
+		fail("Grammar file required")
+	}
+	SrcFile, ImportFormat, ImportFile, ImportPackage = fs.Arg(0), *format, *out, *pkg
+	if ImportFile == "" {
+		ImportFile = strings.TrimSuffix(SrcFile, path.Ext(SrcFile)) + ".md"
+	}
+}
+
+/*
+newCommandFlags returns the flags of a command that interprets a grammar.
+The grammar, start symbol and verbose flags are set when the flags are parsed.
+*/
+func newCommandFlags(cmd string) *commandFlags {
+	Command = cmd
+	fs := &commandFlags{FlagSet: flag.NewFlagSet(cmd, flag.ExitOnError)}
+	fs.Usage = usage
+	fs.grammar = fs.String("g", "", "Grammar file")
+	fs.start = fs.String("start", "", "Start symbol")
+	fs.verbose = fs.Bool("v", false, "Verbose")
+	return fs
+}
+
+type commandFlags struct {
+	*flag.FlagSet
+	grammar, start *string
+	verbose        *bool
+}
+
+func (fs *commandFlags) Parse(args []string) {
+	fs.FlagSet.Parse(args)
+	if *fs.grammar == "" {
+		fail("Grammar file required")
+	}
+	SrcFile, Start, Verbose = *fs.grammar, *fs.start, *fs.verbose
+	getFileBase()
+}
+
 func getFileBase() {
 	if *outDir != "" {
 		BaseDir = *outDir
@@ -83,12 +345,18 @@ func getFileBase() {
 }
 
 func getParserType() {
-	if *Pager || *Knuth {
+	if *Pager || *Knuth || *GLR {
 		*GLL = false
 	}
 	if *Pager && *Knuth {
 		fail("Only one of pager or knuth may be selected")
 	}
+	if *GLR && *Rust {
+		fail("The GLR parser can only be generated in Go")
+	}
+	if *Coverage && *Rust {
+		fail("Coverage recording can only be generated in Go")
+	}
 }
 
 func getSourceFile() {
@@ -112,7 +380,140 @@ func usage() {
 use: gogll -version
     to display the version of goggl, or
 
-use: gogll [-a][-v] [-CPUProf] [-o <out dir>] [-go] [-rust] [-gll] [-pager] [-knuth] [-resolve_conflicts] <source file>
+use: gogll parse -g <grammar file> [-start <start symbol>] [-v] <input file>
+    to parse the input file with the grammar without generating code. 
+    Prints the parse tree of the input, the ambiguities of the parse forest 
+    or the parse errors. Prints the tokens of the input if the grammar has no
+    syntax rules.
+
+    -start <start symbol>: Optional. The start symbol to parse, which must be 
+        declared in the start declaration of the grammar.
+        Default: the default start symbol of the grammar.
+
+use: gogll test -g <grammar file> [-start <start symbol>] [-update] [-v] [<test data dir>]
+    to run the golden tests of the grammar. Every input in <test data dir>/accept 
+    must parse without errors or ambiguities and every input in 
+    <test data dir>/reject must fail to parse. The output of an input, e.g.: 
+    accept/assign.txt, is compared to its golden files: assign.tree for the 
+    parse tree, assign.errors for the parse errors and assign.tokens for the 
+    tokens.
+
+    <test data dir>: Optional. Default: testdata in the directory of the grammar.
+
+    -update: Optional. Write the output of every input to its golden files.
+        The tree or errors are written for grammars with syntax rules and the
+        tokens for grammars without syntax rules. Existing golden files are
+        always updated.
+
+use: gogll gen-sentences -g <grammar file> [-start <start symbol>] [-n <number>] [-depth <depth>] [-seed <seed>] [-corpus <dir>] [-v]
+    to generate random sentences of the grammar. The syntax rules are expanded
+    from the start symbol and the tokens are sampled from the lexer DFA. Every
+    sentence is checked with the grammar interpreter and invalid sentences are
+    discarded. The sentences are printed one per line.
+
+    -n <number>: Optional. The number of sentences. Default: 10
+
+    -depth <depth>: Optional. The maximum depth of the derivation tree of a 
+        sentence, or the number of tokens if the grammar has no syntax rules.
+        The depth of the shortest derivation of the start symbol is used if
+        it is greater. Default: 10
+
+    -seed <seed>: Optional. The seed of the random generator. Default: 1
+
+    -corpus <dir>: Optional. Write the sentences to <dir> as the seed corpus 
+        of a Go fuzz test with a string argument, e.g.: testdata/fuzz/FuzzParse,
+        instead of printing them.
+
+    -v: Optional. Report the number of discarded sentences.
+
+use: gogll coverage -g <grammar file> [-start <start symbol>] [-html <report file>] [-v] <profile or input>...
+    to report the alternates and tokens of the grammar that are not covered.
+    Each argument is a coverage profile written by a parser generated with 
+    -coverage, an input file or a directory of input files. The input files 
+    are parsed with the grammar interpreter. The coverage of all the arguments
+    is added up and the uncovered alternates and tokens are printed with their
+    position in the grammar.
+
+    -html <report file>: Optional. Also write an HTML report listing every 
+        alternate and token with its count.
+
+use: gogll fmt [-d] [-w] <grammar file>...
+    to reprint the rules of the grammar files in canonical form and print
+    the result. Only the code blocks of a markdown grammar are reprinted.
+    The rule order, the blank lines between the rules and the text outside
+    the code blocks are kept.
+
+    -d: Optional. Print the diff of every file that is not formatted instead
+        of the result, and exit with status 1 if there is a diff.
+
+    -w: Optional. Write the result to the grammar file instead of printing it.
+
+use: gogll lsp
+    to run the language server for gogll grammars, which communicates with
+    the editor over stdin and stdout. The server reports the errors, lexer
+    conflicts and LR(1) conflicts of the open grammars and supports go to
+    definition, find references, hover with the FIRST and FOLLOW sets, rename
+    and completion of the nonterminals and token IDs.
+
+use: gogll doc [-o <html file>] [-svg <dir>] <grammar file>
+    to write the documentation of the grammar as an HTML page with a railroad 
+    diagram of every syntax and lex rule. The nonterminals and token IDs in 
+    the diagrams link to their rules. Every syntax rule has a table of its 
+    FIRST and FOLLOW sets and every rule lists the syntax rules that use it.
+    The prose of a markdown grammar is kept between the rules of its code 
+    blocks.
+
+    -o <html file>: Optional. The HTML file.
+        Default: <grammar file> with extension .html
+
+    -svg <dir>: Optional. Also write the diagram of every rule to 
+        <dir>/<rule ID>.svg
+
+use: gogll export [-f <format>] [-o <file>] [-scope <key>=<scope>,...]
+    <grammar file>
+    to translate the grammar to the grammar format of another tool and report
+    the constructs of the grammar that cannot be represented faithfully in 
+    the format.
+
+    -f <format>: Optional. The format, which is one of:
+        ebnf: W3C EBNF
+        antlr: an ANTLR4 combined grammar
+        tree-sitter: a tree-sitter grammar.js
+        textmate: a TextMate grammar for syntax highlighting, which is 
+            derived from the lex rules and string literals of the grammar
+        Default: ebnf
+
+    -o <file>: Optional. The output file. The name of an ANTLR4 grammar is
+        the name of its file.
+        Default: <grammar file> with extension .ebnf, .g4 or 
+        .tmLanguage.json, or grammar.js in the directory of the grammar file.
+
+    -scope <key>=<scope>,...: Optional. textmate only. Assigns TextMate
+        scopes to tokens, replacing the scopes inferred from their roles. 
+        A key is a token ID or a string literal in double quotes, e.g.:
+        -scope 'id=entity.name,"if"=keyword.control.conditional'
+
+use: gogll import [-f <format>] [-o <file>] [-p <package>] <grammar file>
+    to convert the grammar of another tool to a gogll markdown grammar. Lexer
+    rules become lex rules and parser rules become syntax rules, with the 
+    optional, repeated and grouped expressions desugared into new syntax 
+    rules. Actions and the constructs that cannot be translated are dropped
+    and reported with their position in the grammar file.
+
+    -f <format>: Optional. The format, which is one of:
+        ebnf: a W3C EBNF grammar
+        antlr: an ANTLR4 grammar
+        yacc: a Yacc or Bison grammar
+        Default: ebnf for extension .ebnf, antlr for extension .g4 and yacc 
+        for extensions .y and .yy
+
+    -o <file>: Optional. The gogll grammar.
+        Default: <grammar file> with extension .md
+
+    -p <package>: Optional. The package of the gogll grammar.
+        Default: the name of the grammar file without extension
+
+use: gogll [-a][-v] [-CPUProf] [-o <out dir>] [-go] [-rust] [-gll] [-glr] [-pager] [-knuth] [-resolve_conflicts] [-coverage] <source file>
     to generate a lexer and parser.
 
     <source file>: Mandatory. Name of the source file to be processed. 
@@ -136,7 +537,12 @@ use: gogll [-a][-v] [-CPUProf] [-o <out dir>] [-go] [-rust] [-gll] [-pager] [-kn
            Default: false
            
     -gll: Optional. Generate a GLL parser.
-          Default true. False if -knuth or -pager is selected.
+          Default true. False if -glr, -knuth or -pager is selected.
+
+    -glr: Optional. Generate a GLR parser, which follows all the actions of
+          conflicted LR(1) states and returns a BSR set like the GLL parser.
+          The LR(1) tables are Pager's PGM tables, or Knuth's with -knuth.
+          Go only. Default false
                   
     -knuth: Optional. Generate a Knuth LR(1) parser
             Default false
@@ -147,6 +553,11 @@ use: gogll [-a][-v] [-CPUProf] [-o <out dir>] [-go] [-rust] [-gll] [-pager] [-kn
     -resolve_conflicts: Optional. Automatically resolve LR(1) conflicts.
             Default: false. Only used when generating LR(1) parsers.
     
+    -coverage: Optional. Generate the package coverage, which records the 
+          alternates completed by the parser and the tokens scanned by the 
+          lexer. The coverage profile written by coverage.WriteFile is 
+          reported by gogll coverage. Go only. Default false
+
     -bs: Optional. Print BSR statistics (GLL only).
     
     -CPUProf : Optional. Generate a CPU profile. Default false.
@@ -0,0 +1,81 @@
+package coverage
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/goccmack/gogll/v3/internal/loader"
+)
+
+const profile = `gogll coverage v1
+alt Expr 0 3
+alt Stmt 0 1
+tok "=" 1
+tok "id" 4
+`
+
+func TestReadWrite(t *testing.T) {
+	p, err := Read(strings.NewReader(profile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Merge(p)
+	w := new(bytes.Buffer)
+	if err := p.Write(w); err != nil {
+		t.Fatal(err)
+	}
+	exp := `gogll coverage v1
+alt Expr 0 6
+alt Stmt 0 2
+tok "=" 2
+tok "id" 8
+`
+	if w.String() != exp {
+		t.Fatalf("expected:\n%s\ngot:\n%s", exp, w)
+	}
+
+	if _, err := Read(strings.NewReader("gogll coverage v1\nalt Expr x 1\n")); err == nil {
+		t.Fatal("expected an error for an invalid alt line")
+	}
+}
+
+const src = `package "coverage"
+
+Stmt : id "=" Expr ;
+
+Expr : id | "(" Expr ")" ;
+
+id : letter {letter} ;
+
+!comment : '/' '/' {not "\n"} ;
+`
+
+func TestReport(t *testing.T) {
+	gr, errs := loader.Source("test.md", src)
+	if errs != nil {
+		t.Fatal(errs[0])
+	}
+	g := gr.AST
+
+	p, err := Read(strings.NewReader(profile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := new(bytes.Buffer)
+	NewReport(g, p).WriteText(w)
+	exp := `Alternates: 2/3 covered (66.7%)
+Tokens: 2/5 covered (40.0%)
+
+Uncovered alternates:
+test.md:5: Expr : "(" Expr ")"
+
+Uncovered tokens:
+test.md:5: "("
+test.md:5: ")"
+test.md:9: comment
+`
+	if w.String() != exp {
+		t.Fatalf("expected:\n%s\ngot:\n%s", exp, w)
+	}
+}
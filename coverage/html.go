@@ -0,0 +1,93 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package coverage
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+)
+
+type htmlData struct {
+	Title string
+	*Report
+	AltSummary, TokSummary string
+}
+
+// WriteHTML writes r to w as an HTML page with title. Every alternate and
+// token is listed with its position in the grammar and its count. The
+// uncovered alternates and tokens are highlighted.
+func (r *Report) WriteHTML(w io.Writer, title string) error {
+	tmpl, err := template.New("coverage").Parse(htmlSrc)
+	if err != nil {
+		panic(err)
+	}
+	altCovered, altTotal := r.AlternatesCovered()
+	tokCovered, tokTotal := r.TokensCovered()
+	return tmpl.Execute(w, &htmlData{
+		Title:      title,
+		Report:     r,
+		AltSummary: summary(altCovered, altTotal),
+		TokSummary: summary(tokCovered, tokTotal),
+	})
+}
+
+func summary(covered, total int) string {
+	return fmt.Sprintf("%s (%d/%d)", percent(covered, total), covered, total)
+}
+
+const htmlSrc = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+td, th { padding: 2px 12px; text-align: left; }
+td.pos, td.count { color: #666; }
+td.count { text-align: right; }
+code { font-size: 110%; }
+tr.uncovered { background: #fdd; }
+tr.covered { background: #dfd; }
+tr.rule td { padding-top: 10px; font-weight: bold; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p>Alternates covered: {{.AltSummary}}<br>
+Tokens covered: {{.TokSummary}}</p>
+
+<h2>Alternates</h2>
+<table>
+<tr><th>Position</th><th>Alternate</th><th>Count</th></tr>
+{{- range $r := .Rules}}
+<tr class="rule" id="{{$r.NT}}"><td class="pos">{{$r.Pos.File}}:{{$r.Pos.Line}}</td><td><code>{{$r.NT}}</code></td><td></td></tr>
+{{- range $a := $r.Alternates}}
+<tr class="{{if $a.Count}}covered{{else}}uncovered{{end}}"><td class="pos">{{$a.Pos.File}}:{{$a.Pos.Line}}</td><td><code>{{$a.Text}}</code></td><td class="count">{{$a.Count}}</td></tr>
+{{- end}}
+{{- end}}
+</table>
+
+<h2>Tokens</h2>
+<table>
+<tr><th>Position</th><th>Token</th><th>Count</th></tr>
+{{- range $t := .Tokens}}
+<tr class="{{if $t.Count}}covered{{else}}uncovered{{end}}"><td class="pos">{{$t.Pos.File}}:{{$t.Pos.Line}}</td><td><code>{{$t.Name}}</code></td><td class="count">{{$t.Count}}</td></tr>
+{{- end}}
+</table>
+</body>
+</html>
+`
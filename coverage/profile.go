@@ -0,0 +1,175 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+/*
+Package coverage reads and writes grammar coverage profiles and reports the
+alternates and tokens of a grammar that are not covered by a profile.
+
+A profile is written by the coverage package of a lexer and parser generated
+with -coverage, or recorded by gogll coverage with the grammar interpreter.
+It is a text file with the header line Header followed by one line per
+alternate and token:
+
+	alt Expr 0 12
+	tok "id" 7
+
+An alt line contains the head of the alternate, the index of the alternate in
+the syntax rule and the number of times the alternate was completed. A tok
+line contains the quoted token ID and the number of times the token was
+scanned.
+*/
+package coverage
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Header is the first line of a coverage profile
+const Header = "gogll coverage v1"
+
+// Profile contains the number of times each alternate was completed and each
+// token was scanned
+type Profile struct {
+	Alternates map[Alternate]int
+	Tokens     map[string]int
+}
+
+// Alternate identifies alternate Index of the syntax rule of NT
+type Alternate struct {
+	NT    string
+	Index int
+}
+
+// New returns an empty Profile
+func New() *Profile {
+	return &Profile{
+		Alternates: make(map[Alternate]int),
+		Tokens:     make(map[string]int),
+	}
+}
+
+// IsProfile returns true if the file fname starts with Header
+func IsProfile(fname string) bool {
+	f, err := os.Open(fname)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	buf := make([]byte, len(Header))
+	n, _ := io.ReadFull(f, buf)
+	return string(buf[:n]) == Header
+}
+
+// ReadFile reads the profile in file fname
+func ReadFile(fname string) (*Profile, error) {
+	f, err := os.Open(fname)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	p, err := Read(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", fname, err)
+	}
+	return p, nil
+}
+
+// Read reads a profile from r
+func Read(r io.Reader) (*Profile, error) {
+	p := New()
+	sc := bufio.NewScanner(r)
+	if !sc.Scan() || sc.Text() != Header {
+		return nil, fmt.Errorf("not a coverage profile")
+	}
+	for ln := 2; sc.Scan(); ln++ {
+		line := sc.Text()
+		switch {
+		case strings.TrimSpace(line) == "":
+		case strings.HasPrefix(line, "alt "):
+			var alt Alternate
+			var n int
+			if _, err := fmt.Sscanf(line, "alt %s %d %d", &alt.NT, &alt.Index, &n); err != nil {
+				return nil, fmt.Errorf("line %d: %s", ln, err)
+			}
+			p.Alternates[alt] += n
+		case strings.HasPrefix(line, "tok "):
+			var id string
+			var n int
+			if _, err := fmt.Sscanf(line, "tok %q %d", &id, &n); err != nil {
+				return nil, fmt.Errorf("line %d: %s", ln, err)
+			}
+			p.Tokens[id] += n
+		default:
+			return nil, fmt.Errorf("line %d: invalid line %q", ln, line)
+		}
+	}
+	return p, sc.Err()
+}
+
+// Merge adds the counts of p1 to p
+func (p *Profile) Merge(p1 *Profile) {
+	for alt, n := range p1.Alternates {
+		p.Alternates[alt] += n
+	}
+	for id, n := range p1.Tokens {
+		p.Tokens[id] += n
+	}
+}
+
+// Write writes p to w, sorted by alternate and token ID
+func (p *Profile) Write(w io.Writer) error {
+	alts := make([]Alternate, 0, len(p.Alternates))
+	for alt := range p.Alternates {
+		alts = append(alts, alt)
+	}
+	sort.Slice(alts, func(i, j int) bool {
+		if alts[i].NT != alts[j].NT {
+			return alts[i].NT < alts[j].NT
+		}
+		return alts[i].Index < alts[j].Index
+	})
+	toks := make([]string, 0, len(p.Tokens))
+	for id := range p.Tokens {
+		toks = append(toks, id)
+	}
+	sort.Strings(toks)
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, Header)
+	for _, alt := range alts {
+		fmt.Fprintf(bw, "alt %s %d %d\n", alt.NT, alt.Index, p.Alternates[alt])
+	}
+	for _, id := range toks {
+		fmt.Fprintf(bw, "tok %q %d\n", id, p.Tokens[id])
+	}
+	return bw.Flush()
+}
+
+// WriteFile writes p to file fname
+func (p *Profile) WriteFile(fname string) error {
+	f, err := os.Create(fname)
+	if err != nil {
+		return err
+	}
+	if err := p.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,201 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package coverage
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/goccmack/gogll/v3/ast"
+)
+
+// Report is the coverage of the syntax rules and tokens of a grammar
+type Report struct {
+	Rules  []*RuleCoverage
+	Tokens []*TokenCoverage
+}
+
+// RuleCoverage is the coverage of the alternates of a syntax rule
+type RuleCoverage struct {
+	NT         string
+	Pos        *ast.Position
+	Alternates []*AltCoverage
+}
+
+// AltCoverage is the coverage of an alternate
+type AltCoverage struct {
+	Index int
+	// Text is the alternate, e.g.: `Expr : Expr "-" Expr`
+	Text string
+	// Pos is the position of the first symbol of the alternate, or of the
+	// rule if the alternate is empty
+	Pos   *ast.Position
+	Count int
+}
+
+// TokenCoverage is the coverage of a token
+type TokenCoverage struct {
+	ID string
+	// Pos is the position of the lex rule of the token, or of the first
+	// occurrence of a string literal token in the syntax rules
+	Pos   *ast.Position
+	Count int
+	// StringLit is true if the token is a string literal
+	StringLit bool
+}
+
+// NewReport returns the coverage of the syntax rules and tokens of g by p.
+// The tokens are the lex rules, including the suppressed rules, and the
+// string literals of g.
+func NewReport(g *ast.GoGLL, p *Profile) *Report {
+	r := &Report{}
+	slitPos := map[string]*ast.Position{}
+	for _, rule := range g.SyntaxRules {
+		rc := &RuleCoverage{
+			NT:  rule.ID(),
+			Pos: rule.Pos,
+		}
+		for i, a := range rule.Alternates {
+			ac := &AltCoverage{
+				Index: i,
+				Text:  ast.AlternateString(rule.ID(), a),
+				Pos:   rule.Pos,
+				Count: p.Alternates[Alternate{rule.ID(), i}],
+			}
+			if !a.Empty() {
+				ac.Pos = rule.SymbolPosition(a.Symbols[0])
+			}
+			for _, sym := range a.Symbols {
+				if sl, ok := sym.(*ast.StringLit); ok && slitPos[sl.ID()] == nil {
+					slitPos[sl.ID()] = rule.SymbolPosition(sym)
+				}
+			}
+			rc.Alternates = append(rc.Alternates, ac)
+		}
+		r.Rules = append(r.Rules, rc)
+	}
+	for _, rule := range g.LexRules {
+		r.Tokens = append(r.Tokens, &TokenCoverage{
+			ID:    rule.ID(),
+			Pos:   rule.Pos,
+			Count: p.Tokens[rule.ID()],
+		})
+	}
+	for _, id := range g.GetStringLiterals() {
+		r.Tokens = append(r.Tokens, &TokenCoverage{
+			ID:        id,
+			Pos:       slitPos[id],
+			Count:     p.Tokens[id],
+			StringLit: true,
+		})
+	}
+	sort.SliceStable(r.Tokens, func(i, j int) bool {
+		pi, pj := r.Tokens[i].Pos, r.Tokens[j].Pos
+		if pi.File != pj.File {
+			return pi.File < pj.File
+		}
+		return pi.Line < pj.Line
+	})
+	return r
+}
+
+// AlternatesCovered returns the number of covered alternates and the number
+// of alternates
+func (r *Report) AlternatesCovered() (covered, total int) {
+	for _, rc := range r.Rules {
+		for _, ac := range rc.Alternates {
+			if ac.Count > 0 {
+				covered++
+			}
+			total++
+		}
+	}
+	return
+}
+
+// TokensCovered returns the number of covered tokens and the number of tokens
+func (r *Report) TokensCovered() (covered, total int) {
+	for _, tc := range r.Tokens {
+		if tc.Count > 0 {
+			covered++
+		}
+	}
+	return covered, len(r.Tokens)
+}
+
+/*
+WriteText writes the summary of r and the uncovered alternates and tokens to
+w, e.g.:
+
+	Alternates: 9/10 covered (90.0%)
+	Tokens: 7/7 covered (100.0%)
+
+	Uncovered alternates:
+	glr1.md:20: Term : "(" Expr ")"
+*/
+func (r *Report) WriteText(w io.Writer) {
+	covered, total := r.AlternatesCovered()
+	fmt.Fprintf(w, "Alternates: %d/%d covered (%s)\n", covered, total, percent(covered, total))
+	covered, total = r.TokensCovered()
+	fmt.Fprintf(w, "Tokens: %d/%d covered (%s)\n", covered, total, percent(covered, total))
+
+	var alts []string
+	for _, rc := range r.Rules {
+		for _, ac := range rc.Alternates {
+			if ac.Count == 0 {
+				alts = append(alts, fmt.Sprintf("%s: %s", position(ac.Pos), ac.Text))
+			}
+		}
+	}
+	writeList(w, "Uncovered alternates:", alts)
+
+	var toks []string
+	for _, tc := range r.Tokens {
+		if tc.Count == 0 {
+			toks = append(toks, fmt.Sprintf("%s: %s", position(tc.Pos), tc.Name()))
+		}
+	}
+	writeList(w, "Uncovered tokens:", toks)
+}
+
+// Name returns the ID of tc, quoted if tc is a string literal
+func (tc *TokenCoverage) Name() string {
+	if tc.StringLit {
+		return fmt.Sprintf("%q", tc.ID)
+	}
+	return tc.ID
+}
+
+func writeList(w io.Writer, title string, lines []string) {
+	if len(lines) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "\n%s\n", title)
+	for _, l := range lines {
+		fmt.Fprintln(w, l)
+	}
+}
+
+func percent(n, total int) string {
+	if total == 0 {
+		return "100.0%"
+	}
+	return fmt.Sprintf("%.1f%%", 100*float64(n)/float64(total))
+}
+
+func position(pos *ast.Position) string {
+	return fmt.Sprintf("%s:%d", pos.File, pos.Line)
+}
@@ -12,11 +12,11 @@ fn main() {
     let tok = toks[0].clone();
     if tok.id() != "name" || tok.literal_string() != "name1" {
         println!("id {}, lit {}", tok.id(), tok.literal_string());
-        panic!(tok.id().to_string());
+        panic!("{}", tok.id());
     }
     let tok = toks[1].clone();
     if tok.id() != "name" || tok.literal_string() != "name2" {
-        panic!(tok.id().to_string());
+        panic!("{}", tok.id());
     }
     println!("OK")
 }
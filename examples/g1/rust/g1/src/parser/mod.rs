@@ -4,7 +4,9 @@ extern crate lazy_static;
 
 pub mod bsr;
 mod slot;
-mod symbols;
+pub mod sppf;
+pub mod symbols;
+pub mod visitor;
 
 use crate::lexer;
 use crate::token;
@@ -17,6 +19,7 @@ use std::fmt;
 use std::rc::Rc;
 
 struct Parser {
+	start: NT,
 	c_i: usize,
 
 	r: Vec<Rc<Descriptor>>,
@@ -105,13 +108,32 @@ struct CRFNode {
 	i: usize,
 }
 
-/// Parse returns the BSR set containing the parse forest.
+/// START_SYMBOLS contains the start symbols of the grammar, which can be
+/// parsed by parse_from. The first is the default start symbol, which is parsed
+/// by parse.
+pub const START_SYMBOLS: [NT; 1] = [
+    NT::Exp,
+];
+
+/// Parse returns the BSR set containing the parse forest of the default start
+/// symbol, Exp.
 /// If the parse was successfull the length of Vec\<Box\<Error\>\> = 0.
 #[allow(dead_code)]
 pub fn parse(l: Rc<lexer::Lexer>) -> (Box<bsr::Set>, Vec<Box<Error>>) {
-    let mut p = Parser::new(l.clone());
+    parse_from(NT::Exp, l)
+}
+
+/// parse_from returns the BSR set containing the parse forest of the start
+/// symbol nt. parse_from panics if nt is not in START_SYMBOLS.
+/// If the parse was successfull the length of Vec\<Box\<Error\>\> = 0.
+#[allow(dead_code)]
+pub fn parse_from(nt: NT, l: Rc<lexer::Lexer>) -> (Box<bsr::Set>, Vec<Box<Error>>) {
+    if !START_SYMBOLS.contains(&nt) {
+        panic!("{} is not a start symbol", nt)
+    }
+    let mut p = Parser::new(nt, l.clone());
     p.parse();
-    if !p.bsr_set.contain(&NT::Exp, 0, l.tokens.len()-1) {
+    if !p.bsr_set.contain(&nt, 0, l.tokens.len()-1) {
         let errors = p.export_errors();
         (p.bsr_set, errors)
     } else {
@@ -120,8 +142,9 @@ pub fn parse(l: Rc<lexer::Lexer>) -> (Box<bsr::Set>, Vec<Box<Error>>) {
 }
 
 impl Parser {
-    fn new(l: Rc<lexer::Lexer>) -> Box<Parser> {
+    fn new(start: NT, l: Rc<lexer::Lexer>) -> Box<Parser> {
         let mut p = Box::new(Parser{
+            start:       start,
             c_i:         0,
             lex:         l.clone(),
             r:           Vec::with_capacity(1024),
@@ -129,16 +152,16 @@ impl Parser {
             popped:      HashSet::with_capacity(1024),
             crf:         HashMap::with_capacity(1024),
             crf_nodes:   HashSet::with_capacity(1024),
-            bsr_set:     bsr::Set::new(NT::Exp, l.clone()),
+            bsr_set:     bsr::Set::new(start, l.clone()),
             errors:      Vec::with_capacity(1024),
         });
-        p.crf.insert(ClusterNode::new(NT::Exp, 0), HashSet::with_capacity(128));
+        p.crf.insert(ClusterNode::new(start, 0), HashSet::with_capacity(128));
         p
     }
 
     fn parse(&mut self) {
         // let mut c_u = 0;
-        self.nt_add(NT::Exp, 0);
+        self.nt_add(self.start, 0);
         // let mut slotNo = 0;
         while self.r.len() > 0 {
             let (l, c_u, c_i) = self.r_remove();
@@ -0,0 +1,186 @@
+//! Module sppf is generated by gogll. Do not edit.
+
+/*!
+Module sppf implements a Shared Packed Parse Forest as defined in:
+
+    Elizabeth Scott, Adrian Johnstone
+    GLL parse-tree generation
+    Science of Computer Programming (2012), doi:10.1016/j.scico.2012.03.005
+
+The nodes of the SPPF are stored in SPPF.nodes and refer to each other by their
+index, NodeId. The SPPF is built from a BSR set by bsr::Set::to_sppf.
+*/
+
+use crate::parser::slot::Label;
+use crate::parser::symbols::NT;
+
+use std::collections::HashSet;
+use std::fmt;
+use std::fmt::Write;
+use std::fs;
+use std::io;
+
+/// NodeId is the index of a node in SPPF.nodes
+pub type NodeId = usize;
+
+pub struct SPPF {
+    pub nodes: Vec<Node>,
+    /// root is the symbol node of the start symbol
+    pub root: NodeId,
+}
+
+pub enum Node {
+    Intermediate(IntermediateNode),
+    Packed(PackedNode),
+    Symbol(SymbolNode),
+}
+
+/// IntermediateNode is labelled with a grammar slot X : α•β, where |α| > 1
+pub struct IntermediateNode {
+    pub slot: Label,
+    pub lext: usize,
+    pub rext: usize,
+    pub children: Vec<NodeId>,
+}
+
+/// PackedNode is labelled with a grammar slot X : α•β and a pivot
+pub struct PackedNode {
+    pub slot: Label,
+    pub lext: usize,
+    pub pivot: usize,
+    pub rext: usize,
+    /// left_child is either an intermediate or a symbol node
+    pub left_child: Option<NodeId>,
+    pub right_child: Option<NodeId>,
+}
+
+/// SymbolNode is labelled with a terminal, nonterminal or ϵ
+pub struct SymbolNode {
+    pub symbol: String,
+    /// nt is the nonterminal of the node, or None for a terminal or ϵ
+    pub nt: Option<NT>,
+    pub lext: usize,
+    pub rext: usize,
+    pub children: Vec<NodeId>,
+}
+
+impl SPPF {
+    #[allow(dead_code)]
+    pub fn node(&self, id: NodeId) -> &Node {
+        &self.nodes[id]
+    }
+
+    /// dot returns a graph representation of the SPPF in dot notation
+    #[allow(dead_code)]
+    pub fn dot(&self) -> String {
+        let mut bld = DotBuilder {
+            sppf: self,
+            done: HashSet::new(),
+            w: String::new(),
+        };
+        bld.w.push_str("digraph SPPF {\n");
+        bld.dot(self.root);
+        bld.w.push_str("}\n");
+        bld.w
+    }
+
+    /// dot_file writes a graph representation of the SPPF in dot notation to
+    /// file
+    #[allow(dead_code)]
+    pub fn dot_file(&self, file: &str) -> io::Result<()> {
+        fs::write(file, self.dot())
+    }
+}
+
+impl Node {
+    pub fn label(&self) -> String {
+        match self {
+            Node::Intermediate(n) => format!("\"{}:,{},{}\"", slot_string(n.slot), n.lext, n.rext),
+            Node::Packed(n) => format!("\"{},{},{},{}\"", slot_string(n.slot), n.lext, n.pivot, n.rext),
+            Node::Symbol(n) => format!("\"{},{},{}\"", n.symbol, n.lext, n.rext),
+        }
+    }
+}
+
+impl fmt::Display for Node {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        match self {
+            Node::Intermediate(_) => write!(f, "IN: {}", self.label()),
+            Node::Packed(_) => write!(f, "PN: {}", self.label()),
+            Node::Symbol(_) => write!(f, "SN: {}", self.label()),
+        }
+    }
+}
+
+fn slot_string(l: Label) -> String {
+    let mut s = format!("{}:", l.head());
+    for (i, sym) in l.symbols().iter().enumerate() {
+        s.push(' ');
+        if i == l.pos() {
+            s.push('•');
+        }
+        s.push_str(&sym.to_string());
+    }
+    if l.symbols().len() == l.pos() {
+        s.push('•');
+    }
+    s
+}
+
+//---- Dot ----
+
+struct DotBuilder<'a> {
+    sppf: &'a SPPF,
+    done: HashSet<NodeId>,
+    w: String,
+}
+
+impl<'a> DotBuilder<'a> {
+    fn label(&self, id: NodeId) -> String {
+        self.sppf.nodes[id].label()
+    }
+
+    fn dot(&mut self, id: NodeId) {
+        if !self.done.insert(id) {
+            return
+        }
+        let label = self.label(id);
+        match &self.sppf.nodes[id] {
+            Node::Intermediate(n) => {
+                writeln!(self.w, "{} [shape=box]", label).unwrap();
+                for &c in n.children.iter() {
+                    let cl = self.label(c);
+                    writeln!(self.w, "{} -> {}", label, cl).unwrap();
+                    self.dot(c);
+                }
+            },
+            Node::Packed(n) => {
+                writeln!(self.w, "{} [shape=box,style=rounded,penwidth=3]", label).unwrap();
+                if let Some(c) = n.left_child {
+                    self.dot(c);
+                    let cl = self.label(c);
+                    writeln!(self.w, "{} -> {}", label, cl).unwrap();
+                }
+                if let Some(c) = n.right_child {
+                    self.dot(c);
+                    let cl = self.label(c);
+                    writeln!(self.w, "{} -> {}", label, cl).unwrap();
+                }
+                if let (Some(l), Some(r)) = (n.left_child, n.right_child) {
+                    let (ll, rl) = (self.label(l), self.label(r));
+                    writeln!(self.w, "{},{}", ll, rl).unwrap();
+                }
+            },
+            Node::Symbol(n) => {
+                writeln!(self.w, "{}", label).unwrap();
+                for &pn in n.children.iter() {
+                    let pl = self.label(pn);
+                    writeln!(self.w, "{} -> {}", label, pl).unwrap();
+                    self.dot(pn);
+                }
+                let pns: Vec<String> = n.children.iter().map(|&pn| self.label(pn)).collect();
+                writeln!(self.w, "{}", pns.join(";")).unwrap();
+            },
+        }
+    }
+}
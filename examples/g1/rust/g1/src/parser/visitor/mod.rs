@@ -0,0 +1,111 @@
+//! Module visitor is generated by gogll. Do not edit.
+
+/*!
+Module visitor walks an unambiguous BSR set.
+
+walk calls the enter and exit methods of the nonterminal and of the alternate
+of every NT BSR. The enter methods are called in pre-order and the exit methods
+in post-order:
+
+    enter_expr, enter_expr_alt_0, <walk the NT children of the BSR>, exit_expr_alt_0, exit_expr
+
+The methods of Visitor have default implementations, which do nothing, so a
+visitor only implements the methods it needs.
+*/
+
+use crate::parser::bsr::{Set, BSR};
+use crate::parser::symbols::NT;
+
+use std::rc::Rc;
+
+/// Visitor has enter and exit methods for each nonterminal and each alternate
+/// of the grammar. If an enter method returns false the children of the BSR
+/// are not walked. The matching exit method is always called.
+#[allow(unused_variables)]
+pub trait Visitor {
+    fn enter_exp(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_exp(&mut self, set: &Set, b: &Rc<BSR>) {}
+
+    /// Exp : Exp Op Exp
+    fn enter_exp_alt_0(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_exp_alt_0(&mut self, set: &Set, b: &Rc<BSR>) {}
+
+    /// Exp : id
+    fn enter_exp_alt_1(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_exp_alt_1(&mut self, set: &Set, b: &Rc<BSR>) {}
+
+    fn enter_op(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_op(&mut self, set: &Set, b: &Rc<BSR>) {}
+
+    /// Op : "&"
+    fn enter_op_alt_0(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_op_alt_0(&mut self, set: &Set, b: &Rc<BSR>) {}
+
+    /// Op : "|"
+    fn enter_op_alt_1(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_op_alt_1(&mut self, set: &Set, b: &Rc<BSR>) {}
+}
+
+/// Walks the BSR b of set and its NT children, calling the methods of v.
+/// Panics if b has ambiguous children.
+#[allow(dead_code)]
+pub fn walk(set: &Set, b: Rc<BSR>, v: &mut dyn Visitor) {
+    match b.label.head() {
+        NT::Exp => {
+            if v.enter_exp(set, &b) {
+                walk_exp(set, &b, v);
+            }
+            v.exit_exp(set, &b);
+        },
+        NT::Op => {
+            if v.enter_op(set, &b) {
+                walk_op(set, &b, v);
+            }
+            v.exit_op(set, &b);
+        },
+    }
+}
+
+fn walk_exp(set: &Set, b: &Rc<BSR>, v: &mut dyn Visitor) {
+    match b.label.alternate() {
+        0 => {
+            if v.enter_exp_alt_0(set, b) {
+                walk_children(set, b, v);
+            }
+            v.exit_exp_alt_0(set, b);
+        },
+        1 => {
+            if v.enter_exp_alt_1(set, b) {
+                walk_children(set, b, v);
+            }
+            v.exit_exp_alt_1(set, b);
+        },
+        n => panic!("invalid alternate {} of Exp", n),
+    }
+}
+
+fn walk_op(set: &Set, b: &Rc<BSR>, v: &mut dyn Visitor) {
+    match b.label.alternate() {
+        0 => {
+            if v.enter_op_alt_0(set, b) {
+                walk_children(set, b, v);
+            }
+            v.exit_op_alt_0(set, b);
+        },
+        1 => {
+            if v.enter_op_alt_1(set, b) {
+                walk_children(set, b, v);
+            }
+            v.exit_op_alt_1(set, b);
+        },
+        n => panic!("invalid alternate {} of Op", n),
+    }
+}
+
+fn walk_children(set: &Set, b: &Rc<BSR>, v: &mut dyn Visitor) {
+    for (i, s) in b.label.symbols().iter().enumerate() {
+        if s.is_nt() {
+            walk(set, set.get_nt_child_i(b.clone(), i), v);
+        }
+    }
+}
@@ -0,0 +1,397 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+/*
+Package format reprints the rules of a gogll grammar in canonical form.
+
+Only the code blocks of a markdown grammar are reprinted. The text outside the
+code blocks is left untouched. The rules keep their order and a blank line
+between two rules is kept. A rule that is written on one line is reprinted on
+one line:
+
+	Rule : LexRule | SyntaxRule | Import | Start ;
+
+A rule that spans several lines is reprinted with one alternate per line and
+the alternate names aligned:
+
+	Expr
+	    :   lhs:Term op:"+" rhs:Expr #Binary
+	    |   Term                     #Single
+	    ;
+
+The symbols of syntax rules are separated by a single space. The spacing of
+lex rules is only normalised between the alternates, because white space
+separates the tokens of a lex rule, e.g.: after `'[` in a Unicode set.
+*/
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goccmack/gogll/v3/lexer"
+	"github.com/goccmack/gogll/v3/parser"
+	"github.com/goccmack/gogll/v3/token"
+)
+
+const indent = "    "
+
+/*
+Source returns the canonical form of the grammar src, which was read from file
+fname. If fname has the extension ".md" only the code blocks of src are
+reprinted. An error is returned if src has a syntax error.
+*/
+func Source(fname string, src []byte) ([]byte, error) {
+	input := []rune(string(src))
+	md := strings.HasSuffix(fname, ".md")
+	blocks := codeBlocks(input, md)
+
+	lex := lexer.New(code(input, blocks))
+	if _, errs := parser.Parse(lex); errs != nil {
+		return nil, fmt.Errorf("%s: %s", fname, errs[0])
+	}
+	toks := lex.Tokens[:len(lex.Tokens)-1]
+
+	out := new(strings.Builder)
+	pos := 0
+	for _, b := range blocks {
+		var btoks []*token.Token
+		for len(toks) > 0 && toks[0].Lext() < b.rext {
+			btoks, toks = append(btoks, toks[0]), toks[1:]
+		}
+		if len(btoks) == 0 {
+			continue
+		}
+		stmts, err := statements(btoks)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", fname, err)
+		}
+		out.WriteString(string(input[pos:b.lext]))
+		if md {
+			out.WriteString("\n")
+		}
+		for i, s := range stmts {
+			if i > 0 && blankLine(input, stmts[i-1], s) {
+				out.WriteString("\n")
+			}
+			out.WriteString(s.String())
+			out.WriteString("\n")
+		}
+		pos = b.rext
+	}
+	out.WriteString(string(input[pos:]))
+	return []byte(out.String()), nil
+}
+
+// block is the extent of the rules in the input: a markdown code block
+// without its triple backticks, or the whole input if it is not markdown.
+type block struct {
+	lext, rext int
+}
+
+// codeBlocks returns the code blocks of input. The backticks are recognised
+// like the gogll lexer does in markdown files.
+func codeBlocks(input []rune, md bool) (blocks []block) {
+	if !md {
+		return []block{{0, len(input)}}
+	}
+	lext := -1
+	for i := 0; i <= len(input)-3; i++ {
+		if input[i] != '`' || input[i+1] != '`' || input[i+2] != '`' {
+			continue
+		}
+		if lext < 0 {
+			lext = i + 3
+		} else {
+			blocks = append(blocks, block{lext, i})
+			lext = -1
+		}
+		i += 2
+	}
+	if lext >= 0 {
+		blocks = append(blocks, block{lext, len(input)})
+	}
+	return
+}
+
+// code returns a copy of input in which all text outside blocks is replaced
+// by white space. The line breaks are kept.
+func code(input []rune, blocks []block) []rune {
+	c := make([]rune, len(input))
+	for i, r := range input {
+		if r == '\n' {
+			c[i] = '\n'
+		} else {
+			c[i] = ' '
+		}
+	}
+	for _, b := range blocks {
+		copy(c[b.lext:b.rext], input[b.lext:b.rext])
+	}
+	return c
+}
+
+// statement is a package specification, import, start declaration, lex rule
+// or syntax rule
+type statement struct {
+	toks []*token.Token
+}
+
+// statements splits toks into statements
+func statements(toks []*token.Token) (stmts []*statement, err error) {
+	for len(toks) > 0 {
+		n := 0
+		if toks[0].TypeID() == "package" {
+			n = 2
+			if len(toks) > 2 && toks[2].TypeID() == "case_insensitive" && !ruleHead(toks[2:]) {
+				n = 3
+			}
+		} else {
+			for i, t := range toks {
+				if t.TypeID() == ";" {
+					n = i + 1
+					break
+				}
+			}
+			if n == 0 {
+				ln, col := toks[0].GetLineColumn()
+				return nil, fmt.Errorf("%d:%d: rule continues in the next code block", ln, col)
+			}
+		}
+		stmts = append(stmts, &statement{toks[:n]})
+		toks = toks[n:]
+	}
+	return
+}
+
+// blankLine returns true if the input has a blank line between s1 and s2
+func blankLine(input []rune, s1, s2 *statement) bool {
+	lext, rext := s1.toks[len(s1.toks)-1].Rext(), s2.toks[0].Lext()
+	return strings.Count(string(input[lext:rext]), "\n") > 1
+}
+
+func (s *statement) String() string {
+	switch s.toks[0].TypeID() {
+	case "package", "import", "start":
+		if !ruleHead(s.toks) {
+			return join(s.toks, false)
+		}
+	}
+	return s.rule()
+}
+
+// ruleHead returns true if toks start with the head of a lex rule. The
+// keywords of the declarations are token IDs in lex rules, e.g.: start in
+// `start : number {number} ;`.
+func ruleHead(toks []*token.Token) bool {
+	return len(toks) > 1 && toks[1].TypeID() == ":"
+}
+
+// rule returns the canonical form of a lex or syntax rule
+func (s *statement) rule() string {
+	lexRule := s.toks[0].TypeID() != "nt"
+	colon := 0
+	for s.toks[colon].TypeID() != ":" {
+		colon++
+	}
+	head := join(s.toks[:colon], lexRule)
+	alts := alternates(s.toks[colon+1 : len(s.toks)-1])
+
+	if !newline(s.toks[0], s.toks[len(s.toks)-1]) {
+		strs := make([]string, len(alts))
+		for i, alt := range alts {
+			strs[i] = join(alt, lexRule)
+		}
+		return fmt.Sprintf("%s : %s ;", head, strings.Join(strs, " | "))
+	}
+
+	// Align the names of the alternates
+	syms, names := make([]string, len(alts)), make([]string, len(alts))
+	width := 0
+	for i, alt := range alts {
+		j := len(alt)
+		if !lexRule && j > 1 && alt[j-2].TypeID() == "#" {
+			j -= 2
+			names[i] = join(alt[j:], false)
+		}
+		p := &printer{lexRule: lexRule}
+		p.alternate(alt[:j], 2*len(indent))
+		syms[i] = p.String()
+		if n := lastLineLen(syms[i]); names[i] != "" && n > width {
+			width = n
+		}
+	}
+	w := new(strings.Builder)
+	w.WriteString(head)
+	for i := range alts {
+		sep := "|"
+		if i == 0 {
+			sep = ":"
+		}
+		fmt.Fprintf(w, "\n%s%s   %s", indent, sep, syms[i])
+		if names[i] != "" {
+			fmt.Fprintf(w, "%s %s", strings.Repeat(" ", width-lastLineLen(syms[i])), names[i])
+		}
+	}
+	fmt.Fprintf(w, "\n%s;", indent)
+	return w.String()
+}
+
+// alternates splits toks at the "|" tokens that are not in brackets
+func alternates(toks []*token.Token) (alts [][]*token.Token) {
+	depth, lext := 0, 0
+	for i, t := range toks {
+		switch t.TypeID() {
+		case "(", "[", "{", "'[", "<":
+			depth++
+		case ")", "]", "}", "]'", ">":
+			depth--
+		case "|":
+			if depth == 0 {
+				alts = append(alts, toks[lext:i])
+				lext = i + 1
+			}
+		}
+	}
+	return append(alts, toks[lext:])
+}
+
+/*
+printer writes the alternates of a rule that spans several lines. A bracketed
+group that spans several lines is written with its alternates on separate
+lines, e.g.:
+
+	'\''
+	(   not "'"
+	|   '\\' any "\\'nrt"
+	)
+	'\''
+
+Every other line break between the tokens of an alternate is kept, and the
+next line is indented.
+*/
+type printer struct {
+	strings.Builder
+	lexRule bool
+}
+
+// alternate writes the tokens of an alternate that starts at column col
+func (p *printer) alternate(toks []*token.Token, col int) {
+	prevGroup := false
+	for i := 0; i < len(toks); {
+		n := 1
+		switch toks[i].TypeID() {
+		case "(", "[", "{", "<":
+			n = closing(toks[i:]) + 1
+		}
+		group := n > 1 && newline(toks[i], toks[i+n-1])
+		if i > 0 {
+			switch {
+			case group || prevGroup:
+				p.newline(col)
+			case newline(toks[i-1], toks[i]):
+				p.newline(col + len(indent))
+			case space(toks[i-1], toks[i], p.lexRule):
+				p.WriteString(" ")
+			}
+		}
+		if group {
+			p.group(toks[i:i+n], col)
+		} else {
+			p.WriteString(join(toks[i:i+n], p.lexRule))
+		}
+		prevGroup = group
+		i += n
+	}
+}
+
+// group writes the bracketed group toks, which starts at column col
+func (p *printer) group(toks []*token.Token, col int) {
+	for i, alt := range alternates(toks[1 : len(toks)-1]) {
+		if i == 0 {
+			fmt.Fprintf(p, "%-4s", toks[0].LiteralString())
+		} else {
+			p.newline(col)
+			p.WriteString("|   ")
+		}
+		p.alternate(alt, col+len(indent))
+	}
+	p.newline(col)
+	p.WriteString(toks[len(toks)-1].LiteralString())
+}
+
+func (p *printer) newline(col int) {
+	p.WriteString("\n")
+	p.WriteString(strings.Repeat(" ", col))
+}
+
+// closing returns the index of the bracket that closes the bracket toks[0]
+func closing(toks []*token.Token) int {
+	depth := 0
+	for i, t := range toks {
+		switch t.TypeID() {
+		case "(", "[", "{", "'[", "<":
+			depth++
+		case ")", "]", "}", "]'", ">":
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return len(toks) - 1
+}
+
+// newline returns true if there is a line break between t1 and t2
+func newline(t1, t2 *token.Token) bool {
+	return strings.ContainsRune(string(t1.GetInput()[t1.Rext():t2.Lext()]), '\n')
+}
+
+func lastLineLen(s string) int {
+	return len([]rune(s[strings.LastIndex(s, "\n")+1:]))
+}
+
+/*
+join returns the literals of toks separated by single spaces, except:
+
+  - there is no space before ",", "<", ">" and a label colon or after "<",
+    "#" and a label colon;
+  - the tokens of a lex rule are only separated by a space if they are
+    separated by white space in the input.
+*/
+func join(toks []*token.Token, lexRule bool) string {
+	w := new(strings.Builder)
+	for i, t := range toks {
+		if i > 0 && space(toks[i-1], t, lexRule) {
+			w.WriteString(" ")
+		}
+		w.WriteString(t.LiteralString())
+	}
+	return w.String()
+}
+
+func space(t1, t2 *token.Token, lexRule bool) bool {
+	if lexRule {
+		return t1.Rext() < t2.Lext()
+	}
+	switch t1.TypeID() {
+	case "<", "#", ":":
+		return false
+	}
+	switch t2.TypeID() {
+	case ",", "<", ">", ":":
+		return false
+	}
+	return true
+}
@@ -0,0 +1,94 @@
+package format
+
+import (
+	"testing"
+)
+
+const src = "# Expressions\n" +
+	"Prose is `not` reformatted:  A|B\n" +
+	"```\n" +
+	"package   \"expr\"\n" +
+	"start Expr,Term ;\n" +
+	"\n" +
+	"\n" +
+	"Expr :   lhs:Term op : \"+\" rhs:Expr  #Binary\n" +
+	"  | Term #Single ;\n" +
+	"Term : id|List<id ,\",\"> ;\n" +
+	"List<X,Sep> : X | X Sep List<X, Sep> ;\n" +
+	"```\n" +
+	"More prose.\n" +
+	"```\n" +
+	"id : letter  {letter|number} ;\n" +
+	"set : '[ '0'-'9' \\p{L}]' ;\n" +
+	"char\n" +
+	"  : '\\'' ( not \"'\"\n" +
+	"  | '\\\\' any \"nrt\" ) '\\'' ;\n" +
+	"```\n"
+
+const exp = "# Expressions\n" +
+	"Prose is `not` reformatted:  A|B\n" +
+	"```\n" +
+	"package \"expr\"\n" +
+	"start Expr, Term ;\n" +
+	"\n" +
+	"Expr\n" +
+	"    :   lhs:Term op:\"+\" rhs:Expr #Binary\n" +
+	"    |   Term                     #Single\n" +
+	"    ;\n" +
+	"Term : id | List<id, \",\"> ;\n" +
+	"List<X, Sep> : X | X Sep List<X, Sep> ;\n" +
+	"```\n" +
+	"More prose.\n" +
+	"```\n" +
+	"id : letter {letter|number} ;\n" +
+	"set : '[ '0'-'9' \\p{L}]' ;\n" +
+	"char\n" +
+	"    :   '\\''\n" +
+	"        (   not \"'\"\n" +
+	"        |   '\\\\' any \"nrt\"\n" +
+	"        )\n" +
+	"        '\\''\n" +
+	"    ;\n" +
+	"```\n"
+
+func TestSource(t *testing.T) {
+	res, err := Source("test.md", []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res) != exp {
+		t.Fatalf("expected:\n%s\ngot:\n%s", exp, res)
+	}
+	res, err = Source("test.md", res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res) != exp {
+		t.Fatalf("not idempotent:\n%s", res)
+	}
+}
+
+func TestSourceText(t *testing.T) {
+	res, err := Source("test.bnf", []byte("\n\nA :  bb  ;\n\n\nbb : 'b' 'b' ;  \n\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := "A : bb ;\n\nbb : 'b' 'b' ;\n"; string(res) != exp {
+		t.Fatalf("expected:\n%q\ngot:\n%q", exp, res)
+	}
+
+	if _, err := Source("test.bnf", []byte("A : bb")); err == nil {
+		t.Fatal("expected a parse error")
+	}
+}
+
+// The keywords of the start and import declarations may be token IDs
+func TestSourceContextKeywords(t *testing.T) {
+	res, err := Source("test.bnf", []byte("start S ;\nS :  prefix  start ;\nprefix : letter {letter} ;\nstart : number {number} ;\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := "start S ;\nS : prefix start ;\nprefix : letter {letter} ;\nstart : number {number} ;\n"; string(res) != exp {
+		t.Fatalf("expected:\n%q\ngot:\n%q", exp, res)
+	}
+}
@@ -15,6 +15,8 @@
 package frstflw
 
 import (
+	"math"
+
 	"github.com/goccmack/gogll/v3/ast"
 	"github.com/goccmack/goutil/stringset"
 	"github.com/goccmack/goutil/stringslice"
@@ -22,6 +24,10 @@ import (
 
 const Empty = "ϵ"
 
+// NoDerivation is the MinDepth of a nonterminal that derives no string of
+// terminals
+const NoDerivation = math.MaxInt32
+
 type FF struct {
 	// Key=symbol, Value is first set of symbol
 	firstSets map[string]*stringset.StringSet
@@ -29,6 +35,9 @@ type FF struct {
 	// Key=NonTerminal, Value is follow set of NonTerminal
 	followSets map[string]*stringset.StringSet
 
+	// Key=symbol, Value is the height of the shortest derivation tree of symbol
+	minDepths map[string]int
+
 	g *ast.GoGLL
 }
 
@@ -38,6 +47,7 @@ func New(g *ast.GoGLL) *FF {
 	}
 	ff.genFirstSets()
 	ff.genFollow()
+	ff.genMinDepths()
 	return ff
 }
 
@@ -76,6 +86,30 @@ func (ff *FF) Follow(nt string) *stringset.StringSet {
 	}
 }
 
+/*
+MinDepth returns the height of the shortest derivation tree of symbol s.
+The height of a terminal is 0 and of an empty alternate 1. MinDepth returns
+NoDerivation if s derives no string of terminals.
+*/
+func (ff *FF) MinDepth(s string) int {
+	if d, exist := ff.minDepths[s]; exist {
+		return d
+	}
+	return 0
+}
+
+// MinDepthOfString returns the height of the shortest derivation forest of
+// str, which is the maximum of the MinDepth of the symbols of str.
+func (ff *FF) MinDepthOfString(str []string) int {
+	depth := 0
+	for _, s := range str {
+		if d := ff.MinDepth(s); d > depth {
+			depth = d
+		}
+	}
+	return depth
+}
+
 /*
 Dragon book FIRST set algorithm used
 */
@@ -180,11 +214,33 @@ func (ff *FF) genFollowOf(nt string) *stringset.StringSet {
 
 func (ff *FF) initFollowSets() {
 	ff.followSets = make(map[string]*stringset.StringSet)
+	starts := stringset.New(ff.g.StartSymbols()...)
 	for _, nt := range ff.g.NonTerminals.Elements() {
-		if nt == ff.g.StartSymbol() {
+		if starts.Contain(nt) {
 			ff.followSets[nt] = stringset.New("$")
 		} else {
 			ff.followSets[nt] = stringset.New()
 		}
 	}
 }
+
+// genMinDepths computes the MinDepth of every nonterminal by fixed point
+// iteration
+func (ff *FF) genMinDepths() {
+	ff.minDepths = make(map[string]int)
+	for _, nt := range ff.g.NonTerminals.Elements() {
+		ff.minDepths[nt] = NoDerivation
+	}
+	for again := true; again; {
+		again = false
+		for _, r := range ff.g.SyntaxRules {
+			for _, a := range r.Alternates {
+				d := ff.MinDepthOfString(a.GetSymbols())
+				if d != NoDerivation && d+1 < ff.minDepths[r.Head.ID()] {
+					ff.minDepths[r.Head.ID()] = d + 1
+					again = true
+				}
+			}
+		}
+	}
+}
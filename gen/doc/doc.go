@@ -0,0 +1,295 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+/*
+Package doc generates the documentation of a grammar: an HTML page with a
+railroad diagram of every syntax and lex rule, and the diagrams as SVG files.
+
+The prose of a markdown grammar is rendered between the rules of its code
+blocks. The nonterminals and token IDs in the diagrams link to their rules,
+every syntax rule has a table of its FIRST and FOLLOW sets, and every rule
+lists the syntax rules that use it.
+*/
+package doc
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/frstflw"
+)
+
+// rule is a syntax or lex rule of the grammar
+type rule struct {
+	id     string
+	pos    *ast.Position
+	syntax *ast.SyntaxRule
+	lex    *ast.LexRule
+}
+
+// section is the prose of a markdown grammar followed by the rules declared
+// in the code block after it
+type section struct {
+	prose string
+	rules []*rule
+}
+
+type page struct {
+	g  *ast.GoGLL
+	ff *frstflw.FF
+	// usedBy contains the nonterminals of the syntax rules using a symbol
+	usedBy map[string][]string
+	w      *bytes.Buffer
+}
+
+/*
+WriteHTML writes the documentation of grammar g to w as an HTML page. file is
+the grammar file from which g was built. The prose of a markdown grammar is
+read from file. The rules declared in imported files follow the rules of file.
+*/
+func WriteHTML(w io.Writer, file string, g *ast.GoGLL, ff *frstflw.FF) error {
+	src, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	p := &page{g: g, ff: ff, usedBy: usedBy(g), w: new(bytes.Buffer)}
+	sections, imported := split(file, string(src), rules(g))
+
+	title := html.EscapeString("package " + g.Package.GetString())
+	fmt.Fprintf(p.w, htmlHeader, title, svgStyle)
+	if !strings.HasSuffix(file, ".md") {
+		fmt.Fprintf(p.w, "<h1>%s</h1>\n", title)
+	}
+	for _, s := range sections {
+		p.w.WriteString(markdown(s.prose, p.link))
+		for _, r := range s.rules {
+			p.rule(r)
+		}
+	}
+	for _, f := range imported {
+		fmt.Fprintf(p.w, "<h2>Imported from %s</h2>\n", html.EscapeString(f.prose))
+		for _, r := range f.rules {
+			p.rule(r)
+		}
+	}
+	p.w.WriteString("</body>\n</html>\n")
+	_, err = w.Write(p.w.Bytes())
+	return err
+}
+
+/*
+WriteSVG writes the railroad diagram of every rule of g to <dir>/<ID>.svg,
+where ID is the nonterminal or token ID of the rule. The symbols in a diagram
+link to the files of their rules.
+*/
+func WriteSVG(dir string, g *ast.GoGLL) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	link := func(symbol string) string {
+		return symbol + ".svg"
+	}
+	for _, r := range rules(g) {
+		img := svg(r.diagram(g), link, true)
+		if err := ioutil.WriteFile(filepath.Join(dir, r.id+".svg"), []byte(img), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rules returns the syntax and lex rules of g
+func rules(g *ast.GoGLL) []*rule {
+	var rs []*rule
+	for _, r := range g.SyntaxRules {
+		rs = append(rs, &rule{id: r.ID(), pos: r.Pos, syntax: r})
+	}
+	for _, r := range g.LexRules {
+		rs = append(rs, &rule{id: r.ID(), pos: r.Pos, lex: r})
+	}
+	return rs
+}
+
+func (r *rule) diagram(g *ast.GoGLL) diagram {
+	if r.syntax != nil {
+		return syntaxDiagram(r.syntax, g)
+	}
+	return lexDiagram(r.lex)
+}
+
+/*
+split returns the sections of src, the text of grammar file, and the rules
+of the imported files grouped by file. The prose of an imported section is its
+file name. The code blocks of a markdown file are recognised like the gogll
+lexer does. A plain grammar file has one section without prose.
+*/
+func split(file, src string, rules []*rule) (sections, imported []*section) {
+	// blocks contains the first and last line of every code block
+	var blocks [][2]int
+	if strings.HasSuffix(file, ".md") {
+		prose, text := new(strings.Builder), true
+		line, start := 1, 0
+		for i := 0; i < len(src); i++ {
+			if strings.HasPrefix(src[i:], "```") {
+				if text {
+					sections = append(sections, &section{prose: prose.String()})
+					prose.Reset()
+					start = line
+				} else {
+					blocks = append(blocks, [2]int{start, line})
+				}
+				text = !text
+				i += 2
+				continue
+			}
+			if src[i] == '\n' {
+				line++
+			}
+			if text {
+				prose.WriteByte(src[i])
+			}
+		}
+		if !text {
+			blocks = append(blocks, [2]int{start, line})
+		}
+		sections = append(sections, &section{prose: prose.String()})
+	} else {
+		sections = []*section{{}}
+		blocks = [][2]int{{1, strings.Count(src, "\n") + 1}}
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].pos.File != rules[j].pos.File {
+			return rules[i].pos.File < rules[j].pos.File
+		}
+		return rules[i].pos.Line < rules[j].pos.Line
+	})
+	files := map[string]*section{}
+	for _, r := range rules {
+		if r.pos.File != file {
+			if files[r.pos.File] == nil {
+				files[r.pos.File] = &section{prose: r.pos.File}
+				imported = append(imported, files[r.pos.File])
+			}
+			files[r.pos.File].rules = append(files[r.pos.File].rules, r)
+			continue
+		}
+		for i, b := range blocks {
+			if r.pos.Line >= b[0] && r.pos.Line <= b[1] {
+				sections[i].rules = append(sections[i].rules, r)
+				break
+			}
+		}
+	}
+	return
+}
+
+// usedBy returns the nonterminals of the syntax rules of g using every symbol
+func usedBy(g *ast.GoGLL) map[string][]string {
+	used := map[string][]string{}
+	for _, r := range g.SyntaxRules {
+		seen := map[string]bool{}
+		for _, a := range r.Alternates {
+			for _, sym := range a.Symbols {
+				if !seen[sym.ID()] {
+					seen[sym.ID()] = true
+					used[sym.ID()] = append(used[sym.ID()], r.ID())
+				}
+			}
+		}
+	}
+	for _, nts := range used {
+		sort.Strings(nts)
+	}
+	return used
+}
+
+// link returns the URL of the rule of symbol, or "" if symbol has no rule
+func (p *page) link(symbol string) string {
+	if p.g.GetSyntaxRule(symbol) != nil || p.g.GetLexRule(symbol) != nil {
+		return "#" + symbol
+	}
+	return ""
+}
+
+// rule writes the diagram of r and its tables
+func (p *page) rule(r *rule) {
+	fmt.Fprintf(p.w, "<div class=\"rule\" id=\"%s\">\n", html.EscapeString(r.id))
+	fmt.Fprintf(p.w, "<p class=\"head\"><a href=\"#%s\">%s</a>", html.EscapeString(r.id), html.EscapeString(r.id))
+	if r.lex != nil && r.lex.Suppress {
+		p.w.WriteString(` <span class="note">suppressed</span>`)
+	}
+	fmt.Fprintf(p.w, " <span class=\"pos\">%s:%d</span></p>\n", html.EscapeString(r.pos.File), r.pos.Line)
+	p.w.WriteString(svg(r.diagram(p.g), p.link, false))
+	if r.syntax != nil {
+		p.w.WriteString("<table class=\"ff\">\n")
+		fmt.Fprintf(p.w, "<tr><th>FIRST</th><td>%s</td></tr>\n", p.symbols(p.ff.FirstOfSymbol(r.id).ElementsSorted()))
+		fmt.Fprintf(p.w, "<tr><th>FOLLOW</th><td>%s</td></tr>\n", p.symbols(p.ff.Follow(r.id).ElementsSorted()))
+		p.w.WriteString("</table>\n")
+	}
+	if nts := p.usedBy[r.id]; len(nts) > 0 {
+		fmt.Fprintf(p.w, "<p class=\"refs\">Used by: %s</p>\n", p.symbols(nts))
+	}
+	p.w.WriteString("</div>\n")
+}
+
+// symbols returns the HTML of a list of symbols. The string literals are
+// quoted and the symbols with rules are linked.
+func (p *page) symbols(symbols []string) string {
+	w := new(strings.Builder)
+	for i, sym := range symbols {
+		if i > 0 {
+			w.WriteString(" ")
+		}
+		if href := p.link(sym); href != "" {
+			fmt.Fprintf(w, `<a href="%s"><code>%s</code></a>`, html.EscapeString(href), html.EscapeString(sym))
+			continue
+		}
+		if _, ok := p.g.StringLiterals[sym]; ok {
+			sym = strconv.Quote(sym)
+		}
+		fmt.Fprintf(w, "<code>%s</code>", html.EscapeString(sym))
+	}
+	return w.String()
+}
+
+const htmlHeader = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>%s</title>
+<style>
+body { font-family: sans-serif; max-width: 60em; margin: 0 auto; padding: 1em; }
+code { font-size: 110%%; }
+div.rule { margin: 1.5em 0; }
+div.rule:target { background: #ffe; }
+p.head { margin-bottom: 0.2em; }
+p.head a { font-family: monospace; font-size: 120%%; font-weight: bold; color: inherit; text-decoration: none; }
+span.pos, span.note, p.refs { color: #666; font-size: 90%%; }
+table.ff { border-collapse: collapse; }
+table.ff th, table.ff td { padding: 2px 12px; text-align: left; border: 1px solid #ccc; }
+svg.railroad { display: block; max-width: 100%%; height: auto; }
+%s</style>
+</head>
+<body>
+`
@@ -0,0 +1,172 @@
+package doc
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/frstflw"
+	"github.com/goccmack/gogll/v3/internal/loader"
+)
+
+const grammar = "# Expressions\n" +
+	"```\n" +
+	"package \"expr\"\n" +
+	"```\n" +
+	"An `Expr` is a list of *terms*:\n" +
+	"```\n" +
+	"Expr : Term | Term \"-\" Expr ;\n" +
+	"Term : id | \"(\" Expr \")\" ;\n" +
+	"```\n" +
+	"## Tokens\n" +
+	"* identifiers\n" +
+	"* comments\n" +
+	"```\n" +
+	"id : letter {letter | '_'} ;\n" +
+	"!comment : '/' '/' {not \"\\n\"} ;\n" +
+	"```\n"
+
+func build(t *testing.T, file string) (*ast.GoGLL, *frstflw.FF) {
+	gr, errs := loader.File(file)
+	if errs != nil {
+		t.Fatal(errs[0])
+	}
+	return gr.AST, gr.FF
+}
+
+func TestWriteHTML(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "expr.md")
+	if err := ioutil.WriteFile(file, []byte(grammar), 0644); err != nil {
+		t.Fatal(err)
+	}
+	g, ff := build(t, file)
+	w := new(bytes.Buffer)
+	if err := WriteHTML(w, file, g, ff); err != nil {
+		t.Fatal(err)
+	}
+	page := w.String()
+
+	// The prose and the rules are in the order of the grammar
+	order := []string{
+		"<h1>Expressions</h1>",
+		`<p>An <a href="#Expr"><code>Expr</code></a> is a list of <em>terms</em>:</p>`,
+		`<div class="rule" id="Expr">`,
+		`<div class="rule" id="Term">`,
+		"<h2>Tokens</h2>",
+		"<ul>\n<li>identifiers</li>\n<li>comments</li>\n</ul>",
+		`<div class="rule" id="id">`,
+		`<div class="rule" id="comment">`,
+	}
+	i := 0
+	for _, s := range order {
+		j := strings.Index(page[i:], s)
+		if j < 0 {
+			t.Fatalf("missing or out of order: %s\n%s", s, page)
+		}
+		i += j + len(s)
+	}
+	for _, s := range []string{
+		`<a href="#Term"><rect class="nt"`,
+		`<a href="#id"><rect class="tok"`,
+		`">&#34;-&#34;</text>`,
+		`<tr><th>FIRST</th><td><code>&#34;(&#34;</code> <a href="#id"><code>id</code></a></td></tr>`,
+		`<tr><th>FOLLOW</th><td><code>$</code> <code>&#34;)&#34;</code> <code>&#34;-&#34;</code></td></tr>`,
+		`<p class="refs">Used by: <a href="#Expr"><code>Expr</code></a> <a href="#Term"><code>Term</code></a></p>`,
+		`<span class="note">suppressed</span>`,
+	} {
+		if !strings.Contains(page, s) {
+			t.Errorf("missing: %s", s)
+		}
+	}
+	if t.Failed() {
+		t.Log(page)
+	}
+}
+
+func TestWriteSVG(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "expr.md")
+	if err := ioutil.WriteFile(file, []byte(grammar), 0644); err != nil {
+		t.Fatal(err)
+	}
+	g, _ := build(t, file)
+	svgDir := filepath.Join(dir, "svg")
+	if err := WriteSVG(svgDir, g); err != nil {
+		t.Fatal(err)
+	}
+	buf, err := ioutil.ReadFile(filepath.Join(svgDir, "Term.svg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img := string(buf)
+	if !strings.HasPrefix(img, `<svg xmlns="http://www.w3.org/2000/svg"`) ||
+		!strings.Contains(img, `<a href="Expr.svg">`) {
+		t.Fatalf("invalid SVG:\n%s", img)
+	}
+	if _, err := ioutil.ReadFile(filepath.Join(svgDir, "comment.svg")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+var viewBox = regexp.MustCompile(`viewBox="0 0 (\d+) (\d+)"`)
+
+func TestDiagramSize(t *testing.T) {
+	// '(' {'a' | 'b'} ')' with a choice of two boxes below a skip
+	d := sequence{
+		&box{text: "'('", class: classLiteral},
+		choice{skip{}, &loop{choice{
+			&box{text: "'a'", class: classLiteral},
+			&box{text: "'b'", class: classLiteral},
+		}}},
+		&box{text: "')'", class: classLiteral},
+	}
+	// box: 3*8+2*10 = 44, choice of boxes: 44+40 = 84, loop: 84+20 = 104,
+	// choice with skip: 104+40 = 144
+	if w := d.width(); w != 44+hgap+144+hgap+44 {
+		t.Fatalf("width %d", w)
+	}
+	// The loop is 2*radius below the skip. The second box is 11+vgap+11
+	// below the first, and the line back is vgap below the second box.
+	if d.up() != 11 || d.down() != 20+30+11+vgap {
+		t.Fatalf("up %d down %d", d.up(), d.down())
+	}
+	img := svg(d, func(string) string { return "" }, false)
+	if m := viewBox.FindStringSubmatch(img); m == nil || m[1] != "312" || m[2] != "100" {
+		t.Fatalf("viewBox %v", m)
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	link := func(symbol string) string {
+		if symbol == "Expr" {
+			return "#Expr"
+		}
+		return ""
+	}
+	src := "Text with **bold**, _em_, snake_case and [a link](x.html).\n" +
+		"Inline `Expr` and `x < y`.\n" +
+		"\n" +
+		"1. one\n" +
+		"2. two\n" +
+		"   continued\n" +
+		"\n" +
+		"> quote\n" +
+		"\n" +
+		"    code <x>\n" +
+		"\n" +
+		"---\n"
+	exp := "<p>Text with <strong>bold</strong>, <em>em</em>, snake_case and <a href=\"x.html\">a link</a>.\n" +
+		"Inline <a href=\"#Expr\"><code>Expr</code></a> and <code>x &lt; y</code>.</p>\n" +
+		"<ol>\n<li>one</li>\n<li>two\ncontinued</li>\n</ol>\n" +
+		"<blockquote><p>quote</p></blockquote>\n" +
+		"<pre><code>code &lt;x&gt;\n</code></pre>\n" +
+		"<hr>\n"
+	if got := markdown(src, link); got != exp {
+		t.Fatalf("expected:\n%s\ngot:\n%s", exp, got)
+	}
+}
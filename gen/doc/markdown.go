@@ -0,0 +1,257 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package doc
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	headingPattern     = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	listItemPattern    = regexp.MustCompile(`^\s{0,3}([*+-]|\d+[.)])\s+(.*)$`)
+	blockQuotePattern  = regexp.MustCompile(`^\s{0,3}>\s?(.*)$`)
+	rulePattern        = regexp.MustCompile(`^\s{0,3}([-*_])(\s*[-*_]){2,}\s*$`)
+	codeIndentPattern  = regexp.MustCompile(`^(    |\t)`)
+	orderedItemPattern = regexp.MustCompile(`^\d`)
+)
+
+/*
+markdown returns the HTML of the markdown text. It supports the markdown used
+in the prose of grammar files: headings, paragraphs, lists, block quotes,
+indented code, horizontal rules, inline HTML, and inline code, emphasis and
+links. link returns the URL of the rule of a symbol, or "" if there is no rule.
+Inline code that is a symbol links to its rule.
+*/
+func markdown(text string, link func(symbol string) string) string {
+	md := &mdWriter{w: new(bytes.Buffer), link: link}
+	for _, line := range strings.Split(text, "\n") {
+		md.line(line)
+	}
+	md.closeBlock()
+	return md.w.String()
+}
+
+type mdWriter struct {
+	w    *bytes.Buffer
+	link func(symbol string) string
+	// block is the open block: "p", "ul", "ol", "blockquote", "pre" or ""
+	block string
+	// lines are the lines of the open paragraph, list item, block quote or
+	// code block
+	lines []string
+}
+
+func (md *mdWriter) line(line string) {
+	if md.block == "pre" && (codeIndentPattern.MatchString(line) || strings.TrimSpace(line) == "") {
+		md.lines = append(md.lines, codeIndentPattern.ReplaceAllString(line, ""))
+		return
+	}
+	if strings.TrimSpace(line) == "" {
+		md.closeBlock()
+		return
+	}
+	if md.block == "" && codeIndentPattern.MatchString(line) {
+		md.block = "pre"
+		md.lines = []string{codeIndentPattern.ReplaceAllString(line, "")}
+		return
+	}
+	if m := headingPattern.FindStringSubmatch(line); m != nil {
+		md.closeBlock()
+		fmt.Fprintf(md.w, "<h%d>%s</h%d>\n", len(m[1]), md.inline(m[2]), len(m[1]))
+		return
+	}
+	if rulePattern.MatchString(line) {
+		md.closeBlock()
+		md.w.WriteString("<hr>\n")
+		return
+	}
+	if m := listItemPattern.FindStringSubmatch(line); m != nil {
+		list := "ul"
+		if orderedItemPattern.MatchString(m[1]) {
+			list = "ol"
+		}
+		if md.block != list {
+			md.closeBlock()
+			fmt.Fprintf(md.w, "<%s>\n", list)
+			md.block = list
+		} else {
+			md.closeItem()
+		}
+		md.lines = []string{m[2]}
+		return
+	}
+	if m := blockQuotePattern.FindStringSubmatch(line); m != nil {
+		if md.block != "blockquote" {
+			md.closeBlock()
+			md.block = "blockquote"
+		}
+		md.lines = append(md.lines, m[1])
+		return
+	}
+	if md.block == "" && strings.HasPrefix(line, "<") {
+		md.w.WriteString(line + "\n")
+		return
+	}
+	if md.block == "" || md.block == "pre" {
+		md.closeBlock()
+		md.block = "p"
+	}
+	md.lines = append(md.lines, strings.TrimSpace(line))
+}
+
+// closeItem writes the open list item
+func (md *mdWriter) closeItem() {
+	if len(md.lines) > 0 {
+		fmt.Fprintf(md.w, "<li>%s</li>\n", md.inline(strings.Join(md.lines, "\n")))
+	}
+	md.lines = nil
+}
+
+// closeBlock writes the open block
+func (md *mdWriter) closeBlock() {
+	switch md.block {
+	case "p":
+		fmt.Fprintf(md.w, "<p>%s</p>\n", md.inline(strings.Join(md.lines, "\n")))
+	case "ul", "ol":
+		md.closeItem()
+		fmt.Fprintf(md.w, "</%s>\n", md.block)
+	case "blockquote":
+		fmt.Fprintf(md.w, "<blockquote><p>%s</p></blockquote>\n", md.inline(strings.Join(md.lines, "\n")))
+	case "pre":
+		for len(md.lines) > 0 && strings.TrimSpace(md.lines[len(md.lines)-1]) == "" {
+			md.lines = md.lines[:len(md.lines)-1]
+		}
+		fmt.Fprintf(md.w, "<pre><code>%s\n</code></pre>\n", html.EscapeString(strings.Join(md.lines, "\n")))
+	}
+	md.block, md.lines = "", nil
+}
+
+// inline returns the HTML of the inline markdown in s
+func (md *mdWriter) inline(s string) string {
+	w := new(strings.Builder)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && strings.IndexByte("\\`*_[]()#+-.!<>", s[i+1]) >= 0:
+			w.WriteString(html.EscapeString(s[i+1 : i+2]))
+			i++
+			continue
+		case c == '`':
+			n := 1
+			for i+n < len(s) && s[i+n] == '`' {
+				n++
+			}
+			delim := s[i : i+n]
+			if j := strings.Index(s[i+n:], delim); j >= 0 {
+				code := strings.TrimSpace(s[i+n : i+n+j])
+				if href := md.link(code); href != "" {
+					fmt.Fprintf(w, `<a href="%s"><code>%s</code></a>`, html.EscapeString(href), html.EscapeString(code))
+				} else {
+					fmt.Fprintf(w, "<code>%s</code>", html.EscapeString(code))
+				}
+				i += n + j + n - 1
+				continue
+			}
+			w.WriteString(delim)
+			i += n - 1
+			continue
+		case c == '*' || c == '_':
+			strong := i+1 < len(s) && s[i+1] == c
+			delim := s[i : i+1]
+			if strong {
+				delim = s[i : i+2]
+			}
+			if j := closingEmphasis(s, i, delim); j >= 0 {
+				tag := "em"
+				if strong {
+					tag = "strong"
+				}
+				fmt.Fprintf(w, "<%s>%s</%s>", tag, md.inline(s[i+len(delim):j]), tag)
+				i = j + len(delim) - 1
+				continue
+			}
+			w.WriteString(delim)
+			i += len(delim) - 1
+			continue
+		case c == '[':
+			if text, url, n := link(s[i:]); n > 0 {
+				fmt.Fprintf(w, `<a href="%s">%s</a>`, html.EscapeString(url), md.inline(text))
+				i += n - 1
+				continue
+			}
+		case c == '<':
+			if j := strings.IndexByte(s[i:], '>'); j > 0 && isTag(s[i:i+j+1]) {
+				w.WriteString(s[i : i+j+1])
+				i += j
+				continue
+			}
+		}
+		w.WriteString(html.EscapeString(s[i : i+1]))
+	}
+	return w.String()
+}
+
+// closingEmphasis returns the index of the delimiter closing the emphasis
+// opened by delim at i in s, or -1. Underscores inside words do not open
+// emphasis.
+func closingEmphasis(s string, i int, delim string) int {
+	if delim[0] == '_' && i > 0 && isWordChar(s[i-1]) {
+		return -1
+	}
+	start := i + len(delim)
+	if start >= len(s) || s[start] == ' ' {
+		return -1
+	}
+	for j := start + 1; j <= len(s)-len(delim); j++ {
+		if s[j:j+len(delim)] != delim || s[j-1] == ' ' {
+			continue
+		}
+		end := j + len(delim)
+		if delim[0] == '_' && end < len(s) && isWordChar(s[end]) {
+			continue
+		}
+		if len(delim) == 1 && end < len(s) && s[end] == delim[0] {
+			continue
+		}
+		return j
+	}
+	return -1
+}
+
+var linkPattern = regexp.MustCompile(`^\[([^\]]*)\]\(([^)\s]*)(?:\s+"[^"]*")?\)`)
+
+// link returns the text and URL of the link at the start of s and its
+// length, or n == 0 if s does not start with a link
+func link(s string) (text, url string, n int) {
+	m := linkPattern.FindStringSubmatch(s)
+	if m == nil {
+		return "", "", 0
+	}
+	return m[1], m[2], len(m[0])
+}
+
+var tagPattern = regexp.MustCompile(`^</?[a-zA-Z][a-zA-Z0-9]*(\s[^<>]*)?/?>$`)
+
+func isTag(s string) bool {
+	return tagPattern.MatchString(s)
+}
+
+func isWordChar(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
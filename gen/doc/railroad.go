@@ -0,0 +1,425 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package doc
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/goccmack/gogll/v3/ast"
+)
+
+// Dimensions of the railroad diagrams in pixels
+const (
+	charWidth  = 8
+	boxHeight  = 22
+	boxPadding = 10
+	radius     = 10
+	hgap       = 10
+	vgap       = 8
+	margin     = 10
+	endWidth   = 20
+)
+
+// Classes of the boxes of a diagram
+const (
+	classNT      = "nt"
+	classToken   = "tok"
+	classLiteral = "lit"
+	classSet     = "set"
+)
+
+/*
+diagram is a railroad diagram. A diagram is drawn from left to right along a
+horizontal line, which connects it to the diagrams before and after it. up
+and down are the heights of the diagram above and below its line.
+*/
+type diagram interface {
+	width() int
+	up() int
+	down() int
+	// draw draws the diagram with its line starting at x, y
+	draw(w *svgWriter, x, y int)
+}
+
+// box is a symbol. Its text links to the rule of symbol if symbol is not
+// empty.
+type box struct {
+	text, class, symbol string
+}
+
+// sequence is a sequence of diagrams
+type sequence []diagram
+
+// choice is a choice between diagrams. The first diagram is on the line of
+// the choice and the others below it.
+type choice []diagram
+
+// loop is a diagram that is repeated one or more times
+type loop struct {
+	d diagram
+}
+
+// skip is the empty diagram
+type skip struct{}
+
+func (b *box) width() int {
+	return utf8.RuneCountInString(b.text)*charWidth + 2*boxPadding
+}
+
+func (*box) up() int {
+	return boxHeight / 2
+}
+
+func (*box) down() int {
+	return boxHeight / 2
+}
+
+func (b *box) draw(w *svgWriter, x, y int) {
+	href := ""
+	if b.symbol != "" {
+		href = w.link(b.symbol)
+	}
+	if href != "" {
+		fmt.Fprintf(w.elems, `<a href="%s">`, html.EscapeString(href))
+	}
+	rx := 0
+	if b.class == classLiteral {
+		rx = boxHeight / 2
+	}
+	fmt.Fprintf(w.elems, `<rect class="%s" x="%d" y="%d" width="%d" height="%d" rx="%d"/>`,
+		b.class, x, y-boxHeight/2, b.width(), boxHeight, rx)
+	fmt.Fprintf(w.elems, `<text x="%d" y="%d">%s</text>`,
+		x+b.width()/2, y+4, html.EscapeString(b.text))
+	if href != "" {
+		w.elems.WriteString("</a>")
+	}
+	w.elems.WriteString("\n")
+}
+
+func (s sequence) width() int {
+	wd := 0
+	for i, d := range s {
+		if i > 0 {
+			wd += hgap
+		}
+		wd += d.width()
+	}
+	return wd
+}
+
+func (s sequence) up() int {
+	up := 0
+	for _, d := range s {
+		up = max(up, d.up())
+	}
+	return up
+}
+
+func (s sequence) down() int {
+	down := 0
+	for _, d := range s {
+		down = max(down, d.down())
+	}
+	return down
+}
+
+func (s sequence) draw(w *svgWriter, x, y int) {
+	for i, d := range s {
+		if i > 0 {
+			w.line(x, y, hgap)
+			x += hgap
+		}
+		d.draw(w, x, y)
+		x += d.width()
+	}
+}
+
+// inner returns the width of the widest alternative of c
+func (c choice) inner() int {
+	wd := 0
+	for _, d := range c {
+		wd = max(wd, d.width())
+	}
+	return wd
+}
+
+// offsets returns the offsets of the lines of the alternatives of c from the
+// line of c
+func (c choice) offsets() []int {
+	offs := make([]int, len(c))
+	for i := 1; i < len(c); i++ {
+		offs[i] = offs[i-1] + c[i-1].down() + vgap + c[i].up()
+		if offs[i] < 2*radius {
+			offs[i] = 2 * radius
+		}
+	}
+	return offs
+}
+
+func (c choice) width() int {
+	return c.inner() + 4*radius
+}
+
+func (c choice) up() int {
+	return c[0].up()
+}
+
+func (c choice) down() int {
+	offs := c.offsets()
+	return offs[len(c)-1] + c[len(c)-1].down()
+}
+
+func (c choice) draw(w *svgWriter, x, y int) {
+	inner := c.inner()
+	right := x + 2*radius + inner
+	offs := c.offsets()
+	for i, d := range c {
+		yi := y + offs[i]
+		if i == 0 {
+			w.line(x, y, 2*radius)
+			w.line(right, y, 2*radius)
+		} else {
+			w.path("M%d %d a%d %d 0 0 1 %d %d V%d a%d %d 0 0 0 %d %d",
+				x, y, radius, radius, radius, radius, yi-radius, radius, radius, radius, radius)
+			w.path("M%d %d a%d %d 0 0 0 %d %d V%d a%d %d 0 0 1 %d %d",
+				right, yi, radius, radius, radius, -radius, y+radius, radius, radius, radius, -radius)
+		}
+		d.draw(w, x+2*radius, yi)
+		w.line(x+2*radius+d.width(), yi, inner-d.width())
+	}
+}
+
+// back returns the offset of the line back to the start of l
+func (l *loop) back() int {
+	return max(l.d.down()+vgap, 2*radius)
+}
+
+func (l *loop) width() int {
+	return l.d.width() + 2*radius
+}
+
+func (l *loop) up() int {
+	return l.d.up()
+}
+
+func (l *loop) down() int {
+	return l.back()
+}
+
+func (l *loop) draw(w *svgWriter, x, y int) {
+	right := x + radius + l.d.width()
+	w.line(x, y, radius)
+	l.d.draw(w, x+radius, y)
+	w.line(right, y, radius)
+	w.path("M%d %d a%d %d 0 0 1 %d %d V%d a%d %d 0 0 1 %d %d H%d a%d %d 0 0 1 %d %d V%d a%d %d 0 0 1 %d %d",
+		right, y, radius, radius, radius, radius,
+		y+l.back()-radius, radius, radius, -radius, radius,
+		x+radius, radius, radius, -radius, -radius,
+		y+radius, radius, radius, radius, -radius)
+}
+
+func (skip) width() int { return 0 }
+
+func (skip) up() int { return 0 }
+
+func (skip) down() int { return 0 }
+
+func (skip) draw(*svgWriter, int, int) {}
+
+// oneOf returns the choice between the diagrams in c, or the diagram if
+// there is only one
+func oneOf(c ...diagram) diagram {
+	if len(c) == 1 {
+		return c[0]
+	}
+	return choice(c)
+}
+
+// seq returns the sequence of the diagrams in s, skip if s is empty, or the
+// diagram if there is only one
+func seq(s []diagram) diagram {
+	switch len(s) {
+	case 0:
+		return skip{}
+	case 1:
+		return s[0]
+	}
+	return sequence(s)
+}
+
+/*** Diagrams of the rules ***/
+
+// syntaxDiagram returns the diagram of the alternates of r. The token IDs
+// link to their lex rules if they are declared in g.
+func syntaxDiagram(r *ast.SyntaxRule, g *ast.GoGLL) diagram {
+	var alts []diagram
+	for _, a := range r.Alternates {
+		var s []diagram
+		for _, sym := range a.Symbols {
+			switch sym := sym.(type) {
+			case *ast.NT:
+				s = append(s, &box{sym.ID(), classNT, sym.ID()})
+			case *ast.TokID:
+				b := &box{text: sym.ID(), class: classToken}
+				if g.GetLexRule(sym.ID()) != nil {
+					b.symbol = sym.ID()
+				}
+				s = append(s, b)
+			case *ast.StringLit:
+				s = append(s, &box{text: strconv.Quote(sym.ID()), class: classLiteral})
+			}
+		}
+		alts = append(alts, seq(s))
+	}
+	return oneOf(alts...)
+}
+
+// lexDiagram returns the diagram of the regular expression of r
+func lexDiagram(r *ast.LexRule) diagram {
+	return regExpDiagram(r.RegExp)
+}
+
+// regExpDiagram returns the diagram of re. Consecutive character literals
+// are shown as one string.
+func regExpDiagram(re *ast.RegExp) diagram {
+	var s []diagram
+	var chars []*ast.CharLiteral
+	flush := func() {
+		switch len(chars) {
+		case 0:
+			return
+		case 1:
+			s = append(s, &box{text: chars[0].String(), class: classLiteral})
+		default:
+			str := make([]rune, len(chars))
+			for i, c := range chars {
+				str[i] = c.Char()
+			}
+			s = append(s, &box{text: strconv.Quote(string(str)), class: classLiteral})
+		}
+		chars = nil
+	}
+	for _, sym := range re.Symbols {
+		if c, ok := sym.(*ast.CharLiteral); ok {
+			chars = append(chars, c)
+			continue
+		}
+		flush()
+		s = append(s, lexSymbolDiagram(sym))
+	}
+	flush()
+	return seq(s)
+}
+
+func lexSymbolDiagram(sym ast.LexSymbol) diagram {
+	switch sym := sym.(type) {
+	case *ast.Any:
+		return &box{text: "any character", class: classSet}
+	case *ast.LexBracket:
+		alts := make([]diagram, len(sym.Alternates))
+		for i, re := range sym.Alternates {
+			alts[i] = regExpDiagram(re)
+		}
+		d := oneOf(alts...)
+		switch sym.Type {
+		case ast.LexOptional:
+			return choice{skip{}, d}
+		case ast.LexZeroOrMore:
+			return choice{skip{}, &loop{d}}
+		case ast.LexOneOrMore:
+			return &loop{d}
+		}
+		return d
+	case *ast.CharLiteral:
+		return &box{text: sym.String(), class: classLiteral}
+	}
+	return &box{text: sym.String(), class: classSet}
+}
+
+/*** SVG ***/
+
+// svgWriter writes the elements of a diagram. link returns the URL of the
+// rule of a symbol, or "" if the symbol is not linked.
+type svgWriter struct {
+	paths *bytes.Buffer
+	elems *bytes.Buffer
+	link  func(symbol string) string
+}
+
+func (w *svgWriter) path(format string, args ...interface{}) {
+	fmt.Fprintf(w.paths, `<path d="`+format+`"/>`+"\n", args...)
+}
+
+// line draws a horizontal line of length l from x, y
+func (w *svgWriter) line(x, y, l int) {
+	if l > 0 {
+		w.path("M%d %d h%d", x, y, l)
+	}
+}
+
+// svgStyle is the style of the diagrams
+const svgStyle = `svg.railroad path { stroke: #333; stroke-width: 2; fill: none; }
+svg.railroad rect { stroke: #333; stroke-width: 1.5; }
+svg.railroad rect.nt { fill: #def; }
+svg.railroad rect.tok { fill: #dfd; }
+svg.railroad rect.lit { fill: #ffd; }
+svg.railroad rect.set { fill: #eee; }
+svg.railroad text { font: 13px monospace; text-anchor: middle; }
+svg.railroad a text { fill: #00c; text-decoration: underline; }
+`
+
+/*
+svg returns d as an SVG image. Standalone images declare their namespace and
+style, otherwise the page containing the image must include svgStyle.
+*/
+func svg(d diagram, link func(symbol string) string, standalone bool) string {
+	w := &svgWriter{paths: new(bytes.Buffer), elems: new(bytes.Buffer), link: link}
+	up := max(d.up(), boxHeight/2)
+	width := d.width() + 2*endWidth + 2*margin
+	height := up + d.down() + 2*margin
+	x, y := margin, margin+up
+	// The start and end of the diagram are double bars
+	w.path("M%d %d v%d m4 %d v%d", x, y-8, 16, -16, 16)
+	w.line(x+4, y, endWidth-4)
+	d.draw(w, x+endWidth, y)
+	x += endWidth + d.width()
+	w.line(x, y, endWidth-4)
+	w.path("M%d %d v%d m4 %d v%d", x+endWidth-4, y-8, 16, -16, 16)
+
+	res := new(bytes.Buffer)
+	if standalone {
+		fmt.Fprintf(res, `<svg xmlns="http://www.w3.org/2000/svg" class="railroad" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n",
+			width, height, width, height)
+		fmt.Fprintf(res, "<style>\n%s</style>\n", svgStyle)
+	} else {
+		fmt.Fprintf(res, `<svg class="railroad" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n",
+			width, height, width, height)
+	}
+	res.Write(w.paths.Bytes())
+	res.Write(w.elems.Bytes())
+	res.WriteString("</svg>\n")
+	return res.String()
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
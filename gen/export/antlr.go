@@ -0,0 +1,326 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package export
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/goutil/stringset"
+)
+
+// antlrKeywords are the reserved words of ANTLR4 that may not be rule names
+var antlrKeywords = stringset.New("catch", "channels", "finally", "fragment",
+	"grammar", "import", "lexer", "locals", "mode", "options", "parser",
+	"returns", "throws", "tokens")
+
+// antlrWhiteSpace is the lexer rule of the white space between the tokens
+const antlrWhiteSpace = "WHITESPACE"
+
+/*
+antlr writes the grammar as an ANTLR4 combined grammar. ANTLR4 parser rules
+start with a lower case letter and lexer rules with an upper case letter, so
+the first letter of every nonterminal is lower case and of every token ID
+upper case, e.g.: Expr becomes expr and id becomes Id.
+
+Every start symbol S has an entry rule s_EOF : s EOF. Labels and alternate
+names are kept. If an alternate of a rule is named all its alternates are
+named, the unnamed alternates by their number, e.g.: Expr0. Case-insensitive
+string literals are lexer rules named CI_<literal>. Suppressed tokens and white
+space are skipped.
+
+ANTLR4 only supports direct left recursion, so indirect left recursion is
+reported. So are the LR(1) conflicts of the grammar: ANTLR4 parses an
+ambiguous input with the first alternate that matches.
+*/
+func (x *exporter) antlr(name string) {
+	x.antlrLeftRecursion()
+	for _, nts := range x.conflicts() {
+		x.issue(x.g.GetSyntaxRule(nts[0]).Pos,
+			"LR(1) conflict in %s: ANTLR4 resolves an ambiguity by the order of the alternates", strings.Join(nts, ", "))
+	}
+	fmt.Fprintf(x.w, "// Package %s\ngrammar %s;\n", x.g.Package.GetString(), identifier(name))
+	for _, s := range x.g.StartSymbols() {
+		fmt.Fprintf(x.w, "\n%s_EOF : %s EOF ;\n", antlrNT(s), antlrNT(s))
+	}
+	for _, r := range x.g.SyntaxRules {
+		x.w.WriteString("\n")
+		x.antlrSyntaxRule(r)
+	}
+	for _, id := range x.g.GetStringLiterals() {
+		if sl := x.g.StringLiterals[id]; sl.CaseInsensitive {
+			fmt.Fprintf(x.w, "\n%s : %s ;\n", antlrCILiteral(sl), antlrItems(literalItems(sl)))
+		}
+	}
+	for _, r := range x.g.LexRules {
+		skip := ""
+		if r.Suppress {
+			skip = " -> skip"
+		}
+		fmt.Fprintf(x.w, "\n%s : %s%s ;\n", antlrTokID(r.ID()), antlrItems(lexItems(r.RegExp)), skip)
+	}
+	fmt.Fprintf(x.w, "\n%s : [\\p{White_Space}]+ -> skip ;\n", antlrWhiteSpace)
+}
+
+/*
+antlrLeftRecursion reports the rules that are left recursive through another
+rule, e.g.: A in A : B "x" ; B : A "z" ;, or through a nullable symbol, e.g.:
+A in A : B A ; B : empty ;. ANTLR4 only rewrites the left recursion of a rule
+that is the first symbol of one of its alternates.
+*/
+func (x *exporter) antlrLeftRecursion() {
+	// left[nt] are the nonterminals that can start nt, except nt as the first
+	// symbol of its own alternates
+	left := map[string][]string{}
+	for _, r := range x.g.SyntaxRules {
+		for _, a := range r.Alternates {
+			for i, sym := range a.Symbols {
+				nt, ok := sym.(*ast.NT)
+				if !ok {
+					break
+				}
+				if i > 0 || nt.ID() != r.ID() {
+					left[r.ID()] = append(left[r.ID()], nt.ID())
+				}
+				if !x.nullable(nt.ID()) {
+					break
+				}
+			}
+		}
+	}
+	for _, r := range x.g.SyntaxRules {
+		switch path := leftPath(left, r.ID()); {
+		case len(path) == 2:
+			x.issue(r.Pos, "%s is left recursive after a nullable symbol, which ANTLR4 does not support", r.ID())
+		case path != nil:
+			x.issue(r.Pos, "%s is indirectly left recursive, which ANTLR4 does not support: %s",
+				r.ID(), strings.Join(path, " -> "))
+		}
+	}
+}
+
+// leftPath returns the shortest path from nt to nt in left, or nil if there
+// is none
+func leftPath(left map[string][]string, nt string) []string {
+	from := map[string]string{}
+	queue := []string{nt}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for _, m := range left[n] {
+			if _, visited := from[m]; visited {
+				continue
+			}
+			from[m] = n
+			if m == nt {
+				path := []string{nt}
+				for n := from[nt]; n != nt; n = from[n] {
+					path = append([]string{n}, path...)
+				}
+				return append([]string{nt}, path...)
+			}
+			queue = append(queue, m)
+		}
+	}
+	return nil
+}
+
+func (x *exporter) antlrSyntaxRule(r *ast.SyntaxRule) {
+	named := false
+	for _, a := range r.Alternates {
+		named = named || a.Name != ""
+	}
+	alts := make([]string, len(r.Alternates))
+	width := 0
+	for i, a := range r.Alternates {
+		alts[i] = x.antlrAlternate(a)
+		if n := utf8.RuneCountInString(alts[i]); n > width {
+			width = n
+		}
+	}
+	fmt.Fprintf(x.w, "%s\n", antlrNT(r.ID()))
+	for i, a := range r.Alternates {
+		sep := "|"
+		if i == 0 {
+			sep = ":"
+		}
+		if named {
+			pad := strings.Repeat(" ", width-utf8.RuneCountInString(alts[i]))
+			fmt.Fprintf(x.w, "    %s %s%s # %s\n", sep, alts[i], pad, ast.AlternateID(r.ID(), i, a))
+		} else {
+			fmt.Fprintf(x.w, "    %s %s\n", sep, alts[i])
+		}
+	}
+	x.w.WriteString("    ;\n")
+}
+
+func (x *exporter) antlrAlternate(a *ast.SyntaxAlternate) string {
+	if a.Empty() {
+		return "/* empty */"
+	}
+	syms := make([]string, len(a.Symbols))
+	for i, sym := range a.Symbols {
+		switch sym := sym.(type) {
+		case *ast.NT:
+			syms[i] = antlrNT(sym.ID())
+		case *ast.TokID:
+			syms[i] = antlrTokID(sym.ID())
+		case *ast.StringLit:
+			if sl := x.g.StringLiterals[sym.ID()]; sl.CaseInsensitive {
+				syms[i] = antlrCILiteral(sl)
+			} else {
+				syms[i] = antlrString([]rune(sym.ID()))
+			}
+		}
+		if l := a.Label(i); l != "" {
+			if antlrKeywords.Contain(l) {
+				l += "_"
+			}
+			syms[i] = l + "=" + syms[i]
+		}
+	}
+	return strings.Join(syms, " ")
+}
+
+// antlrNT returns the name of the parser rule of nonterminal nt
+func antlrNT(nt string) string {
+	r, n := utf8.DecodeRuneInString(nt)
+	name := string(unicode.ToLower(r)) + nt[n:]
+	if antlrKeywords.Contain(name) {
+		name += "_"
+	}
+	return name
+}
+
+// antlrTokID returns the name of the lexer rule of token ID id
+func antlrTokID(id string) string {
+	r, n := utf8.DecodeRuneInString(id)
+	return string(unicode.ToUpper(r)) + id[n:]
+}
+
+/*
+antlrCILiteral returns the name of the lexer rule of case-insensitive string
+literal sl: CI_ followed by the upper case literal, or by x and the hex codes
+of its characters if it is not an identifier.
+*/
+func antlrCILiteral(sl *ast.StringLit) string {
+	if isIdentifier(sl.ID()) {
+		return "CI_" + strings.ToUpper(sl.ID())
+	}
+	w := new(strings.Builder)
+	w.WriteString("CI_x")
+	for _, r := range sl.ID() {
+		fmt.Fprintf(w, "%x", r)
+	}
+	return w.String()
+}
+
+func antlrItems(items []*lexItem) string {
+	s := make([]string, len(items))
+	for i, it := range items {
+		switch {
+		case it.str != nil:
+			s[i] = antlrString(it.str)
+		case it.any:
+			s[i] = "."
+		case it.set != nil && it.set.not:
+			s[i] = "~[" + classBody(it.set, antlrSetChar) + "]"
+		case it.set != nil:
+			s[i] = "[" + classBody(it.set, antlrSetChar) + "]"
+		default:
+			alts := make([]string, len(it.bracket.Alternates))
+			for j, re := range it.bracket.Alternates {
+				alts[j] = antlrItems(lexItems(re))
+			}
+			s[i] = "( " + strings.Join(alts, " | ") + " )" + suffixes[it.bracket.Type]
+		}
+	}
+	return strings.Join(s, " ")
+}
+
+// suffixes are the EBNF suffixes of the bracket types
+var suffixes = map[ast.BracketType]string{
+	ast.LexGroup:      "",
+	ast.LexOptional:   "?",
+	ast.LexZeroOrMore: "*",
+	ast.LexOneOrMore:  "+",
+}
+
+// antlrString returns str as an ANTLR4 string literal
+func antlrString(str []rune) string {
+	w := new(strings.Builder)
+	w.WriteString("'")
+	for _, r := range str {
+		if r == '\'' {
+			w.WriteString(`\'`)
+		} else {
+			w.WriteString(antlrChar(r))
+		}
+	}
+	w.WriteString("'")
+	return w.String()
+}
+
+func antlrSetChar(r rune) string {
+	if r == ']' || r == '-' {
+		return `\` + string(r)
+	}
+	return antlrChar(r)
+}
+
+func antlrChar(r rune) string {
+	switch {
+	case r == '\\':
+		return `\\`
+	case r == '\n':
+		return `\n`
+	case r == '\r':
+		return `\r`
+	case r == '\t':
+		return `\t`
+	case isPrint(r):
+		return string(r)
+	case r > 0xffff:
+		return fmt.Sprintf(`\u{%X}`, r)
+	}
+	return fmt.Sprintf(`\u%04X`, r)
+}
+
+// identifier returns name with every character that is not a letter, digit
+// or '_' replaced by '_'. An identifier cannot start with a digit.
+func identifier(name string) string {
+	id := []rune(name)
+	for i, r := range id {
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			id[i] = '_'
+		}
+	}
+	if len(id) == 0 || unicode.IsDigit(id[0]) {
+		id = append([]rune{'_'}, id...)
+	}
+	return string(id)
+}
+
+func isIdentifier(s string) bool {
+	for _, r := range s {
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,221 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package export
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/util/runeset"
+)
+
+/*
+ebnf writes the grammar in the EBNF notation of the W3C XML specification.
+The syntax rules are followed by the lex rules, and the Unicode categories
+and properties used by the lex rules are written as rules named unicode_<name>
+at the end.
+
+W3C EBNF has no tokens, so the white space and suppressed tokens between the
+tokens, the alternate names and labels, and the keywords of identifier rules
+are not represented.
+*/
+func (x *exporter) ebnf() {
+	props := map[string]bool{}
+	fmt.Fprintf(x.w, "/* Package %s. The tokens may be separated by white space. */\n",
+		x.g.Package.GetString())
+	for _, r := range x.syntaxRules() {
+		x.reportNames(r, true)
+		x.w.WriteString("\n")
+		x.ebnfSyntaxRule(r)
+	}
+	for _, r := range x.g.LexRules {
+		x.w.WriteString("\n")
+		if r.Suppress {
+			x.issue(r.Pos, "suppressed token %s is written as a rule", r.ID())
+			x.w.WriteString("/* suppressed: may occur between any two tokens */\n")
+		}
+		if r.Identifier {
+			x.issue(r.Pos, "the keywords are not excluded from identifier token %s", r.ID())
+		}
+		fmt.Fprintf(x.w, "%s ::= %s\n", r.ID(), ebnfRegExp(r.RegExp, props))
+	}
+
+	var names []string
+	for p := range props {
+		names = append(names, p)
+	}
+	sort.Strings(names)
+	for _, p := range names {
+		rngs := runeset.FromRangeTable(unicodeTable(p))
+		fmt.Fprintf(x.w, "\nunicode_%s ::= %s\n", p, ebnfRanges(rngs, false))
+	}
+}
+
+func (x *exporter) ebnfSyntaxRule(r *ast.SyntaxRule) {
+	alts := r.Alternates
+	optional := hasEmpty(r)
+	if optional {
+		alts = nonEmpty(r)
+	}
+	if len(alts) == 0 {
+		fmt.Fprintf(x.w, "%s ::= ''\n", r.ID())
+		return
+	}
+	fmt.Fprintf(x.w, "%s ::= ", r.ID())
+	if optional {
+		x.w.WriteString("( ")
+	}
+	for i, a := range alts {
+		if i > 0 {
+			fmt.Fprintf(x.w, "\n%s| ", strings.Repeat(" ", len(r.ID())+3))
+		}
+		for j, sym := range a.Symbols {
+			if j > 0 {
+				x.w.WriteString(" ")
+			}
+			if sl, ok := sym.(*ast.StringLit); ok {
+				x.w.WriteString(ebnfItems(literalItems(x.g.StringLiterals[sl.ID()]), nil))
+			} else {
+				x.w.WriteString(sym.ID())
+			}
+		}
+	}
+	if optional {
+		x.w.WriteString(" )?")
+	}
+	x.w.WriteString("\n")
+}
+
+// ebnfRegExp returns re in EBNF. The Unicode categories and properties in
+// re are added to props.
+func ebnfRegExp(re *ast.RegExp, props map[string]bool) string {
+	return ebnfItems(lexItems(re), props)
+}
+
+func ebnfItems(items []*lexItem, props map[string]bool) string {
+	s := make([]string, len(items))
+	for i, it := range items {
+		switch {
+		case it.str != nil:
+			s[i] = ebnfString(it.str)
+		case it.any:
+			s[i] = "[#x0-#x10FFFF]"
+		case it.set != nil:
+			s[i] = ebnfSet(it.set, props)
+		default:
+			s[i] = ebnfBracket(it.bracket, props)
+		}
+	}
+	return strings.Join(s, " ")
+}
+
+func ebnfBracket(b *ast.LexBracket, props map[string]bool) string {
+	alts := make([]string, len(b.Alternates))
+	for i, re := range b.Alternates {
+		alts[i] = ebnfRegExp(re, props)
+	}
+	return "( " + strings.Join(alts, " | ") + " )" + suffixes[b.Type]
+}
+
+func ebnfSet(set *charSet, props map[string]bool) string {
+	if set.not {
+		return ebnfRanges(set.ranges, true)
+	}
+	var s []string
+	for _, p := range set.props {
+		props[p] = true
+		s = append(s, "unicode_"+p)
+	}
+	if !set.ranges.Empty() {
+		s = append(s, ebnfRanges(set.ranges, false))
+	}
+	if len(s) == 1 {
+		return s[0]
+	}
+	return "( " + strings.Join(s, " | ") + " )"
+}
+
+// ebnfRanges returns the character class of rngs, which is negated if not is
+// true
+func ebnfRanges(rngs runeset.Ranges, not bool) string {
+	if not {
+		return "[^" + classBody(&charSet{ranges: rngs}, ebnfSetChar) + "]"
+	}
+	return "[" + classBody(&charSet{ranges: rngs}, ebnfSetChar) + "]"
+}
+
+func ebnfSetChar(r rune) string {
+	if isPrint(r) && !strings.ContainsRune(`[]^-#\`, r) {
+		return string(r)
+	}
+	return fmt.Sprintf("#x%X", r)
+}
+
+/*
+ebnfString returns the EBNF of str. str is quoted with ' unless it contains
+', in which case it is quoted with ". A string containing both is split into
+strings that do not contain their quote. Characters that are not printable
+are written as #x<hex code point>.
+*/
+func ebnfString(str []rune) string {
+	var s []string
+	quoted := new(strings.Builder)
+	quote, pref := rune(0), '\''
+	if strings.ContainsRune(string(str), '\'') {
+		pref = '"'
+	}
+	flush := func() {
+		if quoted.Len() > 0 {
+			s = append(s, string(quote)+quoted.String()+string(quote))
+			quoted.Reset()
+		}
+		quote = 0
+	}
+	for _, r := range str {
+		if !unicode.IsGraphic(r) || r == utf8.RuneError {
+			flush()
+			s = append(s, fmt.Sprintf("#x%X", r))
+			continue
+		}
+		if r == '\'' || r == '"' {
+			if quote == r {
+				flush()
+			}
+			if quote == 0 {
+				quote = '\'' + '"' - r
+			}
+		} else if quote == 0 {
+			quote = pref
+		}
+		quoted.WriteRune(r)
+	}
+	flush()
+	if len(s) == 0 {
+		return "''"
+	}
+	return strings.Join(s, " ")
+}
+
+// unicodeTable returns the Unicode category or property with name
+func unicodeTable(name string) *unicode.RangeTable {
+	if tab, ok := unicode.Categories[name]; ok {
+		return tab
+	}
+	return unicode.Properties[name]
+}
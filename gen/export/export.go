@@ -0,0 +1,406 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+/*
+Package export translates a grammar to the grammar formats of other tools:
+W3C EBNF, ANTLR4 and tree-sitter, and to a TextMate grammar for the syntax
+highlighting of the language.
+
+The syntax rules, lex rules and suppressed tokens of the grammar are
+translated. A TextMate grammar is derived from the lex rules and the string
+literals of the syntax rules. The constructs of the grammar that cannot be
+represented faithfully in the target format are listed in a Report.
+*/
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/frstflw"
+	"github.com/goccmack/gogll/v3/lr1"
+	"github.com/goccmack/gogll/v3/util/runeset"
+)
+
+// Format is a target format of the exporter
+type Format int
+
+const (
+	EBNF Format = iota
+	ANTLR
+	TreeSitter
+	TextMate
+)
+
+// formatNames are the names of the formats used on the command line
+var formatNames = []string{"ebnf", "antlr", "tree-sitter", "textmate"}
+
+// ParseFormat returns the format with name: ebnf, antlr, tree-sitter or
+// textmate
+func ParseFormat(name string) (Format, error) {
+	for i, n := range formatNames {
+		if n == name {
+			return Format(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown export format %q", name)
+}
+
+// formatTitles are the names of the formats used in reports
+var formatTitles = []string{"W3C EBNF", "ANTLR4", "tree-sitter", "TextMate"}
+
+func (f Format) String() string {
+	return formatTitles[f]
+}
+
+// Issue is a construct of the grammar that cannot be represented faithfully
+// in the target format
+type Issue struct {
+	Pos *ast.Position
+	Msg string
+}
+
+// Report lists the issues of the translation of a grammar
+type Report struct {
+	Format Format
+	Issues []*Issue
+}
+
+/*
+Write writes grammar g in format f to w and returns the report of the
+translation. name is the name of the grammar, which is the ANTLR4 grammar
+name or the tree-sitter or TextMate language name. It is ignored by W3C EBNF.
+
+scopes assigns TextMate scopes to token IDs and to quoted string literals,
+e.g.: id: entity.name or "if": keyword.control.conditional. It is ignored by
+the other formats. An error is returned if a key of scopes is not a token ID
+or string literal of g.
+*/
+func Write(w io.Writer, f Format, name string, g *ast.GoGLL, ff *frstflw.FF, scopes map[string]string) (*Report, error) {
+	x := &exporter{g: g, ff: ff, w: new(bytes.Buffer), rep: &Report{Format: f}}
+	switch f {
+	case EBNF:
+		x.ebnf()
+	case ANTLR:
+		x.antlr(name)
+	case TreeSitter:
+		x.treeSitter(name)
+	case TextMate:
+		if err := x.textMate(name, scopes); err != nil {
+			return nil, err
+		}
+	}
+	sort.SliceStable(x.rep.Issues, func(i, j int) bool {
+		pi, pj := x.rep.Issues[i].Pos, x.rep.Issues[j].Pos
+		if pi.File != pj.File {
+			return pi.File < pj.File
+		}
+		return pi.Line < pj.Line
+	})
+	_, err := w.Write(x.w.Bytes())
+	return x.rep, err
+}
+
+// WriteText writes the issues of r to w
+func (r *Report) WriteText(w io.Writer) {
+	if len(r.Issues) == 0 {
+		fmt.Fprintf(w, "The grammar is represented faithfully in %s\n", r.Format)
+		return
+	}
+	fmt.Fprintf(w, "Constructs that cannot be represented faithfully in %s:\n", r.Format)
+	for _, is := range r.Issues {
+		fmt.Fprintf(w, "  %s:%d: %s\n", is.Pos.File, is.Pos.Line, is.Msg)
+	}
+}
+
+type exporter struct {
+	g   *ast.GoGLL
+	ff  *frstflw.FF
+	w   *bytes.Buffer
+	rep *Report
+}
+
+func (x *exporter) issue(pos *ast.Position, format string, args ...interface{}) {
+	x.rep.Issues = append(x.rep.Issues, &Issue{Pos: pos, Msg: fmt.Sprintf(format, args...)})
+}
+
+// syntaxRules returns the syntax rules of the grammar with the rule of the
+// default start symbol first
+func (x *exporter) syntaxRules() []*ast.SyntaxRule {
+	if len(x.g.SyntaxRules) == 0 {
+		return nil
+	}
+	start := x.g.GetSyntaxRule(x.g.StartSymbol())
+	rules := []*ast.SyntaxRule{start}
+	for _, r := range x.g.SyntaxRules {
+		if r != start {
+			rules = append(rules, r)
+		}
+	}
+	return rules
+}
+
+// nullable returns true if nonterminal nt derives the empty string
+func (x *exporter) nullable(nt string) bool {
+	return x.ff.FirstOfSymbol(nt).Contain(frstflw.Empty)
+}
+
+/*
+conflicts returns the nonterminals of each LR(1) conflict of the grammar,
+which may be ambiguous where it has conflicts. The LR(1) generator panics on
+some grammars, e.g.: a conflict with the accept action, which is reported.
+*/
+func (x *exporter) conflicts() (rules [][]string) {
+	if len(x.g.SyntaxRules) == 0 {
+		return nil
+	}
+	defer func() {
+		if err := recover(); err != nil {
+			start := x.g.GetSyntaxRule(x.g.StartSymbol())
+			x.issue(start.Pos, "the LR(1) conflicts of the grammar cannot be computed: %v", err)
+			rules = nil
+		}
+	}()
+	return lr1.ConflictRules(x.g)
+}
+
+// reportNames reports the alternate names and symbol labels of r, which
+// are dropped by the target format
+func (x *exporter) reportNames(r *ast.SyntaxRule, labels bool) {
+	for _, a := range r.Alternates {
+		if a.Name != "" {
+			x.issue(r.Pos, "the name of alternate %s is dropped", ast.AlternateID(r.ID(), 0, a))
+		}
+		if labels && a.HasLabels() {
+			x.issue(r.Pos, "the labels of alternate `%s` are dropped", ast.AlternateString(r.ID(), a))
+		}
+	}
+}
+
+// hasEmpty returns true if r has an empty alternate
+func hasEmpty(r *ast.SyntaxRule) bool {
+	for _, a := range r.Alternates {
+		if a.Empty() {
+			return true
+		}
+	}
+	return false
+}
+
+// nonEmpty returns the alternates of r that are not empty
+func nonEmpty(r *ast.SyntaxRule) (alts []*ast.SyntaxAlternate) {
+	for _, a := range r.Alternates {
+		if !a.Empty() {
+			alts = append(alts, a)
+		}
+	}
+	return
+}
+
+/*** Lex symbols ***/
+
+/*
+lexItem is a symbol of the regular expression of a lex rule. A sequence of
+character literals is one lexItem.
+*/
+type lexItem struct {
+	// str is the string of a sequence of character literals
+	str []rune
+	// set is the set of characters of a character set
+	set *charSet
+	// any is true if the item matches any character
+	any     bool
+	bracket *ast.LexBracket
+}
+
+// lexItems returns the items of re
+func lexItems(re *ast.RegExp) (items []*lexItem) {
+	var str []rune
+	flush := func() {
+		if len(str) > 0 {
+			items = append(items, &lexItem{str: str})
+			str = nil
+		}
+	}
+	for _, sym := range re.Symbols {
+		switch sym := sym.(type) {
+		case *ast.CharLiteral:
+			str = append(str, sym.Char())
+			continue
+		case *ast.Any:
+			flush()
+			items = append(items, &lexItem{any: true})
+		case *ast.LexBracket:
+			flush()
+			items = append(items, &lexItem{bracket: sym})
+		default:
+			flush()
+			if set := setOf(sym); set.not && set.ranges.Empty() {
+				items = append(items, &lexItem{any: true})
+			} else {
+				items = append(items, &lexItem{set: set})
+			}
+		}
+	}
+	flush()
+	return
+}
+
+// literalItems returns the items of string literal sl. Every character with
+// case variants of a case-insensitive literal is a set of its variants.
+func literalItems(sl *ast.StringLit) (items []*lexItem) {
+	if !sl.CaseInsensitive {
+		return []*lexItem{{str: sl.Value()}}
+	}
+	var str []rune
+	for _, r := range sl.Value() {
+		variants := caseVariants(r)
+		if len(variants) == 1 {
+			str = append(str, r)
+			continue
+		}
+		if len(str) > 0 {
+			items = append(items, &lexItem{str: str})
+			str = nil
+		}
+		rngs := make([]runeset.Range, len(variants))
+		for i, v := range variants {
+			rngs[i] = runeset.Range{Lo: v, Hi: v}
+		}
+		items = append(items, &lexItem{set: &charSet{ranges: runeset.NewRanges(rngs...)}})
+	}
+	if len(str) > 0 {
+		items = append(items, &lexItem{str: str})
+	}
+	return
+}
+
+// caseVariants returns r followed by its upper and lower case variants
+func caseVariants(r rune) []rune {
+	variants := []rune{r}
+	for _, v := range []rune{unicode.ToUpper(r), unicode.ToLower(r)} {
+		if v != variants[0] && (len(variants) == 1 || v != variants[1]) {
+			variants = append(variants, v)
+		}
+	}
+	return variants
+}
+
+// charSet is a set of characters of a lex rule
+type charSet struct {
+	// props are the names of the Unicode categories and properties in the set
+	props []string
+	// ranges are the other characters of the set
+	ranges runeset.Ranges
+	// not is true if the set contains the characters not in ranges. props
+	// is empty.
+	not bool
+}
+
+/*
+setOf returns the set of characters of sym, which is not a character literal,
+any character or a bracket. A Unicode set with excluded ranges is returned
+as the ranges of its characters.
+*/
+func setOf(sym ast.LexSymbol) *charSet {
+	switch sym := sym.(type) {
+	case *ast.AnyOf:
+		return &charSet{ranges: sym.Set.Ranges()}
+	case *ast.Not:
+		return &charSet{ranges: sym.Set.Ranges(), not: true}
+	case *ast.CharRange:
+		return &charSet{ranges: charRange(sym)}
+	case *ast.UnicodeClass:
+		return &charSet{props: []string{classNames[sym.Type]}}
+	case *ast.UnicodeSet:
+		return unicodeSet(sym)
+	}
+	panic(fmt.Sprintf("invalid lex symbol %T", sym))
+}
+
+// classNames are the Unicode categories of the Unicode classes
+var classNames = map[ast.UnicodeClassType]string{
+	ast.Letter:  "L",
+	ast.Upcase:  "Lu",
+	ast.Lowcase: "Ll",
+	ast.Number:  "N",
+	ast.Space:   "Z",
+}
+
+func charRange(cr *ast.CharRange) runeset.Ranges {
+	return runeset.NewRanges(runeset.Range{Lo: cr.From.Char(), Hi: cr.To.Char()})
+}
+
+func unicodeSet(us *ast.UnicodeSet) *charSet {
+	set := &charSet{ranges: runeset.Ranges{}}
+	excl := runeset.Ranges{}
+	for _, rng := range us.Ranges {
+		var rs runeset.Ranges
+		if rng.Type == ast.CharacterRange {
+			rs = charRange(rng.CharRange)
+		} else {
+			rs = runeset.FromRangeTable(rng.GetRangeTable())
+		}
+		switch {
+		case rng.Exclude:
+			excl = excl.Union(rs)
+		case rng.Type == ast.CharacterRange:
+			set.ranges = set.ranges.Union(rs)
+		default:
+			set.props = append(set.props, rng.Range)
+		}
+	}
+	if excl.Empty() {
+		return set
+	}
+	incl := set.ranges
+	for _, rng := range us.Ranges {
+		if !rng.Exclude && rng.Type != ast.CharacterRange {
+			incl = incl.Union(runeset.FromRangeTable(rng.GetRangeTable()))
+		}
+	}
+	return &charSet{ranges: incl.Difference(excl)}
+}
+
+// isPrint returns true if r is a printable ASCII character
+func isPrint(r rune) bool {
+	return r >= ' ' && r <= '~'
+}
+
+/*
+classBody returns the members of a regular expression character class
+containing the characters of set: a \p{<name>} for each Unicode category and
+property and the ranges. char returns a character in the class.
+*/
+func classBody(set *charSet, char func(r rune) string) string {
+	w := new(strings.Builder)
+	for _, p := range set.props {
+		fmt.Fprintf(w, `\p{%s}`, p)
+	}
+	for _, rng := range set.ranges {
+		w.WriteString(char(rng.Lo))
+		if rng.Hi > rng.Lo+1 {
+			w.WriteString("-")
+		}
+		if rng.Hi > rng.Lo {
+			w.WriteString(char(rng.Hi))
+		}
+	}
+	return w.String()
+}
@@ -0,0 +1,265 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/goccmack/gogll/v3/internal/loader"
+)
+
+const grammar = `package "expr"
+
+start Expr, Term ;
+
+Expr : lhs:Expr "-" rhs:Term #Minus | Term #Single ;
+Term : id | "(" Expr ")" | i"select" Opt ;
+Opt : empty | "'\"" ;
+
+@id : letter {letter | '_' | '0'-'9'} ;
+hex : '[ '0'-'9' 'a'-'f']' ;
+lower : '[\p{L}-'A'-'Z']' ;
+!comment : '/' '/' {not "\n"} ;
+`
+
+func export(t *testing.T, f Format, scopes map[string]string) (string, *Report) {
+	gr := load(t, grammar)
+	w := new(bytes.Buffer)
+	rep, err := Write(w, f, "expr", gr.AST, gr.FF, scopes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w.String(), rep
+}
+
+func load(t *testing.T, src string) *loader.Grammar {
+	gr, errs := loader.Source("expr.bnf", src)
+	if errs != nil {
+		t.Fatal(errs[0])
+	}
+	return gr
+}
+
+func check(t *testing.T, out string, rep *Report, contains, issues []string) {
+	for _, s := range contains {
+		if !strings.Contains(out, s) {
+			t.Errorf("missing: %s", s)
+		}
+	}
+	if len(rep.Issues) != len(issues) {
+		t.Errorf("%d issues, expected %d", len(rep.Issues), len(issues))
+	}
+	for i, is := range rep.Issues {
+		if i < len(issues) && !strings.Contains(is.Msg, issues[i]) {
+			t.Errorf("issue %d: %s, expected %s", i, is.Msg, issues[i])
+		}
+	}
+	if t.Failed() {
+		t.Log(out)
+		rep.WriteText(&testWriter{t})
+	}
+}
+
+type testWriter struct {
+	t *testing.T
+}
+
+func (w *testWriter) Write(p []byte) (int, error) {
+	w.t.Log(string(p))
+	return len(p), nil
+}
+
+func TestEBNF(t *testing.T) {
+	out, rep := export(t, EBNF, nil)
+	check(t, out, rep,
+		[]string{
+			"Expr ::= Expr '-' Term\n       | Term\n",
+			"| [Ss] [Ee] [Ll] [Ee] [Cc] [Tt] Opt\n",
+			`Opt ::= ( "'" '"' )?`,
+			"id ::= unicode_L ( unicode_L | '_' | [0-9] )*\n",
+			"hex ::= [0-9a-f]\n",
+			"lower ::= [a-z#xAA#xB5",
+			"/* suppressed: may occur between any two tokens */\ncomment ::= '//' ( [^#xA] )*\n",
+			"unicode_L ::= [A-Za-z#xAA",
+		},
+		[]string{
+			"the name of alternate Expr_Minus is dropped",
+			"the labels of alternate `Expr : lhs:Expr \"-\" rhs:Term` are dropped",
+			"the name of alternate Expr_Single is dropped",
+			"the keywords are not excluded from identifier token id",
+			"suppressed token comment is written as a rule",
+		})
+}
+
+func TestANTLR(t *testing.T) {
+	out, rep := export(t, ANTLR, nil)
+	check(t, out, rep,
+		[]string{
+			"grammar expr;\n",
+			"expr_EOF : expr EOF ;\n\nterm_EOF : term EOF ;\n",
+			"expr\n    : lhs=expr '-' rhs=term # Expr_Minus\n    | term                  # Expr_Single\n    ;\n",
+			"    | CI_SELECT opt\n",
+			"opt\n    : /* empty */\n    | '\\'\"'\n    ;\n",
+			"CI_SELECT : [Ss] [Ee] [Ll] [Ee] [Cc] [Tt] ;\n",
+			"Id : [\\p{L}] ( [\\p{L}] | '_' | [0-9] )* ;\n",
+			"Hex : [0-9a-f] ;\n",
+			"Lower : [a-z\\u00AA\\u00B5",
+			"Comment : '//' ( ~[\\n] )* -> skip ;\n",
+			"WHITESPACE : [\\p{White_Space}]+ -> skip ;\n",
+		},
+		nil)
+}
+
+func TestTreeSitter(t *testing.T) {
+	out, rep := export(t, TreeSitter, nil)
+	check(t, out, rep,
+		[]string{
+			"  name: 'expr',\n",
+			"  extras: $ => [\n    /\\s/,\n    $.comment,\n  ],\n",
+			"  word: $ => $.id,\n",
+			"    Expr: $ => choice(\n      seq(field('lhs', $.Expr), '-', field('rhs', $.Term)),\n      $.Term,\n    ),\n",
+			"seq(alias(token(seq(/[Ss]/, /[Ee]/, /[Ll]/, /[Ee]/, /[Cc]/, /[Tt]/)), 'select'), $.Opt)",
+			"    Opt: $ => choice(\n      blank(),\n      '\\'\"',\n    ),\n",
+			"    id: $ => token(seq(/[\\p{L}]/, repeat(choice(/[\\p{L}]/, '_', /[0-9]/)))),\n",
+			"    lower: $ => token(/[a-z\\u00AA\\u00B5",
+			"    comment: $ => token(seq('//', repeat(/[^\\u000A]/))),\n  },\n});\n",
+		},
+		[]string{
+			"the name of alternate Expr_Minus is dropped",
+			"the name of alternate Expr_Single is dropped",
+			"tree-sitter has one start symbol: Term is not a start symbol",
+			"Opt matches the empty string",
+		})
+}
+
+const ambiguousGrammar = `package "amb"
+
+E : E "+" E | id ;
+
+A : B "x" | "y" ;
+B : A "z" | empty ;
+
+C : D C | "c" ;
+D : empty | "d" ;
+
+id : letter ;
+`
+
+func TestANTLRLeftRecursion(t *testing.T) {
+	gr := load(t, ambiguousGrammar)
+	w := new(bytes.Buffer)
+	rep, err := Write(w, ANTLR, "amb", gr.AST, gr.FF, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(t, w.String(), rep,
+		[]string{"e\n    : e '+' e\n    | Id\n    ;\n"},
+		[]string{
+			"LR(1) conflict in E: ANTLR4 resolves an ambiguity by the order of the alternates",
+			"A is indirectly left recursive, which ANTLR4 does not support: A -> B -> A",
+			"B is indirectly left recursive, which ANTLR4 does not support: B -> A -> B",
+			"C is left recursive after a nullable symbol, which ANTLR4 does not support",
+		})
+}
+
+func TestTreeSitterConflicts(t *testing.T) {
+	gr := load(t, ambiguousGrammar)
+	w := new(bytes.Buffer)
+	rep, err := Write(w, TreeSitter, "amb", gr.AST, gr.FF, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(t, w.String(), rep,
+		[]string{"\n  conflicts: $ => [\n    [$.E],\n  ],\n"},
+		[]string{
+			"LR(1) conflict in E: tree-sitter chooses one parse of an ambiguous input",
+			"B matches the empty string",
+			"D matches the empty string",
+		})
+}
+
+func TestTextMate(t *testing.T) {
+	out, rep := export(t, TextMate, map[string]string{
+		"hex":   "constant.numeric.hex",
+		`"'\""`: "string.quoted.other",
+	})
+	check(t, out, rep,
+		[]string{
+			`  "scopeName": "source.expr",`,
+			"{\n      \"include\": \"#comment\"\n    },\n    {\n      \"include\": \"#words.keyword.control.expr\"\n    },\n    {\n      \"include\": \"#id\"\n    },",
+			"\"comment\": {\n      \"name\": \"comment.line.expr\",\n      \"match\": \"\\\\/\\\\/(?:[^\\\\n])*\"\n",
+			"\"name\": \"constant.numeric.hex\",\n      \"match\": \"[0-9a-f]\"\n",
+			"\"name\": \"variable.other.expr\",\n      \"match\": \"[\\\\p{L}](?:[\\\\p{L}]|_|[0-9])*\"\n",
+			"\"name\": \"punctuation.section.expr\",\n      \"match\": \"(?:\\\\(|\\\\))\"\n",
+			"\"name\": \"string.quoted.other\",\n      \"match\": \"'\\\"\"\n",
+			"\"name\": \"keyword.control.expr\",\n      \"match\": \"\\\\b(?:(?i:select))\\\\b\"\n",
+		},
+		nil)
+}
+
+const strGrammar = `package "str"
+
+Str : string ;
+
+string : '"' { not "\\\"" | '\\' any "\\\"" } '"' ;
+!comment : '/' '*' { . } '*' '/' ;
+`
+
+func TestTextMateLines(t *testing.T) {
+	gr := load(t, strGrammar)
+	w := new(bytes.Buffer)
+	rep, err := Write(w, TextMate, "str", gr.AST, gr.FF, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(t, w.String(), rep,
+		[]string{
+			"\"comment\": {\n      \"name\": \"comment.block.str\",\n      \"begin\": \"\\\\/\\\\*\",\n      \"end\": \"\\\\*\\\\/\"\n    }",
+			"\"string\": {\n      \"name\": \"string.quoted.double.str\",\n      \"begin\": \"\\\"\",\n      \"end\": \"\\\"\",\n      \"patterns\": [\n        {\n          \"match\": \"(?:[^\\\"\\\\\\\\]|\\\\\\\\[\\\"\\\\\\\\])+\"\n",
+		},
+		[]string{
+			"token string spans lines: it is matched from \" to the first \"",
+			"token comment spans lines: it is matched from /* to the first */",
+		})
+}
+
+func TestTextMateNumbers(t *testing.T) {
+	for _, rule := range []string{
+		"num : number {number} ;",
+		"num : <number> ;",
+		"num : '[\\p{Nd}]' {'0'-'9'} ;",
+		"num : < '0'-'9' | '_' > ;",
+	} {
+		gr := load(t, "package \"num\"\n\nNum : num ;\n\n"+rule+"\n")
+		w := new(bytes.Buffer)
+		if _, err := Write(w, TextMate, "num", gr.AST, gr.FF, nil); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(w.String(), `"name": "constant.numeric.num"`) {
+			t.Errorf("%s: expected scope constant.numeric.num\n%s", rule, w)
+		}
+	}
+}
+
+func TestTextMateScopes(t *testing.T) {
+	gr := load(t, grammar)
+	for _, key := range []string{"ident", `"if"`, "select"} {
+		_, err := Write(new(bytes.Buffer), TextMate, "expr", gr.AST, gr.FF, map[string]string{key: "variable.name"})
+		if err == nil {
+			t.Errorf("%s: expected an error", key)
+		}
+	}
+}
+
+func TestEBNFString(t *testing.T) {
+	for _, tst := range []struct{ str, exp string }{
+		{"abc", "'abc'"},
+		{`a'b`, `"a'b"`},
+		{`'"`, `"'" '"'`},
+		{"a\nb", "'a' #xA 'b'"},
+	} {
+		if got := ebnfString([]rune(tst.str)); got != tst.exp {
+			t.Errorf("%q: expected %s, got %s", tst.str, tst.exp, got)
+		}
+	}
+}
@@ -0,0 +1,434 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/util/runeset"
+)
+
+// tmSchema is the JSON schema of TextMate grammars
+const tmSchema = "https://raw.githubusercontent.com/martinring/tmlanguage/master/tmlanguage.json"
+
+type tmGrammar struct {
+	Schema     string             `json:"$schema"`
+	Name       string             `json:"name"`
+	ScopeName  string             `json:"scopeName"`
+	Patterns   []*tmInclude       `json:"patterns"`
+	Repository map[string]*tmRule `json:"repository"`
+}
+
+type tmInclude struct {
+	Include string `json:"include"`
+}
+
+type tmRule struct {
+	Name     string    `json:"name,omitempty"`
+	Match    string    `json:"match,omitempty"`
+	Begin    string    `json:"begin,omitempty"`
+	End      string    `json:"end,omitempty"`
+	Patterns []*tmRule `json:"patterns,omitempty"`
+}
+
+/*
+textMate writes a TextMate grammar (.tmLanguage.json) for syntax highlighting,
+which is derived from the lex rules and the string literals of the syntax
+rules. The scope of a token is inferred from its role:
+
+	suppressed token: comment.line or comment.block
+	string literal that is a word: keyword.control
+	other string literal: keyword.operator or punctuation
+	lex rule starting with a quote: string.quoted
+	lex rule starting with a digit or a Unicode number: constant.numeric
+	identifier or lex rule starting with a letter: variable.other
+	other lex rule: constant.other
+
+The inferred scopes are suffixed with the language name, e.g.:
+keyword.control.expr. scopes assigns scopes to token IDs and to string
+literals, which are quoted, e.g.: "if". They replace the inferred scopes.
+
+TextMate tries the patterns at each position in order, while the gogll lexer
+takes the longest match. The patterns are ordered to match like the lexer:
+the suppressed tokens, the keywords, the other lex rules and then the other
+string literals, longest first.
+*/
+func (x *exporter) textMate(name string, scopes map[string]string) error {
+	lang := strings.ToLower(identifier(name))
+	g := &tmGrammar{
+		Schema:     tmSchema,
+		Name:       name,
+		ScopeName:  "source." + lang,
+		Repository: map[string]*tmRule{},
+	}
+	for key := range scopes {
+		lit, err := strconv.Unquote(key)
+		if x.g.GetLexRule(key) == nil && (err != nil || x.g.StringLiterals[lit] == nil) {
+			return fmt.Errorf("%s is not a token ID or string literal of the grammar", key)
+		}
+	}
+	scope := func(key, inferred string) string {
+		if s, ok := scopes[key]; ok {
+			return s
+		}
+		return inferred + "." + lang
+	}
+	add := func(key string, r *tmRule) {
+		g.Patterns = append(g.Patterns, &tmInclude{"#" + key})
+		g.Repository[key] = r
+	}
+
+	for _, r := range x.g.LexRules {
+		if r.Suppress {
+			add(r.ID(), x.tmLexRule(r, scope(r.ID(), tmCommentScope(r))))
+		}
+	}
+	words, symbols := x.tmLiterals(scope)
+	for _, grp := range words {
+		add("words."+grp.scope, &tmRule{Name: grp.scope, Match: grp.regexp(true)})
+	}
+	for _, r := range x.g.LexRules {
+		if !r.Suppress {
+			add(r.ID(), x.tmLexRule(r, scope(r.ID(), tmLexScope(r))))
+		}
+	}
+	for _, grp := range symbols {
+		add("symbols."+grp.scope, &tmRule{Name: grp.scope, Match: grp.regexp(false)})
+	}
+
+	enc := json.NewEncoder(x.w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(g)
+}
+
+/*
+tmLexRule returns the TextMate rule of lex rule r. A token that can span
+lines, e.g.: a block comment, is matched from its first string to its last
+string, because a TextMate match pattern only matches within a line. The
+repetition between the strings is matched inside the token if it cannot start
+with the last string, e.g.: the escapes of a string literal.
+*/
+func (x *exporter) tmLexRule(r *ast.LexRule, scope string) *tmRule {
+	items := lexItems(r.RegExp)
+	if !matchesNewline(items) {
+		return &tmRule{Name: scope, Match: tmItems(items)}
+	}
+	first, last := items[0], items[len(items)-1]
+	if len(items) < 3 || first.str == nil || last.str == nil {
+		x.issue(r.Pos, "token %s can span lines, which is not matched by TextMate", r.ID())
+		return &tmRule{Name: scope, Match: tmItems(items)}
+	}
+	x.issue(r.Pos, "token %s spans lines: it is matched from %s to the first %s",
+		r.ID(), string(first.str), string(last.str))
+	rule := &tmRule{Name: scope, Begin: tmString(first.str), End: tmString(last.str)}
+	if len(items) == 3 && items[1].bracket != nil && !startsWith(items[1:2], last.str[0]) {
+		switch b := items[1].bracket; b.Type {
+		case ast.LexZeroOrMore, ast.LexOneOrMore:
+			alts := make([]string, len(b.Alternates))
+			for i, re := range b.Alternates {
+				alts[i] = tmItems(lexItems(re))
+			}
+			rule.Patterns = []*tmRule{{Match: "(?:" + strings.Join(alts, "|") + ")+"}}
+		}
+	}
+	return rule
+}
+
+// startsWith returns true if a token matched by items can start with c
+func startsWith(items []*lexItem, c rune) bool {
+	for _, it := range items {
+		switch {
+		case it.str != nil:
+			return it.str[0] == c
+		case it.any:
+			return true
+		case it.set != nil:
+			return setContains(it.set, c)
+		}
+		for _, re := range it.bracket.Alternates {
+			if startsWith(lexItems(re), c) {
+				return true
+			}
+		}
+		if it.bracket.Type == ast.LexGroup || it.bracket.Type == ast.LexOneOrMore {
+			return false
+		}
+	}
+	return false
+}
+
+// setContains returns true if set contains c
+func setContains(set *charSet, c rune) bool {
+	if set.not {
+		return !set.ranges.Contains(c)
+	}
+	for _, p := range set.props {
+		if unicode.Is(unicodeTable(p), c) {
+			return true
+		}
+	}
+	return set.ranges.Contains(c)
+}
+
+// tmCommentScope returns the scope of suppressed token r
+func tmCommentScope(r *ast.LexRule) string {
+	if matchesNewline(lexItems(r.RegExp)) {
+		return "comment.block"
+	}
+	return "comment.line"
+}
+
+// tmLexScope returns the scope of lex rule r, which is inferred from the
+// first character of its tokens. The first character of a leading bracket is
+// the first character of its first alternate.
+func tmLexScope(r *ast.LexRule) string {
+	first := lexItems(r.RegExp)[0]
+	for first.bracket != nil {
+		first = lexItems(first.bracket.Alternates[0])[0]
+	}
+	switch {
+	case r.Identifier:
+		return "variable.other"
+	case first.str != nil:
+		return tmCharScope(tmQuote(first.str))
+	case first.set == nil || first.set.not:
+		return "constant.other"
+	}
+	rngs := first.set.ranges
+	digits := rngs.Subset(runeset.NewRanges(runeset.Range{Lo: '0', Hi: '9'}))
+	if len(first.set.props) > 0 {
+		if digits && numericProps(first.set.props) {
+			return "constant.numeric"
+		}
+		return "variable.other"
+	}
+	if digits {
+		return "constant.numeric"
+	}
+	if len(rngs) == 1 && rngs[0].Lo == rngs[0].Hi {
+		return tmCharScope(rngs[0].Lo)
+	}
+	for _, rng := range rngs {
+		for c := rng.Lo; c <= rng.Hi; c++ {
+			if c == '_' || unicode.IsLetter(c) {
+				return "variable.other"
+			}
+		}
+	}
+	return "constant.other"
+}
+
+// numericProps returns true if every Unicode category in props is a number
+// category, e.g.: N or Nd
+func numericProps(props []string) bool {
+	for _, p := range props {
+		if _, ok := unicode.Categories[p]; !ok || p[0] != 'N' {
+			return false
+		}
+	}
+	return true
+}
+
+// tmQuote returns the first quote of str, e.g.: " of i"...", or the first
+// character of str if it has no quote
+func tmQuote(str []rune) rune {
+	for _, c := range str {
+		if strings.ContainsRune("\"'`", c) {
+			return c
+		}
+	}
+	return str[0]
+}
+
+// tmCharScope returns the scope of a token that starts with c
+func tmCharScope(c rune) string {
+	switch {
+	case c == '"':
+		return "string.quoted.double"
+	case c == '\'':
+		return "string.quoted.single"
+	case c == '`':
+		return "string.quoted.other"
+	case unicode.IsDigit(c):
+		return "constant.numeric"
+	case c == '_' || unicode.IsLetter(c):
+		return "variable.other"
+	}
+	return "constant.other"
+}
+
+// tmGroup is a group of string literals with the same scope
+type tmGroup struct {
+	scope string
+	lits  []*ast.StringLit
+}
+
+/*
+tmLiterals returns the string literals of the syntax rules grouped by scope.
+The literals that are words are matched as keywords and the other literals
+as symbols. The literals of a group are sorted longest first and the symbol
+groups by their longest literal.
+*/
+func (x *exporter) tmLiterals(scope func(key, inferred string) string) (words, symbols []*tmGroup) {
+	wordGroups, symbolGroups := map[string]*tmGroup{}, map[string]*tmGroup{}
+	for _, id := range x.g.GetStringLiterals() {
+		sl := x.g.StringLiterals[id]
+		groups, inferred := symbolGroups, "keyword.operator"
+		switch {
+		case isIdentifier(id):
+			groups, inferred = wordGroups, "keyword.control"
+		case strings.Trim(id, ",;.") == "":
+			inferred = "punctuation.separator"
+		case strings.Trim(id, "()[]{}") == "":
+			inferred = "punctuation.section"
+		}
+		s := scope(strconv.Quote(id), inferred)
+		if groups[s] == nil {
+			groups[s] = &tmGroup{scope: s}
+		}
+		groups[s].lits = append(groups[s].lits, sl)
+	}
+	return sortGroups(wordGroups), sortGroups(symbolGroups)
+}
+
+func sortGroups(groups map[string]*tmGroup) []*tmGroup {
+	var grps []*tmGroup
+	for _, grp := range groups {
+		sort.SliceStable(grp.lits, func(i, j int) bool {
+			return utf8.RuneCountInString(grp.lits[i].ID()) > utf8.RuneCountInString(grp.lits[j].ID())
+		})
+		grps = append(grps, grp)
+	}
+	sort.Slice(grps, func(i, j int) bool {
+		li := utf8.RuneCountInString(grps[i].lits[0].ID())
+		lj := utf8.RuneCountInString(grps[j].lits[0].ID())
+		if li != lj {
+			return li > lj
+		}
+		return grps[i].scope < grps[j].scope
+	})
+	return grps
+}
+
+// regexp returns the regular expression of the literals of grp. Words are
+// matched at word boundaries.
+func (grp *tmGroup) regexp(words bool) string {
+	alts := make([]string, len(grp.lits))
+	for i, sl := range grp.lits {
+		alts[i] = tmString(sl.Value())
+		if sl.CaseInsensitive {
+			alts[i] = "(?i:" + alts[i] + ")"
+		}
+	}
+	re := strings.Join(alts, "|")
+	if words {
+		return `\b(?:` + re + `)\b`
+	}
+	if len(alts) > 1 {
+		re = "(?:" + re + ")"
+	}
+	return re
+}
+
+// matchesNewline returns true if items can match a new line
+func matchesNewline(items []*lexItem) bool {
+	for _, it := range items {
+		switch {
+		case it.str != nil:
+			if strings.ContainsRune(string(it.str), '\n') {
+				return true
+			}
+		case it.any:
+			return true
+		case it.set != nil:
+			if setContains(it.set, '\n') {
+				return true
+			}
+		default:
+			for _, re := range it.bracket.Alternates {
+				if matchesNewline(lexItems(re)) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// tmItems returns the Oniguruma regular expression of items
+func tmItems(items []*lexItem) string {
+	w := new(strings.Builder)
+	for _, it := range items {
+		switch {
+		case it.str != nil:
+			w.WriteString(tmString(it.str))
+		case it.any:
+			w.WriteString(`[\s\S]`)
+		case it.set != nil && it.set.not:
+			w.WriteString("[^" + classBody(it.set, tmSetChar) + "]")
+		case it.set != nil:
+			w.WriteString("[" + classBody(it.set, tmSetChar) + "]")
+		default:
+			alts := make([]string, len(it.bracket.Alternates))
+			for i, re := range it.bracket.Alternates {
+				alts[i] = tmItems(lexItems(re))
+			}
+			w.WriteString("(?:" + strings.Join(alts, "|") + ")" + suffixes[it.bracket.Type])
+		}
+	}
+	return w.String()
+}
+
+// tmString returns the regular expression that matches str
+func tmString(str []rune) string {
+	w := new(strings.Builder)
+	for _, r := range str {
+		if strings.ContainsRune(`\^$.|?*+()[]{}/`, r) {
+			w.WriteString(`\` + string(r))
+		} else {
+			w.WriteString(tmChar(r))
+		}
+	}
+	return w.String()
+}
+
+func tmSetChar(r rune) string {
+	if strings.ContainsRune(`\]^-[`, r) {
+		return `\` + string(r)
+	}
+	return tmChar(r)
+}
+
+func tmChar(r rune) string {
+	switch {
+	case r == '\n':
+		return `\n`
+	case r == '\r':
+		return `\r`
+	case r == '\t':
+		return `\t`
+	case isPrint(r) || r > '~' && unicode.IsGraphic(r):
+		return string(r)
+	}
+	return fmt.Sprintf(`\x{%X}`, r)
+}
@@ -0,0 +1,240 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package export
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/goccmack/gogll/v3/ast"
+)
+
+// tsTokens is the start rule of a grammar without syntax rules
+const tsTokens = "source_file"
+
+/*
+treeSitter writes the grammar as a tree-sitter grammar.js. The rule of the
+default start symbol is the first rule. The suppressed tokens and white space
+are extras and the first identifier lex rule is the word token, which is used
+by tree-sitter to recognise the keywords. Labels are fields. The nonterminals
+of each LR(1) conflict of the grammar are declared in conflicts, which
+tree-sitter parses with GLR and resolves by choosing one parse.
+
+The start rule of a grammar without syntax rules is source_file, which is
+any sequence of the tokens.
+*/
+func (x *exporter) treeSitter(name string) {
+	fmt.Fprintf(x.w, "// Package %s\nmodule.exports = grammar({\n  name: '%s',\n",
+		x.g.Package.GetString(), identifier(name))
+
+	x.w.WriteString("\n  extras: $ => [\n    /\\s/,\n")
+	for _, r := range x.g.LexRules {
+		if r.Suppress {
+			fmt.Fprintf(x.w, "    $.%s,\n", r.ID())
+		}
+	}
+	x.w.WriteString("  ],\n")
+
+	word := false
+	for _, r := range x.g.LexRules {
+		if !r.Identifier {
+			continue
+		}
+		if word {
+			x.issue(r.Pos, "tree-sitter has one word token: the keywords of identifier token %s are not recognised", r.ID())
+			continue
+		}
+		word = true
+		fmt.Fprintf(x.w, "\n  word: $ => $.%s,\n", r.ID())
+	}
+
+	if conflicts := x.conflicts(); len(conflicts) > 0 {
+		x.w.WriteString("\n  conflicts: $ => [\n")
+		for _, nts := range conflicts {
+			refs := make([]string, len(nts))
+			for i, nt := range nts {
+				refs[i] = "$." + nt
+			}
+			fmt.Fprintf(x.w, "    [%s],\n", strings.Join(refs, ", "))
+			x.issue(x.g.GetSyntaxRule(nts[0]).Pos,
+				"LR(1) conflict in %s: tree-sitter chooses one parse of an ambiguous input", strings.Join(nts, ", "))
+		}
+		x.w.WriteString("  ],\n")
+	}
+
+	x.w.WriteString("\n  rules: {\n")
+	rules := x.syntaxRules()
+	if len(rules) == 0 {
+		var toks []string
+		for _, r := range x.g.LexRules {
+			if !r.Suppress {
+				toks = append(toks, "$."+r.ID())
+			}
+		}
+		fmt.Fprintf(x.w, "    %s: $ => repeat(%s),\n\n", tsTokens, tsChoice(toks, "      "))
+	}
+	if starts := x.g.StartSymbols(); len(starts) > 1 {
+		for _, s := range starts[1:] {
+			x.issue(x.g.GetSyntaxRule(s).Pos, "tree-sitter has one start symbol: %s is not a start symbol", s)
+		}
+	}
+	for i, r := range rules {
+		x.reportNames(r, false)
+		if i > 0 && x.nullable(r.ID()) {
+			x.issue(r.Pos, "%s matches the empty string, which tree-sitter only allows for the start rule", r.ID())
+		}
+		alts := make([]string, len(r.Alternates))
+		for j, a := range r.Alternates {
+			alts[j] = x.tsAlternate(a)
+		}
+		fmt.Fprintf(x.w, "    %s: $ => %s,\n\n", r.ID(), tsChoice(alts, "      "))
+	}
+	for _, r := range x.g.LexRules {
+		fmt.Fprintf(x.w, "    %s: $ => token(%s),\n\n", r.ID(), tsItems(lexItems(r.RegExp)))
+	}
+	x.w.Truncate(x.w.Len() - 1)
+	x.w.WriteString("  },\n});\n")
+}
+
+func (x *exporter) tsAlternate(a *ast.SyntaxAlternate) string {
+	if a.Empty() {
+		return "blank()"
+	}
+	syms := make([]string, len(a.Symbols))
+	for i, sym := range a.Symbols {
+		switch sym := sym.(type) {
+		case *ast.StringLit:
+			if sl := x.g.StringLiterals[sym.ID()]; sl.CaseInsensitive {
+				syms[i] = fmt.Sprintf("alias(token(%s), %s)", tsItems(literalItems(sl)), jsString([]rune(sym.ID())))
+			} else {
+				syms[i] = jsString([]rune(sym.ID()))
+			}
+		default:
+			syms[i] = "$." + sym.ID()
+		}
+		if l := a.Label(i); l != "" {
+			syms[i] = fmt.Sprintf("field('%s', %s)", l, syms[i])
+		}
+	}
+	return tsSeq(syms)
+}
+
+// tsChoice returns the choice of alts. The alternates of a choice are written
+// on separate lines with indent.
+func tsChoice(alts []string, indent string) string {
+	if len(alts) == 1 {
+		return alts[0]
+	}
+	w := new(strings.Builder)
+	w.WriteString("choice(\n")
+	for _, a := range alts {
+		fmt.Fprintf(w, "%s%s,\n", indent, a)
+	}
+	w.WriteString(indent[2:] + ")")
+	return w.String()
+}
+
+func tsSeq(s []string) string {
+	if len(s) == 1 {
+		return s[0]
+	}
+	return "seq(" + strings.Join(s, ", ") + ")"
+}
+
+func tsItems(items []*lexItem) string {
+	s := make([]string, len(items))
+	for i, it := range items {
+		switch {
+		case it.str != nil:
+			s[i] = jsString(it.str)
+		case it.any:
+			s[i] = `/[\s\S]/`
+		case it.set != nil:
+			s[i] = tsRegExp(it.set)
+		default:
+			alts := make([]string, len(it.bracket.Alternates))
+			for j, re := range it.bracket.Alternates {
+				alts[j] = tsItems(lexItems(re))
+			}
+			s[i] = alts[0]
+			if len(alts) > 1 {
+				s[i] = "choice(" + strings.Join(alts, ", ") + ")"
+			}
+			switch it.bracket.Type {
+			case ast.LexOptional:
+				s[i] = "optional(" + s[i] + ")"
+			case ast.LexZeroOrMore:
+				s[i] = "repeat(" + s[i] + ")"
+			case ast.LexOneOrMore:
+				s[i] = "repeat1(" + s[i] + ")"
+			}
+		}
+	}
+	return tsSeq(s)
+}
+
+/*
+tsRegExp returns the JavaScript regular expression of set. The characters
+outside the Basic Multilingual Plane are written as \u{<hex code point>},
+which requires the u flag.
+*/
+func tsRegExp(set *charSet) string {
+	flags := ""
+	if n := len(set.ranges); n > 0 && set.ranges[n-1].Hi > 0xffff {
+		flags = "u"
+	}
+	not := ""
+	if set.not {
+		not = "^"
+	}
+	return "/[" + not + classBody(set, tsSetChar) + "]/" + flags
+}
+
+func tsSetChar(r rune) string {
+	switch {
+	case strings.ContainsRune(`\]-[^`, r):
+		return `\` + string(r)
+	case isPrint(r):
+		return string(r)
+	case r > 0xffff:
+		return fmt.Sprintf(`\u{%X}`, r)
+	}
+	return fmt.Sprintf(`\u%04X`, r)
+}
+
+// jsString returns str as a JavaScript string literal
+func jsString(str []rune) string {
+	w := new(strings.Builder)
+	w.WriteString("'")
+	for _, r := range str {
+		switch {
+		case r == '\\' || r == '\'':
+			w.WriteString(`\` + string(r))
+		case r == '\n':
+			w.WriteString(`\n`)
+		case r == '\r':
+			w.WriteString(`\r`)
+		case r == '\t':
+			w.WriteString(`\t`)
+		case isPrint(r) || r > '~' && unicode.IsGraphic(r):
+			w.WriteRune(r)
+		default:
+			fmt.Fprintf(w, `\u{%X}`, r)
+		}
+	}
+	w.WriteString("'")
+	return w.String()
+}
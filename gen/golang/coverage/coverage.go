@@ -0,0 +1,143 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package coverage generates the coverage package of a lexer and parser
+// generated with -coverage, which records the completed alternates and the
+// scanned tokens.
+package coverage
+
+import (
+	"bytes"
+	"text/template"
+
+	"github.com/goccmack/gogll/v3/coverage"
+	"github.com/goccmack/goutil/ioutil"
+)
+
+type Data struct {
+	Header string
+}
+
+func Gen(fname string) {
+	tmpl, err := template.New("coverage").Parse(src)
+	if err != nil {
+		panic(err)
+	}
+	buf := new(bytes.Buffer)
+	if err = tmpl.Execute(buf, &Data{Header: coverage.Header}); err != nil {
+		panic(err)
+	}
+	if err = ioutil.WriteFile(fname, buf.Bytes()); err != nil {
+		panic(err)
+	}
+}
+
+const src = `// Package coverage is generated by gogll. Do not edit.
+
+/*
+Package coverage records the grammar alternates completed by the parser and
+the tokens scanned by the lexer. Recording is always on.
+
+WriteFile writes the coverage profile, which is reported by:
+
+	gogll coverage -g <grammar file> <profile>...
+*/
+package coverage
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+)
+
+type alternate struct {
+	nt    string
+	index int
+}
+
+var (
+	mu         sync.Mutex
+	alternates = map[alternate]int{}
+	tokens     = map[string]int{}
+)
+
+// Alternate records the completion of alternate index of the syntax rule of nt
+func Alternate(nt string, index int) {
+	mu.Lock()
+	alternates[alternate{nt, index}]++
+	mu.Unlock()
+}
+
+// Token records the scanning of the token with ID id
+func Token(id string) {
+	mu.Lock()
+	tokens[id]++
+	mu.Unlock()
+}
+
+// Reset clears the recorded coverage
+func Reset() {
+	mu.Lock()
+	alternates = map[alternate]int{}
+	tokens = map[string]int{}
+	mu.Unlock()
+}
+
+// Write writes the coverage profile to w
+func Write(w io.Writer) error {
+	mu.Lock()
+	defer mu.Unlock()
+	alts := make([]alternate, 0, len(alternates))
+	for alt := range alternates {
+		alts = append(alts, alt)
+	}
+	sort.Slice(alts, func(i, j int) bool {
+		if alts[i].nt != alts[j].nt {
+			return alts[i].nt < alts[j].nt
+		}
+		return alts[i].index < alts[j].index
+	})
+	toks := make([]string, 0, len(tokens))
+	for id := range tokens {
+		toks = append(toks, id)
+	}
+	sort.Strings(toks)
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "{{.Header}}")
+	for _, alt := range alts {
+		fmt.Fprintf(bw, "alt %s %d %d\n", alt.nt, alt.index, alternates[alt])
+	}
+	for _, id := range toks {
+		fmt.Fprintf(bw, "tok %q %d\n", id, tokens[id])
+	}
+	return bw.Flush()
+}
+
+// WriteFile writes the coverage profile to file fname
+func WriteFile(fname string) error {
+	f, err := os.Create(fname)
+	if err != nil {
+		return err
+	}
+	if err := Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+`
@@ -259,6 +259,9 @@ func (s *Set) insert(bsr bsr) {
     }
     switch b := bsr.(type) {
     case BSR:
+        if s.slotEntries[b] {
+            return
+        }
         s.slotEntries[b] = true
         nt := ntSlot{b.Label.Head(), b.leftExtent, b.rightExtent}
         s.ntSlotEntries[nt] = append(s.ntSlotEntries[nt], b)
@@ -413,6 +416,24 @@ func (b BSR) Pivot() int {
     return b.pivot
 }
 
+/*
+Old returns the BSR in old, the BSR set parsed before the edit e of the input,
+with the grammar slot of b and the extents of b before the edit. ok is false
+if the extents of b overlap the tokens re-lexed by e or if old does not 
+contain such a BSR.
+
+The subtree of the old BSR is the same as the subtree of b. Results computed
+from the old BSR, e.g.: AST nodes, can be reused for b.
+*/
+func (b BSR) Old(old *Set, e *lexer.Edit) (ob BSR, ok bool) {
+    lext, rext, ok := e.Unchanged(b.leftExtent, b.rightExtent)
+    if !ok {
+        return BSR{}, false
+    }
+    ob = BSR{b.Label, lext, lext + b.pivot - b.leftExtent, rext, old}
+    return ob, old.slotEntries[ob]
+}
+
 func (b BSR) String() string {
     srcStr := "ℇ"
     if b.leftExtent < b.rightExtent {
@@ -591,13 +612,43 @@ type bldSPPF struct {
     extLeafNodes []sppf.Node
     pNodes       map[string]*sppf.PackedNode
     sNodes       map[string]*sppf.SymbolNode // Index is Node.Label()
+
+    // Reuse of the SPPF parsed before an edit of the input
+    edit     *lexer.Edit
+    oldNodes map[string]*sppf.SymbolNode // Index is Node.Label() before the edit
+    moved    map[sppf.Node]bool
 }
 
+// ToSPPF returns the root of the Shared Packed Parse Forest of the BSR set
 func (pf *Set) ToSPPF() *sppf.SymbolNode {
-    bld := &bldSPPF{
+    return pf.toSPPF(&bldSPPF{
         pNodes: map[string]*sppf.PackedNode{},
         sNodes: map[string]*sppf.SymbolNode{},
+    })
+}
+
+/*
+ToSPPFEdit returns the root of the Shared Packed Parse Forest of the BSR set 
+parsed after the edit e of the input. old is the root of the SPPF parsed
+before the edit.
+
+The NT subtrees of old whose extents lie outside the tokens re-lexed by e are
+reused: their nodes are moved to their extents after the edit. 
+old must not be used after the call.
+*/
+func (pf *Set) ToSPPFEdit(old *sppf.SymbolNode, e *lexer.Edit) *sppf.SymbolNode {
+    bld := &bldSPPF{
+        pNodes:   map[string]*sppf.PackedNode{},
+        sNodes:   map[string]*sppf.SymbolNode{},
+        edit:     e,
+        oldNodes: map[string]*sppf.SymbolNode{},
+        moved:    map[sppf.Node]bool{},
     }
+    bld.indexOld(old, map[sppf.Node]bool{})
+    return pf.toSPPF(bld)
+}
+
+func (pf *Set) toSPPF(bld *bldSPPF) *sppf.SymbolNode {
     rt := pf.GetRoots()[0]
     bld.root = bld.mkSN(rt.Label.Head().String(), rt.leftExtent, rt.rightExtent)
 
@@ -698,6 +749,10 @@ func (bld *bldSPPF) mkSN(symbol string, lext, rext int) *sppf.SymbolNode {
 	if sn1, exist := bld.sNodes[sn.Label()]; exist {
 		return sn1
 	}
+	if old := bld.getOld(sn); old != nil {
+		bld.move(old)
+		return old
+	}
 	bld.sNodes[sn.Label()] = sn
 	if symbols.IsNT(symbol) {
 		bld.extLeafNodes = append(bld.extLeafNodes, sn)
@@ -705,6 +760,83 @@ func (bld *bldSPPF) mkSN(symbol string, lext, rext int) *sppf.SymbolNode {
 	return sn
 }
 
+// getOld returns the NT node of the SPPF before the edit that is unchanged
+// by the edit and has the extents of sn after the edit.
+func (bld *bldSPPF) getOld(sn *sppf.SymbolNode) *sppf.SymbolNode {
+	if bld.edit == nil || !symbols.IsNT(sn.Symbol) {
+		return nil
+	}
+	lext, rext, ok := bld.edit.Unchanged(sn.Lext, sn.Rext)
+	if !ok {
+		return nil
+	}
+	old := &sppf.SymbolNode{Symbol: sn.Symbol, Lext: lext, Rext: rext}
+	return bld.oldNodes[old.Label()]
+}
+
+// indexOld adds the symbol nodes of the SPPF rooted at n to bld.oldNodes
+func (bld *bldSPPF) indexOld(n sppf.Node, done map[sppf.Node]bool) {
+	if n == nil || done[n] {
+		return
+	}
+	done[n] = true
+	switch n1 := n.(type) {
+	case *sppf.SymbolNode:
+		bld.oldNodes[n1.Label()] = n1
+		for _, c := range n1.Children {
+			bld.indexOld(c, done)
+		}
+	case *sppf.IntermediateNode:
+		for _, c := range n1.Children {
+			bld.indexOld(c, done)
+		}
+	case *sppf.PackedNode:
+		bld.indexOld(n1.LeftChild, done)
+		bld.indexOld(n1.RightChild, done)
+	}
+}
+
+// move moves the nodes of the subtree rooted at n to their extents after the
+// edit and adds them to the SPPF under construction.
+func (bld *bldSPPF) move(n sppf.Node) {
+	if n == nil || bld.moved[n] {
+		return
+	}
+	bld.moved[n] = true
+	switch n1 := n.(type) {
+	case *sppf.SymbolNode:
+		n1.Lext, n1.Rext = bld.moveExtents(n1.Lext, n1.Rext)
+		bld.sNodes[n1.Label()] = n1
+		for _, c := range n1.Children {
+			bld.move(c)
+		}
+	case *sppf.IntermediateNode:
+		n1.Lext, n1.Rext = bld.moveExtents(n1.Lext, n1.Rext)
+		for _, c := range n1.Children {
+			bld.move(c)
+		}
+	case *sppf.PackedNode:
+		n1.Pivot += bld.moveExtent(n1.Lext)
+		n1.Lext, n1.Rext = bld.moveExtents(n1.Lext, n1.Rext)
+		bld.pNodes[n1.Label()] = n1
+		bld.move(n1.LeftChild)
+		bld.move(n1.RightChild)
+	}
+}
+
+// moveExtent returns the change of an extent before the edit, which lies
+// outside the re-lexed tokens
+func (bld *bldSPPF) moveExtent(ext int) int {
+	if ext < bld.edit.First {
+		return 0
+	}
+	return bld.edit.NumNew - bld.edit.NumOld
+}
+
+func (bld *bldSPPF) moveExtents(lext, rext int) (int, int) {
+	return lext + bld.moveExtent(lext), rext + bld.moveExtent(lext)
+}
+
 func slotString(nt symbols.NT, body symbols.Symbols, pos int) string {
     w := new(bytes.Buffer)
     fmt.Fprintf(w, "%s:", nt)
@@ -0,0 +1,201 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package bsr
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"text/template"
+	"unicode"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/goutil/ioutil"
+)
+
+// reserved contains the identifiers of the bsr package, which cannot be used
+// as alternate names. They include the field and the methods of BSR, which
+// are promoted to the named alternate types and cannot be used as labels.
+var reserved = map[string]bool{
+	"Alternate":        true,
+	"BSR":              true,
+	"BSRs":             true,
+	"GetAllNTChildren": true,
+	"GetNTChild":       true,
+	"GetNTChildI":      true,
+	"GetNTChildren":    true,
+	"GetNTChildrenI":   true,
+	"GetTChildI":       true,
+	"Label":            true,
+	"LeftExtent":       true,
+	"New":              true,
+	"Old":              true,
+	"Pivot":            true,
+	"RightExtent":      true,
+	"Set":              true,
+	"String":           true,
+}
+
+type labelsData struct {
+	Package    string
+	Alternates []*altData
+	// TokenLabels is true if any labelled symbol is a terminal
+	TokenLabels bool
+}
+
+type altData struct {
+	NT, Name string
+	Index    int
+	Comment  string
+	Labels   []*labelData
+}
+
+type labelData struct {
+	Label, Method string
+	Pos           int
+	NT            bool
+}
+
+/*
+GenLabels generates the named alternate constants and the accessors of the
+named alternates and labelled symbols of g in the bsr package. If g has no
+named alternates labelsFile is removed.
+*/
+func GenLabels(labelsFile string, g *ast.GoGLL) {
+	data, err := getLabelsData(g)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		os.Exit(1)
+	}
+	if len(data.Alternates) == 0 {
+		os.Remove(labelsFile)
+		return
+	}
+	tmpl, err := template.New("labels").Parse(labelsTmpl)
+	if err != nil {
+		panic(err)
+	}
+	buf := new(bytes.Buffer)
+	if err = tmpl.Execute(buf, data); err != nil {
+		panic(err)
+	}
+	if err = ioutil.WriteFile(labelsFile, buf.Bytes()); err != nil {
+		panic(err)
+	}
+}
+
+/*
+getLabelsData returns the template data of g. It returns an error if an
+alternate name is reserved, or if the accessor method of a label is reserved
+or is a method generated for a named alternate.
+*/
+func getLabelsData(g *ast.GoGLL) (*labelsData, error) {
+	data := &labelsData{
+		Package: g.Package.GetString(),
+	}
+	// altMethods contains the methods of BSR generated for the named alternates
+	altMethods := map[string]bool{}
+	for _, r := range g.SyntaxRules {
+		for _, a := range r.Alternates {
+			if a.Name != "" {
+				altMethods[a.Name], altMethods["Is"+a.Name] = true, true
+			}
+		}
+	}
+	for _, r := range g.SyntaxRules {
+		for i, a := range r.Alternates {
+			if a.Name == "" {
+				continue
+			}
+			if reserved[a.Name] {
+				return nil, fmt.Errorf("alternate name %s of %s is reserved", a.Name, r.ID())
+			}
+			alt := &altData{
+				NT:      r.ID(),
+				Name:    a.Name,
+				Index:   i,
+				Comment: ast.AlternateString(r.ID(), a),
+			}
+			for j, sym := range a.Symbols {
+				if a.Label(j) == "" {
+					continue
+				}
+				method := toUpperFirst(a.Label(j))
+				if reserved[method] || altMethods[method] {
+					return nil, fmt.Errorf("label %s of alternate %s of %s is reserved: %s is a field or method of BSR",
+						a.Label(j), a.Name, r.ID(), method)
+				}
+				_, isNT := sym.(*ast.NT)
+				alt.Labels = append(alt.Labels, &labelData{
+					Label:  a.Label(j),
+					Method: method,
+					Pos:    j,
+					NT:     isNT,
+				})
+				data.TokenLabels = data.TokenLabels || !isNT
+			}
+			data.Alternates = append(data.Alternates, alt)
+		}
+	}
+	return data, nil
+}
+
+func toUpperFirst(s string) string {
+	rs := []rune(s)
+	rs[0] = unicode.ToUpper(rs[0])
+	return string(rs)
+}
+
+const labelsTmpl = `// Package bsr is generated by gogll. Do not edit.
+
+package bsr
+
+import (
+	"fmt"
+
+	"{{.Package}}/parser/symbols"{{if .TokenLabels}}
+	"{{.Package}}/token"{{end}}
+)
+
+// Named alternates
+const ({{range $alt := .Alternates}}
+	// {{$alt.Comment}}
+	{{$alt.NT}}_{{$alt.Name}} = {{$alt.Index}}{{end}}
+)
+{{range $alt := .Alternates}}
+// {{$alt.Name}} is alternate #{{$alt.Name}}: {{$alt.Comment}}
+type {{$alt.Name}} struct {
+	BSR
+}
+
+// Is{{$alt.Name}} returns true if b is alternate #{{$alt.Name}} of {{$alt.NT}}
+func (b BSR) Is{{$alt.Name}}() bool {
+	return b.Label.Head() == symbols.NT_{{$alt.NT}} && b.Alternate() == {{$alt.NT}}_{{$alt.Name}}
+}
+
+// {{$alt.Name}} returns b as alternate #{{$alt.Name}} of {{$alt.NT}}.
+// {{$alt.Name}} panics if b is not alternate #{{$alt.Name}}.
+func (b BSR) {{$alt.Name}}() {{$alt.Name}} {
+	if !b.Is{{$alt.Name}}() {
+		panic(fmt.Sprintf("%s is not alternate #{{$alt.Name}} of {{$alt.NT}}", b))
+	}
+	return {{$alt.Name}}{b}
+}
+{{range $l := $alt.Labels}}
+// {{$l.Method}} returns the symbol labelled {{$l.Label}}
+func (a {{$alt.Name}}) {{$l.Method}}() {{if $l.NT}}BSR{{else}}*token.Token{{end}} {
+	return a.{{if $l.NT}}GetNTChildI{{else}}GetTChildI{{end}}({{$l.Pos}})
+}
+{{end}}{{end}}`
@@ -0,0 +1,77 @@
+package bsr
+
+import (
+	"testing"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/lexer"
+	"github.com/goccmack/gogll/v3/parser"
+)
+
+func build(t *testing.T, src string) *ast.GoGLL {
+	lex := lexer.New([]rune(src))
+	bsr, errs := parser.Parse(lex)
+	if errs != nil {
+		t.Fatal(errs[0])
+	}
+	g, err := ast.TryBuild(bsr.GetRoot(), lex, "g.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestLabelMethods(t *testing.T) {
+	data, err := getLabelsData(build(t, `package "g"
+S : left:id right:T #Pair ;
+T : "x" ;
+id : letter ;
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var methods []string
+	for _, l := range data.Alternates[0].Labels {
+		methods = append(methods, l.Method)
+	}
+	if len(methods) != 2 || methods[0] != "Left" || methods[1] != "Right" {
+		t.Errorf("expected methods Left Right, got %v", methods)
+	}
+}
+
+func TestReservedNames(t *testing.T) {
+	for _, tst := range []struct {
+		src, err string
+	}{
+		{`package "g"
+S : "x" #String ;
+`, "alternate name String of S is reserved"},
+		{`package "g"
+S : string:id bSR:id #Pair ;
+id : letter ;
+`, "label string of alternate Pair of S is reserved: String is a field or method of BSR"},
+		{`package "g"
+S : id bSR:id #Pair ;
+id : letter ;
+`, "label bSR of alternate Pair of S is reserved: BSR is a field or method of BSR"},
+		{`package "g"
+S : leftExtent:id #Pair ;
+id : letter ;
+`, "label leftExtent of alternate Pair of S is reserved: LeftExtent is a field or method of BSR"},
+		{`package "g"
+S : isPair:id #Pair | "x" #Other ;
+id : letter ;
+`, "label isPair of alternate Pair of S is reserved: IsPair is a field or method of BSR"},
+		{`package "g"
+S : other:id #Pair | "x" #Other ;
+id : letter ;
+`, "label other of alternate Pair of S is reserved: Other is a field or method of BSR"},
+	} {
+		_, err := getLabelsData(build(t, tst.src))
+		if err == nil {
+			t.Errorf("expected error: %s", tst.err)
+		} else if err.Error() != tst.err {
+			t.Errorf("expected error: %s\ngot: %s", tst.err, err)
+		}
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"text/template"
 
 	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/cfg"
 	"github.com/goccmack/gogll/v3/gslot"
 )
 
@@ -35,11 +36,13 @@ func (g *gen) genAlternatesCode() string {
 
 type AltData struct {
 	NT         string
+	Alt        int
 	AltLabel   string
 	AltComment string
 	Empty      bool
 	Slots      []*SlotData
 	LastSlot   *SlotData
+	Coverage   bool
 }
 
 func (g *gen) getAlternateCode(nt string, alt *ast.SyntaxAlternate, altI int) string {
@@ -60,9 +63,11 @@ func (g *gen) getAltData(nt string, alt *ast.SyntaxAlternate, altI int) *AltData
 	L := gslot.NewLabel(nt, altI, 0, g.gs, g.ff)
 	d := &AltData{
 		NT:         nt,
+		Alt:        altI,
 		AltLabel:   L.Label(),
 		AltComment: L.String(),
 		Empty:      alt.Empty(),
+		Coverage:   *cfg.Coverage,
 	}
 	if !alt.Empty() {
 		d.Slots = g.getSlotsData(nt, alt, altI)
@@ -114,7 +119,8 @@ const altCodeTmpl = `		case slot.{{.AltLabel}}: // {{.AltComment}}{{if .Empty}}
 case slot.{{$slot.PostLabel}}: // {{$slot.Comment}} 
 			{{else}}p.bsrSet.Add(slot.{{$slot.PostLabel}}, cU, p.cI, p.cI+1)
 			p.cI++ {{end}}{{end}}{{end}}
-			if p.follow(symbols.NT_{{.NT}}) {
+			if p.follow(symbols.NT_{{.NT}}) {{"{"}}{{if .Coverage}}
+				coverage.Alternate("{{.NT}}", {{.Alt}}){{end}}
 				p.rtn(symbols.NT_{{.NT}}, cU, p.cI)
 			} else { 
 				p.parseError(slot.{{.AltLabel}}, p.cI, followSets[symbols.NT_{{.NT}}])
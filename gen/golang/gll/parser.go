@@ -30,6 +30,7 @@ import (
 	"github.com/goccmack/gogll/v3/gen/golang/gll/slots"
 	"github.com/goccmack/gogll/v3/gen/golang/gll/sppf"
 	"github.com/goccmack/gogll/v3/gen/golang/gll/symbols"
+	"github.com/goccmack/gogll/v3/gen/golang/gll/visitor"
 	"github.com/goccmack/gogll/v3/gslot"
 	"github.com/goccmack/goutil/ioutil"
 )
@@ -46,10 +47,19 @@ func Gen(g *ast.GoGLL, gs *gslot.GSlot, ff *frstflw.FF) {
 	parserDir := path.Join(cfg.BaseDir, "parser")
 	gn := &gen{g, gs, ff}
 	gn.genParser(parserDir)
+	GenBSR(g, gs, ff)
+}
+
+// GenBSR generates the bsr, slot, sppf, symbols and visitor packages, which
+// are shared by the GLL and the GLR parsers.
+func GenBSR(g *ast.GoGLL, gs *gslot.GSlot, ff *frstflw.FF) {
+	parserDir := path.Join(cfg.BaseDir, "parser")
 	bsr.Gen(filepath.Join(parserDir, "bsr", "bsr.go"), g.Package.GetString())
+	bsr.GenLabels(filepath.Join(parserDir, "bsr", "labels.go"), g)
 	slots.Gen(filepath.Join(parserDir, "slot", "slot.go"), g, gs, ff)
 	sppf.Gen(filepath.Join(cfg.BaseDir, "sppf", "sppf.go"), g.Package.GetString())
 	symbols.Gen(filepath.Join(parserDir, "symbols", "symbols.go"), g)
+	visitor.Gen(filepath.Join(parserDir, "visitor", "visitor.go"), g)
 }
 
 func (g *gen) genParser(parserDir string) {
@@ -74,18 +84,22 @@ func (g *gen) genParser(parserDir string) {
 }
 
 type Data struct {
-	Package     string
-	StartSymbol string
-	CodeX       string
-	TestSelect  string
+	Package      string
+	StartSymbol  string
+	StartSymbols []string
+	CodeX        string
+	TestSelect   string
+	Coverage     bool
 }
 
 func (g *gen) getData(baseDir string) *Data {
 	data := &Data{
-		Package:     g.g.Package.GetString(),
-		StartSymbol: g.g.StartSymbol(),
-		CodeX:       g.genAlternatesCode(),
-		TestSelect:  g.genTestSelect(),
+		Package:      g.g.Package.GetString(),
+		StartSymbol:  g.g.StartSymbol(),
+		StartSymbols: g.g.StartSymbols(),
+		CodeX:        g.genAlternatesCode(),
+		TestSelect:   g.genTestSelect(),
+		Coverage:     *cfg.Coverage,
 	}
 	return data
 }
@@ -105,7 +119,8 @@ import(
 	"sort"
 	"strings"
 
-	"{{.Package}}/parser/bsr"
+	"{{.Package}}/parser/bsr"{{if .Coverage}}
+	"{{.Package}}/coverage"{{end}}
 	"{{.Package}}/lexer"
 	"{{.Package}}/parser/bsr"
 	"{{.Package}}/parser/slot"
@@ -126,10 +141,17 @@ type parser struct {
 	lex         *lexer.Lexer
 	parseErrors []*Error
 
+	start  symbols.NT
 	bsrSet *bsr.Set
 }
 
-func newParser(l *lexer.Lexer) *parser {
+// StartSymbols contains the start symbols of the grammar, which can be parsed
+// by ParseFrom. The first is the default start symbol, which is parsed by Parse.
+var StartSymbols = []symbols.NT{ {{range $nt := .StartSymbols}}
+	symbols.NT_{{$nt}},{{end}}
+}
+
+func newParser(start symbols.NT, l *lexer.Lexer) *parser {
 	return &parser{
 		cI:     0,
 		lex:    l,
@@ -137,24 +159,38 @@ func newParser(l *lexer.Lexer) *parser {
 		U:      &descriptors{},
 		popped: make(map[poppedNode]bool),
 		crf: map[clusterNode][]*crfNode{
-			{symbols.NT_{{.StartSymbol}}, 0}: {},
+			{start, 0}: {},
 		},
 		crfNodes:    map[crfNode]*crfNode{},
-		bsrSet:      bsr.New(symbols.NT_{{.StartSymbol}}, l),
+		start:       start,
+		bsrSet:      bsr.New(start, l),
 		parseErrors: nil,
 	}
 }
 
-// Parse returns the BSR set containing the parse forest.
-// If the parse was successfull []*Error is nil
+// Parse returns the BSR set containing the parse forest of the default start
+// symbol.
+// If the parse was successfull []*Error is nil.
+// If the lexer found lexical errors they are returned first in []*Error.
 func Parse(l *lexer.Lexer) (*bsr.Set, []*Error) {
-	return newParser(l).parse()
+	return ParseFrom(symbols.NT_{{.StartSymbol}}, l)
+}
+
+// ParseFrom is like Parse but parses start symbol nt, which must be one of
+// StartSymbols.
+func ParseFrom(nt symbols.NT, l *lexer.Lexer) (*bsr.Set, []*Error) {
+	for _, start := range StartSymbols {
+		if start == nt {
+			return newParser(nt, l).parse()
+		}
+	}
+	panic(fmt.Sprintf("%s is not a start symbol", nt))
 }
 
 func (p *parser) parse() (*bsr.Set, []*Error) {
 	var L slot.Label
 	m, cU := len(p.lex.Tokens)-1, 0
-	p.ntAdd(symbols.NT_{{.StartSymbol}}, 0)
+	p.ntAdd(p.start, 0)
 	// p.DumpDescriptors()
 	for !p.R.empty() {
 		L, cU, p.cI = p.R.remove()
@@ -170,9 +206,9 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			panic("This must not happen")
 		}
 	}
-	if !p.bsrSet.Contain(symbols.NT_{{.StartSymbol}}, 0, m) {
+	if len(p.lex.Errors) > 0 || !p.bsrSet.Contain(p.start, 0, m) {
 		p.sortParseErrors()
-		return nil, p.parseErrors
+		return nil, append(p.lexErrors(), p.parseErrors...)
 	}
 	return p.bsrSet, nil
 }
@@ -437,9 +473,15 @@ type Error struct {
 
 	// The tokens expected at the point where the error occurred
 	Expected     map[token.Type]string 
+
+	// LexError is not nil if the error is a lexical error
+	LexError     *lexer.LexError
 }
 
 func (pe *Error) String() string {
+	if pe.LexError != nil {
+		return pe.LexError.Error()
+	}
 	w := new(bytes.Buffer)
 	fmt.Fprintf(w, "Parse Error: %s I[%d]=%s at line %d col %d\n", 
 		pe.Slot, pe.cI, pe.Token, pe.Line, pe.Column)
@@ -456,6 +498,24 @@ func (p *parser) parseError(slot slot.Label, i int, expected map[token.Type]stri
 	p.parseErrors = append(p.parseErrors, pe)
 }
 
+// lexErrors returns an Error for every lexical error in the input
+func (p *parser) lexErrors() (errs []*Error) {
+	for _, le := range p.lex.Errors {
+		for i, tok := range p.lex.Tokens {
+			if tok == le.Token {
+				errs = append(errs, &Error{
+					cI:       i,
+					Token:    tok,
+					Line:     le.Line,
+					Column:   le.Column,
+					LexError: le,
+				})
+			}
+		}
+	}
+	return
+}
+
 func (p *parser) sortParseErrors() {
 	sort.Slice(p.parseErrors,
 		func(i, j int) bool {
@@ -0,0 +1,205 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package visitor generates the visitor package of a GLL parser, which walks
+// an unambiguous BSR set.
+package visitor
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"text/template"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/goutil/ioutil"
+)
+
+type Data struct {
+	Package string
+	Rules   []*ruleData
+}
+
+type ruleData struct {
+	NT         string
+	Alternates []*altData
+}
+
+type altData struct {
+	// ID is the alternate ID, e.g.: Expr_Binary or Expr_Alt0
+	ID      string
+	Index   int
+	Comment string
+}
+
+// Gen generates the visitor package of g. It exits with an error if two
+// methods of the visitor have the same name.
+func Gen(visitorFile string, g *ast.GoGLL) {
+	data, gerr := getData(g)
+	if gerr != nil {
+		pos := gerr.Pos
+		fmt.Printf("Semantic Error at %s line %d col %d: %s\n", pos.File, pos.Line, pos.Column, gerr.Err)
+		os.Exit(1)
+	}
+	tmpl, err := template.New("visitor").Parse(src)
+	if err != nil {
+		panic(err)
+	}
+	buf := new(bytes.Buffer)
+	if err = tmpl.Execute(buf, data); err != nil {
+		panic(err)
+	}
+	if err = ioutil.WriteFile(visitorFile, buf.Bytes()); err != nil {
+		panic(err)
+	}
+}
+
+/*
+getData returns the template data of g. It returns an error if the ID of a
+nonterminal or alternate is the ID of another nonterminal or alternate, e.g.:
+the alternate A_Alt1 of A and the nonterminal A_Alt1, or if a nonterminal is
+Children, because the visitor has a method or function for each ID.
+*/
+func getData(g *ast.GoGLL) (*Data, *ast.Error) {
+	data := &Data{
+		Package: g.Package.GetString(),
+	}
+	ids := map[string]string{"Children": "the visitor function walkChildren"}
+	declare := func(id, what string, pos *ast.Position) *ast.Error {
+		if other, exist := ids[id]; exist {
+			return &ast.Error{Pos: pos, Err: fmt.Errorf(
+				"the visitor ID %s of %s is also the ID of %s", id, what, other)}
+		}
+		ids[id] = what
+		return nil
+	}
+	for _, r := range g.SyntaxRules {
+		if err := declare(r.ID(), "nonterminal "+r.ID(), r.Pos); err != nil {
+			return nil, err
+		}
+	}
+	for _, r := range g.SyntaxRules {
+		rd := &ruleData{
+			NT: r.ID(),
+		}
+		for i, a := range r.Alternates {
+			ad := &altData{
+				ID:      AlternateID(r.ID(), i, a),
+				Index:   i,
+				Comment: ast.AlternateString(r.ID(), a),
+			}
+			if err := declare(ad.ID, fmt.Sprintf("alternate `%s`", ad.Comment), r.Pos); err != nil {
+				return nil, err
+			}
+			rd.Alternates = append(rd.Alternates, ad)
+		}
+		data.Rules = append(data.Rules, rd)
+	}
+	return data, nil
+}
+
+// AlternateID returns the visitor ID of alternate i of rule head, which is
+// the head followed by the alternate name, e.g.: Expr_Binary, or by Alt and
+// the alternate number, e.g.: Expr_Alt0.
+func AlternateID(head string, i int, a *ast.SyntaxAlternate) string {
+	if a.Name != "" {
+		return head + "_" + a.Name
+	}
+	return fmt.Sprintf("%s_Alt%d", head, i)
+}
+
+const src = `// Package visitor is generated by gogll. Do not edit.
+
+/*
+Package visitor walks an unambiguous BSR set.
+
+Walk calls the Enter and Exit methods of the nonterminal and of the alternate of
+every NT BSR. The Enter methods are called in pre-order and the Exit methods in
+post-order:
+
+	EnterExpr, EnterExpr_Alt0, <walk the NT children of the BSR>, ExitExpr_Alt0, ExitExpr
+
+BaseVisitor implements Visitor with methods that do nothing. It can be embedded
+in a visitor that only needs some of the methods.
+*/
+package visitor
+
+import (
+	"fmt"
+
+	"{{.Package}}/parser/bsr"
+	"{{.Package}}/parser/symbols"
+)
+
+// Visitor has Enter and Exit methods for each nonterminal and each alternate
+// of the grammar. If an Enter method returns false the children of the BSR
+// are not walked. The matching Exit method is always called.
+type Visitor interface {
+{{- range $r := .Rules}}
+	Enter{{$r.NT}}(b bsr.BSR) bool
+	Exit{{$r.NT}}(b bsr.BSR)
+{{range $a := $r.Alternates}}
+	// {{$a.Comment}}
+	Enter{{$a.ID}}(b bsr.BSR) bool
+	Exit{{$a.ID}}(b bsr.BSR)
+{{end}}{{end}}}
+
+// Walk walks the BSR b and its NT children, calling the methods of v.
+// Walk panics if b has ambiguous children.
+func Walk(b bsr.BSR, v Visitor) {
+	switch b.Label.Head() {
+{{- range $r := .Rules}}
+	case symbols.NT_{{$r.NT}}:
+		if v.Enter{{$r.NT}}(b) {
+			walk{{$r.NT}}(b, v)
+		}
+		v.Exit{{$r.NT}}(b){{end}}
+	default:
+		panic(fmt.Sprintf("invalid NT %s", b.Label.Head()))
+	}
+}
+{{range $r := .Rules}}
+func walk{{$r.NT}}(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+{{- range $a := $r.Alternates}}
+	case {{$a.Index}}:
+		if v.Enter{{$a.ID}}(b) {
+			walkChildren(b, v)
+		}
+		v.Exit{{$a.ID}}(b){{end}}
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of {{$r.NT}}", b.Alternate()))
+	}
+}
+{{end}}
+func walkChildren(b bsr.BSR, v Visitor) {
+	for i, s := range b.Label.Symbols() {
+		if s.IsNonTerminal() {
+			Walk(b.GetNTChildI(i), v)
+		}
+	}
+}
+
+// BaseVisitor implements Visitor. Its Enter methods return true and its Exit
+// methods do nothing.
+type BaseVisitor struct{}
+
+var _ Visitor = BaseVisitor{}
+{{range $r := .Rules}}
+func (BaseVisitor) Enter{{$r.NT}}(b bsr.BSR) bool { return true }
+func (BaseVisitor) Exit{{$r.NT}}(b bsr.BSR) {}
+{{range $a := $r.Alternates}}
+func (BaseVisitor) Enter{{$a.ID}}(b bsr.BSR) bool { return true }
+func (BaseVisitor) Exit{{$a.ID}}(b bsr.BSR) {}
+{{end}}{{end}}`
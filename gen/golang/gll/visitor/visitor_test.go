@@ -0,0 +1,73 @@
+package visitor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/lexer"
+	"github.com/goccmack/gogll/v3/parser"
+)
+
+func build(t *testing.T, src string) *ast.GoGLL {
+	lex := lexer.New([]rune(src))
+	bsr, errs := parser.Parse(lex)
+	if errs != nil {
+		t.Fatal(errs[0])
+	}
+	g, err := ast.TryBuild(bsr.GetRoot(), lex, "g.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestAlternateIDs(t *testing.T) {
+	data, err := getData(build(t, `package "g"
+S : A A1 ;
+A : "x" | "y" ;
+A1 : "z" ;
+`))
+	if err != nil {
+		t.Fatal(err.Err)
+	}
+	var ids []string
+	for _, r := range data.Rules {
+		ids = append(ids, r.NT)
+		for _, a := range r.Alternates {
+			ids = append(ids, a.ID)
+		}
+	}
+	got := strings.Join(ids, " ")
+	if exp := "S S_Alt0 A A_Alt0 A_Alt1 A1 A1_Alt0"; got != exp {
+		t.Errorf("expected %q, got %q", exp, got)
+	}
+}
+
+func TestCollisions(t *testing.T) {
+	for _, tst := range []struct {
+		src, err string
+	}{
+		{`package "g"
+S : A A_Alt1 ;
+A : "x" | "y" ;
+A_Alt1 : "z" ;
+`, "the visitor ID A_Alt1 of alternate `A : \"y\"` is also the ID of nonterminal A_Alt1"},
+		{`package "g"
+S : A A_Bin ;
+A : "x" #Bin | "y" ;
+A_Bin : "z" ;
+`, "the visitor ID A_Bin of alternate `A : \"x\"` is also the ID of nonterminal A_Bin"},
+		{`package "g"
+S : Children ;
+Children : "x" ;
+`, "the visitor ID Children of nonterminal Children is also the ID of the visitor function walkChildren"},
+	} {
+		_, err := getData(build(t, tst.src))
+		if err == nil {
+			t.Errorf("expected error: %s", tst.err)
+		} else if err.Err.Error() != tst.err {
+			t.Errorf("expected error: %s\ngot: %s", tst.err, err.Err)
+		}
+	}
+}
@@ -18,6 +18,7 @@ package ast
 import (
 	"bytes"
 	"fmt"
+	"go/token"
 	"path/filepath"
 	"strings"
 	"text/template"
@@ -46,6 +47,9 @@ type BasicProd struct {
 type Param struct {
 	ID   string
 	Type string
+	// Name is the name of the parameter of the reduce function, which is
+	// the label of the symbol or p<i>
+	Name string
 }
 
 func Gen(pkg string, bprods []*basicprod.Production) {
@@ -89,7 +93,7 @@ func getBasicProd(prod *basicprod.Production) *BasicProd {
 			prod.Head,
 			strings.Join(prod.Body.GetSymbols(), " ")),
 
-		ID: fmt.Sprintf("%s%d", prod.Head, prod.Alternate),
+		ID: prod.ID(),
 
 		Params: getParams(prod.Body),
 
@@ -112,6 +116,13 @@ func getParams(body *ast.SyntaxAlternate) (params []*Param) {
 			}
 
 		}
+		param.Name = fmt.Sprintf("p%d", i)
+		if l := body.Label(i); l != "" {
+			param.Name = l
+			if token.IsKeyword(l) {
+				param.Name += "_"
+			}
+		}
 		params = append(params, param)
 	}
 	return
@@ -137,7 +148,7 @@ import(
 
 {{range $bprod := .BasicProds}}{{$bp := $bprod}}
 // {{$bp.Comment}}
-func {{$bp.ID}}({{range $i, $p := $bp.Params}}{{if ne $i 0}}, {{end}}p{{$i}}{{end}} interface{})(interface{}, error){
+func {{$bp.ID}}({{range $i, $p := $bp.Params}}{{if ne $i 0}}, {{end}}{{$p.Name}}{{end}} interface{})(interface{}, error){
     fmt.Println("ast.{{$bprod.ID}} is unimplemented")
     return nil, nil
 }
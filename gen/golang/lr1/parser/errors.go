@@ -62,7 +62,7 @@ func (E *Error) String() string {
 	} else {
 		fmt.Fprintf(w, "\n")
 	}
-	fmt.Fprintf(w, "Token: type=%d, lit=%s\n", E.ErrorToken.Type, E.ErrorToken.Literal())
+	fmt.Fprintf(w, "Token: type=%d, lit=%s\n", E.ErrorToken.Type(), string(E.ErrorToken.Literal()))
 	ln, col := E.ErrorToken.GetLineColumn()
 	fmt.Fprintf(w, "Pos: offset=%d, line=%d, column=%d\n", E.ErrorToken.Lext(), ln, col)
 	fmt.Fprintf(w, "Expected one of: ")
@@ -43,6 +43,10 @@ type parserData struct {
 	NumStates      int
 	NumTerminals   int
 	Package        string
+	// StartSymbols[i] is the start symbol of start state i
+	StartSymbols []string
+	// Coverage is true if the parser records the reduced alternates
+	Coverage bool
 }
 
 func getParserData(pkg string, prods []*basicprod.Production, states *states.States) *parserData {
@@ -51,6 +55,8 @@ func getParserData(pkg string, prods []*basicprod.Production, states *states.Sta
 		NumStates:      states.Size(),
 		NumTerminals:   len(symbols.GetTerminals()),
 		Package:        pkg,
+		StartSymbols:   basicprod.StartSymbols(prods),
+		Coverage:       *cfg.Coverage,
 	}
 }
 
@@ -61,7 +67,8 @@ import(
 	"bytes"
 	"fmt"
 	"errors"
-
+{{if .Coverage}}
+	"{{.Package}}/coverage"{{end}}
 	parseError "{{.Package}}/errors"
 	"{{.Package}}/lexer"
 	"{{.Package}}/token"
@@ -153,14 +160,34 @@ type Parser struct {
 	i         int
 }
 
+// startStates maps the start symbols of the grammar to their start states
+var startStates = map[string]int{ {{range $i, $nt := .StartSymbols}}
+	"{{$nt}}": {{$i}},{{end}}
+}
+
+// New returns a parser for the default start symbol of the grammar
 func New(lex *lexer.Lexer) *Parser {
+	return newParser(0, lex)
+}
+
+// NewFrom returns a parser for start symbol nt, which must be declared in the 
+// start declaration of the grammar.
+func NewFrom(nt string, lex *lexer.Lexer) *Parser {
+	state, exist := startStates[nt]
+	if !exist {
+		panic(fmt.Sprintf("%s is not a start symbol", nt))
+	}
+	return newParser(state, lex)
+}
+
+func newParser(state int, lex *lexer.Lexer) *Parser {
 	p := &Parser{
 		stack:  newStack(),
 		lex:    lex,
 		tokens: lex.Tokens,
 		i:      0,
 	}
-	p.stack.push(0, nil)
+	p.stack.push(state, nil)
 	return p
 }
 
@@ -237,7 +264,13 @@ func (P *Parser) newError(err error) error {
 	return errors.New(w.String())
 }
 
+// Parse parses the tokens of the lexer. If the lexer found lexical errors
+// Parse returns the first of them without parsing. 
+// All lexical errors are in lexer.Lexer.Errors.
 func (p *Parser) Parse() (res interface{}, err error) {
+	if len(p.lex.Errors) > 0 {
+		return nil, p.lex.Errors[0]
+	}
 	p.next()
 	for acc := false; !acc; {
 		action := actionTab[p.stack.top()].actions[p.nextToken.Type()]
@@ -263,6 +296,9 @@ func (p *Parser) Parse() (res interface{}, err error) {
 			p.next()
 		case reduce:
 			prod := productionsTable[int(act)]
+{{- if .Coverage}}
+			coverage.Alternate(prod.Id, prod.Alternate)
+{{- end}}
 			attrib, err := prod.ReduceFunc(p.stack.popN(prod.NumSymbols))
 			if err != nil {
 				return nil, p.newError(err)
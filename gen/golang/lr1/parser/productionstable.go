@@ -49,15 +49,15 @@ func getProdsTab(pkg string, prods []*basicprod.Production, states *states.State
 	for i, prod := range prods {
 		data.ProdTab[i].String = fmt.Sprintf("`%s`", prod.String())
 		data.ProdTab[i].Id = prod.Head
+		data.ProdTab[i].Alternate = prod.Alternate
 		data.ProdTab[i].NTType = int(symbols.GetNTType(prod.Head))
 		if len(prod.Body.Symbols) == 0 {
 			data.ProdTab[i].NumSymbols = 0
 			data.ProdTab[i].ReduceFunc = fmt.Sprintf("nil, nil")
 		} else {
 			data.ProdTab[i].NumSymbols = len(prod.Body.Symbols)
-			data.ProdTab[i].ReduceFunc = fmt.Sprintf("%s%d(%s)",
-				prod.Head,
-				prod.Alternate,
+			data.ProdTab[i].ReduceFunc = fmt.Sprintf("%s(%s)",
+				prod.ID(),
 				getParamIDs(len(prod.Body.Symbols)))
 		}
 	}
@@ -81,6 +81,7 @@ type prodsTabData struct {
 type prodTabEntry struct {
 	String     string
 	Id         string
+	Alternate  int
 	NTType     int
 	NumSymbols int
 	ReduceFunc string
@@ -99,6 +100,8 @@ type (
 	ProdTabEntry struct {
 		String     string
 		Id         string
+		// Alternate is the index of the alternate in the syntax rule of Id
+		Alternate  int
 		NTType     int
 		Index int
 		NumSymbols int
@@ -110,6 +113,7 @@ var productionsTable = ProdTab {
 	{{range $i, $entry := .ProdTab}}ProdTabEntry{
 		String: {{$entry.String}},
 		Id: "{{$entry.Id}}",
+		Alternate: {{$entry.Alternate}},
 		NTType: {{$entry.NTType}},
 		Index: {{$i}},
 		NumSymbols: {{$entry.NumSymbols}},
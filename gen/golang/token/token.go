@@ -90,6 +90,10 @@ type Token struct {
     typ        Type
     lext, rext int
     input      []rune
+
+    // Trivia attached to the token by lexer.NewWithTrivia
+    leadLext, trailRext int
+    leading, trailing   []*Token
 }
 
 /*
@@ -103,9 +107,16 @@ func New(t Type, lext, rext int, input []rune) *Token {
         lext:  lext,
         rext:  rext,
         input: input,
+        leadLext:  lext,
+        trailRext: rext,
     }
 }
 
+// FullLiteral returns the leading trivia, literal and trailing trivia of t
+func (t *Token) FullLiteral() []rune {
+    return t.input[t.leadLext:t.trailRext]
+}
+
 // GetLineColumn returns the line and column of the left extent of t
 func (t *Token) GetLineColumn() (line, col int) {
     line, col = 1, 1
@@ -128,6 +139,17 @@ func (t *Token) GetInput() []rune {
     return t.input
 }
 
+// LeadingSuppressed returns the suppressed tokens in the leading trivia of t
+func (t *Token) LeadingSuppressed() []*Token {
+    return t.leading
+}
+
+// LeadingTrivia returns the whitespace and suppressed tokens preceding t,
+// which are attached to t by lexer.NewWithTrivia
+func (t *Token) LeadingTrivia() []rune {
+    return t.input[t.leadLext:t.lext]
+}
+
 // Lext returns the left extent of t in the input stream of runes
 func (t *Token) Lext() int {
     return t.lext
@@ -177,6 +199,44 @@ func (t *Token) Rext() int {
     return t.rext
 }
 
+/*
+SetLeadingTrivia sets the leading trivia of t to the input from lext to the
+left extent of t. suppressed contains the suppressed tokens in the trivia.
+*/
+func (t *Token) SetLeadingTrivia(lext int, suppressed []*Token) {
+    t.leadLext, t.leading = lext, suppressed
+}
+
+/*
+SetTrailingTrivia sets the trailing trivia of t to the input from the right
+extent of t to rext. suppressed contains the suppressed tokens in the trivia.
+*/
+func (t *Token) SetTrailingTrivia(rext int, suppressed []*Token) {
+    t.trailRext, t.trailing = rext, suppressed
+}
+
+/*
+Shift returns a copy of t, including its trivia, moved by delta runes in the
+new input. It is used by the lexer to move tokens after an edit of the input.
+*/
+func (t *Token) Shift(delta int, input []rune) *Token {
+    t1 := &Token{
+        typ:       t.typ,
+        lext:      t.lext + delta,
+        rext:      t.rext + delta,
+        input:     input,
+        leadLext:  t.leadLext + delta,
+        trailRext: t.trailRext + delta,
+    }
+    for _, s := range t.leading {
+        t1.leading = append(t1.leading, s.Shift(delta, input))
+    }
+    for _, s := range t.trailing {
+        t1.trailing = append(t1.trailing, s.Shift(delta, input))
+    }
+    return t1
+}
+
 func (t *Token) String() string {
     return fmt.Sprintf("%s (%d,%d) %s",
         t.TypeID(), t.lext, t.rext, t.LiteralString())
@@ -187,6 +247,18 @@ func (t *Token) Suppress() bool {
 	return Suppress[t.typ]
 }
 
+// TrailingSuppressed returns the suppressed tokens in the trailing trivia of t
+func (t *Token) TrailingSuppressed() []*Token {
+    return t.trailing
+}
+
+// TrailingTrivia returns the whitespace and suppressed tokens following t,
+// up to and including the end of the line, which are attached to t by
+// lexer.NewWithTrivia
+func (t *Token) TrailingTrivia() []rune {
+    return t.input[t.rext:t.trailRext]
+}
+
 // Type returns the token Type of t
 func (t *Token) Type() Type {
     return t.typ
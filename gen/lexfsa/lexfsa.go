@@ -30,6 +30,9 @@ func Gen(fname string, ls *items.Sets) {
 	w := new(bytes.Buffer)
 	for _, s := range ls.Sets() {
 		fmt.Fprintf(w, "S%d:\n", s.No)
+		if len(s.Merged) > 1 {
+			fmt.Fprintf(w, "  Merged: %s\n", mergedString(s.Merged))
+		}
 		for _, i := range s.Items() {
 			fmt.Fprintf(w, "    %s\n", i)
 		}
@@ -45,3 +48,14 @@ func Gen(fname string, ls *items.Sets) {
 		panic(err)
 	}
 }
+
+func mergedString(merged []int) string {
+	w := new(bytes.Buffer)
+	for i, no := range merged {
+		if i > 0 {
+			fmt.Fprint(w, ", ")
+		}
+		fmt.Fprintf(w, "S%d", no)
+	}
+	return w.String()
+}
@@ -21,11 +21,14 @@ ToDo:
 package bsr
 
 import (
+	"github.com/goccmack/gogll/v3/ast"
 	"github.com/goccmack/goutil/ioutil"
 )
 
-func Gen(bsrFile string) {
-	if err := ioutil.WriteFile(bsrFile, []byte(bsrTmpl)); err != nil {
+// Gen generates the bsr module with the accessors of the named alternates of g
+func Gen(bsrFile string, g *ast.GoGLL) {
+	src := append([]byte(bsrTmpl), genLabels(g)...)
+	if err := ioutil.WriteFile(bsrFile, src); err != nil {
 		panic(err)
 	}
 }
@@ -44,6 +47,7 @@ Module bsr implements a Binary Subtree Representation set as defined in
 
 use crate::lexer;
 use crate::parser::{slot, symbols};
+use crate::parser::sppf::{self, Node, NodeId, SPPF};
 use crate::parser::symbols::{NT, Symbol};
 use crate::token::{Token};
 
@@ -66,7 +70,7 @@ Set contains the set of Binary Subtree Representations (BSR).
 pub struct Set {
     slot_entries: HashMap<Rc<BSR>, bool>,
     nt_slot_entries: HashMap<NTSlot, Vec<Rc<BSR>>>,
-    string_entries: HashMap<Rc<BSR>, bool>,
+    string_entries: HashMap<StrSlot, Vec<Rc<BSR>>>,
     pub rext: usize,
     lex: Rc<lexer::Lexer>,
 
@@ -80,6 +84,14 @@ struct NTSlot {
     rext: usize,
 }
 
+// StrSlot is the key of the string BSRs of a grammar slot with the same extents
+#[derive(Hash, Eq, PartialEq)]
+struct StrSlot {
+    label: slot::Label,
+    lext: usize,
+    rext: usize,
+}
+
 /// BSR is the binary subtree representation of a parsed nonterminal
 #[derive(Hash, Eq, PartialEq)]
 pub struct BSR {
@@ -166,7 +178,15 @@ impl Set {
                 }
             }
             Kind::Str(b) => {
-                self.string_entries.insert(b.clone(), true);
+                let str_slot = StrSlot{label: b.label, lext: b.lext, rext: b.rext};
+                match self.string_entries.get_mut(&str_slot) {
+                    None => {
+                        self.string_entries.insert(str_slot, vec![b.clone()]);
+                    },
+                    Some(bsrs) => if !bsrs.contains(&b) {
+                        bsrs.push(b.clone())
+                    }
+                }
             }
         };
     }
@@ -287,10 +307,8 @@ impl Set {
     }
     
     fn get_string(&self, l: slot::Label, lext: usize, rext: usize) -> Rc<BSR> {
-        for st in self.string_entries.keys() {
-            if st.label == l && st.lext == lext && st.rext == rext {
-                return st.clone()
-            }
+        if let Some(strs) = self.string_entries.get(&StrSlot{label: l, lext, rext}) {
+            return strs[0].clone()
         }
         panic!("Error: no string BSR {} left extent={} right extent={} pos={}",
             symbols::to_string(l.symbols()), lext, rext, l.pos())
@@ -354,8 +372,201 @@ impl Set {
         return false
     }
 
+    /// Prints the ambiguous subtrees of the parse forest
+    #[allow(dead_code)]
+    pub fn report_ambiguous(&self) {
+        println!("Ambiguous BSR Subtrees:");
+        let rts = self.get_roots();
+        if rts.len() != 1 {
+            println!("BSR has {} ambigous roots", rts.len());
+        }
+        for (i, b) in rts.iter().enumerate() {
+            println!("In root {}", i);
+            if !self.report(b.clone()) {
+                println!("No ambiguous BSRs");
+            }
+        }
+    }
+
+    /// Returns true iff at least one ambiguous BSR was found
+    fn report(&self, b: Rc<BSR>) -> bool {
+        let mut ambiguous = false;
+        for (i, sym) in b.label.symbols().iter().enumerate() {
+            let (ln, col) = self.get_line_column(b.lext);
+            if sym.is_nt() {
+                let children = self.get_nt_children_i(b.clone(), i);
+                if children.len() != 1 {
+                    ambiguous = true;
+                    println!("  Ambigous: in {}: NT {} ({}) at line {} col {} ",
+                        b, sym, i, ln, col);
+                    println!("   Children:");
+                    for c in children.iter() {
+                        println!("     {}", c);
+                    }
+                }
+                for b1 in children.iter() {
+                    self.report(b1.clone());
+                }
+            }
+        }
+        ambiguous
+    }
+
+    /// Returns the Shared Packed Parse Forest of the BSR set
+    #[allow(dead_code)]
+    pub fn to_sppf(&self) -> SPPF {
+        let rt = self.get_roots()[0].clone();
+        let mut bld = BldSPPF{
+            sppf: SPPF{ nodes: Vec::new(), root: 0 },
+            ext_leaf_nodes: Vec::new(),
+            i_nodes: HashMap::new(),
+            p_nodes: HashMap::new(),
+            s_nodes: HashMap::new(),
+        };
+        bld.sppf.root = bld.mk_sn(rt.label.head().to_string(), Some(*rt.label.head()), 
+            rt.lext, rt.rext);
+
+        // let w = (μ, i, j) be an extendable leaf node of G
+        while let Some(w) = bld.ext_leaf_nodes.pop() {
+            let mut children: Vec<NodeId> = Vec::new();
+            match &bld.sppf.nodes[w] {
+                // μ is a nonterminal X in Γ
+                Node::Symbol(sn) => {
+                    let bsts = self.get_nt_slot(&Symbol::NT(sn.nt.unwrap()), sn.lext, sn.rext);
+                    // for each (X ::=γ,i,k, j)∈Υ { mkPN(X ::=γ·,i,k, j,G) }
+                    for bst in bsts.clone().iter() {
+                        children.push(bld.mk_pn(bst.label, bst.lext, bst.pivot, bst.rext));
+                    }
+                },
+                // w is an intermediate node. Suppose μ is X ::=α·δ
+                Node::Intermediate(inode) => {
+                    let (label, lext, rext) = (inode.slot, inode.lext, inode.rext);
+                    if label.pos() == 1 {
+                        children.push(bld.mk_pn(label, lext, lext, rext));
+                    } else {
+                        // for each (α,i,k, j)∈Υ { mkPN(X ::=α·δ,i,k, j,G) }
+                        let mut strs: Vec<Rc<BSR>> = self.string_entries
+                            .get(&StrSlot{label, lext, rext})
+                            .cloned()
+                            .unwrap_or_default();
+                        strs.sort_by_key(|s| s.pivot);
+                        for s in strs {
+                            children.push(bld.mk_pn(label, s.lext, s.pivot, s.rext));
+                        }
+                    }
+                },
+                Node::Packed(_) => panic!("packed node {} is not extendable", w),
+            }
+            match &mut bld.sppf.nodes[w] {
+                Node::Symbol(sn) => sn.children = children,
+                Node::Intermediate(inode) => inode.children = children,
+                Node::Packed(_) => (),
+            }
+        }
+        bld.sppf
+    }
+
 } // impl Set
 
+//---- SPPF ----
+
+struct BldSPPF {
+    sppf: SPPF,
+    ext_leaf_nodes: Vec<NodeId>,
+    i_nodes: HashMap<(slot::Label, usize, usize), NodeId>,
+    p_nodes: HashMap<(slot::Label, usize, usize, usize), NodeId>,
+    s_nodes: HashMap<(String, usize, usize), NodeId>,
+}
+
+impl BldSPPF {
+    fn add(&mut self, n: Node) -> NodeId {
+        self.sppf.nodes.push(n);
+        self.sppf.nodes.len() - 1
+    }
+
+    fn mk_in(&mut self, label: slot::Label, lext: usize, rext: usize) -> NodeId {
+        if let Some(&id) = self.i_nodes.get(&(label, lext, rext)) {
+            return id
+        }
+        let id = self.add(Node::Intermediate(sppf::IntermediateNode{
+            slot: label,
+            lext: lext,
+            rext: rext,
+            children: Vec::new(),
+        }));
+        self.i_nodes.insert((label, lext, rext), id);
+        self.ext_leaf_nodes.push(id);
+        id
+    }
+
+    fn mk_pn(&mut self, label: slot::Label, lext: usize, pivot: usize, rext: usize) -> NodeId {
+        // X ::= ⍺ • β, k
+        if let Some(&id) = self.p_nodes.get(&(label, lext, pivot, rext)) {
+            return id
+        }
+        let id = self.add(Node::Packed(sppf::PackedNode{
+            slot: label,
+            lext: lext,
+            pivot: pivot,
+            rext: rext,
+            left_child: None,
+            right_child: None,
+        }));
+        self.p_nodes.insert((label, lext, pivot, rext), id);
+
+        let (body, pos) = (label.symbols(), label.pos());
+        let (mut left, right);
+        if body.len() == 0 { // ⍺ = ϵ
+            left = None;
+            right = self.mk_sn("ϵ".to_string(), None, lext, lext);
+        } else { // if ( α=βx, where |x|=1) {
+            // mkN(x,k, j, y,G)
+            right = self.mk_sym_sn(&body[pos-1], pivot, rext);
+            left = None;
+            // if (|β|=1) mkN(β,i,k,y,G)
+            if pos == 2 {
+                left = Some(self.mk_sym_sn(&body[0], lext, pivot));
+            }
+            // if (|β|>1) mkN(X ::=β·xδ,i,k,y,G)
+            if pos > 2 {
+                let l = slot::get_label(label.head(), label.alternate(), pos-1);
+                left = Some(self.mk_in(l, lext, pivot));
+            }
+        }
+        if let Node::Packed(pn) = &mut self.sppf.nodes[id] {
+            pn.left_child = left;
+            pn.right_child = Some(right);
+        }
+        id
+    }
+
+    fn mk_sym_sn(&mut self, sym: &Symbol, lext: usize, rext: usize) -> NodeId {
+        match sym {
+            Symbol::NT(nt) => self.mk_sn(sym.to_string(), Some(*nt), lext, rext),
+            Symbol::T(_) => self.mk_sn(sym.to_string(), None, lext, rext),
+        }
+    }
+
+    fn mk_sn(&mut self, symbol: String, nt: Option<NT>, lext: usize, rext: usize) -> NodeId {
+        let key = (symbol, lext, rext);
+        if let Some(&id) = self.s_nodes.get(&key) {
+            return id
+        }
+        let id = self.add(Node::Symbol(sppf::SymbolNode{
+            symbol: key.0.clone(),
+            nt: nt,
+            lext: lext,
+            rext: rext,
+            children: Vec::new(),
+        }));
+        self.s_nodes.insert(key, id);
+        if nt.is_some() {
+            self.ext_leaf_nodes.push(id);
+        }
+        id
+    }
+}
+
 impl Kind {
     fn rext(&self) -> usize {
         match self {
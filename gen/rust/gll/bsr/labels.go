@@ -0,0 +1,181 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package bsr
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"text/template"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/iancoleman/strcase"
+)
+
+// reserved contains the names of the items of the bsr module, which cannot
+// be used as the names of named alternates.
+var reserved = map[string]bool{
+	"BldSPPF": true, "BSR": true, "Greater": true, "HashMap": true,
+	"Kind": true, "Less": true, "NT": true, "NTSlot": true, "Node": true,
+	"NodeId": true, "Ordering": true, "Rc": true, "SPPF": true, "Set": true, "StrSlot": true,
+	"Symbol": true, "Token": true,
+}
+
+// keywords are the Rust keywords and new, the constructor of a named
+// alternate, which get the suffix _ as method names
+var keywords = map[string]bool{
+	"new": true,
+
+	"abstract": true, "as": true, "async": true, "await": true, "become": true,
+	"box": true, "break": true, "const": true, "continue": true, "crate": true,
+	"do": true, "dyn": true, "else": true, "enum": true, "extern": true,
+	"false": true, "final": true, "fn": true, "for": true, "if": true,
+	"impl": true, "in": true, "let": true, "loop": true, "macro": true,
+	"match": true, "mod": true, "move": true, "mut": true, "override": true,
+	"priv": true, "pub": true, "ref": true, "return": true, "self": true,
+	"static": true, "struct": true, "super": true, "trait": true, "true": true,
+	"try": true, "type": true, "typeof": true, "unsafe": true, "unsized": true,
+	"use": true, "virtual": true, "where": true, "while": true, "yield": true,
+}
+
+type labelsData struct {
+	Alternates []*altData
+}
+
+type altData struct {
+	NT, Name string
+	// Const is the constant of the alternate, e.g.: EXPR_BINARY, and Method
+	// the snake case name, e.g.: binary for the test method is_binary of BSR
+	Const, Method string
+	Index         int
+	Comment       string
+	Labels        []*labelData
+}
+
+type labelData struct {
+	Label, Method string
+	Pos           int
+	NT            bool
+}
+
+/*
+genLabels returns the named alternate constants and the accessors of the
+named alternates and labelled symbols of g. It exits with an error if the
+name of an alternate is reserved.
+*/
+func genLabels(g *ast.GoGLL) []byte {
+	data := getLabelsData(g)
+	if len(data.Alternates) == 0 {
+		return nil
+	}
+	tmpl, err := template.New("labels").Parse(labelsTmpl)
+	if err != nil {
+		panic(err)
+	}
+	buf := new(bytes.Buffer)
+	if err = tmpl.Execute(buf, data); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+func getLabelsData(g *ast.GoGLL) *labelsData {
+	data := &labelsData{}
+	for _, r := range g.SyntaxRules {
+		for i, a := range r.Alternates {
+			if a.Name == "" {
+				continue
+			}
+			alt := &altData{
+				NT:      r.ID(),
+				Name:    a.Name,
+				Const:   strcase.ToScreamingSnake(r.ID() + "_" + a.Name),
+				Method:  strcase.ToSnake(a.Name),
+				Index:   i,
+				Comment: ast.AlternateString(r.ID(), a),
+			}
+			if reserved[alt.Name] {
+				fmt.Printf("Error: alternate name %s of %s is reserved\n", a.Name, r.ID())
+				os.Exit(1)
+			}
+			for j, sym := range a.Symbols {
+				if a.Label(j) == "" {
+					continue
+				}
+				_, isNT := sym.(*ast.NT)
+				alt.Labels = append(alt.Labels, &labelData{
+					Label:  a.Label(j),
+					Method: methodName(a.Label(j)),
+					Pos:    j,
+					NT:     isNT,
+				})
+			}
+			data.Alternates = append(data.Alternates, alt)
+		}
+	}
+	return data
+}
+
+// methodName returns the Rust method of label, e.g.: lhs_expr for lhsExpr
+// and type_ for type
+func methodName(label string) string {
+	m := strcase.ToSnake(label)
+	if keywords[m] {
+		m += "_"
+	}
+	return m
+}
+
+const labelsTmpl = `
+//---- Named alternates ----
+{{range $alt := .Alternates}}
+/// {{$alt.Comment}}
+#[allow(dead_code)]
+pub const {{$alt.Const}}: usize = {{$alt.Index}};{{end}}
+{{range $alt := .Alternates}}
+/// {{$alt.Name}} is alternate #{{$alt.Name}}: {{$alt.Comment}}
+#[allow(dead_code, non_camel_case_types)]
+pub struct {{$alt.Name}}<'a> {
+    pub set: &'a Set,
+    pub bsr: Rc<BSR>,
+}
+
+impl BSR {
+    /// Returns true if the BSR is alternate #{{$alt.Name}} of {{$alt.NT}}
+    #[allow(dead_code)]
+    pub fn is_{{$alt.Method}}(&self) -> bool {
+        *self.label.head() == NT::{{$alt.NT}} && self.label.alternate() == {{$alt.Const}}
+    }
+}
+
+impl<'a> {{$alt.Name}}<'a> {
+    /// Returns b of set as alternate #{{$alt.Name}} of {{$alt.NT}}.
+    /// Panics if b is not alternate #{{$alt.Name}}.
+    #[allow(dead_code)]
+    pub fn new(set: &'a Set, b: Rc<BSR>) -> {{$alt.Name}}<'a> {
+        if !b.is_{{$alt.Method}}() {
+            panic!("{} is not alternate #{{$alt.Name}} of {{$alt.NT}}", b);
+        }
+        {{$alt.Name}}{set, bsr: b}
+    }
+{{- range $l := $alt.Labels}}
+
+    /// Returns the symbol labelled {{$l.Label}}
+    #[allow(dead_code)]
+    pub fn {{$l.Method}}(&self) -> {{if $l.NT}}Rc<BSR>{{else}}Rc<Token>{{end}} {
+        self.set.{{if $l.NT}}get_nt_child_i{{else}}get_t_child_i{{end}}(self.bsr.clone(), {{$l.Pos}})
+    }{{end}}
+}
+{{end}}`
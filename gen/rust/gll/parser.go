@@ -23,13 +23,17 @@ import (
 	"github.com/goccmack/gogll/v3/gen/rust/gll/bsr"
 	"github.com/goccmack/gogll/v3/gen/rust/gll/parser"
 	"github.com/goccmack/gogll/v3/gen/rust/gll/slot"
+	"github.com/goccmack/gogll/v3/gen/rust/gll/sppf"
 	"github.com/goccmack/gogll/v3/gen/rust/gll/symbols"
+	"github.com/goccmack/gogll/v3/gen/rust/gll/visitor"
 	"github.com/goccmack/gogll/v3/gslot"
 )
 
 func Gen(parserDir string, g *ast.GoGLL, gs *gslot.GSlot, ff *frstflw.FF) {
-	bsr.Gen(filepath.Join(parserDir, "bsr", "mod.rs"))
+	bsr.Gen(filepath.Join(parserDir, "bsr", "mod.rs"), g)
 	symbols.Gen(filepath.Join(parserDir, "symbols", "mod.rs"), g)
+	sppf.Gen(filepath.Join(parserDir, "sppf", "mod.rs"))
 	slot.Gen(filepath.Join(parserDir, "slot", "mod.rs"), g, gs, ff)
+	visitor.Gen(filepath.Join(parserDir, "visitor", "mod.rs"), g)
 	parser.Gen(filepath.Join(parserDir, "mod.rs"), g, gs, ff)
 }
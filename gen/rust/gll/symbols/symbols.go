@@ -17,9 +17,11 @@ package symbols
 
 import (
 	"bytes"
+	"strings"
 	"text/template"
 
 	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/gen/golang/utils"
 	"github.com/goccmack/goutil/ioutil"
 )
 
@@ -51,18 +53,13 @@ func getData(g *ast.GoGLL) *Data {
 	}
 }
 
+var braces = strings.NewReplacer("{", "{{", "}", "}}")
+
 // escape terminal for Rust string formatting
 func escape(ts []string) []string {
 	esc := make([]string, len(ts))
 	for i, t := range ts {
-		switch t {
-		case "{":
-			esc[i] = "{{"
-		case "}":
-			esc[i] = "}}"
-		default:
-			esc[i] = t
-		}
+		esc[i] = braces.Replace(utils.Escape(t))
 	}
 	return esc
 }
@@ -0,0 +1,186 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package visitor generates the Rust visitor module of a GLL parser, which
+// walks an unambiguous BSR set.
+package visitor
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"text/template"
+
+	"github.com/goccmack/gogll/v3/ast"
+	govisitor "github.com/goccmack/gogll/v3/gen/golang/gll/visitor"
+	"github.com/goccmack/goutil/ioutil"
+	"github.com/iancoleman/strcase"
+)
+
+type Data struct {
+	Rules []*ruleData
+}
+
+type ruleData struct {
+	NT string
+	// Method is the snake case NT, e.g.: expr_list for ExprList
+	Method     string
+	Alternates []*altData
+}
+
+type altData struct {
+	// Method is the snake case alternate ID, e.g.: expr_binary for
+	// Expr_Binary or expr_alt_0 for Expr_Alt0
+	Method  string
+	Index   int
+	Comment string
+}
+
+// Gen generates the visitor module of g. It exits with an error if two
+// methods of the visitor have the same name.
+func Gen(visitorFile string, g *ast.GoGLL) {
+	data, gerr := getData(g)
+	if gerr != nil {
+		pos := gerr.Pos
+		fmt.Printf("Semantic Error at %s line %d col %d: %s\n", pos.File, pos.Line, pos.Column, gerr.Err)
+		os.Exit(1)
+	}
+	tmpl, err := template.New("Rust visitor").Parse(src)
+	if err != nil {
+		panic(err)
+	}
+	buf := new(bytes.Buffer)
+	if err = tmpl.Execute(buf, data); err != nil {
+		panic(err)
+	}
+	if err = ioutil.WriteFile(visitorFile, buf.Bytes()); err != nil {
+		panic(err)
+	}
+}
+
+/*
+getData returns the template data of g. The methods of the visitor are named
+after the snake case IDs of the nonterminals and alternates, see
+govisitor.AlternateID. getData returns an error if two IDs have the same
+snake case, e.g.: the nonterminals ExprList and Expr_List, or if the snake
+case of a nonterminal is children, because the visitor has a function for
+each ID.
+*/
+func getData(g *ast.GoGLL) (*Data, *ast.Error) {
+	data := &Data{}
+	ids := map[string]string{"children": "the visitor function walk_children"}
+	declare := func(id, what string, pos *ast.Position) *ast.Error {
+		if other, exist := ids[id]; exist {
+			return &ast.Error{Pos: pos, Err: fmt.Errorf(
+				"the Rust visitor ID %s of %s is also the ID of %s", id, what, other)}
+		}
+		ids[id] = what
+		return nil
+	}
+	for _, r := range g.SyntaxRules {
+		if err := declare(strcase.ToSnake(r.ID()), "nonterminal "+r.ID(), r.Pos); err != nil {
+			return nil, err
+		}
+	}
+	for _, r := range g.SyntaxRules {
+		rd := &ruleData{
+			NT:     r.ID(),
+			Method: strcase.ToSnake(r.ID()),
+		}
+		for i, a := range r.Alternates {
+			ad := &altData{
+				Method:  strcase.ToSnake(govisitor.AlternateID(r.ID(), i, a)),
+				Index:   i,
+				Comment: ast.AlternateString(r.ID(), a),
+			}
+			if err := declare(ad.Method, fmt.Sprintf("alternate `%s`", ad.Comment), r.Pos); err != nil {
+				return nil, err
+			}
+			rd.Alternates = append(rd.Alternates, ad)
+		}
+		data.Rules = append(data.Rules, rd)
+	}
+	return data, nil
+}
+
+const src = `//! Module visitor is generated by gogll. Do not edit.
+
+/*!
+Module visitor walks an unambiguous BSR set.
+
+walk calls the enter and exit methods of the nonterminal and of the alternate
+of every NT BSR. The enter methods are called in pre-order and the exit methods
+in post-order:
+
+    enter_expr, enter_expr_alt_0, <walk the NT children of the BSR>, exit_expr_alt_0, exit_expr
+
+The methods of Visitor have default implementations, which do nothing, so a
+visitor only implements the methods it needs.
+*/
+
+use crate::parser::bsr::{Set, BSR};
+use crate::parser::symbols::NT;
+
+use std::rc::Rc;
+
+/// Visitor has enter and exit methods for each nonterminal and each alternate
+/// of the grammar. If an enter method returns false the children of the BSR
+/// are not walked. The matching exit method is always called.
+#[allow(unused_variables)]
+pub trait Visitor {
+{{- range $r := .Rules}}
+    fn enter_{{$r.Method}}(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_{{$r.Method}}(&mut self, set: &Set, b: &Rc<BSR>) {}
+{{range $a := $r.Alternates}}
+    /// {{$a.Comment}}
+    fn enter_{{$a.Method}}(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_{{$a.Method}}(&mut self, set: &Set, b: &Rc<BSR>) {}
+{{end}}{{end}}}
+
+/// Walks the BSR b of set and its NT children, calling the methods of v.
+/// Panics if b has ambiguous children.
+#[allow(dead_code)]
+pub fn walk(set: &Set, b: Rc<BSR>, v: &mut dyn Visitor) {
+    match b.label.head() {
+{{- range $r := .Rules}}
+        NT::{{$r.NT}} => {
+            if v.enter_{{$r.Method}}(set, &b) {
+                walk_{{$r.Method}}(set, &b, v);
+            }
+            v.exit_{{$r.Method}}(set, &b);
+        },{{end}}
+    }
+}
+{{range $r := .Rules}}
+fn walk_{{$r.Method}}(set: &Set, b: &Rc<BSR>, v: &mut dyn Visitor) {
+    match b.label.alternate() {
+{{- range $a := $r.Alternates}}
+        {{$a.Index}} => {
+            if v.enter_{{$a.Method}}(set, b) {
+                walk_children(set, b, v);
+            }
+            v.exit_{{$a.Method}}(set, b);
+        },{{end}}
+        n => panic!("invalid alternate {} of {{$r.NT}}", n),
+    }
+}
+{{end}}
+fn walk_children(set: &Set, b: &Rc<BSR>, v: &mut dyn Visitor) {
+    for (i, s) in b.label.symbols().iter().enumerate() {
+        if s.is_nt() {
+            walk(set, set.get_nt_child_i(b.clone(), i), v);
+        }
+    }
+}
+`
@@ -0,0 +1,72 @@
+package visitor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/lexer"
+	"github.com/goccmack/gogll/v3/parser"
+)
+
+func build(t *testing.T, src string) *ast.GoGLL {
+	lex := lexer.New([]rune(src))
+	bsr, errs := parser.Parse(lex)
+	if errs != nil {
+		t.Fatal(errs[0])
+	}
+	g, err := ast.TryBuild(bsr.GetRoot(), lex, "g.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestMethods(t *testing.T) {
+	data, err := getData(build(t, `package "g"
+S : ExprList ;
+ExprList : "x" #Binary | "y" ;
+`))
+	if err != nil {
+		t.Fatal(err.Err)
+	}
+	var methods []string
+	for _, r := range data.Rules {
+		methods = append(methods, r.Method)
+		for _, a := range r.Alternates {
+			methods = append(methods, a.Method)
+		}
+	}
+	got := strings.Join(methods, " ")
+	if exp := "s s_alt_0 expr_list expr_list_binary expr_list_alt_1"; got != exp {
+		t.Errorf("expected %q, got %q", exp, got)
+	}
+}
+
+func TestCollisions(t *testing.T) {
+	for _, tst := range []struct {
+		src, err string
+	}{
+		{`package "g"
+S : A1 A_1 ;
+A1 : "x" ;
+A_1 : "y" ;
+`, "the Rust visitor ID a_1 of nonterminal A_1 is also the ID of nonterminal A1"},
+		{`package "g"
+S : A AAlt1 ;
+A : "x" | "y" ;
+AAlt1 : "z" ;
+`, "the Rust visitor ID a_alt_1 of alternate `A : \"y\"` is also the ID of nonterminal AAlt1"},
+		{`package "g"
+S : Children ;
+Children : "x" ;
+`, "the Rust visitor ID children of nonterminal Children is also the ID of the visitor function walk_children"},
+	} {
+		_, err := getData(build(t, tst.src))
+		if err == nil {
+			t.Errorf("expected error: %s", tst.err)
+		} else if err.Err.Error() != tst.err {
+			t.Errorf("expected error: %s\ngot: %s", tst.err, err.Err)
+		}
+	}
+}
@@ -20,7 +20,10 @@ package lexer
 import (
 	"bytes"
 	"fmt"
+	"os"
+	"strings"
 	"text/template"
+	"unicode"
 
 	"github.com/goccmack/gogll/v3/ast"
 	"github.com/goccmack/gogll/v3/lex/items"
@@ -33,10 +36,31 @@ import (
 type Data struct {
 	Package string
 	Accept  []string
+	// The token types partially matched in each set
+	Partial [][]string
 	// A slice of transitions for each set
-	Transitions    [][]*Transition
+	Transitions [][]*Transition
+	// The perfect hash table of keywords. Unused entries are nil.
+	Keywords       []*Keyword
+	KeywordSeed    uint32
 	CodeBlockDelim string
 	Tick           string
+	// The Unicode category and property tables used by UnicodeSets
+	UnicodeTables []*UnicodeTable
+}
+
+// UnicodeTable is the sorted table of char ranges of a Unicode category or
+// property
+type UnicodeTable struct {
+	Name   string
+	Ranges []string
+}
+
+type Keyword struct {
+	// Key is the literal of the keyword in the table
+	Key             string
+	Type, Class     string
+	CaseInsensitive bool
 }
 
 type Transition struct {
@@ -45,7 +69,9 @@ type Transition struct {
 }
 
 func Gen(fname string, g *ast.GoGLL, ls *items.Sets) {
-	tmpl, err := template.New("Rust lexer").Parse(tmplSrc)
+	tmpl, err := template.New("Rust lexer").Funcs(template.FuncMap{
+		"mod8": func(i int) int { return i % 8 },
+	}).Parse(tmplSrc)
 	if err != nil {
 		panic(err)
 	}
@@ -68,46 +94,97 @@ func getAccept(ls *items.Sets, slits *stringset.StringSet) (tokTypes []string) {
 }
 
 func getData(g *ast.GoGLL, ls *items.Sets) *Data {
+	keywords, seed := getKeywords(g)
+	tables := newUnicodeTables()
 	return &Data{
 		Package:        g.Package.GetString(),
 		Accept:         getAccept(ls, g.GetStringLiteralsSet()),
-		Transitions:    getTransitions(ls),
+		Partial:        getPartial(ls),
+		Transitions:    getTransitions(ls, tables),
+		Keywords:       keywords,
+		KeywordSeed:    seed,
 		CodeBlockDelim: "```",
 		Tick:           "`",
+		UnicodeTables:  tables.tables,
+	}
+}
+
+func getKeywords(g *ast.GoGLL) (keywords []*Keyword, seed uint32) {
+	table, seed, err := items.KeywordTable(items.Keywords(g))
+	if err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(1)
+	}
+	keywords = make([]*Keyword, len(table))
+	for i, kw := range table {
+		if kw != nil {
+			keywords[i] = &Keyword{
+				Key:             kw.Key(),
+				Type:            symbols.TerminalLiteralToType(kw.ID()).TypeString(),
+				Class:           symbols.TerminalLiteralToType(kw.Class.ID()).TypeString(),
+				CaseInsensitive: kw.CaseInsensitive,
+			}
+		}
 	}
+	return
 }
 
-func getTransitions(ls *items.Sets) [][]*Transition {
+// getPartial returns the token types of the lex rules that are partially
+// matched in each set. Nothing is matched in S0.
+func getPartial(ls *items.Sets) [][]string {
+	partial := make([][]string, len(ls.Sets()))
+	for _, set := range ls.Sets()[1:] {
+		ids := stringset.New()
+		for _, itm := range set.Items() {
+			if !itm.IsReduce() {
+				ids.Add(itm.Rule.ID())
+			}
+		}
+		for _, id := range ids.ElementsSorted() {
+			partial[set.No] = append(partial[set.No],
+				symbols.TerminalLiteralToType(id).TypeString())
+		}
+	}
+	return partial
+}
+
+func getTransitions(ls *items.Sets, tables *unicodeTables) [][]*Transition {
 	trans := make([][]*Transition, len(ls.Sets()))
 	for i, set := range ls.Sets() {
-		trans[i] = getSetTransitions(set)
+		trans[i] = getSetTransitions(set, tables)
 	}
 	return trans
 }
 
-func getSetTransitions(set *items.Set) []*Transition {
+func getSetTransitions(set *items.Set, tables *unicodeTables) []*Transition {
 	trans := make([]*Transition, len(set.Transitions))
 	for i, t := range set.Transitions {
-		trans[i] = getTransition(t)
+		trans[i] = getTransition(t, tables)
 	}
 	return trans
 }
 
-func getTransition(t *items.Transition) *Transition {
+func getTransition(t *items.Transition, tables *unicodeTables) *Transition {
 	return &Transition{
-		Condition: getCondition(t.Event),
+		Condition: getCondition(t.Event, tables),
 		NextState: t.To.No,
 	}
 }
 
-func getCondition(event ast.LexBase) string {
+func getCondition(event ast.LexBase, tables *unicodeTables) string {
 	switch e := event.(type) {
 	case *ast.Any:
 		return "true"
 	case *ast.AnyOf:
 		return fmt.Sprintf("any(c, %s)", toSlice(e.Set))
 	case *ast.CharLiteral:
+		if len(e.Literal) > 4 {
+			// hex or Unicode code point escape
+			return fmt.Sprintf("c == %s", toChar(e.Char()))
+		}
 		return fmt.Sprintf("c == %s", string(e.Literal))
+	case *ast.CharRange:
+		return getCharRangeCondition(e)
 	case *ast.Not:
 		return fmt.Sprintf("not(c, %s)", toSlice(e.Set))
 	case *ast.UnicodeClass:
@@ -124,10 +201,98 @@ func getCondition(event ast.LexBase) string {
 			return "c.is_whitespace()"
 		}
 		panic(fmt.Sprintf("Invalid type %d", e.Type))
+	case *ast.UnicodeSet:
+		return getUnicodeSetCondition(e, tables)
 	}
 	panic(fmt.Sprintf("Invalid event %T", event))
 }
 
+func getCharRangeCondition(cr *ast.CharRange) string {
+	return fmt.Sprintf("(%s..=%s).contains(&c)", toChar(cr.From.Char()), toChar(cr.To.Char()))
+}
+
+func getUnicodeSetCondition(e *ast.UnicodeSet, tables *unicodeTables) string {
+	var incl, excl []string
+	for _, rng := range e.Ranges {
+		var cond string
+		if rng.Type == ast.CharacterRange {
+			cond = getCharRangeCondition(rng.CharRange)
+		} else {
+			cond = fmt.Sprintf("in_table(c, &%s)", tables.add(rng))
+		}
+		if rng.Exclude {
+			excl = append(excl, cond)
+		} else {
+			incl = append(incl, cond)
+		}
+	}
+	w := new(bytes.Buffer)
+	fmt.Fprintf(w, "(%s)", strings.Join(incl, " || "))
+	if len(excl) > 0 {
+		fmt.Fprintf(w, " && !(%s)", strings.Join(excl, " || "))
+	}
+	return w.String()
+}
+
+// unicodeTables contains the Unicode category and property tables used by
+// the UnicodeSets of the lexer
+type unicodeTables struct {
+	tables []*UnicodeTable
+	names  map[string]bool
+}
+
+func newUnicodeTables() *unicodeTables {
+	return &unicodeTables{
+		names: make(map[string]bool),
+	}
+}
+
+// add adds the table of the category or property of rng and returns the name
+// of the table
+func (ts *unicodeTables) add(rng *ast.UnicodeRange) string {
+	name := "UNICODE_" + strings.ToUpper(rng.Range)
+	if !ts.names[name] {
+		ts.names[name] = true
+		ts.tables = append(ts.tables, &UnicodeTable{
+			Name:   name,
+			Ranges: getRanges(rng.GetRangeTable()),
+		})
+	}
+	return name
+}
+
+// getRanges returns the sorted, merged ranges of rt as Rust (lo, hi) tuples
+func getRanges(rt *unicode.RangeTable) (ranges []string) {
+	type rng struct{ lo, hi rune }
+	var rngs []rng
+	add := func(lo, hi rune) {
+		if n := len(rngs); n > 0 && rngs[n-1].hi+1 == lo {
+			rngs[n-1].hi = hi
+		} else {
+			rngs = append(rngs, rng{lo, hi})
+		}
+	}
+	addRange := func(lo, hi, stride rune) {
+		if stride == 1 {
+			add(lo, hi)
+			return
+		}
+		for r := lo; r <= hi; r += stride {
+			add(r, r)
+		}
+	}
+	for _, r16 := range rt.R16 {
+		addRange(rune(r16.Lo), rune(r16.Hi), rune(r16.Stride))
+	}
+	for _, r32 := range rt.R32 {
+		addRange(rune(r32.Lo), rune(r32.Hi), rune(r32.Stride))
+	}
+	for _, r := range rngs {
+		ranges = append(ranges, fmt.Sprintf("(0x%x, 0x%x)", r.lo, r.hi))
+	}
+	return
+}
+
 func escape(c rune) string {
 	switch c {
 	case '\'':
@@ -144,6 +309,14 @@ func escape(c rune) string {
 	return string(c)
 }
 
+// toChar returns the Rust char literal of c
+func toChar(c rune) string {
+	if unicode.IsPrint(c) || c == '\n' || c == '\r' || c == '\t' {
+		return fmt.Sprintf("'%s'", escape(c))
+	}
+	return fmt.Sprintf("'\\u{%x}'", c)
+}
+
 func toSlice(rs *runeset.RuneSet) string {
 	w := new(bytes.Buffer)
 	fmt.Fprint(w, "&[")
@@ -163,6 +336,9 @@ const tmplSrc = `
 use crate::token;
 
 use std::{fs, io};
+use std::cell::Cell;
+use std::collections::HashMap;
+use std::fmt;
 use std::rc::Rc;
 
 type State = usize;
@@ -178,7 +354,86 @@ pub struct Lexer {
 	i: Rc<Vec<char>>,
 
 	/// tokens is the vector of tokens constructed by the lexer from I
-	pub tokens: Vec<Rc<token::Token>>
+	pub tokens: Vec<Rc<token::Token>>,
+
+	/// errors contains the lexical errors in the input in order of occurrence
+	pub errors: Vec<LexError>,
+
+	/// trivia is true if the lexer attaches trivia to the tokens
+	trivia: bool,
+}
+
+/// LexError is a lexical error: the lexer could not scan a token from the input.
+#[derive(Clone)]
+pub struct LexError {
+	/// token is the Error token in Lexer.tokens, which contains the input
+	/// skipped by the lexer.
+	pub token: Rc<token::Token>,
+
+	/// pos is the position of the offending char in the input.
+	/// If the lexer reached the end of the input pos == input.len()
+	pub pos: usize,
+
+	/// The line of the offending char in the input
+	pub line: usize,
+
+	/// The column of the offending char in the input
+	pub column: usize,
+
+	/// ch is the offending char. It is None at the end of the input.
+	pub ch: Option<char>,
+
+	/// partial contains the token types that were partially matched when
+	/// the error occurred. It is empty if no token matches the first char.
+	pub partial: Vec<token::Type>,
+}
+
+/// Recovery is the strategy used by the lexer to continue after a lexical error
+#[derive(Clone, Copy, PartialEq, Eq, Debug)]
+pub enum Recovery {
+	/// SkipChar continues scanning at the char after the offending char
+	SkipChar,
+
+	/// SkipToSpace continues scanning at the first whitespace after the
+	/// offending char
+	SkipToSpace,
+}
+
+thread_local! {
+	static ERROR_RECOVERY: Cell<Recovery> = Cell::new(Recovery::SkipChar);
+}
+
+/// set_error_recovery sets the error recovery strategy of the lexers created
+/// on the current thread after it is set. The default is Recovery::SkipChar.
+#[allow(dead_code)]
+pub fn set_error_recovery(r: Recovery) {
+	ERROR_RECOVERY.with(|rec| rec.set(r))
+}
+
+/// error_recovery returns the error recovery strategy of the current thread
+pub fn error_recovery() -> Recovery {
+	ERROR_RECOVERY.with(|rec| rec.get())
+}
+
+/**
+Edit describes how an edit of the input by Lexer::edit changed the tokens.
+
+tokens[first..first+num_old] before the edit were replaced by the re-lexed
+tokens[first..first+num_new]. The index of every token after them changed by
+num_new-num_old.
+*/
+#[derive(Clone, Copy, PartialEq, Eq, Debug)]
+pub struct Edit {
+	/// The edit of the input: deleted chars at offset were replaced by
+	/// inserted chars.
+	pub offset: usize,
+	pub deleted: usize,
+	pub inserted: usize,
+
+	/// The re-lexed window of tokens
+	pub first: usize,
+	pub num_old: usize,
+	pub num_new: usize,
 }
 
 impl Lexer {
@@ -197,41 +452,153 @@ impl Lexer {
 		Ok(Lexer::new(i))
 	}
 
+	/// new_file_with_trivia is like new_file but attaches trivia to the tokens,
+	/// like new_with_trivia.
+	#[allow(dead_code)]
+	pub fn new_file_with_trivia(fname: &String) -> io::Result<Rc<Lexer>> {
+		let i = Rc::new(load_file(fname)?);
+		Ok(Lexer::new_with_trivia(i))
+	}
+
 	/**
 	new constructs a Lexer from a Vec<char>. 
 	
 	All contents of the input are treated as input text.
 	*/
 	pub fn new(input: Rc<Vec<char>>) -> Rc<Lexer> {
+		Rc::new(Lexer::lex(input, false))
+	}
+
+	/**
+	new_with_trivia constructs a Lexer from a Vec<char>, like new, but keeps
+	the whitespace and suppressed tokens between the tokens as trivia attached
+	to the neighbouring tokens.
+
+	The trailing trivia of a token extends up to and including the end of its line.
+	The rest of the trivia between two tokens is the leading trivia of the second
+	token. Trivia at the end of the input is the leading trivia of the EOF token.
+	The input can be reproduced by concatenating token::Token::full_literal() of
+	all the tokens.
+	*/
+	#[allow(dead_code)]
+	pub fn new_with_trivia(input: Rc<Vec<char>>) -> Rc<Lexer> {
+		Rc::new(Lexer::lex(input, true))
+	}
+
+	fn lex(input: Rc<Vec<char>>, trivia: bool) -> Lexer {
 		let mut lex = Lexer{
-			i:      input.clone(),
+			i:      input,
 			tokens: Vec::new(),
+			errors: Vec::new(),
+			trivia: trivia,
 		};
-		let mut lext = 0;
-		while lext < lex.i.len() {
-			while lext < lex.i.len() && lex.i[lext].is_whitespace() {
+		lex.tokens = lex.scan_tokens(0, None, &mut |_| false);
+		lex
+	}
+
+	/**
+	scan_tokens scans the tokens from position lext in the input up to and 
+	including the EOF token, or the first token for which stop returns true.
+	prev is the token before lext. Its trailing trivia is set if the lexer
+	keeps trivia.
+	*/
+	fn scan_tokens(&mut self, mut lext: usize, mut prev: Option<Rc<token::Token>>,
+		stop: &mut dyn FnMut(&token::Token) -> bool) -> Vec<Rc<token::Token>> {
+
+		let mut toks = Vec::new();
+		loop {
+			let tok = if self.trivia {
+				self.scan_with_trivia(lext, prev)
+			} else {
+				self.scan_token(lext)
+			};
+			toks.push(tok.clone());
+			if tok.typ == token::Type::EOF || stop(&tok) {
+				return toks
+			}
+			lext = tok.rext;
+			prev = Some(tok);
+		}
+	}
+
+	/// scan_token returns the first token after position lext in the input
+	/// that is not suppressed.
+	fn scan_token(&mut self, mut lext: usize) -> Rc<token::Token> {
+		loop {
+			while lext < self.i.len() && self.i[lext].is_whitespace() {
 				lext += 1
 			}
-			if lext < lex.i.len() {
-				let tok = lex.scan(lext);
-				lext = tok.rext;
-				if !tok.suppress() {
-					lex.add_token(tok)
-				}
+			if lext >= self.i.len() {
+				return token::new(token::Type::EOF, self.i.len(), self.i.len(), &self.i)
+			}
+			let mut tok = self.scan(lext);
+			if tok.typ == token::Type::Error {
+				tok = self.lex_error(tok)
+			}
+			if !tok.suppress() {
+				return tok
 			}
+			lext = tok.rext
 		}
-		lex.add(token::Type::EOF, input.len(), input.len());
-		Rc::new(lex)
 	}
 
-	fn add(&mut self, t: token::Type, lext: usize, rext: usize) {
-		self.add_token(token::new(t, lext, rext, &self.i))
+	/// scan_with_trivia returns the first token after position lext in the input
+	/// that is not suppressed. It attaches the trivia from lext to the token
+	/// to prev and the token.
+	fn scan_with_trivia(&mut self, mut lext: usize, prev: Option<Rc<token::Token>>) 
+		-> Rc<token::Token> {
+
+		let trivia_lext = lext;
+		let mut suppressed: Vec<Rc<token::Token>> = Vec::new();
+		let tok = loop {
+			while lext < self.i.len() && self.i[lext].is_whitespace() {
+				lext += 1
+			}
+			if lext >= self.i.len() {
+				break token::new(token::Type::EOF, self.i.len(), self.i.len(), &self.i)
+			}
+			let t = self.scan(lext);
+			if t.suppress() {
+				lext = t.rext;
+				suppressed.push(t)
+			} else if t.typ == token::Type::Error {
+				break self.lex_error(t)
+			} else {
+				break t
+			}
+		};
+		let mut split = trivia_lext;
+		let mut num_trailing = 0;
+		if let Some(prev) = prev {
+			split = self.end_of_line(trivia_lext, tok.lext, &suppressed);
+			while num_trailing < suppressed.len() && suppressed[num_trailing].lext < split {
+				num_trailing += 1
+			}
+			prev.set_trailing_trivia(split, suppressed[..num_trailing].to_vec())
+		}
+		tok.set_leading_trivia(split, suppressed[num_trailing..].to_vec());
+		tok
 	}
-	
-	fn add_token(&mut self, tok: Rc<token::Token>) {
-		self.tokens.push(tok)
+
+	/// end_of_line returns the position after the first newline in the trivia
+	/// from lext to rext, that is not part of a suppressed token. It returns rext
+	/// if there is no such newline.
+	fn end_of_line(&self, lext: usize, rext: usize, suppressed: &[Rc<token::Token>]) -> usize {
+		let (mut i, mut j) = (lext, 0);
+		while i < rext {
+			if j < suppressed.len() && suppressed[j].lext == i {
+				i = suppressed[j].rext;
+				j += 1;
+				continue
+			}
+			if self.i[i] == '\n' {
+				return i + 1
+			}
+			i += 1
+		}
+		rext
 	}
-	
+
 	fn scan(&mut self, i: usize) -> Rc<token::Token> {
 		let mut s: State = 0;
 		let mut typ = token::Type::Error;
@@ -249,9 +616,150 @@ impl Lexer {
 				}
 			}
 		}
+{{- if .Keywords}}
+		let typ = keyword_type(typ, &self.i[i..rext]);
+{{- end}}
 		return token::new(typ, i, rext, &self.i)
 	}
 
+	/**
+	lex_error records the LexError for the Error token, tok, and returns the
+	Error token extended by the error recovery strategy, error_recovery().
+	*/
+	fn lex_error(&mut self, tok: Rc<token::Token>) -> Rc<token::Token> {
+		let (mut s, mut pos) = (0, tok.lext);
+		while pos < self.i.len() {
+			let next = NEXT_STATE[s](self.i[pos]);
+			if next == NULL_STATE {
+				break
+			}
+			s = next;
+			pos += 1
+		}
+		let mut tok = tok;
+		if error_recovery() == Recovery::SkipToSpace {
+			let mut rext = tok.rext;
+			while rext < self.i.len() && !self.i[rext].is_whitespace() {
+				rext += 1
+			}
+			tok = token::new(token::Type::Error, tok.lext, rext, &self.i)
+		}
+		let (line, column) = self.get_line_column(pos);
+		self.errors.push(LexError{
+			token: tok.clone(),
+			pos: pos,
+			line: line,
+			column: column,
+			ch: self.i.get(pos).cloned(),
+			partial: PARTIAL[s].to_vec(),
+		});
+		tok
+	}
+
+	/**
+	edit replaces the deleted chars at offset in the input with inserted and
+	re-lexes only the tokens damaged by the edit. It returns the lexer of the
+	edited input, whose tokens after the damaged window are moved and their
+	indices shifted, and whose lexical errors are updated. The lexer is not
+	changed.
+	*/
+	#[allow(dead_code)]
+	pub fn edit(&self, offset: usize, deleted: usize, inserted: &[char]) -> (Rc<Lexer>, Edit) {
+		let mut input = Vec::with_capacity(self.i.len() - deleted + inserted.len());
+		input.extend_from_slice(&self.i[..offset]);
+		input.extend_from_slice(inserted);
+		input.extend_from_slice(&self.i[offset+deleted..]);
+		let input = Rc::new(input);
+		let delta = inserted.len() as isize - deleted as isize;
+		let mv = |i: usize| (i as isize + delta) as usize;
+
+		// The first damaged token is the first token that ends at or after offset,
+		// because the lexer reads the char after a token to find its end.
+		let old = &self.tokens;
+		let mut first = 0;
+		while first < old.len()-1 && old[first].rext < offset {
+			first += 1
+		}
+
+		let mut lex = Lexer{
+			i:      input.clone(),
+			tokens: old[..first].iter().map(|tok| tok.shift(0, &input)).collect(),
+			errors: Vec::new(),
+			trivia: self.trivia,
+		};
+
+		let (lext, prev) = match lex.tokens.last() {
+			Some(prev) => (prev.rext, Some(prev.clone())),
+			None => (0, None),
+		};
+		// Re-lex until a token is the same as an old token after the edit.
+		let mut j = first;
+		let relexed = lex.scan_tokens(lext, prev, &mut |tok| {
+			while j < old.len() &&
+				(old[j].lext < offset+deleted || mv(old[j].lext) < tok.lext) {
+				j += 1
+			}
+			j < old.len() && old[j].typ == tok.typ &&
+				mv(old[j].lext) == tok.lext && mv(old[j].rext) == tok.rext
+		});
+		let mut num_old = old.len() - first;
+		let last = &relexed[relexed.len()-1];
+		if last.typ != token::Type::EOF {
+			num_old = j - first + 1;
+			if lex.trivia {
+				let tok = old[j].shift(delta, &input);
+				last.set_trailing_trivia(tok.rext + tok.trailing_trivia().len(), 
+					tok.trailing_suppressed())
+			}
+		}
+		let num_new = relexed.len();
+		lex.tokens.extend(relexed);
+		for tok in old[first+num_old..].iter() {
+			lex.tokens.push(tok.shift(delta, &input))
+		}
+
+		lex.update_errors(old, &self.errors, first, num_old, num_new, delta);
+
+		(Rc::new(lex), Edit{
+			offset: offset,
+			deleted: deleted,
+			inserted: inserted.len(),
+			first: first,
+			num_old: num_old,
+			num_new: num_new,
+		})
+	}
+
+	// update_errors moves the lexical errors, old_errors, before and after the 
+	// re-lexed tokens to the new tokens. self.errors contains the errors of the
+	// re-lexed tokens.
+	fn update_errors(&mut self, old: &[Rc<token::Token>], old_errors: &[LexError],
+		first: usize, num_old: usize, num_new: usize, delta: isize) {
+
+		let index: HashMap<*const token::Token, usize> = old.iter().enumerate()
+			.map(|(i, tok)| (Rc::as_ptr(tok), i)).collect();
+		let mut before = Vec::new();
+		let mut after = Vec::new();
+		for err in old_errors.iter() {
+			let i = index[&Rc::as_ptr(&err.token)];
+			let mut err1 = err.clone();
+			if i < first {
+				err1.token = self.tokens[i].clone();
+				before.push(err1)
+			} else if i >= first+num_old {
+				err1.token = self.tokens[i+num_new-num_old].clone();
+				err1.pos = (err.pos as isize + delta) as usize;
+				let (line, column) = self.get_line_column(err1.pos);
+				err1.line = line;
+				err1.column = column;
+				after.push(err1)
+			}
+		}
+		before.append(&mut self.errors);
+		before.append(&mut after);
+		self.errors = before
+	}
+
 	/// get_line_column returns the (line, column) of char[i] in the input
 	#[allow(dead_code)]
 	pub fn get_line_column(&self, i: usize) -> (usize, usize) {
@@ -291,6 +799,41 @@ impl Lexer {
 	}
 /*** End of Lexer implementation ***/
 
+impl Edit {
+	/**
+	unchanged returns the token extents before the edit of the tokens from
+	lext to rext after the edit. It returns None if the tokens from lext up to
+	and including rext overlap the re-lexed tokens.
+
+	A subtree of the parse forest with unchanged extents is not changed by the edit.
+	*/
+	#[allow(dead_code)]
+	pub fn unchanged(&self, lext: usize, rext: usize) -> Option<(usize, usize)> {
+		if rext < self.first {
+			Some((lext, rext))
+		} else if lext >= self.first + self.num_new {
+			Some((lext - self.num_new + self.num_old, rext - self.num_new + self.num_old))
+		} else {
+			None
+		}
+	}
+}
+
+impl fmt::Display for LexError {
+	fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+		write!(f, "Lexical error at line {} col {}: ", self.line, self.column)?;
+		match self.ch {
+			None => write!(f, "unexpected end of input")?,
+			Some(c) => write!(f, "unexpected {:?}", c)?,
+		}
+		if self.partial.len() > 0 {
+			let ids: Vec<&str> = self.partial.iter().map(|t| t.id()).collect();
+			write!(f, " while scanning one of [{}]", ids.join(","))?
+		}
+		Ok(())
+	}
+}
+
 
 fn load_file(fname: &String) -> io::Result<Vec<char>> {
 	let input = fs::read_to_string(fname)?;
@@ -348,6 +891,28 @@ fn not(r: char, set: &'static [char]) -> bool {
 	}
 	return true
 }
+{{- if .UnicodeTables}}
+
+/// in_table returns true iff c is in one of the sorted (lo, hi) ranges of table
+fn in_table(c: char, table: &[(u32, u32)]) -> bool {
+	let c = c as u32;
+	table.binary_search_by(|&(lo, hi)| {
+		if hi < c {
+			std::cmp::Ordering::Less
+		} else if lo > c {
+			std::cmp::Ordering::Greater
+		} else {
+			std::cmp::Ordering::Equal
+		}
+	}).is_ok()
+}
+{{- range $t := .UnicodeTables}}
+
+static {{$t.Name}}: [(u32, u32); {{len $t.Ranges}}] = [ {{- range $i, $r := $t.Ranges}}{{if eq 0 (mod8 $i)}}
+	{{else}} {{end}}{{$r}},{{- end}}
+];
+{{- end}}
+{{- end}}
 
 static ACCEPT: [token::Type; {{len .Accept}}] = [ {{range $tok := .Accept}}
     token::Type::{{$tok}}, {{end}}
@@ -355,6 +920,10 @@ static ACCEPT: [token::Type; {{len .Accept}}] = [ {{range $tok := .Accept}}
 
 pub type NextFun = dyn Fn(char) -> State + Sync;
 
+static PARTIAL: [&[token::Type]; {{len .Partial}}] = [ {{range $types := .Partial}}
+    &[ {{range $tok := $types}}token::Type::{{$tok}}, {{end}}], {{end}}
+];
+
 static NEXT_STATE: &'static [&NextFun; {{len .Accept}}] = &[  {{range $i, $set := .Transitions}}
 	// Set{{$i}} {{if (len $set)}}
 	&|c| -> State { {{ else }}
@@ -363,4 +932,55 @@ static NEXT_STATE: &'static [&NextFun; {{len .Accept}}] = &[  {{range $i, $set :
         NULL_STATE
 	}, {{end}}
 ];
+{{- if .Keywords}}
+
+struct Keyword {
+    key: &'static str,
+    typ: token::Type,
+    class: token::Type,
+    case_insensitive: bool,
+}
+
+/// KEYWORDS is the perfect hash table of the keywords scanned by
+/// identifier lex rules
+static KEYWORDS: [Option<Keyword>; {{len .Keywords}}] = [ {{range $kw := .Keywords}}
+    {{if $kw}}Some(Keyword{ key: {{printf "%q" $kw.Key}}, typ: token::Type::{{$kw.Type}}, class: token::Type::{{$kw.Class}}, case_insensitive: {{$kw.CaseInsensitive}} }){{else}}None{{end}}, {{- end}}
+];
+
+const KEYWORD_SEED: u32 = {{.KeywordSeed}};
+
+/// keyword_type returns the token type of the keyword lit if class is the
+/// identifier token type of the keyword. Otherwise it returns class.
+fn keyword_type(class: token::Type, lit: &[char]) -> token::Type {
+    let lit: String = lit.iter().collect();
+    if let Some(kw) = lookup_keyword(&lit) {
+        if kw.class == class {
+            return kw.typ
+        }
+    }
+    if let Some(kw) = lookup_keyword(&lit.to_lowercase()) {
+        if kw.class == class && kw.case_insensitive {
+            return kw.typ
+        }
+    }
+    class
+}
+
+fn lookup_keyword(key: &str) -> Option<&'static Keyword> {
+    match &KEYWORDS[(keyword_hash(key) as usize) & (KEYWORDS.len() - 1)] {
+        Some(kw) if kw.key == key => Some(kw),
+        _ => None,
+    }
+}
+
+/// keyword_hash is the FNV-1a hash of key, starting with KEYWORD_SEED
+fn keyword_hash(key: &str) -> u32 {
+    let mut h: u32 = 2166136261 ^ KEYWORD_SEED;
+    for b in key.bytes() {
+        h ^= b as u32;
+        h = h.wrapping_mul(16777619);
+    }
+    h
+}
+{{- end}}
 `
@@ -91,9 +91,7 @@ func getBasicProd(prod *basicprod.Production) *BasicProd {
 			prod.Head,
 			strings.Join(prod.Body.GetSymbols(), " ")),
 
-		ID: fmt.Sprintf("%s_%d",
-			strcase.ToSnake(prod.Head),
-			prod.Alternate),
+		ID: ReduceFuncID(prod),
 
 		Params: getParams(prod.Body),
 
@@ -101,6 +99,16 @@ func getBasicProd(prod *basicprod.Production) *BasicProd {
 	}
 }
 
+// ReduceFuncID returns the name of the reduce function of prod, which is the
+// head of prod followed by the alternate name, e.g.: expr_binary, or by the
+// alternate number, e.g.: expr_0.
+func ReduceFuncID(prod *basicprod.Production) string {
+	if prod.Body.Name != "" {
+		return strcase.ToSnake(prod.Head) + "_" + strcase.ToSnake(prod.Body.Name)
+	}
+	return fmt.Sprintf("%s_%d", strcase.ToSnake(prod.Head), prod.Alternate)
+}
+
 func getParams(body *ast.SyntaxAlternate) (params []*Param) {
 	for i, sym := range body.Symbols {
 		var param *Param
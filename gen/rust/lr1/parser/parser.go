@@ -43,6 +43,8 @@ type parserData struct {
 	NumStates      int
 	NumTerminals   int
 	Package        string
+	// StartSymbols[i] is the start symbol of start state i
+	StartSymbols []string
 }
 
 func getParserData(pkg string, prods []*basicprod.Production, states *states.States) *parserData {
@@ -51,6 +53,7 @@ func getParserData(pkg string, prods []*basicprod.Production, states *states.Sta
 		NumStates:      states.Size(),
 		NumTerminals:   len(symbols.GetTerminals()),
 		Package:        pkg,
+		StartSymbols:   basicprod.StartSymbols(prods),
 	}
 }
 
@@ -122,16 +125,36 @@ pub struct Parser {
 	i: usize,
 }
 
+/// START_STATES maps the start symbols of the grammar to their start states
+const START_STATES: [(&str, usize); {{len .StartSymbols}}] = [ {{- range $i, $nt := .StartSymbols}}
+	("{{$nt}}", {{$i}}),{{- end}}
+];
+
 impl Parser {
+	/// new returns a parser for the default start symbol of the grammar
 	#[allow(dead_code)]
 	pub fn new(lex: Rc<lexer::Lexer>) -> Box<Parser> {
+		Parser::new_parser(0, lex)
+	}
+
+	/// new_from returns a parser for start symbol nt, which must be declared
+	/// in the start declaration of the grammar.
+	#[allow(dead_code)]
+	pub fn new_from(nt: &str, lex: Rc<lexer::Lexer>) -> Box<Parser> {
+		match START_STATES.iter().find(|(s, _)| *s == nt) {
+			Some((_, state)) => Parser::new_parser(*state, lex),
+			None => panic!("{} is not a start symbol", nt),
+		}
+	}
+
+	fn new_parser(state: usize, lex: Rc<lexer::Lexer>) -> Box<Parser> {
 		let mut p = Box::new(Parser{
 				stack:  Stack::new(),
 				next_token: lex.tokens[0].clone(),
 				lex:    lex,
 				i:      1,
 		});
-		p.stack.push(0, ast::Node::None);
+		p.stack.push(state, ast::Node::None);
 		p
 	}
 
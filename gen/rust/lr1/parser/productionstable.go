@@ -22,11 +22,11 @@ import (
 	"text/template"
 
 	"github.com/goccmack/gogll/v3/cfg"
+	"github.com/goccmack/gogll/v3/gen/rust/lr1/ast"
 	"github.com/goccmack/gogll/v3/lr1/basicprod"
 	"github.com/goccmack/gogll/v3/lr1/states"
 	"github.com/goccmack/gogll/v3/symbols"
 	"github.com/goccmack/goutil/ioutil"
-	"github.com/iancoleman/strcase"
 )
 
 type prodsTabData struct {
@@ -72,13 +72,10 @@ func getProdsTab(pkg string, prods []*basicprod.Production, states *states.State
 			data.ProdTab[i].ReduceFunc = fmt.Sprintf("Ok(None)")
 		} else {
 			data.ProdTab[i].NumSymbols = len(prod.Body.Symbols)
-			data.ProdTab[i].ReduceFuncID = fmt.Sprintf("%s_%d",
-				strcase.ToSnake(prod.Head),
-				prod.Alternate)
+			data.ProdTab[i].ReduceFuncID = ast.ReduceFuncID(prod)
 			data.ProdTab[i].ReduceFuncParams = getParamIDs(len(prod.Body.Symbols))
-			data.ProdTab[i].ReduceFunc = fmt.Sprintf("%s_%d(%s)",
-				strcase.ToSnake(prod.Head),
-				prod.Alternate,
+			data.ProdTab[i].ReduceFunc = fmt.Sprintf("%s(%s)",
+				ast.ReduceFuncID(prod),
 				getParamIDs(len(prod.Body.Symbols)))
 		}
 	}
@@ -19,6 +19,7 @@ import (
 	"bytes"
 	"text/template"
 
+	"github.com/goccmack/gogll/v3/gen/golang/utils"
 	"github.com/goccmack/gogll/v3/symbols"
 	"github.com/goccmack/goutil/ioutil"
 )
@@ -61,7 +62,7 @@ func getTypes() (types []*TypeDef) {
 			&TypeDef{
 				Name: t.TypeString(),
 
-				Comment:  t.Literal(),
+				Comment:  utils.Escape(t.Literal()),
 				Suppress: t.Suppress(),
 			})
 	}
@@ -73,6 +74,7 @@ const tmplSrc = `
 
 extern crate lazy_static;
 
+use std::cell::{Cell, RefCell};
 use std::rc::Rc;
 use std::fmt;
 use lazy_static::lazy_static;
@@ -85,6 +87,12 @@ pub struct Token {
 	pub rext: usize,
 	
 	input: Rc<Vec<char>>,
+
+	// Trivia attached to the token by lexer::Lexer::new_with_trivia
+	lead_lext: Cell<usize>,
+	trail_rext: Cell<usize>,
+	leading: RefCell<Vec<Rc<Token>>>,
+	trailing: RefCell<Vec<Rc<Token>>>,
 }
 
 #[derive(PartialEq, Eq, Hash, Clone, Copy)]
@@ -103,10 +111,20 @@ pub fn new<'a>(t: Type, lext: usize, rext: usize, input: &Rc<Vec<char>>) -> Rc<T
 		lext:  lext,
 		rext:  rext,
 		input: input.clone(),
+		lead_lext: Cell::new(lext),
+		trail_rext: Cell::new(rext),
+		leading: RefCell::new(Vec::new()),
+		trailing: RefCell::new(Vec::new()),
 	})
 }
 
 impl Token {
+	/// full_literal returns the leading trivia, literal and trailing trivia of the token
+	#[allow(dead_code)]
+	pub fn full_literal(&self) -> Vec<char> {
+		self.input[self.lead_lext.get()..self.trail_rext.get()].to_vec()
+	}
+
 	/// get_line_column returns the (line, column) of the left extent of the token
 	pub fn get_line_column(&self) -> (usize, usize) {
 		let mut line = 1;
@@ -126,6 +144,19 @@ impl Token {
 		(line, col)
 	}
 
+	/// leading_suppressed returns the suppressed tokens in the leading trivia of the token
+	#[allow(dead_code)]
+	pub fn leading_suppressed(&self) -> Vec<Rc<Token>> {
+		self.leading.borrow().clone()
+	}
+
+	/// leading_trivia returns the whitespace and suppressed tokens preceding the
+	/// token, which are attached to it by lexer::Lexer::new_with_trivia
+	#[allow(dead_code)]
+	pub fn leading_trivia(&self) -> Vec<char> {
+		self.input[self.lead_lext.get()..self.lext].to_vec()
+	}
+
 	/// returns the id of the token
 	#[allow(dead_code)]
 	pub fn id(&self) -> &'static str {
@@ -143,12 +174,68 @@ impl Token {
 		self.literal().iter().collect::<String>()
 	}
 	
+	/**
+	set_leading_trivia sets the leading trivia of the token to the input from
+	lext to the left extent of the token. suppressed contains the suppressed
+	tokens in the trivia.
+	*/
+	pub fn set_leading_trivia(&self, lext: usize, suppressed: Vec<Rc<Token>>) {
+		self.lead_lext.set(lext);
+		*self.leading.borrow_mut() = suppressed
+	}
+
+	/**
+	set_trailing_trivia sets the trailing trivia of the token to the input from
+	the right extent of the token to rext. suppressed contains the suppressed
+	tokens in the trivia.
+	*/
+	pub fn set_trailing_trivia(&self, rext: usize, suppressed: Vec<Rc<Token>>) {
+		self.trail_rext.set(rext);
+		*self.trailing.borrow_mut() = suppressed
+	}
+
+	/**
+	shift returns a copy of the token, including its trivia, moved by delta
+	chars in the new input. It is used by the lexer to move tokens after an
+	edit of the input.
+	*/
+	pub fn shift(&self, delta: isize, input: &Rc<Vec<char>>) -> Rc<Token> {
+		let mv = |i: usize| (i as isize + delta) as usize;
+		Rc::new(Token{
+			typ: self.typ,
+			lext: mv(self.lext),
+			rext: mv(self.rext),
+			input: input.clone(),
+			lead_lext: Cell::new(mv(self.lead_lext.get())),
+			trail_rext: Cell::new(mv(self.trail_rext.get())),
+			leading: RefCell::new(self.leading.borrow().iter()
+				.map(|s| s.shift(delta, input)).collect()),
+			trailing: RefCell::new(self.trailing.borrow().iter()
+				.map(|s| s.shift(delta, input)).collect()),
+		})
+	}
+
 	/// returns true iff this token is suppressed by the lexer
 	#[allow(dead_code)]
 	pub fn suppress(&self) -> bool {
 		SUPPRESS[&self.typ]
 	}
 
+	/// trailing_suppressed returns the suppressed tokens in the trailing trivia
+	/// of the token
+	#[allow(dead_code)]
+	pub fn trailing_suppressed(&self) -> Vec<Rc<Token>> {
+		self.trailing.borrow().clone()
+	}
+
+	/// trailing_trivia returns the whitespace and suppressed tokens following the
+	/// token, up to and including the end of the line, which are attached to it
+	/// by lexer::Lexer::new_with_trivia
+	#[allow(dead_code)]
+	pub fn trailing_trivia(&self) -> Vec<char> {
+		self.input[self.rext..self.trail_rext.get()].to_vec()
+	}
+
 } // impl Token
 
 impl <'a>fmt::Display for Token {
@@ -0,0 +1,83 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package gtest
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+/*
+Diff returns the lines of exp that are not in got, prefixed with "-", and the
+lines of got that are not in exp, prefixed with "+". Each group of changed
+lines is preceded by the line numbers of the group in exp and got, e.g.:
+
+	@@ -3 +3 @@
+	-    id "x"
+	+    id "y"
+*/
+func Diff(exp, got string) string {
+	a, b := lines(exp), lines(got)
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	w := new(bytes.Buffer)
+	for i, j := 0, 0; i < len(a) || j < len(b); {
+		if i < len(a) && j < len(b) && a[i] == b[j] {
+			i, j = i+1, j+1
+			continue
+		}
+		// Collect the group of changed lines starting at a[i] and b[j]
+		i1, j1 := i, j
+		for (i1 < len(a) || j1 < len(b)) && !(i1 < len(a) && j1 < len(b) && a[i1] == b[j1]) {
+			if j1 >= len(b) || (i1 < len(a) && lcs[i1+1][j1] >= lcs[i1][j1+1]) {
+				i1++
+			} else {
+				j1++
+			}
+		}
+		fmt.Fprintf(w, "@@ -%d +%d @@\n", i+1, j+1)
+		for _, l := range a[i:i1] {
+			fmt.Fprintf(w, "-%s\n", l)
+		}
+		for _, l := range b[j:j1] {
+			fmt.Fprintf(w, "+%s\n", l)
+		}
+		i, j = i1, j1
+	}
+	return w.String()
+}
+
+func lines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
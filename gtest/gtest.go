@@ -0,0 +1,247 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+/*
+Package gtest runs the golden tests of a grammar with the grammar interpreter.
+
+The test data directory contains the inputs in two subdirectories:
+
+	accept: inputs that must parse without errors or ambiguities
+	reject: inputs that must fail to parse
+
+The golden files of an input have the name of the input with the extension
+replaced:
+
+	.tree:   the parse tree of an accepted input
+	.errors: the errors of a rejected input
+	.tokens: the tokens of the input
+
+A golden file that does not exist is not checked. For a grammar without
+syntax rules an input is accepted if it has no lexical errors.
+*/
+package gtest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/goccmack/gogll/v3/interp"
+)
+
+// Golden file extensions
+const (
+	TreeExt   = ".tree"
+	ErrorsExt = ".errors"
+	TokensExt = ".tokens"
+)
+
+// Test data subdirectories
+const (
+	AcceptDir = "accept"
+	RejectDir = "reject"
+)
+
+// Result is the result of a test run
+type Result struct {
+	Passed, Failed, Updated int
+}
+
+type runner struct {
+	g      *interp.Grammar
+	start  string
+	dir    string
+	update bool
+	w      io.Writer
+	res    *Result
+}
+
+/*
+Run runs the tests in the test data directory, dir, with grammar g and
+start symbol start, and reports the result of every input to w. If the
+grammar has no syntax rules start is ignored.
+If update is true the golden files of every input are written instead of
+being compared with the output.
+*/
+func Run(g *interp.Grammar, start, dir string, update bool, w io.Writer) (*Result, error) {
+	r := &runner{g, start, dir, update, w, &Result{}}
+	for _, sub := range []string{AcceptDir, RejectDir} {
+		inputs, err := inputFiles(filepath.Join(dir, sub))
+		if err != nil {
+			return nil, err
+		}
+		for _, in := range inputs {
+			if err := r.run(sub, in); err != nil {
+				return nil, err
+			}
+		}
+	}
+	fmt.Fprintf(w, "%d passed, %d failed", r.res.Passed, r.res.Failed)
+	if update {
+		fmt.Fprintf(w, ", %d golden files updated", r.res.Updated)
+	}
+	fmt.Fprintln(w)
+	return r.res, nil
+}
+
+// inputFiles returns the sorted names of the input files in dir, which need
+// not exist.
+func inputFiles(dir string) (inputs []string, err error) {
+	fis, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, fi := range fis {
+		if !fi.IsDir() && !isGolden(fi.Name()) {
+			inputs = append(inputs, filepath.Join(dir, fi.Name()))
+		}
+	}
+	sort.Strings(inputs)
+	return
+}
+
+func isGolden(fname string) bool {
+	switch filepath.Ext(fname) {
+	case TreeExt, ErrorsExt, TokensExt:
+		return true
+	}
+	return false
+}
+
+// run runs the test of input file in in test data subdirectory sub
+func (r *runner) run(sub, in string) error {
+	lex, err := r.g.NewLexerFile(in)
+	if err != nil {
+		return err
+	}
+	name, _ := filepath.Rel(r.dir, in)
+	var failures []string
+
+	outputs := map[string]string{TokensExt: output(lex.WriteTokens)}
+	if len(r.g.StartSymbols()) == 0 {
+		switch {
+		case len(lex.Errors) > 0 && sub == AcceptDir:
+			failures = append(failures, "lexical errors\n"+output(lex.WriteErrors))
+		case len(lex.Errors) == 0 && sub == RejectDir:
+			failures = append(failures, "scanned without errors")
+		}
+	} else {
+		bsrSet, errs := r.g.Parse(r.start, lex)
+		switch {
+		case errs != nil:
+			outputs[ErrorsExt] = output(func(w io.Writer) { interp.WriteErrors(w, errs) })
+			if sub == AcceptDir {
+				failures = append(failures, "parse errors\n"+outputs[ErrorsExt])
+			}
+		case bsrSet.IsAmbiguous():
+			failures = append(failures, "ambiguous\n"+output(bsrSet.ReportAmbiguous))
+		default:
+			outputs[TreeExt] = output(bsrSet.WriteTree)
+			if sub == RejectDir {
+				failures = append(failures, "parsed without errors")
+			}
+		}
+	}
+
+	for _, ext := range []string{TreeExt, ErrorsExt, TokensExt} {
+		out, ok := outputs[ext]
+		if !ok {
+			continue
+		}
+		golden := strings.TrimSuffix(in, filepath.Ext(in)) + ext
+		if r.update {
+			if err := r.updateGolden(sub, golden, ext, out); err != nil {
+				return err
+			}
+			continue
+		}
+		exp, err := ioutil.ReadFile(golden)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if string(exp) != out {
+			gname, _ := filepath.Rel(r.dir, golden)
+			failures = append(failures, fmt.Sprintf("output differs from %s\n%s",
+				gname, Diff(string(exp), out)))
+		}
+	}
+
+	if len(failures) == 0 {
+		r.res.Passed++
+		fmt.Fprintf(r.w, "PASS %s\n", name)
+		return nil
+	}
+	r.res.Failed++
+	for _, f := range failures {
+		fmt.Fprintf(r.w, "FAIL %s: %s", name, indent(f))
+	}
+	return nil
+}
+
+/*
+updateGolden writes out to the golden file if it exists. Otherwise it creates
+the tree golden file of an accepted input and the errors golden file of a
+rejected input of a grammar with syntax rules, and the tokens golden file of
+an input of a grammar without syntax rules.
+*/
+func (r *runner) updateGolden(sub, golden, ext, out string) error {
+	if _, err := os.Stat(golden); os.IsNotExist(err) {
+		var create bool
+		switch {
+		case len(r.g.StartSymbols()) == 0:
+			create = ext == TokensExt
+		case ext == TreeExt:
+			create = sub == AcceptDir
+		case ext == ErrorsExt:
+			create = sub == RejectDir
+		}
+		if !create {
+			return nil
+		}
+	}
+	if exp, err := ioutil.ReadFile(golden); err == nil && string(exp) == out {
+		return nil
+	}
+	if err := ioutil.WriteFile(golden, []byte(out), 0644); err != nil {
+		return err
+	}
+	r.res.Updated++
+	gname, _ := filepath.Rel(r.dir, golden)
+	fmt.Fprintf(r.w, "UPDATE %s\n", gname)
+	return nil
+}
+
+// output returns the output written by write
+func output(write func(io.Writer)) string {
+	w := new(bytes.Buffer)
+	write(w)
+	return w.String()
+}
+
+// indent indents all lines of s after the first
+func indent(s string) string {
+	s = strings.TrimSuffix(s, "\n")
+	return strings.ReplaceAll(s, "\n", "\n    ") + "\n"
+}
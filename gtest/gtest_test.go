@@ -0,0 +1,92 @@
+package gtest
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/goccmack/gogll/v3/internal/loader"
+	"github.com/goccmack/gogll/v3/interp"
+)
+
+const src = `package "gtest"
+
+List : "[" "]" | "[" Items "]" ;
+
+Items : Item | Item "," Items ;
+
+Item : Item "+" Item | List | word ;
+
+word : letter {letter} ;
+`
+
+func newGrammar(t *testing.T) *interp.Grammar {
+	gr, errs := loader.Source("test.md", src)
+	if errs != nil {
+		t.Fatal(errs[0])
+	}
+	return gr.Interp()
+}
+
+func writeFile(t *testing.T, dir, name, content string) {
+	fname := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(fname), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(fname, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRun(t *testing.T) {
+	g, dir := newGrammar(t), t.TempDir()
+	writeFile(t, dir, "accept/list.txt", "[a, [b]]")
+	writeFile(t, dir, "reject/comma.txt", "[a,]")
+
+	w := new(bytes.Buffer)
+	res, err := Run(g, "List", dir, true, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Passed != 2 || res.Failed != 0 || res.Updated != 2 {
+		t.Fatalf("unexpected result %+v\n%s", res, w)
+	}
+	for _, golden := range []string{"accept/list.tree", "reject/comma.errors"} {
+		if _, err := os.Stat(filepath.Join(dir, golden)); err != nil {
+			t.Fatalf("golden file %s not written", golden)
+		}
+	}
+
+	writeFile(t, dir, "accept/list.tree", "List : [ ]\n")
+	writeFile(t, dir, "accept/ambiguous.txt", "[a + b + c]")
+	writeFile(t, dir, "reject/valid.txt", "[a]")
+	w.Reset()
+	res, err = Run(g, "List", dir, false, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Passed != 1 || res.Failed != 3 {
+		t.Fatalf("unexpected result %+v\n%s", res, w)
+	}
+	for _, exp := range []string{
+		"FAIL accept/ambiguous.txt: ambiguous",
+		"FAIL accept/list.txt: output differs from accept/list.tree",
+		"PASS reject/comma.txt",
+		"FAIL reject/valid.txt: parsed without errors",
+	} {
+		if !strings.Contains(w.String(), exp) {
+			t.Errorf("expected %q in output:\n%s", exp, w)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	diff := Diff("a\nb\nc\n", "a\nx\nc\nd\n")
+	exp := "@@ -2 +2 @@\n-b\n+x\n@@ -4 +4 @@\n+d\n"
+	if diff != exp {
+		t.Fatalf("expected:\n%s\ngot:\n%s", exp, diff)
+	}
+}
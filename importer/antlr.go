@@ -0,0 +1,560 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package importer
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/util/runeset"
+)
+
+/*
+antlrReader reads an ANTLR4 grammar. Combined, lexer and parser grammars are
+read. The rules of lexer modes are added to the default mode. Actions,
+predicates, rule arguments, return values, locals, options and exception
+handlers are dropped with a warning.
+*/
+type antlrReader struct {
+	*scanner
+	g *grammar
+}
+
+func readANTLR(file string, src []rune) (*grammar, error) {
+	r := &antlrReader{scanner: newScanner(file, src), g: &grammar{file: file}}
+	if err := r.grammarSpec(); err != nil {
+		return nil, err
+	}
+	return r.g, nil
+}
+
+func (r *antlrReader) grammarSpec() error {
+	r.skipSpace()
+	kind := r.ident("")
+	if kind == "lexer" || kind == "parser" {
+		r.skipSpace()
+		kind = r.ident("")
+	}
+	if kind != "grammar" {
+		return r.errorf("expected grammar declaration")
+	}
+	r.skipSpace()
+	if r.ident("") == "" {
+		return r.errorf("expected grammar name")
+	}
+	if err := r.expect(";"); err != nil {
+		return err
+	}
+	for {
+		r.skipSpace()
+		if r.peek() == eof {
+			return nil
+		}
+		if err := r.prequelOrRule(); err != nil {
+			return err
+		}
+	}
+}
+
+func (r *antlrReader) prequelOrRule() error {
+	pos := r.position()
+	if r.peek() == '@' {
+		return r.namedAction()
+	}
+	switch id := r.ident(""); id {
+	case "options":
+		opts, err := r.block('{', '}')
+		if err != nil {
+			return err
+		}
+		if strings.Contains(opts, "caseInsensitive") {
+			r.g.warn(pos, "option caseInsensitive is dropped: the lex rules are case sensitive")
+		} else {
+			r.g.warn(pos, "the grammar options are dropped")
+		}
+	case "tokens":
+		toks, err := r.block('{', '}')
+		if err != nil {
+			return err
+		}
+		for _, t := range strings.FieldsFunc(toks, func(c rune) bool { return c == ',' || unicode.IsSpace(c) }) {
+			r.g.tokens = append(r.g.tokens, &tokenDecl{name: t, pos: pos})
+		}
+	case "channels":
+		if _, err := r.block('{', '}'); err != nil {
+			return err
+		}
+		r.g.warn(pos, "channels are dropped: the tokens sent to a channel are suppressed")
+	case "import":
+		for r.peek() != ';' && r.peek() != eof {
+			r.next()
+		}
+		r.next()
+		r.g.warn(pos, "the imported grammars are not imported")
+	case "mode":
+		r.skipSpace()
+		name := r.ident("")
+		if err := r.expect(";"); err != nil {
+			return err
+		}
+		r.g.warn(pos, "lexer mode %s is dropped: its rules are added to the default mode", name)
+	case "fragment":
+		r.skipSpace()
+		return r.rule(r.ident(""), r.position(), true)
+	case "public", "private", "protected":
+		r.skipSpace()
+		return r.rule(r.ident(""), r.position(), false)
+	case "":
+		return r.errorf("unexpected %c", r.peek())
+	default:
+		return r.rule(id, pos, false)
+	}
+	return nil
+}
+
+// namedAction reads an action such as @header {...}
+func (r *antlrReader) namedAction() error {
+	pos := r.position()
+	r.next()
+	name := r.ident("")
+	if r.accept("::") {
+		name += "::" + r.ident("")
+	}
+	if _, err := r.block('{', '}'); err != nil {
+		return err
+	}
+	r.g.warn(pos, "action @%s is dropped", name)
+	return nil
+}
+
+// block skips white space and reads a block from open to close
+func (r *antlrReader) block(open, close rune) (string, error) {
+	r.skipSpace()
+	if r.peek() != open {
+		return "", r.errorf("expected %c", open)
+	}
+	return r.balanced(open, close)
+}
+
+// keyword consumes kw if it is the next word of the input
+func (r *antlrReader) keyword(kw string) bool {
+	r.skipSpace()
+	if !r.hasPrefix(kw) {
+		return false
+	}
+	c := r.peekAt(len(kw))
+	if c == '_' || unicode.IsLetter(c) || unicode.IsDigit(c) {
+		return false
+	}
+	return r.accept(kw)
+}
+
+func (r *antlrReader) rule(name string, pos *ast.Position, fragment bool) error {
+	if name == "" {
+		return r.errorf("expected rule name")
+	}
+	rl := &rule{
+		name:     name,
+		pos:      pos,
+		lex:      unicode.IsUpper([]rune(name)[0]),
+		fragment: fragment,
+	}
+	if err := r.rulePrequel(rl); err != nil {
+		return err
+	}
+	if err := r.expect(":"); err != nil {
+		return err
+	}
+	body, err := r.alternates(rl)
+	if err != nil {
+		return err
+	}
+	rl.body = body
+	if err := r.expect(";"); err != nil {
+		return err
+	}
+	r.g.rules = append(r.g.rules, rl)
+	return r.exceptions(rl)
+}
+
+// rulePrequel reads the arguments, return values, locals, options and actions
+// between the name and the colon of a rule
+func (r *antlrReader) rulePrequel(rl *rule) error {
+	for {
+		r.skipSpace()
+		pos := r.position()
+		switch {
+		case r.peek() == '[':
+			if _, err := r.balanced('[', ']'); err != nil {
+				return err
+			}
+			r.g.warn(pos, "the arguments of %s are dropped", rl.name)
+		case r.keyword("returns"), r.keyword("locals"):
+			if _, err := r.block('[', ']'); err != nil {
+				return err
+			}
+			r.g.warn(pos, "the return values and locals of %s are dropped", rl.name)
+		case r.keyword("throws"):
+			for r.skipSpace(); r.peek() != ':' && r.peek() != eof; r.skipSpace() {
+				r.next()
+			}
+		case r.keyword("options"):
+			if _, err := r.block('{', '}'); err != nil {
+				return err
+			}
+			r.g.warn(pos, "the options of %s are dropped", rl.name)
+		case r.peek() == '@':
+			r.next()
+			name := r.ident("")
+			if _, err := r.block('{', '}'); err != nil {
+				return err
+			}
+			r.g.warn(pos, "action @%s of %s is dropped", name, rl.name)
+		default:
+			return nil
+		}
+	}
+}
+
+// exceptions reads the catch and finally clauses after a rule
+func (r *antlrReader) exceptions(rl *rule) error {
+	for {
+		pos := r.position()
+		switch {
+		case r.keyword("catch"):
+			if _, err := r.block('[', ']'); err != nil {
+				return err
+			}
+			fallthrough
+		case r.keyword("finally"):
+			if _, err := r.block('{', '}'); err != nil {
+				return err
+			}
+			r.g.warn(pos, "the exception handler of %s is dropped", rl.name)
+		default:
+			return nil
+		}
+	}
+}
+
+// alternates reads alternates separated by | up to ; or )
+func (r *antlrReader) alternates(rl *rule) (*choice, error) {
+	ch := &choice{}
+	for {
+		a, err := r.alternative(rl)
+		if err != nil {
+			return nil, err
+		}
+		ch.alts = append(ch.alts, a)
+		if !r.accept("|") {
+			return ch, nil
+		}
+	}
+}
+
+func (r *antlrReader) alternative(rl *rule) (*alternate, error) {
+	r.skipSpace()
+	a := &alternate{pos: r.position()}
+	for {
+		r.skipSpace()
+		pos := r.position()
+		switch c := r.peek(); {
+		case c == '|' || c == ';' || c == ')' || c == eof:
+			return a, nil
+		case c == '#':
+			r.next()
+			r.skipSpace()
+			a.name = r.ident("")
+		case r.hasPrefix("->"):
+			if err := r.lexerCommands(rl); err != nil {
+				return nil, err
+			}
+		case c == '<':
+			if _, err := r.balanced('<', '>'); err != nil {
+				return nil, err
+			}
+			r.g.warn(pos, "element options are dropped")
+		case c == '{':
+			if _, err := r.balanced('{', '}'); err != nil {
+				return nil, err
+			}
+			if r.accept("?") {
+				r.g.warn(pos, "semantic predicate is dropped")
+			} else {
+				r.g.warn(pos, "action is dropped")
+			}
+		default:
+			label, e, err := r.element(rl)
+			if err != nil {
+				return nil, err
+			}
+			if e != nil {
+				a.add(e, label)
+			}
+		}
+	}
+}
+
+// lexerCommands reads the commands after -> in a lexer rule. skip and
+// channel suppress the tokens of the rule.
+func (r *antlrReader) lexerCommands(rl *rule) error {
+	r.accept("->")
+	for {
+		r.skipSpace()
+		pos := r.position()
+		cmd := r.ident("")
+		if cmd == "" {
+			return r.errorf("expected lexer command")
+		}
+		r.skipSpace()
+		if r.peek() == '(' {
+			if _, err := r.balanced('(', ')'); err != nil {
+				return err
+			}
+		}
+		switch cmd {
+		case "skip", "channel":
+			rl.suppress = true
+		default:
+			r.g.warn(pos, "lexer command %s of %s is dropped", cmd, rl.name)
+		}
+		r.skipSpace()
+		if !r.accept(",") {
+			return nil
+		}
+	}
+}
+
+// element reads an optionally labelled atom or block with an optional
+// suffix. EOF returns a nil expr.
+func (r *antlrReader) element(rl *rule) (label string, e expr, err error) {
+	save := *r.scanner
+	if id := r.ident(""); id != "" {
+		r.skipSpace()
+		if r.accept("+=") || r.peek() == '=' && r.peekAt(1) != '>' && r.accept("=") {
+			label = id
+			r.skipSpace()
+		} else {
+			*r.scanner = save
+		}
+	}
+	if e, err = r.atom(rl); err != nil {
+		return "", nil, err
+	}
+	r.skipSpace()
+	pos := r.position()
+	typ := ast.LexGroup
+	switch r.peek() {
+	case '?':
+		typ = ast.LexOptional
+	case '*':
+		typ = ast.LexZeroOrMore
+	case '+':
+		typ = ast.LexOneOrMore
+	}
+	if typ != ast.LexGroup {
+		r.next()
+		if r.accept("?") {
+			r.g.warn(pos, "non-greedy operator is translated as greedy")
+		}
+		if e != nil {
+			e = &repeat{e: e, typ: typ}
+		}
+	}
+	return label, e, nil
+}
+
+func (r *antlrReader) atom(rl *rule) (expr, error) {
+	pos := r.position()
+	switch c := r.peek(); {
+	case c == '(':
+		r.next()
+		ch, err := r.alternates(rl)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.expect(")"); err != nil {
+			return nil, err
+		}
+		return ch, nil
+	case c == '.':
+		r.next()
+		return &anyChar{pos: pos}, nil
+	case c == '~':
+		r.next()
+		r.skipSpace()
+		s, err := r.setElement()
+		if err != nil {
+			return nil, err
+		}
+		if s == nil {
+			r.g.warn(pos, "~ is only translated for character sets: the element is dropped")
+			r.ident("")
+			return nil, nil
+		}
+		s.not = !s.not
+		return s, nil
+	case c == '\'' && !rl.lex:
+		return r.literal()
+	case c == '\'' || c == '[':
+		s, err := r.setElement()
+		if err != nil || s != nil {
+			return s, err
+		}
+		return r.literal()
+	case c == '_' || unicode.IsLetter(c):
+		id := r.ident("")
+		if id == "EOF" {
+			return nil, nil
+		}
+		return &ref{name: id, pos: pos}, nil
+	}
+	return nil, r.errorf("unexpected %c", r.peek())
+}
+
+/*
+setElement reads a character set: [...], a range 'a'..'z', a single
+character literal or a parenthesised alternation of sets. It returns nil
+without consuming input if the input does not start with a set.
+*/
+func (r *antlrReader) setElement() (*set, error) {
+	pos := r.position()
+	switch r.peek() {
+	case '[':
+		return r.charSet()
+	case '(':
+		save := *r.scanner
+		r.next()
+		s := &set{pos: pos}
+		for {
+			r.skipSpace()
+			s1, err := r.setElement()
+			if err != nil || s1 == nil || s1.not {
+				*r.scanner = save
+				return nil, err
+			}
+			s.ranges = s.ranges.Union(s1.ranges)
+			s.props = append(s.props, s1.props...)
+			r.skipSpace()
+			if r.accept(")") {
+				return s, nil
+			}
+			if !r.accept("|") {
+				*r.scanner = save
+				return nil, nil
+			}
+		}
+	case '\'':
+		save := *r.scanner
+		l, err := r.literal()
+		if err != nil {
+			return nil, err
+		}
+		from := l.(*lit).str
+		r.skipSpace()
+		if r.accept("..") {
+			r.skipSpace()
+			l, err := r.literal()
+			if err != nil {
+				return nil, err
+			}
+			to := l.(*lit).str
+			if len(from) != 1 || len(to) != 1 {
+				return nil, r.errorf("invalid range")
+			}
+			return &set{ranges: runeset.NewRanges(runeset.Range{Lo: from[0], Hi: to[0]}), pos: pos}, nil
+		}
+		if len(from) == 1 {
+			return &set{ranges: runeset.NewRanges(runeset.Range{Lo: from[0], Hi: from[0]}), pos: pos}, nil
+		}
+		*r.scanner = save
+	}
+	return nil, nil
+}
+
+// charSet reads [...], which may contain ranges, escapes and Unicode
+// properties, e.g.: [a-z\p{L}\n]
+func (r *antlrReader) charSet() (*set, error) {
+	s := &set{pos: r.position()}
+	r.next()
+	for !r.accept("]") {
+		pos := r.position()
+		if r.hasPrefix(`\p{`) || r.hasPrefix(`\P{`) {
+			r.next()
+			neg := r.next() == 'P'
+			r.next()
+			name := new(strings.Builder)
+			for c := r.next(); c != '}'; c = r.next() {
+				if c == eof || c == '\n' {
+					return nil, r.errorf("unterminated Unicode property")
+				}
+				name.WriteRune(c)
+			}
+			if neg {
+				r.g.warn(pos, `negated Unicode property \P{%s} is dropped`, name)
+			} else {
+				s.props = append(s.props, name.String())
+			}
+			continue
+		}
+		lo, err := r.setChar()
+		if err != nil {
+			return nil, err
+		}
+		hi := lo
+		if r.peek() == '-' && r.peekAt(1) != ']' {
+			r.next()
+			if hi, err = r.setChar(); err != nil {
+				return nil, err
+			}
+		}
+		s.ranges = s.ranges.Union(runeset.NewRanges(runeset.Range{Lo: lo, Hi: hi}))
+	}
+	return s, nil
+}
+
+func (r *antlrReader) setChar() (rune, error) {
+	switch c := r.next(); c {
+	case '\\':
+		return r.escape()
+	case eof:
+		return 0, r.errorf("unterminated character set")
+	default:
+		return c, nil
+	}
+}
+
+// literal reads a string literal '...'
+func (r *antlrReader) literal() (expr, error) {
+	l := &lit{pos: r.position()}
+	r.next()
+	for {
+		switch c := r.next(); c {
+		case '\'':
+			return l, nil
+		case '\\':
+			e, err := r.escape()
+			if err != nil {
+				return nil, err
+			}
+			l.str = append(l.str, e)
+		case '\n', eof:
+			return nil, r.errorf("unterminated string literal")
+		default:
+			l.str = append(l.str, c)
+		}
+	}
+}
@@ -0,0 +1,474 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package importer
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/util/runeset"
+)
+
+// ebnfIdent are the runes other than letters and digits in EBNF symbols
+const ebnfIdent = "_."
+
+/*
+ebnfReader reads a grammar in the EBNF notation of the W3C XML specification,
+e.g.: the grammars written by gogll export -f ebnf. The rules may be numbered,
+e.g.: [1] document ::= prolog element Misc*. Constraints, e.g.: [ wfc: ... ],
+are dropped with a warning.
+
+W3C EBNF does not separate lexer and parser rules. A rule is a lexer rule if
+it contains a character class or character code, e.g.: [a-z] or #x20, or
+only matches white space, and no recursive rule can be reached from it. The
+rules referenced in lexer rules are also lexer rules. All other rules are
+parser rules. Lexer rules that only match white space are dropped with their
+references in parser rules, because the gogll lexer skips white space. A rule
+that follows a comment starting with "suppressed" is suppressed, like the
+suppressed tokens written by gogll export.
+*/
+type ebnfReader struct {
+	*scanner
+	g *grammar
+}
+
+func readEBNF(file string, src []rune) (*grammar, error) {
+	r := &ebnfReader{scanner: newScanner(file, src), g: &grammar{file: file}}
+	if err := r.rules(); err != nil {
+		return nil, err
+	}
+	r.lexerRules()
+	return r.g, nil
+}
+
+func (r *ebnfReader) rules() error {
+	for {
+		r.skipSpace()
+		if r.peek() == eof {
+			return nil
+		}
+		suppress := strings.HasPrefix(r.commentBefore(), "suppressed")
+		r.ruleNumber()
+		r.skipSpace()
+		pos := r.position()
+		name := r.ident(ebnfIdent)
+		if name == "" {
+			return r.errorf("expected rule")
+		}
+		if err := r.expect("::="); err != nil {
+			return err
+		}
+		body, err := r.alternates()
+		if err != nil {
+			return err
+		}
+		r.g.addAlternates(name, pos, body)
+		r.g.getRule(name).suppress = suppress
+	}
+}
+
+// ruleNumber skips the number of a rule, e.g.: [1]
+func (r *ebnfReader) ruleNumber() bool {
+	save := *r.scanner
+	if r.accept("[") {
+		r.skipSpace()
+		n := 0
+		for ; unicode.IsDigit(r.peek()); n++ {
+			r.next()
+		}
+		r.skipSpace()
+		if n > 0 && r.accept("]") {
+			return true
+		}
+	}
+	*r.scanner = save
+	return false
+}
+
+// ruleStart returns true if the input continues with the start of a rule,
+// without consuming it
+func (r *ebnfReader) ruleStart() bool {
+	save := *r.scanner
+	defer func() { *r.scanner = save }()
+	r.ruleNumber()
+	r.skipSpace()
+	if r.ident(ebnfIdent) == "" {
+		return false
+	}
+	r.skipSpace()
+	return r.hasPrefix("::=")
+}
+
+// commentBefore returns the text of the comment that ends before the current
+// position, if any
+func (r *ebnfReader) commentBefore() string {
+	end := r.pos
+	for end > 0 && unicode.IsSpace(r.src[end-1]) {
+		end--
+	}
+	if end < 2 || string(r.src[end-2:end]) != "*/" {
+		return ""
+	}
+	start := strings.LastIndex(string(r.src[:end-2]), "/*")
+	if start < 0 {
+		return ""
+	}
+	return strings.TrimSpace(string(r.src[:end-2])[start+2:])
+}
+
+// alternates reads alternates separated by | up to ) or the next rule
+func (r *ebnfReader) alternates() (*choice, error) {
+	ch := &choice{}
+	for {
+		a, err := r.alternative()
+		if err != nil {
+			return nil, err
+		}
+		ch.alts = append(ch.alts, a)
+		r.skipSpace()
+		if !r.accept("|") {
+			return ch, nil
+		}
+	}
+}
+
+func (r *ebnfReader) alternative() (*alternate, error) {
+	r.skipSpace()
+	a := &alternate{pos: r.position()}
+	for {
+		r.skipSpace()
+		pos := r.position()
+		switch c := r.peek(); {
+		case c == '|' || c == ')' || c == eof || r.ruleStart():
+			return a, nil
+		case r.constraint():
+			r.g.warn(pos, "constraint is dropped")
+		default:
+			e, err := r.difference()
+			if err != nil {
+				return nil, err
+			}
+			if e != nil {
+				a.add(e, "")
+			}
+		}
+	}
+}
+
+// constraint skips a well-formedness or validity constraint, e.g.:
+// [ wfc: Legal Character ]
+func (r *ebnfReader) constraint() bool {
+	save := *r.scanner
+	if r.accept("[") {
+		r.skipSpace()
+		switch strings.ToLower(r.ident("")) {
+		case "wfc", "vc":
+			if r.accept(":") {
+				*r.scanner = save
+				r.balanced('[', ']')
+				return true
+			}
+		}
+	}
+	*r.scanner = save
+	return false
+}
+
+/*
+difference reads A - B, which matches the strings that match A but not B. The
+difference is translated if A and B are character sets. Otherwise B is dropped
+with a warning.
+*/
+func (r *ebnfReader) difference() (expr, error) {
+	e, err := r.element()
+	if err != nil {
+		return nil, err
+	}
+	r.skipSpace()
+	pos := r.position()
+	if !r.accept("-") {
+		return e, nil
+	}
+	r.skipSpace()
+	e1, err := r.element()
+	if err != nil {
+		return nil, err
+	}
+	s, ok := e.(*set)
+	s1, ok1 := e1.(*set)
+	if ok && ok1 && len(s.props) == 0 && len(s1.props) == 0 {
+		return &set{ranges: s.chars().Difference(s1.chars()), pos: s.pos}, nil
+	}
+	r.g.warn(pos, "the exception of the difference is dropped")
+	return e, nil
+}
+
+// chars returns the characters matched by a set without Unicode properties
+func (s *set) chars() runeset.Ranges {
+	if s.not {
+		return s.ranges.Complement()
+	}
+	return s.ranges
+}
+
+// element reads an atom with an optional suffix ?, * or +
+func (r *ebnfReader) element() (expr, error) {
+	e, err := r.atom()
+	if err != nil {
+		return nil, err
+	}
+	typ := ast.LexGroup
+	switch r.peek() {
+	case '?':
+		typ = ast.LexOptional
+	case '*':
+		typ = ast.LexZeroOrMore
+	case '+':
+		typ = ast.LexOneOrMore
+	}
+	if typ != ast.LexGroup {
+		r.next()
+		if e != nil {
+			e = &repeat{e: e, typ: typ}
+		}
+	}
+	return e, nil
+}
+
+// atom reads a group, string, character class, character code or symbol. The
+// empty string returns a nil expr.
+func (r *ebnfReader) atom() (expr, error) {
+	pos := r.position()
+	switch c := r.peek(); {
+	case c == '(':
+		r.next()
+		ch, err := r.alternates()
+		if err != nil {
+			return nil, err
+		}
+		if err := r.expect(")"); err != nil {
+			return nil, err
+		}
+		return ch, nil
+	case c == '\'' || c == '"':
+		r.next()
+		l := &lit{pos: pos}
+		for d := r.next(); d != c; d = r.next() {
+			if d == eof {
+				return nil, r.errorf("unterminated string")
+			}
+			l.str = append(l.str, d)
+		}
+		if len(l.str) == 0 {
+			return nil, nil
+		}
+		return l, nil
+	case c == '[':
+		return r.charClass()
+	case c == '#':
+		ch, err := r.charCode()
+		if err != nil {
+			return nil, err
+		}
+		return &set{ranges: runeset.NewRanges(runeset.Range{Lo: ch, Hi: ch}), pos: pos}, nil
+	case c == '_' || unicode.IsLetter(c):
+		return &ref{name: r.ident(ebnfIdent), pos: pos}, nil
+	}
+	return nil, r.errorf("unexpected %c", r.peek())
+}
+
+// charClass reads [...] or [^...], which may contain characters, character
+// codes and ranges, e.g.: [#x20-#xD7FF]
+func (r *ebnfReader) charClass() (*set, error) {
+	s := &set{pos: r.position()}
+	r.next()
+	s.not = r.accept("^")
+	for !r.accept("]") {
+		lo, err := r.classChar()
+		if err != nil {
+			return nil, err
+		}
+		hi := lo
+		if r.peek() == '-' && r.peekAt(1) != ']' {
+			r.next()
+			if hi, err = r.classChar(); err != nil {
+				return nil, err
+			}
+		}
+		s.ranges = s.ranges.Union(runeset.NewRanges(runeset.Range{Lo: lo, Hi: hi}))
+	}
+	return s, nil
+}
+
+func (r *ebnfReader) classChar() (rune, error) {
+	switch c := r.peek(); c {
+	case '#':
+		return r.charCode()
+	case eof:
+		return 0, r.errorf("unterminated character class")
+	default:
+		return r.next(), nil
+	}
+}
+
+// charCode reads #xN, where N is a hexadecimal code point
+func (r *ebnfReader) charCode() (rune, error) {
+	if !r.accept("#x") {
+		return 0, r.errorf("expected #x")
+	}
+	hex := new(strings.Builder)
+	for unicode.Is(unicode.ASCII_Hex_Digit, r.peek()) {
+		hex.WriteRune(r.next())
+	}
+	c, err := strconv.ParseUint(hex.String(), 16, 32)
+	if err != nil || c > unicode.MaxRune {
+		return 0, r.errorf("invalid character code #x%s", hex)
+	}
+	return rune(c), nil
+}
+
+/*** Lexer rules ***/
+
+/*
+lexerRules marks the lexer rules of the grammar. Lexer rules that are only
+referenced in other lexer rules are fragments. White space rules are
+suppressed and their references in parser rules are dropped.
+*/
+func (r *ebnfReader) lexerRules() {
+	w := &writer{g: r.g}
+	space := map[string]bool{}
+	var mark func(*rule)
+	mark = func(rl *rule) {
+		if rl.lex {
+			return
+		}
+		rl.lex = true
+		refs := map[string]bool{}
+		references(rl.body, refs)
+		for name := range refs {
+			if rl1 := r.g.getRule(name); rl1 != nil {
+				mark(rl1)
+			}
+		}
+	}
+	for _, rl := range r.g.rules {
+		if !r.regular(rl.name) {
+			continue
+		}
+		if w.onlySpace(rl.body, map[string]bool{}) {
+			space[rl.name] = true
+			mark(rl)
+		} else if hasSet(rl.body) {
+			mark(rl)
+		}
+	}
+
+	syntaxRefs, lexRefs := map[string]bool{}, map[string]bool{}
+	for _, rl := range r.g.rules {
+		if rl.lex {
+			references(rl.body, lexRefs)
+		} else {
+			r.dropRefs(rl.body, space)
+			references(rl.body, syntaxRefs)
+		}
+	}
+	for _, rl := range r.g.rules {
+		if space[rl.name] && !lexRefs[rl.name] {
+			rl.suppress = true
+		}
+		rl.fragment = rl.lex && lexRefs[rl.name] && !syntaxRefs[rl.name]
+	}
+}
+
+// regular returns true if no recursive rule can be reached from rule name
+func (r *ebnfReader) regular(name string) bool {
+	var visit func(string, map[string]bool) bool
+	visit = func(name string, path map[string]bool) bool {
+		if path[name] {
+			return false
+		}
+		rl := r.g.getRule(name)
+		if rl == nil {
+			return true
+		}
+		path[name] = true
+		defer delete(path, name)
+		refs := map[string]bool{}
+		references(rl.body, refs)
+		for ref := range refs {
+			if !visit(ref, path) {
+				return false
+			}
+		}
+		return true
+	}
+	return visit(name, map[string]bool{})
+}
+
+// hasSet returns true if e contains a character class or character code
+func hasSet(e expr) bool {
+	switch e := e.(type) {
+	case *set:
+		return true
+	case *repeat:
+		return hasSet(e.e)
+	case *choice:
+		for _, a := range e.alts {
+			for _, item := range a.items {
+				if hasSet(item) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// dropRefs drops the references to the white space rules in space from ch
+func (r *ebnfReader) dropRefs(ch *choice, space map[string]bool) {
+	for _, a := range ch.alts {
+		var items []expr
+		for _, e := range a.items {
+			if r.spaceRef(e, space) {
+				continue
+			}
+			if c, ok := e.(*choice); ok {
+				r.dropRefs(c, space)
+			}
+			if rp, ok := e.(*repeat); ok {
+				if c, ok := rp.e.(*choice); ok {
+					r.dropRefs(c, space)
+				}
+			}
+			items = append(items, e)
+		}
+		a.items, a.labels = items, make([]string, len(items))
+	}
+}
+
+// spaceRef returns true if e is a reference to a white space rule, which may
+// be repeated. A warning is reported for the reference.
+func (r *ebnfReader) spaceRef(e expr, space map[string]bool) bool {
+	if rp, ok := e.(*repeat); ok {
+		e = rp.e
+	}
+	if rf, ok := e.(*ref); ok && space[rf.name] {
+		r.g.warn(rf.pos, "reference to white space %s is dropped: the gogll lexer skips white space", rf.name)
+		return true
+	}
+	return false
+}
@@ -0,0 +1,164 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package importer
+
+import (
+	"fmt"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/util/runeset"
+)
+
+/*
+grammar is an imported grammar. The readers of the different formats translate
+their input to a grammar, which is written in gogll by write.
+*/
+type grammar struct {
+	file  string
+	rules []*rule
+
+	// tokens are the tokens that are declared without a rule, e.g.: by
+	// %token in Yacc
+	tokens []*tokenDecl
+
+	// start is the declared start symbol, if any
+	start    string
+	startPos *ast.Position
+
+	warnings []*Warning
+}
+
+type rule struct {
+	name string
+	pos  *ast.Position
+
+	// lex is true for a lexer rule and fragment for a lexer rule that
+	// is only used in other lexer rules
+	lex, fragment bool
+
+	// suppress is true for a lexer rule whose tokens are skipped
+	suppress bool
+
+	body *choice
+}
+
+// tokenDecl is a token declared without a rule. alias is the string the token
+// is written as in the grammar, if any.
+type tokenDecl struct {
+	name  string
+	pos   *ast.Position
+	alias []rune
+}
+
+// expr is one of *choice, *ref, *lit, *set, *anyChar or *repeat
+type expr interface {
+	isExpr()
+}
+
+// choice is a group of alternates
+type choice struct {
+	alts []*alternate
+}
+
+type alternate struct {
+	pos   *ast.Position
+	items []expr
+
+	// labels are the labels of the items, "" for an item without a label
+	labels []string
+
+	// name is the name of the alternate, if any
+	name string
+}
+
+// ref is a reference to a rule or token
+type ref struct {
+	name string
+	pos  *ast.Position
+}
+
+// lit is a string literal
+type lit struct {
+	str []rune
+	pos *ast.Position
+}
+
+/*
+set is a set of characters, e.g.: [a-z\p{L}]. props are Unicode categories and
+properties, e.g.: L. If not is true the set matches the characters that are
+not in it.
+*/
+type set struct {
+	ranges runeset.Ranges
+	props  []string
+	not    bool
+	pos    *ast.Position
+}
+
+// anyChar matches any character in a lexer rule or any token in a parser rule
+type anyChar struct {
+	pos *ast.Position
+}
+
+// repeat is an optional or repeated expression. typ is one of ast.LexOptional,
+// ast.LexZeroOrMore or ast.LexOneOrMore
+type repeat struct {
+	e   expr
+	typ ast.BracketType
+}
+
+func (*choice) isExpr()  {}
+func (*ref) isExpr()     {}
+func (*lit) isExpr()     {}
+func (*set) isExpr()     {}
+func (*anyChar) isExpr() {}
+func (*repeat) isExpr()  {}
+
+func (g *grammar) warn(pos *ast.Position, format string, args ...interface{}) {
+	g.warnings = append(g.warnings, &Warning{Pos: pos, Msg: fmt.Sprintf(format, args...)})
+}
+
+func (g *grammar) getRule(name string) *rule {
+	for _, r := range g.rules {
+		if r.name == name {
+			return r
+		}
+	}
+	return nil
+}
+
+// addAlternates adds the alternates of body to rule name, which is declared
+// at pos. The rule is added if it does not exist.
+func (g *grammar) addAlternates(name string, pos *ast.Position, body *choice) {
+	if r := g.getRule(name); r != nil {
+		r.body.alts = append(r.body.alts, body.alts...)
+		return
+	}
+	g.rules = append(g.rules, &rule{name: name, pos: pos, body: body})
+}
+
+func (g *grammar) getToken(name string) *tokenDecl {
+	for _, t := range g.tokens {
+		if t.name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+func (a *alternate) add(e expr, label string) {
+	a.items = append(a.items, e)
+	a.labels = append(a.labels, label)
+}
@@ -0,0 +1,140 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+/*
+Package importer converts grammars in the formats of other tools to gogll
+markdown grammars. The supported formats are W3C EBNF, ANTLR4 and Yacc/Bison.
+
+Lexer rules become lex rules and parser rules become syntax rules. The EBNF
+operators of parser rules, e.g.: (a b)*, are desugared into new syntax rules.
+Actions, predicates and other constructs that cannot be translated are
+dropped and reported as warnings with their position in the source grammar.
+*/
+package importer
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/format"
+)
+
+// Format is the format of an imported grammar
+type Format int
+
+const (
+	EBNF Format = iota
+	ANTLR
+	Yacc
+)
+
+// formatNames are the names of the formats used on the command line
+var formatNames = []string{"ebnf", "antlr", "yacc"}
+
+// ParseFormat returns the format with name: ebnf, antlr or yacc
+func ParseFormat(name string) (Format, error) {
+	for i, n := range formatNames {
+		if n == name {
+			return Format(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown import format %q", name)
+}
+
+// FormatOf returns the format of a grammar file from its extension: .ebnf is
+// W3C EBNF, .g4 is ANTLR4, .y and .yy are Yacc.
+func FormatOf(file string) (Format, error) {
+	switch filepath.Ext(file) {
+	case ".ebnf":
+		return EBNF, nil
+	case ".g4":
+		return ANTLR, nil
+	case ".y", ".yy":
+		return Yacc, nil
+	}
+	return 0, fmt.Errorf("unknown grammar format of %s", file)
+}
+
+func (f Format) String() string {
+	return formatNames[f]
+}
+
+// Warning is a construct of the imported grammar that was not translated
+type Warning struct {
+	Pos *ast.Position
+	Msg string
+}
+
+func (w *Warning) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s", w.Pos.File, w.Pos.Line, w.Pos.Column, w.Msg)
+}
+
+// File converts the grammar in file, which is in format f, to a gogll
+// markdown grammar of package pkg.
+func File(file string, f Format, pkg string) ([]byte, []*Warning, error) {
+	src, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	return Convert(file, src, f, pkg)
+}
+
+/*
+Convert converts the grammar src, which was read from file and is in format
+f, to a gogll markdown grammar of package pkg. The warnings are sorted by
+position. An error is returned if src has a syntax error.
+*/
+func Convert(file string, src []byte, f Format, pkg string) ([]byte, []*Warning, error) {
+	var g *grammar
+	var err error
+	switch f {
+	case EBNF:
+		g, err = readEBNF(file, []rune(string(src)))
+	case ANTLR:
+		g, err = readANTLR(file, []rune(string(src)))
+	case Yacc:
+		g, err = readYacc(file, []rune(string(src)))
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	md := write(g, pkg)
+	res, err := format.Source("grammar.md", md)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid gogll grammar generated from %s: %s\n%s", file, err, md)
+	}
+	sortWarnings(g.warnings)
+	return res, g.warnings, nil
+}
+
+func sortWarnings(ws []*Warning) {
+	sort.SliceStable(ws, func(i, j int) bool {
+		pi, pj := ws[i].Pos, ws[j].Pos
+		if pi.Line != pj.Line {
+			return pi.Line < pj.Line
+		}
+		return pi.Column < pj.Column
+	})
+}
+
+// Package returns the default package of an imported grammar file, which is
+// the name of the file without its extension
+func Package(file string) string {
+	base := filepath.Base(file)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
@@ -0,0 +1,271 @@
+package importer
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/lexer"
+	"github.com/goccmack/gogll/v3/parser"
+	"github.com/goccmack/gogll/v3/sc"
+)
+
+const antlrGrammar = `grammar Expr;
+
+options { language = Java; }
+
+@header { package expr; }
+
+prog : stat+ EOF ;
+
+stat
+    : e=expr ';'                    # Print
+    | ID '=' expr ';' { count++; }  # Assign
+    | 'if' '(' expr ')' stat ('else' stat)?   # If
+    ;
+
+expr
+    : lhs=expr op=('*'|'/') rhs=expr
+    | expr ('+'|'-') expr
+    | ID ('(' args+=expr (',' args+=expr)* ')')?
+    | INT
+    | '(' expr ')'
+    ;
+
+ID : LETTER (LETTER | [0-9])* ;
+INT : [0-9]+ ;
+STRING : '"' ~["\\\r\n]* '"' ;
+SEMI : ';' ;
+fragment LETTER : [a-zA-Z_\p{L}] ;
+COMMENT : '/*' .*? '*/' -> skip ;
+WS : [ \t\r\n]+ -> skip ;
+`
+
+const yaccGrammar = `%{
+#include <stdio.h>
+%}
+
+%union { int n; }
+%token <n> NUMBER
+%token IF "if" ELSE "else"
+%left '+' '-'
+%start program
+
+%%
+
+program
+    : /* empty */
+    | program stmt
+    ;
+
+stmt : IF '(' expr ')' stmt %prec ELSE
+     | IF '(' expr ')' stmt ELSE stmt
+     | expr ';' { printf("%d\n", $1); }
+     | error ';'
+
+expr : expr '+' expr
+     | expr '-' expr
+     | NUMBER
+     ;
+
+%%
+
+int main() { return yyparse(); }
+`
+
+func convert(t *testing.T, file, src string, f Format) (string, []*Warning) {
+	md, warnings, err := Convert(file, []byte(src), f, Package(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The generated grammar must be accepted by gogll
+	mdFile := filepath.Join(t.TempDir(), "grammar.md")
+	if err := ioutil.WriteFile(mdFile, md, 0644); err != nil {
+		t.Fatal(err)
+	}
+	lex := lexer.NewFile(mdFile)
+	bsr, errs := parser.Parse(lex)
+	if errs != nil {
+		t.Fatalf("%s\n%s", errs[0], md)
+	}
+	g, err := ast.TryBuild(bsr.GetRoot(), lex, mdFile)
+	if err != nil {
+		t.Fatalf("%s\n%s", err, md)
+	}
+	if errs := sc.Errors(g); len(errs) > 0 {
+		t.Fatalf("%s\n%s", errs[0].Err, md)
+	}
+	return string(md), warnings
+}
+
+func check(t *testing.T, out string, warnings []*Warning, contains, expWarnings []string) {
+	for _, s := range contains {
+		if !strings.Contains(out, s) {
+			t.Errorf("missing: %s", s)
+		}
+	}
+	if len(warnings) != len(expWarnings) {
+		t.Errorf("%d warnings, expected %d", len(warnings), len(expWarnings))
+	}
+	for i, w := range warnings {
+		if i < len(expWarnings) && !strings.Contains(w.String(), expWarnings[i]) {
+			t.Errorf("warning %d: %s, expected %s", i, w, expWarnings[i])
+		}
+	}
+	if t.Failed() {
+		t.Log(out)
+		for _, w := range warnings {
+			t.Log(w)
+		}
+	}
+}
+
+func TestANTLR(t *testing.T) {
+	out, warnings := convert(t, "Expr.g4", antlrGrammar, ANTLR)
+	check(t, out, warnings,
+		[]string{
+			"package \"Expr\"\n",
+			"Prog : Prog_1 ;\n",
+			"Prog_1 : Stat | Stat Prog_1 ;\n",
+			"    :   e_:Expr \";\"                   #Print\n",
+			"    |   id \"=\" Expr \";\"               #Assign\n",
+			"    |   \"if\" \"(\" Expr \")\" Stat Stat_1 #If\n",
+			"Stat_1 : \"else\" Stat | empty ;\n",
+			"    :   Expr Expr_1 Expr\n",
+			"Expr_1 : \"*\" | \"/\" ;\n",
+			"    |   id Expr_4\n",
+			"Expr_3 : empty | \",\" Expr Expr_3 ;\n",
+			"Expr_4 : \"(\" Expr Expr_3 \")\" | empty ;\n",
+			"id : '[ \\p{L} 'A'-'Z' '_'-'_' 'a'-'z' ]' { '[ \\p{L} 'A'-'Z' '_'-'_' 'a'-'z' ]' | '0'-'9' } ;\n",
+			"int : < '0'-'9' > ;\n",
+			"string : '\"' { not \"\\n\\r\\\"\\\\\" } '\"' ;\n",
+			"!comment : '/' '*' { . } '*' '/' ;\n",
+		},
+		[]string{
+			"Expr.g4:3:1: the grammar options are dropped",
+			"Expr.g4:5:1: action @header is dropped",
+			"Expr.g4:11:23: action is dropped",
+			"Expr.g4:16:7: label lhs is dropped",
+			"Expr.g4:16:7: label op is dropped",
+			"Expr.g4:16:7: label rhs is dropped",
+			"Expr.g4:18:11: label args in a group is dropped",
+			"Expr.g4:18:27: label args in a group is dropped",
+			"Expr.g4:28:17: non-greedy operator is translated as greedy",
+			"Expr.g4:29:1: WS is dropped: the gogll lexer skips white space",
+		})
+}
+
+func TestYacc(t *testing.T) {
+	out, warnings := convert(t, "calc.y", yaccGrammar, Yacc)
+	check(t, out, warnings,
+		[]string{
+			"package \"calc\"\n",
+			"start Program ;\n",
+			"    :   empty\n    |   Program Stmt\n",
+			"    :   \"if\" \"(\" Expr \")\" Stmt\n",
+			"    |   \"if\" \"(\" Expr \")\" Stmt \"else\" Stmt\n",
+			"    |   Expr \";\"\n",
+			"    |   \";\"\n",
+			"    |   number_\n",
+			"number_ : 'N' 'U' 'M' 'B' 'E' 'R' ;\n",
+		},
+		[]string{
+			"calc.y:1:1: the C declarations are dropped",
+			"calc.y:6:12: token NUMBER has no lexical definition",
+			"calc.y:8:1: precedence directive %left is dropped",
+			"calc.y:18:29: %prec is dropped",
+			"calc.y:20:17: action is dropped",
+			"calc.y:21:8: error token is dropped",
+			"calc.y:30:1: the C code after the rules is dropped",
+		})
+}
+
+func TestLiterals(t *testing.T) {
+	for _, tst := range []struct {
+		str, char, exp string
+	}{
+		{"a", "'a'", `"a"`},
+		{"'", `'\''`, `"'"`},
+		{`"`, `'"'`, `"\""`},
+		{`\`, `'\\'`, `"\\"`},
+		{"\n", `'\n'`, `"\n"`},
+		{"\x00", `'\x00'`, `"\x00"`},
+		{"\u2028", `'\u2028'`, `"\u2028"`},
+		{"é", "'é'", `"é"`},
+	} {
+		if got := charLit([]rune(tst.str)[0]); got != tst.char {
+			t.Errorf("%q: expected %s, got %s", tst.str, tst.char, got)
+		}
+		if got := stringLit([]rune(tst.str)); got != tst.exp {
+			t.Errorf("%q: expected %s, got %s", tst.str, tst.exp, got)
+		}
+	}
+}
+
+const ebnfGrammar = `/* Package expr. The tokens may be separated by white space. */
+
+[1] Expr ::= Expr '-' Term
+           | Term
+[2] Term ::= id | '(' S? Expr S? ')' | Call [ wfc: Defined Function ]
+Call ::= id '(' ( Expr ( ',' Expr )* )? ')'
+
+id ::= Letter ( Letter | [0-9] | '_' )*
+Letter ::= [a-zA-Z] | #xE9
+str ::= '"' ( [#x20-#x7E] - ["] )* '"'
+S ::= ( #x20 | #x9 | #xA )+
+
+/* suppressed: may occur between any two tokens */
+comment ::= '//' [^#xA]*
+`
+
+func TestEBNF(t *testing.T) {
+	out, warnings := convert(t, "expr.ebnf", ebnfGrammar, EBNF)
+	check(t, out, warnings,
+		[]string{
+			"package \"expr\"\n",
+			"    :   Expr \"-\" Term\n    |   Term\n",
+			"    |   \"(\" Expr \")\"\n    |   Call\n",
+			"Call : id \"(\" Call_2 \")\" ;\n",
+			"Call_1 : empty | \",\" Expr Call_1 ;\n",
+			"Call_2 : Expr Call_1 | empty ;\n",
+			"id : ( ( 'A'-'Z' | 'a'-'z' ) | 'é' ) { ( ( 'A'-'Z' | 'a'-'z' ) | 'é' ) | '0'-'9' | '_' } ;\n",
+			"str : '\"' { ( ' '-'!' | '#'-'~' ) } '\"' ;\n",
+			"!comment : '/' '/' { not \"\\n\" } ;\n",
+		},
+		[]string{
+			"expr.ebnf:5:23: reference to white space S is dropped",
+			"expr.ebnf:5:31: reference to white space S is dropped",
+			"expr.ebnf:5:45: constraint is dropped",
+			"expr.ebnf:11:1: S is dropped: the gogll lexer skips white space",
+		})
+}
+
+func TestSpaceFirst(t *testing.T) {
+	src := "grammar Calc;\n\nprog : (INT NEWLINE)* ;\n\nINT : [0-9]+ ;\nNEWLINE : '\\r'? '\\n' ;\n"
+	out, warnings := convert(t, "Calc.g4", src, ANTLR)
+	check(t, out, warnings,
+		[]string{"newline : [ '\\r' ] '\\n' ;\n"},
+		[]string{"Calc.g4:6:1: NEWLINE can start with white space, which the gogll lexer skips before a token"})
+}
+
+func TestDroppedAlternates(t *testing.T) {
+	src := "grammar Drop;\n\nexpr : INT | ~'x' | ;\n\nlist : (INT | .)* ;\n\nany : . ;\n\nINT : [0-9]+ ;\n"
+	out, warnings := convert(t, "Drop.g4", src, ANTLR)
+	check(t, out, warnings,
+		[]string{
+			"Expr\n    :   int\n    |   empty\n    ;\n",
+			"List_1 : empty | int List_1 ;\n",
+			"Any : empty ;\n",
+		},
+		[]string{
+			"Drop.g4:3:14: alternate 2 of expr is dropped: all its symbols are dropped",
+			"Drop.g4:3:15: character set in parser rule is dropped",
+			"Drop.g4:5:15: wildcard in parser rule is dropped",
+			"Drop.g4:5:15: alternate of a group is dropped: all its symbols are dropped",
+			"Drop.g4:7:1: rule any is written as empty: all its alternates are dropped",
+			"Drop.g4:7:7: wildcard in parser rule is dropped",
+			"Drop.g4:7:7: alternate 1 of any is dropped: all its symbols are dropped",
+		})
+}
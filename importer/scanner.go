@@ -0,0 +1,235 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package importer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/goccmack/gogll/v3/ast"
+)
+
+// eof is returned by the scanner at the end of the input
+const eof = -1
+
+/*
+scanner reads the runes of a grammar and tracks their line and column. It is
+shared by the readers of all formats, which have the same C-style comments.
+*/
+type scanner struct {
+	file      string
+	src       []rune
+	pos       int
+	line, col int
+}
+
+func newScanner(file string, src []rune) *scanner {
+	return &scanner{file: file, src: src, line: 1, col: 1}
+}
+
+func (s *scanner) position() *ast.Position {
+	return &ast.Position{Line: s.line, Column: s.col, File: s.file}
+}
+
+func (s *scanner) peek() rune {
+	return s.peekAt(0)
+}
+
+func (s *scanner) peekAt(i int) rune {
+	if s.pos+i >= len(s.src) {
+		return eof
+	}
+	return s.src[s.pos+i]
+}
+
+func (s *scanner) next() rune {
+	r := s.peek()
+	if r == eof {
+		return r
+	}
+	s.pos++
+	if r == '\n' {
+		s.line, s.col = s.line+1, 1
+	} else {
+		s.col++
+	}
+	return r
+}
+
+func (s *scanner) hasPrefix(str string) bool {
+	for i, r := range []rune(str) {
+		if s.peekAt(i) != r {
+			return false
+		}
+	}
+	return true
+}
+
+// accept consumes str if the input continues with it
+func (s *scanner) accept(str string) bool {
+	if !s.hasPrefix(str) {
+		return false
+	}
+	for range []rune(str) {
+		s.next()
+	}
+	return true
+}
+
+// expect skips white space and comments and consumes str
+func (s *scanner) expect(str string) error {
+	s.skipSpace()
+	if !s.accept(str) {
+		return s.errorf("expected %s", str)
+	}
+	return nil
+}
+
+func (s *scanner) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%s:%d:%d: %s", s.file, s.line, s.col, fmt.Sprintf(format, args...))
+}
+
+// skipSpace skips white space and // and /* */ comments
+func (s *scanner) skipSpace() {
+	for {
+		switch {
+		case unicode.IsSpace(s.peek()):
+			s.next()
+		case s.hasPrefix("//"):
+			for s.peek() != '\n' && s.peek() != eof {
+				s.next()
+			}
+		case s.hasPrefix("/*"):
+			s.accept("/*")
+			for !s.accept("*/") && s.next() != eof {
+			}
+		default:
+			return
+		}
+	}
+}
+
+// skipLine skips the rest of the line, including blocks in braces, which may
+// span several lines
+func (s *scanner) skipLine() {
+	for {
+		switch s.peek() {
+		case '\n', eof:
+			return
+		case '{':
+			s.balanced('{', '}')
+		default:
+			s.next()
+		}
+	}
+}
+
+// ident returns the identifier at the current position, which consists of
+// letters, digits and the runes in extra
+func (s *scanner) ident(extra string) string {
+	w := new(strings.Builder)
+	for r := s.peek(); r == '_' || unicode.IsLetter(r) || w.Len() > 0 && (unicode.IsDigit(r) || strings.ContainsRune(extra, r)); r = s.peek() {
+		w.WriteRune(s.next())
+	}
+	return w.String()
+}
+
+/*
+balanced consumes a block from open to the matching close, e.g.: the action
+{...} of a rule, and returns its contents. Nested blocks, quoted strings and
+comments in the block, which is code in the target language of the grammar,
+are skipped.
+*/
+func (s *scanner) balanced(open, close rune) (string, error) {
+	start := s.pos
+	pos := s.position()
+	s.next()
+	for depth := 1; depth > 0; {
+		switch r := s.peek(); {
+		case r == eof:
+			return "", fmt.Errorf("%s:%d:%d: unterminated %c", s.file, pos.Line, pos.Column, open)
+		case r == '"' || r == '\'' && open != '<':
+			s.next()
+			for c := s.next(); c != r && c != '\n' && c != eof; c = s.next() {
+				if c == '\\' {
+					s.next()
+				}
+			}
+		case s.hasPrefix("//") || s.hasPrefix("/*"):
+			s.skipSpace()
+		case r == open:
+			depth++
+			s.next()
+		case r == close:
+			depth--
+			s.next()
+		default:
+			s.next()
+		}
+	}
+	return string(s.src[start+1 : s.pos-1]), nil
+}
+
+// escape returns the rune of the escape sequence after a backslash. It reads
+// \n, \r, \t, \b, \f, \uXXXX, \u{X...}, \xXX and an escaped character.
+func (s *scanner) escape() (rune, error) {
+	switch r := s.next(); r {
+	case 'n':
+		return '\n', nil
+	case 'r':
+		return '\r', nil
+	case 't':
+		return '\t', nil
+	case 'b':
+		return '\b', nil
+	case 'f':
+		return '\f', nil
+	case 'u', 'x':
+		n := 4
+		if r == 'x' {
+			n = 2
+		}
+		braced := r == 'u' && s.accept("{")
+		if braced {
+			for n = 0; s.peekAt(n) != '}' && s.peekAt(n) != eof; n++ {
+			}
+		}
+		hex := string(s.src[s.pos:min(s.pos+n, len(s.src))])
+		for i := 0; i < n; i++ {
+			s.next()
+		}
+		if braced {
+			s.next()
+		}
+		c, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil || c > unicode.MaxRune {
+			return 0, s.errorf("invalid escape \\%c%s", r, hex)
+		}
+		return rune(c), nil
+	case eof:
+		return 0, s.errorf("unexpected end of file")
+	default:
+		return r, nil
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
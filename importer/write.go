@@ -0,0 +1,830 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package importer
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/token"
+	"github.com/goccmack/gogll/v3/util/runeset"
+	"github.com/goccmack/goutil/stringset"
+)
+
+// reserved are the reserved words of gogll, which cannot be token IDs or
+// labels
+var reserved = stringset.New("any", "as", "case_insensitive", "empty",
+	"import", "letter", "lowcase", "not", "number", "package", "prefix",
+	"rename", "start", "upcase")
+
+// brackets are the open and close brackets of the gogll lex bracket types
+var brackets = map[ast.BracketType][2]string{
+	ast.LexGroup:      {"(", ")"},
+	ast.LexOptional:   {"[", "]"},
+	ast.LexZeroOrMore: {"{", "}"},
+	ast.LexOneOrMore:  {"<", ">"},
+}
+
+/*
+writer writes an imported grammar in gogll.
+
+Parser rules become syntax rules. The optional, repeated and grouped
+expressions of the parser rules are desugared into new syntax rules, which are
+named after the rule, e.g.: the rule of (',' arg)* in Args is
+
+	Args_1 : empty | "," Arg Args_1 ;
+
+Lexer rules become lex rules. Fragments and other lexer rules referenced in a
+lexer rule are inlined. A lexer rule that only matches a string literal, e.g.:
+IF : 'if', is written as the string literal in the syntax rules. A token that
+has no lexical definition is written as a lex rule that matches its name.
+*/
+type writer struct {
+	g *grammar
+	w *bytes.Buffer
+
+	// names are the nonterminals, token IDs and alternate names of the
+	// gogll grammar
+	names map[string]bool
+
+	// nts and toks map the names of the imported rules and tokens to gogll
+	// nonterminals and token IDs. lits maps tokens to string literals.
+	nts, toks map[string]string
+	lits      map[string][]rune
+
+	// lexRules are the lexer rules by name
+	lexRules map[string]*rule
+
+	// placeholders are the tokens without lexical definition that are used
+	placeholders []string
+
+	// generated maps the alternates of generated syntax rules to their name.
+	// generatedRules are the generated rules of the current syntax rule.
+	generated      map[string]string
+	generatedRules []string
+	count          int
+}
+
+func write(g *grammar, pkg string) []byte {
+	w := &writer{
+		g:         g,
+		w:         new(bytes.Buffer),
+		names:     map[string]bool{},
+		nts:       map[string]string{},
+		toks:      map[string]string{},
+		lits:      map[string][]rune{},
+		lexRules:  map[string]*rule{},
+		generated: map[string]string{},
+	}
+	w.declare()
+
+	fmt.Fprintf(w.w, "# %s\n\nThis grammar was imported from `%s` by `gogll import`.\n\n```\n",
+		pkg, filepath.Base(g.file))
+	fmt.Fprintf(w.w, "package %s\n", stringLit([]rune(pkg)))
+	if g.start != "" {
+		if nt, ok := w.nts[g.start]; ok {
+			fmt.Fprintf(w.w, "\nstart %s ;\n", nt)
+		} else {
+			g.warn(g.startPos, "start symbol %s is not a rule", g.start)
+		}
+	}
+	for _, r := range g.rules {
+		if !r.lex {
+			w.syntaxRule(r)
+		}
+	}
+	for _, r := range g.rules {
+		if tok, ok := w.toks[r.name]; ok && r.lex {
+			w.lexRule(tok, r)
+		}
+	}
+	for _, name := range w.placeholders {
+		w.placeholder(name)
+	}
+	w.w.WriteString("```\n")
+	return w.w.Bytes()
+}
+
+/*
+declare names the rules and tokens of the grammar. Lexer rules that only
+match a string literal and are used in parser rules become string literals,
+and suppressed lexer rules that
+only match white space are dropped, because the gogll lexer skips white space.
+*/
+func (w *writer) declare() {
+	refs := map[string]bool{}
+	for _, r := range w.g.rules {
+		if !r.lex {
+			references(r.body, refs)
+		}
+	}
+	for _, r := range w.g.rules {
+		switch {
+		case !r.lex:
+			w.nts[r.name] = w.newName(ntName(r.name))
+		case r.fragment:
+			w.lexRules[r.name] = r
+		case refs[r.name] && !r.suppress && literalRule(r) != nil:
+			w.lexRules[r.name] = r
+			w.lits[r.name] = literalRule(r)
+		default:
+			w.lexRules[r.name] = r
+			if r.suppress && w.onlySpace(r.body, map[string]bool{}) {
+				w.g.warn(r.pos, "%s is dropped: the gogll lexer skips white space", r.name)
+				continue
+			}
+			w.toks[r.name] = w.newName(tokIDName(r.name))
+		}
+	}
+	for _, t := range w.g.tokens {
+		if t.alias != nil {
+			w.lits[t.name] = t.alias
+		}
+	}
+}
+
+// references adds the names referenced in e to refs
+func references(e expr, refs map[string]bool) {
+	switch e := e.(type) {
+	case *ref:
+		refs[e.name] = true
+	case *repeat:
+		references(e.e, refs)
+	case *choice:
+		for _, a := range e.alts {
+			for _, item := range a.items {
+				references(item, refs)
+			}
+		}
+	}
+}
+
+// newName returns name, with '_' appended until it is not the name of
+// another rule or alternate or a reserved word
+func (w *writer) newName(name string) string {
+	for w.names[name] || reserved.Contain(name) {
+		name += "_"
+	}
+	w.names[name] = true
+	return name
+}
+
+// literalRule returns the string literal of a lexer rule that only matches
+// a string literal without white space, or nil
+func literalRule(r *rule) []rune {
+	if len(r.body.alts) != 1 || len(r.body.alts[0].items) != 1 {
+		return nil
+	}
+	var str []rune
+	switch e := r.body.alts[0].items[0].(type) {
+	case *lit:
+		str = e.str
+	case *set:
+		if !e.not && len(e.props) == 0 && len(e.ranges) == 1 && singles(e.ranges) {
+			str = []rune{e.ranges[0].Lo}
+		}
+	}
+	if len(str) == 0 || strings.IndexFunc(string(str), unicode.IsSpace) >= 0 {
+		return nil
+	}
+	return str
+}
+
+// ntName returns name as a gogll nonterminal, which starts with an upper case
+// letter
+func ntName(name string) string {
+	id := []rune(identifier(name))
+	if !unicode.IsLetter(id[0]) {
+		return "N" + string(id)
+	}
+	id[0] = unicode.ToUpper(id[0])
+	return string(id)
+}
+
+/*
+tokIDName returns name as a gogll token ID, which starts with a lower case
+letter and has at least two characters. A name without lower case letters,
+e.g.: INT_LIT, is written in lower case: int_lit.
+*/
+func tokIDName(name string) string {
+	id := []rune(identifier(name))
+	if !unicode.IsLetter(id[0]) {
+		id = append([]rune{'t'}, id...)
+	}
+	if strings.IndexFunc(string(id), unicode.IsLower) < 0 {
+		id = []rune(strings.ToLower(string(id)))
+	}
+	id[0] = unicode.ToLower(id[0])
+	if len(id) == 1 {
+		id = append(id, '_')
+	}
+	return string(id)
+}
+
+// labelName returns name as a gogll label, which has the form of a token ID
+func labelName(name string) string {
+	l := tokIDName(name)
+	for reserved.Contain(l) {
+		l += "_"
+	}
+	return l
+}
+
+// identifier returns name with every character that is not a letter, digit
+// or '_' replaced by '_'
+func identifier(name string) string {
+	id := []rune(name)
+	for i, r := range id {
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			id[i] = '_'
+		}
+	}
+	if len(id) == 0 {
+		return "_"
+	}
+	return string(id)
+}
+
+/*** Syntax rules ***/
+
+func (w *writer) syntaxRule(r *rule) {
+	nt := w.nts[r.name]
+	w.generatedRules, w.count = nil, 0
+	var alts []string
+	for i, a := range r.body.alts {
+		name := ""
+		if a.name != "" {
+			name = w.newName(ntName(a.name))
+		}
+		if alt, ok := w.alternate(nt, a, name, len(r.body.alts) == 1); ok {
+			alts = append(alts, alt)
+		} else {
+			w.g.warn(a.pos, "alternate %d of %s is dropped: all its symbols are dropped", i+1, r.name)
+		}
+	}
+	if len(alts) == 0 {
+		w.g.warn(r.pos, "rule %s is written as empty: all its alternates are dropped", r.name)
+		alts = []string{"empty"}
+	}
+	w.w.WriteString("\n")
+	writeSyntaxRule(w.w, nt, alts)
+	for _, gr := range w.generatedRules {
+		w.w.WriteString("\n" + gr)
+	}
+}
+
+// writeSyntaxRule writes a rule with one alternate on one line and a rule
+// with several alternates on one line per alternate
+func writeSyntaxRule(w *bytes.Buffer, nt string, alts []string) {
+	if len(alts) == 1 {
+		fmt.Fprintf(w, "%s : %s ;\n", nt, alts[0])
+		return
+	}
+	fmt.Fprintf(w, "%s\n", nt)
+	for i, a := range alts {
+		sep := "|"
+		if i == 0 {
+			sep = ":"
+		}
+		fmt.Fprintf(w, "    %s   %s\n", sep, a)
+	}
+	w.WriteString("    ;\n")
+}
+
+/*
+alternate returns alternate a of the rule of nt with name. Labels are only
+kept in named alternates and in the single alternate of a rule, which is
+named after the rule by gogll. It returns false if every symbol of a is
+dropped, in which case a must be dropped instead of being written as empty.
+*/
+func (w *writer) alternate(nt string, a *alternate, name string, single bool) (string, bool) {
+	keepLabels := name != "" || single
+	labels := map[string]bool{}
+	var syms []string
+	for i, e := range a.items {
+		label := ""
+		if a.labels[i] != "" {
+			switch l := labelName(a.labels[i]); {
+			case !keepLabels:
+				w.g.warn(a.pos, "label %s is dropped: gogll requires a named alternate for labels", a.labels[i])
+			case labels[l]:
+				w.g.warn(a.pos, "duplicate label %s is dropped", a.labels[i])
+			default:
+				labels[l] = true
+				label = l
+			}
+		}
+		syms = append(syms, w.symbol(nt, e, label)...)
+	}
+	if len(syms) == 0 {
+		if len(a.items) > 0 {
+			return "", false
+		}
+		syms = []string{"empty"}
+	}
+	if name != "" {
+		syms = append(syms, "#"+name)
+	}
+	return strings.Join(syms, " "), true
+}
+
+// symbol returns the symbols of e in the rule of nt. The label is only
+// written if e is a single symbol.
+func (w *writer) symbol(nt string, e expr, label string) []string {
+	var syms []string
+	switch e := e.(type) {
+	case *ref:
+		syms = []string{w.ref(e)}
+	case *lit:
+		syms = w.literal(e)
+	case *set:
+		w.g.warn(e.pos, "character set in parser rule is dropped")
+	case *anyChar:
+		w.g.warn(e.pos, "wildcard in parser rule is dropped")
+	case *choice:
+		if len(e.alts) == 1 {
+			syms = w.symbols(nt, e.alts[0])
+		} else if alts := w.groupAlternates(nt, e); len(alts) > 0 {
+			syms = []string{w.generate(nt, alts, "")}
+		}
+	case *repeat:
+		syms = w.repeat(nt, e)
+	}
+	if label != "" && len(syms) == 1 {
+		syms[0] = label + ":" + syms[0]
+	}
+	return syms
+}
+
+// symbols returns the symbols of an alternate of a group, whose labels are
+// dropped
+func (w *writer) symbols(nt string, a *alternate) []string {
+	var syms []string
+	for i, e := range a.items {
+		if a.labels[i] != "" {
+			w.g.warn(a.pos, "label %s in a group is dropped", a.labels[i])
+		}
+		syms = append(syms, w.symbol(nt, e, "")...)
+	}
+	return syms
+}
+
+// groupAlternates returns the alternates of the group ch. An alternate of
+// which every symbol is dropped is dropped.
+func (w *writer) groupAlternates(nt string, ch *choice) []string {
+	var alts []string
+	for _, a := range ch.alts {
+		syms := w.symbols(nt, a)
+		if len(syms) == 0 && len(a.items) > 0 {
+			w.g.warn(a.pos, "alternate of a group is dropped: all its symbols are dropped")
+			continue
+		}
+		if len(syms) == 0 {
+			syms = []string{"empty"}
+		}
+		alts = append(alts, strings.Join(syms, " "))
+	}
+	return alts
+}
+
+/*
+repeat desugars X?, X* and X+ into a new rule N:
+
+	X? : N : X | empty ;
+	X* : N : empty | X N ;
+	X+ : N : X | X N ;
+
+If X is a group with several alternates each alternate of X is an
+alternate of N.
+*/
+func (w *writer) repeat(nt string, r *repeat) []string {
+	var inner []string
+	if ch, ok := r.e.(*choice); ok && len(ch.alts) > 1 {
+		if inner = w.groupAlternates(nt, ch); len(inner) == 0 {
+			return nil
+		}
+	} else if syms := w.symbol(nt, r.e, ""); len(syms) > 0 {
+		inner = []string{strings.Join(syms, " ")}
+	} else {
+		return nil
+	}
+	var alts []string
+	switch r.typ {
+	case ast.LexOptional:
+		alts = append(inner, "empty")
+	case ast.LexZeroOrMore:
+		alts = []string{"empty"}
+		for _, a := range inner {
+			alts = append(alts, a+" $")
+		}
+	case ast.LexOneOrMore:
+		alts = inner
+		for _, a := range inner {
+			alts = append(alts, a+" $")
+		}
+	}
+	return []string{w.generate(nt, alts, "$")}
+}
+
+/*
+generate returns the nonterminal of a generated rule of nt with alts. self
+stands for the generated nonterminal in alts. Generated rules with the same
+alternates are shared.
+*/
+func (w *writer) generate(nt string, alts []string, self string) string {
+	key := strings.Join(alts, " | ")
+	if name, ok := w.generated[key]; ok {
+		return name
+	}
+	w.count++
+	name := w.newName(fmt.Sprintf("%s_%d", nt, w.count))
+	w.generated[key] = name
+	if self != "" {
+		for i, a := range alts {
+			alts[i] = strings.ReplaceAll(a, " "+self, " "+name)
+		}
+	}
+	w.generatedRules = append(w.generatedRules, fmt.Sprintf("%s : %s ;\n", name, strings.Join(alts, " | ")))
+	return name
+}
+
+// ref returns the symbol of a reference to a rule or token in a parser rule
+func (w *writer) ref(r *ref) string {
+	if nt, ok := w.nts[r.name]; ok {
+		return nt
+	}
+	if l, ok := w.lits[r.name]; ok {
+		return stringLit(l)
+	}
+	if tok, ok := w.toks[r.name]; ok {
+		return tok
+	}
+	pos := r.pos
+	if t := w.g.getToken(r.name); t != nil {
+		pos = t.pos
+	}
+	w.g.warn(pos, "token %s has no lexical definition: it is written as a lex rule that matches its name", r.name)
+	w.toks[r.name] = w.newName(tokIDName(r.name))
+	w.placeholders = append(w.placeholders, r.name)
+	return w.toks[r.name]
+}
+
+// literal returns the string literals of l. gogll string literals cannot
+// contain white space, so l is split at white space.
+func (w *writer) literal(l *lit) []string {
+	fields := strings.FieldsFunc(string(l.str), unicode.IsSpace)
+	if len(fields) != 1 || len(fields[0]) != len(string(l.str)) {
+		w.g.warn(l.pos, "string literal %q contains white space: it is split at the white space", string(l.str))
+	}
+	syms := make([]string, len(fields))
+	for i, f := range fields {
+		syms[i] = stringLit([]rune(f))
+	}
+	return syms
+}
+
+/*** Lex rules ***/
+
+func (w *writer) lexRule(tok string, r *rule) {
+	syms := w.lexBracket(ast.LexGroup, r.body, map[string]bool{r.name: true})
+	if len(syms) == 0 {
+		w.g.warn(r.pos, "lexer rule %s only matches the empty string: it is dropped", r.name)
+		return
+	}
+	if space, _ := w.spaceFirst(r.body, map[string]bool{r.name: true}); space {
+		w.g.warn(r.pos, "%s can start with white space, which the gogll lexer skips before a token", r.name)
+	}
+	if r.suppress {
+		tok = "!" + tok
+	}
+	fmt.Fprintf(w.w, "\n%s : %s ;\n", tok, strings.Join(syms, " "))
+}
+
+// placeholder writes the lex rule of a token without lexical definition,
+// which matches the name of the token
+func (w *writer) placeholder(name string) {
+	syms := make([]string, 0, len(name))
+	for _, r := range name {
+		syms = append(syms, charLit(r))
+	}
+	fmt.Fprintf(w.w, "\n%s : %s ;\n", w.toks[name], strings.Join(syms, " "))
+}
+
+/*
+lexBracket returns the lex symbols of ch in a bracket of type typ. A group
+with one alternate is inlined. Empty alternates are dropped, which makes a
+group or one-or-more bracket optional. visiting are the rules being inlined.
+*/
+func (w *writer) lexBracket(typ ast.BracketType, ch *choice, visiting map[string]bool) []string {
+	var alts []string
+	for _, a := range ch.alts {
+		var syms []string
+		for _, e := range a.items {
+			syms = append(syms, w.lexExpr(e, visiting)...)
+		}
+		if len(syms) == 0 {
+			switch typ {
+			case ast.LexGroup:
+				typ = ast.LexOptional
+			case ast.LexOneOrMore:
+				typ = ast.LexZeroOrMore
+			}
+			continue
+		}
+		alts = append(alts, strings.Join(syms, " "))
+	}
+	if len(alts) == 0 {
+		return nil
+	}
+	if typ == ast.LexGroup && len(alts) == 1 {
+		return []string{alts[0]}
+	}
+	b := brackets[typ]
+	return []string{b[0] + " " + strings.Join(alts, " | ") + " " + b[1]}
+}
+
+func (w *writer) lexExpr(e expr, visiting map[string]bool) []string {
+	switch e := e.(type) {
+	case *lit:
+		syms := make([]string, len(e.str))
+		for i, r := range e.str {
+			syms[i] = charLit(r)
+		}
+		return syms
+	case *set:
+		return w.lexSet(e)
+	case *anyChar:
+		return []string{"."}
+	case *choice:
+		return w.lexBracket(ast.LexGroup, e, visiting)
+	case *repeat:
+		ch, ok := e.e.(*choice)
+		if !ok {
+			ch = &choice{alts: []*alternate{{items: []expr{e.e}}}}
+		}
+		return w.lexBracket(e.typ, ch, visiting)
+	case *ref:
+		r := w.lexRules[e.name]
+		switch {
+		case r == nil:
+			w.g.warn(e.pos, "%s is not a lexer rule: it is dropped", e.name)
+		case visiting[e.name]:
+			w.g.warn(e.pos, "recursive reference to lexer rule %s is dropped", e.name)
+		default:
+			visiting[e.name] = true
+			defer delete(visiting, e.name)
+			return w.lexBracket(ast.LexGroup, r.body, visiting)
+		}
+	}
+	return nil
+}
+
+/*
+lexSet returns the lex symbol of s. A set of characters is written as a
+character literal, a range, any "..." or not "...". A set with Unicode
+properties is written as a Unicode set, e.g.: '[ \p{L} 'a'-'z' ]'.
+*/
+func (w *writer) lexSet(s *set) []string {
+	var props []string
+	for _, p := range s.props {
+		if _, ok := token.IDToType[`\p{`+p+`}`]; ok {
+			props = append(props, `\p{`+p+`}`)
+		} else {
+			w.g.warn(s.pos, `Unicode property \p{%s} is not supported by gogll: it is dropped`, p)
+		}
+	}
+	if len(props) > 0 {
+		return []string{unicodeSet(props, s.ranges, s.not)}
+	}
+	rngs := s.ranges
+	if s.not {
+		if singles(rngs) {
+			return []string{"not " + stringLit(runes(rngs))}
+		}
+		rngs = rngs.Complement()
+	}
+	switch {
+	case rngs.Empty():
+		w.g.warn(s.pos, "empty character set is dropped")
+		return nil
+	case len(rngs) == 1:
+		return []string{charRange(rngs[0])}
+	case singles(rngs):
+		return []string{"any " + stringLit(runes(rngs))}
+	}
+	parts := make([]string, len(rngs))
+	for i, rng := range rngs {
+		parts[i] = charRange(rng)
+	}
+	return []string{"( " + strings.Join(parts, " | ") + " )"}
+}
+
+// unicodeSet returns the Unicode set of props and rngs, which is negated if
+// not is true
+func unicodeSet(props []string, rngs runeset.Ranges, not bool) string {
+	var specs []string
+	minus := ""
+	if not {
+		specs = append(specs, `'\x00'-'\U0010ffff'`)
+		minus = "-"
+	}
+	for _, p := range props {
+		specs = append(specs, minus+p)
+	}
+	for _, rng := range rngs {
+		specs = append(specs, minus+charLit(rng.Lo)+"-"+charLit(rng.Hi))
+	}
+	return "'[ " + strings.Join(specs, " ") + " ]'"
+}
+
+// singles returns true if every range of rngs is a single character
+func singles(rngs runeset.Ranges) bool {
+	for _, rng := range rngs {
+		if rng.Lo != rng.Hi {
+			return false
+		}
+	}
+	return true
+}
+
+func runes(rngs runeset.Ranges) []rune {
+	rs := make([]rune, len(rngs))
+	for i, rng := range rngs {
+		rs[i] = rng.Lo
+	}
+	return rs
+}
+
+func charRange(rng runeset.Range) string {
+	if rng.Lo == rng.Hi {
+		return charLit(rng.Lo)
+	}
+	return charLit(rng.Lo) + "-" + charLit(rng.Hi)
+}
+
+/*
+onlySpace returns true if the alternates of ch only match white space, e.g.:
+the ANTLR rule WS : [ \t\r\n]+ -> skip ;
+*/
+func (w *writer) onlySpace(ch *choice, visiting map[string]bool) bool {
+	for _, a := range ch.alts {
+		for _, e := range a.items {
+			if !w.onlySpaceExpr(e, visiting) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (w *writer) onlySpaceExpr(e expr, visiting map[string]bool) bool {
+	switch e := e.(type) {
+	case *lit:
+		return strings.TrimFunc(string(e.str), unicode.IsSpace) == ""
+	case *set:
+		if e.not || len(e.props) > 0 {
+			return false
+		}
+		for _, rng := range e.ranges {
+			for r := rng.Lo; r <= rng.Hi; r++ {
+				if !unicode.IsSpace(r) {
+					return false
+				}
+			}
+		}
+		return true
+	case *choice:
+		return w.onlySpace(e, visiting)
+	case *repeat:
+		return w.onlySpaceExpr(e.e, visiting)
+	case *ref:
+		r := w.g.getRule(e.name)
+		if r == nil || !r.lex || visiting[e.name] {
+			return false
+		}
+		visiting[e.name] = true
+		return w.onlySpace(r.body, visiting)
+	}
+	return false
+}
+
+/*
+spaceFirst returns true as space if ch matches a string that starts with a
+white space character listed in a literal or set, e.g.: the ANTLR rule
+NEWLINE : '\r'? '\n' ; nullable is true if ch matches the empty string.
+*/
+func (w *writer) spaceFirst(ch *choice, visiting map[string]bool) (space, nullable bool) {
+	for _, a := range ch.alts {
+		altNullable := true
+		for _, e := range a.items {
+			sp, n := w.spaceFirstExpr(e, visiting)
+			space = space || sp
+			if !n {
+				altNullable = false
+				break
+			}
+		}
+		nullable = nullable || altNullable
+	}
+	return
+}
+
+func (w *writer) spaceFirstExpr(e expr, visiting map[string]bool) (space, nullable bool) {
+	switch e := e.(type) {
+	case *lit:
+		if len(e.str) == 0 {
+			return false, true
+		}
+		return unicode.IsSpace(e.str[0]), false
+	case *set:
+		if e.not {
+			return false, false
+		}
+		for _, rng := range e.ranges {
+			for r := rng.Lo; r <= rng.Hi && r <= unicode.MaxLatin1; r++ {
+				if unicode.IsSpace(r) {
+					return true, false
+				}
+			}
+		}
+		return false, false
+	case *choice:
+		return w.spaceFirst(e, visiting)
+	case *repeat:
+		space, nullable = w.spaceFirstExpr(e.e, visiting)
+		return space, nullable || e.typ != ast.LexOneOrMore
+	case *ref:
+		r := w.lexRules[e.name]
+		if r == nil || visiting[e.name] {
+			return false, false
+		}
+		visiting[e.name] = true
+		defer delete(visiting, e.name)
+		return w.spaceFirst(r.body, visiting)
+	}
+	return false, false
+}
+
+/*** Literals ***/
+
+// charLit returns r as a gogll character literal
+func charLit(r rune) string {
+	switch r {
+	case '\'':
+		return `'\''`
+	case '\\':
+		return `'\\'`
+	}
+	return "'" + escape(r) + "'"
+}
+
+// stringLit returns str as a gogll string literal
+func stringLit(str []rune) string {
+	w := new(strings.Builder)
+	w.WriteString(`"`)
+	for _, r := range str {
+		switch r {
+		case '"', '\\':
+			w.WriteString(`\` + string(r))
+		default:
+			w.WriteString(escape(r))
+		}
+	}
+	w.WriteString(`"`)
+	return w.String()
+}
+
+// escape returns r as it is written in a character or string literal
+func escape(r rune) string {
+	switch {
+	case r == '\n':
+		return `\n`
+	case r == '\r':
+		return `\r`
+	case r == '\t':
+		return `\t`
+	case unicode.IsGraphic(r):
+		return string(r)
+	case r < 0x100:
+		return fmt.Sprintf(`\x%02x`, r)
+	case r < 0x10000:
+		return fmt.Sprintf(`\u%04x`, r)
+	}
+	return fmt.Sprintf(`\U%08x`, r)
+}
@@ -0,0 +1,264 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package importer
+
+import (
+	"unicode"
+
+	"github.com/goccmack/gogll/v3/ast"
+)
+
+// yaccIdent are the runes other than letters and digits in Yacc identifiers
+const yaccIdent = "._"
+
+// yaccTypes are the directives that only declare the C types of the semantic
+// values or the expected conflicts, which are dropped without a warning
+var yaccTypes = map[string]bool{
+	"type":      true,
+	"union":     true,
+	"expect":    true,
+	"expect-rr": true,
+}
+
+/*
+yaccReader reads a Yacc or Bison grammar. The tokens declared by %token and the
+precedence directives have no lexical definition in the grammar, which is
+written for a separate lexer. A token with a Bison alias, e.g.:
+%token IF "if", is written as the alias. Precedence, actions and the C code of
+the grammar are dropped with a warning.
+*/
+type yaccReader struct {
+	*scanner
+	g *grammar
+}
+
+func readYacc(file string, src []rune) (*grammar, error) {
+	r := &yaccReader{scanner: newScanner(file, src), g: &grammar{file: file}}
+	if err := r.declarations(); err != nil {
+		return nil, err
+	}
+	if err := r.rules(); err != nil {
+		return nil, err
+	}
+	return r.g, nil
+}
+
+func (r *yaccReader) declarations() error {
+	for {
+		r.skipSpace()
+		pos := r.position()
+		switch {
+		case r.peek() == eof:
+			return r.errorf("expected %%%%")
+		case r.accept("%%"):
+			return nil
+		case r.accept("%{"):
+			for !r.accept("%}") {
+				if r.next() == eof {
+					return r.errorf("expected %%}")
+				}
+			}
+			r.g.warn(pos, "the C declarations are dropped")
+		case r.accept("%"):
+			if err := r.directive(pos); err != nil {
+				return err
+			}
+		default:
+			return r.errorf("unexpected %c", r.peek())
+		}
+	}
+}
+
+func (r *yaccReader) directive(pos *ast.Position) error {
+	switch d := r.ident("-_"); d {
+	case "token":
+		r.symbols()
+	case "left", "right", "nonassoc", "precedence":
+		r.symbols()
+		r.g.warn(pos, "precedence directive %%%s is dropped", d)
+	case "start":
+		r.skipSpace()
+		r.g.start, r.g.startPos = r.ident(yaccIdent), pos
+	case "":
+		return r.errorf("expected directive")
+	default:
+		r.skipLine()
+		if !yaccTypes[d] {
+			r.g.warn(pos, "directive %%%s is dropped", d)
+		}
+	}
+	return nil
+}
+
+// symbols reads the type tags, names, numbers and aliases of a token or
+// precedence directive
+func (r *yaccReader) symbols() {
+	var last *tokenDecl
+	for {
+		r.skipSpace()
+		pos := r.position()
+		switch c := r.peek(); {
+		case c == '<':
+			r.balanced('<', '>')
+		case c == '_' || unicode.IsLetter(c):
+			name := r.ident(yaccIdent)
+			if last = r.g.getToken(name); last == nil {
+				last = &tokenDecl{name: name, pos: pos}
+				r.g.tokens = append(r.g.tokens, last)
+			}
+		case unicode.IsDigit(c):
+			for unicode.IsDigit(r.peek()) {
+				r.next()
+			}
+		case c == '"':
+			s, err := r.literal()
+			if err == nil && last != nil {
+				last.alias = s.str
+			}
+		case c == '\'':
+			r.literal()
+		default:
+			return
+		}
+	}
+}
+
+func (r *yaccReader) rules() error {
+	for {
+		r.skipSpace()
+		if r.peek() == eof {
+			return nil
+		}
+		pos := r.position()
+		if r.accept("%%") {
+			if r.skipSpace(); r.peek() != eof {
+				r.g.warn(r.position(), "the C code after the rules is dropped")
+			}
+			return nil
+		}
+		name := r.ident(yaccIdent)
+		if name == "" {
+			return r.errorf("expected rule")
+		}
+		if err := r.expect(":"); err != nil {
+			return err
+		}
+		body, err := r.alternates()
+		if err != nil {
+			return err
+		}
+		r.g.addAlternates(name, pos, body)
+		r.skipSpace()
+		r.accept(";")
+	}
+}
+
+func (r *yaccReader) alternates() (*choice, error) {
+	ch := &choice{}
+	for {
+		a, err := r.alternative()
+		if err != nil {
+			return nil, err
+		}
+		ch.alts = append(ch.alts, a)
+		r.skipSpace()
+		if !r.accept("|") {
+			return ch, nil
+		}
+	}
+}
+
+// alternative reads the symbols of an alternate up to |, ; or the name of
+// the next rule
+func (r *yaccReader) alternative() (*alternate, error) {
+	r.skipSpace()
+	a := &alternate{pos: r.position()}
+	for {
+		r.skipSpace()
+		pos := r.position()
+		switch c := r.peek(); {
+		case c == ';' || c == '|' || c == eof || r.hasPrefix("%%"):
+			return a, nil
+		case c == '{':
+			if _, err := r.balanced('{', '}'); err != nil {
+				return nil, err
+			}
+			r.g.warn(pos, "action is dropped")
+		case c == '%':
+			r.next()
+			switch d := r.ident("-"); d {
+			case "empty":
+			case "prec", "dprec", "merge":
+				r.skipSpace()
+				if r.peek() == '\'' {
+					r.literal()
+				} else if r.peek() == '<' {
+					r.balanced('<', '>')
+				} else {
+					r.ident(yaccIdent)
+				}
+				r.g.warn(pos, "%%%s is dropped", d)
+			default:
+				return nil, r.errorf("unexpected %%%s", d)
+			}
+		case c == '\'' || c == '"':
+			l, err := r.literal()
+			if err != nil {
+				return nil, err
+			}
+			a.add(l, "")
+		case c == '_' || unicode.IsLetter(c):
+			save := *r.scanner
+			name := r.ident(yaccIdent)
+			r.skipSpace()
+			if r.peek() == ':' {
+				*r.scanner = save
+				return a, nil
+			}
+			if r.peek() == '[' {
+				r.balanced('[', ']')
+			}
+			if name == "error" {
+				r.g.warn(pos, "error token is dropped")
+			} else {
+				a.add(&ref{name: name, pos: pos}, "")
+			}
+		default:
+			return nil, r.errorf("unexpected %c", c)
+		}
+	}
+}
+
+// literal reads a character literal 'c' or a string literal "..."
+func (r *yaccReader) literal() (*lit, error) {
+	l := &lit{pos: r.position()}
+	quote := r.next()
+	for {
+		switch c := r.next(); c {
+		case quote:
+			return l, nil
+		case '\\':
+			e, err := r.escape()
+			if err != nil {
+				return nil, err
+			}
+			l.str = append(l.str, e)
+		case '\n', eof:
+			return nil, r.errorf("unterminated literal")
+		default:
+			l.str = append(l.str, c)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+/*
+Package loader loads a gogll grammar: it parses the grammar, builds its AST,
+checks its semantics and computes its first and follow sets. The grammar
+slots and the minimised lexer DFA, which are used by the generators and the
+interpreter, are computed by Grammar.
+*/
+package loader
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/cfg"
+	"github.com/goccmack/gogll/v3/frstflw"
+	"github.com/goccmack/gogll/v3/gslot"
+	"github.com/goccmack/gogll/v3/interp"
+	"github.com/goccmack/gogll/v3/lex/items"
+	"github.com/goccmack/gogll/v3/lexer"
+	"github.com/goccmack/gogll/v3/parser"
+	"github.com/goccmack/gogll/v3/sc"
+	"github.com/goccmack/gogll/v3/symbols"
+)
+
+// Grammar is a loaded grammar
+type Grammar struct {
+	AST *ast.GoGLL
+	FF  *frstflw.FF
+}
+
+// File loads the grammar in file. It returns the parse errors of the grammar.
+func File(file string) (*Grammar, []*parser.Error) {
+	return load(lexer.NewFile(file), file)
+}
+
+// Source loads the grammar src, which is reported as file in diagnostics. It
+// returns the parse errors of the grammar.
+func Source(file, src string) (*Grammar, []*parser.Error) {
+	return load(lexer.New([]rune(src)), file)
+}
+
+/*
+load parses the grammar of lex. Like ast.Build and sc.Go, which report the
+semantic errors of the grammar, load exits if the parse forest of the grammar
+is ambiguous.
+*/
+func load(lex *lexer.Lexer, file string) (*Grammar, []*parser.Error) {
+	bsrSet, errs := parser.Parse(lex)
+	if errs != nil {
+		return nil, errs
+	}
+
+	if bsrSet.IsAmbiguous() {
+		fmt.Println("Error: Ambiguous parse forest")
+		bsrSet.ReportAmbiguous()
+		os.Exit(1)
+	}
+
+	g := ast.Build(bsrSet.GetRoot(), lex, file)
+	sc.Go(g)
+	symbols.Init(g)
+	return &Grammar{AST: g, FF: frstflw.New(g)}, nil
+}
+
+// GSlots returns the grammar slots of the grammar
+func (gr *Grammar) GSlots() *gslot.GSlot {
+	return gslot.New(gr.AST, gr.FF)
+}
+
+// LexSets returns the minimised lexer DFA of the grammar. Like items.New it
+// exits if the lex rules of the grammar have incompatible events.
+func (gr *Grammar) LexSets() *items.Sets {
+	lexSets := items.New(gr.AST)
+	minLexSets := lexSets.Minimise(gr.AST.GetStringLiteralsSet())
+	if cfg.Verbose {
+		fmt.Printf("Lexer DFA: %d states, %d after minimisation\n",
+			lexSets.Len(), minLexSets.Len())
+	}
+	return minLexSets
+}
+
+// Interp returns the interpreter of the grammar
+func (gr *Grammar) Interp() *interp.Grammar {
+	return interp.New(gr.AST, gr.FF, gr.GSlots(), gr.LexSets())
+}
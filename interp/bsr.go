@@ -0,0 +1,269 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package interp
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/goccmack/gogll/v3/symbols"
+)
+
+/*
+Set contains the Binary Subtree Representations (BSR) of a parse, as defined
+in
+
+	Scott et al
+	Derivation representation using binary subtree sets,
+	Science of Computer Programming 175 (2019)
+
+Unlike the generated BSR set, the intermediate BSRs are keyed by grammar slot,
+which keeps the ambiguous splits of a string of symbols apart.
+*/
+type Set struct {
+	g     *Grammar
+	lex   *Lexer
+	start symbols.NT
+
+	// ntBSRs contains the BSRs of the completed alternates by nonterminal
+	// and extent
+	ntBSRs map[ntKey][]bsr
+
+	// pivots contains the pivots of the intermediate BSRs by slot and extent
+	pivots map[strKey][]int
+
+	bsrs map[bsr]bool
+}
+
+type bsr struct {
+	L                 int
+	lext, pivot, rext int
+}
+
+type ntKey struct {
+	nt         symbols.NT
+	lext, rext int
+}
+
+type strKey struct {
+	L, lext, rext int
+}
+
+// derivation is a derivation of a nonterminal by the alternate of slot L.
+// ext[i] and ext[i+1] are the left and right extents of symbol i of the
+// alternate.
+type derivation struct {
+	L   int
+	ext []int
+}
+
+func newSet(g *Grammar, start symbols.NT, l *Lexer) *Set {
+	return &Set{
+		g:      g,
+		lex:    l,
+		start:  start,
+		ntBSRs: make(map[ntKey][]bsr),
+		pivots: make(map[strKey][]int),
+		bsrs:   make(map[bsr]bool),
+	}
+}
+
+func (s *Set) add(L, i, k, j int) {
+	slot := s.g.slots[L]
+	if !slot.eor() && slot.pos < 2 {
+		return
+	}
+	b := bsr{L, i, k, j}
+	if s.bsrs[b] {
+		return
+	}
+	s.bsrs[b] = true
+	if slot.eor() {
+		nk := ntKey{slot.head, i, j}
+		s.ntBSRs[nk] = append(s.ntBSRs[nk], b)
+	} else {
+		sk := strKey{L, i, j}
+		s.pivots[sk] = append(s.pivots[sk], k)
+	}
+}
+
+func (s *Set) addEmpty(L, i int) {
+	s.add(L, i, i, i)
+}
+
+// contain returns true iff s contains a derivation of nt with extent lext,rext
+func (s *Set) contain(nt symbols.NT, lext, rext int) bool {
+	return len(s.ntBSRs[ntKey{nt, lext, rext}]) > 0
+}
+
+func (s *Set) rightExtent() int {
+	return len(s.lex.Tokens) - 1
+}
+
+// derivations returns the derivations of nt with extent lext,rext
+func (s *Set) derivations(nt symbols.NT, lext, rext int) (ds []derivation) {
+	for _, b := range s.ntBSRs[ntKey{nt, lext, rext}] {
+		slot := s.g.slots[b.L]
+		switch len(slot.symbols) {
+		case 0:
+			ds = append(ds, derivation{b.L, []int{lext}})
+		case 1:
+			ds = append(ds, derivation{b.L, []int{lext, rext}})
+		default:
+			for _, ext := range s.splits(b.L-1, lext, b.pivot) {
+				ds = append(ds, derivation{b.L, append(ext, rext)})
+			}
+		}
+	}
+	sort.Slice(ds, func(i, j int) bool { return ds[i].less(ds[j]) })
+	return
+}
+
+// splits returns the extents of the symbols before slot L for every way in
+// which the symbols before L derive the tokens from lext to rext.
+func (s *Set) splits(L, lext, rext int) (exts [][]int) {
+	if s.g.slots[L].pos == 1 {
+		return [][]int{{lext, rext}}
+	}
+	for _, k := range s.pivots[strKey{L, lext, rext}] {
+		for _, ext := range s.splits(L-1, lext, k) {
+			exts = append(exts, append(ext, rext))
+		}
+	}
+	return
+}
+
+func (d derivation) less(d1 derivation) bool {
+	if d.L != d1.L {
+		return d.L < d1.L
+	}
+	for i := range d.ext {
+		if d.ext[i] != d1.ext[i] {
+			return d.ext[i] < d1.ext[i]
+		}
+	}
+	return false
+}
+
+// CompletedAlternates calls f once for every completion of an alternate in
+// the parse, with the head of the alternate and its index in the syntax rule.
+func (s *Set) CompletedAlternates(f func(nt string, alt int)) {
+	for _, bsrs := range s.ntBSRs {
+		for _, b := range bsrs {
+			l := s.g.slots[b.L].label
+			f(l.Head, l.Alternate)
+		}
+	}
+}
+
+// IsAmbiguous returns true iff any nonterminal in the parse forest has more
+// than one derivation.
+func (s *Set) IsAmbiguous() bool {
+	ambiguous := false
+	s.walk(func(nt symbols.NT, lext, rext int, ds []derivation) {
+		if len(ds) > 1 {
+			ambiguous = true
+		}
+	})
+	return ambiguous
+}
+
+/*
+ReportAmbiguous writes every nonterminal in the parse forest that has more
+than one derivation to w, e.g.:
+
+	Expr at line 1 col 1 "a-b-c" has 2 derivations:
+	  Expr : Expr - Expr  "a-b" "-" "c"
+	  Expr : Expr - Expr  "a" "-" "b-c"
+*/
+func (s *Set) ReportAmbiguous(w io.Writer) {
+	s.walk(func(nt symbols.NT, lext, rext int, ds []derivation) {
+		if len(ds) < 2 {
+			return
+		}
+		ln, col := s.lex.GetLineColumn(s.lex.Tokens[lext].Lext)
+		fmt.Fprintf(w, "%s at line %d col %d %s has %d derivations:\n",
+			nt, ln, col, s.text(lext, rext), len(ds))
+		for _, d := range ds {
+			slot := s.g.slots[d.L]
+			texts := make([]string, len(slot.symbols))
+			for i := range slot.symbols {
+				texts[i] = s.text(d.ext[i], d.ext[i+1])
+			}
+			fmt.Fprintf(w, "  %s  %s\n", slot.alternate(), strings.Join(texts, " "))
+		}
+	})
+}
+
+// walk calls f once for every nonterminal in the parse forest, in depth
+// first order.
+func (s *Set) walk(f func(nt symbols.NT, lext, rext int, ds []derivation)) {
+	done := map[ntKey]bool{}
+	var walk func(nt symbols.NT, lext, rext int)
+	walk = func(nt symbols.NT, lext, rext int) {
+		if done[ntKey{nt, lext, rext}] {
+			return
+		}
+		done[ntKey{nt, lext, rext}] = true
+		ds := s.derivations(nt, lext, rext)
+		f(nt, lext, rext, ds)
+		for _, d := range ds {
+			for i, sym := range s.g.slots[d.L].symbols {
+				if sym.IsNonTerminal() {
+					walk(sym.(symbols.NT), d.ext[i], d.ext[i+1])
+				}
+			}
+		}
+	}
+	walk(s.start, 0, s.rightExtent())
+}
+
+/*
+WriteTree writes the parse tree of an unambiguous Set to w. Each nonterminal
+is written with its alternate and each token with its type and literal, e.g.:
+
+	Stmt : id = Expr
+	  id "x"
+	  = "="
+	  Expr : num
+	    num "1"
+*/
+func (s *Set) WriteTree(w io.Writer) {
+	s.writeTree(w, s.start, 0, s.rightExtent(), "")
+}
+
+func (s *Set) writeTree(w io.Writer, nt symbols.NT, lext, rext int, indent string) {
+	d := s.derivations(nt, lext, rext)[0]
+	slot := s.g.slots[d.L]
+	fmt.Fprintf(w, "%s%s\n", indent, slot.alternate())
+	for i, sym := range slot.symbols {
+		if sym.IsNonTerminal() {
+			s.writeTree(w, sym.(symbols.NT), d.ext[i], d.ext[i+1], indent+"  ")
+		} else {
+			tok := s.lex.Tokens[d.ext[i]]
+			fmt.Fprintf(w, "%s  %s %q\n", indent, tok.Type.ID(), tok.Literal)
+		}
+	}
+}
+
+// text returns the quoted input of the tokens from lext to rext, or ϵ
+func (s *Set) text(lext, rext int) string {
+	if lext == rext {
+		return "ϵ"
+	}
+	return fmt.Sprintf("%q", s.lex.GetString(lext, rext-1))
+}
@@ -0,0 +1,202 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+/*
+Package interp interprets a grammar without generating code.
+
+The lexer interprets the lexer DFA of the grammar (lex/items) and the parser
+is a GLL parser that interprets the grammar slots (gslot) with the test select
+sets from frstflw. The lexer and parser behave like the generated Go lexer and
+GLL parser: they scan the same tokens, build the same derivations and report
+the same errors.
+*/
+package interp
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/frstflw"
+	"github.com/goccmack/gogll/v3/gslot"
+	"github.com/goccmack/gogll/v3/lex/items"
+	"github.com/goccmack/gogll/v3/lex/items/event"
+	"github.com/goccmack/gogll/v3/symbols"
+	"github.com/goccmack/gogll/v3/util/runeset"
+)
+
+// Grammar contains the lexer DFA and the grammar slots of a grammar.
+// symbols.Init must have been called for the grammar.
+type Grammar struct {
+	g *ast.GoGLL
+
+	// The lexer DFA
+	accept      []symbols.T
+	partial     [][]symbols.T
+	transitions [][]transition
+	keywords    map[string]*keyword
+
+	// The grammar slots in the order of gslot.Slots. The slots of an
+	// alternate are consecutive.
+	slots      []*slot
+	alternates map[symbols.NT][]int
+	follow     map[symbols.NT]tokenSet
+}
+
+type transition struct {
+	runes runeset.Ranges
+	to    int
+}
+
+type keyword struct {
+	typ, class      symbols.T
+	caseInsensitive bool
+}
+
+type slot struct {
+	label   gslot.Label
+	head    symbols.NT
+	symbols symbols.Symbols
+	pos     int
+
+	// first is the set of tokens that can follow the slot
+	first tokenSet
+}
+
+type tokenSet map[symbols.T]bool
+
+// New returns a Grammar for g. ls is the lexer DFA of g.
+func New(g *ast.GoGLL, ff *frstflw.FF, gs *gslot.GSlot, ls *items.Sets) *Grammar {
+	gr := &Grammar{
+		g:          g,
+		keywords:   make(map[string]*keyword),
+		alternates: make(map[symbols.NT][]int),
+		follow:     make(map[symbols.NT]tokenSet),
+	}
+	gr.initLexer(g, ls)
+	gr.initSlots(ff, gs)
+	return gr
+}
+
+// StartSymbols returns the start symbols of the grammar. The first is the
+// default start symbol.
+func (g *Grammar) StartSymbols() []string {
+	return g.g.StartSymbols()
+}
+
+func (g *Grammar) initLexer(gg *ast.GoGLL, ls *items.Sets) {
+	slits := gg.GetStringLiteralsSet()
+	for _, set := range ls.Sets() {
+		g.accept = append(g.accept, symbols.TerminalLiteralToType(set.Accept(slits)))
+		g.partial = append(g.partial, getPartial(set))
+		var trans []transition
+		for _, t := range set.Transitions {
+			trans = append(trans, transition{event.Ranges(t.Event), t.To.No})
+		}
+		g.transitions = append(g.transitions, trans)
+	}
+	for _, kw := range items.Keywords(gg) {
+		g.keywords[kw.Key()] = &keyword{
+			typ:             symbols.TerminalLiteralToType(kw.ID()),
+			class:           symbols.TerminalLiteralToType(kw.Class.ID()),
+			caseInsensitive: kw.CaseInsensitive,
+		}
+	}
+}
+
+// getPartial returns the token types of the lex rules that are partially
+// matched in set. Nothing is matched in S0.
+func getPartial(set *items.Set) (partial []symbols.T) {
+	if set.No == 0 {
+		return nil
+	}
+	ids := map[string]bool{}
+	for _, itm := range set.Items() {
+		if !itm.IsReduce() && !ids[itm.Rule.ID()] {
+			ids[itm.Rule.ID()] = true
+			partial = append(partial, symbols.TerminalLiteralToType(itm.Rule.ID()))
+		}
+	}
+	sort.Slice(partial, func(i, j int) bool {
+		return partial[i].ID() < partial[j].ID()
+	})
+	return
+}
+
+func (g *Grammar) initSlots(ff *frstflw.FF, gs *gslot.GSlot) {
+	for _, nt := range g.g.NonTerminals.Elements() {
+		g.follow[symbols.GetNTType(nt)] = toTokenSet(ff.Follow(nt).Elements())
+	}
+	for i, l := range gs.Slots() {
+		s := &slot{
+			label:   l,
+			head:    symbols.GetNTType(l.Head),
+			symbols: l.Symbols(),
+			pos:     l.Pos,
+		}
+		frst := ff.FirstOfString(s.symbols[l.Pos:].Strings())
+		s.first = toTokenSet(frst.Elements())
+		if frst.Contain(frstflw.Empty) {
+			for t := range g.follow[s.head] {
+				s.first[t] = true
+			}
+		}
+		g.slots = append(g.slots, s)
+		if l.Pos == 0 {
+			g.alternates[s.head] = append(g.alternates[s.head], i)
+		}
+	}
+}
+
+// toTokenSet returns the set of tokens of the terminals in syms, ignoring ϵ
+func toTokenSet(syms []string) tokenSet {
+	ts := tokenSet{}
+	for _, sym := range syms {
+		if sym != frstflw.Empty {
+			ts[symbols.TerminalLiteralToType(sym)] = true
+		}
+	}
+	return ts
+}
+
+// sorted returns the tokens in ts, sorted by token type
+func (ts tokenSet) sorted() (toks []symbols.T) {
+	for t := range ts {
+		toks = append(toks, t)
+	}
+	sort.Slice(toks, func(i, j int) bool { return toks[i] < toks[j] })
+	return
+}
+
+// eor returns true iff s is the end of its alternate
+func (s *slot) eor() bool {
+	return s.pos >= len(s.symbols)
+}
+
+func (s *slot) String() string {
+	return s.label.String()
+}
+
+// alternate returns the alternate of s without the slot position, e.g.:
+// "Expr : Expr Op Expr"
+func (s *slot) alternate() string {
+	str := fmt.Sprintf("%s :", s.head.Literal())
+	if len(s.symbols) == 0 {
+		return str + " empty"
+	}
+	for _, sym := range s.symbols {
+		str += " " + sym.String()
+	}
+	return str
+}
@@ -0,0 +1,113 @@
+package interp_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/goccmack/gogll/v3/internal/loader"
+	"github.com/goccmack/gogll/v3/interp"
+)
+
+const src = `package "interp"
+
+start Stmts, Expr ;
+
+Stmts : Stmt | Stmt Stmts ;
+
+Stmt : "let" id "=" Expr ";" ;
+
+Expr : Expr "-" Expr | id | num ;
+
+id : letter {letter | number} ;
+
+num : number {number} ;
+`
+
+func newGrammar(t *testing.T) *interp.Grammar {
+	gr, errs := loader.Source("test.md", src)
+	if errs != nil {
+		t.Fatal(errs[0])
+	}
+	return gr.Interp()
+}
+
+func TestTree(t *testing.T) {
+	g := newGrammar(t)
+	lex := g.NewLexer([]rune("let x = 1;\nlet lets = x - 2;"))
+	bsrSet, errs := g.Parse("Stmts", lex)
+	if errs != nil {
+		t.Fatal(errs[0])
+	}
+	if bsrSet.IsAmbiguous() {
+		t.Fatal("unexpected ambiguity")
+	}
+	w := new(bytes.Buffer)
+	bsrSet.WriteTree(w)
+	exp := `Stmts : Stmt Stmts
+  Stmt : let id = Expr ;
+    let "let"
+    id "x"
+    = "="
+    Expr : num
+      num "1"
+    ; ";"
+  Stmts : Stmt
+    Stmt : let id = Expr ;
+      let "let"
+      id "lets"
+      = "="
+      Expr : Expr - Expr
+        Expr : id
+          id "x"
+        - "-"
+        Expr : num
+          num "2"
+      ; ";"
+`
+	if w.String() != exp {
+		t.Fatalf("expected:\n%s\ngot:\n%s", exp, w.String())
+	}
+}
+
+func TestAmbiguous(t *testing.T) {
+	g := newGrammar(t)
+	bsrSet, errs := g.Parse("Expr", g.NewLexer([]rune("a - b - c")))
+	if errs != nil {
+		t.Fatal(errs[0])
+	}
+	if !bsrSet.IsAmbiguous() {
+		t.Fatal("expected an ambiguous parse forest")
+	}
+	w := new(bytes.Buffer)
+	bsrSet.ReportAmbiguous(w)
+	exp := `Expr at line 1 col 1 "a - b - c" has 2 derivations:
+  Expr : Expr - Expr  "a" "-" "b - c"
+  Expr : Expr - Expr  "a - b" "-" "c"
+`
+	if w.String() != exp {
+		t.Fatalf("expected:\n%s\ngot:\n%s", exp, w.String())
+	}
+}
+
+func TestParseError(t *testing.T) {
+	g := newGrammar(t)
+	_, errs := g.Parse("Stmts", g.NewLexer([]rune("let x = 1\nlet y = 2;")))
+	if len(errs) == 0 {
+		t.Fatal("expected a parse error")
+	}
+	if errs[0].Line != 2 || errs[0].Column != 1 || errs[0].Token.Literal != "let" {
+		t.Fatalf("unexpected error: %s", errs[0])
+	}
+}
+
+func TestLexError(t *testing.T) {
+	g := newGrammar(t)
+	lex := g.NewLexer([]rune("let x = 1 $ 2;"))
+	if len(lex.Errors) != 1 || lex.Errors[0].Column != 11 {
+		t.Fatalf("expected one lexical error at col 11, got %v", lex.Errors)
+	}
+	_, errs := g.Parse("Stmts", lex)
+	if len(errs) == 0 || errs[0].LexError != lex.Errors[0] {
+		t.Fatal("expected the lexical error first")
+	}
+}
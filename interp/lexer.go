@@ -0,0 +1,290 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package interp
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"unicode"
+
+	"github.com/goccmack/gogll/v3/symbols"
+)
+
+// Lexer contains the input slice of runes and the slice of tokens scanned
+// from the input by the lexer DFA of a grammar
+type Lexer struct {
+	// I is the input slice of runes
+	I []rune
+
+	// Tokens is the slice of tokens scanned from I. The last token is EOF.
+	Tokens []*Token
+
+	// Errors contains the lexical errors in I in order of occurrence
+	Errors []*LexError
+
+	// Suppressed contains the suppressed tokens scanned from I, which are
+	// not in Tokens
+	Suppressed []*Token
+
+	g *Grammar
+}
+
+// Token is a token scanned by Lexer
+type Token struct {
+	Type symbols.T
+
+	// Lext and Rext are the left and right extents of the token in the input
+	Lext, Rext int
+
+	// Literal is the input scanned for the token
+	Literal string
+}
+
+// LexError is a lexical error: the lexer could not scan a token from the input.
+type LexError struct {
+	// Token is the Error token in Lexer.Tokens, which contains the input
+	// skipped by the lexer.
+	Token *Token
+
+	// Pos is the position of the offending rune in the input.
+	// If the lexer reached the end of the input Pos == len(input)
+	Pos int
+
+	// The line and column of the offending rune in the input
+	Line, Column int
+
+	// Rune is the offending rune. It is -1 at the end of the input.
+	Rune rune
+
+	// Partial contains the token types that were partially matched when
+	// the error occurred. It is empty if no token matches the first rune.
+	Partial []symbols.T
+}
+
+const nullState = -1
+
+/*
+NewLexerFile returns a Lexer for the input file, fname.
+
+If the input file is a markdown file all text outside code blocks is treated
+as whitespace.
+*/
+func (g *Grammar) NewLexerFile(fname string) (*Lexer, error) {
+	buf, err := ioutil.ReadFile(fname)
+	if err != nil {
+		return nil, err
+	}
+	input := []rune(string(buf))
+	if strings.HasSuffix(fname, ".md") {
+		loadMd(input)
+	}
+	return g.NewLexer(input), nil
+}
+
+func loadMd(input []rune) {
+	i := 0
+	text := true
+	for i < len(input) {
+		if i <= len(input)-3 && input[i] == '`' && input[i+1] == '`' && input[i+2] == '`' {
+			text = !text
+			for j := 0; j < 3; j++ {
+				input[i+j] = ' '
+			}
+			i += 3
+		}
+		if i < len(input) {
+			if text && input[i] != '\n' {
+				input[i] = ' '
+			}
+			i += 1
+		}
+	}
+}
+
+// NewLexer returns a Lexer for the input slice of runes
+func (g *Grammar) NewLexer(input []rune) *Lexer {
+	lex := &Lexer{
+		I: input,
+		g: g,
+	}
+	for lext := 0; ; {
+		tok := lex.scanToken(lext)
+		lex.Tokens = append(lex.Tokens, tok)
+		if tok.Type == symbols.EoF {
+			return lex
+		}
+		lext = tok.Rext
+	}
+}
+
+// scanToken returns the first token after position lext in the input that
+// is not suppressed.
+func (l *Lexer) scanToken(lext int) *Token {
+	for {
+		for lext < len(l.I) && unicode.IsSpace(l.I[lext]) {
+			lext++
+		}
+		if lext >= len(l.I) {
+			return l.newToken(symbols.EoF, len(l.I), len(l.I))
+		}
+		tok := l.scan(lext)
+		if tok.Type == symbols.Error {
+			l.lexError(tok)
+		}
+		if !tok.Type.Suppress() {
+			return tok
+		}
+		l.Suppressed = append(l.Suppressed, tok)
+		lext = tok.Rext
+	}
+}
+
+// scan follows the same longest match rule as the generated lexer
+func (l *Lexer) scan(i int) *Token {
+	s, typ, rext := nullState, symbols.Error, i+1
+	if i < len(l.I) {
+		s = l.nextState(0, l.I[i])
+	}
+	for s != nullState {
+		typ = l.g.accept[s]
+		if rext >= len(l.I) {
+			s = nullState
+		} else {
+			s = l.nextState(s, l.I[rext])
+			if s != nullState || typ == symbols.Error {
+				rext++
+			}
+		}
+	}
+	return l.newToken(l.keywordType(typ, l.I[i:rext]), i, rext)
+}
+
+func (l *Lexer) nextState(s int, r rune) int {
+	for _, t := range l.g.transitions[s] {
+		if t.runes.Contains(r) {
+			return t.to
+		}
+	}
+	return nullState
+}
+
+// keywordType returns the token type of the keyword lit if class is the
+// identifier token type of the keyword. Otherwise it returns class.
+func (l *Lexer) keywordType(class symbols.T, lit []rune) symbols.T {
+	if kw := l.g.keywords[string(lit)]; kw != nil && kw.class == class {
+		return kw.typ
+	}
+	if kw := l.g.keywords[strings.ToLower(string(lit))]; kw != nil &&
+		kw.class == class && kw.caseInsensitive {
+		return kw.typ
+	}
+	return class
+}
+
+func (l *Lexer) lexError(tok *Token) {
+	s, pos := 0, tok.Lext
+	for pos < len(l.I) {
+		next := l.nextState(s, l.I[pos])
+		if next == nullState {
+			break
+		}
+		s, pos = next, pos+1
+	}
+	err := &LexError{
+		Token:   tok,
+		Pos:     pos,
+		Rune:    -1,
+		Partial: l.g.partial[s],
+	}
+	if pos < len(l.I) {
+		err.Rune = l.I[pos]
+	}
+	err.Line, err.Column = l.GetLineColumn(pos)
+	l.Errors = append(l.Errors, err)
+}
+
+func (l *Lexer) newToken(typ symbols.T, lext, rext int) *Token {
+	return &Token{
+		Type:    typ,
+		Lext:    lext,
+		Rext:    rext,
+		Literal: string(l.I[lext:rext]),
+	}
+}
+
+func (e *LexError) Error() string {
+	w := new(bytes.Buffer)
+	fmt.Fprintf(w, "Lexical error at line %d col %d: ", e.Line, e.Column)
+	if e.Rune == -1 {
+		fmt.Fprint(w, "unexpected end of input")
+	} else {
+		fmt.Fprintf(w, "unexpected %q", e.Rune)
+	}
+	if len(e.Partial) > 0 {
+		fmt.Fprint(w, " while scanning one of [")
+		for i, t := range e.Partial {
+			if i > 0 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprint(w, t.ID())
+		}
+		fmt.Fprint(w, "]")
+	}
+	return w.String()
+}
+
+// WriteTokens writes the tokens to w, one token per line
+func (l *Lexer) WriteTokens(w io.Writer) {
+	for _, tok := range l.Tokens {
+		fmt.Fprintln(w, tok)
+	}
+}
+
+// WriteErrors writes the lexical errors to w, one error per line
+func (l *Lexer) WriteErrors(w io.Writer) {
+	for _, err := range l.Errors {
+		fmt.Fprintln(w, err.Error())
+	}
+}
+
+// GetLineColumn returns the line and column of rune[i] in the input
+func (l *Lexer) GetLineColumn(i int) (line, col int) {
+	line, col = 1, 1
+	for j := 0; j < i; j++ {
+		switch l.I[j] {
+		case '\n':
+			line++
+			col = 1
+		case '\t':
+			col += 4
+		default:
+			col++
+		}
+	}
+	return
+}
+
+// GetString returns the input string from the left extent of Token[lext] to
+// the right extent of Token[rext]
+func (l *Lexer) GetString(lext, rext int) string {
+	return string(l.I[l.Tokens[lext].Lext:l.Tokens[rext].Rext])
+}
+
+func (t *Token) String() string {
+	return fmt.Sprintf("%s (%d,%d) %s", t.Type.ID(), t.Lext, t.Rext, t.Literal)
+}
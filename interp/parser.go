@@ -0,0 +1,303 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package interp
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/goccmack/gogll/v3/symbols"
+)
+
+type parser struct {
+	g   *Grammar
+	lex *Lexer
+	cI  int
+
+	R []descriptor
+	U map[descriptor]bool
+
+	popped    map[poppedNode]bool
+	poppedRxt map[clusterNode][]int
+	crf       map[clusterNode][]crfNode
+
+	start       symbols.NT
+	bsrSet      *Set
+	parseErrors []*Error
+}
+
+type descriptor struct {
+	L, k, i int
+}
+
+type poppedNode struct {
+	X    symbols.NT
+	k, j int
+}
+
+type clusterNode struct {
+	X symbols.NT
+	k int
+}
+
+type crfNode struct {
+	L, i int
+}
+
+/*
+Parse returns the BSR set containing the parse forest of start, which must be
+one of the start symbols of the grammar.
+If the parse was successfull []*Error is nil.
+If the lexer found lexical errors they are returned first in []*Error.
+*/
+func (g *Grammar) Parse(start string, l *Lexer) (*Set, []*Error) {
+	for _, s := range g.StartSymbols() {
+		if s == start {
+			return g.newParser(symbols.GetNTType(start), l).parse()
+		}
+	}
+	panic(fmt.Sprintf("%s is not a start symbol", start))
+}
+
+func (g *Grammar) newParser(start symbols.NT, l *Lexer) *parser {
+	return &parser{
+		g:         g,
+		lex:       l,
+		U:         make(map[descriptor]bool),
+		popped:    make(map[poppedNode]bool),
+		poppedRxt: make(map[clusterNode][]int),
+		crf: map[clusterNode][]crfNode{
+			{start, 0}: {},
+		},
+		start:  start,
+		bsrSet: newSet(g, start, l),
+	}
+}
+
+func (p *parser) parse() (*Set, []*Error) {
+	m := len(p.lex.Tokens) - 1
+	p.ntAdd(p.start, 0)
+	for len(p.R) > 0 {
+		d := p.R[len(p.R)-1]
+		p.R = p.R[:len(p.R)-1]
+		p.cI = d.i
+		p.alternate(d.L, d.k)
+	}
+	if len(p.lex.Errors) > 0 || !p.bsrSet.contain(p.start, 0, m) {
+		p.sortParseErrors()
+		return nil, append(p.lexErrors(), p.parseErrors...)
+	}
+	return p.bsrSet, nil
+}
+
+// alternate interprets the alternate of slot L from L to the end of the
+// alternate or to the first nonterminal after L.
+func (p *parser) alternate(L, cU int) {
+	s := p.g.slots[L]
+	alt := L - s.pos
+	if len(s.symbols) == 0 {
+		p.bsrSet.addEmpty(L, p.cI)
+	}
+	for pos := s.pos; pos < len(s.symbols); pos++ {
+		if pos > 0 && !p.testSelect(alt+pos) {
+			p.parseError(alt+pos, p.cI, p.g.slots[alt+pos].first)
+			return
+		}
+		if s.symbols[pos].IsNonTerminal() {
+			p.call(alt+pos+1, cU, p.cI)
+			return
+		}
+		p.bsrSet.add(alt+pos+1, cU, p.cI, p.cI+1)
+		p.cI++
+	}
+	if p.follow(s.head) {
+		p.rtn(s.head, cU, p.cI)
+	} else {
+		p.parseError(alt, p.cI, p.g.follow[s.head])
+	}
+}
+
+func (p *parser) ntAdd(nt symbols.NT, j int) {
+	failed := true
+	expected := tokenSet{}
+	for _, l := range p.g.alternates[nt] {
+		if p.testSelect(l) {
+			p.dscAdd(l, j, j)
+			failed = false
+		} else {
+			for t := range p.g.slots[l].first {
+				expected[t] = true
+			}
+		}
+	}
+	if failed {
+		for _, l := range p.g.alternates[nt] {
+			p.parseError(l, j, expected)
+		}
+	}
+}
+
+/*** Call Return Forest ***/
+
+func (p *parser) call(L, i, j int) {
+	u := crfNode{L, i}
+	s := p.g.slots[L]
+	X := s.symbols[s.pos-1].(symbols.NT)
+	ndV := clusterNode{X, j}
+	v, exist := p.crf[ndV]
+	if !exist {
+		p.crf[ndV] = []crfNode{u}
+		p.ntAdd(X, j)
+		return
+	}
+	for _, nd := range v {
+		if nd == u {
+			return
+		}
+	}
+	p.crf[ndV] = append(v, u)
+	for _, h := range p.poppedRxt[ndV] {
+		p.dscAdd(L, i, h)
+		p.bsrSet.add(L, i, j, h)
+	}
+}
+
+func (p *parser) rtn(X symbols.NT, k, j int) {
+	pn := poppedNode{X, k, j}
+	if p.popped[pn] {
+		return
+	}
+	p.popped[pn] = true
+	cn := clusterNode{X, k}
+	p.poppedRxt[cn] = append(p.poppedRxt[cn], j)
+	for _, nd := range p.crf[cn] {
+		p.dscAdd(nd.L, nd.i, j)
+		p.bsrSet.add(nd.L, nd.i, k, j)
+	}
+}
+
+func (p *parser) dscAdd(L, k, i int) {
+	d := descriptor{L, k, i}
+	if !p.U[d] {
+		p.U[d] = true
+		p.R = append(p.R, d)
+	}
+}
+
+/*** TestSelect ***/
+
+func (p *parser) follow(nt symbols.NT) bool {
+	return p.g.follow[nt][p.lex.Tokens[p.cI].Type]
+}
+
+func (p *parser) testSelect(L int) bool {
+	return p.g.slots[L].first[p.lex.Tokens[p.cI].Type]
+}
+
+/*** Errors ***/
+
+/*
+Error is returned by Parse at every point at which the parser fails to parse
+a grammar production. For non-LL-1 grammars there will be an error for each
+alternate attempted by the parser.
+
+The errors are sorted in descending order of input position (index of token in
+the stream of tokens).
+*/
+type Error struct {
+	// Index of token that caused the error.
+	cI int
+
+	// Grammar slot at which the error occured, e.g.: "Expr : Expr ∙Op Expr"
+	Slot string
+
+	// The token at which the error occurred.
+	Token *Token
+
+	// The line and column in the input text at which the error occurred
+	Line, Column int
+
+	// The tokens expected at the point where the error occurred
+	Expected []symbols.T
+
+	// LexError is not nil if the error is a lexical error
+	LexError *LexError
+}
+
+func (pe *Error) String() string {
+	if pe.LexError != nil {
+		return pe.LexError.Error()
+	}
+	w := new(bytes.Buffer)
+	fmt.Fprintf(w, "Parse Error: %s I[%d]=%s at line %d col %d\n",
+		pe.Slot, pe.cI, pe.Token, pe.Line, pe.Column)
+	exp := []string{}
+	for _, e := range pe.Expected {
+		exp = append(exp, e.String())
+	}
+	fmt.Fprintf(w, "Expected one of: [%s]", strings.Join(exp, ","))
+	return w.String()
+}
+
+// WriteErrors writes the errors in errs that are on the line of the first
+// error to w, one error per line. Normally they are the errors of interest.
+func WriteErrors(w io.Writer, errs []*Error) {
+	for _, err := range errs {
+		if err.Line == errs[0].Line {
+			fmt.Fprintln(w, err)
+		}
+	}
+}
+
+func (p *parser) parseError(L, i int, expected tokenSet) {
+	pe := &Error{
+		cI:       i,
+		Slot:     p.g.slots[L].String(),
+		Token:    p.lex.Tokens[i],
+		Expected: expected.sorted(),
+	}
+	p.parseErrors = append(p.parseErrors, pe)
+}
+
+// lexErrors returns an Error for every lexical error in the input
+func (p *parser) lexErrors() (errs []*Error) {
+	for _, le := range p.lex.Errors {
+		for i, tok := range p.lex.Tokens {
+			if tok == le.Token {
+				errs = append(errs, &Error{
+					cI:       i,
+					Token:    tok,
+					Line:     le.Line,
+					Column:   le.Column,
+					LexError: le,
+				})
+			}
+		}
+	}
+	return
+}
+
+func (p *parser) sortParseErrors() {
+	sort.SliceStable(p.parseErrors,
+		func(i, j int) bool {
+			return p.parseErrors[j].Token.Lext < p.parseErrors[i].Token.Lext
+		})
+	for _, pe := range p.parseErrors {
+		pe.Line, pe.Column = p.lex.GetLineColumn(pe.Token.Lext)
+	}
+}
@@ -18,14 +18,17 @@ limitations under the License.
 Package event implements the events that cause transitions between FSA states.
 
 * Unicode classes: number, letter, upcase, lowcase, space
-* Ranges: any, anyof, not
+* Ranges: any, anyof, not, character ranges
 * CharLit
+
+Events that contain character ranges are compared as sets of runes
+(runeset.Ranges).
 */
 package event
 
 import (
+	"bytes"
 	"fmt"
-	"os"
 	"sort"
 	"unicode"
 
@@ -74,6 +77,9 @@ func GetOrdered(items ...*item.Item) (events []ast.LexBase) {
 // Subset returns True if a is a subset of b, False if a is not a subset of b,
 // and Undefined if the subset relationship is not defined between a and b
 func Subset(a, b ast.LexBase) TriState {
+	if hasCharRange(a) || hasCharRange(b) {
+		return rangeSubset(a, b)
+	}
 	switch a1 := a.(type) {
 	case *ast.Any:
 		_, ok := b.(*ast.Any)
@@ -175,6 +181,16 @@ func Subset(a, b ast.LexBase) TriState {
 	}
 }
 
+// Match returns true iff r is matched by event e
+func Match(e ast.LexBase, r rune) bool {
+	return toRanges(e).Contains(r)
+}
+
+// Ranges returns the set of runes matched by e
+func Ranges(e ast.LexBase) runeset.Ranges {
+	return toRanges(e)
+}
+
 func anyOf(r rune, rs *runeset.RuneSet) bool {
 	return rs.Contains(r)
 }
@@ -200,6 +216,18 @@ func getEvents(items ...*item.Item) (events []ast.LexBase) {
 	return
 }
 
+// hasCharRange returns true iff e is a CharRange or a UnicodeSet containing
+// a CharRange
+func hasCharRange(e ast.LexBase) bool {
+	switch e1 := e.(type) {
+	case *ast.CharRange:
+		return true
+	case *ast.UnicodeSet:
+		return e1.HasCharRange()
+	}
+	return false
+}
+
 func noneOf(r rune, rs *runeset.RuneSet) bool {
 	return !rs.Contains(r)
 }
@@ -207,6 +235,9 @@ func noneOf(r rune, rs *runeset.RuneSet) bool {
 // sort events from most to least specific class
 func sortEvents(events []ast.LexBase) {
 	sort.Slice(events, func(i, j int) bool {
+		if hasCharRange(events[i]) || hasCharRange(events[j]) {
+			return rangeLess(events[i], events[j])
+		}
 		switch e1 := events[i].(type) {
 		case *ast.Any:
 			return false
@@ -301,6 +332,68 @@ func sortEvents(events []ast.LexBase) {
 	})
 }
 
+// rangeLess returns true iff a is a proper subset of b, or if a and b are not
+// subsets of each other and the first rune of a is less than the first rune of b.
+// The empty set is less than any other set.
+func rangeLess(a, b ast.LexBase) bool {
+	ra, rb := toRanges(a), toRanges(b)
+	switch {
+	case ra.Equal(rb):
+		return false
+	case ra.Empty():
+		return true
+	case rb.Empty():
+		return false
+	case ra.Subset(rb):
+		return true
+	case rb.Subset(ra):
+		return false
+	}
+	return ra[0].Lo < rb[0].Lo
+}
+
+// rangeSubset returns True if the runes of a are a subset of the runes of b,
+// False if a and b have no common runes and Undefined if they overlap.
+func rangeSubset(a, b ast.LexBase) TriState {
+	ra, rb := toRanges(a), toRanges(b)
+	switch {
+	case ra.Subset(rb):
+		return True
+	case ra.Intersection(rb).Empty():
+		return False
+	}
+	return Undefined
+}
+
+// toRanges returns the set of runes matched by e
+func toRanges(e ast.LexBase) runeset.Ranges {
+	switch e1 := e.(type) {
+	case *ast.Any:
+		return runeset.All
+	case *ast.AnyOf:
+		return e1.Set.Ranges()
+	case *ast.CharLiteral:
+		return runeset.NewRanges(runeset.Range{Lo: e1.Char(), Hi: e1.Char()})
+	case *ast.CharRange:
+		return runeset.NewRanges(runeset.Range{Lo: e1.From.Char(), Hi: e1.To.Char()})
+	case *ast.Not:
+		return e1.Set.Ranges().Complement()
+	case *ast.UnicodeClass:
+		return runeset.FromRangeTable(e1.GetRangeTable())
+	case *ast.UnicodeSet:
+		incl, excl := runeset.Ranges{}, runeset.Ranges{}
+		for _, rng := range e1.Ranges {
+			if rng.Exclude {
+				excl = excl.Union(runeset.FromRangeTable(rng.GetRangeTable()))
+			} else {
+				incl = incl.Union(runeset.FromRangeTable(rng.GetRangeTable()))
+			}
+		}
+		return incl.Difference(excl)
+	}
+	panic(fmt.Sprintf("Invalid event %T", e))
+}
+
 func toTriState(b bool) TriState {
 	if b {
 		return True
@@ -332,15 +425,34 @@ func unicodeClassContains(c *ast.UnicodeClass, rs ...rune) bool {
 	return true
 }
 
-func fail(items []*item.Item, incomatibleEvents []eventPair) {
-	fmt.Println("Error in lexer events")
-	fmt.Println("  Set:")
-	for _, item := range items {
-		fmt.Println("    ", item)
+/*
+Error reports the transition events of a set of lex items for which neither
+event is a subset of the other. GetOrdered panics with an *Error, which is
+recovered by items.TryNew.
+*/
+type Error struct {
+	Items  []*item.Item
+	Events [][2]ast.LexBase
+}
+
+func (e *Error) Error() string {
+	w := new(bytes.Buffer)
+	fmt.Fprintln(w, "Error in lexer events")
+	fmt.Fprintln(w, "  Set:")
+	for _, item := range e.Items {
+		fmt.Fprintln(w, "    ", item)
+	}
+	fmt.Fprint(w, "  Incompatible events:")
+	for _, ee := range e.Events {
+		fmt.Fprint(w, "\n     ", ee[0], " ", ee[1])
 	}
-	fmt.Println("  Incompatible events:")
+	return w.String()
+}
+
+func fail(items []*item.Item, incomatibleEvents []eventPair) {
+	err := &Error{Items: items}
 	for _, ee := range incomatibleEvents {
-		fmt.Println("    ", ee.a, " ", ee.b)
+		err.Events = append(err.Events, [2]ast.LexBase{ee.a, ee.b})
 	}
-	os.Exit(1)
+	panic(err)
 }
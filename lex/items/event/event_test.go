@@ -0,0 +1,43 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/goccmack/gogll/v3/ast"
+)
+
+func charRange(from, to rune) *ast.CharRange {
+	return &ast.CharRange{
+		From: ast.NewCharLiteral(nil, []rune{'\'', from, '\''}),
+		To:   ast.NewCharLiteral(nil, []rune{'\'', to, '\''}),
+	}
+}
+
+func TestRangeLess(t *testing.T) {
+	var (
+		empty = charRange('z', 'a')
+		ac    = charRange('a', 'c')
+		am    = charRange('a', 'm')
+		az    = charRange('a', 'z')
+		kz    = charRange('k', 'z')
+	)
+	for _, tst := range []struct {
+		a, b ast.LexBase
+		exp  bool
+	}{
+		{empty, empty, false},
+		{empty, ac, true},
+		{ac, empty, false},
+		{ac, ac, false},
+		{ac, az, true},
+		{az, ac, false},
+		{am, kz, true},
+		{kz, am, false},
+		{az, &ast.Any{}, true},
+		{&ast.Any{}, az, false},
+	} {
+		if got := rangeLess(tst.a, tst.b); got != tst.exp {
+			t.Errorf("rangeLess(%s, %s): expected %t, got %t", toRanges(tst.a), toRanges(tst.b), tst.exp, got)
+		}
+	}
+}
@@ -43,6 +43,10 @@ type Set struct {
 	No          int
 	set         []*item.Item
 	Transitions []*Transition
+
+	// Merged contains the numbers of the sets of the unminimised DFA that
+	// were merged into this set by Minimise.
+	Merged []int
 }
 
 type Sets struct {
@@ -54,10 +58,31 @@ type Transition struct {
 	To    *Set
 }
 
+// New returns the lexical item sets of g. New prints the error and exits if
+// g has incompatible lexer events.
 func New(g *ast.GoGLL) *Sets {
+	sets, err := TryNew(g)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	return sets
+}
+
+// TryNew is like New but returns an *event.Error instead of exiting.
+func TryNew(g *ast.GoGLL) (sets *Sets, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(*event.Error)
+			if !ok {
+				panic(r)
+			}
+			sets, err = nil, e
+		}
+	}()
 	s0 := set0(g)
 	s0.No = 0
-	sets := new(Sets).add(s0)
+	sets = new(Sets).add(s0)
 	i, changed := 0, true
 	for changed || i < sets.Len() {
 		// fmt.Printf("item.New: %d sets\n", len(sets.sets))
@@ -78,7 +103,7 @@ func New(g *ast.GoGLL) *Sets {
 		sort.Sort(s)
 	}
 	// fmt.Println("items.New: done")
-	return sets
+	return sets, nil
 }
 
 // Accept returns the token type accepted by the first reduce item in set
@@ -218,8 +243,14 @@ func set0(g *ast.GoGLL) *Set {
 	for _, rule := range g.LexRules {
 		s0.add(item.New(rule).Emoves()...)
 	}
+	keywords := stringset.New()
+	for _, kw := range Keywords(g) {
+		keywords.Add(kw.ID())
+	}
 	for _, sl := range g.StringLiterals {
-		s0.add(item.New(stringLitToRule(sl)))
+		if !keywords.Contain(sl.ID()) {
+			s0.add(item.New(stringLitToRule(sl)).Emoves()...)
+		}
 	}
 	return s0
 }
@@ -271,11 +302,17 @@ func stringLitToRegExp(sl *ast.StringLit) *ast.RegExp {
 func stringLitToLexSymbols(sl *ast.StringLit) (symbols []ast.LexSymbol) {
 	slit := sl.Literal()
 	for i := 1; i < len(slit)-1; i++ {
+		var cl *ast.CharLiteral
 		if slit[i] == '\\' {
-			symbols = append(symbols, ast.CharLitFromStringLit(sl, i, true))
-			i++
+			cl = ast.CharLitFromStringLit(sl, i, true)
+			i += ast.EscapeLen(slit[i:]) - 1
+		} else {
+			cl = ast.CharLitFromStringLit(sl, i, false)
+		}
+		if sl.CaseInsensitive {
+			symbols = append(symbols, ast.CaseInsensitive(cl))
 		} else {
-			symbols = append(symbols, ast.CharLitFromStringLit(sl, i, false))
+			symbols = append(symbols, cl)
 		}
 	}
 
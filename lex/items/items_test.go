@@ -7,6 +7,7 @@ import (
 	"github.com/goccmack/gogll/v3/ast"
 	"github.com/goccmack/gogll/v3/lexer"
 	"github.com/goccmack/gogll/v3/parser"
+	"github.com/goccmack/goutil/stringset"
 )
 
 const src = `package "names"
@@ -26,3 +27,97 @@ func Test1(t *testing.T) {
 
 	New(g)
 }
+
+const minSrc = `package "min"
+abc : ('a' 'b' 'c' | 'x' 'b' 'c' | 'y' 'b' 'c') ;
+`
+
+func TestMinimise(t *testing.T) {
+	lex := lexer.New([]rune(minSrc))
+	bsr, err := parser.Parse(lex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := ast.Build(bsr.GetRoot(), lex, "test.md")
+
+	sets := New(g)
+	min := sets.Minimise(g.GetStringLiteralsSet())
+	if sets.Len() != 8 || min.Len() != 4 {
+		t.Fatalf("expected 8 sets minimised to 4, got %d and %d", sets.Len(), min.Len())
+	}
+	for _, s := range min.Sets() {
+		for _, tr := range s.Transitions {
+			if min.Set(tr.To.No) != tr.To {
+				t.Errorf("S%d: transition %s to a set outside the minimised DFA", s.No, tr.Event)
+			}
+		}
+	}
+}
+
+func char(c rune) *ast.CharLiteral {
+	return ast.NewCharLiteral(nil, []rune{'\'', c, '\''})
+}
+
+// S1 and S2 are equivalent but list their transitions in a different order
+func TestMinimiseReordered(t *testing.T) {
+	sets := &Sets{}
+	for i := 0; i < 5; i++ {
+		sets.sets = append(sets.sets, &Set{No: i})
+	}
+	s := sets.sets
+	s[0].Transitions = []*Transition{{char('a'), s[1]}, {char('b'), s[2]}}
+	s[1].Transitions = []*Transition{{char('x'), s[3]}, {char('y'), s[4]}}
+	s[2].Transitions = []*Transition{{char('y'), s[4]}, {char('x'), s[3]}}
+
+	min := sets.Minimise(stringset.New())
+	if min.Len() != 3 {
+		t.Fatalf("expected 3 sets, got %d", min.Len())
+	}
+	for i, exp := range [][]int{{0}, {1, 2}, {3, 4}} {
+		if got := min.Set(i).Merged; fmt.Sprint(got) != fmt.Sprint(exp) {
+			t.Errorf("S%d: expected merged %v, got %v", i, exp, got)
+		}
+	}
+}
+
+func build(t *testing.T, src string) *ast.GoGLL {
+	lex := lexer.New([]rune(src))
+	bsr, err := parser.Parse(lex)
+	if err != nil {
+		t.Fatal(err[0])
+	}
+	return ast.Build(bsr.GetRoot(), lex, "test.md")
+}
+
+func TestKeywordTable(t *testing.T) {
+	g := build(t, `package "kw"
+@ident : letter {letter} ;
+S : "if" | i"select" ident | "from" ;
+`)
+	keywords := Keywords(g)
+	table, seed, err := KeywordTable(keywords)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keywords) != 3 || len(table) != 8 {
+		t.Fatalf("expected 3 keywords in a table of 8, got %d and %d", len(keywords), len(table))
+	}
+	for _, kw := range keywords {
+		if table[KeywordHash(kw.Key(), seed)&uint32(len(table)-1)] != kw {
+			t.Errorf("%s is not at its hash index", kw.ID())
+		}
+	}
+}
+
+// Keywords with the same key cannot be in a perfect hash table
+func TestKeywordTableDuplicateKeys(t *testing.T) {
+	g := build(t, `package "kw"
+@ident : letter {letter} ;
+S : i"Select" | i"select" ident ;
+`)
+	_, _, err := KeywordTable(Keywords(g))
+	exp := `keywords i"Select" and i"select" match the same identifiers`
+	if err == nil || err.Error() != exp {
+		t.Errorf("expected error %s, got %v", exp, err)
+	}
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2020 Marius Ackerman
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package items
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/lex/items/event"
+	"github.com/goccmack/goutil/stringset"
+)
+
+// Keyword is a string literal that is scanned by an identifier lex rule
+type Keyword struct {
+	*ast.StringLit
+	// Class is the identifier lex rule that scans the keyword
+	Class *ast.LexRule
+}
+
+/*
+Keywords returns the string literals of g that are matched by an identifier
+lex rule of g, sorted by ID. If more than one identifier rule matches a string
+literal it is a keyword of the first identifier rule. A case-insensitive
+string literal is only a keyword if its upper and lower case variants are
+matched by the identifier rule.
+
+The keywords are not added to the lexer DFA. The generated lexer looks them
+up in the keyword table after scanning an identifier.
+*/
+func Keywords(g *ast.GoGLL) (keywords []*Keyword) {
+	slits := g.GetStringLiterals()
+	isKeyword := stringset.New()
+	for _, rule := range g.LexRules {
+		if !rule.Identifier {
+			continue
+		}
+		sets := New(&ast.GoGLL{
+			LexRules: []*ast.LexRule{{TokID: rule.TokID, RegExp: rule.RegExp}},
+		})
+		for _, id := range slits {
+			if !isKeyword.Contain(id) && sets.matches(g.StringLiterals[id], rule) {
+				isKeyword.Add(id)
+				keywords = append(keywords,
+					&Keyword{StringLit: g.StringLiterals[id], Class: rule})
+			}
+		}
+	}
+	sort.Slice(keywords, func(i, j int) bool {
+		return keywords[i].ID() < keywords[j].ID()
+	})
+	return
+}
+
+// Key returns the key of kw in the keyword table. The key of a case-insensitive
+// keyword is its lower case literal.
+func (kw *Keyword) Key() string {
+	if kw.CaseInsensitive {
+		return strings.ToLower(kw.ID())
+	}
+	return kw.ID()
+}
+
+// literal returns kw as it is written in the grammar, e.g.: i"select"
+func (kw *Keyword) literal() string {
+	if kw.CaseInsensitive {
+		return fmt.Sprintf("i%q", kw.ID())
+	}
+	return fmt.Sprintf("%q", kw.ID())
+}
+
+/*
+KeywordTable returns a perfect hash table of keywords: the index of every
+keyword in table is KeywordHash(kw.Key(), seed) & (len(table)-1).
+Unused entries of table are nil. The size of table is a power of 2.
+
+Two keywords with the same key match the same identifiers, e.g.: i"Select"
+and i"select". KeywordTable returns an error if keywords has duplicate keys
+or if no seed gives a perfect hash table of at most maxTableSize entries.
+*/
+func KeywordTable(keywords []*Keyword) (table []*Keyword, seed uint32, err error) {
+	if len(keywords) == 0 {
+		return nil, 0, nil
+	}
+	keys := make(map[string]*Keyword, len(keywords))
+	for _, kw := range keywords {
+		if kw1, exist := keys[kw.Key()]; exist {
+			return nil, 0, fmt.Errorf("keywords %s and %s match the same identifiers",
+				kw1.literal(), kw.literal())
+		}
+		keys[kw.Key()] = kw
+	}
+	size := 1
+	for size < 2*len(keywords) {
+		size *= 2
+	}
+	for ; size <= maxTableSize; size *= 2 {
+		for seed = 0; seed < maxSeed; seed++ {
+			if table = keywordTable(keywords, size, seed); table != nil {
+				return table, seed, nil
+			}
+		}
+	}
+	return nil, 0, fmt.Errorf("no perfect hash table of %d keywords with at most %d entries",
+		len(keywords), maxTableSize)
+}
+
+const (
+	// maxTableSize is the maximum size of a keyword table
+	maxTableSize = 1 << 20
+	// maxSeed is the number of seeds tried for each table size
+	maxSeed = 1000
+)
+
+// KeywordHash is the FNV-1a hash of the bytes of s, starting with seed.
+// The generated lexers implement the same function.
+func KeywordHash(s string, seed uint32) uint32 {
+	h := 2166136261 ^ seed
+	for i := 0; i < len(s); i++ {
+		h ^= uint32(s[i])
+		h *= 16777619
+	}
+	return h
+}
+
+// keywordTable returns nil if two keywords have the same index
+func keywordTable(keywords []*Keyword, size int, seed uint32) []*Keyword {
+	table := make([]*Keyword, size)
+	for _, kw := range keywords {
+		i := KeywordHash(kw.Key(), seed) & uint32(size-1)
+		if table[i] != nil {
+			return nil
+		}
+		table[i] = kw
+	}
+	return table
+}
+
+// matches returns true iff the identifier rule matches sl
+func (sets *Sets) matches(sl *ast.StringLit, rule *ast.LexRule) bool {
+	variants := []string{sl.ID()}
+	if sl.CaseInsensitive {
+		variants = append(variants, strings.ToUpper(sl.ID()), strings.ToLower(sl.ID()))
+	}
+	for _, v := range variants {
+		if sets.scan([]rune(v)) != rule.ID() {
+			return false
+		}
+	}
+	return true
+}
+
+// scan returns the token type accepted by the DFA after scanning all of
+// input, or "Error".
+func (sets *Sets) scan(input []rune) string {
+	s := sets.sets[0]
+	for _, r := range input {
+		var next *Set
+		for _, t := range s.Transitions {
+			if event.Match(t.Event, r) {
+				next = t.To
+				break
+			}
+		}
+		if next == nil {
+			return "Error"
+		}
+		s = next
+	}
+	return s.Accept(stringset.New())
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2020 Marius Ackerman
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package items
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/goccmack/goutil/stringset"
+)
+
+/*
+Minimise returns a DFA equivalent to sets with the minimal number of states.
+It uses Hopcroft's partition refinement: the sets are initially partitioned by
+the token type they accept. Each block of the partition is then used as a
+splitter for each transition event: every block that contains sets with a
+transition on the event into the splitter and sets without one is split, until
+no splitter is left.
+
+The alphabet is the set of transition events of the DFA, compared by their
+string. A set without a transition on an event goes to an implicit dead state.
+GetOrdered orders the events of every set from the most to the least specific,
+so equivalent sets test their overlapping events in the same order and their
+transitions are compared regardless of the order in which they are listed.
+
+Each set of the minimised DFA keeps the items of the lowest numbered set of its
+partition and records the numbers of all the sets of the partition in Merged.
+S0 remains the start state.
+
+slits is the set of string literals from the AST
+*/
+func (sets *Sets) Minimise(slits *stringset.StringSet) *Sets {
+	return sets.fromPartition(sets.refine(sets.initialPartition(slits)))
+}
+
+// initialPartition returns the block number of each set, partitioned by the
+// token type accepted by the set.
+func (sets *Sets) initialPartition(slits *stringset.StringSet) []int {
+	keys := make([]string, len(sets.sets))
+	for i, s := range sets.sets {
+		keys[i] = s.Accept(slits)
+	}
+	return toPartition(keys)
+}
+
+// splitter is a block of the partition and the index of a transition event
+type splitter struct {
+	block, event int
+}
+
+/*
+refine returns the coarsest refinement of partition in which all the sets of a
+block have transitions on the same events into the same blocks.
+*/
+func (sets *Sets) refine(partition []int) []int {
+	// The dead state is number len(sets.sets), in its own block
+	dead := len(sets.sets)
+	events, inverse := sets.inverseTransitions()
+
+	block := append(append([]int{}, partition...), numPartitions(partition))
+	members := make([][]int, block[dead]+1)
+	for s, b := range block {
+		members[b] = append(members[b], s)
+	}
+
+	work, inWork := []splitter{}, map[splitter]bool{}
+	addWork := func(sp splitter) {
+		if !inWork[sp] {
+			inWork[sp] = true
+			work = append(work, sp)
+		}
+	}
+	for b := range members {
+		for e := range events {
+			addWork(splitter{b, e})
+		}
+	}
+
+	for len(work) > 0 {
+		sp := work[len(work)-1]
+		work = work[:len(work)-1]
+		delete(inWork, sp)
+
+		// from contains the sets with a transition on the event into the
+		// splitter, grouped by their block
+		from := map[int][]int{}
+		for _, to := range members[sp.block] {
+			for _, s := range inverse[sp.event][to] {
+				from[block[s]] = append(from[block[s]], s)
+			}
+		}
+		for _, b := range sortedKeys(from) {
+			split := from[b]
+			if len(split) == len(members[b]) {
+				continue
+			}
+			nb := len(members)
+			inSplit := map[int]bool{}
+			for _, s := range split {
+				inSplit[s] = true
+				block[s] = nb
+			}
+			rest := []int{}
+			for _, s := range members[b] {
+				if !inSplit[s] {
+					rest = append(rest, s)
+				}
+			}
+			members[b] = rest
+			members = append(members, split)
+			for e := range events {
+				if inWork[splitter{b, e}] || len(split) <= len(rest) {
+					addWork(splitter{nb, e})
+				} else {
+					addWork(splitter{b, e})
+				}
+			}
+		}
+	}
+
+	keys := make([]string, len(sets.sets))
+	for i := range keys {
+		keys[i] = fmt.Sprint(block[i])
+	}
+	return toPartition(keys)
+}
+
+/*
+inverseTransitions returns the transition events of sets and, for each event,
+the sets with a transition on the event into each set. The dead state,
+number len(sets.sets), is the target of the sets without a transition on the
+event and of itself.
+*/
+func (sets *Sets) inverseTransitions() (events []string, inverse []map[int][]int) {
+	dead := len(sets.sets)
+	eventNo := map[string]int{}
+	for _, s := range sets.sets {
+		for _, t := range s.Transitions {
+			if _, exist := eventNo[t.Event.String()]; !exist {
+				eventNo[t.Event.String()] = len(events)
+				events = append(events, t.Event.String())
+				inverse = append(inverse, map[int][]int{})
+			}
+		}
+	}
+	for e := range events {
+		inverse[e][dead] = []int{dead}
+	}
+	for _, s := range sets.sets {
+		has := make([]bool, len(events))
+		for _, t := range s.Transitions {
+			e := eventNo[t.Event.String()]
+			has[e] = true
+			inverse[e][t.To.No] = append(inverse[e][t.To.No], s.No)
+		}
+		for e := range events {
+			if !has[e] {
+				inverse[e][dead] = append(inverse[e][dead], s.No)
+			}
+		}
+	}
+	return
+}
+
+func sortedKeys(m map[int][]int) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+// fromPartition returns the DFA with one set for every block in partition
+func (sets *Sets) fromPartition(partition []int) *Sets {
+	min := &Sets{sets: make([]*Set, numPartitions(partition))}
+	for i, s := range sets.sets {
+		if min.sets[partition[i]] == nil {
+			min.sets[partition[i]] = &Set{
+				No:  partition[i],
+				set: s.set,
+			}
+		}
+		min.sets[partition[i]].Merged = append(min.sets[partition[i]].Merged, s.No)
+	}
+	for i, s := range sets.sets {
+		to := min.sets[partition[i]]
+		if to.Merged[0] != s.No {
+			continue
+		}
+		for _, t := range s.Transitions {
+			to.Transitions = append(to.Transitions,
+				&Transition{
+					Event: t.Event,
+					To:    min.sets[partition[t.To.No]],
+				})
+		}
+	}
+	return min
+}
+
+func numPartitions(partition []int) (num int) {
+	for _, b := range partition {
+		if b >= num {
+			num = b + 1
+		}
+	}
+	return
+}
+
+// toPartition numbers the blocks in order of the first set with each key,
+// which keeps S0 in block 0.
+func toPartition(keys []string) []int {
+	blocks := make(map[string]int)
+	partition := make([]int, len(keys))
+	for i, key := range keys {
+		b, exist := blocks[key]
+		if !exist {
+			b = len(blocks)
+			blocks[key] = b
+		}
+		partition[i] = b
+	}
+	return partition
+}
@@ -3,7 +3,8 @@
 package lexer
 
 import (
-	// "fmt"
+	"bytes"
+	"fmt"
 	"io/ioutil"
 	"strings"
 	"unicode"
@@ -113,8 +114,51 @@ type Lexer struct {
 
 	// Tokens is the slice of tokens constructed by the lexer from I
 	Tokens []*token.Token
+
+	// Errors contains the lexical errors in I in order of occurrence
+	Errors []*LexError
+
+	// trivia is true if the lexer attaches trivia to the tokens
+	trivia bool
+}
+
+// LexError is a lexical error: the lexer could not scan a token from the input.
+type LexError struct {
+	// Token is the Error token in Lexer.Tokens, which contains the input 
+	// skipped by the lexer.
+	Token *token.Token
+
+	// Pos is the position of the offending rune in the input. 
+	// If the lexer reached the end of the input Pos == len(input)
+	Pos int
+
+	// The line and column of the offending rune in the input
+	Line, Column int
+
+	// Rune is the offending rune. It is -1 at the end of the input.
+	Rune rune
+
+	// Partial contains the token types that were partially matched when
+	// the error occurred. It is empty if no token matches the first rune.
+	Partial []token.Type
 }
 
+// Recovery is the strategy used by the lexer to continue after a lexical error
+type Recovery int
+
+const (
+	// SkipRune continues scanning at the rune after the offending rune
+	SkipRune Recovery = iota
+
+	// SkipToSpace continues scanning at the first whitespace after the
+	// offending rune
+	SkipToSpace
+)
+
+// ErrorRecovery is the error recovery strategy of the lexers created 
+// after it is set. The default is SkipRune.
+var ErrorRecovery = SkipRune
+
 /*
 NewFile constructs a Lexer created from the input file, fname. 
 
@@ -125,6 +169,16 @@ If the input file is a normal text file NewFile treats all text in the inputfile
 as input text.
 */
 func NewFile(fname string) *Lexer {
+	return New(readFile(fname))
+}
+
+// NewFileWithTrivia is like NewFile but attaches trivia to the tokens, 
+// like NewWithTrivia.
+func NewFileWithTrivia(fname string) *Lexer {
+	return NewWithTrivia(readFile(fname))
+}
+
+func readFile(fname string) []rune {
 	buf, err := ioutil.ReadFile(fname)
 	if err != nil {
 		panic(err)
@@ -133,7 +187,7 @@ func NewFile(fname string) *Lexer {
 	if strings.HasSuffix(fname, ".md") {
 		loadMd(input)
 	}
-	return New(input)
+	return input
 }
 
 func loadMd(input []rune) {
@@ -170,21 +224,123 @@ func New(input []rune) *Lexer {
 		I:      input,
 		Tokens: make([]*token.Token, 0, 2048),
 	}
-	lext := 0
-	for lext < len(lex.I) {
-		for lext < len(lex.I) && unicode.IsSpace(lex.I[lext]) {
+	lex.Tokens = append(lex.Tokens, lex.scanTokens(0, nil, nil)...)
+	return lex
+}
+
+/*
+NewWithTrivia constructs a Lexer from a slice of runes, like New, but keeps
+the whitespace and suppressed tokens between the tokens as trivia attached
+to the neighbouring tokens.
+
+The trailing trivia of a token extends up to and including the end of its line.
+The rest of the trivia between two tokens is the leading trivia of the second
+token. Trivia at the end of the input is the leading trivia of the EOF token.
+The input can be reproduced by concatenating Token.FullLiteral() of all the
+tokens.
+*/
+func NewWithTrivia(input []rune) *Lexer {
+	lex := &Lexer{
+		I:      input,
+		Tokens: make([]*token.Token, 0, 2048),
+		trivia: true,
+	}
+	lex.Tokens = append(lex.Tokens, lex.scanTokens(0, nil, nil)...)
+	return lex
+}
+
+/*
+scanTokens scans the tokens from position lext in the input up to and 
+including the EOF token, or the first token for which stop returns true.
+prev is the token before lext. Its trailing trivia is set if l keeps trivia.
+*/
+func (l *Lexer) scanTokens(lext int, prev *token.Token, stop func(*token.Token) bool) (toks []*token.Token) {
+	for {
+		var tok *token.Token
+		if l.trivia {
+			tok = l.scanWithTrivia(lext, prev)
+		} else {
+			tok = l.scanToken(lext)
+		}
+		toks = append(toks, tok)
+		if tok.Type() == token.EOF || (stop != nil && stop(tok)) {
+			return
+		}
+		lext, prev = tok.Rext(), tok
+	}
+}
+
+// scanToken returns the first token after position lext in the input that
+// is not suppressed.
+func (l *Lexer) scanToken(lext int) *token.Token {
+	for {
+		for lext < len(l.I) && unicode.IsSpace(l.I[lext]) {
+			lext++
+		}
+		if lext >= len(l.I) {
+			return token.New(token.EOF, len(l.I), len(l.I), l.I)
+		}
+		tok := l.scan(lext)
+		if tok.Type() == token.Error {
+			tok = l.lexError(tok)
+		}
+		if !tok.Suppress() {
+			return tok
+		}
+		lext = tok.Rext()
+	}
+}
+
+// scanWithTrivia returns the first token after position lext in the input
+// that is not suppressed. It attaches the trivia from lext to the token 
+// to prev and the token.
+func (l *Lexer) scanWithTrivia(lext int, prev *token.Token) *token.Token {
+	triviaLext, suppressed := lext, []*token.Token{}
+	var tok *token.Token
+	for tok == nil {
+		for lext < len(l.I) && unicode.IsSpace(l.I[lext]) {
 			lext++
 		}
-		if lext < len(lex.I) {
-			tok := lex.scan(lext)
-			lext = tok.Rext()
-			if !tok.Suppress() {
-				lex.addToken(tok)
+		if lext >= len(l.I) {
+			tok = token.New(token.EOF, len(l.I), len(l.I), l.I)
+		} else if t := l.scan(lext); t.Suppress() {
+			suppressed = append(suppressed, t)
+			lext = t.Rext()
+		} else {
+			if t.Type() == token.Error {
+				t = l.lexError(t)
 			}
+			tok = t
 		}
 	}
-	lex.add(token.EOF, len(input), len(input))
-	return lex
+	split, numTrailing := triviaLext, 0
+	if prev != nil {
+		split = l.endOfLine(triviaLext, tok.Lext(), suppressed)
+		for numTrailing < len(suppressed) && suppressed[numTrailing].Lext() < split {
+			numTrailing++
+		}
+		prev.SetTrailingTrivia(split, suppressed[:numTrailing])
+	}
+	tok.SetLeadingTrivia(split, suppressed[numTrailing:])
+	return tok
+}
+
+// endOfLine returns the position after the first newline in the trivia from
+// lext to rext, that is not part of a suppressed token. It returns rext if
+// there is no such newline.
+func (l *Lexer) endOfLine(lext, rext int, suppressed []*token.Token) int {
+	for i, j := lext, 0; i < rext; {
+		if j < len(suppressed) && suppressed[j].Lext() == i {
+			i = suppressed[j].Rext()
+			j++
+			continue
+		}
+		if l.I[i] == '\n' {
+			return i + 1
+		}
+		i++
+	}
+	return rext
 }
 
 func (l *Lexer) scan(i int) *token.Token {
@@ -211,6 +367,187 @@ func (l *Lexer) scan(i int) *token.Token {
 	return tok
 }
 
+/*
+Edit describes how an edit of the input by Lexer.Edit changed the tokens.
+
+Tokens[First:First+NumOld] before the edit were replaced by the re-lexed
+Tokens[First:First+NumNew]. The index of every token after them changed by
+NumNew-NumOld.
+*/
+type Edit struct {
+	// The edit of the input: Deleted runes at Offset were replaced by 
+	// Inserted runes.
+	Offset, Deleted, Inserted int
+
+	// The re-lexed window of tokens
+	First, NumOld, NumNew int
+}
+
+/*
+Edit replaces the deleted runes at offset in the input with inserted and 
+re-lexes only the tokens damaged by the edit. The tokens after the damaged 
+window are moved and their indices shifted. Lexical errors are updated.
+*/
+func (l *Lexer) Edit(offset, deleted int, inserted []rune) *Edit {
+	input := make([]rune, 0, len(l.I)-deleted+len(inserted))
+	input = append(input, l.I[:offset]...)
+	input = append(input, inserted...)
+	input = append(input, l.I[offset+deleted:]...)
+	delta := len(inserted) - deleted
+
+	// The first damaged token is the first token that ends at or after offset,
+	// because the lexer reads the rune after a token to find its end.
+	first := 0
+	for first < len(l.Tokens)-1 && l.Tokens[first].Rext() < offset {
+		first++
+	}
+
+	old, oldErrors := l.Tokens, l.Errors
+	l.I, l.Errors = input, nil
+	l.Tokens = make([]*token.Token, 0, len(old)+len(inserted))
+	for _, tok := range old[:first] {
+		l.Tokens = append(l.Tokens, tok.Shift(0, input))
+	}
+
+	lext, prev := 0, (*token.Token)(nil)
+	if first > 0 {
+		prev = l.Tokens[first-1]
+		lext = prev.Rext()
+	}
+	// Re-lex until a token is the same as an old token after the edit.
+	j := first
+	relexed := l.scanTokens(lext, prev, func(tok *token.Token) bool {
+		for j < len(old) && 
+			(old[j].Lext() < offset+deleted || old[j].Lext()+delta < tok.Lext()) {
+			j++
+		}
+		return j < len(old) && old[j].Type() == tok.Type() &&
+			old[j].Lext()+delta == tok.Lext() && old[j].Rext()+delta == tok.Rext()
+	})
+	numOld := len(old) - first
+	if last := relexed[len(relexed)-1]; last.Type() != token.EOF {
+		numOld = j - first + 1
+		if l.trivia {
+			tok := old[j].Shift(delta, input)
+			last.SetTrailingTrivia(tok.Rext()+len(tok.TrailingTrivia()), tok.TrailingSuppressed())
+		}
+	}
+	l.Tokens = append(l.Tokens, relexed...)
+	for _, tok := range old[first+numOld:] {
+		l.Tokens = append(l.Tokens, tok.Shift(delta, input))
+	}
+
+	l.updateErrors(old, oldErrors, first, numOld, len(relexed), delta)
+
+	return &Edit{
+		Offset:   offset,
+		Deleted:  deleted,
+		Inserted: len(inserted),
+		First:    first,
+		NumOld:   numOld,
+		NumNew:   len(relexed),
+	}
+}
+
+// updateErrors moves the lexical errors, oldErrors, before and after the 
+// re-lexed tokens to the new tokens. l.Errors contains the errors of the
+// re-lexed tokens.
+func (l *Lexer) updateErrors(old []*token.Token, oldErrors []*LexError, 
+	first, numOld, numNew, delta int) {
+
+	index := make(map[*token.Token]int, len(oldErrors))
+	for i, tok := range old {
+		index[tok] = i
+	}
+	var before, after []*LexError
+	for _, err := range oldErrors {
+		err1 := *err
+		switch i := index[err.Token]; {
+		case i < first:
+			err1.Token = l.Tokens[i]
+			before = append(before, &err1)
+		case i >= first+numOld:
+			err1.Token = l.Tokens[i+numNew-numOld]
+			err1.Pos += delta
+			err1.Line, err1.Column = l.GetLineColumn(err1.Pos)
+			after = append(after, &err1)
+		}
+	}
+	l.Errors = append(append(before, l.Errors...), after...)
+}
+
+/*
+Unchanged returns the token extents before the edit, e, of the tokens from 
+lext to rext after the edit. ok is false if the tokens from lext up to and 
+including rext overlap the re-lexed tokens. 
+
+A subtree of the parse forest with unchanged extents is not changed by the edit.
+*/
+func (e *Edit) Unchanged(lext, rext int) (oldLext, oldRext int, ok bool) {
+	switch {
+	case rext < e.First:
+		return lext, rext, true
+	case lext >= e.First+e.NumNew:
+		return lext - e.NumNew + e.NumOld, rext - e.NumNew + e.NumOld, true
+	}
+	return 0, 0, false
+}
+
+/*
+lexError records the LexError for the Error token, tok, and returns the Error
+token extended by the error recovery strategy, ErrorRecovery.
+*/
+func (l *Lexer) lexError(tok *token.Token) *token.Token {
+	s, pos := state(0), tok.Lext()
+	for pos < len(l.I) {
+		next := nextState[s](l.I[pos])
+		if next == nullState {
+			break
+		}
+		s, pos = next, pos+1
+	}
+	if ErrorRecovery == SkipToSpace {
+		rext := tok.Rext()
+		for rext < len(l.I) && !unicode.IsSpace(l.I[rext]) {
+			rext++
+		}
+		tok = token.New(token.Error, tok.Lext(), rext, l.I)
+	}
+	err := &LexError{
+		Token:   tok,
+		Pos:     pos,
+		Rune:    -1,
+		Partial: partial[s],
+	}
+	if pos < len(l.I) {
+		err.Rune = l.I[pos]
+	}
+	err.Line, err.Column = l.GetLineColumn(pos)
+	l.Errors = append(l.Errors, err)
+	return tok
+}
+
+func (e *LexError) Error() string {
+	w := new(bytes.Buffer)
+	fmt.Fprintf(w, "Lexical error at line %d col %d: ", e.Line, e.Column)
+	if e.Rune == -1 {
+		fmt.Fprint(w, "unexpected end of input")
+	} else {
+		fmt.Fprintf(w, "unexpected %q", e.Rune)
+	}
+	if len(e.Partial) > 0 {
+		fmt.Fprint(w, " while scanning one of [")
+		for i, t := range e.Partial {
+			if i > 0 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprint(w, t.ID())
+		}
+		fmt.Fprint(w, "]")
+	}
+	return w.String()
+}
+
 func escape(r rune) string {
 	switch r {
 	case '"':
@@ -285,8 +622,8 @@ var accept = []token.Type{
 	token.Error, 
 	token.T_0, 
 	token.Error, 
+	token.T_1, 
 	token.Error, 
-	token.T_2, 
 	token.T_3, 
 	token.T_4, 
 	token.T_5, 
@@ -295,60 +632,75 @@ var accept = []token.Type{
 	token.T_8, 
 	token.T_9, 
 	token.T_10, 
+	token.T_11, 
+	token.T_12, 
+	token.T_13, 
 	token.Error, 
-	token.T_93, 
+	token.T_96, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_107, 
-	token.T_108, 
-	token.T_109, 
-	token.T_101, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_1, 
 	token.Error, 
+	token.T_118, 
+	token.T_119, 
+	token.T_120, 
+	token.T_108, 
 	token.Error, 
 	token.Error, 
-	token.T_94, 
-	token.T_105, 
-	token.T_105, 
-	token.T_105, 
-	token.T_105, 
-	token.T_105, 
-	token.T_105, 
-	token.T_105, 
-	token.T_105, 
-	token.T_105, 
-	token.T_104, 
-	token.T_96, 
-	token.T_96, 
 	token.Error, 
-	token.T_95, 
-	token.T_105, 
-	token.T_105, 
-	token.T_105, 
-	token.T_100, 
-	token.T_105, 
-	token.T_105, 
-	token.T_105, 
+	token.T_2, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_97, 
+	token.T_116, 
+	token.T_116, 
+	token.T_99, 
+	token.T_116, 
+	token.T_116, 
 	token.Error, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_115, 
+	token.T_101, 
+	token.T_101, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_101, 
 	token.Error, 
+	token.T_98, 
+	token.T_116, 
+	token.T_116, 
 	token.Error, 
 	token.Error, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_107, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -357,19 +709,12 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_105, 
-	token.T_105, 
-	token.T_105, 
-	token.T_105, 
-	token.T_105, 
-	token.T_105, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_17, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -385,29 +730,40 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_37, 
+	token.T_116, 
+	token.T_116, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_104, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
 	token.Error, 
-	token.T_42, 
+	token.T_113, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_113, 
 	token.Error, 
-	token.T_48, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_20, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_69, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -418,30 +774,21 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_40, 
 	token.Error, 
-	token.T_82, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_45, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_51, 
 	token.Error, 
 	token.Error, 
-	token.T_92, 
-	token.T_97, 
-	token.T_105, 
-	token.T_105, 
-	token.T_105, 
-	token.T_105, 
-	token.T_105, 
 	token.Error, 
 	token.Error, 
-	token.T_13, 
-	token.T_14, 
-	token.T_15, 
-	token.T_16, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -449,66 +796,54 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_72, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_30, 
-	token.T_31, 
 	token.Error, 
 	token.Error, 
-	token.T_34, 
-	token.T_35, 
-	token.T_36, 
 	token.Error, 
-	token.T_39, 
-	token.T_40, 
-	token.T_41, 
-	token.T_43, 
-	token.T_44, 
 	token.Error, 
-	token.T_46, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_60, 
-	token.T_61, 
-	token.T_62, 
-	token.T_63, 
-	token.T_64, 
-	token.T_65, 
+	token.T_85, 
 	token.Error, 
-	token.T_67, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_74, 
 	token.Error, 
-	token.T_76, 
-	token.T_77, 
 	token.Error, 
-	token.T_79, 
 	token.Error, 
+	token.T_95, 
+	token.T_116, 
+	token.T_102, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_116, 
+	token.T_114, 
+	token.T_116, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_89, 
-	token.T_90, 
-	token.T_91, 
-	token.T_98, 
-	token.T_105, 
-	token.T_102, 
-	token.T_105, 
-	token.T_106, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_16, 
+	token.T_17, 
+	token.T_18, 
+	token.T_19, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -520,19 +855,43 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_33, 
+	token.T_34, 
+	token.Error, 
+	token.Error, 
+	token.T_37, 
+	token.T_38, 
+	token.T_39, 
 	token.Error, 
+	token.T_42, 
+	token.T_43, 
+	token.T_44, 
+	token.T_46, 
+	token.T_47, 
 	token.Error, 
+	token.T_49, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_63, 
+	token.T_64, 
+	token.T_65, 
+	token.T_66, 
+	token.T_67, 
+	token.T_68, 
 	token.Error, 
+	token.T_70, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_77, 
 	token.Error, 
+	token.T_79, 
+	token.T_80, 
 	token.Error, 
+	token.T_82, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -541,12 +900,21 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_92, 
+	token.T_93, 
+	token.T_94, 
+	token.T_116, 
 	token.Error, 
-	token.T_99, 
 	token.T_103, 
+	token.T_105, 
+	token.T_116, 
+	token.T_109, 
+	token.T_116, 
+	token.T_111, 
+	token.T_112, 
+	token.T_117, 
 	token.Error, 
 	token.Error, 
-	token.T_18, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -560,7 +928,6 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_38, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -583,6 +950,11 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_116, 
+	token.Error, 
+	token.T_106, 
+	token.T_110, 
+	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.T_21, 
@@ -595,26 +967,20 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_33, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_57, 
 	token.Error, 
+	token.T_41, 
 	token.Error, 
-	token.T_68, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_73, 
 	token.Error, 
 	token.Error, 
-	token.T_80, 
 	token.Error, 
 	token.Error, 
-	token.T_84, 
 	token.Error, 
-	token.T_86, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -623,16 +989,15 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_24, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_116, 
 	token.Error, 
-	token.T_29, 
 	token.Error, 
 	token.Error, 
-	token.T_47, 
 	token.Error, 
+	token.T_24, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -642,38 +1007,47 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_36, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_60, 
 	token.Error, 
-	token.T_81, 
 	token.Error, 
+	token.T_71, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_76, 
 	token.Error, 
 	token.Error, 
+	token.T_83, 
 	token.Error, 
 	token.Error, 
+	token.T_87, 
 	token.Error, 
+	token.T_89, 
 	token.Error, 
 	token.Error, 
+	token.T_116, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_27, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_32, 
 	token.Error, 
 	token.Error, 
+	token.T_50, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_71, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -685,11 +1059,12 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_22, 
+	token.T_84, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_116, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -712,19 +1087,20 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_74, 
 	token.Error, 
 	token.Error, 
-	token.T_20, 
-	token.T_23, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_116, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_25, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -743,7 +1119,6 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_19, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -751,12 +1126,14 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_116, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_23, 
+	token.T_26, 
 	token.Error, 
 	token.Error, 
-	token.T_55, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -773,7 +1150,6 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_27, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -781,8 +1157,10 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_116, 
 	token.Error, 
 	token.Error, 
+	token.T_22, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -790,16 +1168,13 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_78, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_88, 
 	token.Error, 
-	token.T_12, 
 	token.Error, 
+	token.T_58, 
 	token.Error, 
-	token.T_28, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -811,10 +1186,12 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_116, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_30, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -831,10 +1208,36 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_81, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_91, 
+	token.T_116, 
 	token.Error, 
+	token.T_15, 
+	token.Error, 
+	token.Error, 
+	token.T_31, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.T_100, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -848,19 +1251,15 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_53, 
 	token.Error, 
 	token.Error, 
-	token.T_58, 
 	token.Error, 
 	token.Error, 
-	token.T_70, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_11, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -869,25 +1268,29 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_54, 
 	token.T_56, 
 	token.Error, 
 	token.Error, 
+	token.T_61, 
+	token.Error, 
 	token.Error, 
+	token.T_73, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_14, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_49, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_57, 
+	token.T_59, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -903,9 +1306,7 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_75, 
 	token.Error, 
-	token.T_85, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
@@ -915,54 +1316,840 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_72, 
 	token.Error, 
-	token.T_87, 
-	token.T_25, 
 	token.Error, 
 	token.Error, 
+	token.T_55, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_59, 
+	token.T_78, 
 	token.Error, 
+	token.T_88, 
 	token.Error, 
-	token.T_26, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_83, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_51, 
+	token.T_75, 
+	token.Error, 
+	token.T_90, 
+	token.T_28, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_32, 
-	token.T_45, 
+	token.T_62, 
 	token.Error, 
 	token.Error, 
+	token.T_29, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_86, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_54, 
 	token.Error, 
-	token.T_66, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
 	token.Error, 
+	token.T_35, 
+	token.T_48, 
 	token.Error, 
-	token.T_50, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.T_69, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.Error, 
+	token.T_53, 
+}
+
+var partial = [][]token.Type{ 
+	{ }, 
+	{ }, 
+	{ token.T_115, }, 
+	{ }, 
+	{ token.T_2, token.T_101, token.T_113, }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ token.T_14, token.T_15, token.T_16, token.T_17, token.T_18, token.T_19, token.T_20, token.T_21, token.T_22, token.T_23, token.T_24, token.T_25, token.T_26, token.T_27, token.T_28, token.T_29, token.T_30, token.T_31, token.T_32, token.T_33, token.T_34, token.T_35, token.T_36, token.T_37, token.T_38, token.T_39, token.T_40, token.T_41, token.T_42, token.T_43, token.T_44, token.T_45, token.T_46, token.T_47, token.T_48, token.T_49, token.T_50, token.T_51, token.T_52, token.T_53, token.T_54, token.T_55, token.T_56, token.T_57, token.T_58, token.T_59, token.T_60, token.T_61, token.T_62, token.T_63, token.T_64, token.T_65, token.T_66, token.T_67, token.T_68, token.T_69, token.T_70, token.T_71, token.T_72, token.T_73, token.T_74, token.T_75, token.T_76, token.T_77, token.T_78, token.T_79, token.T_80, token.T_81, token.T_82, token.T_83, token.T_84, token.T_85, token.T_86, token.T_87, token.T_88, token.T_89, token.T_90, token.T_91, token.T_92, token.T_93, token.T_94, token.T_95, }, 
+	{ token.T_97, }, 
+	{ token.T_98, token.T_99, token.T_116, }, 
+	{ token.T_100, token.T_116, }, 
+	{ token.T_102, token.T_116, }, 
+	{ token.T_103, token.T_104, token.T_116, }, 
+	{ token.T_105, token.T_106, token.T_116, }, 
+	{ token.T_107, token.T_109, token.T_116, }, 
+	{ token.T_110, token.T_111, token.T_116, }, 
+	{ token.T_112, token.T_116, }, 
+	{ token.T_114, token.T_116, }, 
+	{ token.T_116, token.T_117, }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ token.T_108, }, 
+	{ token.T_116, }, 
+	{ token.T_115, }, 
+	{ token.T_115, }, 
+	{ token.T_101, token.T_113, }, 
+	{ token.T_101, }, 
+	{ token.T_101, }, 
+	{ token.T_14, token.T_15, token.T_16, token.T_17, token.T_18, token.T_19, token.T_20, token.T_21, token.T_22, token.T_23, token.T_24, token.T_25, token.T_26, token.T_27, token.T_28, token.T_29, token.T_30, token.T_31, token.T_32, token.T_33, token.T_34, token.T_35, token.T_36, token.T_37, token.T_38, token.T_39, token.T_40, token.T_41, token.T_42, token.T_43, token.T_44, token.T_45, token.T_46, token.T_47, token.T_48, token.T_49, token.T_50, token.T_51, token.T_52, token.T_53, token.T_54, token.T_55, token.T_56, token.T_57, token.T_58, token.T_59, token.T_60, token.T_61, token.T_62, token.T_63, token.T_64, token.T_65, token.T_66, token.T_67, token.T_68, token.T_69, token.T_70, token.T_71, token.T_72, token.T_73, token.T_74, token.T_75, token.T_76, token.T_77, token.T_78, token.T_79, token.T_80, token.T_81, token.T_82, token.T_83, token.T_84, token.T_85, token.T_86, token.T_87, token.T_88, token.T_89, token.T_90, token.T_91, token.T_92, token.T_93, token.T_94, token.T_95, }, 
+	{ }, 
+	{ token.T_116, }, 
+	{ token.T_98, token.T_116, }, 
+	{ token.T_116, }, 
+	{ token.T_100, token.T_116, }, 
+	{ token.T_102, token.T_116, }, 
+	{ token.T_104, }, 
+	{ token.T_103, token.T_116, }, 
+	{ token.T_105, token.T_116, }, 
+	{ token.T_106, token.T_116, }, 
+	{ token.T_107, token.T_116, }, 
+	{ token.T_109, token.T_116, }, 
+	{ token.T_110, token.T_116, }, 
+	{ token.T_111, token.T_116, }, 
+	{ token.T_112, token.T_116, }, 
+	{ token.T_114, token.T_116, }, 
+	{ token.T_116, token.T_117, }, 
+	{ token.T_115, }, 
+	{ token.T_115, }, 
+	{ token.T_115, }, 
+	{ }, 
+	{ token.T_113, }, 
+	{ token.T_101, }, 
+	{ token.T_101, }, 
+	{ token.T_101, }, 
+	{ token.T_101, }, 
+	{ }, 
+	{ token.T_14, token.T_15, token.T_16, token.T_17, token.T_18, token.T_19, token.T_20, token.T_21, token.T_22, token.T_23, token.T_24, token.T_25, token.T_26, token.T_27, token.T_28, token.T_29, token.T_30, token.T_31, token.T_32, token.T_33, token.T_34, token.T_35, token.T_36, token.T_37, token.T_38, token.T_39, token.T_40, token.T_41, token.T_42, token.T_43, token.T_44, token.T_45, token.T_46, token.T_47, token.T_48, token.T_49, token.T_50, token.T_51, token.T_52, token.T_53, token.T_54, token.T_55, token.T_56, token.T_57, token.T_58, token.T_59, token.T_60, token.T_61, token.T_62, token.T_63, token.T_64, token.T_65, token.T_66, token.T_67, token.T_68, token.T_69, token.T_70, token.T_71, token.T_72, token.T_73, token.T_74, token.T_75, token.T_76, token.T_77, token.T_78, token.T_79, token.T_80, token.T_81, token.T_82, token.T_83, token.T_84, token.T_85, token.T_86, token.T_87, token.T_88, token.T_89, token.T_90, token.T_91, token.T_92, token.T_93, token.T_94, token.T_95, }, 
+	{ token.T_116, }, 
+	{ token.T_100, token.T_116, }, 
+	{ token.T_102, token.T_116, }, 
+	{ token.T_104, }, 
+	{ token.T_104, }, 
+	{ token.T_103, token.T_116, }, 
+	{ token.T_105, token.T_116, }, 
+	{ token.T_106, token.T_116, }, 
+	{ token.T_116, }, 
+	{ token.T_109, token.T_116, }, 
+	{ token.T_110, token.T_116, }, 
+	{ token.T_111, token.T_116, }, 
+	{ token.T_112, token.T_116, }, 
+	{ token.T_114, token.T_116, }, 
+	{ token.T_116, token.T_117, }, 
+	{ token.T_115, }, 
+	{ token.T_115, }, 
+	{ token.T_115, }, 
+	{ token.T_113, }, 
+	{ token.T_113, }, 
+	{ token.T_101, }, 
+	{ token.T_101, }, 
+	{ token.T_101, }, 
+	{ token.T_14, }, 
+	{ token.T_15, }, 
+	{ token.T_16, token.T_17, token.T_18, token.T_19, token.T_20, }, 
+	{ token.T_21, token.T_22, token.T_23, token.T_24, }, 
+	{ token.T_25, }, 
+	{ token.T_26, token.T_27, }, 
+	{ token.T_28, token.T_29, token.T_30, }, 
+	{ token.T_31, }, 
+	{ token.T_32, token.T_33, token.T_34, token.T_35, token.T_36, token.T_37, token.T_38, token.T_39, token.T_40, }, 
+	{ token.T_41, token.T_42, token.T_43, token.T_44, token.T_45, }, 
+	{ token.T_46, token.T_47, token.T_48, token.T_49, token.T_50, token.T_51, }, 
+	{ token.T_52, token.T_53, token.T_54, token.T_55, token.T_56, token.T_57, token.T_58, token.T_59, token.T_60, }, 
+	{ token.T_61, token.T_62, token.T_63, token.T_64, token.T_65, token.T_66, token.T_67, token.T_68, token.T_69, token.T_70, token.T_71, token.T_72, }, 
+	{ token.T_73, }, 
+	{ token.T_74, token.T_75, }, 
+	{ token.T_76, token.T_77, token.T_78, token.T_79, token.T_80, token.T_81, token.T_82, token.T_83, token.T_84, token.T_85, }, 
+	{ token.T_86, token.T_87, }, 
+	{ token.T_88, token.T_89, }, 
+	{ token.T_90, }, 
+	{ token.T_91, }, 
+	{ token.T_92, token.T_93, token.T_94, token.T_95, }, 
+	{ token.T_100, token.T_116, }, 
+	{ token.T_102, token.T_116, }, 
+	{ token.T_104, }, 
+	{ token.T_104, }, 
+	{ token.T_104, }, 
+	{ }, 
+	{ token.T_103, token.T_116, }, 
+	{ token.T_105, token.T_116, }, 
+	{ token.T_106, token.T_116, }, 
+	{ token.T_109, token.T_116, }, 
+	{ token.T_110, token.T_116, }, 
+	{ token.T_111, token.T_116, }, 
+	{ token.T_112, token.T_116, }, 
+	{ token.T_114, token.T_116, }, 
+	{ token.T_116, token.T_117, }, 
+	{ token.T_115, }, 
+	{ token.T_113, }, 
+	{ token.T_113, }, 
+	{ token.T_113, }, 
+	{ token.T_113, }, 
+	{ }, 
+	{ token.T_101, }, 
+	{ token.T_14, }, 
+	{ token.T_15, }, 
+	{ token.T_16, }, 
+	{ token.T_17, }, 
+	{ token.T_18, }, 
+	{ token.T_19, }, 
+	{ }, 
+	{ token.T_21, }, 
+	{ token.T_22, }, 
+	{ token.T_23, token.T_24, }, 
+	{ token.T_25, }, 
+	{ token.T_26, }, 
+	{ token.T_27, }, 
+	{ token.T_28, token.T_29, }, 
+	{ token.T_30, }, 
+	{ token.T_31, }, 
+	{ token.T_32, }, 
+	{ token.T_33, }, 
+	{ token.T_34, }, 
+	{ token.T_35, token.T_36, token.T_37, }, 
+	{ token.T_38, }, 
+	{ token.T_39, }, 
+	{ }, 
+	{ token.T_41, }, 
+	{ token.T_42, }, 
+	{ token.T_43, }, 
+	{ token.T_44, }, 
+	{ }, 
+	{ token.T_46, }, 
+	{ token.T_47, }, 
+	{ token.T_48, token.T_49, }, 
+	{ token.T_50, }, 
+	{ }, 
+	{ token.T_52, token.T_53, token.T_54, token.T_55, token.T_56, token.T_57, token.T_58, token.T_59, token.T_60, }, 
+	{ token.T_61, token.T_62, }, 
+	{ token.T_63, }, 
+	{ token.T_64, }, 
+	{ token.T_65, }, 
+	{ token.T_66, }, 
+	{ token.T_67, }, 
+	{ token.T_68, }, 
+	{ token.T_69, }, 
+	{ token.T_70, }, 
+	{ token.T_71, }, 
+	{ }, 
+	{ token.T_73, }, 
+	{ token.T_74, }, 
+	{ token.T_75, }, 
+	{ token.T_76, }, 
+	{ token.T_77, }, 
+	{ token.T_78, }, 
+	{ token.T_79, }, 
+	{ token.T_80, }, 
+	{ token.T_81, token.T_82, }, 
+	{ token.T_83, }, 
+	{ token.T_84, }, 
+	{ }, 
+	{ token.T_86, }, 
+	{ token.T_87, }, 
+	{ token.T_88, }, 
+	{ token.T_89, }, 
+	{ token.T_90, }, 
+	{ token.T_91, }, 
+	{ token.T_92, }, 
+	{ token.T_93, }, 
+	{ token.T_94, }, 
+	{ }, 
+	{ token.T_100, token.T_116, }, 
+	{ token.T_116, }, 
+	{ token.T_104, }, 
+	{ token.T_104, }, 
+	{ token.T_104, }, 
+	{ token.T_103, token.T_116, }, 
+	{ token.T_105, token.T_116, }, 
+	{ token.T_106, token.T_116, }, 
+	{ token.T_109, token.T_116, }, 
+	{ token.T_110, token.T_116, }, 
+	{ token.T_111, token.T_116, }, 
+	{ token.T_112, token.T_116, }, 
+	{ token.T_116, }, 
+	{ token.T_116, token.T_117, }, 
+	{ token.T_115, }, 
+	{ token.T_113, }, 
+	{ token.T_113, }, 
+	{ token.T_113, }, 
+	{ token.T_101, }, 
+	{ token.T_14, }, 
+	{ token.T_15, }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ token.T_21, }, 
+	{ token.T_22, }, 
+	{ token.T_23, }, 
+	{ token.T_24, }, 
+	{ token.T_25, }, 
+	{ token.T_26, }, 
+	{ token.T_27, }, 
+	{ token.T_28, token.T_29, }, 
+	{ token.T_30, }, 
+	{ token.T_31, }, 
+	{ token.T_32, }, 
+	{ }, 
+	{ }, 
+	{ token.T_35, }, 
+	{ token.T_36, }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ token.T_41, }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ token.T_48, }, 
+	{ }, 
+	{ token.T_50, }, 
+	{ token.T_52, token.T_53, token.T_54, token.T_55, token.T_56, token.T_57, token.T_58, token.T_59, token.T_60, }, 
+	{ token.T_61, token.T_62, }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ token.T_69, }, 
+	{ }, 
+	{ token.T_71, }, 
+	{ token.T_73, }, 
+	{ token.T_74, }, 
+	{ token.T_75, }, 
+	{ token.T_76, }, 
+	{ }, 
+	{ token.T_78, }, 
+	{ }, 
+	{ }, 
+	{ token.T_81, }, 
+	{ }, 
+	{ token.T_83, }, 
+	{ token.T_84, }, 
+	{ token.T_86, }, 
+	{ token.T_87, }, 
+	{ token.T_88, }, 
+	{ token.T_89, }, 
+	{ token.T_90, }, 
+	{ token.T_91, }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ token.T_100, token.T_116, }, 
+	{ token.T_104, }, 
+	{ token.T_116, }, 
+	{ token.T_116, }, 
+	{ token.T_106, token.T_116, }, 
+	{ token.T_116, }, 
+	{ token.T_110, token.T_116, }, 
+	{ token.T_116, }, 
+	{ token.T_116, }, 
+	{ token.T_116, }, 
+	{ token.T_113, }, 
+	{ token.T_14, }, 
+	{ token.T_15, }, 
+	{ token.T_21, }, 
+	{ token.T_22, }, 
+	{ token.T_23, }, 
+	{ token.T_24, }, 
+	{ token.T_25, }, 
+	{ token.T_26, }, 
+	{ token.T_27, }, 
+	{ token.T_28, token.T_29, }, 
+	{ token.T_30, }, 
+	{ token.T_31, }, 
+	{ token.T_32, }, 
+	{ token.T_35, }, 
+	{ token.T_36, }, 
+	{ token.T_41, }, 
+	{ token.T_48, }, 
+	{ token.T_50, }, 
+	{ token.T_52, token.T_53, token.T_54, token.T_55, token.T_56, token.T_57, token.T_58, token.T_59, token.T_60, }, 
+	{ token.T_61, token.T_62, }, 
+	{ token.T_69, }, 
+	{ token.T_71, }, 
+	{ token.T_73, }, 
+	{ token.T_74, }, 
+	{ token.T_75, }, 
+	{ token.T_76, }, 
+	{ token.T_78, }, 
+	{ token.T_81, }, 
+	{ token.T_83, }, 
+	{ token.T_84, }, 
+	{ token.T_86, }, 
+	{ token.T_87, }, 
+	{ token.T_88, }, 
+	{ token.T_89, }, 
+	{ token.T_90, }, 
+	{ token.T_91, }, 
+	{ token.T_100, token.T_116, }, 
+	{ token.T_104, }, 
+	{ token.T_116, }, 
+	{ token.T_116, }, 
+	{ token.T_113, }, 
+	{ token.T_14, }, 
+	{ token.T_15, }, 
+	{ }, 
+	{ token.T_22, }, 
+	{ token.T_23, }, 
+	{ token.T_24, }, 
+	{ token.T_25, }, 
+	{ token.T_26, }, 
+	{ token.T_27, }, 
+	{ token.T_28, }, 
+	{ token.T_29, }, 
+	{ token.T_30, }, 
+	{ token.T_31, }, 
+	{ token.T_32, }, 
+	{ token.T_35, }, 
+	{ token.T_36, }, 
+	{ }, 
+	{ token.T_48, }, 
+	{ token.T_50, }, 
+	{ token.T_52, token.T_53, token.T_54, token.T_55, token.T_56, token.T_57, token.T_58, token.T_59, token.T_60, }, 
+	{ token.T_61, token.T_62, }, 
+	{ token.T_69, }, 
+	{ token.T_71, }, 
+	{ token.T_73, }, 
+	{ token.T_74, }, 
+	{ token.T_75, }, 
+	{ token.T_76, }, 
+	{ token.T_78, }, 
+	{ token.T_81, }, 
+	{ token.T_83, }, 
+	{ token.T_84, }, 
+	{ token.T_86, }, 
+	{ token.T_87, }, 
+	{ token.T_88, }, 
+	{ token.T_89, }, 
+	{ token.T_90, }, 
+	{ token.T_91, }, 
+	{ token.T_100, token.T_116, }, 
+	{ token.T_14, }, 
+	{ token.T_15, }, 
+	{ token.T_22, }, 
+	{ token.T_23, }, 
+	{ }, 
+	{ token.T_25, }, 
+	{ token.T_26, }, 
+	{ token.T_27, }, 
+	{ token.T_28, }, 
+	{ token.T_29, }, 
+	{ token.T_30, }, 
+	{ token.T_31, }, 
+	{ token.T_32, }, 
+	{ token.T_35, }, 
+	{ }, 
+	{ token.T_48, }, 
+	{ token.T_50, }, 
+	{ token.T_52, token.T_53, token.T_54, token.T_55, token.T_56, token.T_57, token.T_58, token.T_59, }, 
+	{ }, 
+	{ token.T_61, token.T_62, }, 
+	{ token.T_69, }, 
+	{ }, 
+	{ token.T_73, }, 
+	{ token.T_74, }, 
+	{ token.T_75, }, 
+	{ }, 
+	{ token.T_78, }, 
+	{ token.T_81, }, 
+	{ }, 
+	{ token.T_84, }, 
+	{ token.T_86, }, 
+	{ }, 
+	{ token.T_88, }, 
+	{ }, 
+	{ token.T_90, }, 
+	{ token.T_91, }, 
+	{ token.T_100, token.T_116, }, 
+	{ token.T_14, }, 
+	{ token.T_15, }, 
+	{ token.T_22, }, 
+	{ token.T_23, }, 
+	{ token.T_25, }, 
+	{ token.T_26, }, 
+	{ }, 
+	{ token.T_28, }, 
+	{ token.T_29, }, 
+	{ token.T_30, }, 
+	{ token.T_31, }, 
+	{ }, 
+	{ token.T_35, }, 
+	{ token.T_48, }, 
+	{ }, 
+	{ token.T_52, }, 
+	{ token.T_53, }, 
+	{ token.T_54, }, 
+	{ token.T_55, token.T_56, }, 
+	{ token.T_57, }, 
+	{ token.T_58, }, 
+	{ token.T_59, }, 
+	{ token.T_61, token.T_62, }, 
+	{ token.T_69, }, 
+	{ token.T_73, }, 
+	{ token.T_74, }, 
+	{ token.T_75, }, 
+	{ token.T_78, }, 
+	{ token.T_81, }, 
+	{ }, 
+	{ token.T_86, }, 
+	{ token.T_88, }, 
+	{ token.T_90, }, 
+	{ token.T_91, }, 
+	{ token.T_100, token.T_116, }, 
+	{ token.T_14, }, 
+	{ token.T_15, }, 
+	{ token.T_22, }, 
+	{ token.T_23, }, 
+	{ token.T_25, }, 
+	{ token.T_26, }, 
+	{ token.T_28, }, 
+	{ token.T_29, }, 
+	{ token.T_30, }, 
+	{ token.T_31, }, 
+	{ token.T_35, }, 
+	{ token.T_48, }, 
+	{ token.T_52, }, 
+	{ token.T_53, }, 
+	{ token.T_54, }, 
+	{ token.T_55, token.T_56, }, 
+	{ token.T_57, }, 
+	{ token.T_58, }, 
+	{ token.T_59, }, 
+	{ token.T_61, token.T_62, }, 
+	{ token.T_69, }, 
+	{ token.T_73, }, 
+	{ }, 
+	{ token.T_75, }, 
+	{ token.T_78, }, 
+	{ token.T_81, }, 
+	{ token.T_86, }, 
+	{ token.T_88, }, 
+	{ token.T_90, }, 
+	{ token.T_91, }, 
+	{ token.T_100, token.T_116, }, 
+	{ token.T_14, }, 
+	{ token.T_15, }, 
+	{ token.T_22, }, 
+	{ token.T_23, }, 
+	{ }, 
+	{ token.T_26, }, 
+	{ token.T_28, }, 
+	{ token.T_29, }, 
+	{ token.T_30, }, 
+	{ token.T_31, }, 
+	{ token.T_35, }, 
+	{ token.T_48, }, 
+	{ token.T_52, }, 
+	{ token.T_53, }, 
+	{ token.T_54, }, 
+	{ token.T_55, token.T_56, }, 
+	{ token.T_57, }, 
+	{ token.T_58, }, 
+	{ token.T_59, }, 
+	{ token.T_61, }, 
+	{ token.T_62, }, 
+	{ token.T_69, }, 
+	{ token.T_73, }, 
+	{ token.T_75, }, 
+	{ token.T_78, }, 
+	{ token.T_81, }, 
+	{ token.T_86, }, 
+	{ token.T_88, }, 
+	{ token.T_90, }, 
+	{ token.T_91, }, 
+	{ token.T_100, token.T_116, }, 
+	{ token.T_14, }, 
+	{ token.T_15, }, 
+	{ token.T_22, }, 
+	{ }, 
+	{ }, 
+	{ token.T_28, }, 
+	{ token.T_29, }, 
+	{ token.T_30, }, 
+	{ token.T_31, }, 
+	{ token.T_35, }, 
+	{ token.T_48, }, 
+	{ token.T_52, }, 
+	{ token.T_53, }, 
+	{ token.T_54, }, 
+	{ token.T_55, }, 
+	{ token.T_56, }, 
+	{ token.T_57, }, 
+	{ token.T_58, }, 
+	{ token.T_59, }, 
+	{ token.T_61, }, 
+	{ token.T_62, }, 
+	{ token.T_69, }, 
+	{ token.T_73, }, 
+	{ token.T_75, }, 
+	{ token.T_78, }, 
+	{ token.T_81, }, 
+	{ token.T_86, }, 
+	{ token.T_88, }, 
+	{ token.T_90, }, 
+	{ token.T_91, }, 
+	{ token.T_100, token.T_116, }, 
+	{ token.T_14, }, 
+	{ token.T_15, }, 
+	{ }, 
+	{ token.T_28, }, 
+	{ token.T_29, }, 
+	{ token.T_30, }, 
+	{ token.T_31, }, 
+	{ token.T_35, }, 
+	{ token.T_48, }, 
+	{ token.T_52, }, 
+	{ token.T_53, }, 
+	{ token.T_54, }, 
+	{ token.T_55, }, 
+	{ token.T_56, }, 
+	{ token.T_57, }, 
+	{ }, 
+	{ token.T_59, }, 
+	{ token.T_61, }, 
+	{ token.T_62, }, 
+	{ token.T_69, }, 
+	{ token.T_73, }, 
+	{ token.T_75, }, 
+	{ token.T_78, }, 
+	{ token.T_81, }, 
+	{ token.T_86, }, 
+	{ token.T_88, }, 
+	{ token.T_90, }, 
+	{ token.T_91, }, 
+	{ token.T_100, token.T_116, }, 
+	{ token.T_14, }, 
+	{ token.T_15, }, 
+	{ token.T_28, }, 
+	{ token.T_29, }, 
+	{ }, 
+	{ token.T_31, }, 
+	{ token.T_35, }, 
+	{ token.T_48, }, 
+	{ token.T_52, }, 
+	{ token.T_53, }, 
+	{ token.T_54, }, 
+	{ token.T_55, }, 
+	{ token.T_56, }, 
+	{ token.T_57, }, 
+	{ token.T_59, }, 
+	{ token.T_61, }, 
+	{ token.T_62, }, 
+	{ token.T_69, }, 
+	{ token.T_73, }, 
+	{ token.T_75, }, 
+	{ token.T_78, }, 
+	{ }, 
+	{ token.T_86, }, 
+	{ token.T_88, }, 
+	{ token.T_90, }, 
+	{ }, 
+	{ token.T_100, token.T_116, }, 
+	{ token.T_14, }, 
+	{ }, 
+	{ token.T_28, }, 
+	{ token.T_29, }, 
+	{ }, 
+	{ token.T_35, }, 
+	{ token.T_48, }, 
+	{ token.T_52, }, 
+	{ token.T_53, }, 
+	{ token.T_54, }, 
+	{ token.T_55, }, 
+	{ token.T_56, }, 
+	{ token.T_57, }, 
+	{ token.T_59, }, 
+	{ token.T_61, }, 
+	{ token.T_62, }, 
+	{ token.T_69, }, 
+	{ token.T_73, }, 
+	{ token.T_75, }, 
+	{ token.T_78, }, 
+	{ token.T_86, }, 
+	{ token.T_88, }, 
+	{ token.T_90, }, 
+	{ token.T_116, }, 
+	{ token.T_14, }, 
+	{ token.T_28, }, 
+	{ token.T_29, }, 
+	{ token.T_35, }, 
+	{ token.T_48, }, 
+	{ token.T_52, }, 
+	{ token.T_53, }, 
+	{ token.T_54, }, 
+	{ token.T_55, }, 
+	{ token.T_56, }, 
+	{ token.T_57, }, 
+	{ token.T_59, }, 
+	{ token.T_61, }, 
+	{ token.T_62, }, 
+	{ token.T_69, }, 
+	{ token.T_73, }, 
+	{ token.T_75, }, 
+	{ token.T_78, }, 
+	{ token.T_86, }, 
+	{ token.T_88, }, 
+	{ token.T_90, }, 
+	{ token.T_14, }, 
+	{ token.T_28, }, 
+	{ token.T_29, }, 
+	{ token.T_35, }, 
+	{ token.T_48, }, 
+	{ token.T_52, }, 
+	{ token.T_53, }, 
+	{ token.T_54, }, 
+	{ token.T_55, }, 
+	{ }, 
+	{ token.T_57, }, 
+	{ token.T_59, }, 
+	{ }, 
+	{ token.T_62, }, 
+	{ token.T_69, }, 
+	{ }, 
+	{ token.T_75, }, 
+	{ token.T_78, }, 
+	{ token.T_86, }, 
+	{ token.T_88, }, 
+	{ token.T_90, }, 
+	{ }, 
+	{ token.T_28, }, 
+	{ token.T_29, }, 
+	{ token.T_35, }, 
+	{ token.T_48, }, 
+	{ token.T_52, }, 
+	{ token.T_53, }, 
+	{ token.T_54, }, 
+	{ token.T_55, }, 
+	{ }, 
+	{ }, 
+	{ token.T_62, }, 
+	{ token.T_69, }, 
+	{ token.T_75, }, 
+	{ token.T_78, }, 
+	{ token.T_86, }, 
+	{ token.T_88, }, 
+	{ token.T_90, }, 
+	{ token.T_28, }, 
+	{ token.T_29, }, 
+	{ token.T_35, }, 
+	{ token.T_48, }, 
+	{ }, 
+	{ token.T_53, }, 
+	{ token.T_54, }, 
+	{ token.T_55, }, 
+	{ token.T_62, }, 
+	{ token.T_69, }, 
+	{ token.T_75, }, 
+	{ token.T_78, }, 
+	{ token.T_86, }, 
+	{ token.T_88, }, 
+	{ token.T_90, }, 
+	{ token.T_28, }, 
+	{ token.T_29, }, 
+	{ token.T_35, }, 
+	{ token.T_48, }, 
+	{ token.T_53, }, 
+	{ token.T_54, }, 
+	{ }, 
+	{ token.T_62, }, 
+	{ token.T_69, }, 
+	{ token.T_75, }, 
+	{ }, 
+	{ token.T_86, }, 
+	{ }, 
+	{ token.T_90, }, 
+	{ token.T_28, }, 
+	{ token.T_29, }, 
+	{ token.T_35, }, 
+	{ token.T_48, }, 
+	{ token.T_53, }, 
+	{ token.T_54, }, 
+	{ token.T_62, }, 
+	{ token.T_69, }, 
+	{ }, 
+	{ token.T_86, }, 
+	{ }, 
+	{ }, 
+	{ token.T_29, }, 
+	{ token.T_35, }, 
+	{ token.T_48, }, 
+	{ token.T_53, }, 
+	{ token.T_54, }, 
+	{ }, 
+	{ token.T_69, }, 
+	{ token.T_86, }, 
+	{ }, 
+	{ token.T_35, }, 
+	{ token.T_48, }, 
+	{ token.T_53, }, 
+	{ token.T_54, }, 
+	{ token.T_69, }, 
+	{ }, 
+	{ token.T_35, }, 
+	{ token.T_48, }, 
+	{ token.T_53, }, 
+	{ }, 
+	{ token.T_69, }, 
+	{ token.T_35, }, 
+	{ token.T_48, }, 
+	{ token.T_53, }, 
+	{ token.T_69, }, 
+	{ }, 
+	{ }, 
+	{ token.T_53, }, 
+	{ token.T_69, }, 
+	{ token.T_53, }, 
+	{ token.T_69, }, 
+	{ token.T_53, }, 
+	{ token.T_69, }, 
+	{ token.T_53, }, 
+	{ token.T_69, }, 
+	{ token.T_53, }, 
+	{ token.T_69, }, 
+	{ token.T_53, }, 
+	{ }, 
+	{ token.T_53, }, 
+	{ token.T_53, }, 
+	{ token.T_53, }, 
+	{ token.T_53, }, 
+	{ token.T_53, }, 
+	{ }, 
 }
 
 var nextState = []func(r rune) state{ 
@@ -973,52 +2160,66 @@ var nextState = []func(r rune) state{
 			return 1 
 		case r == '"':
 			return 2 
-		case r == '\'':
+		case r == '#':
 			return 3 
-		case r == '(':
+		case r == '\'':
 			return 4 
-		case r == ')':
+		case r == '(':
 			return 5 
-		case r == '-':
+		case r == ')':
 			return 6 
-		case r == '.':
+		case r == ',':
 			return 7 
-		case r == ':':
+		case r == '-':
 			return 8 
-		case r == ';':
+		case r == '.':
 			return 9 
-		case r == '<':
+		case r == ':':
 			return 10 
-		case r == '>':
+		case r == ';':
 			return 11 
-		case r == '[':
+		case r == '<':
 			return 12 
-		case r == '\\':
+		case r == '>':
 			return 13 
-		case r == ']':
+		case r == '@':
 			return 14 
-		case r == 'a':
+		case r == '[':
 			return 15 
-		case r == 'e':
+		case r == '\\':
 			return 16 
-		case r == 'l':
+		case r == ']':
 			return 17 
-		case r == 'n':
+		case r == 'a':
 			return 18 
-		case r == 'p':
+		case r == 'c':
 			return 19 
-		case r == 'u':
+		case r == 'e':
 			return 20 
-		case r == '{':
+		case r == 'i':
 			return 21 
-		case r == '|':
+		case r == 'l':
 			return 22 
-		case r == '}':
+		case r == 'n':
 			return 23 
-		case unicode.IsUpper(r):
+		case r == 'p':
 			return 24 
-		case unicode.IsLower(r):
+		case r == 'r':
 			return 25 
+		case r == 's':
+			return 26 
+		case r == 'u':
+			return 27 
+		case r == '{':
+			return 28 
+		case r == '|':
+			return 29 
+		case r == '}':
+			return 30 
+		case unicode.IsUpper(r):
+			return 31 
+		case unicode.IsLower(r):
+			return 32 
 		}
 		return nullState
 	}, 
@@ -1032,27 +2233,27 @@ var nextState = []func(r rune) state{
 	func(r rune) state {
 		switch { 
 		case r == '\\':
-			return 26 
+			return 33 
 		case not(r, []rune{'"','\\'}):
-			return 27 
+			return 34 
 		}
 		return nullState
 	}, 
 	// Set3
 	func(r rune) state {
 		switch { 
-		case r == '[':
-			return 28 
-		case r == '\\':
-			return 29 
-		case not(r, []rune{'\''}):
-			return 30 
 		}
 		return nullState
 	}, 
 	// Set4
 	func(r rune) state {
 		switch { 
+		case r == '[':
+			return 35 
+		case r == '\\':
+			return 36 
+		case not(r, []rune{'\''}):
+			return 37 
 		}
 		return nullState
 	}, 
@@ -1107,60 +2308,34 @@ var nextState = []func(r rune) state{
 	// Set13
 	func(r rune) state {
 		switch { 
-		case r == 'p':
-			return 31 
 		}
 		return nullState
 	}, 
 	// Set14
 	func(r rune) state {
 		switch { 
-		case r == '\'':
-			return 32 
 		}
 		return nullState
 	}, 
 	// Set15
 	func(r rune) state {
 		switch { 
-		case r == '_':
-			return 33 
-		case r == 'n':
-			return 34 
-		case unicode.IsLetter(r):
-			return 33 
-		case unicode.IsNumber(r):
-			return 33 
 		}
 		return nullState
 	}, 
 	// Set16
 	func(r rune) state {
 		switch { 
-		case r == '_':
-			return 33 
-		case r == 'm':
-			return 35 
-		case unicode.IsLetter(r):
-			return 33 
-		case unicode.IsNumber(r):
-			return 33 
+		case r == 'p':
+			return 38 
 		}
 		return nullState
 	}, 
 	// Set17
 	func(r rune) state {
 		switch { 
-		case r == '_':
-			return 33 
-		case r == 'e':
-			return 36 
-		case r == 'o':
-			return 37 
-		case unicode.IsLetter(r):
-			return 33 
-		case unicode.IsNumber(r):
-			return 33 
+		case r == '\'':
+			return 39 
 		}
 		return nullState
 	}, 
@@ -1168,15 +2343,15 @@ var nextState = []func(r rune) state{
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
-		case r == 'o':
-			return 38 
-		case r == 'u':
-			return 39 
+			return 40 
+		case r == 'n':
+			return 41 
+		case r == 's':
+			return 42 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
@@ -1184,13 +2359,13 @@ var nextState = []func(r rune) state{
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
-		case r == 'a':
 			return 40 
+		case r == 'a':
+			return 43 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
@@ -1198,31 +2373,61 @@ var nextState = []func(r rune) state{
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
-		case r == 'p':
-			return 41 
+			return 40 
+		case r == 'm':
+			return 44 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
 	// Set21
 	func(r rune) state {
 		switch { 
-		}
-		return nullState
+		case r == '"':
+			return 45 
+		case r == '_':
+			return 40 
+		case r == 'm':
+			return 46 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
 	}, 
 	// Set22
 	func(r rune) state {
 		switch { 
+		case r == '_':
+			return 40 
+		case r == 'e':
+			return 47 
+		case r == 'o':
+			return 48 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
 	// Set23
 	func(r rune) state {
 		switch { 
+		case r == '_':
+			return 40 
+		case r == 'o':
+			return 49 
+		case r == 'u':
+			return 50 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
@@ -1230,11 +2435,15 @@ var nextState = []func(r rune) state{
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 24 
+			return 40 
+		case r == 'a':
+			return 51 
+		case r == 'r':
+			return 52 
 		case unicode.IsLetter(r):
-			return 24 
+			return 40 
 		case unicode.IsNumber(r):
-			return 24 
+			return 40 
 		}
 		return nullState
 	}, 
@@ -1242,167 +2451,155 @@ var nextState = []func(r rune) state{
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
+			return 40 
+		case r == 'e':
+			return 53 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
 	// Set26
 	func(r rune) state {
 		switch { 
-		case any(r, []rune{'"','\\','n','r','t'}):
-			return 27 
+		case r == '_':
+			return 40 
+		case r == 't':
+			return 54 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
 	// Set27
 	func(r rune) state {
 		switch { 
-		case r == '"':
-			return 42 
-		case r == '\\':
-			return 26 
-		case not(r, []rune{'"','\\'}):
-			return 27 
+		case r == '_':
+			return 40 
+		case r == 'p':
+			return 55 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
 	// Set28
 	func(r rune) state {
 		switch { 
-		case r == '\'':
-			return 43 
 		}
 		return nullState
 	}, 
 	// Set29
 	func(r rune) state {
 		switch { 
-		case any(r, []rune{'\'','\\','n','r','t'}):
-			return 44 
-		case r == '\'':
-			return 44 
 		}
 		return nullState
 	}, 
 	// Set30
 	func(r rune) state {
 		switch { 
-		case r == '\'':
-			return 43 
 		}
 		return nullState
 	}, 
 	// Set31
 	func(r rune) state {
 		switch { 
-		case r == '{':
-			return 45 
+		case r == '_':
+			return 31 
+		case unicode.IsLetter(r):
+			return 31 
+		case unicode.IsNumber(r):
+			return 31 
 		}
 		return nullState
 	}, 
 	// Set32
 	func(r rune) state {
 		switch { 
+		case r == '_':
+			return 40 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
 	// Set33
 	func(r rune) state {
 		switch { 
-		case r == '_':
-			return 33 
-		case unicode.IsLetter(r):
-			return 33 
-		case unicode.IsNumber(r):
-			return 33 
+		case r == 'U':
+			return 56 
+		case r == 'u':
+			return 57 
+		case r == 'x':
+			return 58 
+		case any(r, []rune{'"','\\','n','r','t'}):
+			return 34 
 		}
 		return nullState
 	}, 
 	// Set34
 	func(r rune) state {
 		switch { 
-		case r == '_':
-			return 33 
-		case r == 'y':
-			return 46 
-		case unicode.IsLetter(r):
-			return 33 
-		case unicode.IsNumber(r):
+		case r == '"':
+			return 59 
+		case r == '\\':
 			return 33 
+		case not(r, []rune{'"','\\'}):
+			return 34 
 		}
 		return nullState
 	}, 
 	// Set35
 	func(r rune) state {
 		switch { 
-		case r == '_':
-			return 33 
-		case r == 'p':
-			return 47 
-		case unicode.IsLetter(r):
-			return 33 
-		case unicode.IsNumber(r):
-			return 33 
+		case r == '\'':
+			return 60 
 		}
 		return nullState
 	}, 
 	// Set36
 	func(r rune) state {
 		switch { 
-		case r == '_':
-			return 33 
-		case r == 't':
-			return 48 
-		case unicode.IsLetter(r):
-			return 33 
-		case unicode.IsNumber(r):
-			return 33 
+		case any(r, []rune{'\'','\\','n','r','t'}):
+			return 61 
+		case r == '\'':
+			return 61 
+		case r == 'U':
+			return 62 
+		case r == 'u':
+			return 63 
+		case r == 'x':
+			return 64 
 		}
 		return nullState
 	}, 
 	// Set37
 	func(r rune) state {
 		switch { 
-		case r == '_':
-			return 33 
-		case r == 'w':
-			return 49 
-		case unicode.IsLetter(r):
-			return 33 
-		case unicode.IsNumber(r):
-			return 33 
+		case r == '\'':
+			return 65 
 		}
 		return nullState
 	}, 
 	// Set38
 	func(r rune) state {
 		switch { 
-		case r == '_':
-			return 33 
-		case r == 't':
-			return 50 
-		case unicode.IsLetter(r):
-			return 33 
-		case unicode.IsNumber(r):
-			return 33 
+		case r == '{':
+			return 66 
 		}
 		return nullState
 	}, 
 	// Set39
 	func(r rune) state {
 		switch { 
-		case r == '_':
-			return 33 
-		case r == 'm':
-			return 51 
-		case unicode.IsLetter(r):
-			return 33 
-		case unicode.IsNumber(r):
-			return 33 
 		}
 		return nullState
 	}, 
@@ -1410,13 +2607,11 @@ var nextState = []func(r rune) state{
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
-		case r == 'c':
-			return 52 
+			return 40 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
@@ -1424,81 +2619,63 @@ var nextState = []func(r rune) state{
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
-		case r == 'c':
-			return 53 
+			return 40 
+		case r == 'y':
+			return 67 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
 	// Set42
 	func(r rune) state {
 		switch { 
+		case r == '_':
+			return 40 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
 	// Set43
 	func(r rune) state {
 		switch { 
+		case r == '_':
+			return 40 
+		case r == 's':
+			return 68 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
 	// Set44
 	func(r rune) state {
 		switch { 
-		case r == '\'':
-			return 43 
+		case r == '_':
+			return 40 
+		case r == 'p':
+			return 69 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
 	// Set45
 	func(r rune) state {
 		switch { 
-		case r == 'A':
-			return 54 
-		case r == 'B':
-			return 55 
-		case r == 'C':
-			return 56 
-		case r == 'D':
-			return 57 
-		case r == 'E':
-			return 58 
-		case r == 'H':
-			return 59 
-		case r == 'I':
-			return 60 
-		case r == 'J':
-			return 61 
-		case r == 'L':
-			return 62 
-		case r == 'M':
-			return 63 
-		case r == 'N':
-			return 64 
-		case r == 'O':
-			return 65 
-		case r == 'P':
-			return 66 
-		case r == 'Q':
-			return 67 
-		case r == 'R':
-			return 68 
-		case r == 'S':
-			return 69 
-		case r == 'T':
+		case r == '\\':
 			return 70 
-		case r == 'U':
+		case not(r, []rune{'"','\\'}):
 			return 71 
-		case r == 'V':
-			return 72 
-		case r == 'W':
-			return 73 
-		case r == 'Z':
-			return 74 
 		}
 		return nullState
 	}, 
@@ -1506,11 +2683,13 @@ var nextState = []func(r rune) state{
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
+			return 40 
+		case r == 'p':
+			return 72 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
@@ -1518,13 +2697,13 @@ var nextState = []func(r rune) state{
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
+			return 40 
 		case r == 't':
-			return 75 
+			return 73 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
@@ -1532,13 +2711,13 @@ var nextState = []func(r rune) state{
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
-		case r == 't':
-			return 76 
+			return 40 
+		case r == 'w':
+			return 74 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
@@ -1546,13 +2725,13 @@ var nextState = []func(r rune) state{
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
-		case r == 'c':
-			return 77 
+			return 40 
+		case r == 't':
+			return 75 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
@@ -1560,11 +2739,13 @@ var nextState = []func(r rune) state{
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
+			return 40 
+		case r == 'm':
+			return 76 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
@@ -1572,13 +2753,13 @@ var nextState = []func(r rune) state{
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
-		case r == 'b':
-			return 78 
+			return 40 
+		case r == 'c':
+			return 77 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
@@ -1586,13 +2767,13 @@ var nextState = []func(r rune) state{
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
-		case r == 'k':
-			return 79 
+			return 40 
+		case r == 'e':
+			return 78 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
@@ -1600,5087 +2781,6021 @@ var nextState = []func(r rune) state{
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
-		case r == 'a':
-			return 80 
+			return 40 
+		case r == 'n':
+			return 79 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
 	// Set54
 	func(r rune) state {
 		switch { 
-		case r == 'S':
-			return 81 
+		case r == '_':
+			return 40 
+		case r == 'a':
+			return 80 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
 	// Set55
 	func(r rune) state {
 		switch { 
-		case r == 'i':
-			return 82 
+		case r == '_':
+			return 40 
+		case r == 'c':
+			return 81 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
 	// Set56
 	func(r rune) state {
 		switch { 
-		case r == 'c':
-			return 83 
-		case r == 'f':
-			return 84 
-		case r == 'o':
-			return 85 
-		case r == 's':
-			return 86 
-		case r == '}':
-			return 87 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 82 
 		}
 		return nullState
 	}, 
 	// Set57
 	func(r rune) state {
 		switch { 
-		case r == 'a':
-			return 88 
-		case r == 'e':
-			return 89 
-		case r == 'i':
-			return 90 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 83 
 		}
 		return nullState
 	}, 
 	// Set58
 	func(r rune) state {
 		switch { 
-		case r == 'x':
-			return 91 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 84 
 		}
 		return nullState
 	}, 
 	// Set59
 	func(r rune) state {
 		switch { 
-		case r == 'e':
-			return 92 
-		case r == 'y':
-			return 93 
 		}
 		return nullState
 	}, 
 	// Set60
 	func(r rune) state {
 		switch { 
-		case r == 'D':
-			return 94 
-		case r == 'd':
-			return 95 
+		case r == '\\':
+			return 85 
+		case not(r, []rune{'\t','\n','\r',' ','\''}):
+			return 86 
 		}
 		return nullState
 	}, 
 	// Set61
 	func(r rune) state {
 		switch { 
-		case r == 'o':
-			return 96 
+		case r == '\'':
+			return 65 
 		}
 		return nullState
 	}, 
 	// Set62
 	func(r rune) state {
 		switch { 
-		case r == 'e':
-			return 97 
-		case r == 'l':
-			return 98 
-		case r == 'm':
-			return 99 
-		case r == 'o':
-			return 100 
-		case r == 't':
-			return 101 
-		case r == 'u':
-			return 102 
-		case r == '}':
-			return 103 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 87 
 		}
 		return nullState
 	}, 
 	// Set63
 	func(r rune) state {
 		switch { 
-		case r == 'a':
-			return 104 
-		case r == 'c':
-			return 105 
-		case r == 'e':
-			return 106 
-		case r == 'n':
-			return 107 
-		case r == '}':
-			return 108 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 88 
 		}
 		return nullState
 	}, 
 	// Set64
 	func(r rune) state {
 		switch { 
-		case r == 'd':
-			return 109 
-		case r == 'l':
-			return 110 
-		case r == 'o':
-			return 111 
-		case r == 'u':
-			return 112 
-		case r == '}':
-			return 113 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 89 
 		}
 		return nullState
 	}, 
 	// Set65
 	func(r rune) state {
 		switch { 
-		case r == 't':
-			return 114 
 		}
 		return nullState
 	}, 
 	// Set66
 	func(r rune) state {
 		switch { 
-		case r == 'a':
-			return 115 
-		case r == 'c':
-			return 116 
-		case r == 'd':
-			return 117 
-		case r == 'e':
-			return 118 
-		case r == 'f':
-			return 119 
-		case r == 'i':
-			return 120 
-		case r == 'o':
-			return 121 
-		case r == 'r':
-			return 122 
-		case r == 's':
-			return 123 
-		case r == 'u':
-			return 124 
-		case r == '}':
-			return 125 
+		case r == 'A':
+			return 90 
+		case r == 'B':
+			return 91 
+		case r == 'C':
+			return 92 
+		case r == 'D':
+			return 93 
+		case r == 'E':
+			return 94 
+		case r == 'H':
+			return 95 
+		case r == 'I':
+			return 96 
+		case r == 'J':
+			return 97 
+		case r == 'L':
+			return 98 
+		case r == 'M':
+			return 99 
+		case r == 'N':
+			return 100 
+		case r == 'O':
+			return 101 
+		case r == 'P':
+			return 102 
+		case r == 'Q':
+			return 103 
+		case r == 'R':
+			return 104 
+		case r == 'S':
+			return 105 
+		case r == 'T':
+			return 106 
+		case r == 'U':
+			return 107 
+		case r == 'V':
+			return 108 
+		case r == 'W':
+			return 109 
+		case r == 'Z':
+			return 110 
 		}
 		return nullState
 	}, 
 	// Set67
 	func(r rune) state {
 		switch { 
-		case r == 'u':
-			return 126 
+		case r == '_':
+			return 40 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
 	// Set68
 	func(r rune) state {
 		switch { 
-		case r == 'a':
-			return 127 
+		case r == '_':
+			return 40 
 		case r == 'e':
-			return 128 
-		}
-		return nullState
-	}, 
-	// Set69
+			return 111 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set69
 	func(r rune) state {
 		switch { 
-		case r == 'T':
-			return 129 
+		case r == '_':
+			return 40 
+		case r == 't':
+			return 112 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set70
+	func(r rune) state {
+		switch { 
+		case r == 'U':
+			return 113 
+		case r == 'u':
+			return 114 
+		case r == 'x':
+			return 115 
+		case any(r, []rune{'"','\\','n','r','t'}):
+			return 71 
+		}
+		return nullState
+	}, 
+	// Set71
+	func(r rune) state {
+		switch { 
+		case r == '"':
+			return 116 
+		case r == '\\':
+			return 70 
+		case not(r, []rune{'"','\\'}):
+			return 71 
+		}
+		return nullState
+	}, 
+	// Set72
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case r == 'o':
+			return 117 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set73
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case r == 't':
+			return 118 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set74
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
 		case r == 'c':
+			return 119 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set75
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set76
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case r == 'b':
+			return 120 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set77
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case r == 'k':
+			return 121 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set78
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case r == 'f':
+			return 122 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set79
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case r == 'a':
+			return 123 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set80
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case r == 'r':
+			return 124 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set81
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case r == 'a':
+			return 125 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set82
+	func(r rune) state {
+		switch { 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 126 
+		}
+		return nullState
+	}, 
+	// Set83
+	func(r rune) state {
+		switch { 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 58 
+		}
+		return nullState
+	}, 
+	// Set84
+	func(r rune) state {
+		switch { 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 34 
+		}
+		return nullState
+	}, 
+	// Set85
+	func(r rune) state {
+		switch { 
+		case any(r, []rune{'\'','\\','n','r','t'}):
+			return 127 
+		case r == '\'':
+			return 127 
+		case r == 'U':
+			return 128 
+		case r == 'u':
+			return 129 
+		case r == 'x':
 			return 130 
-		case r == 'e':
+		}
+		return nullState
+	}, 
+	// Set86
+	func(r rune) state {
+		switch { 
+		case r == '\'':
 			return 131 
-		case r == 'k':
+		}
+		return nullState
+	}, 
+	// Set87
+	func(r rune) state {
+		switch { 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
 			return 132 
-		case r == 'm':
+		}
+		return nullState
+	}, 
+	// Set88
+	func(r rune) state {
+		switch { 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 64 
+		}
+		return nullState
+	}, 
+	// Set89
+	func(r rune) state {
+		switch { 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 37 
+		}
+		return nullState
+	}, 
+	// Set90
+	func(r rune) state {
+		switch { 
+		case r == 'S':
 			return 133 
-		case r == 'o':
+		}
+		return nullState
+	}, 
+	// Set91
+	func(r rune) state {
+		switch { 
+		case r == 'i':
 			return 134 
+		}
+		return nullState
+	}, 
+	// Set92
+	func(r rune) state {
+		switch { 
+		case r == 'c':
+			return 135 
+		case r == 'f':
+			return 136 
+		case r == 'o':
+			return 137 
+		case r == 's':
+			return 138 
+		case r == '}':
+			return 139 
+		}
+		return nullState
+	}, 
+	// Set93
+	func(r rune) state {
+		switch { 
+		case r == 'a':
+			return 140 
+		case r == 'e':
+			return 141 
+		case r == 'i':
+			return 142 
+		}
+		return nullState
+	}, 
+	// Set94
+	func(r rune) state {
+		switch { 
+		case r == 'x':
+			return 143 
+		}
+		return nullState
+	}, 
+	// Set95
+	func(r rune) state {
+		switch { 
+		case r == 'e':
+			return 144 
+		case r == 'y':
+			return 145 
+		}
+		return nullState
+	}, 
+	// Set96
+	func(r rune) state {
+		switch { 
+		case r == 'D':
+			return 146 
+		case r == 'd':
+			return 147 
+		}
+		return nullState
+	}, 
+	// Set97
+	func(r rune) state {
+		switch { 
+		case r == 'o':
+			return 148 
+		}
+		return nullState
+	}, 
+	// Set98
+	func(r rune) state {
+		switch { 
+		case r == 'e':
+			return 149 
+		case r == 'l':
+			return 150 
+		case r == 'm':
+			return 151 
+		case r == 'o':
+			return 152 
+		case r == 't':
+			return 153 
+		case r == 'u':
+			return 154 
+		case r == '}':
+			return 155 
+		}
+		return nullState
+	}, 
+	// Set99
+	func(r rune) state {
+		switch { 
+		case r == 'a':
+			return 156 
+		case r == 'c':
+			return 157 
+		case r == 'e':
+			return 158 
+		case r == 'n':
+			return 159 
+		case r == '}':
+			return 160 
+		}
+		return nullState
+	}, 
+	// Set100
+	func(r rune) state {
+		switch { 
+		case r == 'd':
+			return 161 
+		case r == 'l':
+			return 162 
+		case r == 'o':
+			return 163 
+		case r == 'u':
+			return 164 
+		case r == '}':
+			return 165 
+		}
+		return nullState
+	}, 
+	// Set101
+	func(r rune) state {
+		switch { 
+		case r == 't':
+			return 166 
+		}
+		return nullState
+	}, 
+	// Set102
+	func(r rune) state {
+		switch { 
+		case r == 'a':
+			return 167 
+		case r == 'c':
+			return 168 
+		case r == 'd':
+			return 169 
+		case r == 'e':
+			return 170 
+		case r == 'f':
+			return 171 
+		case r == 'i':
+			return 172 
+		case r == 'o':
+			return 173 
+		case r == 'r':
+			return 174 
+		case r == 's':
+			return 175 
+		case r == 'u':
+			return 176 
+		case r == '}':
+			return 177 
+		}
+		return nullState
+	}, 
+	// Set103
+	func(r rune) state {
+		switch { 
+		case r == 'u':
+			return 178 
+		}
+		return nullState
+	}, 
+	// Set104
+	func(r rune) state {
+		switch { 
+		case r == 'a':
+			return 179 
+		case r == 'e':
+			return 180 
+		}
+		return nullState
+	}, 
+	// Set105
+	func(r rune) state {
+		switch { 
+		case r == 'T':
+			return 181 
+		case r == 'c':
+			return 182 
+		case r == 'e':
+			return 183 
+		case r == 'k':
+			return 184 
+		case r == 'm':
+			return 185 
+		case r == 'o':
+			return 186 
 		case r == 'p':
-			return 135 
+			return 187 
 		case r == 'y':
-			return 136 
+			return 188 
 		case r == '}':
-			return 137 
+			return 189 
 		}
 		return nullState
 	}, 
-	// Set70
+	// Set106
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 138 
+			return 190 
 		case r == 'i':
-			return 139 
+			return 191 
 		}
 		return nullState
 	}, 
-	// Set71
+	// Set107
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 140 
+			return 192 
 		case r == 'p':
-			return 141 
+			return 193 
 		}
 		return nullState
 	}, 
-	// Set72
+	// Set108
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 142 
+			return 194 
 		}
 		return nullState
 	}, 
-	// Set73
+	// Set109
 	func(r rune) state {
 		switch { 
 		case r == 'h':
-			return 143 
+			return 195 
 		}
 		return nullState
 	}, 
-	// Set74
+	// Set110
 	func(r rune) state {
 		switch { 
 		case r == 'l':
-			return 144 
+			return 196 
 		case r == 'p':
-			return 145 
+			return 197 
 		case r == 's':
-			return 146 
+			return 198 
 		case r == '}':
-			return 147 
+			return 199 
 		}
 		return nullState
 	}, 
-	// Set75
+	// Set111
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
+			return 200 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set112
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
 		case r == 'y':
-			return 148 
+			return 201 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set76
+	// Set113
+	func(r rune) state {
+		switch { 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 202 
+		}
+		return nullState
+	}, 
+	// Set114
+	func(r rune) state {
+		switch { 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 203 
+		}
+		return nullState
+	}, 
+	// Set115
+	func(r rune) state {
+		switch { 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 204 
+		}
+		return nullState
+	}, 
+	// Set116
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set117
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
+			return 40 
+		case r == 'r':
+			return 205 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set118
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
 		case r == 'e':
-			return 149 
+			return 206 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set77
+	// Set119
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
+			return 40 
 		case r == 'a':
-			return 150 
+			return 207 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set78
+	// Set120
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
+			return 40 
 		case r == 'e':
-			return 151 
+			return 208 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set79
+	// Set121
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
+			return 40 
 		case r == 'a':
-			return 152 
+			return 209 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set80
+	// Set122
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
+			return 40 
+		case r == 'i':
+			return 210 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set123
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case r == 'm':
+			return 211 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set124
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case r == 't':
+			return 212 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set125
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
 		case r == 's':
-			return 153 
+			return 213 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set81
+	// Set126
+	func(r rune) state {
+		switch { 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 214 
+		}
+		return nullState
+	}, 
+	// Set127
+	func(r rune) state {
+		switch { 
+		case r == '\'':
+			return 131 
+		}
+		return nullState
+	}, 
+	// Set128
+	func(r rune) state {
+		switch { 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 215 
+		}
+		return nullState
+	}, 
+	// Set129
+	func(r rune) state {
+		switch { 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 216 
+		}
+		return nullState
+	}, 
+	// Set130
+	func(r rune) state {
+		switch { 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 217 
+		}
+		return nullState
+	}, 
+	// Set131
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set132
+	func(r rune) state {
+		switch { 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 218 
+		}
+		return nullState
+	}, 
+	// Set133
 	func(r rune) state {
 		switch { 
 		case r == 'C':
-			return 154 
+			return 219 
 		}
 		return nullState
 	}, 
-	// Set82
+	// Set134
 	func(r rune) state {
 		switch { 
 		case r == 'd':
-			return 155 
+			return 220 
 		}
 		return nullState
 	}, 
-	// Set83
+	// Set135
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 156 
+			return 221 
 		}
 		return nullState
 	}, 
-	// Set84
+	// Set136
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 157 
+			return 222 
 		}
 		return nullState
 	}, 
-	// Set85
+	// Set137
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 158 
+			return 223 
 		}
 		return nullState
 	}, 
-	// Set86
+	// Set138
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 159 
+			return 224 
 		}
 		return nullState
 	}, 
-	// Set87
+	// Set139
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set88
+	// Set140
 	func(r rune) state {
 		switch { 
 		case r == 's':
-			return 160 
+			return 225 
 		}
 		return nullState
 	}, 
-	// Set89
+	// Set141
 	func(r rune) state {
 		switch { 
 		case r == 'p':
-			return 161 
+			return 226 
 		}
 		return nullState
 	}, 
-	// Set90
+	// Set142
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 162 
+			return 227 
 		case r == 'g':
-			return 163 
+			return 228 
 		}
 		return nullState
 	}, 
-	// Set91
+	// Set143
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 164 
+			return 229 
 		}
 		return nullState
 	}, 
-	// Set92
+	// Set144
 	func(r rune) state {
 		switch { 
 		case r == 'x':
-			return 165 
+			return 230 
 		}
 		return nullState
 	}, 
-	// Set93
+	// Set145
 	func(r rune) state {
 		switch { 
 		case r == 'p':
-			return 166 
+			return 231 
 		}
 		return nullState
 	}, 
-	// Set94
+	// Set146
 	func(r rune) state {
 		switch { 
 		case r == 'S':
-			return 167 
+			return 232 
 		}
 		return nullState
 	}, 
-	// Set95
+	// Set147
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 168 
+			return 233 
 		}
 		return nullState
 	}, 
-	// Set96
+	// Set148
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 169 
+			return 234 
 		}
 		return nullState
 	}, 
-	// Set97
+	// Set149
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 170 
+			return 235 
 		}
 		return nullState
 	}, 
-	// Set98
+	// Set150
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 171 
+			return 236 
 		}
 		return nullState
 	}, 
-	// Set99
+	// Set151
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 172 
+			return 237 
 		}
 		return nullState
 	}, 
-	// Set100
+	// Set152
 	func(r rune) state {
 		switch { 
 		case r == 'g':
-			return 173 
+			return 238 
 		case r == 'w':
-			return 174 
+			return 239 
 		case r == '}':
-			return 175 
+			return 240 
 		}
 		return nullState
 	}, 
-	// Set101
+	// Set153
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 176 
+			return 241 
 		}
 		return nullState
 	}, 
-	// Set102
+	// Set154
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 177 
+			return 242 
 		}
 		return nullState
 	}, 
-	// Set103
+	// Set155
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set104
+	// Set156
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 178 
+			return 243 
 		}
 		return nullState
 	}, 
-	// Set105
+	// Set157
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 179 
+			return 244 
 		}
 		return nullState
 	}, 
-	// Set106
+	// Set158
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 180 
+			return 245 
 		}
 		return nullState
 	}, 
-	// Set107
+	// Set159
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 181 
+			return 246 
 		}
 		return nullState
 	}, 
-	// Set108
+	// Set160
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set109
+	// Set161
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 182 
+			return 247 
 		}
 		return nullState
 	}, 
-	// Set110
+	// Set162
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 183 
+			return 248 
 		}
 		return nullState
 	}, 
-	// Set111
+	// Set163
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 184 
+			return 249 
 		case r == '}':
-			return 185 
+			return 250 
 		}
 		return nullState
 	}, 
-	// Set112
+	// Set164
 	func(r rune) state {
 		switch { 
 		case r == 'm':
-			return 186 
+			return 251 
 		}
 		return nullState
 	}, 
-	// Set113
+	// Set165
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set114
+	// Set166
 	func(r rune) state {
 		switch { 
 		case r == 'h':
-			return 187 
+			return 252 
 		}
 		return nullState
 	}, 
-	// Set115
+	// Set167
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 188 
+			return 253 
 		}
 		return nullState
 	}, 
-	// Set116
+	// Set168
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 189 
+			return 254 
 		}
 		return nullState
 	}, 
-	// Set117
+	// Set169
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 190 
+			return 255 
 		}
 		return nullState
 	}, 
-	// Set118
+	// Set170
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 191 
+			return 256 
 		}
 		return nullState
 	}, 
-	// Set119
+	// Set171
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 192 
+			return 257 
 		}
 		return nullState
 	}, 
-	// Set120
+	// Set172
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 193 
+			return 258 
 		}
 		return nullState
 	}, 
-	// Set121
+	// Set173
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 194 
+			return 259 
 		}
 		return nullState
 	}, 
-	// Set122
+	// Set174
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 195 
+			return 260 
 		}
 		return nullState
 	}, 
-	// Set123
+	// Set175
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 196 
+			return 261 
 		}
 		return nullState
 	}, 
-	// Set124
+	// Set176
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 197 
+			return 262 
+		}
+		return nullState
+	}, 
+	// Set177
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set178
+	func(r rune) state {
+		switch { 
+		case r == 'o':
+			return 263 
+		}
+		return nullState
+	}, 
+	// Set179
+	func(r rune) state {
+		switch { 
+		case r == 'd':
+			return 264 
+		}
+		return nullState
+	}, 
+	// Set180
+	func(r rune) state {
+		switch { 
+		case r == 'g':
+			return 265 
+		}
+		return nullState
+	}, 
+	// Set181
+	func(r rune) state {
+		switch { 
+		case r == 'e':
+			return 266 
+		}
+		return nullState
+	}, 
+	// Set182
+	func(r rune) state {
+		switch { 
+		case r == '}':
+			return 267 
+		}
+		return nullState
+	}, 
+	// Set183
+	func(r rune) state {
+		switch { 
+		case r == 'n':
+			return 268 
+		}
+		return nullState
+	}, 
+	// Set184
+	func(r rune) state {
+		switch { 
+		case r == '}':
+			return 269 
+		}
+		return nullState
+	}, 
+	// Set185
+	func(r rune) state {
+		switch { 
+		case r == '}':
+			return 270 
+		}
+		return nullState
+	}, 
+	// Set186
+	func(r rune) state {
+		switch { 
+		case r == 'f':
+			return 271 
+		case r == '}':
+			return 272 
+		}
+		return nullState
+	}, 
+	// Set187
+	func(r rune) state {
+		switch { 
+		case r == 'a':
+			return 273 
+		}
+		return nullState
+	}, 
+	// Set188
+	func(r rune) state {
+		switch { 
+		case r == 'm':
+			return 274 
 		}
 		return nullState
 	}, 
-	// Set125
+	// Set189
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set126
+	// Set190
 	func(r rune) state {
 		switch { 
-		case r == 'o':
-			return 198 
+		case r == 'r':
+			return 275 
 		}
 		return nullState
 	}, 
-	// Set127
+	// Set191
 	func(r rune) state {
 		switch { 
-		case r == 'd':
-			return 199 
+		case r == 't':
+			return 276 
 		}
 		return nullState
 	}, 
-	// Set128
+	// Set192
 	func(r rune) state {
 		switch { 
-		case r == 'g':
-			return 200 
+		case r == 'i':
+			return 277 
 		}
 		return nullState
 	}, 
-	// Set129
+	// Set193
 	func(r rune) state {
 		switch { 
-		case r == 'e':
-			return 201 
+		case r == 'p':
+			return 278 
 		}
 		return nullState
 	}, 
-	// Set130
+	// Set194
 	func(r rune) state {
 		switch { 
-		case r == '}':
-			return 202 
+		case r == 'r':
+			return 279 
 		}
 		return nullState
 	}, 
-	// Set131
+	// Set195
 	func(r rune) state {
 		switch { 
-		case r == 'n':
-			return 203 
+		case r == 'i':
+			return 280 
 		}
 		return nullState
 	}, 
-	// Set132
+	// Set196
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 204 
+			return 281 
 		}
 		return nullState
 	}, 
-	// Set133
+	// Set197
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 205 
+			return 282 
 		}
 		return nullState
 	}, 
-	// Set134
+	// Set198
 	func(r rune) state {
 		switch { 
-		case r == 'f':
-			return 206 
 		case r == '}':
-			return 207 
+			return 283 
 		}
 		return nullState
 	}, 
-	// Set135
+	// Set199
 	func(r rune) state {
 		switch { 
-		case r == 'a':
-			return 208 
 		}
 		return nullState
 	}, 
-	// Set136
+	// Set200
 	func(r rune) state {
 		switch { 
-		case r == 'm':
-			return 209 
+		case r == '_':
+			return 40 
+		case r == 'i':
+			return 284 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set137
+	// Set201
 	func(r rune) state {
 		switch { 
+		case r == '_':
+			return 40 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set138
+	// Set202
 	func(r rune) state {
 		switch { 
-		case r == 'r':
-			return 210 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 285 
 		}
 		return nullState
 	}, 
-	// Set139
+	// Set203
 	func(r rune) state {
 		switch { 
-		case r == 't':
-			return 211 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 115 
 		}
 		return nullState
 	}, 
-	// Set140
+	// Set204
 	func(r rune) state {
 		switch { 
-		case r == 'i':
-			return 212 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 71 
 		}
 		return nullState
 	}, 
-	// Set141
+	// Set205
 	func(r rune) state {
 		switch { 
-		case r == 'p':
-			return 213 
+		case r == '_':
+			return 40 
+		case r == 't':
+			return 286 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set142
+	// Set206
 	func(r rune) state {
 		switch { 
+		case r == '_':
+			return 40 
 		case r == 'r':
-			return 214 
+			return 287 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set143
+	// Set207
 	func(r rune) state {
 		switch { 
-		case r == 'i':
-			return 215 
+		case r == '_':
+			return 40 
+		case r == 's':
+			return 288 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set144
+	// Set208
 	func(r rune) state {
 		switch { 
-		case r == '}':
-			return 216 
+		case r == '_':
+			return 40 
+		case r == 'r':
+			return 289 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set145
+	// Set209
 	func(r rune) state {
 		switch { 
-		case r == '}':
-			return 217 
+		case r == '_':
+			return 40 
+		case r == 'g':
+			return 290 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set146
+	// Set210
 	func(r rune) state {
 		switch { 
-		case r == '}':
-			return 218 
+		case r == '_':
+			return 40 
+		case r == 'x':
+			return 291 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set147
+	// Set211
 	func(r rune) state {
 		switch { 
+		case r == '_':
+			return 40 
+		case r == 'e':
+			return 292 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set148
+	// Set212
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
+			return 40 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set149
+	// Set213
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
-		case r == 'r':
-			return 219 
+			return 40 
+		case r == 'e':
+			return 293 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set150
+	// Set214
 	func(r rune) state {
 		switch { 
-		case r == '_':
-			return 33 
-		case r == 's':
-			return 220 
-		case unicode.IsLetter(r):
-			return 33 
-		case unicode.IsNumber(r):
-			return 33 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 57 
 		}
 		return nullState
 	}, 
-	// Set151
+	// Set215
 	func(r rune) state {
 		switch { 
-		case r == '_':
-			return 33 
-		case r == 'r':
-			return 221 
-		case unicode.IsLetter(r):
-			return 33 
-		case unicode.IsNumber(r):
-			return 33 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 294 
 		}
 		return nullState
 	}, 
-	// Set152
+	// Set216
 	func(r rune) state {
 		switch { 
-		case r == '_':
-			return 33 
-		case r == 'g':
-			return 222 
-		case unicode.IsLetter(r):
-			return 33 
-		case unicode.IsNumber(r):
-			return 33 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 130 
 		}
 		return nullState
 	}, 
-	// Set153
+	// Set217
 	func(r rune) state {
 		switch { 
-		case r == '_':
-			return 33 
-		case r == 'e':
-			return 223 
-		case unicode.IsLetter(r):
-			return 33 
-		case unicode.IsNumber(r):
-			return 33 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 86 
 		}
 		return nullState
 	}, 
-	// Set154
+	// Set218
+	func(r rune) state {
+		switch { 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 63 
+		}
+		return nullState
+	}, 
+	// Set219
 	func(r rune) state {
 		switch { 
 		case r == 'I':
-			return 224 
+			return 295 
 		}
 		return nullState
 	}, 
-	// Set155
+	// Set220
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 225 
+			return 296 
 		}
 		return nullState
 	}, 
-	// Set156
+	// Set221
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set157
+	// Set222
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set158
+	// Set223
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set159
+	// Set224
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set160
+	// Set225
 	func(r rune) state {
 		switch { 
 		case r == 'h':
-			return 226 
+			return 297 
 		}
 		return nullState
 	}, 
-	// Set161
+	// Set226
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 227 
+			return 298 
 		}
 		return nullState
 	}, 
-	// Set162
+	// Set227
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 228 
+			return 299 
 		}
 		return nullState
 	}, 
-	// Set163
+	// Set228
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 229 
+			return 300 
 		}
 		return nullState
 	}, 
-	// Set164
+	// Set229
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 230 
+			return 301 
 		}
 		return nullState
 	}, 
-	// Set165
+	// Set230
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 231 
+			return 302 
 		}
 		return nullState
 	}, 
-	// Set166
+	// Set231
 	func(r rune) state {
 		switch { 
 		case r == 'h':
-			return 232 
+			return 303 
 		}
 		return nullState
 	}, 
-	// Set167
+	// Set232
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 233 
+			return 304 
 		}
 		return nullState
 	}, 
-	// Set168
+	// Set233
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 234 
+			return 305 
 		}
 		return nullState
 	}, 
-	// Set169
+	// Set234
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 235 
+			return 306 
 		}
 		return nullState
 	}, 
-	// Set170
+	// Set235
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 236 
+			return 307 
 		}
 		return nullState
 	}, 
-	// Set171
+	// Set236
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set172
+	// Set237
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set173
+	// Set238
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 237 
+			return 308 
 		}
 		return nullState
 	}, 
-	// Set174
+	// Set239
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 238 
+			return 309 
 		}
 		return nullState
 	}, 
-	// Set175
+	// Set240
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set176
+	// Set241
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set177
+	// Set242
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set178
+	// Set243
 	func(r rune) state {
 		switch { 
 		case r == 'k':
-			return 239 
+			return 310 
 		}
 		return nullState
 	}, 
-	// Set179
+	// Set244
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set180
+	// Set245
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set181
+	// Set246
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set182
+	// Set247
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set183
+	// Set248
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set184
+	// Set249
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 240 
+			return 311 
 		}
 		return nullState
 	}, 
-	// Set185
+	// Set250
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set186
+	// Set251
 	func(r rune) state {
 		switch { 
 		case r == 'b':
-			return 241 
+			return 312 
 		}
 		return nullState
 	}, 
-	// Set187
+	// Set252
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 242 
+			return 313 
 		}
 		return nullState
 	}, 
-	// Set188
+	// Set253
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 243 
+			return 314 
 		}
 		return nullState
 	}, 
-	// Set189
+	// Set254
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set190
+	// Set255
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set191
+	// Set256
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set192
+	// Set257
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set193
+	// Set258
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set194
+	// Set259
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set195
+	// Set260
 	func(r rune) state {
 		switch { 
 		case r == 'p':
-			return 244 
+			return 315 
 		}
 		return nullState
 	}, 
-	// Set196
+	// Set261
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set197
+	// Set262
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 245 
+			return 316 
 		}
 		return nullState
 	}, 
-	// Set198
+	// Set263
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 246 
+			return 317 
 		}
 		return nullState
 	}, 
-	// Set199
+	// Set264
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 247 
+			return 318 
 		}
 		return nullState
 	}, 
-	// Set200
+	// Set265
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 248 
+			return 319 
 		}
 		return nullState
 	}, 
-	// Set201
+	// Set266
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 249 
+			return 320 
 		}
 		return nullState
 	}, 
-	// Set202
+	// Set267
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set203
+	// Set268
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 250 
+			return 321 
 		}
 		return nullState
 	}, 
-	// Set204
+	// Set269
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set205
+	// Set270
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set206
+	// Set271
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 251 
+			return 322 
 		}
 		return nullState
 	}, 
-	// Set207
+	// Set272
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set208
+	// Set273
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 252 
+			return 323 
 		}
 		return nullState
 	}, 
-	// Set209
+	// Set274
 	func(r rune) state {
 		switch { 
 		case r == 'b':
-			return 253 
+			return 324 
 		}
 		return nullState
 	}, 
-	// Set210
+	// Set275
 	func(r rune) state {
 		switch { 
 		case r == 'm':
-			return 254 
+			return 325 
 		}
 		return nullState
 	}, 
-	// Set211
+	// Set276
 	func(r rune) state {
 		switch { 
 		case r == 'l':
-			return 255 
+			return 326 
 		}
 		return nullState
 	}, 
-	// Set212
+	// Set277
 	func(r rune) state {
 		switch { 
 		case r == 'f':
-			return 256 
+			return 327 
 		}
 		return nullState
 	}, 
-	// Set213
+	// Set278
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 257 
+			return 328 
 		}
 		return nullState
 	}, 
-	// Set214
+	// Set279
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 258 
+			return 329 
 		}
 		return nullState
 	}, 
-	// Set215
+	// Set280
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 259 
+			return 330 
 		}
 		return nullState
 	}, 
-	// Set216
+	// Set281
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set217
+	// Set282
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set218
+	// Set283
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set219
+	// Set284
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
+			return 40 
+		case r == 'n':
+			return 331 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set285
+	func(r rune) state {
+		switch { 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 332 
+		}
+		return nullState
+	}, 
+	// Set286
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set287
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set288
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case r == 'e':
+			return 333 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set289
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set220
+	// Set290
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
+			return 40 
 		case r == 'e':
-			return 260 
+			return 334 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set221
+	// Set291
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
+			return 40 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set222
+	// Set292
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
-		case r == 'e':
-			return 261 
+			return 40 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set223
+	// Set293
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
+			return 40 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set224
+	// Set294
+	func(r rune) state {
+		switch { 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 335 
+		}
+		return nullState
+	}, 
+	// Set295
 	func(r rune) state {
 		switch { 
 		case r == 'I':
-			return 262 
+			return 336 
 		}
 		return nullState
 	}, 
-	// Set225
+	// Set296
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 263 
+			return 337 
 		}
 		return nullState
 	}, 
-	// Set226
+	// Set297
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 264 
+			return 338 
 		}
 		return nullState
 	}, 
-	// Set227
+	// Set298
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 265 
+			return 339 
 		}
 		return nullState
 	}, 
-	// Set228
+	// Set299
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 266 
+			return 340 
 		}
 		return nullState
 	}, 
-	// Set229
+	// Set300
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 267 
+			return 341 
 		}
 		return nullState
 	}, 
-	// Set230
+	// Set301
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 268 
+			return 342 
 		}
 		return nullState
 	}, 
-	// Set231
+	// Set302
 	func(r rune) state {
 		switch { 
 		case r == 'D':
-			return 269 
+			return 343 
 		}
 		return nullState
 	}, 
-	// Set232
+	// Set303
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 270 
+			return 344 
 		}
 		return nullState
 	}, 
-	// Set233
+	// Set304
 	func(r rune) state {
 		switch { 
 		case r == 'B':
-			return 271 
+			return 345 
 		case r == 'T':
-			return 272 
+			return 346 
 		}
 		return nullState
 	}, 
-	// Set234
+	// Set305
 	func(r rune) state {
 		switch { 
 		case r == 'g':
-			return 273 
+			return 347 
 		}
 		return nullState
 	}, 
-	// Set235
+	// Set306
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 274 
+			return 348 
 		}
 		return nullState
 	}, 
-	// Set236
+	// Set307
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 275 
+			return 349 
 		}
 		return nullState
 	}, 
-	// Set237
+	// Set308
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 276 
+			return 350 
 		}
 		return nullState
 	}, 
-	// Set238
+	// Set309
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 277 
+			return 351 
 		}
 		return nullState
 	}, 
-	// Set239
+	// Set310
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 278 
+			return 352 
 		}
 		return nullState
 	}, 
-	// Set240
+	// Set311
 	func(r rune) state {
 		switch { 
 		case r == 'h':
-			return 279 
+			return 353 
 		}
 		return nullState
 	}, 
-	// Set241
+	// Set312
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 280 
+			return 354 
 		}
 		return nullState
 	}, 
-	// Set242
+	// Set313
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 281 
+			return 355 
 		}
 		return nullState
 	}, 
-	// Set243
+	// Set314
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 282 
+			return 356 
 		}
 		return nullState
 	}, 
-	// Set244
+	// Set315
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 283 
+			return 357 
 		}
 		return nullState
 	}, 
-	// Set245
+	// Set316
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 284 
+			return 358 
 		}
 		return nullState
 	}, 
-	// Set246
+	// Set317
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 285 
+			return 359 
 		}
 		return nullState
 	}, 
-	// Set247
+	// Set318
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 286 
+			return 360 
 		}
 		return nullState
 	}, 
-	// Set248
+	// Set319
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 287 
+			return 361 
 		}
 		return nullState
 	}, 
-	// Set249
+	// Set320
 	func(r rune) state {
 		switch { 
 		case r == 'm':
-			return 288 
+			return 362 
 		}
 		return nullState
 	}, 
-	// Set250
+	// Set321
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 289 
+			return 363 
 		}
 		return nullState
 	}, 
-	// Set251
+	// Set322
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 290 
+			return 364 
 		}
 		return nullState
 	}, 
-	// Set252
+	// Set323
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 291 
+			return 365 
 		}
 		return nullState
 	}, 
-	// Set253
+	// Set324
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 292 
+			return 366 
 		}
 		return nullState
 	}, 
-	// Set254
+	// Set325
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 293 
+			return 367 
 		}
 		return nullState
 	}, 
-	// Set255
+	// Set326
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 294 
+			return 368 
 		}
 		return nullState
 	}, 
-	// Set256
+	// Set327
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 295 
+			return 369 
 		}
 		return nullState
 	}, 
-	// Set257
+	// Set328
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 296 
+			return 370 
 		}
 		return nullState
 	}, 
-	// Set258
+	// Set329
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 297 
+			return 371 
 		}
 		return nullState
 	}, 
-	// Set259
+	// Set330
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 298 
+			return 372 
 		}
 		return nullState
 	}, 
-	// Set260
+	// Set331
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
+			return 40 
+		case r == 's':
+			return 373 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set261
+	// Set332
+	func(r rune) state {
+		switch { 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 114 
+		}
+		return nullState
+	}, 
+	// Set333
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 33 
+			return 40 
 		case unicode.IsLetter(r):
-			return 33 
+			return 40 
 		case unicode.IsNumber(r):
-			return 33 
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set262
+	// Set334
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 299 
+			return 40 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set263
+	// Set335
+	func(r rune) state {
+		switch { 
+		case any(r, []rune{'0','1','2','3','4','5','6','7','8','9','A','B','C','D','E','F','a','b','c','d','e','f'}):
+			return 129 
+		}
+		return nullState
+	}, 
+	// Set336
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 374 
+		}
+		return nullState
+	}, 
+	// Set337
 	func(r rune) state {
 		switch { 
 		case r == 'C':
-			return 300 
+			return 375 
 		}
 		return nullState
 	}, 
-	// Set264
+	// Set338
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set265
+	// Set339
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 301 
+			return 376 
 		}
 		return nullState
 	}, 
-	// Set266
+	// Set340
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 302 
+			return 377 
 		}
 		return nullState
 	}, 
-	// Set267
+	// Set341
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 303 
+			return 378 
 		}
 		return nullState
 	}, 
-	// Set268
+	// Set342
 	func(r rune) state {
 		switch { 
 		case r == 'd':
-			return 304 
+			return 379 
 		}
 		return nullState
 	}, 
-	// Set269
+	// Set343
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 305 
+			return 380 
 		}
 		return nullState
 	}, 
-	// Set270
+	// Set344
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 306 
+			return 381 
 		}
 		return nullState
 	}, 
-	// Set271
+	// Set345
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 307 
+			return 382 
 		}
 		return nullState
 	}, 
-	// Set272
+	// Set346
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 308 
+			return 383 
 		}
 		return nullState
 	}, 
-	// Set273
+	// Set347
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 309 
+			return 384 
 		}
 		return nullState
 	}, 
-	// Set274
+	// Set348
 	func(r rune) state {
 		switch { 
 		case r == 'C':
-			return 310 
+			return 385 
 		}
 		return nullState
 	}, 
-	// Set275
+	// Set349
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 311 
+			return 386 
 		}
 		return nullState
 	}, 
-	// Set276
+	// Set350
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 312 
+			return 387 
 		}
 		return nullState
 	}, 
-	// Set277
+	// Set351
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 313 
+			return 388 
 		}
 		return nullState
 	}, 
-	// Set278
+	// Set352
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set279
+	// Set353
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 314 
+			return 389 
 		}
 		return nullState
 	}, 
-	// Set280
+	// Set354
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 315 
+			return 390 
 		}
 		return nullState
 	}, 
-	// Set281
+	// Set355
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 316 
+			return 391 
 		case r == '}':
-			return 317 
+			return 392 
 		}
 		return nullState
 	}, 
-	// Set282
+	// Set356
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 318 
+			return 393 
 		}
 		return nullState
 	}, 
-	// Set283
+	// Set357
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 319 
+			return 394 
 		}
 		return nullState
 	}, 
-	// Set284
+	// Set358
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 320 
+			return 395 
 		}
 		return nullState
 	}, 
-	// Set285
+	// Set359
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 321 
+			return 396 
 		}
 		return nullState
 	}, 
-	// Set286
+	// Set360
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 322 
+			return 397 
 		}
 		return nullState
 	}, 
-	// Set287
+	// Set361
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 323 
+			return 398 
 		}
 		return nullState
 	}, 
-	// Set288
+	// Set362
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 324 
+			return 399 
 		}
 		return nullState
 	}, 
-	// Set289
+	// Set363
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 325 
+			return 400 
 		}
 		return nullState
 	}, 
-	// Set290
+	// Set364
 	func(r rune) state {
 		switch { 
 		case r == 'D':
-			return 326 
+			return 401 
 		}
 		return nullState
 	}, 
-	// Set291
+	// Set365
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 327 
+			return 402 
 		}
 		return nullState
 	}, 
-	// Set292
+	// Set366
 	func(r rune) state {
 		switch { 
 		case r == 'l':
-			return 328 
+			return 403 
 		}
 		return nullState
 	}, 
-	// Set293
+	// Set367
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 329 
+			return 404 
 		}
 		return nullState
 	}, 
-	// Set294
+	// Set368
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 330 
+			return 405 
 		}
 		return nullState
 	}, 
-	// Set295
+	// Set369
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 331 
+			return 406 
 		}
 		return nullState
 	}, 
-	// Set296
+	// Set370
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 332 
+			return 407 
 		}
 		return nullState
 	}, 
-	// Set297
+	// Set371
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 333 
+			return 408 
 		}
 		return nullState
 	}, 
-	// Set298
+	// Set372
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 334 
+			return 409 
 		}
 		return nullState
 	}, 
-	// Set299
+	// Set373
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case r == 'e':
+			return 410 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set374
 	func(r rune) state {
 		switch { 
 		case r == 'H':
-			return 335 
+			return 411 
 		}
 		return nullState
 	}, 
-	// Set300
+	// Set375
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 336 
+			return 412 
 		}
 		return nullState
 	}, 
-	// Set301
+	// Set376
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 337 
+			return 413 
 		}
 		return nullState
 	}, 
-	// Set302
+	// Set377
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 338 
+			return 414 
 		}
 		return nullState
 	}, 
-	// Set303
+	// Set378
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set304
+	// Set379
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 339 
+			return 415 
 		}
 		return nullState
 	}, 
-	// Set305
+	// Set380
 	func(r rune) state {
 		switch { 
 		case r == 'g':
-			return 340 
+			return 416 
 		}
 		return nullState
 	}, 
-	// Set306
+	// Set381
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 341 
+			return 417 
 		}
 		return nullState
 	}, 
-	// Set307
+	// Set382
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 342 
+			return 418 
 		}
 		return nullState
 	}, 
-	// Set308
+	// Set383
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 343 
+			return 419 
 		}
 		return nullState
 	}, 
-	// Set309
+	// Set384
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 344 
+			return 420 
 		}
 		return nullState
 	}, 
-	// Set310
+	// Set385
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 345 
+			return 421 
 		}
 		return nullState
 	}, 
-	// Set311
+	// Set386
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 346 
+			return 422 
 		}
 		return nullState
 	}, 
-	// Set312
+	// Set387
 	func(r rune) state {
 		switch { 
 		case r == 'l':
-			return 347 
+			return 423 
 		}
 		return nullState
 	}, 
-	// Set313
+	// Set388
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set314
+	// Set389
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 348 
+			return 424 
 		}
 		return nullState
 	}, 
-	// Set315
+	// Set390
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 349 
+			return 425 
 		}
 		return nullState
 	}, 
-	// Set316
+	// Set391
 	func(r rune) state {
 		switch { 
 		case r == 'A':
-			return 350 
+			return 426 
 		case r == 'D':
-			return 351 
+			return 427 
 		case r == 'G':
-			return 352 
+			return 428 
 		case r == 'I':
-			return 353 
+			return 429 
 		case r == 'L':
-			return 354 
+			return 430 
 		case r == 'M':
-			return 355 
+			return 431 
 		case r == 'U':
-			return 356 
+			return 432 
 		}
 		return nullState
 	}, 
-	// Set317
+	// Set392
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set318
+	// Set393
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 357 
+			return 433 
 		}
 		return nullState
 	}, 
-	// Set319
+	// Set394
 	func(r rune) state {
 		switch { 
 		case r == 'd':
-			return 358 
+			return 434 
 		}
 		return nullState
 	}, 
-	// Set320
+	// Set395
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set321
+	// Set396
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 359 
+			return 435 
 		}
 		return nullState
 	}, 
-	// Set322
+	// Set397
 	func(r rune) state {
 		switch { 
 		case r == 'l':
-			return 360 
+			return 436 
 		}
 		return nullState
 	}, 
-	// Set323
+	// Set398
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 361 
+			return 437 
 		}
 		return nullState
 	}, 
-	// Set324
+	// Set399
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set325
+	// Set400
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 362 
+			return 438 
 		}
 		return nullState
 	}, 
-	// Set326
+	// Set401
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 363 
+			return 439 
 		}
 		return nullState
 	}, 
-	// Set327
+	// Set402
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set328
+	// Set403
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 364 
+			return 440 
 		}
 		return nullState
 	}, 
-	// Set329
+	// Set404
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 365 
+			return 441 
 		}
 		return nullState
 	}, 
-	// Set330
+	// Set405
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set331
+	// Set406
 	func(r rune) state {
 		switch { 
 		case r == 'd':
-			return 366 
+			return 442 
 		}
 		return nullState
 	}, 
-	// Set332
+	// Set407
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set333
+	// Set408
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 367 
+			return 443 
 		}
 		return nullState
 	}, 
-	// Set334
+	// Set409
 	func(r rune) state {
 		switch { 
 		case r == 'S':
-			return 368 
+			return 444 
 		}
 		return nullState
 	}, 
-	// Set335
+	// Set410
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case r == 'n':
+			return 445 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set411
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 369 
+			return 446 
 		}
 		return nullState
 	}, 
-	// Set336
+	// Set412
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 370 
+			return 447 
 		}
 		return nullState
 	}, 
-	// Set337
+	// Set413
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 371 
+			return 448 
 		}
 		return nullState
 	}, 
-	// Set338
+	// Set414
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 372 
+			return 449 
 		}
 		return nullState
 	}, 
-	// Set339
+	// Set415
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 373 
+			return 450 
 		}
 		return nullState
 	}, 
-	// Set340
+	// Set416
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 374 
+			return 451 
 		}
 		return nullState
 	}, 
-	// Set341
+	// Set417
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set342
+	// Set418
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 375 
+			return 452 
 		}
 		return nullState
 	}, 
-	// Set343
+	// Set419
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 376 
+			return 453 
 		}
 		return nullState
 	}, 
-	// Set344
+	// Set420
 	func(r rune) state {
 		switch { 
 		case r == 'p':
-			return 377 
+			return 454 
 		}
 		return nullState
 	}, 
-	// Set345
+	// Set421
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 378 
+			return 455 
 		}
 		return nullState
 	}, 
-	// Set346
+	// Set422
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set347
+	// Set423
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 379 
+			return 456 
 		}
 		return nullState
 	}, 
-	// Set348
+	// Set424
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 380 
+			return 457 
 		}
 		return nullState
 	}, 
-	// Set349
+	// Set425
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set350
+	// Set426
 	func(r rune) state {
 		switch { 
 		case r == 'l':
-			return 381 
+			return 458 
 		}
 		return nullState
 	}, 
-	// Set351
+	// Set427
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 382 
+			return 459 
 		}
 		return nullState
 	}, 
-	// Set352
+	// Set428
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 383 
+			return 460 
 		}
 		return nullState
 	}, 
-	// Set353
+	// Set429
 	func(r rune) state {
 		switch { 
 		case r == 'D':
-			return 384 
+			return 461 
 		}
 		return nullState
 	}, 
-	// Set354
+	// Set430
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 385 
+			return 462 
 		}
 		return nullState
 	}, 
-	// Set355
+	// Set431
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 386 
+			return 463 
 		}
 		return nullState
 	}, 
-	// Set356
+	// Set432
 	func(r rune) state {
 		switch { 
 		case r == 'p':
-			return 387 
+			return 464 
 		}
 		return nullState
 	}, 
-	// Set357
+	// Set433
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 388 
+			return 465 
 		}
 		return nullState
 	}, 
-	// Set358
+	// Set434
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 389 
+			return 466 
 		}
 		return nullState
 	}, 
-	// Set359
+	// Set435
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 390 
+			return 467 
 		}
 		return nullState
 	}, 
-	// Set360
+	// Set436
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 391 
+			return 468 
 		}
 		return nullState
 	}, 
-	// Set361
+	// Set437
 	func(r rune) state {
 		switch { 
 		case r == 'l':
-			return 392 
+			return 469 
 		}
 		return nullState
 	}, 
-	// Set362
+	// Set438
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 393 
+			return 470 
 		}
 		return nullState
 	}, 
-	// Set363
+	// Set439
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 394 
+			return 471 
 		}
 		return nullState
 	}, 
-	// Set364
+	// Set440
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set365
+	// Set441
 	func(r rune) state {
 		switch { 
 		case r == 'l':
-			return 395 
+			return 472 
 		}
 		return nullState
 	}, 
-	// Set366
+	// Set442
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 396 
+			return 473 
 		}
 		return nullState
 	}, 
-	// Set367
+	// Set443
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 397 
+			return 474 
 		}
 		return nullState
 	}, 
-	// Set368
+	// Set444
 	func(r rune) state {
 		switch { 
 		case r == 'p':
-			return 398 
+			return 475 
 		}
 		return nullState
 	}, 
-	// Set369
+	// Set445
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case r == 's':
+			return 476 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set446
 	func(r rune) state {
 		switch { 
 		case r == 'x':
-			return 399 
+			return 477 
 		}
 		return nullState
 	}, 
-	// Set370
+	// Set447
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 400 
+			return 478 
 		}
 		return nullState
 	}, 
-	// Set371
+	// Set448
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 401 
+			return 479 
 		}
 		return nullState
 	}, 
-	// Set372
+	// Set449
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 402 
+			return 480 
 		}
 		return nullState
 	}, 
-	// Set373
+	// Set450
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 403 
+			return 481 
 		}
 		return nullState
 	}, 
-	// Set374
+	// Set451
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 404 
+			return 482 
 		}
 		return nullState
 	}, 
-	// Set375
+	// Set452
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 405 
+			return 483 
 		}
 		return nullState
 	}, 
-	// Set376
+	// Set453
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 406 
+			return 484 
 		}
 		return nullState
 	}, 
-	// Set377
+	// Set454
 	func(r rune) state {
 		switch { 
 		case r == 'h':
-			return 407 
+			return 485 
 		}
 		return nullState
 	}, 
-	// Set378
+	// Set455
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 408 
+			return 486 
 		}
 		return nullState
 	}, 
-	// Set379
+	// Set456
 	func(r rune) state {
 		switch { 
 		case r == 'O':
-			return 409 
+			return 487 
 		}
 		return nullState
 	}, 
-	// Set380
+	// Set457
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 410 
+			return 488 
 		}
 		return nullState
 	}, 
-	// Set381
+	// Set458
 	func(r rune) state {
 		switch { 
 		case r == 'p':
-			return 411 
+			return 489 
 		}
 		return nullState
 	}, 
-	// Set382
+	// Set459
 	func(r rune) state {
 		switch { 
 		case r == 'f':
-			return 412 
+			return 490 
 		}
 		return nullState
 	}, 
-	// Set383
+	// Set460
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 413 
+			return 491 
 		}
 		return nullState
 	}, 
-	// Set384
+	// Set461
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 414 
+			return 492 
 		}
 		return nullState
 	}, 
-	// Set385
+	// Set462
 	func(r rune) state {
 		switch { 
 		case r == 'w':
-			return 415 
+			return 493 
 		}
 		return nullState
 	}, 
-	// Set386
+	// Set463
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 416 
+			return 494 
 		}
 		return nullState
 	}, 
-	// Set387
+	// Set464
 	func(r rune) state {
 		switch { 
 		case r == 'p':
-			return 417 
+			return 495 
 		}
 		return nullState
 	}, 
-	// Set388
+	// Set465
 	func(r rune) state {
 		switch { 
 		case r == 'S':
-			return 418 
+			return 496 
 		case r == 'W':
-			return 419 
+			return 497 
 		}
 		return nullState
 	}, 
-	// Set389
+	// Set466
 	func(r rune) state {
 		switch { 
 		case r == 'd':
-			return 420 
+			return 498 
 		}
 		return nullState
 	}, 
-	// Set390
+	// Set467
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 421 
+			return 499 
 		}
 		return nullState
 	}, 
-	// Set391
+	// Set468
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set392
+	// Set469
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 422 
+			return 500 
 		}
 		return nullState
 	}, 
-	// Set393
+	// Set470
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 423 
+			return 501 
 		}
 		return nullState
 	}, 
-	// Set394
+	// Set471
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 424 
+			return 502 
 		}
 		return nullState
 	}, 
-	// Set395
+	// Set472
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 425 
+			return 503 
 		}
 		return nullState
 	}, 
-	// Set396
+	// Set473
 	func(r rune) state {
 		switch { 
 		case r == 'I':
-			return 426 
+			return 504 
 		}
 		return nullState
 	}, 
-	// Set397
+	// Set474
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 427 
+			return 505 
 		}
 		return nullState
 	}, 
-	// Set398
+	// Set475
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 428 
+			return 506 
 		}
 		return nullState
 	}, 
-	// Set399
+	// Set476
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 429 
+			return 40 
+		case r == 'i':
+			return 507 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set400
+	// Set477
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 508 
+		}
+		return nullState
+	}, 
+	// Set478
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 430 
+			return 509 
 		}
 		return nullState
 	}, 
-	// Set401
+	// Set479
 	func(r rune) state {
 		switch { 
 		case r == 'd':
-			return 431 
+			return 510 
 		}
 		return nullState
 	}, 
-	// Set402
+	// Set480
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 432 
+			return 511 
 		}
 		return nullState
 	}, 
-	// Set403
+	// Set481
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set404
+	// Set482
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 433 
+			return 512 
 		}
 		return nullState
 	}, 
-	// Set405
+	// Set483
 	func(r rune) state {
 		switch { 
 		case r == 'y':
-			return 434 
+			return 513 
 		}
 		return nullState
 	}, 
-	// Set406
+	// Set484
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 435 
+			return 514 
 		}
 		return nullState
 	}, 
-	// Set407
+	// Set485
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 436 
+			return 515 
 		}
 		return nullState
 	}, 
-	// Set408
+	// Set486
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 437 
+			return 516 
 		}
 		return nullState
 	}, 
-	// Set409
+	// Set487
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 438 
+			return 517 
 		}
 		return nullState
 	}, 
-	// Set410
+	// Set488
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 439 
+			return 518 
 		}
 		return nullState
 	}, 
-	// Set411
+	// Set489
 	func(r rune) state {
 		switch { 
 		case r == 'h':
-			return 440 
+			return 519 
 		}
 		return nullState
 	}, 
-	// Set412
+	// Set490
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 441 
+			return 520 
 		}
 		return nullState
 	}, 
-	// Set413
+	// Set491
 	func(r rune) state {
 		switch { 
 		case r == 'p':
-			return 442 
+			return 521 
 		}
 		return nullState
 	}, 
-	// Set414
+	// Set492
 	func(r rune) state {
 		switch { 
 		case r == 'C':
-			return 443 
+			return 522 
 		case r == 'S':
-			return 444 
+			return 523 
 		}
 		return nullState
 	}, 
-	// Set415
+	// Set493
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 445 
+			return 524 
 		}
 		return nullState
 	}, 
-	// Set416
+	// Set494
 	func(r rune) state {
 		switch { 
 		case r == 'h':
-			return 446 
+			return 525 
 		}
 		return nullState
 	}, 
-	// Set417
+	// Set495
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 447 
+			return 526 
 		}
 		return nullState
 	}, 
-	// Set418
+	// Set496
 	func(r rune) state {
 		switch { 
 		case r == 'y':
-			return 448 
+			return 527 
 		}
 		return nullState
 	}, 
-	// Set419
+	// Set497
 	func(r rune) state {
 		switch { 
 		case r == 'h':
-			return 449 
+			return 528 
 		}
 		return nullState
 	}, 
-	// Set420
+	// Set498
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 450 
+			return 529 
 		}
 		return nullState
 	}, 
-	// Set421
+	// Set499
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 451 
+			return 530 
 		}
 		return nullState
 	}, 
-	// Set422
+	// Set500
 	func(r rune) state {
 		switch { 
 		case r == 'I':
-			return 452 
+			return 531 
 		}
 		return nullState
 	}, 
-	// Set423
+	// Set501
 	func(r rune) state {
 		switch { 
 		case r == 'T':
-			return 453 
+			return 532 
 		}
 		return nullState
 	}, 
-	// Set424
+	// Set502
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 454 
+			return 533 
 		}
 		return nullState
 	}, 
-	// Set425
+	// Set503
 	func(r rune) state {
 		switch { 
 		case r == 'P':
-			return 455 
+			return 534 
 		}
 		return nullState
 	}, 
-	// Set426
+	// Set504
 	func(r rune) state {
 		switch { 
 		case r == 'd':
-			return 456 
+			return 535 
 		}
 		return nullState
 	}, 
-	// Set427
+	// Set505
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 457 
+			return 536 
 		}
 		return nullState
 	}, 
-	// Set428
+	// Set506
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 458 
+			return 537 
 		}
 		return nullState
 	}, 
-	// Set429
+	// Set507
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case r == 't':
+			return 538 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set508
 	func(r rune) state {
 		switch { 
 		case r == 'D':
-			return 459 
+			return 539 
 		}
 		return nullState
 	}, 
-	// Set430
+	// Set509
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 460 
+			return 540 
 		}
 		return nullState
 	}, 
-	// Set431
+	// Set510
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 461 
+			return 541 
 		}
 		return nullState
 	}, 
-	// Set432
+	// Set511
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set433
+	// Set512
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set434
+	// Set513
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 462 
+			return 542 
 		}
 		return nullState
 	}, 
-	// Set435
+	// Set514
 	func(r rune) state {
 		switch { 
 		case r == 'y':
-			return 463 
+			return 543 
 		}
 		return nullState
 	}, 
-	// Set436
+	// Set515
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 464 
+			return 544 
 		}
 		return nullState
 	}, 
-	// Set437
+	// Set516
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 465 
+			return 545 
 		}
 		return nullState
 	}, 
-	// Set438
+	// Set517
 	func(r rune) state {
 		switch { 
 		case r == 'd':
-			return 466 
+			return 546 
 		}
 		return nullState
 	}, 
-	// Set439
+	// Set518
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 467 
+			return 547 
 		}
 		return nullState
 	}, 
-	// Set440
+	// Set519
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 468 
+			return 548 
 		}
 		return nullState
 	}, 
-	// Set441
+	// Set520
 	func(r rune) state {
 		switch { 
 		case r == 'u':
-			return 469 
+			return 549 
 		}
 		return nullState
 	}, 
-	// Set442
+	// Set521
 	func(r rune) state {
 		switch { 
 		case r == 'h':
-			return 470 
+			return 550 
 		}
 		return nullState
 	}, 
-	// Set443
+	// Set522
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 471 
+			return 551 
 		}
 		return nullState
 	}, 
-	// Set444
+	// Set523
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 472 
+			return 552 
 		}
 		return nullState
 	}, 
-	// Set445
+	// Set524
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 473 
+			return 553 
 		}
 		return nullState
 	}, 
-	// Set446
+	// Set525
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 474 
+			return 554 
 		}
 		return nullState
 	}, 
-	// Set447
+	// Set526
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 475 
+			return 555 
 		}
 		return nullState
 	}, 
-	// Set448
+	// Set527
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 476 
+			return 556 
 		}
 		return nullState
 	}, 
-	// Set449
+	// Set528
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 477 
+			return 557 
 		}
 		return nullState
 	}, 
-	// Set450
+	// Set529
 	func(r rune) state {
 		switch { 
 		case r == 'C':
-			return 478 
+			return 558 
 		}
 		return nullState
 	}, 
-	// Set451
+	// Set530
 	func(r rune) state {
 		switch { 
 		case r == 'M':
-			return 479 
+			return 559 
 		}
 		return nullState
 	}, 
-	// Set452
+	// Set531
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 480 
+			return 560 
 		}
 		return nullState
 	}, 
-	// Set453
+	// Set532
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 481 
+			return 561 
 		}
 		return nullState
 	}, 
-	// Set454
+	// Set533
 	func(r rune) state {
 		switch { 
 		case r == 'd':
-			return 482 
+			return 562 
 		}
 		return nullState
 	}, 
-	// Set455
+	// Set534
 	func(r rune) state {
 		switch { 
 		case r == 'u':
-			return 483 
+			return 563 
 		}
 		return nullState
 	}, 
-	// Set456
+	// Set535
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 484 
+			return 564 
 		}
 		return nullState
 	}, 
-	// Set457
+	// Set536
 	func(r rune) state {
 		switch { 
 		case r == 'S':
-			return 485 
+			return 565 
 		}
 		return nullState
 	}, 
-	// Set458
+	// Set537
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 486 
+			return 566 
 		}
 		return nullState
 	}, 
-	// Set459
+	// Set538
 	func(r rune) state {
 		switch { 
+		case r == '_':
+			return 40 
 		case r == 'i':
-			return 487 
+			return 567 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
 		}
 		return nullState
 	}, 
-	// Set460
+	// Set539
+	func(r rune) state {
+		switch { 
+		case r == 'i':
+			return 568 
+		}
+		return nullState
+	}, 
+	// Set540
 	func(r rune) state {
 		switch { 
 		case r == 'l':
-			return 488 
+			return 569 
 		}
 		return nullState
 	}, 
-	// Set461
+	// Set541
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set462
+	// Set542
 	func(r rune) state {
 		switch { 
 		case r == 'O':
-			return 489 
+			return 570 
 		}
 		return nullState
 	}, 
-	// Set463
+	// Set543
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 490 
+			return 571 
 		}
 		return nullState
 	}, 
-	// Set464
+	// Set544
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 491 
+			return 572 
 		}
 		return nullState
 	}, 
-	// Set465
+	// Set545
 	func(r rune) state {
 		switch { 
 		case r == 'l':
-			return 492 
+			return 573 
 		}
 		return nullState
 	}, 
-	// Set466
+	// Set546
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 493 
+			return 574 
 		}
 		return nullState
 	}, 
-	// Set467
+	// Set547
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 494 
+			return 575 
 		}
 		return nullState
 	}, 
-	// Set468
+	// Set548
 	func(r rune) state {
 		switch { 
 		case r == 'b':
-			return 495 
+			return 576 
 		}
 		return nullState
 	}, 
-	// Set469
+	// Set549
 	func(r rune) state {
 		switch { 
 		case r == 'l':
-			return 496 
+			return 577 
 		}
 		return nullState
 	}, 
-	// Set470
+	// Set550
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 497 
+			return 578 
 		}
 		return nullState
 	}, 
-	// Set471
+	// Set551
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 498 
+			return 579 
 		}
 		return nullState
 	}, 
-	// Set472
+	// Set552
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 499 
+			return 580 
 		}
 		return nullState
 	}, 
-	// Set473
+	// Set553
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 500 
+			return 581 
 		}
 		return nullState
 	}, 
-	// Set474
+	// Set554
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set475
+	// Set555
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 501 
+			return 582 
 		}
 		return nullState
 	}, 
-	// Set476
+	// Set556
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 502 
+			return 583 
 		}
 		return nullState
 	}, 
-	// Set477
+	// Set557
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 503 
+			return 584 
 		}
 		return nullState
 	}, 
-	// Set478
+	// Set558
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 504 
+			return 585 
 		}
 		return nullState
 	}, 
-	// Set479
+	// Set559
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 505 
+			return 586 
 		}
 		return nullState
 	}, 
-	// Set480
+	// Set560
 	func(r rune) state {
 		switch { 
 		case r == 'd':
-			return 506 
+			return 587 
 		}
 		return nullState
 	}, 
-	// Set481
+	// Set561
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 507 
+			return 588 
 		}
 		return nullState
 	}, 
-	// Set482
+	// Set562
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 508 
+			return 589 
 		}
 		return nullState
 	}, 
-	// Set483
+	// Set563
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 509 
+			return 590 
 		}
 		return nullState
 	}, 
-	// Set484
+	// Set564
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 510 
+			return 591 
 		}
 		return nullState
 	}, 
-	// Set485
+	// Set565
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 511 
+			return 592 
 		}
 		return nullState
 	}, 
-	// Set486
+	// Set566
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 512 
+			return 593 
 		}
 		return nullState
 	}, 
-	// Set487
+	// Set567
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case r == 'v':
+			return 594 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set568
 	func(r rune) state {
 		switch { 
 		case r == 'g':
-			return 513 
+			return 595 
 		}
 		return nullState
 	}, 
-	// Set488
+	// Set569
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 514 
+			return 596 
 		}
 		return nullState
 	}, 
-	// Set489
+	// Set570
 	func(r rune) state {
 		switch { 
 		case r == 'p':
-			return 515 
+			return 597 
 		}
 		return nullState
 	}, 
-	// Set490
+	// Set571
 	func(r rune) state {
 		switch { 
 		case r == 'O':
-			return 516 
+			return 598 
 		}
 		return nullState
 	}, 
-	// Set491
+	// Set572
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set492
+	// Set573
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 517 
+			return 599 
 		}
 		return nullState
 	}, 
-	// Set493
+	// Set574
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 518 
+			return 600 
 		}
 		return nullState
 	}, 
-	// Set494
+	// Set575
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 519 
+			return 601 
 		}
 		return nullState
 	}, 
-	// Set495
+	// Set576
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 520 
+			return 602 
 		}
 		return nullState
 	}, 
-	// Set496
+	// Set577
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 521 
+			return 603 
 		}
 		return nullState
 	}, 
-	// Set497
+	// Set578
 	func(r rune) state {
 		switch { 
 		case r == 'm':
-			return 522 
+			return 604 
 		}
 		return nullState
 	}, 
-	// Set498
+	// Set579
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 523 
+			return 605 
 		}
 		return nullState
 	}, 
-	// Set499
+	// Set580
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 524 
+			return 606 
 		}
 		return nullState
 	}, 
-	// Set500
+	// Set581
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 525 
+			return 607 
 		}
 		return nullState
 	}, 
-	// Set501
+	// Set582
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 526 
+			return 608 
 		}
 		return nullState
 	}, 
-	// Set502
+	// Set583
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 527 
+			return 609 
 		}
 		return nullState
 	}, 
-	// Set503
+	// Set584
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 528 
+			return 610 
 		}
 		return nullState
 	}, 
-	// Set504
+	// Set585
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 529 
+			return 611 
 		}
 		return nullState
 	}, 
-	// Set505
+	// Set586
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 530 
+			return 612 
 		}
 		return nullState
 	}, 
-	// Set506
+	// Set587
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 531 
+			return 613 
 		}
 		return nullState
 	}, 
-	// Set507
+	// Set588
 	func(r rune) state {
 		switch { 
 		case r == 'm':
-			return 532 
+			return 614 
 		}
 		return nullState
 	}, 
-	// Set508
+	// Set589
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set509
+	// Set590
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 533 
+			return 615 
 		}
 		return nullState
 	}, 
-	// Set510
+	// Set591
 	func(r rune) state {
 		switch { 
 		case r == 'g':
-			return 534 
+			return 616 
 		}
 		return nullState
 	}, 
-	// Set511
+	// Set592
 	func(r rune) state {
 		switch { 
 		case r == 'l':
-			return 535 
+			return 617 
 		}
 		return nullState
 	}, 
-	// Set512
+	// Set593
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set513
+	// Set594
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case r == 'e':
+			return 618 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set595
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 536 
+			return 619 
 		}
 		return nullState
 	}, 
-	// Set514
+	// Set596
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set515
+	// Set597
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 537 
+			return 620 
 		}
 		return nullState
 	}, 
-	// Set516
+	// Set598
 	func(r rune) state {
 		switch { 
 		case r == 'p':
-			return 538 
+			return 621 
 		}
 		return nullState
 	}, 
-	// Set517
+	// Set599
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set518
+	// Set600
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 539 
+			return 622 
 		}
 		return nullState
 	}, 
-	// Set519
+	// Set601
 	func(r rune) state {
 		switch { 
 		case r == 'C':
-			return 540 
+			return 623 
 		}
 		return nullState
 	}, 
-	// Set520
+	// Set602
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 541 
+			return 624 
 		}
 		return nullState
 	}, 
-	// Set521
+	// Set603
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 542 
+			return 625 
 		}
 		return nullState
 	}, 
-	// Set522
+	// Set604
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 543 
+			return 626 
 		}
 		return nullState
 	}, 
-	// Set523
+	// Set605
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 544 
+			return 627 
 		}
 		return nullState
 	}, 
-	// Set524
+	// Set606
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 545 
+			return 628 
 		}
 		return nullState
 	}, 
-	// Set525
+	// Set607
 	func(r rune) state {
 		switch { 
 		case r == 's':
-			return 546 
+			return 629 
 		}
 		return nullState
 	}, 
-	// Set526
+	// Set608
 	func(r rune) state {
 		switch { 
 		case r == 's':
-			return 547 
+			return 630 
 		}
 		return nullState
 	}, 
-	// Set527
+	// Set609
 	func(r rune) state {
 		switch { 
 		case r == 'x':
-			return 548 
+			return 631 
 		}
 		return nullState
 	}, 
-	// Set528
+	// Set610
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 549 
+			return 632 
 		}
 		return nullState
 	}, 
-	// Set529
+	// Set611
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 550 
+			return 633 
 		}
 		return nullState
 	}, 
-	// Set530
+	// Set612
 	func(r rune) state {
 		switch { 
 		case r == 'k':
-			return 551 
+			return 634 
 		}
 		return nullState
 	}, 
-	// Set531
+	// Set613
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 552 
+			return 635 
 		}
 		return nullState
 	}, 
-	// Set532
+	// Set614
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 553 
+			return 636 
 		}
 		return nullState
 	}, 
-	// Set533
+	// Set615
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 554 
+			return 637 
 		}
 		return nullState
 	}, 
-	// Set534
+	// Set616
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 555 
+			return 638 
 		}
 		return nullState
 	}, 
-	// Set535
+	// Set617
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 556 
+			return 639 
 		}
 		return nullState
 	}, 
-	// Set536
+	// Set618
+	func(r rune) state {
+		switch { 
+		case r == '_':
+			return 40 
+		case unicode.IsLetter(r):
+			return 40 
+		case unicode.IsNumber(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set619
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 557 
+			return 640 
 		}
 		return nullState
 	}, 
-	// Set537
+	// Set620
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 558 
+			return 641 
 		}
 		return nullState
 	}, 
-	// Set538
+	// Set621
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 559 
+			return 642 
 		}
 		return nullState
 	}, 
-	// Set539
+	// Set622
 	func(r rune) state {
 		switch { 
 		case r == 'E':
-			return 560 
+			return 643 
 		}
 		return nullState
 	}, 
-	// Set540
+	// Set623
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 561 
+			return 644 
 		}
 		return nullState
 	}, 
-	// Set541
+	// Set624
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 562 
+			return 645 
 		}
 		return nullState
 	}, 
-	// Set542
+	// Set625
 	func(r rune) state {
 		switch { 
 		case r == 'I':
-			return 563 
+			return 646 
 		}
 		return nullState
 	}, 
-	// Set543
+	// Set626
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 564 
+			return 647 
 		}
 		return nullState
 	}, 
-	// Set544
+	// Set627
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 565 
+			return 648 
 		}
 		return nullState
 	}, 
-	// Set545
+	// Set628
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 566 
+			return 649 
 		}
 		return nullState
 	}, 
-	// Set546
+	// Set629
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 567 
+			return 650 
 		}
 		return nullState
 	}, 
-	// Set547
+	// Set630
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 568 
+			return 651 
 		}
 		return nullState
 	}, 
-	// Set548
+	// Set631
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 569 
+			return 652 
 		}
 		return nullState
 	}, 
-	// Set549
+	// Set632
 	func(r rune) state {
 		switch { 
 		case r == 'S':
-			return 570 
+			return 653 
 		}
 		return nullState
 	}, 
-	// Set550
+	// Set633
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 571 
+			return 654 
 		}
 		return nullState
 	}, 
-	// Set551
+	// Set634
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 572 
+			return 655 
 		}
 		return nullState
 	}, 
-	// Set552
+	// Set635
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 573 
+			return 656 
 		}
 		return nullState
 	}, 
-	// Set553
+	// Set636
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 574 
+			return 657 
 		}
 		return nullState
 	}, 
-	// Set554
+	// Set637
 	func(r rune) state {
 		switch { 
 		case r == 'u':
-			return 575 
+			return 658 
 		}
 		return nullState
 	}, 
-	// Set555
+	// Set638
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 576 
+			return 659 
 		}
 		return nullState
 	}, 
-	// Set556
+	// Set639
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 577 
+			return 660 
 		}
 		return nullState
 	}, 
-	// Set557
+	// Set640
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 578 
+			return 661 
 		}
 		return nullState
 	}, 
-	// Set558
+	// Set641
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 579 
+			return 662 
 		}
 		return nullState
 	}, 
-	// Set559
+	// Set642
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 580 
+			return 663 
 		}
 		return nullState
 	}, 
-	// Set560
+	// Set643
 	func(r rune) state {
 		switch { 
 		case r == 'x':
-			return 581 
+			return 664 
 		}
 		return nullState
 	}, 
-	// Set561
+	// Set644
 	func(r rune) state {
 		switch { 
 		case r == 'd':
-			return 582 
+			return 665 
 		}
 		return nullState
 	}, 
-	// Set562
+	// Set645
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 583 
+			return 666 
 		}
 		return nullState
 	}, 
-	// Set563
+	// Set646
 	func(r rune) state {
 		switch { 
 		case r == 'g':
-			return 584 
+			return 667 
 		}
 		return nullState
 	}, 
-	// Set564
+	// Set647
 	func(r rune) state {
 		switch { 
 		case r == 'E':
-			return 585 
+			return 668 
 		}
 		return nullState
 	}, 
-	// Set565
+	// Set648
 	func(r rune) state {
 		switch { 
 		case r == 'u':
-			return 586 
+			return 669 
 		}
 		return nullState
 	}, 
-	// Set566
+	// Set649
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set567
+	// Set650
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 587 
+			return 670 
 		}
 		return nullState
 	}, 
-	// Set568
+	// Set651
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 588 
+			return 671 
 		}
 		return nullState
 	}, 
-	// Set569
+	// Set652
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set570
+	// Set653
 	func(r rune) state {
 		switch { 
 		case r == 'p':
-			return 589 
+			return 672 
 		}
 		return nullState
 	}, 
-	// Set571
+	// Set654
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 590 
+			return 673 
 		}
 		return nullState
 	}, 
-	// Set572
+	// Set655
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set573
+	// Set656
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 591 
+			return 674 
 		}
 		return nullState
 	}, 
-	// Set574
+	// Set657
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 592 
+			return 675 
 		}
 		return nullState
 	}, 
-	// Set575
+	// Set658
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 593 
+			return 676 
 		}
 		return nullState
 	}, 
-	// Set576
+	// Set659
 	func(r rune) state {
 		switch { 
 		case r == 'p':
-			return 594 
+			return 677 
 		}
 		return nullState
 	}, 
-	// Set577
+	// Set660
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 595 
+			return 678 
 		}
 		return nullState
 	}, 
-	// Set578
+	// Set661
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set579
+	// Set662
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 596 
+			return 679 
 		}
 		return nullState
 	}, 
-	// Set580
+	// Set663
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 597 
+			return 680 
 		}
 		return nullState
 	}, 
-	// Set581
+	// Set664
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 598 
+			return 681 
 		}
 		return nullState
 	}, 
-	// Set582
+	// Set665
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 599 
+			return 682 
 		}
 		return nullState
 	}, 
-	// Set583
+	// Set666
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 600 
+			return 683 
 		}
 		return nullState
 	}, 
-	// Set584
+	// Set667
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 601 
+			return 684 
 		}
 		return nullState
 	}, 
-	// Set585
+	// Set668
 	func(r rune) state {
 		switch { 
 		case r == 'x':
-			return 602 
+			return 685 
 		}
 		return nullState
 	}, 
-	// Set586
+	// Set669
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 603 
+			return 686 
 		}
 		return nullState
 	}, 
-	// Set587
+	// Set670
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set588
+	// Set671
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set589
+	// Set672
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 604 
+			return 687 
 		}
 		return nullState
 	}, 
-	// Set590
+	// Set673
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 605 
+			return 688 
 		}
 		return nullState
 	}, 
-	// Set591
+	// Set674
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 606 
+			return 689 
 		}
 		return nullState
 	}, 
-	// Set592
+	// Set675
 	func(r rune) state {
 		switch { 
 		case r == 'l':
-			return 607 
+			return 690 
 		}
 		return nullState
 	}, 
-	// Set593
+	// Set676
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 608 
+			return 691 
 		}
 		return nullState
 	}, 
-	// Set594
+	// Set677
 	func(r rune) state {
 		switch { 
 		case r == 'h':
-			return 609 
+			return 692 
 		}
 		return nullState
 	}, 
-	// Set595
+	// Set678
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 610 
+			return 693 
 		}
 		return nullState
 	}, 
-	// Set596
+	// Set679
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 611 
+			return 694 
 		}
 		return nullState
 	}, 
-	// Set597
+	// Set680
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 612 
+			return 695 
 		}
 		return nullState
 	}, 
-	// Set598
+	// Set681
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 613 
+			return 696 
 		}
 		return nullState
 	}, 
-	// Set599
+	// Set682
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 614 
+			return 697 
 		}
 		return nullState
 	}, 
-	// Set600
+	// Set683
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set601
+	// Set684
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 615 
+			return 698 
 		}
 		return nullState
 	}, 
-	// Set602
+	// Set685
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 616 
+			return 699 
 		}
 		return nullState
 	}, 
-	// Set603
+	// Set686
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 617 
+			return 700 
 		}
 		return nullState
 	}, 
-	// Set604
+	// Set687
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 618 
+			return 701 
 		}
 		return nullState
 	}, 
-	// Set605
+	// Set688
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 619 
+			return 702 
 		}
 		return nullState
 	}, 
-	// Set606
+	// Set689
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 620 
+			return 703 
 		}
 		return nullState
 	}, 
-	// Set607
+	// Set690
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 621 
+			return 704 
 		}
 		return nullState
 	}, 
-	// Set608
+	// Set691
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 622 
+			return 705 
 		}
 		return nullState
 	}, 
-	// Set609
+	// Set692
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 623 
+			return 706 
 		}
 		return nullState
 	}, 
-	// Set610
+	// Set693
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 624 
+			return 707 
 		}
 		return nullState
 	}, 
-	// Set611
+	// Set694
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 625 
+			return 708 
 		}
 		return nullState
 	}, 
-	// Set612
+	// Set695
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 626 
+			return 709 
 		}
 		return nullState
 	}, 
-	// Set613
+	// Set696
 	func(r rune) state {
 		switch { 
 		case r == 'p':
-			return 627 
+			return 710 
 		}
 		return nullState
 	}, 
-	// Set614
+	// Set697
 	func(r rune) state {
 		switch { 
 		case r == 'P':
-			return 628 
+			return 711 
 		}
 		return nullState
 	}, 
-	// Set615
+	// Set698
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 629 
+			return 712 
 		}
 		return nullState
 	}, 
-	// Set616
+	// Set699
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 630 
+			return 713 
 		}
 		return nullState
 	}, 
-	// Set617
+	// Set700
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set618
+	// Set701
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 631 
+			return 714 
 		}
 		return nullState
 	}, 
-	// Set619
+	// Set702
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 632 
+			return 715 
 		}
 		return nullState
 	}, 
-	// Set620
+	// Set703
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 633 
+			return 716 
 		}
 		return nullState
 	}, 
-	// Set621
+	// Set704
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set622
+	// Set705
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 634 
+			return 717 
 		}
 		return nullState
 	}, 
-	// Set623
+	// Set706
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set624
+	// Set707
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 635 
+			return 718 
 		}
 		return nullState
 	}, 
-	// Set625
+	// Set708
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 636 
+			return 719 
 		}
 		return nullState
 	}, 
-	// Set626
+	// Set709
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 637 
+			return 720 
 		}
 		return nullState
 	}, 
-	// Set627
+	// Set710
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 638 
+			return 721 
 		}
 		return nullState
 	}, 
-	// Set628
+	// Set711
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 639 
+			return 722 
 		}
 		return nullState
 	}, 
-	// Set629
+	// Set712
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 640 
+			return 723 
 		}
 		return nullState
 	}, 
-	// Set630
+	// Set713
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 641 
+			return 724 
 		}
 		return nullState
 	}, 
-	// Set631
+	// Set714
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 642 
+			return 725 
 		}
 		return nullState
 	}, 
-	// Set632
+	// Set715
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 643 
+			return 726 
 		}
 		return nullState
 	}, 
-	// Set633
+	// Set716
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set634
+	// Set717
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 644 
+			return 727 
 		}
 		return nullState
 	}, 
-	// Set635
+	// Set718
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set636
+	// Set719
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set637
+	// Set720
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 645 
+			return 728 
 		}
 		return nullState
 	}, 
-	// Set638
+	// Set721
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 646 
+			return 729 
 		}
 		return nullState
 	}, 
-	// Set639
+	// Set722
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 647 
+			return 730 
 		}
 		return nullState
 	}, 
-	// Set640
+	// Set723
 	func(r rune) state {
 		switch { 
 		case r == 'b':
-			return 648 
+			return 731 
 		}
 		return nullState
 	}, 
-	// Set641
+	// Set724
 	func(r rune) state {
 		switch { 
 		case r == 'd':
-			return 649 
+			return 732 
 		}
 		return nullState
 	}, 
-	// Set642
+	// Set725
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set643
+	// Set726
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 650 
+			return 733 
 		}
 		return nullState
 	}, 
-	// Set644
+	// Set727
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 651 
+			return 734 
 		}
 		return nullState
 	}, 
-	// Set645
+	// Set728
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set646
+	// Set729
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 652 
+			return 735 
 		}
 		return nullState
 	}, 
-	// Set647
+	// Set730
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 653 
+			return 736 
 		}
 		return nullState
 	}, 
-	// Set648
+	// Set731
 	func(r rune) state {
 		switch { 
 		case r == 'l':
-			return 654 
+			return 737 
 		}
 		return nullState
 	}, 
-	// Set649
+	// Set732
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 655 
+			return 738 
 		}
 		return nullState
 	}, 
-	// Set650
+	// Set733
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 656 
+			return 739 
 		}
 		return nullState
 	}, 
-	// Set651
+	// Set734
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set652
+	// Set735
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 657 
+			return 740 
 		}
 		return nullState
 	}, 
-	// Set653
+	// Set736
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 658 
+			return 741 
 		}
 		return nullState
 	}, 
-	// Set654
+	// Set737
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 659 
+			return 742 
 		}
 		return nullState
 	}, 
-	// Set655
+	// Set738
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set656
+	// Set739
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 660 
+			return 743 
 		}
 		return nullState
 	}, 
-	// Set657
+	// Set740
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 661 
+			return 744 
 		}
 		return nullState
 	}, 
-	// Set658
+	// Set741
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 662 
+			return 745 
 		}
 		return nullState
 	}, 
-	// Set659
+	// Set742
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 663 
+			return 746 
 		}
 		return nullState
 	}, 
-	// Set660
+	// Set743
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 664 
+			return 747 
 		}
 		return nullState
 	}, 
-	// Set661
+	// Set744
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set662
+	// Set745
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set663
+	// Set746
 	func(r rune) state {
 		switch { 
 		case r == 'C':
-			return 665 
+			return 748 
 		}
 		return nullState
 	}, 
-	// Set664
+	// Set747
 	func(r rune) state {
 		switch { 
 		case r == 'M':
-			return 666 
+			return 749 
 		}
 		return nullState
 	}, 
-	// Set665
+	// Set748
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 667 
+			return 750 
 		}
 		return nullState
 	}, 
-	// Set666
+	// Set749
 	func(r rune) state {
 		switch { 
 		case r == 'a':
-			return 668 
+			return 751 
 		}
 		return nullState
 	}, 
-	// Set667
+	// Set750
 	func(r rune) state {
 		switch { 
 		case r == 'd':
-			return 669 
+			return 752 
 		}
 		return nullState
 	}, 
-	// Set668
+	// Set751
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 670 
+			return 753 
 		}
 		return nullState
 	}, 
-	// Set669
+	// Set752
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 671 
+			return 754 
 		}
 		return nullState
 	}, 
-	// Set670
+	// Set753
 	func(r rune) state {
 		switch { 
 		case r == 'k':
-			return 672 
+			return 755 
 		}
 		return nullState
 	}, 
-	// Set671
+	// Set754
 	func(r rune) state {
 		switch { 
 		case r == '_':
-			return 673 
+			return 756 
 		}
 		return nullState
 	}, 
-	// Set672
+	// Set755
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 674 
+			return 757 
 		}
 		return nullState
 	}, 
-	// Set673
+	// Set756
 	func(r rune) state {
 		switch { 
 		case r == 'P':
-			return 675 
+			return 758 
 		}
 		return nullState
 	}, 
-	// Set674
+	// Set757
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set675
+	// Set758
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 676 
+			return 759 
 		}
 		return nullState
 	}, 
-	// Set676
+	// Set759
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 677 
+			return 760 
 		}
 		return nullState
 	}, 
-	// Set677
+	// Set760
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 678 
+			return 761 
 		}
 		return nullState
 	}, 
-	// Set678
+	// Set761
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 679 
+			return 762 
 		}
 		return nullState
 	}, 
-	// Set679
+	// Set762
 	func(r rune) state {
 		switch { 
 		case r == '}':
-			return 680 
+			return 763 
 		}
 		return nullState
 	}, 
-	// Set680
+	// Set763
 	func(r rune) state {
 		switch { 
 		}
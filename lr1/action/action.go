@@ -13,17 +13,20 @@ One entry for each state. Each entry is a map of symbol -> action
 type Actions []map[string]Action
 
 /*
+numStarts is the number of augmented start productions, which are the first
+basic productions.
+
 Returns:
 conflicts - for each state a list of conflict lists. Each conflict list contains a list of conflicts for each
 conflicted symbol in the state.
 */
-func GetActions(states *states.States) (actions Actions, conflicts [][]*Conflict) {
+func GetActions(states *states.States, numStarts int) (actions Actions, conflicts [][]*Conflict) {
 	actions = make(Actions, len(states.List))
 	conflicts = make([][]*Conflict, len(states.List))
 	for si, state := range states.List {
 		actions[si] = make(map[string]Action)
 		for _, sym := range symbols.GetTerminalSymbols() {
-			act, cnf := stateAction(state, sym)
+			act, cnf := stateAction(state, sym, numStarts)
 			actions[si][sym] = act
 			if cnf != nil {
 				conflicts[si] = append(conflicts[si], cnf)
@@ -33,12 +36,48 @@ func GetActions(states *states.States) (actions Actions, conflicts [][]*Conflict
 	return
 }
 
-func stateAction(state *states.State, nextSym string) (action Action, conflict *Conflict) {
+/*
+GetAllActions returns for each state and terminal symbol all the actions of
+the state, including conflicting actions. A shift action is first, followed by
+the reduce and accept actions in the order of the config groups of the state.
+*/
+func GetAllActions(states *states.States, numStarts int) (actions []map[string][]Action) {
+	actions = make([]map[string][]Action, len(states.List))
+	for si, state := range states.List {
+		actions[si] = make(map[string][]Action)
+		for _, sym := range symbols.GetTerminalSymbols() {
+			var acts []Action
+			if nextState := state.Transitions.Transition(sym); nextState != nil {
+				acts = append(acts, Shift(nextState.Number))
+			}
+			for _, cfgrp := range state.ConfigGroups().List() {
+				if act := configGroupAction(cfgrp, sym, numStarts); act != nil && !contain(acts, act) {
+					acts = append(acts, act)
+				}
+			}
+			if len(acts) > 0 {
+				actions[si][sym] = acts
+			}
+		}
+	}
+	return
+}
+
+func contain(actions []Action, action Action) bool {
+	for _, act := range actions {
+		if act.Equal(action) {
+			return true
+		}
+	}
+	return false
+}
+
+func stateAction(state *states.State, nextSym string, numStarts int) (action Action, conflict *Conflict) {
 	if nextState := state.Transitions.Transition(nextSym); nextState != nil {
 		action = Shift(nextState.Number)
 	}
 	for _, cfgrp := range state.ConfigGroups().List() {
-		if act1 := configGroupAction(cfgrp, nextSym); act1 != nil {
+		if act1 := configGroupAction(cfgrp, nextSym, numStarts); act1 != nil {
 			switch {
 			case action == nil:
 				action = act1
@@ -51,10 +90,10 @@ func stateAction(state *states.State, nextSym string) (action Action, conflict *
 	return
 }
 
-func configGroupAction(cfgrp *states.ConfigGroup, nextSym string) (action Action) {
+func configGroupAction(cfgrp *states.ConfigGroup, nextSym string, numStarts int) (action Action) {
 	if cfgrp.Item.Reduce() {
 		if cfgrp.ContextSet.Contain[nextSym] {
-			if cfgrp.Item.BasicProdIdx == 0 {
+			if cfgrp.Item.BasicProdIdx < numStarts {
 				action = ACCEPT
 			} else {
 				action = Reduce(cfgrp.Item.BasicProdIdx)
@@ -13,14 +13,27 @@ type Production struct {
 	Body      *ast.SyntaxAlternate
 }
 
+// Get returns the basic productions of rules with the augmented start
+// production G0 : S, where S is the head of the first rule.
 func Get(rules []*ast.SyntaxRule) (prods []*Production) {
-	prods = append(prods,
-		&Production{
-			Head: "G0",
-			Body: &ast.SyntaxAlternate{
-				Symbols: []ast.SyntaxSymbol{rules[0].Head},
-			},
-		})
+	return GetFrom(rules, []string{rules[0].Head.ID()})
+}
+
+/*
+GetFrom returns the basic productions of rules with an augmented start
+production for each of the start symbols: G0 : S0, G1 : S1, ... The augmented
+start productions are the first productions returned.
+*/
+func GetFrom(rules []*ast.SyntaxRule, starts []string) (prods []*Production) {
+	for i, start := range starts {
+		prods = append(prods,
+			&Production{
+				Head: StartHead(i),
+				Body: &ast.SyntaxAlternate{
+					Symbols: []ast.SyntaxSymbol{getRule(rules, start).Head},
+				},
+			})
+	}
 	for _, r := range rules {
 		for i, alt := range r.Alternates {
 			prods = append(prods,
@@ -34,8 +47,40 @@ func Get(rules []*ast.SyntaxRule) (prods []*Production) {
 	return prods
 }
 
+// StartSymbols returns the start symbols of the augmented start productions
+// of prods. StartSymbols[i] is the start symbol of start state i.
+func StartSymbols(prods []*Production) (starts []string) {
+	for i, prod := range prods {
+		if prod.Head != StartHead(i) {
+			break
+		}
+		starts = append(starts, prod.Body.Symbols[0].ID())
+	}
+	return
+}
+
+// ID returns the ID of the reduce function of p. See ast.AlternateID.
+func (p *Production) ID() string {
+	return ast.AlternateID(p.Head, p.Alternate, p.Body)
+}
+
 func (p *Production) String() string {
 	return fmt.Sprintf("%s : %s ;",
 		p.Head,
 		strings.Join(p.Body.GetSymbols(), " "))
 }
+
+// StartHead returns the head of the augmented start production of start
+// symbol i
+func StartHead(i int) string {
+	return fmt.Sprintf("G%d", i)
+}
+
+func getRule(rules []*ast.SyntaxRule, nt string) *ast.SyntaxRule {
+	for _, r := range rules {
+		if r.Head.ID() == nt {
+			return r
+		}
+	}
+	panic(fmt.Sprintf("no syntax rule %s", nt))
+}
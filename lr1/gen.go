@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"sort"
+	"strings"
 
 	"github.com/goccmack/gogll/v3/ast"
 	"github.com/goccmack/gogll/v3/cfg"
@@ -24,30 +26,115 @@ import (
 )
 
 func Gen(g *ast.GoGLL) ([]*basicprod.Production, *states.States, action.Actions) {
+	prods, items, states := getStates(g)
+
+	actions, conflicts := action.GetActions(states, len(g.StartSymbols()))
+	handleConflicts(conflicts, states)
+
+	if cfg.Verbose {
+		ioutil.WriteFile(path.Join(cfg.BaseDir, "CFG_items.txt"), []byte(items.String()))
+		ioutil.WriteFile(path.Join(cfg.BaseDir, "LR1_states.txt"), statesString(states, actions))
+	}
+
+	return prods, states, actions
+}
+
+/*
+GenGLR returns the basic productions, the LR(1) states and, for each state,
+all the actions of the state for the GLR parser. LR(1) conflicts are not
+errors: the GLR parser follows all the conflicting actions. With -v the
+conflicts are written to LR1_conflicts.txt.
+*/
+func GenGLR(g *ast.GoGLL) ([]*basicprod.Production, *states.States, []map[string][]action.Action) {
+	prods, items, states := getStates(g)
+
+	actions := action.GetAllActions(states, len(g.StartSymbols()))
+
+	if cfg.Verbose {
+		conflicts := glrConflicts(states, actions)
+		if numConflicts(conflicts) > 0 {
+			fmt.Printf("%d LR(1) conflicts. See LR1_conflicts.txt\n", numConflicts(conflicts))
+			writeConflicts(conflicts)
+		}
+		ioutil.WriteFile(path.Join(cfg.BaseDir, "CFG_items.txt"), []byte(items.String()))
+		ioutil.WriteFile(path.Join(cfg.BaseDir, "LR1_states.txt"), glrStatesString(states, actions))
+	}
+
+	return prods, states, actions
+}
+
+/*
+Conflicts returns the basic productions of g and the LR(1) conflicts of each
+state: of Pager's PGM states, or of Knuth's states with -knuth. Unlike Gen,
+Conflicts writes no files.
+*/
+func Conflicts(g *ast.GoGLL) ([]*basicprod.Production, [][]*action.Conflict) {
+	prods, _, states := buildStates(g)
+	_, conflicts := action.GetActions(states, len(g.StartSymbols()))
+	return prods, conflicts
+}
+
+/*
+ConflictRules returns the nonterminals of each LR(1) conflict of g: the heads
+of the productions reduced in the conflict and of the items of the conflicting
+state that shift the conflict symbol. The nonterminals of a conflict are
+sorted and every set of nonterminals is returned once. Like Conflicts,
+ConflictRules writes no files.
+*/
+func ConflictRules(g *ast.GoGLL) (rules [][]string) {
+	prods, _, states := buildStates(g)
+	_, conflicts := action.GetActions(states, len(g.StartSymbols()))
+	returned := map[string]bool{}
+	for si, scs := range conflicts {
+		for _, c := range scs {
+			if c == nil {
+				continue
+			}
+			heads := map[string]bool{}
+			for _, a := range c.Actions {
+				if r, ok := a.(action.Reduce); ok && g.GetSyntaxRule(prods[r].Head) != nil {
+					heads[prods[r].Head] = true
+				}
+			}
+			for _, cg := range states.List[si].ConfigGroups().List() {
+				if cg.Item.ExpectedSymbol() == c.Symbol && g.GetSyntaxRule(cg.Item.Id) != nil {
+					heads[cg.Item.Id] = true
+				}
+			}
+			var nts []string
+			for nt := range heads {
+				nts = append(nts, nt)
+			}
+			sort.Strings(nts)
+			if key := strings.Join(nts, " "); len(nts) > 0 && !returned[key] {
+				returned[key] = true
+				rules = append(rules, nts)
+			}
+		}
+	}
+	return
+}
+
+func getStates(g *ast.GoGLL) ([]*basicprod.Production, *items.Items, *states.States) {
 	removeOldFiles()
+	return buildStates(g)
+}
 
-	prods := basicprod.Get(g.SyntaxRules)
+func buildStates(g *ast.GoGLL) ([]*basicprod.Production, *items.Items, *states.States) {
+	starts := g.StartSymbols()
+	prods := basicprod.GetFrom(g.SyntaxRules, starts)
 	items := items.NewItems(prods)
 	smbls := symbols.GetSymbols()
 	first := first.New(prods)
 	var states *states.States
 	if *cfg.Knuth {
 		//TODO: remove symbols
-		states = knuth.States(smbls, items, first)
+		states = knuth.States(smbls, items, first, len(starts))
 	} else {
 		//TODO: remove symbols
-		states = pgm.States(smbls, items, first)
+		states = pgm.States(smbls, items, first, len(starts))
 	}
-
-	actions, conflicts := action.GetActions(states)
-	handleConflicts(conflicts, states)
-
-	if cfg.Verbose {
-		ioutil.WriteFile(path.Join(cfg.BaseDir, "CFG_items.txt"), []byte(items.String()))
-		ioutil.WriteFile(path.Join(cfg.BaseDir, "LR1_states.txt"), statesString(states, actions))
-	}
-
-	return prods, states, actions
+	return prods, items, states
 }
 
 func handleConflicts(conflicts [][]*action.Conflict, states *states.States) {
@@ -140,6 +227,34 @@ func statesString(states *states.States, actions action.Actions) []byte {
 	return w.Bytes()
 }
 
+// glrConflicts returns the conflicts of the GLR actions of each state
+func glrConflicts(states *states.States, actions []map[string][]action.Action) [][]*action.Conflict {
+	conflicts := make([][]*action.Conflict, len(states.List))
+	for si := range states.List {
+		for _, symT := range symbols.GetTerminalSymbols() {
+			if acts := actions[si][symT]; len(acts) > 1 {
+				conflicts[si] = append(conflicts[si], &action.Conflict{Symbol: symT, Actions: acts})
+			}
+		}
+	}
+	return conflicts
+}
+
+func glrStatesString(states *states.States, actions []map[string][]action.Action) []byte {
+	w := new(bytes.Buffer)
+	for si, state := range states.List {
+		fmt.Fprintf(w, "%s", state)
+		fmt.Fprintf(w, "Actions:\n")
+		for _, symT := range symbols.GetTerminalSymbols() {
+			for _, act := range actions[si][symT] {
+				fmt.Fprintf(w, "\t%s: %s\n", symT, act)
+			}
+		}
+		fmt.Fprintln(w)
+	}
+	return w.Bytes()
+}
+
 func removeOldFiles() {
 	os.Remove(path.Join(cfg.BaseDir, "basic_productions.txt"))
 	os.Remove(path.Join(cfg.BaseDir, "CFG_items.txt"))
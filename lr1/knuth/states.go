@@ -7,11 +7,11 @@ import (
 	"github.com/goccmack/gogll/v3/lr1/symbolsuccessors"
 )
 
-func States(symbols []string, lr0items *items.Items, first *first.First) *states.States {
+func States(symbols []string, lr0items *items.Items, first *first.First, numStarts int) *states.States {
 	s := &states.States{
 		List: make([]*states.State, 0, 64),
 	}
-	s.NewState0(symbols, lr0items, first, states.NewConfigGroup(lr0items.List[0], "$"))
+	s.NewStartStates(symbols, lr0items, first, numStarts)
 	symSuccessors := symbolsuccessors.NewSymbolSuccessors()
 	for si := 0; si < len(s.List); si++ {
 		st_trans := make([]states.Transition, 0, 4)
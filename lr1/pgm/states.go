@@ -7,13 +7,14 @@ import (
 	"github.com/goccmack/gogll/v3/lr1/symbolsuccessors"
 )
 
-func States(symbols []string, lr0items *items.Items, first *first.First) *states.States {
+func States(symbols []string, lr0items *items.Items, first *first.First, numStarts int) *states.States {
 	s := &states.States{
 		List: make([]*states.State, 0, 64),
 	}
-	s.NewState0(symbols, lr0items, first, states.NewConfigGroup(lr0items.List[0], "$"))
+	s.NewStartStates(symbols, lr0items, first, numStarts)
 	symSuccessors := symbolsuccessors.NewSymbolSuccessors()
 	for si := 0; si < len(s.List); si++ {
+		nucleus := s.List[si].Nucleus.Clone()
 		st_trans := make([]states.Transition, 0, 4)
 		for _, trans := range s.List[si].Next().List() {
 			merged := false
@@ -40,6 +41,12 @@ func States(symbols []string, lr0items *items.Items, first *first.First) *states
 			}
 		}
 		s.List[si].Transitions = states.NewTransitionsList(st_trans, symbols)
+		// A successor merged into state si, e.g.: by a transition on a symbol
+		// deriving the empty string, adds context to si after its successors
+		// were computed. Recompute them.
+		if s.List[si].Nucleus.ContextDiff(nucleus).Len() > 0 {
+			si--
+		}
 	}
 	// s.GenActions()
 	return s
@@ -91,7 +98,15 @@ func propagateContext(this *states.State, expandedNucleusCfGrps *states.ConfigGr
 		sym, nextState := t.Sym, t.State
 		newNucleus := makeContext(expandedNucleusCfGrps, nextState.Nucleus)
 		if weaklyCompatibleCfgGrpSet(nextState.Nucleus, newNucleus) {
+			oldNucleus := nextState.Nucleus.Clone()
 			mergeContext(nextState, newNucleus)
+			// Propagate the new context to the successors of nextState
+			if cfgDiff := nextState.Nucleus.ContextDiff(oldNucleus); cfgDiff.Len() > 0 {
+				cfgDiff.Add(nextState.NonNucleus.List()...)
+				for sym, states := range propagateContext(nextState, cfgDiff) {
+					newStates[sym] = append(newStates[sym], states...)
+				}
+			}
 		} else {
 			newState := this.NextSymbol(sym)
 			newState.Number = -nextState.Number
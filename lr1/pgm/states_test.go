@@ -0,0 +1,64 @@
+package pgm
+
+import (
+	"testing"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/lexer"
+	"github.com/goccmack/gogll/v3/lr1/basicprod"
+	"github.com/goccmack/gogll/v3/lr1/first"
+	"github.com/goccmack/gogll/v3/lr1/items"
+	"github.com/goccmack/gogll/v3/lr1/knuth"
+	"github.com/goccmack/gogll/v3/lr1/states"
+	"github.com/goccmack/gogll/v3/parser"
+	"github.com/goccmack/gogll/v3/symbols"
+)
+
+/*
+TestMergedContext checks that every Knuth LR(1) state has a PGM state with
+the same core and at least the same lookaheads. A derives the empty string,
+so states get new lookaheads from merged successors after their own
+successors were computed, e.g.: "b" of S : A S •"b".
+*/
+func TestMergedContext(t *testing.T) {
+	lex := lexer.New([]rune(`package "g"
+S : A S "b" | "x" ;
+A : "a" | empty ;
+`))
+	bsr, errs := parser.Parse(lex)
+	if errs != nil {
+		t.Fatal(errs[0])
+	}
+	g := ast.Build(bsr.GetRoot(), lex, "g.md")
+	symbols.Init(g)
+	prods := basicprod.GetFrom(g.SyntaxRules, g.StartSymbols())
+	lr0items := items.NewItems(prods)
+	ff := first.New(prods)
+	pgmStates := States(symbols.GetSymbols(), lr0items, ff, 1)
+	for _, ks := range knuth.States(symbols.GetSymbols(), lr0items, ff, 1).List {
+		if !hasSuperState(pgmStates, ks) {
+			t.Errorf("no PGM state has the core and lookaheads of Knuth state\n%s", ks)
+		}
+	}
+}
+
+// hasSuperState returns true if a state of ss has the core of s and all the
+// lookaheads of s
+func hasSuperState(ss *states.States, s *states.State) bool {
+	for _, s1 := range ss.List {
+		if s1.Nucleus.CoreEqual(s.Nucleus) && containsContext(s1, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsContext(s1, s *states.State) bool {
+	for _, cg := range append(s.Nucleus.List(), s.NonNucleus.List()...) {
+		cg1 := s1.GetGroup(cg)
+		if cg1 == nil || cg.ContextSet.Diff(cg1.ContextSet).Size() > 0 {
+			return false
+		}
+	}
+	return true
+}
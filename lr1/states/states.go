@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/goccmack/gogll/v3/lr1/basicprod"
 	"github.com/goccmack/gogll/v3/lr1/first"
 	"github.com/goccmack/gogll/v3/lr1/items"
 )
@@ -26,10 +27,17 @@ type States struct {
 	List []*State
 }
 
-func (this *States) NewState0(symbols []string, items *items.Items, first *first.First, cg ...*ConfigGroup) *States {
-	s0 := NewState(symbols, items, first).Add(cg...).Closure().Closure()
-	s0.Number = 0
-	this.List = append(this.List, s0)
+/*
+NewStartStates adds a start state for each of the numStarts augmented start
+productions, G0, G1, ... State i is the start state of Gi.
+*/
+func (this *States) NewStartStates(symbols []string, items *items.Items, first *first.First, numStarts int) *States {
+	for i := 0; i < numStarts; i++ {
+		item := items.StartItems(basicprod.StartHead(i))[0]
+		s := NewState(symbols, items, first).Add(NewConfigGroup(item, "$")).Closure().Closure()
+		s.Number = len(this.List)
+		this.List = append(this.List, s)
+	}
 	return this
 }
 
@@ -0,0 +1,562 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package lsp
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/frstflw"
+	"github.com/goccmack/gogll/v3/lex/items"
+	"github.com/goccmack/gogll/v3/lexer"
+	"github.com/goccmack/gogll/v3/lr1"
+	"github.com/goccmack/gogll/v3/lr1/action"
+	"github.com/goccmack/gogll/v3/parser"
+	"github.com/goccmack/gogll/v3/parser/bsr"
+	"github.com/goccmack/gogll/v3/parser/visitor"
+	"github.com/goccmack/gogll/v3/sc"
+	"github.com/goccmack/gogll/v3/symbols"
+	"github.com/goccmack/gogll/v3/token"
+	"github.com/goccmack/goutil/stringset"
+)
+
+// document is an open grammar file and the result of its analysis
+type document struct {
+	uri   string
+	fname string
+	text  []rune
+	// lines contains the offset of the first rune of each line of text
+	lines []int
+
+	// occs contains the occurrences of the nonterminals and token IDs in the
+	// code of the document, in input order
+	occs []*occurrence
+
+	// g and ff are nil if the grammar has errors
+	g  *ast.GoGLL
+	ff *frstflw.FF
+
+	diagnostics []Diagnostic
+}
+
+// occurrence is an occurrence of a nonterminal or token ID in a document
+type occurrence struct {
+	id         string
+	nt         bool
+	lext, rext int
+	// def is true if the occurrence is the head of the rule declaring id.
+	// The rule extends from lext to ruleRext.
+	def      bool
+	ruleRext int
+}
+
+/*
+newDocument analyses the grammar text of the document uri. The text outside
+the code blocks of a markdown grammar is ignored, like the gogll lexer does.
+The diagnostics are the parse errors, the AST and semantic errors, the lexer
+conflicts and the LR(1) conflicts of the grammar. Only the parse errors of a
+file without a package specification are reported.
+*/
+func newDocument(uri, text string) *document {
+	d := &document{
+		uri:   uri,
+		fname: uriToPath(uri),
+		text:  []rune(text),
+	}
+	d.lines = lineOffsets(d.text)
+	lex := lexer.New(code(d.text, strings.HasSuffix(d.fname, ".md")))
+	bsrSet, errs := parser.Parse(lex)
+	if errs != nil {
+		d.occs = occurrences(lex.Tokens, nil)
+		d.parseError(errs)
+		return d
+	}
+	d.occs = occurrences(lex.Tokens, keywordTokIDs(bsrSet))
+	d.analyse(lex, bsrSet)
+	return d
+}
+
+func (d *document) analyse(lex *lexer.Lexer, bsrSet *bsr.Set) {
+	if bsrSet.IsAmbiguous() {
+		d.addDiagnostic(d.rangeOf(0, 0), SeverityError, "ambiguous parse forest")
+		return
+	}
+	// A grammar file without a package specification is only imported by
+	// other grammars and cannot be built on its own
+	if lex.Tokens[0].TypeID() != "package" {
+		return
+	}
+	g, err := ast.TryBuild(bsrSet.GetRoot(), lex, d.fname)
+	if err != nil {
+		e := err.(*ast.Error)
+		d.addDiagnostic(d.positionRange(e.Pos), SeverityError, e.Err.Error())
+		return
+	}
+	if errs := sc.Errors(g); len(errs) > 0 {
+		for _, e := range errs {
+			d.addDiagnostic(d.positionRange(e.Pos), SeverityError, e.Err.Error())
+		}
+		return
+	}
+	symbols.Init(g)
+	d.g, d.ff = g, frstflw.New(g)
+
+	lexSets, err := items.TryNew(g)
+	if err != nil {
+		d.addDiagnostic(d.rangeOf(0, 0), SeverityError, err.Error())
+		return
+	}
+	d.lexConflicts(lexSets)
+	if len(g.SyntaxRules) > 0 {
+		d.lr1Conflicts()
+	}
+}
+
+// parseError adds a diagnostic for the first parse error in errs
+func (d *document) parseError(errs []*parser.Error) {
+	e := errs[0]
+	rng := d.rangeOf(e.Token.Lext(), e.Token.Rext())
+	if e.LexError != nil {
+		d.addDiagnostic(rng, SeverityError, e.LexError.Error())
+		return
+	}
+	expected := stringset.New()
+	for _, e1 := range errs {
+		if e1.Token == e.Token {
+			for _, exp := range e1.Expected {
+				expected.Add(exp)
+			}
+		}
+	}
+	d.addDiagnostic(rng, SeverityError, fmt.Sprintf("unexpected %s, expected one of: %s",
+		e.Token.TypeID(), strings.Join(expected.ElementsSorted(), " ")))
+}
+
+/*
+lexConflicts adds a diagnostic for every pair of lex rules that accept the same
+input. The lexer returns the token of the first rule, which shadows the second
+rule for that input. A string literal shadows a lex rule, e.g.: a keyword and
+an identifier, which is not a conflict.
+*/
+func (d *document) lexConflicts(lexSets *items.Sets) {
+	slits := d.g.GetStringLiteralsSet()
+	reported := map[string]bool{}
+	for _, set := range lexSets.Sets() {
+		var accept, accSlits []string
+		for _, itm := range set.Items() {
+			if itm.IsReduce() {
+				if slits.Contain(itm.Rule.ID()) {
+					accSlits = append(accSlits, itm.Rule.ID())
+				} else {
+					accept = append(accept, itm.Rule.ID())
+				}
+			}
+		}
+		if len(accSlits) > 1 {
+			msg := fmt.Sprintf("string literals %q and %q accept the same input", accSlits[0], accSlits[1])
+			if !reported[msg] {
+				reported[msg] = true
+				d.addDiagnostic(d.rangeOf(0, 0), SeverityError, msg)
+			}
+			continue
+		}
+		for _, id := range accept[min(1, len(accept)):] {
+			msg := fmt.Sprintf("lex rule %s is shadowed by %s: both accept the same input", id, accept[0])
+			if r := d.g.GetLexRule(id); r != nil && !reported[msg] {
+				reported[msg] = true
+				d.addDiagnostic(d.positionRange(r.Pos), SeverityWarning, msg)
+			}
+		}
+	}
+}
+
+/*
+lr1Conflicts adds a diagnostic for every LR(1) conflict of the grammar at
+the first alternate that is reduced in the conflict. The LR(1) generator
+panics on some grammars that only have a GLL parser, e.g.: a conflict with
+the accept action, which is reported as a single diagnostic.
+*/
+func (d *document) lr1Conflicts() {
+	defer func() {
+		if err := recover(); err != nil {
+			d.addDiagnostic(d.rangeOf(0, 0), SeverityWarning, fmt.Sprintf("no LR(1) parser: %v", err))
+		}
+	}()
+	prods, conflicts := lr1.Conflicts(d.g)
+	reported := map[string]bool{}
+	for _, scs := range conflicts {
+		for _, c := range scs {
+			if c == nil {
+				continue
+			}
+			var acts []string
+			var pos *ast.Position
+			for _, a := range c.Actions {
+				switch a1 := a.(type) {
+				case action.Reduce:
+					p := prods[a1]
+					acts = append(acts, "reduce "+ast.AlternateString(p.Head, p.Body))
+					if r := d.g.GetSyntaxRule(p.Head); pos == nil && r != nil {
+						pos = r.Pos
+						if !p.Body.Empty() {
+							pos = r.SymbolPosition(p.Body.Symbols[0])
+						}
+					}
+				case action.Shift:
+					acts = append(acts, "shift")
+				case action.Accept:
+					acts = append(acts, "accept")
+				}
+			}
+			msg := fmt.Sprintf("LR(1) conflict on %s: %s", c.Symbol, strings.Join(acts, " / "))
+			if !reported[msg] {
+				reported[msg] = true
+				rng := d.rangeOf(0, 0)
+				if pos != nil {
+					rng = d.positionRange(pos)
+				}
+				d.addDiagnostic(rng, SeverityWarning, msg)
+			}
+		}
+	}
+}
+
+func (d *document) addDiagnostic(rng Range, severity int, msg string) {
+	d.diagnostics = append(d.diagnostics, Diagnostic{
+		Range:    rng,
+		Severity: severity,
+		Source:   "gogll",
+		Message:  msg,
+	})
+}
+
+/*** Symbols ***/
+
+// tokIDVisitor collects the keywords that are parsed as token IDs
+type tokIDVisitor struct {
+	visitor.BaseVisitor
+	toks map[int]bool
+}
+
+// TokID : tokid | "as" | "case_insensitive" | "import" | "prefix" | "rename" | "start" ;
+func (v *tokIDVisitor) EnterTokID(b bsr.BSR) bool {
+	if b.Alternate() > 0 {
+		v.toks[b.LeftExtent()] = true
+	}
+	return false
+}
+
+// keywordTokIDs returns the indices of the keyword tokens that are token IDs
+// in the parse forest, e.g.: start in `start : number {number} ;`.
+func keywordTokIDs(bsrSet *bsr.Set) map[int]bool {
+	v := &tokIDVisitor{toks: map[int]bool{}}
+	if !bsrSet.IsAmbiguous() {
+		for _, root := range bsrSet.GetRoots() {
+			visitor.Walk(root, v)
+		}
+	}
+	return v.toks
+}
+
+/*
+occurrences returns the occurrences of the nonterminals and token IDs in
+toks. The keyword tokens in tokIDs are token IDs. The parameters of template
+rules, the labels of symbols, the names of alternates and the symbols of
+imports are not occurrences.
+*/
+func occurrences(toks []*token.Token, tokIDs map[int]bool) (occs []*occurrence) {
+	typ := func(i int) string {
+		if tokIDs[i] {
+			return "tokid"
+		}
+		return toks[i].TypeID()
+	}
+	head := true
+	var def *occurrence
+	params := map[string]bool{}
+	for i := 0; i < len(toks); i++ {
+		t := toks[i]
+		switch typ(i) {
+		case ";":
+			if def != nil {
+				def.ruleRext = t.Rext()
+			}
+			head, def, params = true, nil, map[string]bool{}
+			continue
+		case "package":
+			for i+1 < len(toks) && (typ(i+1) == "string_lit" ||
+				typ(i+1) == "case_insensitive") {
+				i++
+			}
+			continue
+		case "import":
+			for i+1 < len(toks) && typ(i+1) != ";" {
+				i++
+			}
+			continue
+		case "!", "@":
+			continue
+		case "nt", "tokid":
+			occ := &occurrence{
+				id:   t.LiteralString(),
+				nt:   typ(i) == "nt",
+				lext: t.Lext(),
+				rext: t.Rext(),
+			}
+			switch {
+			case head:
+				occ.def, occ.ruleRext = true, t.Rext()
+				def = occ
+				occs = append(occs, occ)
+				// Skip the template parameters
+				if i+1 < len(toks) && toks[i+1].TypeID() == "<" {
+					for i++; i < len(toks) && toks[i].TypeID() != ">"; i++ {
+						params[toks[i].LiteralString()] = true
+					}
+				}
+			case params[occ.id],
+				!occ.nt && i+1 < len(toks) && toks[i+1].TypeID() == ":",
+				i > 0 && toks[i-1].TypeID() == "#":
+			default:
+				occs = append(occs, occ)
+			}
+		}
+		head = false
+	}
+	return
+}
+
+// occurrenceAt returns the occurrence at pos, or nil
+func (d *document) occurrenceAt(pos Position) *occurrence {
+	offset := d.offset(pos)
+	for _, occ := range d.occs {
+		if occ.lext <= offset && offset <= occ.rext {
+			return occ
+		}
+	}
+	return nil
+}
+
+// definition returns the local declaration of id, or nil
+func (d *document) definition(id string) *occurrence {
+	for _, occ := range d.occs {
+		if occ.def && occ.id == id {
+			return occ
+		}
+	}
+	return nil
+}
+
+// references returns the occurrences of id
+func (d *document) references(id string) (occs []*occurrence) {
+	for _, occ := range d.occs {
+		if occ.id == id {
+			occs = append(occs, occ)
+		}
+	}
+	return
+}
+
+// rulePosition returns the position of the rule declaring id in the grammar
+// or in one of its imports, or nil if the grammar has errors or does not
+// declare id.
+func (d *document) rulePosition(id string) *ast.Position {
+	if d.g == nil {
+		return nil
+	}
+	if r := d.g.GetSyntaxRule(id); r != nil {
+		return r.Pos
+	}
+	if r := d.g.GetLexRule(id); r != nil {
+		return r.Pos
+	}
+	return nil
+}
+
+// symbolNames returns the nonterminals and token IDs declared in the document
+// and its imports, sorted
+func (d *document) symbolNames() (nts, tokids []string) {
+	ntSet, tokSet := stringset.New(), stringset.New()
+	for _, occ := range d.occs {
+		if occ.def && occ.nt {
+			ntSet.Add(occ.id)
+		} else if occ.def {
+			tokSet.Add(occ.id)
+		}
+	}
+	if d.g != nil {
+		for _, r := range d.g.SyntaxRules {
+			ntSet.Add(r.ID())
+		}
+		for _, r := range d.g.LexRules {
+			tokSet.Add(r.ID())
+		}
+	}
+	return ntSet.ElementsSorted(), tokSet.ElementsSorted()
+}
+
+/*** Positions ***/
+
+// code returns a copy of text in which the text outside the code blocks of a
+// markdown grammar is replaced by white space. The line breaks are kept.
+func code(text []rune, md bool) []rune {
+	c := make([]rune, len(text))
+	copy(c, text)
+	if !md {
+		return c
+	}
+	inCode := false
+	for i := 0; i < len(c); i++ {
+		if i <= len(c)-3 && c[i] == '`' && c[i+1] == '`' && c[i+2] == '`' {
+			inCode = !inCode
+			c[i], c[i+1], c[i+2] = ' ', ' ', ' '
+			i += 2
+			continue
+		}
+		if !inCode && c[i] != '\n' {
+			c[i] = ' '
+		}
+	}
+	return c
+}
+
+func lineOffsets(text []rune) []int {
+	lines := []int{0}
+	for i, r := range text {
+		if r == '\n' {
+			lines = append(lines, i+1)
+		}
+	}
+	return lines
+}
+
+// position returns the LSP position of the rune at offset
+func (d *document) position(offset int) Position {
+	ln := sort.Search(len(d.lines), func(i int) bool { return d.lines[i] > offset }) - 1
+	return Position{
+		Line:      ln,
+		Character: len(utf16.Encode(d.text[d.lines[ln]:offset])),
+	}
+}
+
+// offset returns the offset of the rune at the LSP position pos
+func (d *document) offset(pos Position) int {
+	if pos.Line >= len(d.lines) {
+		return len(d.text)
+	}
+	i, ch := d.lines[pos.Line], 0
+	for ; i < len(d.text) && d.text[i] != '\n' && ch < pos.Character; i++ {
+		ch += len(utf16.Encode([]rune{d.text[i]}))
+	}
+	return i
+}
+
+func (d *document) rangeOf(lext, rext int) Range {
+	return Range{Start: d.position(lext), End: d.position(rext)}
+}
+
+func (d *document) occRange(occ *occurrence) Range {
+	return d.rangeOf(occ.lext, occ.rext)
+}
+
+/*
+positionRange returns the range of the word at the AST position pos, in which
+a tab counts as four columns. The range is at the start of the document if pos
+is in another file.
+*/
+func (d *document) positionRange(pos *ast.Position) Range {
+	if filepath.Clean(pos.File) != filepath.Clean(d.fname) {
+		return d.rangeOf(0, 0)
+	}
+	lext := astOffset(d.text, pos)
+	rext := lext
+	for rext < len(d.text) && !strings.ContainsRune(" \t\r\n;:|", d.text[rext]) {
+		rext++
+	}
+	return d.rangeOf(lext, rext)
+}
+
+// astOffset returns the offset in text of the AST position pos
+func astOffset(text []rune, pos *ast.Position) int {
+	ln, col := 1, 1
+	for i, r := range text {
+		if ln == pos.Line && col >= pos.Column {
+			return i
+		}
+		switch r {
+		case '\n':
+			if ln == pos.Line {
+				return i
+			}
+			ln, col = ln+1, 1
+		case '\t':
+			col += 4
+		default:
+			col++
+		}
+	}
+	return len(text)
+}
+
+// location returns the location of the identifier at the AST position pos,
+// which may be in a file that is not open. The identifier may differ from the
+// ID of the rule if the file is imported with a prefix or renaming.
+func (d *document) location(pos *ast.Position) (*Location, error) {
+	fd := d
+	if filepath.Clean(pos.File) != filepath.Clean(d.fname) {
+		buf, err := ioutil.ReadFile(pos.File)
+		if err != nil {
+			return nil, err
+		}
+		fd = &document{uri: pathToURI(pos.File), text: []rune(string(buf))}
+		fd.lines = lineOffsets(fd.text)
+	}
+	lext := astOffset(fd.text, pos)
+	rext := lext
+	for rext < len(fd.text) && (unicode.IsLetter(fd.text[rext]) ||
+		unicode.IsNumber(fd.text[rext]) || fd.text[rext] == '_') {
+		rext++
+	}
+	return &Location{URI: fd.uri, Range: fd.rangeOf(lext, rext)}, nil
+}
+
+func uriToPath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return uri
+	}
+	return filepath.FromSlash(u.Path)
+}
+
+func pathToURI(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String()
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
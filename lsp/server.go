@@ -0,0 +1,336 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+/*
+Package lsp implements a Language Server Protocol server for gogll grammar
+files, which communicates with the editor over JSON-RPC.
+
+The server supports full document synchronisation and offers:
+
+  - diagnostics: parse errors, AST and semantic errors, lex rules that accept
+    the same input and LR(1) conflicts;
+  - go to definition and find references of nonterminals and token IDs;
+  - hover, which shows the rule of a symbol and the FIRST and FOLLOW sets of
+    a nonterminal;
+  - rename of the nonterminals and token IDs declared in a document;
+  - completion of the declared symbol names.
+
+Markdown grammars (.md) are analysed like the gogll lexer reads them: only the
+text in the code blocks is grammar.
+*/
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/goccmack/gogll/v3/cfg"
+)
+
+// Server is a language server for gogll grammars
+type Server struct {
+	in  *bufio.Reader
+	out io.Writer
+	// log receives the errors of the server, e.g.: os.Stderr
+	log  io.Writer
+	docs map[string]*document
+}
+
+// NewServer returns a server that reads requests from in and writes
+// responses to out. Errors that cannot be returned to the client are written
+// to log.
+func NewServer(in io.Reader, out, log io.Writer) *Server {
+	return &Server{
+		in:   bufio.NewReader(in),
+		out:  out,
+		log:  log,
+		docs: make(map[string]*document),
+	}
+}
+
+// Run serves the requests of the client until it sends exit or closes the
+// input.
+func (s *Server) Run() error {
+	for {
+		buf, err := readMessage(s.in)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var req request
+		if err := json.Unmarshal(buf, &req); err != nil {
+			s.respond(nil, nil, &responseError{parseError, err.Error()})
+			continue
+		}
+		if req.Method == "exit" {
+			return nil
+		}
+		s.handle(&req)
+	}
+}
+
+// handle calls the handler of req and responds to requests. A panic in a
+// handler is returned to the client as an internal error.
+func (s *Server) handle(req *request) {
+	var result interface{}
+	var err error
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s: %v", req.Method, r)
+		}
+		if req.ID != nil {
+			rerr, ok := err.(*responseError)
+			if err != nil && !ok {
+				rerr = &responseError{internalError, err.Error()}
+			}
+			s.respond(req.ID, result, rerr)
+		} else if err != nil {
+			fmt.Fprintf(s.log, "gogll lsp: %s\n", err)
+		}
+	}()
+
+	switch req.Method {
+	case "initialize":
+		result = s.initialize()
+	case "initialized", "$/cancelRequest", "$/setTrace":
+	case "shutdown":
+	case "textDocument/didOpen":
+		var p didOpenParams
+		if err = unmarshal(req.Params, &p); err == nil {
+			s.update(p.TextDocument.URI, p.TextDocument.Text)
+		}
+	case "textDocument/didChange":
+		var p didChangeParams
+		if err = unmarshal(req.Params, &p); err == nil && len(p.ContentChanges) > 0 {
+			s.update(p.TextDocument.URI, p.ContentChanges[len(p.ContentChanges)-1].Text)
+		}
+	case "textDocument/didClose":
+		var p didCloseParams
+		if err = unmarshal(req.Params, &p); err == nil {
+			delete(s.docs, p.TextDocument.URI)
+			s.publishDiagnostics(p.TextDocument.URI, nil)
+		}
+	case "textDocument/definition":
+		var p positionParams
+		if err = unmarshal(req.Params, &p); err == nil {
+			result, err = s.definition(&p)
+		}
+	case "textDocument/references":
+		var p referenceParams
+		if err = unmarshal(req.Params, &p); err == nil {
+			result = s.references(&p)
+		}
+	case "textDocument/hover":
+		var p positionParams
+		if err = unmarshal(req.Params, &p); err == nil {
+			result = s.hover(&p)
+		}
+	case "textDocument/rename":
+		var p renameParams
+		if err = unmarshal(req.Params, &p); err == nil {
+			result, err = s.rename(&p)
+		}
+	case "textDocument/completion":
+		var p positionParams
+		if err = unmarshal(req.Params, &p); err == nil {
+			result = s.completion(&p)
+		}
+	default:
+		if req.ID != nil {
+			err = &responseError{methodNotFound, "method not supported: " + req.Method}
+		}
+	}
+}
+
+func unmarshal(params json.RawMessage, v interface{}) error {
+	if err := json.Unmarshal(params, v); err != nil {
+		return &responseError{invalidParams, err.Error()}
+	}
+	return nil
+}
+
+func (s *Server) respond(id *json.RawMessage, result interface{}, err *responseError) {
+	resp := &response{JSONRPC: "2.0", ID: id, Result: result}
+	if err != nil {
+		resp.Result, resp.Error = nil, err
+	}
+	if err := writeMessage(s.out, resp); err != nil {
+		fmt.Fprintf(s.log, "gogll lsp: %s\n", err)
+	}
+}
+
+func (s *Server) notify(method string, params interface{}) {
+	if err := writeMessage(s.out, &notification{JSONRPC: "2.0", Method: method, Params: params}); err != nil {
+		fmt.Fprintf(s.log, "gogll lsp: %s\n", err)
+	}
+}
+
+func (s *Server) initialize() *initializeResult {
+	res := &initializeResult{
+		Capabilities: serverCapabilities{
+			TextDocumentSync:   1,
+			DefinitionProvider: true,
+			ReferencesProvider: true,
+			HoverProvider:      true,
+			RenameProvider:     true,
+			CompletionProvider: struct{}{},
+		},
+	}
+	res.ServerInfo.Name, res.ServerInfo.Version = "gogll", cfg.Version
+	return res
+}
+
+// update analyses the new text of document uri and publishes its diagnostics
+func (s *Server) update(uri, text string) {
+	d := newDocument(uri, text)
+	s.docs[uri] = d
+	s.publishDiagnostics(uri, d.diagnostics)
+}
+
+func (s *Server) publishDiagnostics(uri string, diags []Diagnostic) {
+	if diags == nil {
+		diags = []Diagnostic{}
+	}
+	s.notify("textDocument/publishDiagnostics", &publishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: diags,
+	})
+}
+
+// symbolAt returns the document and the symbol occurrence at the position of
+// p, or nil
+func (s *Server) symbolAt(p *positionParams) (*document, *occurrence) {
+	d := s.docs[p.TextDocument.URI]
+	if d == nil {
+		return nil, nil
+	}
+	return d, d.occurrenceAt(p.Position)
+}
+
+// definition returns the location of the rule declaring the symbol at p. The
+// rule may be declared in an imported file.
+func (s *Server) definition(p *positionParams) (*Location, error) {
+	d, occ := s.symbolAt(p)
+	if occ == nil {
+		return nil, nil
+	}
+	if def := d.definition(occ.id); def != nil {
+		return &Location{URI: d.uri, Range: d.occRange(def)}, nil
+	}
+	if pos := d.rulePosition(occ.id); pos != nil {
+		return d.location(pos)
+	}
+	return nil, nil
+}
+
+// references returns the locations of the occurrences of the symbol at p in
+// its document
+func (s *Server) references(p *referenceParams) []Location {
+	d, occ := s.symbolAt(&p.positionParams)
+	if occ == nil {
+		return nil
+	}
+	locs := []Location{}
+	for _, ref := range d.references(occ.id) {
+		if !ref.def || p.Context.IncludeDeclaration {
+			locs = append(locs, Location{URI: d.uri, Range: d.occRange(ref)})
+		}
+	}
+	return locs
+}
+
+// hover returns the rule of the symbol at p, and the FIRST and FOLLOW sets if
+// the symbol is a nonterminal
+func (s *Server) hover(p *positionParams) *Hover {
+	d, occ := s.symbolAt(p)
+	if occ == nil {
+		return nil
+	}
+	w := new(strings.Builder)
+	kind := "lex rule"
+	if occ.nt {
+		kind = "syntax rule"
+	}
+	if def := d.definition(occ.id); def != nil {
+		fmt.Fprintf(w, "```\n%s\n```\n", string(d.text[def.lext:def.ruleRext]))
+	} else if pos := d.rulePosition(occ.id); pos != nil {
+		fmt.Fprintf(w, "%s `%s` declared in %s line %d\n", kind, occ.id, pos.File, pos.Line)
+	} else {
+		fmt.Fprintf(w, "%s `%s` is not declared\n", kind, occ.id)
+	}
+	if occ.nt && d.ff != nil && d.g.GetSyntaxRule(occ.id) != nil {
+		fmt.Fprintf(w, "\nFIRST: `%s`\n\nFOLLOW: `%s`\n",
+			strings.Join(d.ff.FirstOfSymbol(occ.id).ElementsSorted(), " "),
+			strings.Join(d.ff.Follow(occ.id).ElementsSorted(), " "))
+	}
+	return &Hover{
+		Contents: MarkupContent{Kind: "markdown", Value: w.String()},
+		Range:    d.occRange(occ),
+	}
+}
+
+var (
+	ntPattern    = regexp.MustCompile(`^\p{Lu}[\p{L}\p{N}_]*$`)
+	tokidPattern = regexp.MustCompile(`^\p{Ll}[\p{L}\p{N}_]+$`)
+)
+
+// rename returns the edits that rename the symbol at p and all its
+// occurrences in its document. The symbol must be declared in the document.
+func (s *Server) rename(p *renameParams) (*WorkspaceEdit, error) {
+	d, occ := s.symbolAt(&p.positionParams)
+	if occ == nil {
+		return nil, &responseError{invalidParams, "no nonterminal or token ID at the position"}
+	}
+	if d.definition(occ.id) == nil {
+		return nil, &responseError{invalidParams, fmt.Sprintf("%s is not declared in this file", occ.id)}
+	}
+	switch {
+	case occ.nt && !ntPattern.MatchString(p.NewName):
+		return nil, &responseError{invalidParams, fmt.Sprintf("%s is not a valid nonterminal", p.NewName)}
+	case !occ.nt && !tokidPattern.MatchString(p.NewName):
+		return nil, &responseError{invalidParams, fmt.Sprintf("%s is not a valid token ID", p.NewName)}
+	}
+	if d.definition(p.NewName) != nil || d.rulePosition(p.NewName) != nil {
+		return nil, &responseError{invalidParams, fmt.Sprintf("%s is already declared", p.NewName)}
+	}
+	var edits []TextEdit
+	for _, ref := range d.references(occ.id) {
+		edits = append(edits, TextEdit{Range: d.occRange(ref), NewText: p.NewName})
+	}
+	return &WorkspaceEdit{Changes: map[string][]TextEdit{d.uri: edits}}, nil
+}
+
+// completion returns the nonterminals and token IDs declared in the document
+// of p and its imports
+func (s *Server) completion(p *positionParams) []CompletionItem {
+	d := s.docs[p.TextDocument.URI]
+	if d == nil {
+		return nil
+	}
+	items := []CompletionItem{}
+	nts, tokids := d.symbolNames()
+	for _, nt := range nts {
+		items = append(items, CompletionItem{Label: nt, Kind: CompletionFunction, Detail: "syntax rule"})
+	}
+	for _, tokid := range tokids {
+		items = append(items, CompletionItem{Label: tokid, Kind: CompletionConstant, Detail: "lex rule"})
+	}
+	return items
+}
@@ -0,0 +1,177 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const grammar = "# Expressions\n" +
+	"```\n" +
+	"package \"expr\"\n" +
+	"\n" +
+	"Expr : Expr \"-\" Expr | Term ;\n" +
+	"Term : id | \"(\" Expr \")\" ;\n" +
+	"```\n" +
+	"Prose that mentions Expr and id.\n" +
+	"```\n" +
+	"id : letter {letter} ;\n" +
+	"```\n"
+
+// session sends the messages to a server and returns the messages it writes
+func session(t *testing.T, msgs ...string) []map[string]interface{} {
+	in, out := new(bytes.Buffer), new(bytes.Buffer)
+	for i, msg := range msgs {
+		if err := writeMessage(in, json.RawMessage(msg)); err != nil {
+			t.Fatalf("message %d: %s", i, err)
+		}
+	}
+	log := new(bytes.Buffer)
+	if err := NewServer(in, out, log).Run(); err != nil {
+		t.Fatal(err)
+	}
+	if log.Len() > 0 {
+		t.Fatal(log)
+	}
+	var res []map[string]interface{}
+	r := bufio.NewReader(out)
+	for {
+		buf, err := readMessage(r)
+		if err != nil {
+			break
+		}
+		var msg map[string]interface{}
+		if err := json.Unmarshal(buf, &msg); err != nil {
+			t.Fatal(err)
+		}
+		res = append(res, msg)
+	}
+	return res
+}
+
+func didOpen(text string) string {
+	params, _ := json.Marshal(map[string]interface{}{
+		"textDocument": map[string]string{"uri": "file:///tmp/expr.md", "text": text},
+	})
+	return `{"jsonrpc":"2.0","method":"textDocument/didOpen","params":` + string(params) + `}`
+}
+
+func positionRequest(id int, method string, line, char int, extra string) string {
+	return `{"jsonrpc":"2.0","id":` + itoa(id) + `,"method":"` + method + `","params":{` +
+		`"textDocument":{"uri":"file:///tmp/expr.md"},` +
+		`"position":{"line":` + itoa(line) + `,"character":` + itoa(char) + `}` + extra + `}}`
+}
+
+func itoa(i int) string {
+	buf, _ := json.Marshal(i)
+	return string(buf)
+}
+
+func toJSON(v interface{}) string {
+	w := new(bytes.Buffer)
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.Encode(v)
+	return strings.TrimSpace(w.String())
+}
+
+func TestDiagnostics(t *testing.T) {
+	text := strings.Replace(grammar, "Term : id", "Term : Factor", 1)
+	msgs := session(t, didOpen(text))
+	diags := toJSON(msgs[0]["params"].(map[string]interface{})["diagnostics"])
+	exp := `[{"message":"No declaration of syntax rule Factor","range":{"end":{"character":13,"line":5},` +
+		`"start":{"character":7,"line":5}},"severity":1,"source":"gogll"}]`
+	if diags != exp {
+		t.Fatalf("expected:\n%s\ngot:\n%s", exp, diags)
+	}
+
+	msgs = session(t, didOpen(strings.Replace(grammar, "Term : id", `Term : "ab" id | i"ab" id`, 1)))
+	diags = toJSON(msgs[0]["params"].(map[string]interface{})["diagnostics"])
+	exp = `[{"message":"string literal \"ab\" is used both case-sensitive and case-insensitive (i\"ab\")",` +
+		`"range":{"end":{"character":22,"line":5},"start":{"character":18,"line":5}},"severity":1,"source":"gogll"}]`
+	if diags != exp {
+		t.Fatalf("expected:\n%s\ngot:\n%s", exp, diags)
+	}
+
+	msgs = session(t, didOpen(grammar))
+	diags = toJSON(msgs[0]["params"].(map[string]interface{})["diagnostics"])
+	if !strings.Contains(diags, `LR(1) conflict on -: shift / reduce Expr : Expr \"-\" Expr`) {
+		t.Fatalf("expected an LR(1) conflict, got:\n%s", diags)
+	}
+
+	msgs = session(t, didOpen(strings.Replace(grammar, "Term ;", "Term", 1)))
+	diags = toJSON(msgs[0]["params"].(map[string]interface{})["diagnostics"])
+	if !strings.Contains(diags, `"message":"unexpected :, expected one of: # , ; < > as case_insensitive import istring_lit nt prefix rename start string_lit tokid |"`) ||
+		!strings.Contains(diags, `"start":{"character":5,"line":5}`) {
+		t.Fatalf("expected a parse error, got:\n%s", diags)
+	}
+}
+
+// The LR(1) generator panics on the accept conflict of S : A ; A : S ;
+func TestLR1Panic(t *testing.T) {
+	text := "```\npackage \"cycle\"\n\nS : A ;\nA : S | \"a\" ;\n```\n"
+	msgs := session(t, didOpen(text), positionRequest(1, "textDocument/hover", 4, 0, ""))
+	diags := toJSON(msgs[0]["params"].(map[string]interface{})["diagnostics"])
+	if !strings.Contains(diags, `"message":"no LR(1) parser: Cannot have LR1 conflict with Accept."`) {
+		t.Fatalf("expected an LR(1) diagnostic, got:\n%s", diags)
+	}
+	if hover := toJSON(msgs[1]["result"]); !strings.Contains(hover, "FIRST: `a`") {
+		t.Fatalf("expected the hover of A, got:\n%s", hover)
+	}
+}
+
+func TestNavigation(t *testing.T) {
+	msgs := session(t,
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`,
+		didOpen(grammar),
+		// Term in the first rule
+		positionRequest(2, "textDocument/definition", 4, 24, ""),
+		positionRequest(3, "textDocument/references", 4, 1, `,"context":{"includeDeclaration":true}`),
+		positionRequest(4, "textDocument/hover", 5, 0, ""),
+		positionRequest(5, "textDocument/rename", 9, 1, `,"newName":"ident"`),
+		positionRequest(6, "textDocument/rename", 9, 1, `,"newName":"Ident"`),
+		positionRequest(7, "textDocument/completion", 5, 7, ""),
+		// Prose
+		positionRequest(8, "textDocument/definition", 7, 21, ""),
+		`{"jsonrpc":"2.0","id":9,"method":"shutdown"}`,
+		`{"jsonrpc":"2.0","method":"exit"}`,
+	)
+	results := map[float64]string{}
+	for _, msg := range msgs {
+		if id, ok := msg["id"].(float64); ok {
+			if e, ok := msg["error"]; ok {
+				results[id] = "error: " + e.(map[string]interface{})["message"].(string)
+			} else {
+				results[id] = toJSON(msg["result"])
+			}
+		}
+	}
+	if !strings.Contains(results[1], `"definitionProvider":true`) {
+		t.Errorf("initialize: %s", results[1])
+	}
+	exp := map[float64]string{
+		2: `{"range":{"end":{"character":4,"line":5},"start":{"character":0,"line":5}},"uri":"file:///tmp/expr.md"}`,
+		3: `[{"range":{"end":{"character":4,"line":4},"start":{"character":0,"line":4}},"uri":"file:///tmp/expr.md"},` +
+			`{"range":{"end":{"character":11,"line":4},"start":{"character":7,"line":4}},"uri":"file:///tmp/expr.md"},` +
+			`{"range":{"end":{"character":20,"line":4},"start":{"character":16,"line":4}},"uri":"file:///tmp/expr.md"},` +
+			`{"range":{"end":{"character":20,"line":5},"start":{"character":16,"line":5}},"uri":"file:///tmp/expr.md"}]`,
+		4: `{"contents":{"kind":"markdown","value":"` +
+			"```\\nTerm : id | \\\"(\\\" Expr \\\")\\\" ;\\n```\\n\\nFIRST: `( id`\\n\\nFOLLOW: `$ ) -`\\n" +
+			`"},"range":{"end":{"character":4,"line":5},"start":{"character":0,"line":5}}}`,
+		5: `{"changes":{"file:///tmp/expr.md":[` +
+			`{"newText":"ident","range":{"end":{"character":9,"line":5},"start":{"character":7,"line":5}}},` +
+			`{"newText":"ident","range":{"end":{"character":2,"line":9},"start":{"character":0,"line":9}}}]}}`,
+		6: `error: Ident is not a valid token ID`,
+		7: `[{"detail":"syntax rule","kind":3,"label":"Expr"},{"detail":"syntax rule","kind":3,"label":"Term"},` +
+			`{"detail":"lex rule","kind":21,"label":"id"}]`,
+		8: `null`,
+		9: `null`,
+	}
+	for id := 2.0; id <= 9; id++ {
+		if results[id] != exp[id] {
+			t.Errorf("request %v: expected:\n%s\ngot:\n%s", id, exp[id], results[id])
+		}
+	}
+}
@@ -17,17 +17,25 @@ limitations under the License.
 package main
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
 	"runtime/pprof"
+	"strings"
 
 	"github.com/goccmack/gogll/v3/ast"
 	"github.com/goccmack/gogll/v3/cfg"
-	"github.com/goccmack/gogll/v3/frstflw"
+	"github.com/goccmack/gogll/v3/coverage"
+	"github.com/goccmack/gogll/v3/format"
+	gendoc "github.com/goccmack/gogll/v3/gen/doc"
+	"github.com/goccmack/gogll/v3/gen/export"
 	genff "github.com/goccmack/gogll/v3/gen/firstfollow"
+	gencoverage "github.com/goccmack/gogll/v3/gen/golang/coverage"
 	gengogll "github.com/goccmack/gogll/v3/gen/golang/gll"
+	gengoglr "github.com/goccmack/gogll/v3/gen/golang/glr"
 	gengolexer "github.com/goccmack/gogll/v3/gen/golang/lexer"
 	gengolr1 "github.com/goccmack/gogll/v3/gen/golang/lr1"
 	gengotoken "github.com/goccmack/gogll/v3/gen/golang/token"
@@ -38,13 +46,16 @@ import (
 	genrusttoken "github.com/goccmack/gogll/v3/gen/rust/token"
 	"github.com/goccmack/gogll/v3/gen/slots"
 	gensymbols "github.com/goccmack/gogll/v3/gen/symbols"
-	"github.com/goccmack/gogll/v3/gslot"
-	"github.com/goccmack/gogll/v3/lex/items"
-	"github.com/goccmack/gogll/v3/lexer"
+	"github.com/goccmack/gogll/v3/gtest"
+	"github.com/goccmack/gogll/v3/importer"
+	"github.com/goccmack/gogll/v3/internal/loader"
+	"github.com/goccmack/gogll/v3/interp"
 	"github.com/goccmack/gogll/v3/lr1"
+	"github.com/goccmack/gogll/v3/lsp"
 	"github.com/goccmack/gogll/v3/parser"
-	"github.com/goccmack/gogll/v3/sc"
+	"github.com/goccmack/gogll/v3/sentences"
 	"github.com/goccmack/gogll/v3/symbols"
+	"github.com/goccmack/goutil/stringslice"
 )
 
 func main() {
@@ -62,26 +73,39 @@ func main() {
 		}
 		defer pprof.StopCPUProfile()
 	}
-	lex := lexer.NewFile(cfg.SrcFile)
-	bsrSet, errs := parser.Parse(lex)
-	if errs != nil {
-		parseErrors(errs)
-	}
-
-	if bsrSet.IsAmbiguous() {
-		fmt.Println("Error: Ambiguous parse forest")
-		bsrSet.ReportAmbiguous()
-		os.Exit(1)
+	switch cfg.Command {
+	case "parse":
+		parse()
+		return
+	case "test":
+		runTests()
+		return
+	case "gen-sentences":
+		genSentences()
+		return
+	case "coverage":
+		reportCoverage()
+		return
+	case "fmt":
+		formatGrammars()
+		return
+	case "doc":
+		writeDoc()
+		return
+	case "export":
+		exportGrammar()
+		return
+	case "import":
+		importGrammar()
+		return
+	case "lsp":
+		if err := lsp.NewServer(os.Stdin, os.Stdout, os.Stderr).Run(); err != nil {
+			fail(err)
+		}
+		return
 	}
-
-	g := ast.Build(bsrSet.GetRoot(), lex, cfg.SrcFile)
-	sc.Go(g, lex)
-	symbols.Init(g)
-
-	ff := frstflw.New(g)
-	gs := gslot.New(g, ff)
-
-	lexSets := items.New(g)
+	gr := load(cfg.SrcFile)
+	g, ff, gs, lexSets := gr.AST, gr.FF, gr.GSlots(), gr.LexSets()
 	if cfg.Verbose {
 		gensymbols.Gen(g)
 		genff.Gen(g, ff)
@@ -91,12 +115,19 @@ func main() {
 
 	switch {
 	case *cfg.Go:
+		if *cfg.Coverage {
+			gencoverage.Gen(filepath.Join(cfg.BaseDir, "coverage", "coverage.go"))
+		}
 		gengolexer.Gen(g, lexSets)
 		gengotoken.Gen(g)
 		if len(g.SyntaxRules) > 0 {
-			if *cfg.GLL {
+			switch {
+			case *cfg.GLL:
 				gengogll.Gen(g, gs, ff)
-			} else {
+			case *cfg.GLR:
+				bprods, states, actions := lr1.GenGLR(g)
+				gengoglr.Gen(g, gs, ff, bprods, states, actions)
+			default:
 				bprods, states, actions := lr1.Gen(g)
 				gengolr1.Gen(g.Package.GetString(), bprods, states, actions)
 			}
@@ -116,6 +147,317 @@ func main() {
 
 }
 
+// load loads the grammar in file. It exits with the parse errors of file.
+func load(file string) *loader.Grammar {
+	gr, errs := loader.File(file)
+	if errs != nil {
+		parseErrors(errs)
+	}
+	return gr
+}
+
+// parse interprets the grammar to parse cfg.InputFile
+func parse() {
+	gr, start := loadInterp()
+	lex, err := gr.NewLexerFile(cfg.InputFile)
+	if err != nil {
+		fail(err)
+	}
+	if start == "" {
+		lex.WriteTokens(os.Stdout)
+		lex.WriteErrors(os.Stdout)
+		if len(lex.Errors) > 0 {
+			os.Exit(1)
+		}
+		return
+	}
+	bsrSet, errs := gr.Parse(start, lex)
+	if errs != nil {
+		fmt.Println("Parse Errors:")
+		interp.WriteErrors(os.Stdout, errs)
+		os.Exit(1)
+	}
+	if bsrSet.IsAmbiguous() {
+		fmt.Println("Ambiguous parse forest:")
+		bsrSet.ReportAmbiguous(os.Stdout)
+		os.Exit(1)
+	}
+	bsrSet.WriteTree(os.Stdout)
+}
+
+// runTests runs the golden tests in the test data directory, cfg.InputFile
+func runTests() {
+	gr, start := loadInterp()
+	res, err := gtest.Run(gr, start, cfg.InputFile, cfg.Update, os.Stdout)
+	if err != nil {
+		fail(err)
+	}
+	if res.Failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// maxAttempts is the number of sentences gen-sentences generates per
+// requested sentence before it gives up
+const maxAttempts = 10
+
+// genSentences generates random sentences of cfg.SrcFile. Duplicate
+// sentences and sentences rejected by the grammar interpreter are discarded.
+func genSentences() {
+	ld := load(cfg.SrcFile)
+	lexSets := ld.LexSets()
+	gr, start := interp.New(ld.AST, ld.FF, ld.GSlots(), lexSets), startSymbol(ld.AST)
+	gen := sentences.New(ld.AST, ld.FF, lexSets, cfg.Seed)
+	var snts []string
+	seen, discarded := map[string]bool{}, 0
+	for i := 0; len(snts) < cfg.NumSentences && i < maxAttempts*cfg.NumSentences; i++ {
+		snt, err := gen.Sentence(start, cfg.MaxDepth)
+		if err != nil {
+			fail(err)
+		}
+		if seen[snt] {
+			continue
+		}
+		seen[snt] = true
+		lex := gr.NewLexer([]rune(snt))
+		if len(lex.Errors) > 0 {
+			discarded++
+			continue
+		}
+		if start != "" {
+			if _, errs := gr.Parse(start, lex); errs != nil {
+				discarded++
+				continue
+			}
+		}
+		snts = append(snts, snt)
+	}
+	if cfg.Verbose {
+		fmt.Fprintf(os.Stderr, "%d sentences generated, %d invalid sentences discarded\n",
+			len(snts), discarded)
+	}
+	if cfg.CorpusDir != "" {
+		if err := sentences.WriteCorpus(cfg.CorpusDir, snts); err != nil {
+			fail(err)
+		}
+		return
+	}
+	for _, snt := range snts {
+		fmt.Println(snt)
+	}
+}
+
+// reportCoverage reports the coverage of cfg.SrcFile by the coverage profiles,
+// input files and directories of input files in cfg.InputFiles
+func reportCoverage() {
+	ld := load(cfg.SrcFile)
+	g, gr, start := ld.AST, ld.Interp(), startSymbol(ld.AST)
+	prof := coverage.New()
+	for _, arg := range cfg.InputFiles {
+		err := filepath.Walk(arg, func(fname string, fi os.FileInfo, err error) error {
+			if err != nil || fi.IsDir() {
+				return err
+			}
+			switch filepath.Ext(fname) {
+			case gtest.TreeExt, gtest.ErrorsExt, gtest.TokensExt:
+				if fname != arg {
+					return nil
+				}
+			}
+			return recordCoverage(gr, start, fname, prof)
+		})
+		if err != nil {
+			fail(err)
+		}
+	}
+	rep := coverage.NewReport(g, prof)
+	rep.WriteText(os.Stdout)
+	if cfg.HTMLFile != "" {
+		f, err := os.Create(cfg.HTMLFile)
+		if err != nil {
+			fail(err)
+		}
+		if err := rep.WriteHTML(f, "Coverage of "+cfg.SrcFile); err != nil {
+			fail(err)
+		}
+		if err := f.Close(); err != nil {
+			fail(err)
+		}
+	}
+}
+
+// formatGrammars formats the grammar files in cfg.InputFiles. It exits with
+// status 1 if -d is selected and a file is not formatted.
+func formatGrammars() {
+	unformatted := false
+	for _, fname := range cfg.InputFiles {
+		src, err := ioutil.ReadFile(fname)
+		if err != nil {
+			fail(err)
+		}
+		res, err := format.Source(fname, src)
+		if err != nil {
+			fail(err)
+		}
+		if !cfg.Diff && !cfg.WriteBack {
+			os.Stdout.Write(res)
+			continue
+		}
+		if bytes.Equal(src, res) {
+			continue
+		}
+		if cfg.Diff {
+			unformatted = true
+			fmt.Printf("--- %s\n+++ %s (formatted)\n%s", fname, fname, gtest.Diff(string(src), string(res)))
+		}
+		if cfg.WriteBack {
+			fi, err := os.Stat(fname)
+			if err != nil {
+				fail(err)
+			}
+			if err := ioutil.WriteFile(fname, res, fi.Mode()); err != nil {
+				fail(err)
+			}
+		}
+	}
+	if unformatted {
+		os.Exit(1)
+	}
+}
+
+// writeDoc writes the documentation of cfg.SrcFile to cfg.HTMLFile, and the
+// railroad diagrams of its rules to cfg.SVGDir if it is selected
+func writeDoc() {
+	gr := load(cfg.SrcFile)
+	g, ff := gr.AST, gr.FF
+	f, err := os.Create(cfg.HTMLFile)
+	if err != nil {
+		fail(err)
+	}
+	if err := gendoc.WriteHTML(f, cfg.SrcFile, g, ff); err != nil {
+		fail(err)
+	}
+	if err := f.Close(); err != nil {
+		fail(err)
+	}
+	if cfg.SVGDir != "" {
+		if err := gendoc.WriteSVG(cfg.SVGDir, g); err != nil {
+			fail(err)
+		}
+	}
+}
+
+// exportGrammar writes cfg.SrcFile to cfg.ExportFile in cfg.ExportFormat and
+// prints the constructs that cannot be represented faithfully in the format
+func exportGrammar() {
+	format, err := export.ParseFormat(cfg.ExportFormat)
+	if err != nil {
+		fail(err)
+	}
+	gr := load(cfg.SrcFile)
+	g, ff := gr.AST, gr.FF
+	// The name of an ANTLR4 grammar must be the name of its file
+	name := path.Base(g.Package.GetString())
+	if format == export.ANTLR {
+		name = strings.TrimSuffix(filepath.Base(cfg.ExportFile), filepath.Ext(cfg.ExportFile))
+	}
+	buf := new(bytes.Buffer)
+	rep, err := export.Write(buf, format, name, g, ff, cfg.ExportScopes)
+	if err != nil {
+		fail(err)
+	}
+	if err := ioutil.WriteFile(cfg.ExportFile, buf.Bytes(), 0644); err != nil {
+		fail(err)
+	}
+	rep.WriteText(os.Stdout)
+}
+
+// importGrammar converts cfg.SrcFile to the gogll grammar cfg.ImportFile and
+// prints the constructs that were not translated
+func importGrammar() {
+	format, err := importer.FormatOf(cfg.SrcFile)
+	if cfg.ImportFormat != "" {
+		format, err = importer.ParseFormat(cfg.ImportFormat)
+	}
+	if err != nil {
+		fail(err)
+	}
+	pkg := cfg.ImportPackage
+	if pkg == "" {
+		pkg = importer.Package(cfg.SrcFile)
+	}
+	md, warnings, err := importer.File(cfg.SrcFile, format, pkg)
+	if err != nil {
+		fail(err)
+	}
+	if err := ioutil.WriteFile(cfg.ImportFile, md, 0644); err != nil {
+		fail(err)
+	}
+	for _, w := range warnings {
+		fmt.Println(w)
+	}
+}
+
+// recordCoverage adds the coverage profile in file fname to prof, or the
+// coverage of input file fname parsed by the grammar interpreter.
+func recordCoverage(gr *interp.Grammar, start, fname string, prof *coverage.Profile) error {
+	if coverage.IsProfile(fname) {
+		p, err := coverage.ReadFile(fname)
+		if err != nil {
+			return err
+		}
+		prof.Merge(p)
+		return nil
+	}
+	lex, err := gr.NewLexerFile(fname)
+	if err != nil {
+		return err
+	}
+	for _, toks := range [][]*interp.Token{lex.Tokens[:len(lex.Tokens)-1], lex.Suppressed} {
+		for _, tok := range toks {
+			if tok.Type != symbols.Error {
+				prof.Tokens[tok.Type.ID()]++
+			}
+		}
+	}
+	if start == "" {
+		return nil
+	}
+	bsrSet, errs := gr.Parse(start, lex)
+	if errs != nil {
+		if cfg.Verbose {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", fname, errs[0])
+		}
+		return nil
+	}
+	bsrSet.CompletedAlternates(func(nt string, alt int) {
+		prof.Alternates[coverage.Alternate{NT: nt, Index: alt}]++
+	})
+	return nil
+}
+
+// loadInterp returns the grammar interpreter of cfg.SrcFile and its start
+// symbol
+func loadInterp() (*interp.Grammar, string) {
+	gr := load(cfg.SrcFile)
+	return gr.Interp(), startSymbol(gr.AST)
+}
+
+// startSymbol returns cfg.Start, or the default start symbol of g. The start
+// symbol is empty if g has no syntax rules.
+func startSymbol(g *ast.GoGLL) string {
+	if len(g.SyntaxRules) == 0 {
+		return ""
+	}
+	start := cfg.Start
+	if start == "" {
+		start = g.StartSymbol()
+	} else if !stringslice.Contains(g.StartSymbols(), start) {
+		fail(fmt.Errorf("%s is not a start symbol of %s", start, cfg.SrcFile))
+	}
+	return start
+}
+
 func fail(err error) {
 	fmt.Printf("Error: %s\n", err)
 	os.Exit(1)
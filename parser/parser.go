@@ -27,10 +27,17 @@ type parser struct {
 	lex         *lexer.Lexer
 	parseErrors []*Error
 
+	start  symbols.NT
 	bsrSet *bsr.Set
 }
 
-func newParser(l *lexer.Lexer) *parser {
+// StartSymbols contains the start symbols of the grammar, which can be parsed
+// by ParseFrom. The first is the default start symbol, which is parsed by Parse.
+var StartSymbols = []symbols.NT{
+	symbols.NT_GoGLL,
+}
+
+func newParser(start symbols.NT, l *lexer.Lexer) *parser {
 	return &parser{
 		cI:     0,
 		lex:    l,
@@ -38,24 +45,38 @@ func newParser(l *lexer.Lexer) *parser {
 		U:      &descriptors{},
 		popped: make(map[poppedNode]bool),
 		crf: map[clusterNode][]*crfNode{
-			{symbols.NT_GoGLL, 0}: {},
+			{start, 0}: {},
 		},
 		crfNodes:    map[crfNode]*crfNode{},
-		bsrSet:      bsr.New(symbols.NT_GoGLL, l),
+		start:       start,
+		bsrSet:      bsr.New(start, l),
 		parseErrors: nil,
 	}
 }
 
-// Parse returns the BSR set containing the parse forest.
-// If the parse was successfull []*Error is nil
+// Parse returns the BSR set containing the parse forest of the default start
+// symbol.
+// If the parse was successfull []*Error is nil.
+// If the lexer found lexical errors they are returned first in []*Error.
 func Parse(l *lexer.Lexer) (*bsr.Set, []*Error) {
-	return newParser(l).parse()
+	return ParseFrom(symbols.NT_GoGLL, l)
+}
+
+// ParseFrom is like Parse but parses start symbol nt, which must be one of
+// StartSymbols.
+func ParseFrom(nt symbols.NT, l *lexer.Lexer) (*bsr.Set, []*Error) {
+	for _, start := range StartSymbols {
+		if start == nt {
+			return newParser(nt, l).parse()
+		}
+	}
+	panic(fmt.Sprintf("%s is not a start symbol", nt))
 }
 
 func (p *parser) parse() (*bsr.Set, []*Error) {
 	var L slot.Label
 	m, cU := len(p.lex.Tokens)-1, 0
-	p.ntAdd(symbols.NT_GoGLL, 0)
+	p.ntAdd(p.start, 0)
 	// p.DumpDescriptors()
 	for !p.R.empty() {
 		L, cU, p.cI = p.R.remove()
@@ -65,6 +86,29 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 		// p.DumpDescriptors()
 
 		switch L {
+		case slot.CharRange0R0: // CharRange : ∙char_lit - char_lit
+
+			p.bsrSet.Add(slot.CharRange0R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.CharRange0R1) {
+				p.parseError(slot.CharRange0R1, p.cI, first[slot.CharRange0R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.CharRange0R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.CharRange0R2) {
+				p.parseError(slot.CharRange0R2, p.cI, first[slot.CharRange0R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.CharRange0R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_CharRange) {
+				p.rtn(symbols.NT_CharRange, cU, p.cI)
+			} else {
+				p.parseError(slot.CharRange0R0, p.cI, followSets[symbols.NT_CharRange])
+			}
 		case slot.GoGLL0R0: // GoGLL : ∙Package Rules
 
 			p.call(slot.GoGLL0R1, cU, p.cI)
@@ -83,6 +127,149 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.GoGLL0R0, p.cI, followSets[symbols.NT_GoGLL])
 			}
+		case slot.GoGLL1R0: // GoGLL : ∙Rules
+
+			p.call(slot.GoGLL1R1, cU, p.cI)
+		case slot.GoGLL1R1: // GoGLL : Rules ∙
+
+			if p.follow(symbols.NT_GoGLL) {
+				p.rtn(symbols.NT_GoGLL, cU, p.cI)
+			} else {
+				p.parseError(slot.GoGLL1R0, p.cI, followSets[symbols.NT_GoGLL])
+			}
+		case slot.Import0R0: // Import : ∙import string_lit ;
+
+			p.bsrSet.Add(slot.Import0R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Import0R1) {
+				p.parseError(slot.Import0R1, p.cI, first[slot.Import0R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.Import0R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Import0R2) {
+				p.parseError(slot.Import0R2, p.cI, first[slot.Import0R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.Import0R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_Import) {
+				p.rtn(symbols.NT_Import, cU, p.cI)
+			} else {
+				p.parseError(slot.Import0R0, p.cI, followSets[symbols.NT_Import])
+			}
+		case slot.Import1R0: // Import : ∙import string_lit prefix nt ;
+
+			p.bsrSet.Add(slot.Import1R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Import1R1) {
+				p.parseError(slot.Import1R1, p.cI, first[slot.Import1R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.Import1R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Import1R2) {
+				p.parseError(slot.Import1R2, p.cI, first[slot.Import1R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.Import1R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Import1R3) {
+				p.parseError(slot.Import1R3, p.cI, first[slot.Import1R3])
+				break
+			}
+
+			p.bsrSet.Add(slot.Import1R4, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Import1R4) {
+				p.parseError(slot.Import1R4, p.cI, first[slot.Import1R4])
+				break
+			}
+
+			p.bsrSet.Add(slot.Import1R5, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_Import) {
+				p.rtn(symbols.NT_Import, cU, p.cI)
+			} else {
+				p.parseError(slot.Import1R0, p.cI, followSets[symbols.NT_Import])
+			}
+		case slot.Import2R0: // Import : ∙import string_lit rename Renames ;
+
+			p.bsrSet.Add(slot.Import2R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Import2R1) {
+				p.parseError(slot.Import2R1, p.cI, first[slot.Import2R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.Import2R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Import2R2) {
+				p.parseError(slot.Import2R2, p.cI, first[slot.Import2R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.Import2R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Import2R3) {
+				p.parseError(slot.Import2R3, p.cI, first[slot.Import2R3])
+				break
+			}
+
+			p.call(slot.Import2R4, cU, p.cI)
+		case slot.Import2R4: // Import : import string_lit rename Renames ∙;
+
+			if !p.testSelect(slot.Import2R4) {
+				p.parseError(slot.Import2R4, p.cI, first[slot.Import2R4])
+				break
+			}
+
+			p.bsrSet.Add(slot.Import2R5, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_Import) {
+				p.rtn(symbols.NT_Import, cU, p.cI)
+			} else {
+				p.parseError(slot.Import2R0, p.cI, followSets[symbols.NT_Import])
+			}
+		case slot.LabelledSymbol0R0: // LabelledSymbol : ∙SyntaxSymbol
+
+			p.call(slot.LabelledSymbol0R1, cU, p.cI)
+		case slot.LabelledSymbol0R1: // LabelledSymbol : SyntaxSymbol ∙
+
+			if p.follow(symbols.NT_LabelledSymbol) {
+				p.rtn(symbols.NT_LabelledSymbol, cU, p.cI)
+			} else {
+				p.parseError(slot.LabelledSymbol0R0, p.cI, followSets[symbols.NT_LabelledSymbol])
+			}
+		case slot.LabelledSymbol1R0: // LabelledSymbol : ∙TokID : SyntaxSymbol
+
+			p.call(slot.LabelledSymbol1R1, cU, p.cI)
+		case slot.LabelledSymbol1R1: // LabelledSymbol : TokID ∙: SyntaxSymbol
+
+			if !p.testSelect(slot.LabelledSymbol1R1) {
+				p.parseError(slot.LabelledSymbol1R1, p.cI, first[slot.LabelledSymbol1R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.LabelledSymbol1R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.LabelledSymbol1R2) {
+				p.parseError(slot.LabelledSymbol1R2, p.cI, first[slot.LabelledSymbol1R2])
+				break
+			}
+
+			p.call(slot.LabelledSymbol1R3, cU, p.cI)
+		case slot.LabelledSymbol1R3: // LabelledSymbol : TokID : SyntaxSymbol ∙
+
+			if p.follow(symbols.NT_LabelledSymbol) {
+				p.rtn(symbols.NT_LabelledSymbol, cU, p.cI)
+			} else {
+				p.parseError(slot.LabelledSymbol1R0, p.cI, followSets[symbols.NT_LabelledSymbol])
+			}
 		case slot.LexAlternates0R0: // LexAlternates : ∙RegExp
 
 			p.call(slot.LexAlternates0R1, cU, p.cI)
@@ -230,10 +417,11 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.LexOptional0R0, p.cI, followSets[symbols.NT_LexOptional])
 			}
-		case slot.LexRule0R0: // LexRule : ∙tokid : RegExp ;
+		case slot.LexRule0R0: // LexRule : ∙TokID : RegExp ;
+
+			p.call(slot.LexRule0R1, cU, p.cI)
+		case slot.LexRule0R1: // LexRule : TokID ∙: RegExp ;
 
-			p.bsrSet.Add(slot.LexRule0R1, cU, p.cI, p.cI+1)
-			p.cI++
 			if !p.testSelect(slot.LexRule0R1) {
 				p.parseError(slot.LexRule0R1, p.cI, first[slot.LexRule0R1])
 				break
@@ -247,7 +435,7 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			}
 
 			p.call(slot.LexRule0R3, cU, p.cI)
-		case slot.LexRule0R3: // LexRule : tokid : RegExp ∙;
+		case slot.LexRule0R3: // LexRule : TokID : RegExp ∙;
 
 			if !p.testSelect(slot.LexRule0R3) {
 				p.parseError(slot.LexRule0R3, p.cI, first[slot.LexRule0R3])
@@ -261,7 +449,7 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.LexRule0R0, p.cI, followSets[symbols.NT_LexRule])
 			}
-		case slot.LexRule1R0: // LexRule : ∙! tokid : RegExp ;
+		case slot.LexRule1R0: // LexRule : ∙! TokID : RegExp ;
 
 			p.bsrSet.Add(slot.LexRule1R1, cU, p.cI, p.cI+1)
 			p.cI++
@@ -270,8 +458,9 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 				break
 			}
 
-			p.bsrSet.Add(slot.LexRule1R2, cU, p.cI, p.cI+1)
-			p.cI++
+			p.call(slot.LexRule1R2, cU, p.cI)
+		case slot.LexRule1R2: // LexRule : ! TokID ∙: RegExp ;
+
 			if !p.testSelect(slot.LexRule1R2) {
 				p.parseError(slot.LexRule1R2, p.cI, first[slot.LexRule1R2])
 				break
@@ -285,7 +474,7 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			}
 
 			p.call(slot.LexRule1R4, cU, p.cI)
-		case slot.LexRule1R4: // LexRule : ! tokid : RegExp ∙;
+		case slot.LexRule1R4: // LexRule : ! TokID : RegExp ∙;
 
 			if !p.testSelect(slot.LexRule1R4) {
 				p.parseError(slot.LexRule1R4, p.cI, first[slot.LexRule1R4])
@@ -299,6 +488,45 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.LexRule1R0, p.cI, followSets[symbols.NT_LexRule])
 			}
+		case slot.LexRule2R0: // LexRule : ∙@ TokID : RegExp ;
+
+			p.bsrSet.Add(slot.LexRule2R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.LexRule2R1) {
+				p.parseError(slot.LexRule2R1, p.cI, first[slot.LexRule2R1])
+				break
+			}
+
+			p.call(slot.LexRule2R2, cU, p.cI)
+		case slot.LexRule2R2: // LexRule : @ TokID ∙: RegExp ;
+
+			if !p.testSelect(slot.LexRule2R2) {
+				p.parseError(slot.LexRule2R2, p.cI, first[slot.LexRule2R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.LexRule2R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.LexRule2R3) {
+				p.parseError(slot.LexRule2R3, p.cI, first[slot.LexRule2R3])
+				break
+			}
+
+			p.call(slot.LexRule2R4, cU, p.cI)
+		case slot.LexRule2R4: // LexRule : @ TokID : RegExp ∙;
+
+			if !p.testSelect(slot.LexRule2R4) {
+				p.parseError(slot.LexRule2R4, p.cI, first[slot.LexRule2R4])
+				break
+			}
+
+			p.bsrSet.Add(slot.LexRule2R5, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_LexRule) {
+				p.rtn(symbols.NT_LexRule, cU, p.cI)
+			} else {
+				p.parseError(slot.LexRule2R0, p.cI, followSets[symbols.NT_LexRule])
+			}
 		case slot.LexSymbol0R0: // LexSymbol : ∙.
 
 			p.bsrSet.Add(slot.LexSymbol0R1, cU, p.cI, p.cI+1)
@@ -379,6 +607,16 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.LexSymbol6R0, p.cI, followSets[symbols.NT_LexSymbol])
 			}
+		case slot.LexSymbol7R0: // LexSymbol : ∙CharRange
+
+			p.call(slot.LexSymbol7R1, cU, p.cI)
+		case slot.LexSymbol7R1: // LexSymbol : CharRange ∙
+
+			if p.follow(symbols.NT_LexSymbol) {
+				p.rtn(symbols.NT_LexSymbol, cU, p.cI)
+			} else {
+				p.parseError(slot.LexSymbol7R0, p.cI, followSets[symbols.NT_LexSymbol])
+			}
 		case slot.LexZeroOrMore0R0: // LexZeroOrMore : ∙{ LexAlternates }
 
 			p.bsrSet.Add(slot.LexZeroOrMore0R1, cU, p.cI, p.cI+1)
@@ -419,6 +657,29 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.Package0R0, p.cI, followSets[symbols.NT_Package])
 			}
+		case slot.Package1R0: // Package : ∙package string_lit case_insensitive
+
+			p.bsrSet.Add(slot.Package1R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Package1R1) {
+				p.parseError(slot.Package1R1, p.cI, first[slot.Package1R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.Package1R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Package1R2) {
+				p.parseError(slot.Package1R2, p.cI, first[slot.Package1R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.Package1R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_Package) {
+				p.rtn(symbols.NT_Package, cU, p.cI)
+			} else {
+				p.parseError(slot.Package1R0, p.cI, followSets[symbols.NT_Package])
+			}
 		case slot.PlusOrMinUnicodeSet0R0: // PlusOrMinUnicodeSet : ∙UnicodeSetSpec
 
 			p.call(slot.PlusOrMinUnicodeSet0R1, cU, p.cI)
@@ -456,10 +717,11 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.RegExp0R0, p.cI, followSets[symbols.NT_RegExp])
 			}
-		case slot.RegExp1R0: // RegExp : ∙tokid
+		case slot.RegExp1R0: // RegExp : ∙TokID
+
+			p.call(slot.RegExp1R1, cU, p.cI)
+		case slot.RegExp1R1: // RegExp : TokID ∙
 
-			p.bsrSet.Add(slot.RegExp1R1, cU, p.cI, p.cI+1)
-			p.cI++
 			if p.follow(symbols.NT_RegExp) {
 				p.rtn(symbols.NT_RegExp, cU, p.cI)
 			} else {
@@ -483,23 +745,107 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.RegExp2R0, p.cI, followSets[symbols.NT_RegExp])
 			}
-		case slot.RegExp3R0: // RegExp : ∙tokid RegExp
+		case slot.RegExp3R0: // RegExp : ∙TokID RegExp
+
+			p.call(slot.RegExp3R1, cU, p.cI)
+		case slot.RegExp3R1: // RegExp : TokID ∙RegExp
 
-			p.bsrSet.Add(slot.RegExp3R1, cU, p.cI, p.cI+1)
-			p.cI++
 			if !p.testSelect(slot.RegExp3R1) {
 				p.parseError(slot.RegExp3R1, p.cI, first[slot.RegExp3R1])
 				break
 			}
 
 			p.call(slot.RegExp3R2, cU, p.cI)
-		case slot.RegExp3R2: // RegExp : tokid RegExp ∙
+		case slot.RegExp3R2: // RegExp : TokID RegExp ∙
 
 			if p.follow(symbols.NT_RegExp) {
 				p.rtn(symbols.NT_RegExp, cU, p.cI)
 			} else {
 				p.parseError(slot.RegExp3R0, p.cI, followSets[symbols.NT_RegExp])
 			}
+		case slot.Rename0R0: // Rename : ∙nt as nt
+
+			p.bsrSet.Add(slot.Rename0R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Rename0R1) {
+				p.parseError(slot.Rename0R1, p.cI, first[slot.Rename0R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.Rename0R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Rename0R2) {
+				p.parseError(slot.Rename0R2, p.cI, first[slot.Rename0R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.Rename0R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_Rename) {
+				p.rtn(symbols.NT_Rename, cU, p.cI)
+			} else {
+				p.parseError(slot.Rename0R0, p.cI, followSets[symbols.NT_Rename])
+			}
+		case slot.Rename1R0: // Rename : ∙TokID as TokID
+
+			p.call(slot.Rename1R1, cU, p.cI)
+		case slot.Rename1R1: // Rename : TokID ∙as TokID
+
+			if !p.testSelect(slot.Rename1R1) {
+				p.parseError(slot.Rename1R1, p.cI, first[slot.Rename1R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.Rename1R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Rename1R2) {
+				p.parseError(slot.Rename1R2, p.cI, first[slot.Rename1R2])
+				break
+			}
+
+			p.call(slot.Rename1R3, cU, p.cI)
+		case slot.Rename1R3: // Rename : TokID as TokID ∙
+
+			if p.follow(symbols.NT_Rename) {
+				p.rtn(symbols.NT_Rename, cU, p.cI)
+			} else {
+				p.parseError(slot.Rename1R0, p.cI, followSets[symbols.NT_Rename])
+			}
+		case slot.Renames0R0: // Renames : ∙Rename
+
+			p.call(slot.Renames0R1, cU, p.cI)
+		case slot.Renames0R1: // Renames : Rename ∙
+
+			if p.follow(symbols.NT_Renames) {
+				p.rtn(symbols.NT_Renames, cU, p.cI)
+			} else {
+				p.parseError(slot.Renames0R0, p.cI, followSets[symbols.NT_Renames])
+			}
+		case slot.Renames1R0: // Renames : ∙Rename , Renames
+
+			p.call(slot.Renames1R1, cU, p.cI)
+		case slot.Renames1R1: // Renames : Rename ∙, Renames
+
+			if !p.testSelect(slot.Renames1R1) {
+				p.parseError(slot.Renames1R1, p.cI, first[slot.Renames1R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.Renames1R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Renames1R2) {
+				p.parseError(slot.Renames1R2, p.cI, first[slot.Renames1R2])
+				break
+			}
+
+			p.call(slot.Renames1R3, cU, p.cI)
+		case slot.Renames1R3: // Renames : Rename , Renames ∙
+
+			if p.follow(symbols.NT_Renames) {
+				p.rtn(symbols.NT_Renames, cU, p.cI)
+			} else {
+				p.parseError(slot.Renames1R0, p.cI, followSets[symbols.NT_Renames])
+			}
 		case slot.Rule0R0: // Rule : ∙LexRule
 
 			p.call(slot.Rule0R1, cU, p.cI)
@@ -520,6 +866,26 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.Rule1R0, p.cI, followSets[symbols.NT_Rule])
 			}
+		case slot.Rule2R0: // Rule : ∙Import
+
+			p.call(slot.Rule2R1, cU, p.cI)
+		case slot.Rule2R1: // Rule : Import ∙
+
+			if p.follow(symbols.NT_Rule) {
+				p.rtn(symbols.NT_Rule, cU, p.cI)
+			} else {
+				p.parseError(slot.Rule2R0, p.cI, followSets[symbols.NT_Rule])
+			}
+		case slot.Rule3R0: // Rule : ∙Start
+
+			p.call(slot.Rule3R1, cU, p.cI)
+		case slot.Rule3R1: // Rule : Start ∙
+
+			if p.follow(symbols.NT_Rule) {
+				p.rtn(symbols.NT_Rule, cU, p.cI)
+			} else {
+				p.parseError(slot.Rule3R0, p.cI, followSets[symbols.NT_Rule])
+			}
 		case slot.Rules0R0: // Rules : ∙Rule
 
 			p.call(slot.Rules0R1, cU, p.cI)
@@ -548,6 +914,63 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.Rules1R0, p.cI, followSets[symbols.NT_Rules])
 			}
+		case slot.Start0R0: // Start : ∙start StartSymbols ;
+
+			p.bsrSet.Add(slot.Start0R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Start0R1) {
+				p.parseError(slot.Start0R1, p.cI, first[slot.Start0R1])
+				break
+			}
+
+			p.call(slot.Start0R2, cU, p.cI)
+		case slot.Start0R2: // Start : start StartSymbols ∙;
+
+			if !p.testSelect(slot.Start0R2) {
+				p.parseError(slot.Start0R2, p.cI, first[slot.Start0R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.Start0R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_Start) {
+				p.rtn(symbols.NT_Start, cU, p.cI)
+			} else {
+				p.parseError(slot.Start0R0, p.cI, followSets[symbols.NT_Start])
+			}
+		case slot.StartSymbols0R0: // StartSymbols : ∙nt
+
+			p.bsrSet.Add(slot.StartSymbols0R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_StartSymbols) {
+				p.rtn(symbols.NT_StartSymbols, cU, p.cI)
+			} else {
+				p.parseError(slot.StartSymbols0R0, p.cI, followSets[symbols.NT_StartSymbols])
+			}
+		case slot.StartSymbols1R0: // StartSymbols : ∙nt , StartSymbols
+
+			p.bsrSet.Add(slot.StartSymbols1R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.StartSymbols1R1) {
+				p.parseError(slot.StartSymbols1R1, p.cI, first[slot.StartSymbols1R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.StartSymbols1R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.StartSymbols1R2) {
+				p.parseError(slot.StartSymbols1R2, p.cI, first[slot.StartSymbols1R2])
+				break
+			}
+
+			p.call(slot.StartSymbols1R3, cU, p.cI)
+		case slot.StartSymbols1R3: // StartSymbols : nt , StartSymbols ∙
+
+			if p.follow(symbols.NT_StartSymbols) {
+				p.rtn(symbols.NT_StartSymbols, cU, p.cI)
+			} else {
+				p.parseError(slot.StartSymbols1R0, p.cI, followSets[symbols.NT_StartSymbols])
+			}
 		case slot.SyntaxAlternate0R0: // SyntaxAlternate : ∙SyntaxSymbols
 
 			p.call(slot.SyntaxAlternate0R1, cU, p.cI)
@@ -567,6 +990,53 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.SyntaxAlternate1R0, p.cI, followSets[symbols.NT_SyntaxAlternate])
 			}
+		case slot.SyntaxAlternate2R0: // SyntaxAlternate : ∙SyntaxSymbols # nt
+
+			p.call(slot.SyntaxAlternate2R1, cU, p.cI)
+		case slot.SyntaxAlternate2R1: // SyntaxAlternate : SyntaxSymbols ∙# nt
+
+			if !p.testSelect(slot.SyntaxAlternate2R1) {
+				p.parseError(slot.SyntaxAlternate2R1, p.cI, first[slot.SyntaxAlternate2R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.SyntaxAlternate2R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.SyntaxAlternate2R2) {
+				p.parseError(slot.SyntaxAlternate2R2, p.cI, first[slot.SyntaxAlternate2R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.SyntaxAlternate2R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_SyntaxAlternate) {
+				p.rtn(symbols.NT_SyntaxAlternate, cU, p.cI)
+			} else {
+				p.parseError(slot.SyntaxAlternate2R0, p.cI, followSets[symbols.NT_SyntaxAlternate])
+			}
+		case slot.SyntaxAlternate3R0: // SyntaxAlternate : ∙empty # nt
+
+			p.bsrSet.Add(slot.SyntaxAlternate3R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.SyntaxAlternate3R1) {
+				p.parseError(slot.SyntaxAlternate3R1, p.cI, first[slot.SyntaxAlternate3R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.SyntaxAlternate3R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.SyntaxAlternate3R2) {
+				p.parseError(slot.SyntaxAlternate3R2, p.cI, first[slot.SyntaxAlternate3R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.SyntaxAlternate3R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_SyntaxAlternate) {
+				p.rtn(symbols.NT_SyntaxAlternate, cU, p.cI)
+			} else {
+				p.parseError(slot.SyntaxAlternate3R0, p.cI, followSets[symbols.NT_SyntaxAlternate])
+			}
 		case slot.SyntaxAlternates0R0: // SyntaxAlternates : ∙SyntaxAlternate
 
 			p.call(slot.SyntaxAlternates0R1, cU, p.cI)
@@ -606,87 +1076,312 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 
 			p.bsrSet.Add(slot.SyntaxRule0R1, cU, p.cI, p.cI+1)
 			p.cI++
-			if !p.testSelect(slot.SyntaxRule0R1) {
-				p.parseError(slot.SyntaxRule0R1, p.cI, first[slot.SyntaxRule0R1])
+			if !p.testSelect(slot.SyntaxRule0R1) {
+				p.parseError(slot.SyntaxRule0R1, p.cI, first[slot.SyntaxRule0R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.SyntaxRule0R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.SyntaxRule0R2) {
+				p.parseError(slot.SyntaxRule0R2, p.cI, first[slot.SyntaxRule0R2])
+				break
+			}
+
+			p.call(slot.SyntaxRule0R3, cU, p.cI)
+		case slot.SyntaxRule0R3: // SyntaxRule : nt : SyntaxAlternates ∙;
+
+			if !p.testSelect(slot.SyntaxRule0R3) {
+				p.parseError(slot.SyntaxRule0R3, p.cI, first[slot.SyntaxRule0R3])
+				break
+			}
+
+			p.bsrSet.Add(slot.SyntaxRule0R4, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_SyntaxRule) {
+				p.rtn(symbols.NT_SyntaxRule, cU, p.cI)
+			} else {
+				p.parseError(slot.SyntaxRule0R0, p.cI, followSets[symbols.NT_SyntaxRule])
+			}
+		case slot.SyntaxRule1R0: // SyntaxRule : ∙nt < TemplateParams > : SyntaxAlternates ;
+
+			p.bsrSet.Add(slot.SyntaxRule1R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.SyntaxRule1R1) {
+				p.parseError(slot.SyntaxRule1R1, p.cI, first[slot.SyntaxRule1R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.SyntaxRule1R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.SyntaxRule1R2) {
+				p.parseError(slot.SyntaxRule1R2, p.cI, first[slot.SyntaxRule1R2])
+				break
+			}
+
+			p.call(slot.SyntaxRule1R3, cU, p.cI)
+		case slot.SyntaxRule1R3: // SyntaxRule : nt < TemplateParams ∙> : SyntaxAlternates ;
+
+			if !p.testSelect(slot.SyntaxRule1R3) {
+				p.parseError(slot.SyntaxRule1R3, p.cI, first[slot.SyntaxRule1R3])
+				break
+			}
+
+			p.bsrSet.Add(slot.SyntaxRule1R4, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.SyntaxRule1R4) {
+				p.parseError(slot.SyntaxRule1R4, p.cI, first[slot.SyntaxRule1R4])
+				break
+			}
+
+			p.bsrSet.Add(slot.SyntaxRule1R5, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.SyntaxRule1R5) {
+				p.parseError(slot.SyntaxRule1R5, p.cI, first[slot.SyntaxRule1R5])
+				break
+			}
+
+			p.call(slot.SyntaxRule1R6, cU, p.cI)
+		case slot.SyntaxRule1R6: // SyntaxRule : nt < TemplateParams > : SyntaxAlternates ∙;
+
+			if !p.testSelect(slot.SyntaxRule1R6) {
+				p.parseError(slot.SyntaxRule1R6, p.cI, first[slot.SyntaxRule1R6])
+				break
+			}
+
+			p.bsrSet.Add(slot.SyntaxRule1R7, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_SyntaxRule) {
+				p.rtn(symbols.NT_SyntaxRule, cU, p.cI)
+			} else {
+				p.parseError(slot.SyntaxRule1R0, p.cI, followSets[symbols.NT_SyntaxRule])
+			}
+		case slot.SyntaxSymbol0R0: // SyntaxSymbol : ∙nt
+
+			p.bsrSet.Add(slot.SyntaxSymbol0R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_SyntaxSymbol) {
+				p.rtn(symbols.NT_SyntaxSymbol, cU, p.cI)
+			} else {
+				p.parseError(slot.SyntaxSymbol0R0, p.cI, followSets[symbols.NT_SyntaxSymbol])
+			}
+		case slot.SyntaxSymbol1R0: // SyntaxSymbol : ∙TokID
+
+			p.call(slot.SyntaxSymbol1R1, cU, p.cI)
+		case slot.SyntaxSymbol1R1: // SyntaxSymbol : TokID ∙
+
+			if p.follow(symbols.NT_SyntaxSymbol) {
+				p.rtn(symbols.NT_SyntaxSymbol, cU, p.cI)
+			} else {
+				p.parseError(slot.SyntaxSymbol1R0, p.cI, followSets[symbols.NT_SyntaxSymbol])
+			}
+		case slot.SyntaxSymbol2R0: // SyntaxSymbol : ∙string_lit
+
+			p.bsrSet.Add(slot.SyntaxSymbol2R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_SyntaxSymbol) {
+				p.rtn(symbols.NT_SyntaxSymbol, cU, p.cI)
+			} else {
+				p.parseError(slot.SyntaxSymbol2R0, p.cI, followSets[symbols.NT_SyntaxSymbol])
+			}
+		case slot.SyntaxSymbol3R0: // SyntaxSymbol : ∙istring_lit
+
+			p.bsrSet.Add(slot.SyntaxSymbol3R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_SyntaxSymbol) {
+				p.rtn(symbols.NT_SyntaxSymbol, cU, p.cI)
+			} else {
+				p.parseError(slot.SyntaxSymbol3R0, p.cI, followSets[symbols.NT_SyntaxSymbol])
+			}
+		case slot.SyntaxSymbol4R0: // SyntaxSymbol : ∙nt < TemplateArgs >
+
+			p.bsrSet.Add(slot.SyntaxSymbol4R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.SyntaxSymbol4R1) {
+				p.parseError(slot.SyntaxSymbol4R1, p.cI, first[slot.SyntaxSymbol4R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.SyntaxSymbol4R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.SyntaxSymbol4R2) {
+				p.parseError(slot.SyntaxSymbol4R2, p.cI, first[slot.SyntaxSymbol4R2])
+				break
+			}
+
+			p.call(slot.SyntaxSymbol4R3, cU, p.cI)
+		case slot.SyntaxSymbol4R3: // SyntaxSymbol : nt < TemplateArgs ∙>
+
+			if !p.testSelect(slot.SyntaxSymbol4R3) {
+				p.parseError(slot.SyntaxSymbol4R3, p.cI, first[slot.SyntaxSymbol4R3])
+				break
+			}
+
+			p.bsrSet.Add(slot.SyntaxSymbol4R4, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_SyntaxSymbol) {
+				p.rtn(symbols.NT_SyntaxSymbol, cU, p.cI)
+			} else {
+				p.parseError(slot.SyntaxSymbol4R0, p.cI, followSets[symbols.NT_SyntaxSymbol])
+			}
+		case slot.SyntaxSymbols0R0: // SyntaxSymbols : ∙LabelledSymbol
+
+			p.call(slot.SyntaxSymbols0R1, cU, p.cI)
+		case slot.SyntaxSymbols0R1: // SyntaxSymbols : LabelledSymbol ∙
+
+			if p.follow(symbols.NT_SyntaxSymbols) {
+				p.rtn(symbols.NT_SyntaxSymbols, cU, p.cI)
+			} else {
+				p.parseError(slot.SyntaxSymbols0R0, p.cI, followSets[symbols.NT_SyntaxSymbols])
+			}
+		case slot.SyntaxSymbols1R0: // SyntaxSymbols : ∙LabelledSymbol SyntaxSymbols
+
+			p.call(slot.SyntaxSymbols1R1, cU, p.cI)
+		case slot.SyntaxSymbols1R1: // SyntaxSymbols : LabelledSymbol ∙SyntaxSymbols
+
+			if !p.testSelect(slot.SyntaxSymbols1R1) {
+				p.parseError(slot.SyntaxSymbols1R1, p.cI, first[slot.SyntaxSymbols1R1])
+				break
+			}
+
+			p.call(slot.SyntaxSymbols1R2, cU, p.cI)
+		case slot.SyntaxSymbols1R2: // SyntaxSymbols : LabelledSymbol SyntaxSymbols ∙
+
+			if p.follow(symbols.NT_SyntaxSymbols) {
+				p.rtn(symbols.NT_SyntaxSymbols, cU, p.cI)
+			} else {
+				p.parseError(slot.SyntaxSymbols1R0, p.cI, followSets[symbols.NT_SyntaxSymbols])
+			}
+		case slot.TemplateArgs0R0: // TemplateArgs : ∙SyntaxSymbol
+
+			p.call(slot.TemplateArgs0R1, cU, p.cI)
+		case slot.TemplateArgs0R1: // TemplateArgs : SyntaxSymbol ∙
+
+			if p.follow(symbols.NT_TemplateArgs) {
+				p.rtn(symbols.NT_TemplateArgs, cU, p.cI)
+			} else {
+				p.parseError(slot.TemplateArgs0R0, p.cI, followSets[symbols.NT_TemplateArgs])
+			}
+		case slot.TemplateArgs1R0: // TemplateArgs : ∙SyntaxSymbol , TemplateArgs
+
+			p.call(slot.TemplateArgs1R1, cU, p.cI)
+		case slot.TemplateArgs1R1: // TemplateArgs : SyntaxSymbol ∙, TemplateArgs
+
+			if !p.testSelect(slot.TemplateArgs1R1) {
+				p.parseError(slot.TemplateArgs1R1, p.cI, first[slot.TemplateArgs1R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.TemplateArgs1R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.TemplateArgs1R2) {
+				p.parseError(slot.TemplateArgs1R2, p.cI, first[slot.TemplateArgs1R2])
+				break
+			}
+
+			p.call(slot.TemplateArgs1R3, cU, p.cI)
+		case slot.TemplateArgs1R3: // TemplateArgs : SyntaxSymbol , TemplateArgs ∙
+
+			if p.follow(symbols.NT_TemplateArgs) {
+				p.rtn(symbols.NT_TemplateArgs, cU, p.cI)
+			} else {
+				p.parseError(slot.TemplateArgs1R0, p.cI, followSets[symbols.NT_TemplateArgs])
+			}
+		case slot.TemplateParams0R0: // TemplateParams : ∙nt
+
+			p.bsrSet.Add(slot.TemplateParams0R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_TemplateParams) {
+				p.rtn(symbols.NT_TemplateParams, cU, p.cI)
+			} else {
+				p.parseError(slot.TemplateParams0R0, p.cI, followSets[symbols.NT_TemplateParams])
+			}
+		case slot.TemplateParams1R0: // TemplateParams : ∙nt , TemplateParams
+
+			p.bsrSet.Add(slot.TemplateParams1R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.TemplateParams1R1) {
+				p.parseError(slot.TemplateParams1R1, p.cI, first[slot.TemplateParams1R1])
 				break
 			}
 
-			p.bsrSet.Add(slot.SyntaxRule0R2, cU, p.cI, p.cI+1)
+			p.bsrSet.Add(slot.TemplateParams1R2, cU, p.cI, p.cI+1)
 			p.cI++
-			if !p.testSelect(slot.SyntaxRule0R2) {
-				p.parseError(slot.SyntaxRule0R2, p.cI, first[slot.SyntaxRule0R2])
+			if !p.testSelect(slot.TemplateParams1R2) {
+				p.parseError(slot.TemplateParams1R2, p.cI, first[slot.TemplateParams1R2])
 				break
 			}
 
-			p.call(slot.SyntaxRule0R3, cU, p.cI)
-		case slot.SyntaxRule0R3: // SyntaxRule : nt : SyntaxAlternates ∙;
+			p.call(slot.TemplateParams1R3, cU, p.cI)
+		case slot.TemplateParams1R3: // TemplateParams : nt , TemplateParams ∙
 
-			if !p.testSelect(slot.SyntaxRule0R3) {
-				p.parseError(slot.SyntaxRule0R3, p.cI, first[slot.SyntaxRule0R3])
-				break
+			if p.follow(symbols.NT_TemplateParams) {
+				p.rtn(symbols.NT_TemplateParams, cU, p.cI)
+			} else {
+				p.parseError(slot.TemplateParams1R0, p.cI, followSets[symbols.NT_TemplateParams])
 			}
+		case slot.TokID0R0: // TokID : ∙tokid
 
-			p.bsrSet.Add(slot.SyntaxRule0R4, cU, p.cI, p.cI+1)
+			p.bsrSet.Add(slot.TokID0R1, cU, p.cI, p.cI+1)
 			p.cI++
-			if p.follow(symbols.NT_SyntaxRule) {
-				p.rtn(symbols.NT_SyntaxRule, cU, p.cI)
+			if p.follow(symbols.NT_TokID) {
+				p.rtn(symbols.NT_TokID, cU, p.cI)
 			} else {
-				p.parseError(slot.SyntaxRule0R0, p.cI, followSets[symbols.NT_SyntaxRule])
+				p.parseError(slot.TokID0R0, p.cI, followSets[symbols.NT_TokID])
 			}
-		case slot.SyntaxSymbol0R0: // SyntaxSymbol : ∙nt
+		case slot.TokID1R0: // TokID : ∙as
 
-			p.bsrSet.Add(slot.SyntaxSymbol0R1, cU, p.cI, p.cI+1)
+			p.bsrSet.Add(slot.TokID1R1, cU, p.cI, p.cI+1)
 			p.cI++
-			if p.follow(symbols.NT_SyntaxSymbol) {
-				p.rtn(symbols.NT_SyntaxSymbol, cU, p.cI)
+			if p.follow(symbols.NT_TokID) {
+				p.rtn(symbols.NT_TokID, cU, p.cI)
 			} else {
-				p.parseError(slot.SyntaxSymbol0R0, p.cI, followSets[symbols.NT_SyntaxSymbol])
+				p.parseError(slot.TokID1R0, p.cI, followSets[symbols.NT_TokID])
 			}
-		case slot.SyntaxSymbol1R0: // SyntaxSymbol : ∙tokid
+		case slot.TokID2R0: // TokID : ∙case_insensitive
 
-			p.bsrSet.Add(slot.SyntaxSymbol1R1, cU, p.cI, p.cI+1)
+			p.bsrSet.Add(slot.TokID2R1, cU, p.cI, p.cI+1)
 			p.cI++
-			if p.follow(symbols.NT_SyntaxSymbol) {
-				p.rtn(symbols.NT_SyntaxSymbol, cU, p.cI)
+			if p.follow(symbols.NT_TokID) {
+				p.rtn(symbols.NT_TokID, cU, p.cI)
 			} else {
-				p.parseError(slot.SyntaxSymbol1R0, p.cI, followSets[symbols.NT_SyntaxSymbol])
+				p.parseError(slot.TokID2R0, p.cI, followSets[symbols.NT_TokID])
 			}
-		case slot.SyntaxSymbol2R0: // SyntaxSymbol : ∙string_lit
+		case slot.TokID3R0: // TokID : ∙import
 
-			p.bsrSet.Add(slot.SyntaxSymbol2R1, cU, p.cI, p.cI+1)
+			p.bsrSet.Add(slot.TokID3R1, cU, p.cI, p.cI+1)
 			p.cI++
-			if p.follow(symbols.NT_SyntaxSymbol) {
-				p.rtn(symbols.NT_SyntaxSymbol, cU, p.cI)
+			if p.follow(symbols.NT_TokID) {
+				p.rtn(symbols.NT_TokID, cU, p.cI)
 			} else {
-				p.parseError(slot.SyntaxSymbol2R0, p.cI, followSets[symbols.NT_SyntaxSymbol])
+				p.parseError(slot.TokID3R0, p.cI, followSets[symbols.NT_TokID])
 			}
-		case slot.SyntaxSymbols0R0: // SyntaxSymbols : ∙SyntaxSymbol
+		case slot.TokID4R0: // TokID : ∙prefix
 
-			p.call(slot.SyntaxSymbols0R1, cU, p.cI)
-		case slot.SyntaxSymbols0R1: // SyntaxSymbols : SyntaxSymbol ∙
-
-			if p.follow(symbols.NT_SyntaxSymbols) {
-				p.rtn(symbols.NT_SyntaxSymbols, cU, p.cI)
+			p.bsrSet.Add(slot.TokID4R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_TokID) {
+				p.rtn(symbols.NT_TokID, cU, p.cI)
 			} else {
-				p.parseError(slot.SyntaxSymbols0R0, p.cI, followSets[symbols.NT_SyntaxSymbols])
+				p.parseError(slot.TokID4R0, p.cI, followSets[symbols.NT_TokID])
 			}
-		case slot.SyntaxSymbols1R0: // SyntaxSymbols : ∙SyntaxSymbol SyntaxSymbols
-
-			p.call(slot.SyntaxSymbols1R1, cU, p.cI)
-		case slot.SyntaxSymbols1R1: // SyntaxSymbols : SyntaxSymbol ∙SyntaxSymbols
+		case slot.TokID5R0: // TokID : ∙rename
 
-			if !p.testSelect(slot.SyntaxSymbols1R1) {
-				p.parseError(slot.SyntaxSymbols1R1, p.cI, first[slot.SyntaxSymbols1R1])
-				break
+			p.bsrSet.Add(slot.TokID5R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_TokID) {
+				p.rtn(symbols.NT_TokID, cU, p.cI)
+			} else {
+				p.parseError(slot.TokID5R0, p.cI, followSets[symbols.NT_TokID])
 			}
+		case slot.TokID6R0: // TokID : ∙start
 
-			p.call(slot.SyntaxSymbols1R2, cU, p.cI)
-		case slot.SyntaxSymbols1R2: // SyntaxSymbols : SyntaxSymbol SyntaxSymbols ∙
-
-			if p.follow(symbols.NT_SyntaxSymbols) {
-				p.rtn(symbols.NT_SyntaxSymbols, cU, p.cI)
+			p.bsrSet.Add(slot.TokID6R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_TokID) {
+				p.rtn(symbols.NT_TokID, cU, p.cI)
 			} else {
-				p.parseError(slot.SyntaxSymbols1R0, p.cI, followSets[symbols.NT_SyntaxSymbols])
+				p.parseError(slot.TokID6R0, p.cI, followSets[symbols.NT_TokID])
 			}
 		case slot.UnicodeCategory0R0: // UnicodeCategory : ∙\p{Cc}
 
@@ -1494,6 +2189,44 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.UnicodeSet0R0, p.cI, followSets[symbols.NT_UnicodeSet])
 			}
+		case slot.UnicodeSet1R0: // UnicodeSet : ∙set_char_lit - char_lit UnicodeSetSpecs ]'
+
+			p.bsrSet.Add(slot.UnicodeSet1R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.UnicodeSet1R1) {
+				p.parseError(slot.UnicodeSet1R1, p.cI, first[slot.UnicodeSet1R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.UnicodeSet1R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.UnicodeSet1R2) {
+				p.parseError(slot.UnicodeSet1R2, p.cI, first[slot.UnicodeSet1R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.UnicodeSet1R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.UnicodeSet1R3) {
+				p.parseError(slot.UnicodeSet1R3, p.cI, first[slot.UnicodeSet1R3])
+				break
+			}
+
+			p.call(slot.UnicodeSet1R4, cU, p.cI)
+		case slot.UnicodeSet1R4: // UnicodeSet : set_char_lit - char_lit UnicodeSetSpecs ∙]'
+
+			if !p.testSelect(slot.UnicodeSet1R4) {
+				p.parseError(slot.UnicodeSet1R4, p.cI, first[slot.UnicodeSet1R4])
+				break
+			}
+
+			p.bsrSet.Add(slot.UnicodeSet1R5, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_UnicodeSet) {
+				p.rtn(symbols.NT_UnicodeSet, cU, p.cI)
+			} else {
+				p.parseError(slot.UnicodeSet1R0, p.cI, followSets[symbols.NT_UnicodeSet])
+			}
 		case slot.UnicodeSetSpec0R0: // UnicodeSetSpec : ∙UnicodeCategory
 
 			p.call(slot.UnicodeSetSpec0R1, cU, p.cI)
@@ -1514,6 +2247,16 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.UnicodeSetSpec1R0, p.cI, followSets[symbols.NT_UnicodeSetSpec])
 			}
+		case slot.UnicodeSetSpec2R0: // UnicodeSetSpec : ∙CharRange
+
+			p.call(slot.UnicodeSetSpec2R1, cU, p.cI)
+		case slot.UnicodeSetSpec2R1: // UnicodeSetSpec : CharRange ∙
+
+			if p.follow(symbols.NT_UnicodeSetSpec) {
+				p.rtn(symbols.NT_UnicodeSetSpec, cU, p.cI)
+			} else {
+				p.parseError(slot.UnicodeSetSpec2R0, p.cI, followSets[symbols.NT_UnicodeSetSpec])
+			}
 		case slot.UnicodeSetSpecs0R0: // UnicodeSetSpecs : ∙
 			p.bsrSet.AddEmpty(slot.UnicodeSetSpecs0R0, p.cI)
 
@@ -1565,9 +2308,9 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			panic("This must not happen")
 		}
 	}
-	if !p.bsrSet.Contain(symbols.NT_GoGLL, 0, m) {
+	if len(p.lex.Errors) > 0 || !p.bsrSet.Contain(p.start, 0, m) {
 		p.sortParseErrors()
-		return nil, p.parseErrors
+		return nil, append(p.lexErrors(), p.parseErrors...)
 	}
 	return p.bsrSet, nil
 }
@@ -1806,1168 +2549,1999 @@ func (p *parser) testSelect(l slot.Label) bool {
 }
 
 var first = []map[token.Type]string{
+	// CharRange : ∙char_lit - char_lit
+	{
+		token.T_101: "char_lit",
+	},
+	// CharRange : char_lit ∙- char_lit
+	{
+		token.T_6: "-",
+	},
+	// CharRange : char_lit - ∙char_lit
+	{
+		token.T_101: "char_lit",
+	},
+	// CharRange : char_lit - char_lit ∙
+	{
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_6:   "-",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_96:  "]",
+		token.T_97:  "]'",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
+	},
 	// GoGLL : ∙Package Rules
 	{
-		token.T_103: "package",
+		token.T_110: "package",
 	},
 	// GoGLL : Package ∙Rules
 	{
 		token.T_0:   "!",
-		token.T_101: "nt",
-		token.T_105: "tokid",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
 	},
 	// GoGLL : Package Rules ∙
 	{
 		token.EOF: "$",
 	},
+	// GoGLL : ∙Rules
+	{
+		token.T_0:   "!",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// GoGLL : Rules ∙
+	{
+		token.EOF: "$",
+	},
+	// Import : ∙import string_lit ;
+	{
+		token.T_103: "import",
+	},
+	// Import : import ∙string_lit ;
+	{
+		token.T_115: "string_lit",
+	},
+	// Import : import string_lit ∙;
+	{
+		token.T_9: ";",
+	},
+	// Import : import string_lit ; ∙
+	{
+		token.T_0:   "!",
+		token.EOF:   "$",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// Import : ∙import string_lit prefix nt ;
+	{
+		token.T_103: "import",
+	},
+	// Import : import ∙string_lit prefix nt ;
+	{
+		token.T_115: "string_lit",
+	},
+	// Import : import string_lit ∙prefix nt ;
+	{
+		token.T_111: "prefix",
+	},
+	// Import : import string_lit prefix ∙nt ;
+	{
+		token.T_108: "nt",
+	},
+	// Import : import string_lit prefix nt ∙;
+	{
+		token.T_9: ";",
+	},
+	// Import : import string_lit prefix nt ; ∙
+	{
+		token.T_0:   "!",
+		token.EOF:   "$",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// Import : ∙import string_lit rename Renames ;
+	{
+		token.T_103: "import",
+	},
+	// Import : import ∙string_lit rename Renames ;
+	{
+		token.T_115: "string_lit",
+	},
+	// Import : import string_lit ∙rename Renames ;
+	{
+		token.T_112: "rename",
+	},
+	// Import : import string_lit rename ∙Renames ;
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// Import : import string_lit rename Renames ∙;
+	{
+		token.T_9: ";",
+	},
+	// Import : import string_lit rename Renames ; ∙
+	{
+		token.T_0:   "!",
+		token.EOF:   "$",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// LabelledSymbol : ∙SyntaxSymbol
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+	},
+	// LabelledSymbol : SyntaxSymbol ∙
+	{
+		token.T_1:   "#",
+		token.T_9:   ";",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+		token.T_119: "|",
+	},
+	// LabelledSymbol : ∙TokID : SyntaxSymbol
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// LabelledSymbol : TokID ∙: SyntaxSymbol
+	{
+		token.T_8: ":",
+	},
+	// LabelledSymbol : TokID : ∙SyntaxSymbol
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+	},
+	// LabelledSymbol : TokID : SyntaxSymbol ∙
+	{
+		token.T_1:   "#",
+		token.T_9:   ";",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+		token.T_119: "|",
+	},
 	// LexAlternates : ∙RegExp
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_5:   ".",
-		token.T_8:   "<",
-		token.T_10:  "[",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_7:   ".",
+		token.T_10:  "<",
+		token.T_13:  "[",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
 	},
 	// LexAlternates : RegExp ∙
 	{
-		token.T_3:   ")",
-		token.T_9:   ">",
-		token.T_93:  "]",
-		token.T_109: "}",
+		token.T_4:   ")",
+		token.T_11:  ">",
+		token.T_96:  "]",
+		token.T_120: "}",
 	},
 	// LexAlternates : ∙RegExp | LexAlternates
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_5:   ".",
-		token.T_8:   "<",
-		token.T_10:  "[",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_7:   ".",
+		token.T_10:  "<",
+		token.T_13:  "[",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
 	},
 	// LexAlternates : RegExp ∙| LexAlternates
 	{
-		token.T_108: "|",
+		token.T_119: "|",
 	},
 	// LexAlternates : RegExp | ∙LexAlternates
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_5:   ".",
-		token.T_8:   "<",
-		token.T_10:  "[",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_7:   ".",
+		token.T_10:  "<",
+		token.T_13:  "[",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
 	},
 	// LexAlternates : RegExp | LexAlternates ∙
 	{
-		token.T_3:   ")",
-		token.T_9:   ">",
-		token.T_93:  "]",
-		token.T_109: "}",
+		token.T_4:   ")",
+		token.T_11:  ">",
+		token.T_96:  "]",
+		token.T_120: "}",
 	},
 	// LexBracket : ∙LexGroup
 	{
-		token.T_2: "(",
+		token.T_3: "(",
 	},
 	// LexBracket : LexGroup ∙
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// LexBracket : ∙LexOptional
 	{
-		token.T_10: "[",
+		token.T_13: "[",
 	},
 	// LexBracket : LexOptional ∙
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// LexBracket : ∙LexZeroOrMore
 	{
-		token.T_107: "{",
+		token.T_118: "{",
 	},
 	// LexBracket : LexZeroOrMore ∙
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// LexBracket : ∙LexOneOrMore
 	{
-		token.T_8: "<",
+		token.T_10: "<",
 	},
 	// LexBracket : LexOneOrMore ∙
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// LexGroup : ∙( LexAlternates )
 	{
-		token.T_2: "(",
+		token.T_3: "(",
 	},
 	// LexGroup : ( ∙LexAlternates )
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_5:   ".",
-		token.T_8:   "<",
-		token.T_10:  "[",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_7:   ".",
+		token.T_10:  "<",
+		token.T_13:  "[",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
 	},
 	// LexGroup : ( LexAlternates ∙)
 	{
-		token.T_3: ")",
+		token.T_4: ")",
 	},
 	// LexGroup : ( LexAlternates ) ∙
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// LexOneOrMore : ∙< LexAlternates >
 	{
-		token.T_8: "<",
+		token.T_10: "<",
 	},
 	// LexOneOrMore : < ∙LexAlternates >
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_5:   ".",
-		token.T_8:   "<",
-		token.T_10:  "[",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_7:   ".",
+		token.T_10:  "<",
+		token.T_13:  "[",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
 	},
 	// LexOneOrMore : < LexAlternates ∙>
 	{
-		token.T_9: ">",
+		token.T_11: ">",
 	},
 	// LexOneOrMore : < LexAlternates > ∙
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// LexOptional : ∙[ LexAlternates ]
 	{
-		token.T_10: "[",
+		token.T_13: "[",
 	},
 	// LexOptional : [ ∙LexAlternates ]
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_5:   ".",
-		token.T_8:   "<",
-		token.T_10:  "[",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_7:   ".",
+		token.T_10:  "<",
+		token.T_13:  "[",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
 	},
 	// LexOptional : [ LexAlternates ∙]
 	{
-		token.T_93: "]",
+		token.T_96: "]",
 	},
 	// LexOptional : [ LexAlternates ] ∙
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
-	},
-	// LexRule : ∙tokid : RegExp ;
-	{
-		token.T_105: "tokid",
-	},
-	// LexRule : tokid ∙: RegExp ;
-	{
-		token.T_6: ":",
-	},
-	// LexRule : tokid : ∙RegExp ;
-	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_5:   ".",
-		token.T_8:   "<",
-		token.T_10:  "[",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-	},
-	// LexRule : tokid : RegExp ∙;
-	{
-		token.T_7: ";",
-	},
-	// LexRule : tokid : RegExp ; ∙
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
+	},
+	// LexRule : ∙TokID : RegExp ;
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// LexRule : TokID ∙: RegExp ;
+	{
+		token.T_8: ":",
+	},
+	// LexRule : TokID : ∙RegExp ;
+	{
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_7:   ".",
+		token.T_10:  "<",
+		token.T_13:  "[",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+	},
+	// LexRule : TokID : RegExp ∙;
+	{
+		token.T_9: ";",
+	},
+	// LexRule : TokID : RegExp ; ∙
 	{
 		token.T_0:   "!",
 		token.EOF:   "$",
-		token.T_101: "nt",
-		token.T_105: "tokid",
-	},
-	// LexRule : ∙! tokid : RegExp ;
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// LexRule : ∙! TokID : RegExp ;
 	{
 		token.T_0: "!",
 	},
-	// LexRule : ! ∙tokid : RegExp ;
+	// LexRule : ! ∙TokID : RegExp ;
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// LexRule : ! TokID ∙: RegExp ;
+	{
+		token.T_8: ":",
+	},
+	// LexRule : ! TokID : ∙RegExp ;
+	{
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_7:   ".",
+		token.T_10:  "<",
+		token.T_13:  "[",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+	},
+	// LexRule : ! TokID : RegExp ∙;
+	{
+		token.T_9: ";",
+	},
+	// LexRule : ! TokID : RegExp ; ∙
 	{
-		token.T_105: "tokid",
-	},
-	// LexRule : ! tokid ∙: RegExp ;
-	{
-		token.T_6: ":",
-	},
-	// LexRule : ! tokid : ∙RegExp ;
-	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_5:   ".",
-		token.T_8:   "<",
-		token.T_10:  "[",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-	},
-	// LexRule : ! tokid : RegExp ∙;
-	{
-		token.T_7: ";",
-	},
-	// LexRule : ! tokid : RegExp ; ∙
+		token.T_0:   "!",
+		token.EOF:   "$",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// LexRule : ∙@ TokID : RegExp ;
+	{
+		token.T_12: "@",
+	},
+	// LexRule : @ ∙TokID : RegExp ;
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// LexRule : @ TokID ∙: RegExp ;
+	{
+		token.T_8: ":",
+	},
+	// LexRule : @ TokID : ∙RegExp ;
+	{
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_7:   ".",
+		token.T_10:  "<",
+		token.T_13:  "[",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+	},
+	// LexRule : @ TokID : RegExp ∙;
+	{
+		token.T_9: ";",
+	},
+	// LexRule : @ TokID : RegExp ; ∙
 	{
 		token.T_0:   "!",
 		token.EOF:   "$",
-		token.T_101: "nt",
-		token.T_105: "tokid",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
 	},
 	// LexSymbol : ∙.
 	{
-		token.T_5: ".",
+		token.T_7: ".",
 	},
 	// LexSymbol : . ∙
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// LexSymbol : ∙any string_lit
 	{
-		token.T_95: "any",
+		token.T_98: "any",
 	},
 	// LexSymbol : any ∙string_lit
 	{
-		token.T_104: "string_lit",
+		token.T_115: "string_lit",
 	},
 	// LexSymbol : any string_lit ∙
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// LexSymbol : ∙char_lit
 	{
-		token.T_96: "char_lit",
+		token.T_101: "char_lit",
 	},
 	// LexSymbol : char_lit ∙
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// LexSymbol : ∙LexBracket
 	{
-		token.T_2:   "(",
-		token.T_8:   "<",
-		token.T_10:  "[",
-		token.T_107: "{",
+		token.T_3:   "(",
+		token.T_10:  "<",
+		token.T_13:  "[",
+		token.T_118: "{",
 	},
 	// LexSymbol : LexBracket ∙
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// LexSymbol : ∙not string_lit
 	{
-		token.T_100: "not",
+		token.T_107: "not",
 	},
 	// LexSymbol : not ∙string_lit
 	{
-		token.T_104: "string_lit",
+		token.T_115: "string_lit",
 	},
 	// LexSymbol : not string_lit ∙
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// LexSymbol : ∙UnicodeClass
 	{
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_102: "number",
-		token.T_106: "upcase",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_109: "number",
+		token.T_117: "upcase",
 	},
 	// LexSymbol : UnicodeClass ∙
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// LexSymbol : ∙UnicodeSet
 	{
-		token.T_1: "'[",
+		token.T_2:   "'[",
+		token.T_113: "set_char_lit",
 	},
 	// LexSymbol : UnicodeSet ∙
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
+	},
+	// LexSymbol : ∙CharRange
+	{
+		token.T_101: "char_lit",
+	},
+	// LexSymbol : CharRange ∙
+	{
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// LexZeroOrMore : ∙{ LexAlternates }
 	{
-		token.T_107: "{",
+		token.T_118: "{",
 	},
 	// LexZeroOrMore : { ∙LexAlternates }
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_5:   ".",
-		token.T_8:   "<",
-		token.T_10:  "[",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_7:   ".",
+		token.T_10:  "<",
+		token.T_13:  "[",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
 	},
 	// LexZeroOrMore : { LexAlternates ∙}
 	{
-		token.T_109: "}",
+		token.T_120: "}",
 	},
 	// LexZeroOrMore : { LexAlternates } ∙
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// Package : ∙package string_lit
 	{
-		token.T_103: "package",
+		token.T_110: "package",
 	},
 	// Package : package ∙string_lit
 	{
-		token.T_104: "string_lit",
+		token.T_115: "string_lit",
 	},
 	// Package : package string_lit ∙
 	{
 		token.T_0:   "!",
-		token.T_101: "nt",
-		token.T_105: "tokid",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// Package : ∙package string_lit case_insensitive
+	{
+		token.T_110: "package",
+	},
+	// Package : package ∙string_lit case_insensitive
+	{
+		token.T_115: "string_lit",
+	},
+	// Package : package string_lit ∙case_insensitive
+	{
+		token.T_100: "case_insensitive",
+	},
+	// Package : package string_lit case_insensitive ∙
+	{
+		token.T_0:   "!",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
 	},
 	// PlusOrMinUnicodeSet : ∙UnicodeSetSpec
 	{
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_101: "char_lit",
 	},
 	// PlusOrMinUnicodeSet : UnicodeSetSpec ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// PlusOrMinUnicodeSet : ∙- UnicodeSetSpec
 	{
-		token.T_4: "-",
+		token.T_6: "-",
 	},
 	// PlusOrMinUnicodeSet : - ∙UnicodeSetSpec
 	{
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_101: "char_lit",
 	},
 	// PlusOrMinUnicodeSet : - UnicodeSetSpec ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// RegExp : ∙LexSymbol
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_5:   ".",
-		token.T_8:   "<",
-		token.T_10:  "[",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_106: "upcase",
-		token.T_107: "{",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_7:   ".",
+		token.T_10:  "<",
+		token.T_13:  "[",
+		token.T_98:  "any",
+		token.T_101: "char_lit",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_113: "set_char_lit",
+		token.T_117: "upcase",
+		token.T_118: "{",
 	},
 	// RegExp : LexSymbol ∙
 	{
-		token.T_3:   ")",
-		token.T_7:   ";",
-		token.T_9:   ">",
-		token.T_93:  "]",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_4:   ")",
+		token.T_9:   ";",
+		token.T_11:  ">",
+		token.T_96:  "]",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
-	// RegExp : ∙tokid
+	// RegExp : ∙TokID
 	{
-		token.T_105: "tokid",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
 	},
-	// RegExp : tokid ∙
+	// RegExp : TokID ∙
 	{
-		token.T_3:   ")",
-		token.T_7:   ";",
-		token.T_9:   ">",
-		token.T_93:  "]",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_4:   ")",
+		token.T_9:   ";",
+		token.T_11:  ">",
+		token.T_96:  "]",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// RegExp : ∙LexSymbol RegExp
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_5:   ".",
-		token.T_8:   "<",
-		token.T_10:  "[",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_106: "upcase",
-		token.T_107: "{",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_7:   ".",
+		token.T_10:  "<",
+		token.T_13:  "[",
+		token.T_98:  "any",
+		token.T_101: "char_lit",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_113: "set_char_lit",
+		token.T_117: "upcase",
+		token.T_118: "{",
 	},
 	// RegExp : LexSymbol ∙RegExp
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_5:   ".",
-		token.T_8:   "<",
-		token.T_10:  "[",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_7:   ".",
+		token.T_10:  "<",
+		token.T_13:  "[",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
 	},
 	// RegExp : LexSymbol RegExp ∙
 	{
-		token.T_3:   ")",
-		token.T_7:   ";",
-		token.T_9:   ">",
-		token.T_93:  "]",
-		token.T_108: "|",
-		token.T_109: "}",
-	},
-	// RegExp : ∙tokid RegExp
-	{
-		token.T_105: "tokid",
-	},
-	// RegExp : tokid ∙RegExp
-	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_5:   ".",
-		token.T_8:   "<",
-		token.T_10:  "[",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-	},
-	// RegExp : tokid RegExp ∙
-	{
-		token.T_3:   ")",
-		token.T_7:   ";",
-		token.T_9:   ">",
-		token.T_93:  "]",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_4:   ")",
+		token.T_9:   ";",
+		token.T_11:  ">",
+		token.T_96:  "]",
+		token.T_119: "|",
+		token.T_120: "}",
+	},
+	// RegExp : ∙TokID RegExp
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// RegExp : TokID ∙RegExp
+	{
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_7:   ".",
+		token.T_10:  "<",
+		token.T_13:  "[",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+	},
+	// RegExp : TokID RegExp ∙
+	{
+		token.T_4:   ")",
+		token.T_9:   ";",
+		token.T_11:  ">",
+		token.T_96:  "]",
+		token.T_119: "|",
+		token.T_120: "}",
+	},
+	// Rename : ∙nt as nt
+	{
+		token.T_108: "nt",
+	},
+	// Rename : nt ∙as nt
+	{
+		token.T_99: "as",
+	},
+	// Rename : nt as ∙nt
+	{
+		token.T_108: "nt",
+	},
+	// Rename : nt as nt ∙
+	{
+		token.T_5: ",",
+		token.T_9: ";",
+	},
+	// Rename : ∙TokID as TokID
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// Rename : TokID ∙as TokID
+	{
+		token.T_99: "as",
+	},
+	// Rename : TokID as ∙TokID
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// Rename : TokID as TokID ∙
+	{
+		token.T_5: ",",
+		token.T_9: ";",
+	},
+	// Renames : ∙Rename
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// Renames : Rename ∙
+	{
+		token.T_9: ";",
+	},
+	// Renames : ∙Rename , Renames
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// Renames : Rename ∙, Renames
+	{
+		token.T_5: ",",
+	},
+	// Renames : Rename , ∙Renames
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// Renames : Rename , Renames ∙
+	{
+		token.T_9: ";",
 	},
 	// Rule : ∙LexRule
 	{
 		token.T_0:   "!",
-		token.T_105: "tokid",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
 	},
 	// Rule : LexRule ∙
 	{
 		token.T_0:   "!",
 		token.EOF:   "$",
-		token.T_101: "nt",
-		token.T_105: "tokid",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
 	},
 	// Rule : ∙SyntaxRule
 	{
-		token.T_101: "nt",
+		token.T_108: "nt",
 	},
 	// Rule : SyntaxRule ∙
 	{
 		token.T_0:   "!",
 		token.EOF:   "$",
-		token.T_101: "nt",
-		token.T_105: "tokid",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// Rule : ∙Import
+	{
+		token.T_103: "import",
+	},
+	// Rule : Import ∙
+	{
+		token.T_0:   "!",
+		token.EOF:   "$",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// Rule : ∙Start
+	{
+		token.T_114: "start",
+	},
+	// Rule : Start ∙
+	{
+		token.T_0:   "!",
+		token.EOF:   "$",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
 	},
 	// Rules : ∙Rule
 	{
 		token.T_0:   "!",
-		token.T_101: "nt",
-		token.T_105: "tokid",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
 	},
 	// Rules : Rule ∙
 	{
@@ -2976,9172 +4550,10517 @@ var first = []map[token.Type]string{
 	// Rules : ∙Rule Rules
 	{
 		token.T_0:   "!",
-		token.T_101: "nt",
-		token.T_105: "tokid",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
 	},
 	// Rules : Rule ∙Rules
 	{
 		token.T_0:   "!",
-		token.T_101: "nt",
-		token.T_105: "tokid",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
 	},
 	// Rules : Rule Rules ∙
 	{
 		token.EOF: "$",
 	},
+	// Start : ∙start StartSymbols ;
+	{
+		token.T_114: "start",
+	},
+	// Start : start ∙StartSymbols ;
+	{
+		token.T_108: "nt",
+	},
+	// Start : start StartSymbols ∙;
+	{
+		token.T_9: ";",
+	},
+	// Start : start StartSymbols ; ∙
+	{
+		token.T_0:   "!",
+		token.EOF:   "$",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// StartSymbols : ∙nt
+	{
+		token.T_108: "nt",
+	},
+	// StartSymbols : nt ∙
+	{
+		token.T_9: ";",
+	},
+	// StartSymbols : ∙nt , StartSymbols
+	{
+		token.T_108: "nt",
+	},
+	// StartSymbols : nt ∙, StartSymbols
+	{
+		token.T_5: ",",
+	},
+	// StartSymbols : nt , ∙StartSymbols
+	{
+		token.T_108: "nt",
+	},
+	// StartSymbols : nt , StartSymbols ∙
+	{
+		token.T_9: ";",
+	},
 	// SyntaxAlternate : ∙SyntaxSymbols
 	{
-		token.T_101: "nt",
-		token.T_104: "string_lit",
-		token.T_105: "tokid",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
 	},
 	// SyntaxAlternate : SyntaxSymbols ∙
 	{
-		token.T_7:   ";",
-		token.T_108: "|",
+		token.T_9:   ";",
+		token.T_119: "|",
 	},
 	// SyntaxAlternate : ∙empty
 	{
-		token.T_97: "empty",
+		token.T_102: "empty",
 	},
 	// SyntaxAlternate : empty ∙
 	{
-		token.T_7:   ";",
-		token.T_108: "|",
+		token.T_9:   ";",
+		token.T_119: "|",
+	},
+	// SyntaxAlternate : ∙SyntaxSymbols # nt
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+	},
+	// SyntaxAlternate : SyntaxSymbols ∙# nt
+	{
+		token.T_1: "#",
+	},
+	// SyntaxAlternate : SyntaxSymbols # ∙nt
+	{
+		token.T_108: "nt",
+	},
+	// SyntaxAlternate : SyntaxSymbols # nt ∙
+	{
+		token.T_9:   ";",
+		token.T_119: "|",
+	},
+	// SyntaxAlternate : ∙empty # nt
+	{
+		token.T_102: "empty",
+	},
+	// SyntaxAlternate : empty ∙# nt
+	{
+		token.T_1: "#",
+	},
+	// SyntaxAlternate : empty # ∙nt
+	{
+		token.T_108: "nt",
+	},
+	// SyntaxAlternate : empty # nt ∙
+	{
+		token.T_9:   ";",
+		token.T_119: "|",
 	},
 	// SyntaxAlternates : ∙SyntaxAlternate
 	{
-		token.T_97:  "empty",
-		token.T_101: "nt",
-		token.T_104: "string_lit",
-		token.T_105: "tokid",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_102: "empty",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
 	},
 	// SyntaxAlternates : SyntaxAlternate ∙
 	{
-		token.T_7: ";",
+		token.T_9: ";",
 	},
 	// SyntaxAlternates : ∙SyntaxAlternate | SyntaxAlternates
 	{
-		token.T_97:  "empty",
-		token.T_101: "nt",
-		token.T_104: "string_lit",
-		token.T_105: "tokid",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_102: "empty",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
 	},
 	// SyntaxAlternates : SyntaxAlternate ∙| SyntaxAlternates
 	{
-		token.T_108: "|",
+		token.T_119: "|",
 	},
 	// SyntaxAlternates : SyntaxAlternate | ∙SyntaxAlternates
 	{
-		token.T_97:  "empty",
-		token.T_101: "nt",
-		token.T_104: "string_lit",
-		token.T_105: "tokid",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_102: "empty",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
 	},
 	// SyntaxAlternates : SyntaxAlternate | SyntaxAlternates ∙
 	{
-		token.T_7: ";",
+		token.T_9: ";",
 	},
 	// SyntaxRule : ∙nt : SyntaxAlternates ;
 	{
-		token.T_101: "nt",
+		token.T_108: "nt",
 	},
 	// SyntaxRule : nt ∙: SyntaxAlternates ;
 	{
-		token.T_6: ":",
+		token.T_8: ":",
 	},
 	// SyntaxRule : nt : ∙SyntaxAlternates ;
 	{
-		token.T_97:  "empty",
-		token.T_101: "nt",
-		token.T_104: "string_lit",
-		token.T_105: "tokid",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_102: "empty",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
 	},
 	// SyntaxRule : nt : SyntaxAlternates ∙;
 	{
-		token.T_7: ";",
+		token.T_9: ";",
 	},
 	// SyntaxRule : nt : SyntaxAlternates ; ∙
 	{
 		token.T_0:   "!",
 		token.EOF:   "$",
-		token.T_101: "nt",
-		token.T_105: "tokid",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
 	},
-	// SyntaxSymbol : ∙nt
+	// SyntaxRule : ∙nt < TemplateParams > : SyntaxAlternates ;
 	{
-		token.T_101: "nt",
+		token.T_108: "nt",
 	},
-	// SyntaxSymbol : nt ∙
+	// SyntaxRule : nt ∙< TemplateParams > : SyntaxAlternates ;
 	{
-		token.T_7:   ";",
-		token.T_101: "nt",
-		token.T_104: "string_lit",
-		token.T_105: "tokid",
-		token.T_108: "|",
+		token.T_10: "<",
 	},
-	// SyntaxSymbol : ∙tokid
+	// SyntaxRule : nt < ∙TemplateParams > : SyntaxAlternates ;
 	{
-		token.T_105: "tokid",
+		token.T_108: "nt",
 	},
-	// SyntaxSymbol : tokid ∙
+	// SyntaxRule : nt < TemplateParams ∙> : SyntaxAlternates ;
 	{
-		token.T_7:   ";",
-		token.T_101: "nt",
-		token.T_104: "string_lit",
-		token.T_105: "tokid",
-		token.T_108: "|",
+		token.T_11: ">",
 	},
-	// SyntaxSymbol : ∙string_lit
+	// SyntaxRule : nt < TemplateParams > ∙: SyntaxAlternates ;
 	{
-		token.T_104: "string_lit",
+		token.T_8: ":",
 	},
-	// SyntaxSymbol : string_lit ∙
+	// SyntaxRule : nt < TemplateParams > : ∙SyntaxAlternates ;
 	{
-		token.T_7:   ";",
-		token.T_101: "nt",
-		token.T_104: "string_lit",
-		token.T_105: "tokid",
-		token.T_108: "|",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_102: "empty",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
 	},
-	// SyntaxSymbols : ∙SyntaxSymbol
+	// SyntaxRule : nt < TemplateParams > : SyntaxAlternates ∙;
 	{
-		token.T_101: "nt",
-		token.T_104: "string_lit",
-		token.T_105: "tokid",
+		token.T_9: ";",
 	},
-	// SyntaxSymbols : SyntaxSymbol ∙
+	// SyntaxRule : nt < TemplateParams > : SyntaxAlternates ; ∙
 	{
-		token.T_7:   ";",
-		token.T_108: "|",
+		token.T_0:   "!",
+		token.EOF:   "$",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// SyntaxSymbol : ∙nt
+	{
+		token.T_108: "nt",
+	},
+	// SyntaxSymbol : nt ∙
+	{
+		token.T_1:   "#",
+		token.T_5:   ",",
+		token.T_9:   ";",
+		token.T_11:  ">",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+		token.T_119: "|",
+	},
+	// SyntaxSymbol : ∙TokID
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// SyntaxSymbol : TokID ∙
+	{
+		token.T_1:   "#",
+		token.T_5:   ",",
+		token.T_9:   ";",
+		token.T_11:  ">",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+		token.T_119: "|",
 	},
-	// SyntaxSymbols : ∙SyntaxSymbol SyntaxSymbols
+	// SyntaxSymbol : ∙string_lit
 	{
-		token.T_101: "nt",
-		token.T_104: "string_lit",
-		token.T_105: "tokid",
+		token.T_115: "string_lit",
 	},
-	// SyntaxSymbols : SyntaxSymbol ∙SyntaxSymbols
+	// SyntaxSymbol : string_lit ∙
 	{
-		token.T_101: "nt",
-		token.T_104: "string_lit",
-		token.T_105: "tokid",
+		token.T_1:   "#",
+		token.T_5:   ",",
+		token.T_9:   ";",
+		token.T_11:  ">",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+		token.T_119: "|",
+	},
+	// SyntaxSymbol : ∙istring_lit
+	{
+		token.T_104: "istring_lit",
+	},
+	// SyntaxSymbol : istring_lit ∙
+	{
+		token.T_1:   "#",
+		token.T_5:   ",",
+		token.T_9:   ";",
+		token.T_11:  ">",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+		token.T_119: "|",
+	},
+	// SyntaxSymbol : ∙nt < TemplateArgs >
+	{
+		token.T_108: "nt",
+	},
+	// SyntaxSymbol : nt ∙< TemplateArgs >
+	{
+		token.T_10: "<",
+	},
+	// SyntaxSymbol : nt < ∙TemplateArgs >
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+	},
+	// SyntaxSymbol : nt < TemplateArgs ∙>
+	{
+		token.T_11: ">",
+	},
+	// SyntaxSymbol : nt < TemplateArgs > ∙
+	{
+		token.T_1:   "#",
+		token.T_5:   ",",
+		token.T_9:   ";",
+		token.T_11:  ">",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+		token.T_119: "|",
+	},
+	// SyntaxSymbols : ∙LabelledSymbol
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+	},
+	// SyntaxSymbols : LabelledSymbol ∙
+	{
+		token.T_1:   "#",
+		token.T_9:   ";",
+		token.T_119: "|",
+	},
+	// SyntaxSymbols : ∙LabelledSymbol SyntaxSymbols
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
 	},
-	// SyntaxSymbols : SyntaxSymbol SyntaxSymbols ∙
+	// SyntaxSymbols : LabelledSymbol ∙SyntaxSymbols
 	{
-		token.T_7:   ";",
-		token.T_108: "|",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+	},
+	// SyntaxSymbols : LabelledSymbol SyntaxSymbols ∙
+	{
+		token.T_1:   "#",
+		token.T_9:   ";",
+		token.T_119: "|",
+	},
+	// TemplateArgs : ∙SyntaxSymbol
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+	},
+	// TemplateArgs : SyntaxSymbol ∙
+	{
+		token.T_11: ">",
+	},
+	// TemplateArgs : ∙SyntaxSymbol , TemplateArgs
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+	},
+	// TemplateArgs : SyntaxSymbol ∙, TemplateArgs
+	{
+		token.T_5: ",",
+	},
+	// TemplateArgs : SyntaxSymbol , ∙TemplateArgs
+	{
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+	},
+	// TemplateArgs : SyntaxSymbol , TemplateArgs ∙
+	{
+		token.T_11: ">",
+	},
+	// TemplateParams : ∙nt
+	{
+		token.T_108: "nt",
+	},
+	// TemplateParams : nt ∙
+	{
+		token.T_11: ">",
+	},
+	// TemplateParams : ∙nt , TemplateParams
+	{
+		token.T_108: "nt",
+	},
+	// TemplateParams : nt ∙, TemplateParams
+	{
+		token.T_5: ",",
+	},
+	// TemplateParams : nt , ∙TemplateParams
+	{
+		token.T_108: "nt",
+	},
+	// TemplateParams : nt , TemplateParams ∙
+	{
+		token.T_11: ">",
+	},
+	// TokID : ∙tokid
+	{
+		token.T_116: "tokid",
+	},
+	// TokID : tokid ∙
+	{
+		token.T_1:   "#",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_5:   ",",
+		token.T_7:   ".",
+		token.T_8:   ":",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_108: "nt",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
+	},
+	// TokID : ∙as
+	{
+		token.T_99: "as",
+	},
+	// TokID : as ∙
+	{
+		token.T_1:   "#",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_5:   ",",
+		token.T_7:   ".",
+		token.T_8:   ":",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_108: "nt",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
+	},
+	// TokID : ∙case_insensitive
+	{
+		token.T_100: "case_insensitive",
+	},
+	// TokID : case_insensitive ∙
+	{
+		token.T_1:   "#",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_5:   ",",
+		token.T_7:   ".",
+		token.T_8:   ":",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_108: "nt",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
+	},
+	// TokID : ∙import
+	{
+		token.T_103: "import",
+	},
+	// TokID : import ∙
+	{
+		token.T_1:   "#",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_5:   ",",
+		token.T_7:   ".",
+		token.T_8:   ":",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_108: "nt",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
+	},
+	// TokID : ∙prefix
+	{
+		token.T_111: "prefix",
+	},
+	// TokID : prefix ∙
+	{
+		token.T_1:   "#",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_5:   ",",
+		token.T_7:   ".",
+		token.T_8:   ":",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_108: "nt",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
+	},
+	// TokID : ∙rename
+	{
+		token.T_112: "rename",
+	},
+	// TokID : rename ∙
+	{
+		token.T_1:   "#",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_5:   ",",
+		token.T_7:   ".",
+		token.T_8:   ":",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_108: "nt",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
+	},
+	// TokID : ∙start
+	{
+		token.T_114: "start",
+	},
+	// TokID : start ∙
+	{
+		token.T_1:   "#",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_5:   ",",
+		token.T_7:   ".",
+		token.T_8:   ":",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_108: "nt",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// UnicodeCategory : ∙\p{Cc}
 	{
-		token.T_13: "\\p{Cc}",
+		token.T_16: "\\p{Cc}",
 	},
 	// UnicodeCategory : \p{Cc} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Cf}
 	{
-		token.T_14: "\\p{Cf}",
+		token.T_17: "\\p{Cf}",
 	},
 	// UnicodeCategory : \p{Cf} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Co}
 	{
-		token.T_15: "\\p{Co}",
+		token.T_18: "\\p{Co}",
 	},
 	// UnicodeCategory : \p{Co} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Cs}
 	{
-		token.T_16: "\\p{Cs}",
+		token.T_19: "\\p{Cs}",
 	},
 	// UnicodeCategory : \p{Cs} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Digit}
 	{
-		token.T_21: "\\p{Digit}",
+		token.T_24: "\\p{Digit}",
 	},
 	// UnicodeCategory : \p{Digit} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Nd}
 	{
-		token.T_43: "\\p{Nd}",
+		token.T_46: "\\p{Nd}",
 	},
 	// UnicodeCategory : \p{Nd} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Letter}
 	{
-		token.T_29: "\\p{Letter}",
+		token.T_32: "\\p{Letter}",
 	},
 	// UnicodeCategory : \p{Letter} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{L}
 	{
-		token.T_37: "\\p{L}",
+		token.T_40: "\\p{L}",
 	},
 	// UnicodeCategory : \p{L} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Lm}
 	{
-		token.T_31: "\\p{Lm}",
+		token.T_34: "\\p{Lm}",
 	},
 	// UnicodeCategory : \p{Lm} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Lo}
 	{
-		token.T_34: "\\p{Lo}",
+		token.T_37: "\\p{Lo}",
 	},
 	// UnicodeCategory : \p{Lo} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Lower}
 	{
-		token.T_33: "\\p{Lower}",
+		token.T_36: "\\p{Lower}",
 	},
 	// UnicodeCategory : \p{Lower} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Ll}
 	{
-		token.T_30: "\\p{Ll}",
+		token.T_33: "\\p{Ll}",
 	},
 	// UnicodeCategory : \p{Ll} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Mark}
 	{
-		token.T_38: "\\p{Mark}",
+		token.T_41: "\\p{Mark}",
 	},
 	// UnicodeCategory : \p{Mark} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{M}
 	{
-		token.T_42: "\\p{M}",
+		token.T_45: "\\p{M}",
 	},
 	// UnicodeCategory : \p{M} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Mc}
 	{
-		token.T_39: "\\p{Mc}",
+		token.T_42: "\\p{Mc}",
 	},
 	// UnicodeCategory : \p{Mc} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Me}
 	{
-		token.T_40: "\\p{Me}",
+		token.T_43: "\\p{Me}",
 	},
 	// UnicodeCategory : \p{Me} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Mn}
 	{
-		token.T_41: "\\p{Mn}",
+		token.T_44: "\\p{Mn}",
 	},
 	// UnicodeCategory : \p{Mn} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Nl}
 	{
-		token.T_44: "\\p{Nl}",
+		token.T_47: "\\p{Nl}",
 	},
 	// UnicodeCategory : \p{Nl} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{No}
 	{
-		token.T_46: "\\p{No}",
+		token.T_49: "\\p{No}",
 	},
 	// UnicodeCategory : \p{No} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Number}
 	{
-		token.T_47: "\\p{Number}",
+		token.T_50: "\\p{Number}",
 	},
 	// UnicodeCategory : \p{Number} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{N}
 	{
-		token.T_48: "\\p{N}",
+		token.T_51: "\\p{N}",
 	},
 	// UnicodeCategory : \p{N} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Other}
 	{
-		token.T_57: "\\p{Other}",
+		token.T_60: "\\p{Other}",
 	},
 	// UnicodeCategory : \p{Other} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{C}
 	{
-		token.T_17: "\\p{C}",
+		token.T_20: "\\p{C}",
 	},
 	// UnicodeCategory : \p{C} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Pc}
 	{
-		token.T_60: "\\p{Pc}",
+		token.T_63: "\\p{Pc}",
 	},
 	// UnicodeCategory : \p{Pc} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Pd}
 	{
-		token.T_61: "\\p{Pd}",
+		token.T_64: "\\p{Pd}",
 	},
 	// UnicodeCategory : \p{Pd} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Pe}
 	{
-		token.T_62: "\\p{Pe}",
+		token.T_65: "\\p{Pe}",
 	},
 	// UnicodeCategory : \p{Pe} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Pf}
 	{
-		token.T_63: "\\p{Pf}",
+		token.T_66: "\\p{Pf}",
 	},
 	// UnicodeCategory : \p{Pf} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Pi}
 	{
-		token.T_64: "\\p{Pi}",
+		token.T_67: "\\p{Pi}",
 	},
 	// UnicodeCategory : \p{Pi} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Po}
 	{
-		token.T_65: "\\p{Po}",
+		token.T_68: "\\p{Po}",
 	},
 	// UnicodeCategory : \p{Po} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Ps}
 	{
-		token.T_67: "\\p{Ps}",
+		token.T_70: "\\p{Ps}",
 	},
 	// UnicodeCategory : \p{Ps} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Punct}
 	{
-		token.T_68: "\\p{Punct}",
+		token.T_71: "\\p{Punct}",
 	},
 	// UnicodeCategory : \p{Punct} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{P}
 	{
-		token.T_69: "\\p{P}",
+		token.T_72: "\\p{P}",
 	},
 	// UnicodeCategory : \p{P} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Sc}
 	{
-		token.T_74: "\\p{Sc}",
+		token.T_77: "\\p{Sc}",
 	},
 	// UnicodeCategory : \p{Sc} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Sk}
 	{
-		token.T_76: "\\p{Sk}",
+		token.T_79: "\\p{Sk}",
 	},
 	// UnicodeCategory : \p{Sk} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Sm}
 	{
-		token.T_77: "\\p{Sm}",
+		token.T_80: "\\p{Sm}",
 	},
 	// UnicodeCategory : \p{Sm} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{So}
 	{
-		token.T_79: "\\p{So}",
+		token.T_82: "\\p{So}",
 	},
 	// UnicodeCategory : \p{So} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Space}
 	{
-		token.T_80: "\\p{Space}",
+		token.T_83: "\\p{Space}",
 	},
 	// UnicodeCategory : \p{Space} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Z}
 	{
-		token.T_92: "\\p{Z}",
+		token.T_95: "\\p{Z}",
 	},
 	// UnicodeCategory : \p{Z} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Symbol}
 	{
-		token.T_81: "\\p{Symbol}",
+		token.T_84: "\\p{Symbol}",
 	},
 	// UnicodeCategory : \p{Symbol} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{S}
 	{
-		token.T_82: "\\p{S}",
+		token.T_85: "\\p{S}",
 	},
 	// UnicodeCategory : \p{S} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Title}
 	{
-		token.T_84: "\\p{Title}",
+		token.T_87: "\\p{Title}",
 	},
 	// UnicodeCategory : \p{Title} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Lt}
 	{
-		token.T_35: "\\p{Lt}",
+		token.T_38: "\\p{Lt}",
 	},
 	// UnicodeCategory : \p{Lt} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Upper}
 	{
-		token.T_86: "\\p{Upper}",
+		token.T_89: "\\p{Upper}",
 	},
 	// UnicodeCategory : \p{Upper} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Lu}
 	{
-		token.T_36: "\\p{Lu}",
+		token.T_39: "\\p{Lu}",
 	},
 	// UnicodeCategory : \p{Lu} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Zl}
 	{
-		token.T_89: "\\p{Zl}",
+		token.T_92: "\\p{Zl}",
 	},
 	// UnicodeCategory : \p{Zl} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Zp}
 	{
-		token.T_90: "\\p{Zp}",
+		token.T_93: "\\p{Zp}",
 	},
 	// UnicodeCategory : \p{Zp} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeCategory : ∙\p{Zs}
 	{
-		token.T_91: "\\p{Zs}",
+		token.T_94: "\\p{Zs}",
 	},
 	// UnicodeCategory : \p{Zs} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeClass : ∙letter
 	{
-		token.T_98: "letter",
+		token.T_105: "letter",
 	},
 	// UnicodeClass : letter ∙
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// UnicodeClass : ∙upcase
 	{
-		token.T_106: "upcase",
+		token.T_117: "upcase",
 	},
 	// UnicodeClass : upcase ∙
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// UnicodeClass : ∙lowcase
 	{
-		token.T_99: "lowcase",
+		token.T_106: "lowcase",
 	},
 	// UnicodeClass : lowcase ∙
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// UnicodeClass : ∙number
 	{
-		token.T_102: "number",
+		token.T_109: "number",
 	},
 	// UnicodeClass : number ∙
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// UnicodeProperty : ∙\p{ASCII_Hex_Digit}
 	{
-		token.T_11: "\\p{ASCII_Hex_Digit}",
+		token.T_14: "\\p{ASCII_Hex_Digit}",
 	},
 	// UnicodeProperty : \p{ASCII_Hex_Digit} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Bidi_Control}
 	{
-		token.T_12: "\\p{Bidi_Control}",
+		token.T_15: "\\p{Bidi_Control}",
 	},
 	// UnicodeProperty : \p{Bidi_Control} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Dash}
 	{
-		token.T_18: "\\p{Dash}",
+		token.T_21: "\\p{Dash}",
 	},
 	// UnicodeProperty : \p{Dash} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Deprecated}
 	{
-		token.T_19: "\\p{Deprecated}",
+		token.T_22: "\\p{Deprecated}",
 	},
 	// UnicodeProperty : \p{Deprecated} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Diacritic}
 	{
-		token.T_20: "\\p{Diacritic}",
+		token.T_23: "\\p{Diacritic}",
 	},
 	// UnicodeProperty : \p{Diacritic} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Extender}
 	{
-		token.T_22: "\\p{Extender}",
+		token.T_25: "\\p{Extender}",
 	},
 	// UnicodeProperty : \p{Extender} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Hex_Digit}
 	{
-		token.T_23: "\\p{Hex_Digit}",
+		token.T_26: "\\p{Hex_Digit}",
 	},
 	// UnicodeProperty : \p{Hex_Digit} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Hyphen}
 	{
-		token.T_24: "\\p{Hyphen}",
+		token.T_27: "\\p{Hyphen}",
 	},
 	// UnicodeProperty : \p{Hyphen} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{IDS_Binary_Operator}
 	{
-		token.T_25: "\\p{IDS_Binary_Operator}",
+		token.T_28: "\\p{IDS_Binary_Operator}",
 	},
 	// UnicodeProperty : \p{IDS_Binary_Operator} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{IDS_Trinary_Operator}
 	{
-		token.T_26: "\\p{IDS_Trinary_Operator}",
+		token.T_29: "\\p{IDS_Trinary_Operator}",
 	},
 	// UnicodeProperty : \p{IDS_Trinary_Operator} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Ideographic}
 	{
-		token.T_27: "\\p{Ideographic}",
+		token.T_30: "\\p{Ideographic}",
 	},
 	// UnicodeProperty : \p{Ideographic} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Join_Control}
 	{
-		token.T_28: "\\p{Join_Control}",
+		token.T_31: "\\p{Join_Control}",
 	},
 	// UnicodeProperty : \p{Join_Control} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Logical_Order_Exception}
 	{
-		token.T_32: "\\p{Logical_Order_Exception}",
+		token.T_35: "\\p{Logical_Order_Exception}",
 	},
 	// UnicodeProperty : \p{Logical_Order_Exception} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Noncharacter_Code_Point}
 	{
-		token.T_45: "\\p{Noncharacter_Code_Point}",
+		token.T_48: "\\p{Noncharacter_Code_Point}",
 	},
 	// UnicodeProperty : \p{Noncharacter_Code_Point} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Other_Alphabetic}
 	{
-		token.T_49: "\\p{Other_Alphabetic}",
+		token.T_52: "\\p{Other_Alphabetic}",
 	},
 	// UnicodeProperty : \p{Other_Alphabetic} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Other_Default_Ignorable_Code_Point}
 	{
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_53: "\\p{Other_Default_Ignorable_Code_Point}",
 	},
 	// UnicodeProperty : \p{Other_Default_Ignorable_Code_Point} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Other_Grapheme_Extend}
 	{
-		token.T_51: "\\p{Other_Grapheme_Extend}",
+		token.T_54: "\\p{Other_Grapheme_Extend}",
 	},
 	// UnicodeProperty : \p{Other_Grapheme_Extend} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Other_ID_Continue}
 	{
-		token.T_52: "\\p{Other_ID_Continue}",
+		token.T_55: "\\p{Other_ID_Continue}",
 	},
 	// UnicodeProperty : \p{Other_ID_Continue} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Other_ID_Start}
 	{
-		token.T_53: "\\p{Other_ID_Start}",
+		token.T_56: "\\p{Other_ID_Start}",
 	},
 	// UnicodeProperty : \p{Other_ID_Start} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Other_Lowercase}
 	{
-		token.T_54: "\\p{Other_Lowercase}",
+		token.T_57: "\\p{Other_Lowercase}",
 	},
 	// UnicodeProperty : \p{Other_Lowercase} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Other_Math}
 	{
-		token.T_55: "\\p{Other_Math}",
+		token.T_58: "\\p{Other_Math}",
 	},
 	// UnicodeProperty : \p{Other_Math} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Other_Uppercase}
 	{
-		token.T_56: "\\p{Other_Uppercase}",
+		token.T_59: "\\p{Other_Uppercase}",
 	},
 	// UnicodeProperty : \p{Other_Uppercase} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Pattern_Syntax}
 	{
-		token.T_58: "\\p{Pattern_Syntax}",
+		token.T_61: "\\p{Pattern_Syntax}",
 	},
 	// UnicodeProperty : \p{Pattern_Syntax} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Pattern_White_Space}
 	{
-		token.T_59: "\\p{Pattern_White_Space}",
+		token.T_62: "\\p{Pattern_White_Space}",
 	},
 	// UnicodeProperty : \p{Pattern_White_Space} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Prepended_Concatenation_Mark}
 	{
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
+		token.T_69: "\\p{Prepended_Concatenation_Mark}",
 	},
 	// UnicodeProperty : \p{Prepended_Concatenation_Mark} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Quotation_Mark}
 	{
-		token.T_70: "\\p{Quotation_Mark}",
+		token.T_73: "\\p{Quotation_Mark}",
 	},
 	// UnicodeProperty : \p{Quotation_Mark} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Radical}
 	{
-		token.T_71: "\\p{Radical}",
+		token.T_74: "\\p{Radical}",
 	},
 	// UnicodeProperty : \p{Radical} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Regional_Indicator}
 	{
-		token.T_72: "\\p{Regional_Indicator}",
+		token.T_75: "\\p{Regional_Indicator}",
 	},
 	// UnicodeProperty : \p{Regional_Indicator} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{STerm}
 	{
-		token.T_73: "\\p{STerm}",
+		token.T_76: "\\p{STerm}",
 	},
 	// UnicodeProperty : \p{STerm} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Sentence_Terminal}
 	{
-		token.T_75: "\\p{Sentence_Terminal}",
+		token.T_78: "\\p{Sentence_Terminal}",
 	},
 	// UnicodeProperty : \p{Sentence_Terminal} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Soft_Dotted}
 	{
-		token.T_78: "\\p{Soft_Dotted}",
+		token.T_81: "\\p{Soft_Dotted}",
 	},
 	// UnicodeProperty : \p{Soft_Dotted} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Terminal_Punctuation}
 	{
-		token.T_83: "\\p{Terminal_Punctuation}",
+		token.T_86: "\\p{Terminal_Punctuation}",
 	},
 	// UnicodeProperty : \p{Terminal_Punctuation} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Unified_Ideograph}
 	{
-		token.T_85: "\\p{Unified_Ideograph}",
+		token.T_88: "\\p{Unified_Ideograph}",
 	},
 	// UnicodeProperty : \p{Unified_Ideograph} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{Variation_Selector}
 	{
-		token.T_87: "\\p{Variation_Selector}",
+		token.T_90: "\\p{Variation_Selector}",
 	},
 	// UnicodeProperty : \p{Variation_Selector} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeProperty : ∙\p{White_Space}
 	{
-		token.T_88: "\\p{White_Space}",
+		token.T_91: "\\p{White_Space}",
 	},
 	// UnicodeProperty : \p{White_Space} ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeSet : ∙'[ UnicodeSetSpec UnicodeSetSpecs ]'
 	{
-		token.T_1: "'[",
+		token.T_2: "'[",
 	},
 	// UnicodeSet : '[ ∙UnicodeSetSpec UnicodeSetSpecs ]'
 	{
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_101: "char_lit",
 	},
 	// UnicodeSet : '[ UnicodeSetSpec ∙UnicodeSetSpecs ]'
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeSet : '[ UnicodeSetSpec UnicodeSetSpecs ∙]'
 	{
-		token.T_94: "]'",
+		token.T_97: "]'",
 	},
 	// UnicodeSet : '[ UnicodeSetSpec UnicodeSetSpecs ]' ∙
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
+	},
+	// UnicodeSet : ∙set_char_lit - char_lit UnicodeSetSpecs ]'
+	{
+		token.T_113: "set_char_lit",
+	},
+	// UnicodeSet : set_char_lit ∙- char_lit UnicodeSetSpecs ]'
+	{
+		token.T_6: "-",
+	},
+	// UnicodeSet : set_char_lit - ∙char_lit UnicodeSetSpecs ]'
+	{
+		token.T_101: "char_lit",
+	},
+	// UnicodeSet : set_char_lit - char_lit ∙UnicodeSetSpecs ]'
+	{
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
+	},
+	// UnicodeSet : set_char_lit - char_lit UnicodeSetSpecs ∙]'
+	{
+		token.T_97: "]'",
+	},
+	// UnicodeSet : set_char_lit - char_lit UnicodeSetSpecs ]' ∙
+	{
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// UnicodeSetSpec : ∙UnicodeCategory
 	{
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_21: "\\p{Digit}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_57: "\\p{Other}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_74: "\\p{Sc}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_84: "\\p{Title}",
-		token.T_86: "\\p{Upper}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
+		token.T_16: "\\p{Cc}",
+		token.T_17: "\\p{Cf}",
+		token.T_18: "\\p{Co}",
+		token.T_19: "\\p{Cs}",
+		token.T_20: "\\p{C}",
+		token.T_24: "\\p{Digit}",
+		token.T_32: "\\p{Letter}",
+		token.T_33: "\\p{Ll}",
+		token.T_34: "\\p{Lm}",
+		token.T_36: "\\p{Lower}",
+		token.T_37: "\\p{Lo}",
+		token.T_38: "\\p{Lt}",
+		token.T_39: "\\p{Lu}",
+		token.T_40: "\\p{L}",
+		token.T_41: "\\p{Mark}",
+		token.T_42: "\\p{Mc}",
+		token.T_43: "\\p{Me}",
+		token.T_44: "\\p{Mn}",
+		token.T_45: "\\p{M}",
+		token.T_46: "\\p{Nd}",
+		token.T_47: "\\p{Nl}",
+		token.T_49: "\\p{No}",
+		token.T_50: "\\p{Number}",
+		token.T_51: "\\p{N}",
+		token.T_60: "\\p{Other}",
+		token.T_63: "\\p{Pc}",
+		token.T_64: "\\p{Pd}",
+		token.T_65: "\\p{Pe}",
+		token.T_66: "\\p{Pf}",
+		token.T_67: "\\p{Pi}",
+		token.T_68: "\\p{Po}",
+		token.T_70: "\\p{Ps}",
+		token.T_71: "\\p{Punct}",
+		token.T_72: "\\p{P}",
+		token.T_77: "\\p{Sc}",
+		token.T_79: "\\p{Sk}",
+		token.T_80: "\\p{Sm}",
+		token.T_82: "\\p{So}",
+		token.T_83: "\\p{Space}",
+		token.T_84: "\\p{Symbol}",
+		token.T_85: "\\p{S}",
+		token.T_87: "\\p{Title}",
+		token.T_89: "\\p{Upper}",
+		token.T_92: "\\p{Zl}",
+		token.T_93: "\\p{Zp}",
+		token.T_94: "\\p{Zs}",
+		token.T_95: "\\p{Z}",
 	},
 	// UnicodeSetSpec : UnicodeCategory ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeSetSpec : ∙UnicodeProperty
 	{
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
+		token.T_14: "\\p{ASCII_Hex_Digit}",
+		token.T_15: "\\p{Bidi_Control}",
+		token.T_21: "\\p{Dash}",
+		token.T_22: "\\p{Deprecated}",
+		token.T_23: "\\p{Diacritic}",
+		token.T_25: "\\p{Extender}",
+		token.T_26: "\\p{Hex_Digit}",
+		token.T_27: "\\p{Hyphen}",
+		token.T_28: "\\p{IDS_Binary_Operator}",
+		token.T_29: "\\p{IDS_Trinary_Operator}",
+		token.T_30: "\\p{Ideographic}",
+		token.T_31: "\\p{Join_Control}",
+		token.T_35: "\\p{Logical_Order_Exception}",
+		token.T_48: "\\p{Noncharacter_Code_Point}",
+		token.T_52: "\\p{Other_Alphabetic}",
+		token.T_53: "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54: "\\p{Other_Grapheme_Extend}",
+		token.T_55: "\\p{Other_ID_Continue}",
+		token.T_56: "\\p{Other_ID_Start}",
+		token.T_57: "\\p{Other_Lowercase}",
+		token.T_58: "\\p{Other_Math}",
+		token.T_59: "\\p{Other_Uppercase}",
+		token.T_61: "\\p{Pattern_Syntax}",
+		token.T_62: "\\p{Pattern_White_Space}",
+		token.T_69: "\\p{Prepended_Concatenation_Mark}",
+		token.T_73: "\\p{Quotation_Mark}",
+		token.T_74: "\\p{Radical}",
+		token.T_75: "\\p{Regional_Indicator}",
+		token.T_76: "\\p{STerm}",
+		token.T_78: "\\p{Sentence_Terminal}",
+		token.T_81: "\\p{Soft_Dotted}",
+		token.T_86: "\\p{Terminal_Punctuation}",
+		token.T_88: "\\p{Unified_Ideograph}",
+		token.T_90: "\\p{Variation_Selector}",
+		token.T_91: "\\p{White_Space}",
 	},
 	// UnicodeSetSpec : UnicodeProperty ∙
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
+	},
+	// UnicodeSetSpec : ∙CharRange
+	{
+		token.T_101: "char_lit",
+	},
+	// UnicodeSetSpec : CharRange ∙
+	{
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeSetSpecs : ∙
 	{
-		token.T_94: "]'",
+		token.T_97: "]'",
 	},
 	// UnicodeSetSpecs : ∙UnicodeSpecList
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_101: "char_lit",
 	},
 	// UnicodeSetSpecs : UnicodeSpecList ∙
 	{
-		token.T_94: "]'",
+		token.T_97: "]'",
 	},
 	// UnicodeSpecList : ∙PlusOrMinUnicodeSet
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_101: "char_lit",
 	},
 	// UnicodeSpecList : PlusOrMinUnicodeSet ∙
 	{
-		token.T_94: "]'",
+		token.T_97: "]'",
 	},
 	// UnicodeSpecList : ∙PlusOrMinUnicodeSet UnicodeSpecList
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_101: "char_lit",
 	},
 	// UnicodeSpecList : PlusOrMinUnicodeSet ∙UnicodeSpecList
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_101: "char_lit",
 	},
 	// UnicodeSpecList : PlusOrMinUnicodeSet UnicodeSpecList ∙
 	{
-		token.T_94: "]'",
+		token.T_97: "]'",
 	},
 }
 
 var followSets = []map[token.Type]string{
+	// CharRange
+	{
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_6:   "-",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_96:  "]",
+		token.T_97:  "]'",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
+	},
 	// GoGLL
 	{
 		token.EOF: "$",
 	},
+	// Import
+	{
+		token.T_0:   "!",
+		token.EOF:   "$",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// LabelledSymbol
+	{
+		token.T_1:   "#",
+		token.T_9:   ";",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+		token.T_119: "|",
+	},
 	// LexAlternates
 	{
-		token.T_3:   ")",
-		token.T_9:   ">",
-		token.T_93:  "]",
-		token.T_109: "}",
+		token.T_4:   ")",
+		token.T_11:  ">",
+		token.T_96:  "]",
+		token.T_120: "}",
 	},
 	// LexBracket
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// LexGroup
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// LexOneOrMore
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// LexOptional
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// LexRule
 	{
 		token.T_0:   "!",
 		token.EOF:   "$",
-		token.T_101: "nt",
-		token.T_105: "tokid",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
 	},
 	// LexSymbol
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// LexZeroOrMore
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// Package
 	{
 		token.T_0:   "!",
-		token.T_101: "nt",
-		token.T_105: "tokid",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
 	},
 	// PlusOrMinUnicodeSet
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// RegExp
 	{
-		token.T_3:   ")",
-		token.T_7:   ";",
-		token.T_9:   ">",
-		token.T_93:  "]",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_4:   ")",
+		token.T_9:   ";",
+		token.T_11:  ">",
+		token.T_96:  "]",
+		token.T_119: "|",
+		token.T_120: "}",
+	},
+	// Rename
+	{
+		token.T_5: ",",
+		token.T_9: ";",
+	},
+	// Renames
+	{
+		token.T_9: ";",
 	},
 	// Rule
 	{
 		token.T_0:   "!",
 		token.EOF:   "$",
-		token.T_101: "nt",
-		token.T_105: "tokid",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
 	},
 	// Rules
 	{
 		token.EOF: "$",
 	},
+	// Start
+	{
+		token.T_0:   "!",
+		token.EOF:   "$",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
+	},
+	// StartSymbols
+	{
+		token.T_9: ";",
+	},
 	// SyntaxAlternate
 	{
-		token.T_7:   ";",
-		token.T_108: "|",
+		token.T_9:   ";",
+		token.T_119: "|",
 	},
 	// SyntaxAlternates
 	{
-		token.T_7: ";",
+		token.T_9: ";",
 	},
 	// SyntaxRule
 	{
 		token.T_0:   "!",
 		token.EOF:   "$",
-		token.T_101: "nt",
-		token.T_105: "tokid",
+		token.T_12:  "@",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_116: "tokid",
 	},
 	// SyntaxSymbol
 	{
-		token.T_7:   ";",
-		token.T_101: "nt",
-		token.T_104: "string_lit",
-		token.T_105: "tokid",
-		token.T_108: "|",
+		token.T_1:   "#",
+		token.T_5:   ",",
+		token.T_9:   ";",
+		token.T_11:  ">",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_108: "nt",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+		token.T_119: "|",
 	},
 	// SyntaxSymbols
 	{
-		token.T_7:   ";",
-		token.T_108: "|",
+		token.T_1:   "#",
+		token.T_9:   ";",
+		token.T_119: "|",
+	},
+	// TemplateArgs
+	{
+		token.T_11: ">",
+	},
+	// TemplateParams
+	{
+		token.T_11: ">",
+	},
+	// TokID
+	{
+		token.T_1:   "#",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_5:   ",",
+		token.T_7:   ".",
+		token.T_8:   ":",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_104: "istring_lit",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_108: "nt",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_115: "string_lit",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// UnicodeCategory
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeClass
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// UnicodeProperty
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeSet
 	{
-		token.T_1:   "'[",
-		token.T_2:   "(",
-		token.T_3:   ")",
-		token.T_5:   ".",
-		token.T_7:   ";",
-		token.T_8:   "<",
-		token.T_9:   ">",
-		token.T_10:  "[",
-		token.T_93:  "]",
-		token.T_95:  "any",
-		token.T_96:  "char_lit",
-		token.T_98:  "letter",
-		token.T_99:  "lowcase",
-		token.T_100: "not",
-		token.T_102: "number",
-		token.T_105: "tokid",
-		token.T_106: "upcase",
-		token.T_107: "{",
-		token.T_108: "|",
-		token.T_109: "}",
+		token.T_2:   "'[",
+		token.T_3:   "(",
+		token.T_4:   ")",
+		token.T_7:   ".",
+		token.T_9:   ";",
+		token.T_10:  "<",
+		token.T_11:  ">",
+		token.T_13:  "[",
+		token.T_96:  "]",
+		token.T_98:  "any",
+		token.T_99:  "as",
+		token.T_100: "case_insensitive",
+		token.T_101: "char_lit",
+		token.T_103: "import",
+		token.T_105: "letter",
+		token.T_106: "lowcase",
+		token.T_107: "not",
+		token.T_109: "number",
+		token.T_111: "prefix",
+		token.T_112: "rename",
+		token.T_113: "set_char_lit",
+		token.T_114: "start",
+		token.T_116: "tokid",
+		token.T_117: "upcase",
+		token.T_118: "{",
+		token.T_119: "|",
+		token.T_120: "}",
 	},
 	// UnicodeSetSpec
 	{
-		token.T_4:  "-",
-		token.T_11: "\\p{ASCII_Hex_Digit}",
-		token.T_12: "\\p{Bidi_Control}",
-		token.T_13: "\\p{Cc}",
-		token.T_14: "\\p{Cf}",
-		token.T_15: "\\p{Co}",
-		token.T_16: "\\p{Cs}",
-		token.T_17: "\\p{C}",
-		token.T_18: "\\p{Dash}",
-		token.T_19: "\\p{Deprecated}",
-		token.T_20: "\\p{Diacritic}",
-		token.T_21: "\\p{Digit}",
-		token.T_22: "\\p{Extender}",
-		token.T_23: "\\p{Hex_Digit}",
-		token.T_24: "\\p{Hyphen}",
-		token.T_25: "\\p{IDS_Binary_Operator}",
-		token.T_26: "\\p{IDS_Trinary_Operator}",
-		token.T_27: "\\p{Ideographic}",
-		token.T_28: "\\p{Join_Control}",
-		token.T_29: "\\p{Letter}",
-		token.T_30: "\\p{Ll}",
-		token.T_31: "\\p{Lm}",
-		token.T_32: "\\p{Logical_Order_Exception}",
-		token.T_33: "\\p{Lower}",
-		token.T_34: "\\p{Lo}",
-		token.T_35: "\\p{Lt}",
-		token.T_36: "\\p{Lu}",
-		token.T_37: "\\p{L}",
-		token.T_38: "\\p{Mark}",
-		token.T_39: "\\p{Mc}",
-		token.T_40: "\\p{Me}",
-		token.T_41: "\\p{Mn}",
-		token.T_42: "\\p{M}",
-		token.T_43: "\\p{Nd}",
-		token.T_44: "\\p{Nl}",
-		token.T_45: "\\p{Noncharacter_Code_Point}",
-		token.T_46: "\\p{No}",
-		token.T_47: "\\p{Number}",
-		token.T_48: "\\p{N}",
-		token.T_49: "\\p{Other_Alphabetic}",
-		token.T_50: "\\p{Other_Default_Ignorable_Code_Point}",
-		token.T_51: "\\p{Other_Grapheme_Extend}",
-		token.T_52: "\\p{Other_ID_Continue}",
-		token.T_53: "\\p{Other_ID_Start}",
-		token.T_54: "\\p{Other_Lowercase}",
-		token.T_55: "\\p{Other_Math}",
-		token.T_56: "\\p{Other_Uppercase}",
-		token.T_57: "\\p{Other}",
-		token.T_58: "\\p{Pattern_Syntax}",
-		token.T_59: "\\p{Pattern_White_Space}",
-		token.T_60: "\\p{Pc}",
-		token.T_61: "\\p{Pd}",
-		token.T_62: "\\p{Pe}",
-		token.T_63: "\\p{Pf}",
-		token.T_64: "\\p{Pi}",
-		token.T_65: "\\p{Po}",
-		token.T_66: "\\p{Prepended_Concatenation_Mark}",
-		token.T_67: "\\p{Ps}",
-		token.T_68: "\\p{Punct}",
-		token.T_69: "\\p{P}",
-		token.T_70: "\\p{Quotation_Mark}",
-		token.T_71: "\\p{Radical}",
-		token.T_72: "\\p{Regional_Indicator}",
-		token.T_73: "\\p{STerm}",
-		token.T_74: "\\p{Sc}",
-		token.T_75: "\\p{Sentence_Terminal}",
-		token.T_76: "\\p{Sk}",
-		token.T_77: "\\p{Sm}",
-		token.T_78: "\\p{Soft_Dotted}",
-		token.T_79: "\\p{So}",
-		token.T_80: "\\p{Space}",
-		token.T_81: "\\p{Symbol}",
-		token.T_82: "\\p{S}",
-		token.T_83: "\\p{Terminal_Punctuation}",
-		token.T_84: "\\p{Title}",
-		token.T_85: "\\p{Unified_Ideograph}",
-		token.T_86: "\\p{Upper}",
-		token.T_87: "\\p{Variation_Selector}",
-		token.T_88: "\\p{White_Space}",
-		token.T_89: "\\p{Zl}",
-		token.T_90: "\\p{Zp}",
-		token.T_91: "\\p{Zs}",
-		token.T_92: "\\p{Z}",
-		token.T_94: "]'",
+		token.T_6:   "-",
+		token.T_14:  "\\p{ASCII_Hex_Digit}",
+		token.T_15:  "\\p{Bidi_Control}",
+		token.T_16:  "\\p{Cc}",
+		token.T_17:  "\\p{Cf}",
+		token.T_18:  "\\p{Co}",
+		token.T_19:  "\\p{Cs}",
+		token.T_20:  "\\p{C}",
+		token.T_21:  "\\p{Dash}",
+		token.T_22:  "\\p{Deprecated}",
+		token.T_23:  "\\p{Diacritic}",
+		token.T_24:  "\\p{Digit}",
+		token.T_25:  "\\p{Extender}",
+		token.T_26:  "\\p{Hex_Digit}",
+		token.T_27:  "\\p{Hyphen}",
+		token.T_28:  "\\p{IDS_Binary_Operator}",
+		token.T_29:  "\\p{IDS_Trinary_Operator}",
+		token.T_30:  "\\p{Ideographic}",
+		token.T_31:  "\\p{Join_Control}",
+		token.T_32:  "\\p{Letter}",
+		token.T_33:  "\\p{Ll}",
+		token.T_34:  "\\p{Lm}",
+		token.T_35:  "\\p{Logical_Order_Exception}",
+		token.T_36:  "\\p{Lower}",
+		token.T_37:  "\\p{Lo}",
+		token.T_38:  "\\p{Lt}",
+		token.T_39:  "\\p{Lu}",
+		token.T_40:  "\\p{L}",
+		token.T_41:  "\\p{Mark}",
+		token.T_42:  "\\p{Mc}",
+		token.T_43:  "\\p{Me}",
+		token.T_44:  "\\p{Mn}",
+		token.T_45:  "\\p{M}",
+		token.T_46:  "\\p{Nd}",
+		token.T_47:  "\\p{Nl}",
+		token.T_48:  "\\p{Noncharacter_Code_Point}",
+		token.T_49:  "\\p{No}",
+		token.T_50:  "\\p{Number}",
+		token.T_51:  "\\p{N}",
+		token.T_52:  "\\p{Other_Alphabetic}",
+		token.T_53:  "\\p{Other_Default_Ignorable_Code_Point}",
+		token.T_54:  "\\p{Other_Grapheme_Extend}",
+		token.T_55:  "\\p{Other_ID_Continue}",
+		token.T_56:  "\\p{Other_ID_Start}",
+		token.T_57:  "\\p{Other_Lowercase}",
+		token.T_58:  "\\p{Other_Math}",
+		token.T_59:  "\\p{Other_Uppercase}",
+		token.T_60:  "\\p{Other}",
+		token.T_61:  "\\p{Pattern_Syntax}",
+		token.T_62:  "\\p{Pattern_White_Space}",
+		token.T_63:  "\\p{Pc}",
+		token.T_64:  "\\p{Pd}",
+		token.T_65:  "\\p{Pe}",
+		token.T_66:  "\\p{Pf}",
+		token.T_67:  "\\p{Pi}",
+		token.T_68:  "\\p{Po}",
+		token.T_69:  "\\p{Prepended_Concatenation_Mark}",
+		token.T_70:  "\\p{Ps}",
+		token.T_71:  "\\p{Punct}",
+		token.T_72:  "\\p{P}",
+		token.T_73:  "\\p{Quotation_Mark}",
+		token.T_74:  "\\p{Radical}",
+		token.T_75:  "\\p{Regional_Indicator}",
+		token.T_76:  "\\p{STerm}",
+		token.T_77:  "\\p{Sc}",
+		token.T_78:  "\\p{Sentence_Terminal}",
+		token.T_79:  "\\p{Sk}",
+		token.T_80:  "\\p{Sm}",
+		token.T_81:  "\\p{Soft_Dotted}",
+		token.T_82:  "\\p{So}",
+		token.T_83:  "\\p{Space}",
+		token.T_84:  "\\p{Symbol}",
+		token.T_85:  "\\p{S}",
+		token.T_86:  "\\p{Terminal_Punctuation}",
+		token.T_87:  "\\p{Title}",
+		token.T_88:  "\\p{Unified_Ideograph}",
+		token.T_89:  "\\p{Upper}",
+		token.T_90:  "\\p{Variation_Selector}",
+		token.T_91:  "\\p{White_Space}",
+		token.T_92:  "\\p{Zl}",
+		token.T_93:  "\\p{Zp}",
+		token.T_94:  "\\p{Zs}",
+		token.T_95:  "\\p{Z}",
+		token.T_97:  "]'",
+		token.T_101: "char_lit",
 	},
 	// UnicodeSetSpecs
 	{
-		token.T_94: "]'",
+		token.T_97: "]'",
 	},
 	// UnicodeSpecList
 	{
-		token.T_94: "]'",
+		token.T_97: "]'",
 	},
 }
 
@@ -12173,9 +15092,15 @@ type Error struct {
 
 	// The tokens expected at the point where the error occurred
 	Expected map[token.Type]string
+
+	// LexError is not nil if the error is a lexical error
+	LexError *lexer.LexError
 }
 
 func (pe *Error) String() string {
+	if pe.LexError != nil {
+		return pe.LexError.Error()
+	}
 	w := new(bytes.Buffer)
 	fmt.Fprintf(w, "Parse Error: %s I[%d]=%s at line %d col %d\n",
 		pe.Slot, pe.cI, pe.Token, pe.Line, pe.Column)
@@ -12192,6 +15117,24 @@ func (p *parser) parseError(slot slot.Label, i int, expected map[token.Type]stri
 	p.parseErrors = append(p.parseErrors, pe)
 }
 
+// lexErrors returns an Error for every lexical error in the input
+func (p *parser) lexErrors() (errs []*Error) {
+	for _, le := range p.lex.Errors {
+		for i, tok := range p.lex.Tokens {
+			if tok == le.Token {
+				errs = append(errs, &Error{
+					cI:       i,
+					Token:    tok,
+					Line:     le.Line,
+					Column:   le.Column,
+					LexError: le,
+				})
+			}
+		}
+	}
+	return
+}
+
 func (p *parser) sortParseErrors() {
 	sort.Slice(p.parseErrors,
 		func(i, j int) bool {
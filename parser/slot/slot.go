@@ -12,9 +12,37 @@ import(
 type Label int
 
 const(
-	GoGLL0R0 Label = iota
+	CharRange0R0 Label = iota
+	CharRange0R1
+	CharRange0R2
+	CharRange0R3
+	GoGLL0R0
 	GoGLL0R1
 	GoGLL0R2
+	GoGLL1R0
+	GoGLL1R1
+	Import0R0
+	Import0R1
+	Import0R2
+	Import0R3
+	Import1R0
+	Import1R1
+	Import1R2
+	Import1R3
+	Import1R4
+	Import1R5
+	Import2R0
+	Import2R1
+	Import2R2
+	Import2R3
+	Import2R4
+	Import2R5
+	LabelledSymbol0R0
+	LabelledSymbol0R1
+	LabelledSymbol1R0
+	LabelledSymbol1R1
+	LabelledSymbol1R2
+	LabelledSymbol1R3
 	LexAlternates0R0
 	LexAlternates0R1
 	LexAlternates1R0
@@ -52,6 +80,12 @@ const(
 	LexRule1R3
 	LexRule1R4
 	LexRule1R5
+	LexRule2R0
+	LexRule2R1
+	LexRule2R2
+	LexRule2R3
+	LexRule2R4
+	LexRule2R5
 	LexSymbol0R0
 	LexSymbol0R1
 	LexSymbol1R0
@@ -68,6 +102,8 @@ const(
 	LexSymbol5R1
 	LexSymbol6R0
 	LexSymbol6R1
+	LexSymbol7R0
+	LexSymbol7R1
 	LexZeroOrMore0R0
 	LexZeroOrMore0R1
 	LexZeroOrMore0R2
@@ -75,6 +111,10 @@ const(
 	Package0R0
 	Package0R1
 	Package0R2
+	Package1R0
+	Package1R1
+	Package1R2
+	Package1R3
 	PlusOrMinUnicodeSet0R0
 	PlusOrMinUnicodeSet0R1
 	PlusOrMinUnicodeSet1R0
@@ -90,19 +130,55 @@ const(
 	RegExp3R0
 	RegExp3R1
 	RegExp3R2
+	Rename0R0
+	Rename0R1
+	Rename0R2
+	Rename0R3
+	Rename1R0
+	Rename1R1
+	Rename1R2
+	Rename1R3
+	Renames0R0
+	Renames0R1
+	Renames1R0
+	Renames1R1
+	Renames1R2
+	Renames1R3
 	Rule0R0
 	Rule0R1
 	Rule1R0
 	Rule1R1
+	Rule2R0
+	Rule2R1
+	Rule3R0
+	Rule3R1
 	Rules0R0
 	Rules0R1
 	Rules1R0
 	Rules1R1
 	Rules1R2
+	Start0R0
+	Start0R1
+	Start0R2
+	Start0R3
+	StartSymbols0R0
+	StartSymbols0R1
+	StartSymbols1R0
+	StartSymbols1R1
+	StartSymbols1R2
+	StartSymbols1R3
 	SyntaxAlternate0R0
 	SyntaxAlternate0R1
 	SyntaxAlternate1R0
 	SyntaxAlternate1R1
+	SyntaxAlternate2R0
+	SyntaxAlternate2R1
+	SyntaxAlternate2R2
+	SyntaxAlternate2R3
+	SyntaxAlternate3R0
+	SyntaxAlternate3R1
+	SyntaxAlternate3R2
+	SyntaxAlternate3R3
 	SyntaxAlternates0R0
 	SyntaxAlternates0R1
 	SyntaxAlternates1R0
@@ -114,17 +190,58 @@ const(
 	SyntaxRule0R2
 	SyntaxRule0R3
 	SyntaxRule0R4
+	SyntaxRule1R0
+	SyntaxRule1R1
+	SyntaxRule1R2
+	SyntaxRule1R3
+	SyntaxRule1R4
+	SyntaxRule1R5
+	SyntaxRule1R6
+	SyntaxRule1R7
 	SyntaxSymbol0R0
 	SyntaxSymbol0R1
 	SyntaxSymbol1R0
 	SyntaxSymbol1R1
 	SyntaxSymbol2R0
 	SyntaxSymbol2R1
+	SyntaxSymbol3R0
+	SyntaxSymbol3R1
+	SyntaxSymbol4R0
+	SyntaxSymbol4R1
+	SyntaxSymbol4R2
+	SyntaxSymbol4R3
+	SyntaxSymbol4R4
 	SyntaxSymbols0R0
 	SyntaxSymbols0R1
 	SyntaxSymbols1R0
 	SyntaxSymbols1R1
 	SyntaxSymbols1R2
+	TemplateArgs0R0
+	TemplateArgs0R1
+	TemplateArgs1R0
+	TemplateArgs1R1
+	TemplateArgs1R2
+	TemplateArgs1R3
+	TemplateParams0R0
+	TemplateParams0R1
+	TemplateParams1R0
+	TemplateParams1R1
+	TemplateParams1R2
+	TemplateParams1R3
+	TokID0R0
+	TokID0R1
+	TokID1R0
+	TokID1R1
+	TokID2R0
+	TokID2R1
+	TokID3R0
+	TokID3R1
+	TokID4R0
+	TokID4R1
+	TokID5R0
+	TokID5R1
+	TokID6R0
+	TokID6R1
 	UnicodeCategory0R0
 	UnicodeCategory0R1
 	UnicodeCategory1R0
@@ -302,10 +419,18 @@ const(
 	UnicodeSet0R2
 	UnicodeSet0R3
 	UnicodeSet0R4
+	UnicodeSet1R0
+	UnicodeSet1R1
+	UnicodeSet1R2
+	UnicodeSet1R3
+	UnicodeSet1R4
+	UnicodeSet1R5
 	UnicodeSetSpec0R0
 	UnicodeSetSpec0R1
 	UnicodeSetSpec1R0
 	UnicodeSetSpec1R1
+	UnicodeSetSpec2R0
+	UnicodeSetSpec2R1
 	UnicodeSetSpecs0R0
 	UnicodeSetSpecs1R0
 	UnicodeSetSpecs1R1
@@ -403,6 +528,42 @@ func (s *Slot) String() string {
 }
 
 var slots = map[Label]*Slot{ 
+	CharRange0R0: {
+		symbols.NT_CharRange, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_101, 
+			symbols.T_6, 
+			symbols.T_101,
+		}, 
+		CharRange0R0, 
+	},
+	CharRange0R1: {
+		symbols.NT_CharRange, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_101, 
+			symbols.T_6, 
+			symbols.T_101,
+		}, 
+		CharRange0R1, 
+	},
+	CharRange0R2: {
+		symbols.NT_CharRange, 0, 2, 
+		symbols.Symbols{  
+			symbols.T_101, 
+			symbols.T_6, 
+			symbols.T_101,
+		}, 
+		CharRange0R2, 
+	},
+	CharRange0R3: {
+		symbols.NT_CharRange, 0, 3, 
+		symbols.Symbols{  
+			symbols.T_101, 
+			symbols.T_6, 
+			symbols.T_101,
+		}, 
+		CharRange0R3, 
+	},
 	GoGLL0R0: {
 		symbols.NT_GoGLL, 0, 0, 
 		symbols.Symbols{  
@@ -427,6 +588,238 @@ var slots = map[Label]*Slot{
 		}, 
 		GoGLL0R2, 
 	},
+	GoGLL1R0: {
+		symbols.NT_GoGLL, 1, 0, 
+		symbols.Symbols{  
+			symbols.NT_Rules,
+		}, 
+		GoGLL1R0, 
+	},
+	GoGLL1R1: {
+		symbols.NT_GoGLL, 1, 1, 
+		symbols.Symbols{  
+			symbols.NT_Rules,
+		}, 
+		GoGLL1R1, 
+	},
+	Import0R0: {
+		symbols.NT_Import, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_103, 
+			symbols.T_115, 
+			symbols.T_9,
+		}, 
+		Import0R0, 
+	},
+	Import0R1: {
+		symbols.NT_Import, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_103, 
+			symbols.T_115, 
+			symbols.T_9,
+		}, 
+		Import0R1, 
+	},
+	Import0R2: {
+		symbols.NT_Import, 0, 2, 
+		symbols.Symbols{  
+			symbols.T_103, 
+			symbols.T_115, 
+			symbols.T_9,
+		}, 
+		Import0R2, 
+	},
+	Import0R3: {
+		symbols.NT_Import, 0, 3, 
+		symbols.Symbols{  
+			symbols.T_103, 
+			symbols.T_115, 
+			symbols.T_9,
+		}, 
+		Import0R3, 
+	},
+	Import1R0: {
+		symbols.NT_Import, 1, 0, 
+		symbols.Symbols{  
+			symbols.T_103, 
+			symbols.T_115, 
+			symbols.T_111, 
+			symbols.T_108, 
+			symbols.T_9,
+		}, 
+		Import1R0, 
+	},
+	Import1R1: {
+		symbols.NT_Import, 1, 1, 
+		symbols.Symbols{  
+			symbols.T_103, 
+			symbols.T_115, 
+			symbols.T_111, 
+			symbols.T_108, 
+			symbols.T_9,
+		}, 
+		Import1R1, 
+	},
+	Import1R2: {
+		symbols.NT_Import, 1, 2, 
+		symbols.Symbols{  
+			symbols.T_103, 
+			symbols.T_115, 
+			symbols.T_111, 
+			symbols.T_108, 
+			symbols.T_9,
+		}, 
+		Import1R2, 
+	},
+	Import1R3: {
+		symbols.NT_Import, 1, 3, 
+		symbols.Symbols{  
+			symbols.T_103, 
+			symbols.T_115, 
+			symbols.T_111, 
+			symbols.T_108, 
+			symbols.T_9,
+		}, 
+		Import1R3, 
+	},
+	Import1R4: {
+		symbols.NT_Import, 1, 4, 
+		symbols.Symbols{  
+			symbols.T_103, 
+			symbols.T_115, 
+			symbols.T_111, 
+			symbols.T_108, 
+			symbols.T_9,
+		}, 
+		Import1R4, 
+	},
+	Import1R5: {
+		symbols.NT_Import, 1, 5, 
+		symbols.Symbols{  
+			symbols.T_103, 
+			symbols.T_115, 
+			symbols.T_111, 
+			symbols.T_108, 
+			symbols.T_9,
+		}, 
+		Import1R5, 
+	},
+	Import2R0: {
+		symbols.NT_Import, 2, 0, 
+		symbols.Symbols{  
+			symbols.T_103, 
+			symbols.T_115, 
+			symbols.T_112, 
+			symbols.NT_Renames, 
+			symbols.T_9,
+		}, 
+		Import2R0, 
+	},
+	Import2R1: {
+		symbols.NT_Import, 2, 1, 
+		symbols.Symbols{  
+			symbols.T_103, 
+			symbols.T_115, 
+			symbols.T_112, 
+			symbols.NT_Renames, 
+			symbols.T_9,
+		}, 
+		Import2R1, 
+	},
+	Import2R2: {
+		symbols.NT_Import, 2, 2, 
+		symbols.Symbols{  
+			symbols.T_103, 
+			symbols.T_115, 
+			symbols.T_112, 
+			symbols.NT_Renames, 
+			symbols.T_9,
+		}, 
+		Import2R2, 
+	},
+	Import2R3: {
+		symbols.NT_Import, 2, 3, 
+		symbols.Symbols{  
+			symbols.T_103, 
+			symbols.T_115, 
+			symbols.T_112, 
+			symbols.NT_Renames, 
+			symbols.T_9,
+		}, 
+		Import2R3, 
+	},
+	Import2R4: {
+		symbols.NT_Import, 2, 4, 
+		symbols.Symbols{  
+			symbols.T_103, 
+			symbols.T_115, 
+			symbols.T_112, 
+			symbols.NT_Renames, 
+			symbols.T_9,
+		}, 
+		Import2R4, 
+	},
+	Import2R5: {
+		symbols.NT_Import, 2, 5, 
+		symbols.Symbols{  
+			symbols.T_103, 
+			symbols.T_115, 
+			symbols.T_112, 
+			symbols.NT_Renames, 
+			symbols.T_9,
+		}, 
+		Import2R5, 
+	},
+	LabelledSymbol0R0: {
+		symbols.NT_LabelledSymbol, 0, 0, 
+		symbols.Symbols{  
+			symbols.NT_SyntaxSymbol,
+		}, 
+		LabelledSymbol0R0, 
+	},
+	LabelledSymbol0R1: {
+		symbols.NT_LabelledSymbol, 0, 1, 
+		symbols.Symbols{  
+			symbols.NT_SyntaxSymbol,
+		}, 
+		LabelledSymbol0R1, 
+	},
+	LabelledSymbol1R0: {
+		symbols.NT_LabelledSymbol, 1, 0, 
+		symbols.Symbols{  
+			symbols.NT_TokID, 
+			symbols.T_8, 
+			symbols.NT_SyntaxSymbol,
+		}, 
+		LabelledSymbol1R0, 
+	},
+	LabelledSymbol1R1: {
+		symbols.NT_LabelledSymbol, 1, 1, 
+		symbols.Symbols{  
+			symbols.NT_TokID, 
+			symbols.T_8, 
+			symbols.NT_SyntaxSymbol,
+		}, 
+		LabelledSymbol1R1, 
+	},
+	LabelledSymbol1R2: {
+		symbols.NT_LabelledSymbol, 1, 2, 
+		symbols.Symbols{  
+			symbols.NT_TokID, 
+			symbols.T_8, 
+			symbols.NT_SyntaxSymbol,
+		}, 
+		LabelledSymbol1R2, 
+	},
+	LabelledSymbol1R3: {
+		symbols.NT_LabelledSymbol, 1, 3, 
+		symbols.Symbols{  
+			symbols.NT_TokID, 
+			symbols.T_8, 
+			symbols.NT_SyntaxSymbol,
+		}, 
+		LabelledSymbol1R3, 
+	},
 	LexAlternates0R0: {
 		symbols.NT_LexAlternates, 0, 0, 
 		symbols.Symbols{  
@@ -445,7 +838,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_LexAlternates, 1, 0, 
 		symbols.Symbols{  
 			symbols.NT_RegExp, 
-			symbols.T_108, 
+			symbols.T_119, 
 			symbols.NT_LexAlternates,
 		}, 
 		LexAlternates1R0, 
@@ -454,7 +847,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_LexAlternates, 1, 1, 
 		symbols.Symbols{  
 			symbols.NT_RegExp, 
-			symbols.T_108, 
+			symbols.T_119, 
 			symbols.NT_LexAlternates,
 		}, 
 		LexAlternates1R1, 
@@ -463,7 +856,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_LexAlternates, 1, 2, 
 		symbols.Symbols{  
 			symbols.NT_RegExp, 
-			symbols.T_108, 
+			symbols.T_119, 
 			symbols.NT_LexAlternates,
 		}, 
 		LexAlternates1R2, 
@@ -472,7 +865,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_LexAlternates, 1, 3, 
 		symbols.Symbols{  
 			symbols.NT_RegExp, 
-			symbols.T_108, 
+			symbols.T_119, 
 			symbols.NT_LexAlternates,
 		}, 
 		LexAlternates1R3, 
@@ -536,158 +929,158 @@ var slots = map[Label]*Slot{
 	LexGroup0R0: {
 		symbols.NT_LexGroup, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_2, 
+			symbols.T_3, 
 			symbols.NT_LexAlternates, 
-			symbols.T_3,
+			symbols.T_4,
 		}, 
 		LexGroup0R0, 
 	},
 	LexGroup0R1: {
 		symbols.NT_LexGroup, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_2, 
+			symbols.T_3, 
 			symbols.NT_LexAlternates, 
-			symbols.T_3,
+			symbols.T_4,
 		}, 
 		LexGroup0R1, 
 	},
 	LexGroup0R2: {
 		symbols.NT_LexGroup, 0, 2, 
 		symbols.Symbols{  
-			symbols.T_2, 
+			symbols.T_3, 
 			symbols.NT_LexAlternates, 
-			symbols.T_3,
+			symbols.T_4,
 		}, 
 		LexGroup0R2, 
 	},
 	LexGroup0R3: {
 		symbols.NT_LexGroup, 0, 3, 
 		symbols.Symbols{  
-			symbols.T_2, 
+			symbols.T_3, 
 			symbols.NT_LexAlternates, 
-			symbols.T_3,
+			symbols.T_4,
 		}, 
 		LexGroup0R3, 
 	},
 	LexOneOrMore0R0: {
 		symbols.NT_LexOneOrMore, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_8, 
+			symbols.T_10, 
 			symbols.NT_LexAlternates, 
-			symbols.T_9,
+			symbols.T_11,
 		}, 
 		LexOneOrMore0R0, 
 	},
 	LexOneOrMore0R1: {
 		symbols.NT_LexOneOrMore, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_8, 
+			symbols.T_10, 
 			symbols.NT_LexAlternates, 
-			symbols.T_9,
+			symbols.T_11,
 		}, 
 		LexOneOrMore0R1, 
 	},
 	LexOneOrMore0R2: {
 		symbols.NT_LexOneOrMore, 0, 2, 
 		symbols.Symbols{  
-			symbols.T_8, 
+			symbols.T_10, 
 			symbols.NT_LexAlternates, 
-			symbols.T_9,
+			symbols.T_11,
 		}, 
 		LexOneOrMore0R2, 
 	},
 	LexOneOrMore0R3: {
 		symbols.NT_LexOneOrMore, 0, 3, 
 		symbols.Symbols{  
-			symbols.T_8, 
+			symbols.T_10, 
 			symbols.NT_LexAlternates, 
-			symbols.T_9,
+			symbols.T_11,
 		}, 
 		LexOneOrMore0R3, 
 	},
 	LexOptional0R0: {
 		symbols.NT_LexOptional, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_10, 
+			symbols.T_13, 
 			symbols.NT_LexAlternates, 
-			symbols.T_93,
+			symbols.T_96,
 		}, 
 		LexOptional0R0, 
 	},
 	LexOptional0R1: {
 		symbols.NT_LexOptional, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_10, 
+			symbols.T_13, 
 			symbols.NT_LexAlternates, 
-			symbols.T_93,
+			symbols.T_96,
 		}, 
 		LexOptional0R1, 
 	},
 	LexOptional0R2: {
 		symbols.NT_LexOptional, 0, 2, 
 		symbols.Symbols{  
-			symbols.T_10, 
+			symbols.T_13, 
 			symbols.NT_LexAlternates, 
-			symbols.T_93,
+			symbols.T_96,
 		}, 
 		LexOptional0R2, 
 	},
 	LexOptional0R3: {
 		symbols.NT_LexOptional, 0, 3, 
 		symbols.Symbols{  
-			symbols.T_10, 
+			symbols.T_13, 
 			symbols.NT_LexAlternates, 
-			symbols.T_93,
+			symbols.T_96,
 		}, 
 		LexOptional0R3, 
 	},
 	LexRule0R0: {
 		symbols.NT_LexRule, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_105, 
-			symbols.T_6, 
+			symbols.NT_TokID, 
+			symbols.T_8, 
 			symbols.NT_RegExp, 
-			symbols.T_7,
+			symbols.T_9,
 		}, 
 		LexRule0R0, 
 	},
 	LexRule0R1: {
 		symbols.NT_LexRule, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_105, 
-			symbols.T_6, 
+			symbols.NT_TokID, 
+			symbols.T_8, 
 			symbols.NT_RegExp, 
-			symbols.T_7,
+			symbols.T_9,
 		}, 
 		LexRule0R1, 
 	},
 	LexRule0R2: {
 		symbols.NT_LexRule, 0, 2, 
 		symbols.Symbols{  
-			symbols.T_105, 
-			symbols.T_6, 
+			symbols.NT_TokID, 
+			symbols.T_8, 
 			symbols.NT_RegExp, 
-			symbols.T_7,
+			symbols.T_9,
 		}, 
 		LexRule0R2, 
 	},
 	LexRule0R3: {
 		symbols.NT_LexRule, 0, 3, 
 		symbols.Symbols{  
-			symbols.T_105, 
-			symbols.T_6, 
+			symbols.NT_TokID, 
+			symbols.T_8, 
 			symbols.NT_RegExp, 
-			symbols.T_7,
+			symbols.T_9,
 		}, 
 		LexRule0R3, 
 	},
 	LexRule0R4: {
 		symbols.NT_LexRule, 0, 4, 
 		symbols.Symbols{  
-			symbols.T_105, 
-			symbols.T_6, 
+			symbols.NT_TokID, 
+			symbols.T_8, 
 			symbols.NT_RegExp, 
-			symbols.T_7,
+			symbols.T_9,
 		}, 
 		LexRule0R4, 
 	},
@@ -695,10 +1088,10 @@ var slots = map[Label]*Slot{
 		symbols.NT_LexRule, 1, 0, 
 		symbols.Symbols{  
 			symbols.T_0, 
-			symbols.T_105, 
-			symbols.T_6, 
+			symbols.NT_TokID, 
+			symbols.T_8, 
 			symbols.NT_RegExp, 
-			symbols.T_7,
+			symbols.T_9,
 		}, 
 		LexRule1R0, 
 	},
@@ -706,10 +1099,10 @@ var slots = map[Label]*Slot{
 		symbols.NT_LexRule, 1, 1, 
 		symbols.Symbols{  
 			symbols.T_0, 
-			symbols.T_105, 
-			symbols.T_6, 
+			symbols.NT_TokID, 
+			symbols.T_8, 
 			symbols.NT_RegExp, 
-			symbols.T_7,
+			symbols.T_9,
 		}, 
 		LexRule1R1, 
 	},
@@ -717,10 +1110,10 @@ var slots = map[Label]*Slot{
 		symbols.NT_LexRule, 1, 2, 
 		symbols.Symbols{  
 			symbols.T_0, 
-			symbols.T_105, 
-			symbols.T_6, 
+			symbols.NT_TokID, 
+			symbols.T_8, 
 			symbols.NT_RegExp, 
-			symbols.T_7,
+			symbols.T_9,
 		}, 
 		LexRule1R2, 
 	},
@@ -728,10 +1121,10 @@ var slots = map[Label]*Slot{
 		symbols.NT_LexRule, 1, 3, 
 		symbols.Symbols{  
 			symbols.T_0, 
-			symbols.T_105, 
-			symbols.T_6, 
+			symbols.NT_TokID, 
+			symbols.T_8, 
 			symbols.NT_RegExp, 
-			symbols.T_7,
+			symbols.T_9,
 		}, 
 		LexRule1R3, 
 	},
@@ -739,10 +1132,10 @@ var slots = map[Label]*Slot{
 		symbols.NT_LexRule, 1, 4, 
 		symbols.Symbols{  
 			symbols.T_0, 
-			symbols.T_105, 
-			symbols.T_6, 
+			symbols.NT_TokID, 
+			symbols.T_8, 
 			symbols.NT_RegExp, 
-			symbols.T_7,
+			symbols.T_9,
 		}, 
 		LexRule1R4, 
 	},
@@ -750,62 +1143,128 @@ var slots = map[Label]*Slot{
 		symbols.NT_LexRule, 1, 5, 
 		symbols.Symbols{  
 			symbols.T_0, 
-			symbols.T_105, 
-			symbols.T_6, 
+			symbols.NT_TokID, 
+			symbols.T_8, 
 			symbols.NT_RegExp, 
-			symbols.T_7,
+			symbols.T_9,
 		}, 
 		LexRule1R5, 
 	},
-	LexSymbol0R0: {
-		symbols.NT_LexSymbol, 0, 0, 
+	LexRule2R0: {
+		symbols.NT_LexRule, 2, 0, 
 		symbols.Symbols{  
-			symbols.T_5,
+			symbols.T_12, 
+			symbols.NT_TokID, 
+			symbols.T_8, 
+			symbols.NT_RegExp, 
+			symbols.T_9,
 		}, 
-		LexSymbol0R0, 
+		LexRule2R0, 
 	},
-	LexSymbol0R1: {
-		symbols.NT_LexSymbol, 0, 1, 
+	LexRule2R1: {
+		symbols.NT_LexRule, 2, 1, 
 		symbols.Symbols{  
-			symbols.T_5,
+			symbols.T_12, 
+			symbols.NT_TokID, 
+			symbols.T_8, 
+			symbols.NT_RegExp, 
+			symbols.T_9,
 		}, 
-		LexSymbol0R1, 
+		LexRule2R1, 
 	},
-	LexSymbol1R0: {
-		symbols.NT_LexSymbol, 1, 0, 
+	LexRule2R2: {
+		symbols.NT_LexRule, 2, 2, 
 		symbols.Symbols{  
-			symbols.T_95, 
-			symbols.T_104,
+			symbols.T_12, 
+			symbols.NT_TokID, 
+			symbols.T_8, 
+			symbols.NT_RegExp, 
+			symbols.T_9,
 		}, 
-		LexSymbol1R0, 
+		LexRule2R2, 
 	},
-	LexSymbol1R1: {
-		symbols.NT_LexSymbol, 1, 1, 
+	LexRule2R3: {
+		symbols.NT_LexRule, 2, 3, 
 		symbols.Symbols{  
-			symbols.T_95, 
-			symbols.T_104,
+			symbols.T_12, 
+			symbols.NT_TokID, 
+			symbols.T_8, 
+			symbols.NT_RegExp, 
+			symbols.T_9,
 		}, 
-		LexSymbol1R1, 
+		LexRule2R3, 
 	},
-	LexSymbol1R2: {
-		symbols.NT_LexSymbol, 1, 2, 
+	LexRule2R4: {
+		symbols.NT_LexRule, 2, 4, 
 		symbols.Symbols{  
-			symbols.T_95, 
-			symbols.T_104,
-		}, 
+			symbols.T_12, 
+			symbols.NT_TokID, 
+			symbols.T_8, 
+			symbols.NT_RegExp, 
+			symbols.T_9,
+		}, 
+		LexRule2R4, 
+	},
+	LexRule2R5: {
+		symbols.NT_LexRule, 2, 5, 
+		symbols.Symbols{  
+			symbols.T_12, 
+			symbols.NT_TokID, 
+			symbols.T_8, 
+			symbols.NT_RegExp, 
+			symbols.T_9,
+		}, 
+		LexRule2R5, 
+	},
+	LexSymbol0R0: {
+		symbols.NT_LexSymbol, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_7,
+		}, 
+		LexSymbol0R0, 
+	},
+	LexSymbol0R1: {
+		symbols.NT_LexSymbol, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_7,
+		}, 
+		LexSymbol0R1, 
+	},
+	LexSymbol1R0: {
+		symbols.NT_LexSymbol, 1, 0, 
+		symbols.Symbols{  
+			symbols.T_98, 
+			symbols.T_115,
+		}, 
+		LexSymbol1R0, 
+	},
+	LexSymbol1R1: {
+		symbols.NT_LexSymbol, 1, 1, 
+		symbols.Symbols{  
+			symbols.T_98, 
+			symbols.T_115,
+		}, 
+		LexSymbol1R1, 
+	},
+	LexSymbol1R2: {
+		symbols.NT_LexSymbol, 1, 2, 
+		symbols.Symbols{  
+			symbols.T_98, 
+			symbols.T_115,
+		}, 
 		LexSymbol1R2, 
 	},
 	LexSymbol2R0: {
 		symbols.NT_LexSymbol, 2, 0, 
 		symbols.Symbols{  
-			symbols.T_96,
+			symbols.T_101,
 		}, 
 		LexSymbol2R0, 
 	},
 	LexSymbol2R1: {
 		symbols.NT_LexSymbol, 2, 1, 
 		symbols.Symbols{  
-			symbols.T_96,
+			symbols.T_101,
 		}, 
 		LexSymbol2R1, 
 	},
@@ -826,24 +1285,24 @@ var slots = map[Label]*Slot{
 	LexSymbol4R0: {
 		symbols.NT_LexSymbol, 4, 0, 
 		symbols.Symbols{  
-			symbols.T_100, 
-			symbols.T_104,
+			symbols.T_107, 
+			symbols.T_115,
 		}, 
 		LexSymbol4R0, 
 	},
 	LexSymbol4R1: {
 		symbols.NT_LexSymbol, 4, 1, 
 		symbols.Symbols{  
-			symbols.T_100, 
-			symbols.T_104,
+			symbols.T_107, 
+			symbols.T_115,
 		}, 
 		LexSymbol4R1, 
 	},
 	LexSymbol4R2: {
 		symbols.NT_LexSymbol, 4, 2, 
 		symbols.Symbols{  
-			symbols.T_100, 
-			symbols.T_104,
+			symbols.T_107, 
+			symbols.T_115,
 		}, 
 		LexSymbol4R2, 
 	},
@@ -875,66 +1334,116 @@ var slots = map[Label]*Slot{
 		}, 
 		LexSymbol6R1, 
 	},
+	LexSymbol7R0: {
+		symbols.NT_LexSymbol, 7, 0, 
+		symbols.Symbols{  
+			symbols.NT_CharRange,
+		}, 
+		LexSymbol7R0, 
+	},
+	LexSymbol7R1: {
+		symbols.NT_LexSymbol, 7, 1, 
+		symbols.Symbols{  
+			symbols.NT_CharRange,
+		}, 
+		LexSymbol7R1, 
+	},
 	LexZeroOrMore0R0: {
 		symbols.NT_LexZeroOrMore, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_107, 
+			symbols.T_118, 
 			symbols.NT_LexAlternates, 
-			symbols.T_109,
+			symbols.T_120,
 		}, 
 		LexZeroOrMore0R0, 
 	},
 	LexZeroOrMore0R1: {
 		symbols.NT_LexZeroOrMore, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_107, 
+			symbols.T_118, 
 			symbols.NT_LexAlternates, 
-			symbols.T_109,
+			symbols.T_120,
 		}, 
 		LexZeroOrMore0R1, 
 	},
 	LexZeroOrMore0R2: {
 		symbols.NT_LexZeroOrMore, 0, 2, 
 		symbols.Symbols{  
-			symbols.T_107, 
+			symbols.T_118, 
 			symbols.NT_LexAlternates, 
-			symbols.T_109,
+			symbols.T_120,
 		}, 
 		LexZeroOrMore0R2, 
 	},
 	LexZeroOrMore0R3: {
 		symbols.NT_LexZeroOrMore, 0, 3, 
 		symbols.Symbols{  
-			symbols.T_107, 
+			symbols.T_118, 
 			symbols.NT_LexAlternates, 
-			symbols.T_109,
+			symbols.T_120,
 		}, 
 		LexZeroOrMore0R3, 
 	},
 	Package0R0: {
 		symbols.NT_Package, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_103, 
-			symbols.T_104,
+			symbols.T_110, 
+			symbols.T_115,
 		}, 
 		Package0R0, 
 	},
 	Package0R1: {
 		symbols.NT_Package, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_103, 
-			symbols.T_104,
+			symbols.T_110, 
+			symbols.T_115,
 		}, 
 		Package0R1, 
 	},
 	Package0R2: {
 		symbols.NT_Package, 0, 2, 
 		symbols.Symbols{  
-			symbols.T_103, 
-			symbols.T_104,
+			symbols.T_110, 
+			symbols.T_115,
 		}, 
 		Package0R2, 
 	},
+	Package1R0: {
+		symbols.NT_Package, 1, 0, 
+		symbols.Symbols{  
+			symbols.T_110, 
+			symbols.T_115, 
+			symbols.T_100,
+		}, 
+		Package1R0, 
+	},
+	Package1R1: {
+		symbols.NT_Package, 1, 1, 
+		symbols.Symbols{  
+			symbols.T_110, 
+			symbols.T_115, 
+			symbols.T_100,
+		}, 
+		Package1R1, 
+	},
+	Package1R2: {
+		symbols.NT_Package, 1, 2, 
+		symbols.Symbols{  
+			symbols.T_110, 
+			symbols.T_115, 
+			symbols.T_100,
+		}, 
+		Package1R2, 
+	},
+	Package1R3: {
+		symbols.NT_Package, 1, 3, 
+		symbols.Symbols{  
+			symbols.T_110, 
+			symbols.T_115, 
+			symbols.T_100,
+		}, 
+		Package1R3, 
+	},
 	PlusOrMinUnicodeSet0R0: {
 		symbols.NT_PlusOrMinUnicodeSet, 0, 0, 
 		symbols.Symbols{  
@@ -952,7 +1461,7 @@ var slots = map[Label]*Slot{
 	PlusOrMinUnicodeSet1R0: {
 		symbols.NT_PlusOrMinUnicodeSet, 1, 0, 
 		symbols.Symbols{  
-			symbols.T_4, 
+			symbols.T_6, 
 			symbols.NT_UnicodeSetSpec,
 		}, 
 		PlusOrMinUnicodeSet1R0, 
@@ -960,7 +1469,7 @@ var slots = map[Label]*Slot{
 	PlusOrMinUnicodeSet1R1: {
 		symbols.NT_PlusOrMinUnicodeSet, 1, 1, 
 		symbols.Symbols{  
-			symbols.T_4, 
+			symbols.T_6, 
 			symbols.NT_UnicodeSetSpec,
 		}, 
 		PlusOrMinUnicodeSet1R1, 
@@ -968,7 +1477,7 @@ var slots = map[Label]*Slot{
 	PlusOrMinUnicodeSet1R2: {
 		symbols.NT_PlusOrMinUnicodeSet, 1, 2, 
 		symbols.Symbols{  
-			symbols.T_4, 
+			symbols.T_6, 
 			symbols.NT_UnicodeSetSpec,
 		}, 
 		PlusOrMinUnicodeSet1R2, 
@@ -990,14 +1499,14 @@ var slots = map[Label]*Slot{
 	RegExp1R0: {
 		symbols.NT_RegExp, 1, 0, 
 		symbols.Symbols{  
-			symbols.T_105,
+			symbols.NT_TokID,
 		}, 
 		RegExp1R0, 
 	},
 	RegExp1R1: {
 		symbols.NT_RegExp, 1, 1, 
 		symbols.Symbols{  
-			symbols.T_105,
+			symbols.NT_TokID,
 		}, 
 		RegExp1R1, 
 	},
@@ -1028,7 +1537,7 @@ var slots = map[Label]*Slot{
 	RegExp3R0: {
 		symbols.NT_RegExp, 3, 0, 
 		symbols.Symbols{  
-			symbols.T_105, 
+			symbols.NT_TokID, 
 			symbols.NT_RegExp,
 		}, 
 		RegExp3R0, 
@@ -1036,7 +1545,7 @@ var slots = map[Label]*Slot{
 	RegExp3R1: {
 		symbols.NT_RegExp, 3, 1, 
 		symbols.Symbols{  
-			symbols.T_105, 
+			symbols.NT_TokID, 
 			symbols.NT_RegExp,
 		}, 
 		RegExp3R1, 
@@ -1044,11 +1553,133 @@ var slots = map[Label]*Slot{
 	RegExp3R2: {
 		symbols.NT_RegExp, 3, 2, 
 		symbols.Symbols{  
-			symbols.T_105, 
+			symbols.NT_TokID, 
 			symbols.NT_RegExp,
 		}, 
 		RegExp3R2, 
 	},
+	Rename0R0: {
+		symbols.NT_Rename, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_99, 
+			symbols.T_108,
+		}, 
+		Rename0R0, 
+	},
+	Rename0R1: {
+		symbols.NT_Rename, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_99, 
+			symbols.T_108,
+		}, 
+		Rename0R1, 
+	},
+	Rename0R2: {
+		symbols.NT_Rename, 0, 2, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_99, 
+			symbols.T_108,
+		}, 
+		Rename0R2, 
+	},
+	Rename0R3: {
+		symbols.NT_Rename, 0, 3, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_99, 
+			symbols.T_108,
+		}, 
+		Rename0R3, 
+	},
+	Rename1R0: {
+		symbols.NT_Rename, 1, 0, 
+		symbols.Symbols{  
+			symbols.NT_TokID, 
+			symbols.T_99, 
+			symbols.NT_TokID,
+		}, 
+		Rename1R0, 
+	},
+	Rename1R1: {
+		symbols.NT_Rename, 1, 1, 
+		symbols.Symbols{  
+			symbols.NT_TokID, 
+			symbols.T_99, 
+			symbols.NT_TokID,
+		}, 
+		Rename1R1, 
+	},
+	Rename1R2: {
+		symbols.NT_Rename, 1, 2, 
+		symbols.Symbols{  
+			symbols.NT_TokID, 
+			symbols.T_99, 
+			symbols.NT_TokID,
+		}, 
+		Rename1R2, 
+	},
+	Rename1R3: {
+		symbols.NT_Rename, 1, 3, 
+		symbols.Symbols{  
+			symbols.NT_TokID, 
+			symbols.T_99, 
+			symbols.NT_TokID,
+		}, 
+		Rename1R3, 
+	},
+	Renames0R0: {
+		symbols.NT_Renames, 0, 0, 
+		symbols.Symbols{  
+			symbols.NT_Rename,
+		}, 
+		Renames0R0, 
+	},
+	Renames0R1: {
+		symbols.NT_Renames, 0, 1, 
+		symbols.Symbols{  
+			symbols.NT_Rename,
+		}, 
+		Renames0R1, 
+	},
+	Renames1R0: {
+		symbols.NT_Renames, 1, 0, 
+		symbols.Symbols{  
+			symbols.NT_Rename, 
+			symbols.T_5, 
+			symbols.NT_Renames,
+		}, 
+		Renames1R0, 
+	},
+	Renames1R1: {
+		symbols.NT_Renames, 1, 1, 
+		symbols.Symbols{  
+			symbols.NT_Rename, 
+			symbols.T_5, 
+			symbols.NT_Renames,
+		}, 
+		Renames1R1, 
+	},
+	Renames1R2: {
+		symbols.NT_Renames, 1, 2, 
+		symbols.Symbols{  
+			symbols.NT_Rename, 
+			symbols.T_5, 
+			symbols.NT_Renames,
+		}, 
+		Renames1R2, 
+	},
+	Renames1R3: {
+		symbols.NT_Renames, 1, 3, 
+		symbols.Symbols{  
+			symbols.NT_Rename, 
+			symbols.T_5, 
+			symbols.NT_Renames,
+		}, 
+		Renames1R3, 
+	},
 	Rule0R0: {
 		symbols.NT_Rule, 0, 0, 
 		symbols.Symbols{  
@@ -1077,6 +1708,34 @@ var slots = map[Label]*Slot{
 		}, 
 		Rule1R1, 
 	},
+	Rule2R0: {
+		symbols.NT_Rule, 2, 0, 
+		symbols.Symbols{  
+			symbols.NT_Import,
+		}, 
+		Rule2R0, 
+	},
+	Rule2R1: {
+		symbols.NT_Rule, 2, 1, 
+		symbols.Symbols{  
+			symbols.NT_Import,
+		}, 
+		Rule2R1, 
+	},
+	Rule3R0: {
+		symbols.NT_Rule, 3, 0, 
+		symbols.Symbols{  
+			symbols.NT_Start,
+		}, 
+		Rule3R0, 
+	},
+	Rule3R1: {
+		symbols.NT_Rule, 3, 1, 
+		symbols.Symbols{  
+			symbols.NT_Start,
+		}, 
+		Rule3R1, 
+	},
 	Rules0R0: {
 		symbols.NT_Rules, 0, 0, 
 		symbols.Symbols{  
@@ -1115,6 +1774,92 @@ var slots = map[Label]*Slot{
 		}, 
 		Rules1R2, 
 	},
+	Start0R0: {
+		symbols.NT_Start, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_114, 
+			symbols.NT_StartSymbols, 
+			symbols.T_9,
+		}, 
+		Start0R0, 
+	},
+	Start0R1: {
+		symbols.NT_Start, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_114, 
+			symbols.NT_StartSymbols, 
+			symbols.T_9,
+		}, 
+		Start0R1, 
+	},
+	Start0R2: {
+		symbols.NT_Start, 0, 2, 
+		symbols.Symbols{  
+			symbols.T_114, 
+			symbols.NT_StartSymbols, 
+			symbols.T_9,
+		}, 
+		Start0R2, 
+	},
+	Start0R3: {
+		symbols.NT_Start, 0, 3, 
+		symbols.Symbols{  
+			symbols.T_114, 
+			symbols.NT_StartSymbols, 
+			symbols.T_9,
+		}, 
+		Start0R3, 
+	},
+	StartSymbols0R0: {
+		symbols.NT_StartSymbols, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_108,
+		}, 
+		StartSymbols0R0, 
+	},
+	StartSymbols0R1: {
+		symbols.NT_StartSymbols, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_108,
+		}, 
+		StartSymbols0R1, 
+	},
+	StartSymbols1R0: {
+		symbols.NT_StartSymbols, 1, 0, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_5, 
+			symbols.NT_StartSymbols,
+		}, 
+		StartSymbols1R0, 
+	},
+	StartSymbols1R1: {
+		symbols.NT_StartSymbols, 1, 1, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_5, 
+			symbols.NT_StartSymbols,
+		}, 
+		StartSymbols1R1, 
+	},
+	StartSymbols1R2: {
+		symbols.NT_StartSymbols, 1, 2, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_5, 
+			symbols.NT_StartSymbols,
+		}, 
+		StartSymbols1R2, 
+	},
+	StartSymbols1R3: {
+		symbols.NT_StartSymbols, 1, 3, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_5, 
+			symbols.NT_StartSymbols,
+		}, 
+		StartSymbols1R3, 
+	},
 	SyntaxAlternate0R0: {
 		symbols.NT_SyntaxAlternate, 0, 0, 
 		symbols.Symbols{  
@@ -1132,17 +1877,89 @@ var slots = map[Label]*Slot{
 	SyntaxAlternate1R0: {
 		symbols.NT_SyntaxAlternate, 1, 0, 
 		symbols.Symbols{  
-			symbols.T_97,
+			symbols.T_102,
 		}, 
 		SyntaxAlternate1R0, 
 	},
 	SyntaxAlternate1R1: {
 		symbols.NT_SyntaxAlternate, 1, 1, 
 		symbols.Symbols{  
-			symbols.T_97,
+			symbols.T_102,
 		}, 
 		SyntaxAlternate1R1, 
 	},
+	SyntaxAlternate2R0: {
+		symbols.NT_SyntaxAlternate, 2, 0, 
+		symbols.Symbols{  
+			symbols.NT_SyntaxSymbols, 
+			symbols.T_1, 
+			symbols.T_108,
+		}, 
+		SyntaxAlternate2R0, 
+	},
+	SyntaxAlternate2R1: {
+		symbols.NT_SyntaxAlternate, 2, 1, 
+		symbols.Symbols{  
+			symbols.NT_SyntaxSymbols, 
+			symbols.T_1, 
+			symbols.T_108,
+		}, 
+		SyntaxAlternate2R1, 
+	},
+	SyntaxAlternate2R2: {
+		symbols.NT_SyntaxAlternate, 2, 2, 
+		symbols.Symbols{  
+			symbols.NT_SyntaxSymbols, 
+			symbols.T_1, 
+			symbols.T_108,
+		}, 
+		SyntaxAlternate2R2, 
+	},
+	SyntaxAlternate2R3: {
+		symbols.NT_SyntaxAlternate, 2, 3, 
+		symbols.Symbols{  
+			symbols.NT_SyntaxSymbols, 
+			symbols.T_1, 
+			symbols.T_108,
+		}, 
+		SyntaxAlternate2R3, 
+	},
+	SyntaxAlternate3R0: {
+		symbols.NT_SyntaxAlternate, 3, 0, 
+		symbols.Symbols{  
+			symbols.T_102, 
+			symbols.T_1, 
+			symbols.T_108,
+		}, 
+		SyntaxAlternate3R0, 
+	},
+	SyntaxAlternate3R1: {
+		symbols.NT_SyntaxAlternate, 3, 1, 
+		symbols.Symbols{  
+			symbols.T_102, 
+			symbols.T_1, 
+			symbols.T_108,
+		}, 
+		SyntaxAlternate3R1, 
+	},
+	SyntaxAlternate3R2: {
+		symbols.NT_SyntaxAlternate, 3, 2, 
+		symbols.Symbols{  
+			symbols.T_102, 
+			symbols.T_1, 
+			symbols.T_108,
+		}, 
+		SyntaxAlternate3R2, 
+	},
+	SyntaxAlternate3R3: {
+		symbols.NT_SyntaxAlternate, 3, 3, 
+		symbols.Symbols{  
+			symbols.T_102, 
+			symbols.T_1, 
+			symbols.T_108,
+		}, 
+		SyntaxAlternate3R3, 
+	},
 	SyntaxAlternates0R0: {
 		symbols.NT_SyntaxAlternates, 0, 0, 
 		symbols.Symbols{  
@@ -1155,1428 +1972,1860 @@ var slots = map[Label]*Slot{
 		symbols.Symbols{  
 			symbols.NT_SyntaxAlternate,
 		}, 
-		SyntaxAlternates0R1, 
+		SyntaxAlternates0R1, 
+	},
+	SyntaxAlternates1R0: {
+		symbols.NT_SyntaxAlternates, 1, 0, 
+		symbols.Symbols{  
+			symbols.NT_SyntaxAlternate, 
+			symbols.T_119, 
+			symbols.NT_SyntaxAlternates,
+		}, 
+		SyntaxAlternates1R0, 
+	},
+	SyntaxAlternates1R1: {
+		symbols.NT_SyntaxAlternates, 1, 1, 
+		symbols.Symbols{  
+			symbols.NT_SyntaxAlternate, 
+			symbols.T_119, 
+			symbols.NT_SyntaxAlternates,
+		}, 
+		SyntaxAlternates1R1, 
+	},
+	SyntaxAlternates1R2: {
+		symbols.NT_SyntaxAlternates, 1, 2, 
+		symbols.Symbols{  
+			symbols.NT_SyntaxAlternate, 
+			symbols.T_119, 
+			symbols.NT_SyntaxAlternates,
+		}, 
+		SyntaxAlternates1R2, 
+	},
+	SyntaxAlternates1R3: {
+		symbols.NT_SyntaxAlternates, 1, 3, 
+		symbols.Symbols{  
+			symbols.NT_SyntaxAlternate, 
+			symbols.T_119, 
+			symbols.NT_SyntaxAlternates,
+		}, 
+		SyntaxAlternates1R3, 
+	},
+	SyntaxRule0R0: {
+		symbols.NT_SyntaxRule, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_8, 
+			symbols.NT_SyntaxAlternates, 
+			symbols.T_9,
+		}, 
+		SyntaxRule0R0, 
+	},
+	SyntaxRule0R1: {
+		symbols.NT_SyntaxRule, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_8, 
+			symbols.NT_SyntaxAlternates, 
+			symbols.T_9,
+		}, 
+		SyntaxRule0R1, 
+	},
+	SyntaxRule0R2: {
+		symbols.NT_SyntaxRule, 0, 2, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_8, 
+			symbols.NT_SyntaxAlternates, 
+			symbols.T_9,
+		}, 
+		SyntaxRule0R2, 
+	},
+	SyntaxRule0R3: {
+		symbols.NT_SyntaxRule, 0, 3, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_8, 
+			symbols.NT_SyntaxAlternates, 
+			symbols.T_9,
+		}, 
+		SyntaxRule0R3, 
+	},
+	SyntaxRule0R4: {
+		symbols.NT_SyntaxRule, 0, 4, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_8, 
+			symbols.NT_SyntaxAlternates, 
+			symbols.T_9,
+		}, 
+		SyntaxRule0R4, 
+	},
+	SyntaxRule1R0: {
+		symbols.NT_SyntaxRule, 1, 0, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_10, 
+			symbols.NT_TemplateParams, 
+			symbols.T_11, 
+			symbols.T_8, 
+			symbols.NT_SyntaxAlternates, 
+			symbols.T_9,
+		}, 
+		SyntaxRule1R0, 
+	},
+	SyntaxRule1R1: {
+		symbols.NT_SyntaxRule, 1, 1, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_10, 
+			symbols.NT_TemplateParams, 
+			symbols.T_11, 
+			symbols.T_8, 
+			symbols.NT_SyntaxAlternates, 
+			symbols.T_9,
+		}, 
+		SyntaxRule1R1, 
+	},
+	SyntaxRule1R2: {
+		symbols.NT_SyntaxRule, 1, 2, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_10, 
+			symbols.NT_TemplateParams, 
+			symbols.T_11, 
+			symbols.T_8, 
+			symbols.NT_SyntaxAlternates, 
+			symbols.T_9,
+		}, 
+		SyntaxRule1R2, 
+	},
+	SyntaxRule1R3: {
+		symbols.NT_SyntaxRule, 1, 3, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_10, 
+			symbols.NT_TemplateParams, 
+			symbols.T_11, 
+			symbols.T_8, 
+			symbols.NT_SyntaxAlternates, 
+			symbols.T_9,
+		}, 
+		SyntaxRule1R3, 
+	},
+	SyntaxRule1R4: {
+		symbols.NT_SyntaxRule, 1, 4, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_10, 
+			symbols.NT_TemplateParams, 
+			symbols.T_11, 
+			symbols.T_8, 
+			symbols.NT_SyntaxAlternates, 
+			symbols.T_9,
+		}, 
+		SyntaxRule1R4, 
+	},
+	SyntaxRule1R5: {
+		symbols.NT_SyntaxRule, 1, 5, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_10, 
+			symbols.NT_TemplateParams, 
+			symbols.T_11, 
+			symbols.T_8, 
+			symbols.NT_SyntaxAlternates, 
+			symbols.T_9,
+		}, 
+		SyntaxRule1R5, 
+	},
+	SyntaxRule1R6: {
+		symbols.NT_SyntaxRule, 1, 6, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_10, 
+			symbols.NT_TemplateParams, 
+			symbols.T_11, 
+			symbols.T_8, 
+			symbols.NT_SyntaxAlternates, 
+			symbols.T_9,
+		}, 
+		SyntaxRule1R6, 
+	},
+	SyntaxRule1R7: {
+		symbols.NT_SyntaxRule, 1, 7, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_10, 
+			symbols.NT_TemplateParams, 
+			symbols.T_11, 
+			symbols.T_8, 
+			symbols.NT_SyntaxAlternates, 
+			symbols.T_9,
+		}, 
+		SyntaxRule1R7, 
+	},
+	SyntaxSymbol0R0: {
+		symbols.NT_SyntaxSymbol, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_108,
+		}, 
+		SyntaxSymbol0R0, 
+	},
+	SyntaxSymbol0R1: {
+		symbols.NT_SyntaxSymbol, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_108,
+		}, 
+		SyntaxSymbol0R1, 
+	},
+	SyntaxSymbol1R0: {
+		symbols.NT_SyntaxSymbol, 1, 0, 
+		symbols.Symbols{  
+			symbols.NT_TokID,
+		}, 
+		SyntaxSymbol1R0, 
+	},
+	SyntaxSymbol1R1: {
+		symbols.NT_SyntaxSymbol, 1, 1, 
+		symbols.Symbols{  
+			symbols.NT_TokID,
+		}, 
+		SyntaxSymbol1R1, 
+	},
+	SyntaxSymbol2R0: {
+		symbols.NT_SyntaxSymbol, 2, 0, 
+		symbols.Symbols{  
+			symbols.T_115,
+		}, 
+		SyntaxSymbol2R0, 
+	},
+	SyntaxSymbol2R1: {
+		symbols.NT_SyntaxSymbol, 2, 1, 
+		symbols.Symbols{  
+			symbols.T_115,
+		}, 
+		SyntaxSymbol2R1, 
+	},
+	SyntaxSymbol3R0: {
+		symbols.NT_SyntaxSymbol, 3, 0, 
+		symbols.Symbols{  
+			symbols.T_104,
+		}, 
+		SyntaxSymbol3R0, 
+	},
+	SyntaxSymbol3R1: {
+		symbols.NT_SyntaxSymbol, 3, 1, 
+		symbols.Symbols{  
+			symbols.T_104,
+		}, 
+		SyntaxSymbol3R1, 
+	},
+	SyntaxSymbol4R0: {
+		symbols.NT_SyntaxSymbol, 4, 0, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_10, 
+			symbols.NT_TemplateArgs, 
+			symbols.T_11,
+		}, 
+		SyntaxSymbol4R0, 
+	},
+	SyntaxSymbol4R1: {
+		symbols.NT_SyntaxSymbol, 4, 1, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_10, 
+			symbols.NT_TemplateArgs, 
+			symbols.T_11,
+		}, 
+		SyntaxSymbol4R1, 
+	},
+	SyntaxSymbol4R2: {
+		symbols.NT_SyntaxSymbol, 4, 2, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_10, 
+			symbols.NT_TemplateArgs, 
+			symbols.T_11,
+		}, 
+		SyntaxSymbol4R2, 
+	},
+	SyntaxSymbol4R3: {
+		symbols.NT_SyntaxSymbol, 4, 3, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_10, 
+			symbols.NT_TemplateArgs, 
+			symbols.T_11,
+		}, 
+		SyntaxSymbol4R3, 
+	},
+	SyntaxSymbol4R4: {
+		symbols.NT_SyntaxSymbol, 4, 4, 
+		symbols.Symbols{  
+			symbols.T_108, 
+			symbols.T_10, 
+			symbols.NT_TemplateArgs, 
+			symbols.T_11,
+		}, 
+		SyntaxSymbol4R4, 
+	},
+	SyntaxSymbols0R0: {
+		symbols.NT_SyntaxSymbols, 0, 0, 
+		symbols.Symbols{  
+			symbols.NT_LabelledSymbol,
+		}, 
+		SyntaxSymbols0R0, 
+	},
+	SyntaxSymbols0R1: {
+		symbols.NT_SyntaxSymbols, 0, 1, 
+		symbols.Symbols{  
+			symbols.NT_LabelledSymbol,
+		}, 
+		SyntaxSymbols0R1, 
+	},
+	SyntaxSymbols1R0: {
+		symbols.NT_SyntaxSymbols, 1, 0, 
+		symbols.Symbols{  
+			symbols.NT_LabelledSymbol, 
+			symbols.NT_SyntaxSymbols,
+		}, 
+		SyntaxSymbols1R0, 
+	},
+	SyntaxSymbols1R1: {
+		symbols.NT_SyntaxSymbols, 1, 1, 
+		symbols.Symbols{  
+			symbols.NT_LabelledSymbol, 
+			symbols.NT_SyntaxSymbols,
+		}, 
+		SyntaxSymbols1R1, 
+	},
+	SyntaxSymbols1R2: {
+		symbols.NT_SyntaxSymbols, 1, 2, 
+		symbols.Symbols{  
+			symbols.NT_LabelledSymbol, 
+			symbols.NT_SyntaxSymbols,
+		}, 
+		SyntaxSymbols1R2, 
+	},
+	TemplateArgs0R0: {
+		symbols.NT_TemplateArgs, 0, 0, 
+		symbols.Symbols{  
+			symbols.NT_SyntaxSymbol,
+		}, 
+		TemplateArgs0R0, 
+	},
+	TemplateArgs0R1: {
+		symbols.NT_TemplateArgs, 0, 1, 
+		symbols.Symbols{  
+			symbols.NT_SyntaxSymbol,
+		}, 
+		TemplateArgs0R1, 
+	},
+	TemplateArgs1R0: {
+		symbols.NT_TemplateArgs, 1, 0, 
+		symbols.Symbols{  
+			symbols.NT_SyntaxSymbol, 
+			symbols.T_5, 
+			symbols.NT_TemplateArgs,
+		}, 
+		TemplateArgs1R0, 
+	},
+	TemplateArgs1R1: {
+		symbols.NT_TemplateArgs, 1, 1, 
+		symbols.Symbols{  
+			symbols.NT_SyntaxSymbol, 
+			symbols.T_5, 
+			symbols.NT_TemplateArgs,
+		}, 
+		TemplateArgs1R1, 
+	},
+	TemplateArgs1R2: {
+		symbols.NT_TemplateArgs, 1, 2, 
+		symbols.Symbols{  
+			symbols.NT_SyntaxSymbol, 
+			symbols.T_5, 
+			symbols.NT_TemplateArgs,
+		}, 
+		TemplateArgs1R2, 
+	},
+	TemplateArgs1R3: {
+		symbols.NT_TemplateArgs, 1, 3, 
+		symbols.Symbols{  
+			symbols.NT_SyntaxSymbol, 
+			symbols.T_5, 
+			symbols.NT_TemplateArgs,
+		}, 
+		TemplateArgs1R3, 
 	},
-	SyntaxAlternates1R0: {
-		symbols.NT_SyntaxAlternates, 1, 0, 
+	TemplateParams0R0: {
+		symbols.NT_TemplateParams, 0, 0, 
 		symbols.Symbols{  
-			symbols.NT_SyntaxAlternate, 
-			symbols.T_108, 
-			symbols.NT_SyntaxAlternates,
+			symbols.T_108,
 		}, 
-		SyntaxAlternates1R0, 
+		TemplateParams0R0, 
 	},
-	SyntaxAlternates1R1: {
-		symbols.NT_SyntaxAlternates, 1, 1, 
+	TemplateParams0R1: {
+		symbols.NT_TemplateParams, 0, 1, 
 		symbols.Symbols{  
-			symbols.NT_SyntaxAlternate, 
-			symbols.T_108, 
-			symbols.NT_SyntaxAlternates,
+			symbols.T_108,
 		}, 
-		SyntaxAlternates1R1, 
+		TemplateParams0R1, 
 	},
-	SyntaxAlternates1R2: {
-		symbols.NT_SyntaxAlternates, 1, 2, 
+	TemplateParams1R0: {
+		symbols.NT_TemplateParams, 1, 0, 
 		symbols.Symbols{  
-			symbols.NT_SyntaxAlternate, 
 			symbols.T_108, 
-			symbols.NT_SyntaxAlternates,
+			symbols.T_5, 
+			symbols.NT_TemplateParams,
 		}, 
-		SyntaxAlternates1R2, 
+		TemplateParams1R0, 
 	},
-	SyntaxAlternates1R3: {
-		symbols.NT_SyntaxAlternates, 1, 3, 
+	TemplateParams1R1: {
+		symbols.NT_TemplateParams, 1, 1, 
 		symbols.Symbols{  
-			symbols.NT_SyntaxAlternate, 
 			symbols.T_108, 
-			symbols.NT_SyntaxAlternates,
+			symbols.T_5, 
+			symbols.NT_TemplateParams,
 		}, 
-		SyntaxAlternates1R3, 
+		TemplateParams1R1, 
 	},
-	SyntaxRule0R0: {
-		symbols.NT_SyntaxRule, 0, 0, 
+	TemplateParams1R2: {
+		symbols.NT_TemplateParams, 1, 2, 
 		symbols.Symbols{  
-			symbols.T_101, 
-			symbols.T_6, 
-			symbols.NT_SyntaxAlternates, 
-			symbols.T_7,
+			symbols.T_108, 
+			symbols.T_5, 
+			symbols.NT_TemplateParams,
 		}, 
-		SyntaxRule0R0, 
+		TemplateParams1R2, 
 	},
-	SyntaxRule0R1: {
-		symbols.NT_SyntaxRule, 0, 1, 
+	TemplateParams1R3: {
+		symbols.NT_TemplateParams, 1, 3, 
 		symbols.Symbols{  
-			symbols.T_101, 
-			symbols.T_6, 
-			symbols.NT_SyntaxAlternates, 
-			symbols.T_7,
+			symbols.T_108, 
+			symbols.T_5, 
+			symbols.NT_TemplateParams,
 		}, 
-		SyntaxRule0R1, 
+		TemplateParams1R3, 
 	},
-	SyntaxRule0R2: {
-		symbols.NT_SyntaxRule, 0, 2, 
+	TokID0R0: {
+		symbols.NT_TokID, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_101, 
-			symbols.T_6, 
-			symbols.NT_SyntaxAlternates, 
-			symbols.T_7,
+			symbols.T_116,
 		}, 
-		SyntaxRule0R2, 
+		TokID0R0, 
 	},
-	SyntaxRule0R3: {
-		symbols.NT_SyntaxRule, 0, 3, 
+	TokID0R1: {
+		symbols.NT_TokID, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_101, 
-			symbols.T_6, 
-			symbols.NT_SyntaxAlternates, 
-			symbols.T_7,
+			symbols.T_116,
 		}, 
-		SyntaxRule0R3, 
+		TokID0R1, 
 	},
-	SyntaxRule0R4: {
-		symbols.NT_SyntaxRule, 0, 4, 
+	TokID1R0: {
+		symbols.NT_TokID, 1, 0, 
 		symbols.Symbols{  
-			symbols.T_101, 
-			symbols.T_6, 
-			symbols.NT_SyntaxAlternates, 
-			symbols.T_7,
+			symbols.T_99,
 		}, 
-		SyntaxRule0R4, 
+		TokID1R0, 
 	},
-	SyntaxSymbol0R0: {
-		symbols.NT_SyntaxSymbol, 0, 0, 
+	TokID1R1: {
+		symbols.NT_TokID, 1, 1, 
 		symbols.Symbols{  
-			symbols.T_101,
+			symbols.T_99,
 		}, 
-		SyntaxSymbol0R0, 
+		TokID1R1, 
 	},
-	SyntaxSymbol0R1: {
-		symbols.NT_SyntaxSymbol, 0, 1, 
+	TokID2R0: {
+		symbols.NT_TokID, 2, 0, 
 		symbols.Symbols{  
-			symbols.T_101,
+			symbols.T_100,
 		}, 
-		SyntaxSymbol0R1, 
+		TokID2R0, 
 	},
-	SyntaxSymbol1R0: {
-		symbols.NT_SyntaxSymbol, 1, 0, 
+	TokID2R1: {
+		symbols.NT_TokID, 2, 1, 
 		symbols.Symbols{  
-			symbols.T_105,
+			symbols.T_100,
 		}, 
-		SyntaxSymbol1R0, 
+		TokID2R1, 
 	},
-	SyntaxSymbol1R1: {
-		symbols.NT_SyntaxSymbol, 1, 1, 
+	TokID3R0: {
+		symbols.NT_TokID, 3, 0, 
 		symbols.Symbols{  
-			symbols.T_105,
+			symbols.T_103,
 		}, 
-		SyntaxSymbol1R1, 
+		TokID3R0, 
 	},
-	SyntaxSymbol2R0: {
-		symbols.NT_SyntaxSymbol, 2, 0, 
+	TokID3R1: {
+		symbols.NT_TokID, 3, 1, 
 		symbols.Symbols{  
-			symbols.T_104,
+			symbols.T_103,
 		}, 
-		SyntaxSymbol2R0, 
+		TokID3R1, 
 	},
-	SyntaxSymbol2R1: {
-		symbols.NT_SyntaxSymbol, 2, 1, 
+	TokID4R0: {
+		symbols.NT_TokID, 4, 0, 
 		symbols.Symbols{  
-			symbols.T_104,
+			symbols.T_111,
 		}, 
-		SyntaxSymbol2R1, 
+		TokID4R0, 
 	},
-	SyntaxSymbols0R0: {
-		symbols.NT_SyntaxSymbols, 0, 0, 
+	TokID4R1: {
+		symbols.NT_TokID, 4, 1, 
 		symbols.Symbols{  
-			symbols.NT_SyntaxSymbol,
+			symbols.T_111,
 		}, 
-		SyntaxSymbols0R0, 
+		TokID4R1, 
 	},
-	SyntaxSymbols0R1: {
-		symbols.NT_SyntaxSymbols, 0, 1, 
+	TokID5R0: {
+		symbols.NT_TokID, 5, 0, 
 		symbols.Symbols{  
-			symbols.NT_SyntaxSymbol,
+			symbols.T_112,
 		}, 
-		SyntaxSymbols0R1, 
+		TokID5R0, 
 	},
-	SyntaxSymbols1R0: {
-		symbols.NT_SyntaxSymbols, 1, 0, 
+	TokID5R1: {
+		symbols.NT_TokID, 5, 1, 
 		symbols.Symbols{  
-			symbols.NT_SyntaxSymbol, 
-			symbols.NT_SyntaxSymbols,
+			symbols.T_112,
 		}, 
-		SyntaxSymbols1R0, 
+		TokID5R1, 
 	},
-	SyntaxSymbols1R1: {
-		symbols.NT_SyntaxSymbols, 1, 1, 
+	TokID6R0: {
+		symbols.NT_TokID, 6, 0, 
 		symbols.Symbols{  
-			symbols.NT_SyntaxSymbol, 
-			symbols.NT_SyntaxSymbols,
+			symbols.T_114,
 		}, 
-		SyntaxSymbols1R1, 
+		TokID6R0, 
 	},
-	SyntaxSymbols1R2: {
-		symbols.NT_SyntaxSymbols, 1, 2, 
+	TokID6R1: {
+		symbols.NT_TokID, 6, 1, 
 		symbols.Symbols{  
-			symbols.NT_SyntaxSymbol, 
-			symbols.NT_SyntaxSymbols,
+			symbols.T_114,
 		}, 
-		SyntaxSymbols1R2, 
+		TokID6R1, 
 	},
 	UnicodeCategory0R0: {
 		symbols.NT_UnicodeCategory, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_13,
+			symbols.T_16,
 		}, 
 		UnicodeCategory0R0, 
 	},
 	UnicodeCategory0R1: {
 		symbols.NT_UnicodeCategory, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_13,
+			symbols.T_16,
 		}, 
 		UnicodeCategory0R1, 
 	},
 	UnicodeCategory1R0: {
 		symbols.NT_UnicodeCategory, 1, 0, 
 		symbols.Symbols{  
-			symbols.T_14,
+			symbols.T_17,
 		}, 
 		UnicodeCategory1R0, 
 	},
 	UnicodeCategory1R1: {
 		symbols.NT_UnicodeCategory, 1, 1, 
 		symbols.Symbols{  
-			symbols.T_14,
+			symbols.T_17,
 		}, 
 		UnicodeCategory1R1, 
 	},
 	UnicodeCategory2R0: {
 		symbols.NT_UnicodeCategory, 2, 0, 
 		symbols.Symbols{  
-			symbols.T_15,
+			symbols.T_18,
 		}, 
 		UnicodeCategory2R0, 
 	},
 	UnicodeCategory2R1: {
 		symbols.NT_UnicodeCategory, 2, 1, 
 		symbols.Symbols{  
-			symbols.T_15,
+			symbols.T_18,
 		}, 
 		UnicodeCategory2R1, 
 	},
 	UnicodeCategory3R0: {
 		symbols.NT_UnicodeCategory, 3, 0, 
 		symbols.Symbols{  
-			symbols.T_16,
+			symbols.T_19,
 		}, 
 		UnicodeCategory3R0, 
 	},
 	UnicodeCategory3R1: {
 		symbols.NT_UnicodeCategory, 3, 1, 
 		symbols.Symbols{  
-			symbols.T_16,
+			symbols.T_19,
 		}, 
 		UnicodeCategory3R1, 
 	},
 	UnicodeCategory4R0: {
 		symbols.NT_UnicodeCategory, 4, 0, 
 		symbols.Symbols{  
-			symbols.T_21,
+			symbols.T_24,
 		}, 
 		UnicodeCategory4R0, 
 	},
 	UnicodeCategory4R1: {
 		symbols.NT_UnicodeCategory, 4, 1, 
 		symbols.Symbols{  
-			symbols.T_21,
+			symbols.T_24,
 		}, 
 		UnicodeCategory4R1, 
 	},
 	UnicodeCategory5R0: {
 		symbols.NT_UnicodeCategory, 5, 0, 
 		symbols.Symbols{  
-			symbols.T_43,
+			symbols.T_46,
 		}, 
 		UnicodeCategory5R0, 
 	},
 	UnicodeCategory5R1: {
 		symbols.NT_UnicodeCategory, 5, 1, 
 		symbols.Symbols{  
-			symbols.T_43,
+			symbols.T_46,
 		}, 
 		UnicodeCategory5R1, 
 	},
 	UnicodeCategory6R0: {
 		symbols.NT_UnicodeCategory, 6, 0, 
 		symbols.Symbols{  
-			symbols.T_29,
+			symbols.T_32,
 		}, 
 		UnicodeCategory6R0, 
 	},
 	UnicodeCategory6R1: {
 		symbols.NT_UnicodeCategory, 6, 1, 
 		symbols.Symbols{  
-			symbols.T_29,
+			symbols.T_32,
 		}, 
 		UnicodeCategory6R1, 
 	},
 	UnicodeCategory7R0: {
 		symbols.NT_UnicodeCategory, 7, 0, 
 		symbols.Symbols{  
-			symbols.T_37,
+			symbols.T_40,
 		}, 
 		UnicodeCategory7R0, 
 	},
 	UnicodeCategory7R1: {
 		symbols.NT_UnicodeCategory, 7, 1, 
 		symbols.Symbols{  
-			symbols.T_37,
+			symbols.T_40,
 		}, 
 		UnicodeCategory7R1, 
 	},
 	UnicodeCategory8R0: {
 		symbols.NT_UnicodeCategory, 8, 0, 
 		symbols.Symbols{  
-			symbols.T_31,
+			symbols.T_34,
 		}, 
 		UnicodeCategory8R0, 
 	},
 	UnicodeCategory8R1: {
 		symbols.NT_UnicodeCategory, 8, 1, 
 		symbols.Symbols{  
-			symbols.T_31,
+			symbols.T_34,
 		}, 
 		UnicodeCategory8R1, 
 	},
 	UnicodeCategory9R0: {
 		symbols.NT_UnicodeCategory, 9, 0, 
 		symbols.Symbols{  
-			symbols.T_34,
+			symbols.T_37,
 		}, 
 		UnicodeCategory9R0, 
 	},
 	UnicodeCategory9R1: {
 		symbols.NT_UnicodeCategory, 9, 1, 
 		symbols.Symbols{  
-			symbols.T_34,
+			symbols.T_37,
 		}, 
 		UnicodeCategory9R1, 
 	},
 	UnicodeCategory10R0: {
 		symbols.NT_UnicodeCategory, 10, 0, 
 		symbols.Symbols{  
-			symbols.T_33,
+			symbols.T_36,
 		}, 
 		UnicodeCategory10R0, 
 	},
 	UnicodeCategory10R1: {
 		symbols.NT_UnicodeCategory, 10, 1, 
 		symbols.Symbols{  
-			symbols.T_33,
+			symbols.T_36,
 		}, 
 		UnicodeCategory10R1, 
 	},
 	UnicodeCategory11R0: {
 		symbols.NT_UnicodeCategory, 11, 0, 
 		symbols.Symbols{  
-			symbols.T_30,
+			symbols.T_33,
 		}, 
 		UnicodeCategory11R0, 
 	},
 	UnicodeCategory11R1: {
 		symbols.NT_UnicodeCategory, 11, 1, 
 		symbols.Symbols{  
-			symbols.T_30,
+			symbols.T_33,
 		}, 
 		UnicodeCategory11R1, 
 	},
 	UnicodeCategory12R0: {
 		symbols.NT_UnicodeCategory, 12, 0, 
 		symbols.Symbols{  
-			symbols.T_38,
+			symbols.T_41,
 		}, 
 		UnicodeCategory12R0, 
 	},
 	UnicodeCategory12R1: {
 		symbols.NT_UnicodeCategory, 12, 1, 
 		symbols.Symbols{  
-			symbols.T_38,
+			symbols.T_41,
 		}, 
 		UnicodeCategory12R1, 
 	},
 	UnicodeCategory13R0: {
 		symbols.NT_UnicodeCategory, 13, 0, 
 		symbols.Symbols{  
-			symbols.T_42,
+			symbols.T_45,
 		}, 
 		UnicodeCategory13R0, 
 	},
 	UnicodeCategory13R1: {
 		symbols.NT_UnicodeCategory, 13, 1, 
 		symbols.Symbols{  
-			symbols.T_42,
+			symbols.T_45,
 		}, 
 		UnicodeCategory13R1, 
 	},
 	UnicodeCategory14R0: {
 		symbols.NT_UnicodeCategory, 14, 0, 
 		symbols.Symbols{  
-			symbols.T_39,
+			symbols.T_42,
 		}, 
 		UnicodeCategory14R0, 
 	},
 	UnicodeCategory14R1: {
 		symbols.NT_UnicodeCategory, 14, 1, 
 		symbols.Symbols{  
-			symbols.T_39,
+			symbols.T_42,
 		}, 
 		UnicodeCategory14R1, 
 	},
 	UnicodeCategory15R0: {
 		symbols.NT_UnicodeCategory, 15, 0, 
 		symbols.Symbols{  
-			symbols.T_40,
+			symbols.T_43,
 		}, 
 		UnicodeCategory15R0, 
 	},
 	UnicodeCategory15R1: {
 		symbols.NT_UnicodeCategory, 15, 1, 
 		symbols.Symbols{  
-			symbols.T_40,
+			symbols.T_43,
 		}, 
 		UnicodeCategory15R1, 
 	},
 	UnicodeCategory16R0: {
 		symbols.NT_UnicodeCategory, 16, 0, 
 		symbols.Symbols{  
-			symbols.T_41,
+			symbols.T_44,
 		}, 
 		UnicodeCategory16R0, 
 	},
 	UnicodeCategory16R1: {
 		symbols.NT_UnicodeCategory, 16, 1, 
 		symbols.Symbols{  
-			symbols.T_41,
+			symbols.T_44,
 		}, 
 		UnicodeCategory16R1, 
 	},
 	UnicodeCategory17R0: {
 		symbols.NT_UnicodeCategory, 17, 0, 
 		symbols.Symbols{  
-			symbols.T_44,
+			symbols.T_47,
 		}, 
 		UnicodeCategory17R0, 
 	},
 	UnicodeCategory17R1: {
 		symbols.NT_UnicodeCategory, 17, 1, 
 		symbols.Symbols{  
-			symbols.T_44,
+			symbols.T_47,
 		}, 
 		UnicodeCategory17R1, 
 	},
 	UnicodeCategory18R0: {
 		symbols.NT_UnicodeCategory, 18, 0, 
 		symbols.Symbols{  
-			symbols.T_46,
+			symbols.T_49,
 		}, 
 		UnicodeCategory18R0, 
 	},
 	UnicodeCategory18R1: {
 		symbols.NT_UnicodeCategory, 18, 1, 
 		symbols.Symbols{  
-			symbols.T_46,
+			symbols.T_49,
 		}, 
 		UnicodeCategory18R1, 
 	},
 	UnicodeCategory19R0: {
 		symbols.NT_UnicodeCategory, 19, 0, 
 		symbols.Symbols{  
-			symbols.T_47,
+			symbols.T_50,
 		}, 
 		UnicodeCategory19R0, 
 	},
 	UnicodeCategory19R1: {
 		symbols.NT_UnicodeCategory, 19, 1, 
 		symbols.Symbols{  
-			symbols.T_47,
+			symbols.T_50,
 		}, 
 		UnicodeCategory19R1, 
 	},
 	UnicodeCategory20R0: {
 		symbols.NT_UnicodeCategory, 20, 0, 
 		symbols.Symbols{  
-			symbols.T_48,
+			symbols.T_51,
 		}, 
 		UnicodeCategory20R0, 
 	},
 	UnicodeCategory20R1: {
 		symbols.NT_UnicodeCategory, 20, 1, 
 		symbols.Symbols{  
-			symbols.T_48,
+			symbols.T_51,
 		}, 
 		UnicodeCategory20R1, 
 	},
 	UnicodeCategory21R0: {
 		symbols.NT_UnicodeCategory, 21, 0, 
 		symbols.Symbols{  
-			symbols.T_57,
+			symbols.T_60,
 		}, 
 		UnicodeCategory21R0, 
 	},
 	UnicodeCategory21R1: {
 		symbols.NT_UnicodeCategory, 21, 1, 
 		symbols.Symbols{  
-			symbols.T_57,
+			symbols.T_60,
 		}, 
 		UnicodeCategory21R1, 
 	},
 	UnicodeCategory22R0: {
 		symbols.NT_UnicodeCategory, 22, 0, 
 		symbols.Symbols{  
-			symbols.T_17,
+			symbols.T_20,
 		}, 
 		UnicodeCategory22R0, 
 	},
 	UnicodeCategory22R1: {
 		symbols.NT_UnicodeCategory, 22, 1, 
 		symbols.Symbols{  
-			symbols.T_17,
+			symbols.T_20,
 		}, 
 		UnicodeCategory22R1, 
 	},
 	UnicodeCategory23R0: {
 		symbols.NT_UnicodeCategory, 23, 0, 
 		symbols.Symbols{  
-			symbols.T_60,
+			symbols.T_63,
 		}, 
 		UnicodeCategory23R0, 
 	},
 	UnicodeCategory23R1: {
 		symbols.NT_UnicodeCategory, 23, 1, 
 		symbols.Symbols{  
-			symbols.T_60,
+			symbols.T_63,
 		}, 
 		UnicodeCategory23R1, 
 	},
 	UnicodeCategory24R0: {
 		symbols.NT_UnicodeCategory, 24, 0, 
 		symbols.Symbols{  
-			symbols.T_61,
+			symbols.T_64,
 		}, 
 		UnicodeCategory24R0, 
 	},
 	UnicodeCategory24R1: {
 		symbols.NT_UnicodeCategory, 24, 1, 
 		symbols.Symbols{  
-			symbols.T_61,
+			symbols.T_64,
 		}, 
 		UnicodeCategory24R1, 
 	},
 	UnicodeCategory25R0: {
 		symbols.NT_UnicodeCategory, 25, 0, 
 		symbols.Symbols{  
-			symbols.T_62,
+			symbols.T_65,
 		}, 
 		UnicodeCategory25R0, 
 	},
 	UnicodeCategory25R1: {
 		symbols.NT_UnicodeCategory, 25, 1, 
 		symbols.Symbols{  
-			symbols.T_62,
+			symbols.T_65,
 		}, 
 		UnicodeCategory25R1, 
 	},
 	UnicodeCategory26R0: {
 		symbols.NT_UnicodeCategory, 26, 0, 
 		symbols.Symbols{  
-			symbols.T_63,
+			symbols.T_66,
 		}, 
 		UnicodeCategory26R0, 
 	},
 	UnicodeCategory26R1: {
 		symbols.NT_UnicodeCategory, 26, 1, 
 		symbols.Symbols{  
-			symbols.T_63,
+			symbols.T_66,
 		}, 
 		UnicodeCategory26R1, 
 	},
 	UnicodeCategory27R0: {
 		symbols.NT_UnicodeCategory, 27, 0, 
 		symbols.Symbols{  
-			symbols.T_64,
+			symbols.T_67,
 		}, 
 		UnicodeCategory27R0, 
 	},
 	UnicodeCategory27R1: {
 		symbols.NT_UnicodeCategory, 27, 1, 
 		symbols.Symbols{  
-			symbols.T_64,
+			symbols.T_67,
 		}, 
 		UnicodeCategory27R1, 
 	},
 	UnicodeCategory28R0: {
 		symbols.NT_UnicodeCategory, 28, 0, 
 		symbols.Symbols{  
-			symbols.T_65,
+			symbols.T_68,
 		}, 
 		UnicodeCategory28R0, 
 	},
 	UnicodeCategory28R1: {
 		symbols.NT_UnicodeCategory, 28, 1, 
 		symbols.Symbols{  
-			symbols.T_65,
+			symbols.T_68,
 		}, 
 		UnicodeCategory28R1, 
 	},
 	UnicodeCategory29R0: {
 		symbols.NT_UnicodeCategory, 29, 0, 
 		symbols.Symbols{  
-			symbols.T_67,
+			symbols.T_70,
 		}, 
 		UnicodeCategory29R0, 
 	},
 	UnicodeCategory29R1: {
 		symbols.NT_UnicodeCategory, 29, 1, 
 		symbols.Symbols{  
-			symbols.T_67,
+			symbols.T_70,
 		}, 
 		UnicodeCategory29R1, 
 	},
 	UnicodeCategory30R0: {
 		symbols.NT_UnicodeCategory, 30, 0, 
 		symbols.Symbols{  
-			symbols.T_68,
+			symbols.T_71,
 		}, 
 		UnicodeCategory30R0, 
 	},
 	UnicodeCategory30R1: {
 		symbols.NT_UnicodeCategory, 30, 1, 
 		symbols.Symbols{  
-			symbols.T_68,
+			symbols.T_71,
 		}, 
 		UnicodeCategory30R1, 
 	},
 	UnicodeCategory31R0: {
 		symbols.NT_UnicodeCategory, 31, 0, 
 		symbols.Symbols{  
-			symbols.T_69,
+			symbols.T_72,
 		}, 
 		UnicodeCategory31R0, 
 	},
 	UnicodeCategory31R1: {
 		symbols.NT_UnicodeCategory, 31, 1, 
 		symbols.Symbols{  
-			symbols.T_69,
+			symbols.T_72,
 		}, 
 		UnicodeCategory31R1, 
 	},
 	UnicodeCategory32R0: {
 		symbols.NT_UnicodeCategory, 32, 0, 
 		symbols.Symbols{  
-			symbols.T_74,
+			symbols.T_77,
 		}, 
 		UnicodeCategory32R0, 
 	},
 	UnicodeCategory32R1: {
 		symbols.NT_UnicodeCategory, 32, 1, 
 		symbols.Symbols{  
-			symbols.T_74,
+			symbols.T_77,
 		}, 
 		UnicodeCategory32R1, 
 	},
 	UnicodeCategory33R0: {
 		symbols.NT_UnicodeCategory, 33, 0, 
 		symbols.Symbols{  
-			symbols.T_76,
+			symbols.T_79,
 		}, 
 		UnicodeCategory33R0, 
 	},
 	UnicodeCategory33R1: {
 		symbols.NT_UnicodeCategory, 33, 1, 
 		symbols.Symbols{  
-			symbols.T_76,
+			symbols.T_79,
 		}, 
 		UnicodeCategory33R1, 
 	},
 	UnicodeCategory34R0: {
 		symbols.NT_UnicodeCategory, 34, 0, 
 		symbols.Symbols{  
-			symbols.T_77,
+			symbols.T_80,
 		}, 
 		UnicodeCategory34R0, 
 	},
 	UnicodeCategory34R1: {
 		symbols.NT_UnicodeCategory, 34, 1, 
 		symbols.Symbols{  
-			symbols.T_77,
+			symbols.T_80,
 		}, 
 		UnicodeCategory34R1, 
 	},
 	UnicodeCategory35R0: {
 		symbols.NT_UnicodeCategory, 35, 0, 
 		symbols.Symbols{  
-			symbols.T_79,
+			symbols.T_82,
 		}, 
 		UnicodeCategory35R0, 
 	},
 	UnicodeCategory35R1: {
 		symbols.NT_UnicodeCategory, 35, 1, 
 		symbols.Symbols{  
-			symbols.T_79,
+			symbols.T_82,
 		}, 
 		UnicodeCategory35R1, 
 	},
 	UnicodeCategory36R0: {
 		symbols.NT_UnicodeCategory, 36, 0, 
 		symbols.Symbols{  
-			symbols.T_80,
+			symbols.T_83,
 		}, 
 		UnicodeCategory36R0, 
 	},
 	UnicodeCategory36R1: {
 		symbols.NT_UnicodeCategory, 36, 1, 
 		symbols.Symbols{  
-			symbols.T_80,
+			symbols.T_83,
 		}, 
 		UnicodeCategory36R1, 
 	},
 	UnicodeCategory37R0: {
 		symbols.NT_UnicodeCategory, 37, 0, 
 		symbols.Symbols{  
-			symbols.T_92,
+			symbols.T_95,
 		}, 
 		UnicodeCategory37R0, 
 	},
 	UnicodeCategory37R1: {
 		symbols.NT_UnicodeCategory, 37, 1, 
 		symbols.Symbols{  
-			symbols.T_92,
+			symbols.T_95,
 		}, 
 		UnicodeCategory37R1, 
 	},
 	UnicodeCategory38R0: {
 		symbols.NT_UnicodeCategory, 38, 0, 
 		symbols.Symbols{  
-			symbols.T_81,
+			symbols.T_84,
 		}, 
 		UnicodeCategory38R0, 
 	},
 	UnicodeCategory38R1: {
 		symbols.NT_UnicodeCategory, 38, 1, 
 		symbols.Symbols{  
-			symbols.T_81,
+			symbols.T_84,
 		}, 
 		UnicodeCategory38R1, 
 	},
 	UnicodeCategory39R0: {
 		symbols.NT_UnicodeCategory, 39, 0, 
 		symbols.Symbols{  
-			symbols.T_82,
+			symbols.T_85,
 		}, 
 		UnicodeCategory39R0, 
 	},
 	UnicodeCategory39R1: {
 		symbols.NT_UnicodeCategory, 39, 1, 
 		symbols.Symbols{  
-			symbols.T_82,
+			symbols.T_85,
 		}, 
 		UnicodeCategory39R1, 
 	},
 	UnicodeCategory40R0: {
 		symbols.NT_UnicodeCategory, 40, 0, 
 		symbols.Symbols{  
-			symbols.T_84,
+			symbols.T_87,
 		}, 
 		UnicodeCategory40R0, 
 	},
 	UnicodeCategory40R1: {
 		symbols.NT_UnicodeCategory, 40, 1, 
 		symbols.Symbols{  
-			symbols.T_84,
+			symbols.T_87,
 		}, 
 		UnicodeCategory40R1, 
 	},
 	UnicodeCategory41R0: {
 		symbols.NT_UnicodeCategory, 41, 0, 
 		symbols.Symbols{  
-			symbols.T_35,
+			symbols.T_38,
 		}, 
 		UnicodeCategory41R0, 
 	},
 	UnicodeCategory41R1: {
 		symbols.NT_UnicodeCategory, 41, 1, 
 		symbols.Symbols{  
-			symbols.T_35,
+			symbols.T_38,
 		}, 
 		UnicodeCategory41R1, 
 	},
 	UnicodeCategory42R0: {
 		symbols.NT_UnicodeCategory, 42, 0, 
 		symbols.Symbols{  
-			symbols.T_86,
+			symbols.T_89,
 		}, 
 		UnicodeCategory42R0, 
 	},
 	UnicodeCategory42R1: {
 		symbols.NT_UnicodeCategory, 42, 1, 
 		symbols.Symbols{  
-			symbols.T_86,
+			symbols.T_89,
 		}, 
 		UnicodeCategory42R1, 
 	},
 	UnicodeCategory43R0: {
 		symbols.NT_UnicodeCategory, 43, 0, 
 		symbols.Symbols{  
-			symbols.T_36,
+			symbols.T_39,
 		}, 
 		UnicodeCategory43R0, 
 	},
 	UnicodeCategory43R1: {
 		symbols.NT_UnicodeCategory, 43, 1, 
 		symbols.Symbols{  
-			symbols.T_36,
+			symbols.T_39,
 		}, 
 		UnicodeCategory43R1, 
 	},
 	UnicodeCategory44R0: {
 		symbols.NT_UnicodeCategory, 44, 0, 
 		symbols.Symbols{  
-			symbols.T_89,
+			symbols.T_92,
 		}, 
 		UnicodeCategory44R0, 
 	},
 	UnicodeCategory44R1: {
 		symbols.NT_UnicodeCategory, 44, 1, 
 		symbols.Symbols{  
-			symbols.T_89,
+			symbols.T_92,
 		}, 
 		UnicodeCategory44R1, 
 	},
 	UnicodeCategory45R0: {
 		symbols.NT_UnicodeCategory, 45, 0, 
 		symbols.Symbols{  
-			symbols.T_90,
+			symbols.T_93,
 		}, 
 		UnicodeCategory45R0, 
 	},
 	UnicodeCategory45R1: {
 		symbols.NT_UnicodeCategory, 45, 1, 
 		symbols.Symbols{  
-			symbols.T_90,
+			symbols.T_93,
 		}, 
 		UnicodeCategory45R1, 
 	},
 	UnicodeCategory46R0: {
 		symbols.NT_UnicodeCategory, 46, 0, 
 		symbols.Symbols{  
-			symbols.T_91,
+			symbols.T_94,
 		}, 
 		UnicodeCategory46R0, 
 	},
 	UnicodeCategory46R1: {
 		symbols.NT_UnicodeCategory, 46, 1, 
 		symbols.Symbols{  
-			symbols.T_91,
+			symbols.T_94,
 		}, 
 		UnicodeCategory46R1, 
 	},
 	UnicodeClass0R0: {
 		symbols.NT_UnicodeClass, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_98,
+			symbols.T_105,
 		}, 
 		UnicodeClass0R0, 
 	},
 	UnicodeClass0R1: {
 		symbols.NT_UnicodeClass, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_98,
+			symbols.T_105,
 		}, 
 		UnicodeClass0R1, 
 	},
 	UnicodeClass1R0: {
 		symbols.NT_UnicodeClass, 1, 0, 
 		symbols.Symbols{  
-			symbols.T_106,
+			symbols.T_117,
 		}, 
 		UnicodeClass1R0, 
 	},
 	UnicodeClass1R1: {
 		symbols.NT_UnicodeClass, 1, 1, 
 		symbols.Symbols{  
-			symbols.T_106,
+			symbols.T_117,
 		}, 
 		UnicodeClass1R1, 
 	},
 	UnicodeClass2R0: {
 		symbols.NT_UnicodeClass, 2, 0, 
 		symbols.Symbols{  
-			symbols.T_99,
+			symbols.T_106,
 		}, 
 		UnicodeClass2R0, 
 	},
 	UnicodeClass2R1: {
 		symbols.NT_UnicodeClass, 2, 1, 
 		symbols.Symbols{  
-			symbols.T_99,
+			symbols.T_106,
 		}, 
 		UnicodeClass2R1, 
 	},
 	UnicodeClass3R0: {
 		symbols.NT_UnicodeClass, 3, 0, 
 		symbols.Symbols{  
-			symbols.T_102,
+			symbols.T_109,
 		}, 
 		UnicodeClass3R0, 
 	},
 	UnicodeClass3R1: {
 		symbols.NT_UnicodeClass, 3, 1, 
 		symbols.Symbols{  
-			symbols.T_102,
+			symbols.T_109,
 		}, 
 		UnicodeClass3R1, 
 	},
 	UnicodeProperty0R0: {
 		symbols.NT_UnicodeProperty, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_11,
+			symbols.T_14,
 		}, 
 		UnicodeProperty0R0, 
 	},
 	UnicodeProperty0R1: {
 		symbols.NT_UnicodeProperty, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_11,
+			symbols.T_14,
 		}, 
 		UnicodeProperty0R1, 
 	},
 	UnicodeProperty1R0: {
 		symbols.NT_UnicodeProperty, 1, 0, 
 		symbols.Symbols{  
-			symbols.T_12,
+			symbols.T_15,
 		}, 
 		UnicodeProperty1R0, 
 	},
 	UnicodeProperty1R1: {
 		symbols.NT_UnicodeProperty, 1, 1, 
 		symbols.Symbols{  
-			symbols.T_12,
+			symbols.T_15,
 		}, 
 		UnicodeProperty1R1, 
 	},
 	UnicodeProperty2R0: {
 		symbols.NT_UnicodeProperty, 2, 0, 
 		symbols.Symbols{  
-			symbols.T_18,
+			symbols.T_21,
 		}, 
 		UnicodeProperty2R0, 
 	},
 	UnicodeProperty2R1: {
 		symbols.NT_UnicodeProperty, 2, 1, 
 		symbols.Symbols{  
-			symbols.T_18,
+			symbols.T_21,
 		}, 
 		UnicodeProperty2R1, 
 	},
 	UnicodeProperty3R0: {
 		symbols.NT_UnicodeProperty, 3, 0, 
 		symbols.Symbols{  
-			symbols.T_19,
+			symbols.T_22,
 		}, 
 		UnicodeProperty3R0, 
 	},
 	UnicodeProperty3R1: {
 		symbols.NT_UnicodeProperty, 3, 1, 
 		symbols.Symbols{  
-			symbols.T_19,
+			symbols.T_22,
 		}, 
 		UnicodeProperty3R1, 
 	},
 	UnicodeProperty4R0: {
 		symbols.NT_UnicodeProperty, 4, 0, 
 		symbols.Symbols{  
-			symbols.T_20,
+			symbols.T_23,
 		}, 
 		UnicodeProperty4R0, 
 	},
 	UnicodeProperty4R1: {
 		symbols.NT_UnicodeProperty, 4, 1, 
 		symbols.Symbols{  
-			symbols.T_20,
+			symbols.T_23,
 		}, 
 		UnicodeProperty4R1, 
 	},
 	UnicodeProperty5R0: {
 		symbols.NT_UnicodeProperty, 5, 0, 
 		symbols.Symbols{  
-			symbols.T_22,
+			symbols.T_25,
 		}, 
 		UnicodeProperty5R0, 
 	},
 	UnicodeProperty5R1: {
 		symbols.NT_UnicodeProperty, 5, 1, 
 		symbols.Symbols{  
-			symbols.T_22,
+			symbols.T_25,
 		}, 
 		UnicodeProperty5R1, 
 	},
 	UnicodeProperty6R0: {
 		symbols.NT_UnicodeProperty, 6, 0, 
 		symbols.Symbols{  
-			symbols.T_23,
+			symbols.T_26,
 		}, 
 		UnicodeProperty6R0, 
 	},
 	UnicodeProperty6R1: {
 		symbols.NT_UnicodeProperty, 6, 1, 
 		symbols.Symbols{  
-			symbols.T_23,
+			symbols.T_26,
 		}, 
 		UnicodeProperty6R1, 
 	},
 	UnicodeProperty7R0: {
 		symbols.NT_UnicodeProperty, 7, 0, 
 		symbols.Symbols{  
-			symbols.T_24,
+			symbols.T_27,
 		}, 
 		UnicodeProperty7R0, 
 	},
 	UnicodeProperty7R1: {
 		symbols.NT_UnicodeProperty, 7, 1, 
 		symbols.Symbols{  
-			symbols.T_24,
+			symbols.T_27,
 		}, 
 		UnicodeProperty7R1, 
 	},
 	UnicodeProperty8R0: {
 		symbols.NT_UnicodeProperty, 8, 0, 
 		symbols.Symbols{  
-			symbols.T_25,
+			symbols.T_28,
 		}, 
 		UnicodeProperty8R0, 
 	},
 	UnicodeProperty8R1: {
 		symbols.NT_UnicodeProperty, 8, 1, 
 		symbols.Symbols{  
-			symbols.T_25,
+			symbols.T_28,
 		}, 
 		UnicodeProperty8R1, 
 	},
 	UnicodeProperty9R0: {
 		symbols.NT_UnicodeProperty, 9, 0, 
 		symbols.Symbols{  
-			symbols.T_26,
+			symbols.T_29,
 		}, 
 		UnicodeProperty9R0, 
 	},
 	UnicodeProperty9R1: {
 		symbols.NT_UnicodeProperty, 9, 1, 
 		symbols.Symbols{  
-			symbols.T_26,
+			symbols.T_29,
 		}, 
 		UnicodeProperty9R1, 
 	},
 	UnicodeProperty10R0: {
 		symbols.NT_UnicodeProperty, 10, 0, 
 		symbols.Symbols{  
-			symbols.T_27,
+			symbols.T_30,
 		}, 
 		UnicodeProperty10R0, 
 	},
 	UnicodeProperty10R1: {
 		symbols.NT_UnicodeProperty, 10, 1, 
 		symbols.Symbols{  
-			symbols.T_27,
+			symbols.T_30,
 		}, 
 		UnicodeProperty10R1, 
 	},
 	UnicodeProperty11R0: {
 		symbols.NT_UnicodeProperty, 11, 0, 
 		symbols.Symbols{  
-			symbols.T_28,
+			symbols.T_31,
 		}, 
 		UnicodeProperty11R0, 
 	},
 	UnicodeProperty11R1: {
 		symbols.NT_UnicodeProperty, 11, 1, 
 		symbols.Symbols{  
-			symbols.T_28,
+			symbols.T_31,
 		}, 
 		UnicodeProperty11R1, 
 	},
 	UnicodeProperty12R0: {
 		symbols.NT_UnicodeProperty, 12, 0, 
 		symbols.Symbols{  
-			symbols.T_32,
+			symbols.T_35,
 		}, 
 		UnicodeProperty12R0, 
 	},
 	UnicodeProperty12R1: {
 		symbols.NT_UnicodeProperty, 12, 1, 
 		symbols.Symbols{  
-			symbols.T_32,
+			symbols.T_35,
 		}, 
 		UnicodeProperty12R1, 
 	},
 	UnicodeProperty13R0: {
 		symbols.NT_UnicodeProperty, 13, 0, 
 		symbols.Symbols{  
-			symbols.T_45,
+			symbols.T_48,
 		}, 
 		UnicodeProperty13R0, 
 	},
 	UnicodeProperty13R1: {
 		symbols.NT_UnicodeProperty, 13, 1, 
 		symbols.Symbols{  
-			symbols.T_45,
+			symbols.T_48,
 		}, 
 		UnicodeProperty13R1, 
 	},
 	UnicodeProperty14R0: {
 		symbols.NT_UnicodeProperty, 14, 0, 
 		symbols.Symbols{  
-			symbols.T_49,
+			symbols.T_52,
 		}, 
 		UnicodeProperty14R0, 
 	},
 	UnicodeProperty14R1: {
 		symbols.NT_UnicodeProperty, 14, 1, 
 		symbols.Symbols{  
-			symbols.T_49,
+			symbols.T_52,
 		}, 
 		UnicodeProperty14R1, 
 	},
 	UnicodeProperty15R0: {
 		symbols.NT_UnicodeProperty, 15, 0, 
 		symbols.Symbols{  
-			symbols.T_50,
+			symbols.T_53,
 		}, 
 		UnicodeProperty15R0, 
 	},
 	UnicodeProperty15R1: {
 		symbols.NT_UnicodeProperty, 15, 1, 
 		symbols.Symbols{  
-			symbols.T_50,
+			symbols.T_53,
 		}, 
 		UnicodeProperty15R1, 
 	},
 	UnicodeProperty16R0: {
 		symbols.NT_UnicodeProperty, 16, 0, 
 		symbols.Symbols{  
-			symbols.T_51,
+			symbols.T_54,
 		}, 
 		UnicodeProperty16R0, 
 	},
 	UnicodeProperty16R1: {
 		symbols.NT_UnicodeProperty, 16, 1, 
 		symbols.Symbols{  
-			symbols.T_51,
+			symbols.T_54,
 		}, 
 		UnicodeProperty16R1, 
 	},
 	UnicodeProperty17R0: {
 		symbols.NT_UnicodeProperty, 17, 0, 
 		symbols.Symbols{  
-			symbols.T_52,
+			symbols.T_55,
 		}, 
 		UnicodeProperty17R0, 
 	},
 	UnicodeProperty17R1: {
 		symbols.NT_UnicodeProperty, 17, 1, 
 		symbols.Symbols{  
-			symbols.T_52,
+			symbols.T_55,
 		}, 
 		UnicodeProperty17R1, 
 	},
 	UnicodeProperty18R0: {
 		symbols.NT_UnicodeProperty, 18, 0, 
 		symbols.Symbols{  
-			symbols.T_53,
+			symbols.T_56,
 		}, 
 		UnicodeProperty18R0, 
 	},
 	UnicodeProperty18R1: {
 		symbols.NT_UnicodeProperty, 18, 1, 
 		symbols.Symbols{  
-			symbols.T_53,
+			symbols.T_56,
 		}, 
 		UnicodeProperty18R1, 
 	},
 	UnicodeProperty19R0: {
 		symbols.NT_UnicodeProperty, 19, 0, 
 		symbols.Symbols{  
-			symbols.T_54,
+			symbols.T_57,
 		}, 
 		UnicodeProperty19R0, 
 	},
 	UnicodeProperty19R1: {
 		symbols.NT_UnicodeProperty, 19, 1, 
 		symbols.Symbols{  
-			symbols.T_54,
+			symbols.T_57,
 		}, 
 		UnicodeProperty19R1, 
 	},
 	UnicodeProperty20R0: {
 		symbols.NT_UnicodeProperty, 20, 0, 
 		symbols.Symbols{  
-			symbols.T_55,
+			symbols.T_58,
 		}, 
 		UnicodeProperty20R0, 
 	},
 	UnicodeProperty20R1: {
 		symbols.NT_UnicodeProperty, 20, 1, 
 		symbols.Symbols{  
-			symbols.T_55,
+			symbols.T_58,
 		}, 
 		UnicodeProperty20R1, 
 	},
 	UnicodeProperty21R0: {
 		symbols.NT_UnicodeProperty, 21, 0, 
 		symbols.Symbols{  
-			symbols.T_56,
+			symbols.T_59,
 		}, 
 		UnicodeProperty21R0, 
 	},
 	UnicodeProperty21R1: {
 		symbols.NT_UnicodeProperty, 21, 1, 
 		symbols.Symbols{  
-			symbols.T_56,
+			symbols.T_59,
 		}, 
 		UnicodeProperty21R1, 
 	},
 	UnicodeProperty22R0: {
 		symbols.NT_UnicodeProperty, 22, 0, 
 		symbols.Symbols{  
-			symbols.T_58,
+			symbols.T_61,
 		}, 
 		UnicodeProperty22R0, 
 	},
 	UnicodeProperty22R1: {
 		symbols.NT_UnicodeProperty, 22, 1, 
 		symbols.Symbols{  
-			symbols.T_58,
+			symbols.T_61,
 		}, 
 		UnicodeProperty22R1, 
 	},
 	UnicodeProperty23R0: {
 		symbols.NT_UnicodeProperty, 23, 0, 
 		symbols.Symbols{  
-			symbols.T_59,
+			symbols.T_62,
 		}, 
 		UnicodeProperty23R0, 
 	},
 	UnicodeProperty23R1: {
 		symbols.NT_UnicodeProperty, 23, 1, 
 		symbols.Symbols{  
-			symbols.T_59,
+			symbols.T_62,
 		}, 
 		UnicodeProperty23R1, 
 	},
 	UnicodeProperty24R0: {
 		symbols.NT_UnicodeProperty, 24, 0, 
 		symbols.Symbols{  
-			symbols.T_66,
+			symbols.T_69,
 		}, 
 		UnicodeProperty24R0, 
 	},
 	UnicodeProperty24R1: {
 		symbols.NT_UnicodeProperty, 24, 1, 
 		symbols.Symbols{  
-			symbols.T_66,
+			symbols.T_69,
 		}, 
 		UnicodeProperty24R1, 
 	},
 	UnicodeProperty25R0: {
 		symbols.NT_UnicodeProperty, 25, 0, 
 		symbols.Symbols{  
-			symbols.T_70,
+			symbols.T_73,
 		}, 
 		UnicodeProperty25R0, 
 	},
 	UnicodeProperty25R1: {
 		symbols.NT_UnicodeProperty, 25, 1, 
 		symbols.Symbols{  
-			symbols.T_70,
+			symbols.T_73,
 		}, 
 		UnicodeProperty25R1, 
 	},
 	UnicodeProperty26R0: {
 		symbols.NT_UnicodeProperty, 26, 0, 
 		symbols.Symbols{  
-			symbols.T_71,
+			symbols.T_74,
 		}, 
 		UnicodeProperty26R0, 
 	},
 	UnicodeProperty26R1: {
 		symbols.NT_UnicodeProperty, 26, 1, 
 		symbols.Symbols{  
-			symbols.T_71,
+			symbols.T_74,
 		}, 
 		UnicodeProperty26R1, 
 	},
 	UnicodeProperty27R0: {
 		symbols.NT_UnicodeProperty, 27, 0, 
 		symbols.Symbols{  
-			symbols.T_72,
+			symbols.T_75,
 		}, 
 		UnicodeProperty27R0, 
 	},
 	UnicodeProperty27R1: {
 		symbols.NT_UnicodeProperty, 27, 1, 
 		symbols.Symbols{  
-			symbols.T_72,
+			symbols.T_75,
 		}, 
 		UnicodeProperty27R1, 
 	},
 	UnicodeProperty28R0: {
 		symbols.NT_UnicodeProperty, 28, 0, 
 		symbols.Symbols{  
-			symbols.T_73,
+			symbols.T_76,
 		}, 
 		UnicodeProperty28R0, 
 	},
 	UnicodeProperty28R1: {
 		symbols.NT_UnicodeProperty, 28, 1, 
 		symbols.Symbols{  
-			symbols.T_73,
+			symbols.T_76,
 		}, 
 		UnicodeProperty28R1, 
 	},
 	UnicodeProperty29R0: {
 		symbols.NT_UnicodeProperty, 29, 0, 
 		symbols.Symbols{  
-			symbols.T_75,
+			symbols.T_78,
 		}, 
 		UnicodeProperty29R0, 
 	},
 	UnicodeProperty29R1: {
 		symbols.NT_UnicodeProperty, 29, 1, 
 		symbols.Symbols{  
-			symbols.T_75,
+			symbols.T_78,
 		}, 
 		UnicodeProperty29R1, 
 	},
 	UnicodeProperty30R0: {
 		symbols.NT_UnicodeProperty, 30, 0, 
 		symbols.Symbols{  
-			symbols.T_78,
+			symbols.T_81,
 		}, 
 		UnicodeProperty30R0, 
 	},
 	UnicodeProperty30R1: {
 		symbols.NT_UnicodeProperty, 30, 1, 
 		symbols.Symbols{  
-			symbols.T_78,
+			symbols.T_81,
 		}, 
 		UnicodeProperty30R1, 
 	},
 	UnicodeProperty31R0: {
 		symbols.NT_UnicodeProperty, 31, 0, 
 		symbols.Symbols{  
-			symbols.T_83,
+			symbols.T_86,
 		}, 
 		UnicodeProperty31R0, 
 	},
 	UnicodeProperty31R1: {
 		symbols.NT_UnicodeProperty, 31, 1, 
 		symbols.Symbols{  
-			symbols.T_83,
+			symbols.T_86,
 		}, 
 		UnicodeProperty31R1, 
 	},
 	UnicodeProperty32R0: {
 		symbols.NT_UnicodeProperty, 32, 0, 
 		symbols.Symbols{  
-			symbols.T_85,
+			symbols.T_88,
 		}, 
 		UnicodeProperty32R0, 
 	},
 	UnicodeProperty32R1: {
 		symbols.NT_UnicodeProperty, 32, 1, 
 		symbols.Symbols{  
-			symbols.T_85,
+			symbols.T_88,
 		}, 
 		UnicodeProperty32R1, 
 	},
 	UnicodeProperty33R0: {
 		symbols.NT_UnicodeProperty, 33, 0, 
 		symbols.Symbols{  
-			symbols.T_87,
+			symbols.T_90,
 		}, 
 		UnicodeProperty33R0, 
 	},
 	UnicodeProperty33R1: {
 		symbols.NT_UnicodeProperty, 33, 1, 
 		symbols.Symbols{  
-			symbols.T_87,
+			symbols.T_90,
 		}, 
 		UnicodeProperty33R1, 
 	},
 	UnicodeProperty34R0: {
 		symbols.NT_UnicodeProperty, 34, 0, 
 		symbols.Symbols{  
-			symbols.T_88,
+			symbols.T_91,
 		}, 
 		UnicodeProperty34R0, 
 	},
 	UnicodeProperty34R1: {
 		symbols.NT_UnicodeProperty, 34, 1, 
 		symbols.Symbols{  
-			symbols.T_88,
+			symbols.T_91,
 		}, 
 		UnicodeProperty34R1, 
 	},
 	UnicodeSet0R0: {
 		symbols.NT_UnicodeSet, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_1, 
+			symbols.T_2, 
 			symbols.NT_UnicodeSetSpec, 
 			symbols.NT_UnicodeSetSpecs, 
-			symbols.T_94,
+			symbols.T_97,
 		}, 
 		UnicodeSet0R0, 
 	},
 	UnicodeSet0R1: {
 		symbols.NT_UnicodeSet, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_1, 
+			symbols.T_2, 
 			symbols.NT_UnicodeSetSpec, 
 			symbols.NT_UnicodeSetSpecs, 
-			symbols.T_94,
+			symbols.T_97,
 		}, 
 		UnicodeSet0R1, 
 	},
 	UnicodeSet0R2: {
 		symbols.NT_UnicodeSet, 0, 2, 
 		symbols.Symbols{  
-			symbols.T_1, 
+			symbols.T_2, 
 			symbols.NT_UnicodeSetSpec, 
 			symbols.NT_UnicodeSetSpecs, 
-			symbols.T_94,
+			symbols.T_97,
 		}, 
 		UnicodeSet0R2, 
 	},
 	UnicodeSet0R3: {
 		symbols.NT_UnicodeSet, 0, 3, 
 		symbols.Symbols{  
-			symbols.T_1, 
+			symbols.T_2, 
 			symbols.NT_UnicodeSetSpec, 
 			symbols.NT_UnicodeSetSpecs, 
-			symbols.T_94,
+			symbols.T_97,
 		}, 
 		UnicodeSet0R3, 
 	},
 	UnicodeSet0R4: {
 		symbols.NT_UnicodeSet, 0, 4, 
 		symbols.Symbols{  
-			symbols.T_1, 
+			symbols.T_2, 
 			symbols.NT_UnicodeSetSpec, 
 			symbols.NT_UnicodeSetSpecs, 
-			symbols.T_94,
+			symbols.T_97,
 		}, 
 		UnicodeSet0R4, 
 	},
+	UnicodeSet1R0: {
+		symbols.NT_UnicodeSet, 1, 0, 
+		symbols.Symbols{  
+			symbols.T_113, 
+			symbols.T_6, 
+			symbols.T_101, 
+			symbols.NT_UnicodeSetSpecs, 
+			symbols.T_97,
+		}, 
+		UnicodeSet1R0, 
+	},
+	UnicodeSet1R1: {
+		symbols.NT_UnicodeSet, 1, 1, 
+		symbols.Symbols{  
+			symbols.T_113, 
+			symbols.T_6, 
+			symbols.T_101, 
+			symbols.NT_UnicodeSetSpecs, 
+			symbols.T_97,
+		}, 
+		UnicodeSet1R1, 
+	},
+	UnicodeSet1R2: {
+		symbols.NT_UnicodeSet, 1, 2, 
+		symbols.Symbols{  
+			symbols.T_113, 
+			symbols.T_6, 
+			symbols.T_101, 
+			symbols.NT_UnicodeSetSpecs, 
+			symbols.T_97,
+		}, 
+		UnicodeSet1R2, 
+	},
+	UnicodeSet1R3: {
+		symbols.NT_UnicodeSet, 1, 3, 
+		symbols.Symbols{  
+			symbols.T_113, 
+			symbols.T_6, 
+			symbols.T_101, 
+			symbols.NT_UnicodeSetSpecs, 
+			symbols.T_97,
+		}, 
+		UnicodeSet1R3, 
+	},
+	UnicodeSet1R4: {
+		symbols.NT_UnicodeSet, 1, 4, 
+		symbols.Symbols{  
+			symbols.T_113, 
+			symbols.T_6, 
+			symbols.T_101, 
+			symbols.NT_UnicodeSetSpecs, 
+			symbols.T_97,
+		}, 
+		UnicodeSet1R4, 
+	},
+	UnicodeSet1R5: {
+		symbols.NT_UnicodeSet, 1, 5, 
+		symbols.Symbols{  
+			symbols.T_113, 
+			symbols.T_6, 
+			symbols.T_101, 
+			symbols.NT_UnicodeSetSpecs, 
+			symbols.T_97,
+		}, 
+		UnicodeSet1R5, 
+	},
 	UnicodeSetSpec0R0: {
 		symbols.NT_UnicodeSetSpec, 0, 0, 
 		symbols.Symbols{  
@@ -2605,6 +3854,20 @@ var slots = map[Label]*Slot{
 		}, 
 		UnicodeSetSpec1R1, 
 	},
+	UnicodeSetSpec2R0: {
+		symbols.NT_UnicodeSetSpec, 2, 0, 
+		symbols.Symbols{  
+			symbols.NT_CharRange,
+		}, 
+		UnicodeSetSpec2R0, 
+	},
+	UnicodeSetSpec2R1: {
+		symbols.NT_UnicodeSetSpec, 2, 1, 
+		symbols.Symbols{  
+			symbols.NT_CharRange,
+		}, 
+		UnicodeSetSpec2R1, 
+	},
 	UnicodeSetSpecs0R0: {
 		symbols.NT_UnicodeSetSpecs, 0, 0, 
 		symbols.Symbols{ 
@@ -2666,9 +3929,37 @@ var slots = map[Label]*Slot{
 }
 
 var slotIndex = map[Index]Label { 
+	Index{ symbols.NT_CharRange,0,0 }: CharRange0R0,
+	Index{ symbols.NT_CharRange,0,1 }: CharRange0R1,
+	Index{ symbols.NT_CharRange,0,2 }: CharRange0R2,
+	Index{ symbols.NT_CharRange,0,3 }: CharRange0R3,
 	Index{ symbols.NT_GoGLL,0,0 }: GoGLL0R0,
 	Index{ symbols.NT_GoGLL,0,1 }: GoGLL0R1,
 	Index{ symbols.NT_GoGLL,0,2 }: GoGLL0R2,
+	Index{ symbols.NT_GoGLL,1,0 }: GoGLL1R0,
+	Index{ symbols.NT_GoGLL,1,1 }: GoGLL1R1,
+	Index{ symbols.NT_Import,0,0 }: Import0R0,
+	Index{ symbols.NT_Import,0,1 }: Import0R1,
+	Index{ symbols.NT_Import,0,2 }: Import0R2,
+	Index{ symbols.NT_Import,0,3 }: Import0R3,
+	Index{ symbols.NT_Import,1,0 }: Import1R0,
+	Index{ symbols.NT_Import,1,1 }: Import1R1,
+	Index{ symbols.NT_Import,1,2 }: Import1R2,
+	Index{ symbols.NT_Import,1,3 }: Import1R3,
+	Index{ symbols.NT_Import,1,4 }: Import1R4,
+	Index{ symbols.NT_Import,1,5 }: Import1R5,
+	Index{ symbols.NT_Import,2,0 }: Import2R0,
+	Index{ symbols.NT_Import,2,1 }: Import2R1,
+	Index{ symbols.NT_Import,2,2 }: Import2R2,
+	Index{ symbols.NT_Import,2,3 }: Import2R3,
+	Index{ symbols.NT_Import,2,4 }: Import2R4,
+	Index{ symbols.NT_Import,2,5 }: Import2R5,
+	Index{ symbols.NT_LabelledSymbol,0,0 }: LabelledSymbol0R0,
+	Index{ symbols.NT_LabelledSymbol,0,1 }: LabelledSymbol0R1,
+	Index{ symbols.NT_LabelledSymbol,1,0 }: LabelledSymbol1R0,
+	Index{ symbols.NT_LabelledSymbol,1,1 }: LabelledSymbol1R1,
+	Index{ symbols.NT_LabelledSymbol,1,2 }: LabelledSymbol1R2,
+	Index{ symbols.NT_LabelledSymbol,1,3 }: LabelledSymbol1R3,
 	Index{ symbols.NT_LexAlternates,0,0 }: LexAlternates0R0,
 	Index{ symbols.NT_LexAlternates,0,1 }: LexAlternates0R1,
 	Index{ symbols.NT_LexAlternates,1,0 }: LexAlternates1R0,
@@ -2706,6 +3997,12 @@ var slotIndex = map[Index]Label {
 	Index{ symbols.NT_LexRule,1,3 }: LexRule1R3,
 	Index{ symbols.NT_LexRule,1,4 }: LexRule1R4,
 	Index{ symbols.NT_LexRule,1,5 }: LexRule1R5,
+	Index{ symbols.NT_LexRule,2,0 }: LexRule2R0,
+	Index{ symbols.NT_LexRule,2,1 }: LexRule2R1,
+	Index{ symbols.NT_LexRule,2,2 }: LexRule2R2,
+	Index{ symbols.NT_LexRule,2,3 }: LexRule2R3,
+	Index{ symbols.NT_LexRule,2,4 }: LexRule2R4,
+	Index{ symbols.NT_LexRule,2,5 }: LexRule2R5,
 	Index{ symbols.NT_LexSymbol,0,0 }: LexSymbol0R0,
 	Index{ symbols.NT_LexSymbol,0,1 }: LexSymbol0R1,
 	Index{ symbols.NT_LexSymbol,1,0 }: LexSymbol1R0,
@@ -2722,6 +4019,8 @@ var slotIndex = map[Index]Label {
 	Index{ symbols.NT_LexSymbol,5,1 }: LexSymbol5R1,
 	Index{ symbols.NT_LexSymbol,6,0 }: LexSymbol6R0,
 	Index{ symbols.NT_LexSymbol,6,1 }: LexSymbol6R1,
+	Index{ symbols.NT_LexSymbol,7,0 }: LexSymbol7R0,
+	Index{ symbols.NT_LexSymbol,7,1 }: LexSymbol7R1,
 	Index{ symbols.NT_LexZeroOrMore,0,0 }: LexZeroOrMore0R0,
 	Index{ symbols.NT_LexZeroOrMore,0,1 }: LexZeroOrMore0R1,
 	Index{ symbols.NT_LexZeroOrMore,0,2 }: LexZeroOrMore0R2,
@@ -2729,6 +4028,10 @@ var slotIndex = map[Index]Label {
 	Index{ symbols.NT_Package,0,0 }: Package0R0,
 	Index{ symbols.NT_Package,0,1 }: Package0R1,
 	Index{ symbols.NT_Package,0,2 }: Package0R2,
+	Index{ symbols.NT_Package,1,0 }: Package1R0,
+	Index{ symbols.NT_Package,1,1 }: Package1R1,
+	Index{ symbols.NT_Package,1,2 }: Package1R2,
+	Index{ symbols.NT_Package,1,3 }: Package1R3,
 	Index{ symbols.NT_PlusOrMinUnicodeSet,0,0 }: PlusOrMinUnicodeSet0R0,
 	Index{ symbols.NT_PlusOrMinUnicodeSet,0,1 }: PlusOrMinUnicodeSet0R1,
 	Index{ symbols.NT_PlusOrMinUnicodeSet,1,0 }: PlusOrMinUnicodeSet1R0,
@@ -2744,19 +4047,55 @@ var slotIndex = map[Index]Label {
 	Index{ symbols.NT_RegExp,3,0 }: RegExp3R0,
 	Index{ symbols.NT_RegExp,3,1 }: RegExp3R1,
 	Index{ symbols.NT_RegExp,3,2 }: RegExp3R2,
+	Index{ symbols.NT_Rename,0,0 }: Rename0R0,
+	Index{ symbols.NT_Rename,0,1 }: Rename0R1,
+	Index{ symbols.NT_Rename,0,2 }: Rename0R2,
+	Index{ symbols.NT_Rename,0,3 }: Rename0R3,
+	Index{ symbols.NT_Rename,1,0 }: Rename1R0,
+	Index{ symbols.NT_Rename,1,1 }: Rename1R1,
+	Index{ symbols.NT_Rename,1,2 }: Rename1R2,
+	Index{ symbols.NT_Rename,1,3 }: Rename1R3,
+	Index{ symbols.NT_Renames,0,0 }: Renames0R0,
+	Index{ symbols.NT_Renames,0,1 }: Renames0R1,
+	Index{ symbols.NT_Renames,1,0 }: Renames1R0,
+	Index{ symbols.NT_Renames,1,1 }: Renames1R1,
+	Index{ symbols.NT_Renames,1,2 }: Renames1R2,
+	Index{ symbols.NT_Renames,1,3 }: Renames1R3,
 	Index{ symbols.NT_Rule,0,0 }: Rule0R0,
 	Index{ symbols.NT_Rule,0,1 }: Rule0R1,
 	Index{ symbols.NT_Rule,1,0 }: Rule1R0,
 	Index{ symbols.NT_Rule,1,1 }: Rule1R1,
+	Index{ symbols.NT_Rule,2,0 }: Rule2R0,
+	Index{ symbols.NT_Rule,2,1 }: Rule2R1,
+	Index{ symbols.NT_Rule,3,0 }: Rule3R0,
+	Index{ symbols.NT_Rule,3,1 }: Rule3R1,
 	Index{ symbols.NT_Rules,0,0 }: Rules0R0,
 	Index{ symbols.NT_Rules,0,1 }: Rules0R1,
 	Index{ symbols.NT_Rules,1,0 }: Rules1R0,
 	Index{ symbols.NT_Rules,1,1 }: Rules1R1,
 	Index{ symbols.NT_Rules,1,2 }: Rules1R2,
+	Index{ symbols.NT_Start,0,0 }: Start0R0,
+	Index{ symbols.NT_Start,0,1 }: Start0R1,
+	Index{ symbols.NT_Start,0,2 }: Start0R2,
+	Index{ symbols.NT_Start,0,3 }: Start0R3,
+	Index{ symbols.NT_StartSymbols,0,0 }: StartSymbols0R0,
+	Index{ symbols.NT_StartSymbols,0,1 }: StartSymbols0R1,
+	Index{ symbols.NT_StartSymbols,1,0 }: StartSymbols1R0,
+	Index{ symbols.NT_StartSymbols,1,1 }: StartSymbols1R1,
+	Index{ symbols.NT_StartSymbols,1,2 }: StartSymbols1R2,
+	Index{ symbols.NT_StartSymbols,1,3 }: StartSymbols1R3,
 	Index{ symbols.NT_SyntaxAlternate,0,0 }: SyntaxAlternate0R0,
 	Index{ symbols.NT_SyntaxAlternate,0,1 }: SyntaxAlternate0R1,
 	Index{ symbols.NT_SyntaxAlternate,1,0 }: SyntaxAlternate1R0,
 	Index{ symbols.NT_SyntaxAlternate,1,1 }: SyntaxAlternate1R1,
+	Index{ symbols.NT_SyntaxAlternate,2,0 }: SyntaxAlternate2R0,
+	Index{ symbols.NT_SyntaxAlternate,2,1 }: SyntaxAlternate2R1,
+	Index{ symbols.NT_SyntaxAlternate,2,2 }: SyntaxAlternate2R2,
+	Index{ symbols.NT_SyntaxAlternate,2,3 }: SyntaxAlternate2R3,
+	Index{ symbols.NT_SyntaxAlternate,3,0 }: SyntaxAlternate3R0,
+	Index{ symbols.NT_SyntaxAlternate,3,1 }: SyntaxAlternate3R1,
+	Index{ symbols.NT_SyntaxAlternate,3,2 }: SyntaxAlternate3R2,
+	Index{ symbols.NT_SyntaxAlternate,3,3 }: SyntaxAlternate3R3,
 	Index{ symbols.NT_SyntaxAlternates,0,0 }: SyntaxAlternates0R0,
 	Index{ symbols.NT_SyntaxAlternates,0,1 }: SyntaxAlternates0R1,
 	Index{ symbols.NT_SyntaxAlternates,1,0 }: SyntaxAlternates1R0,
@@ -2768,17 +4107,58 @@ var slotIndex = map[Index]Label {
 	Index{ symbols.NT_SyntaxRule,0,2 }: SyntaxRule0R2,
 	Index{ symbols.NT_SyntaxRule,0,3 }: SyntaxRule0R3,
 	Index{ symbols.NT_SyntaxRule,0,4 }: SyntaxRule0R4,
+	Index{ symbols.NT_SyntaxRule,1,0 }: SyntaxRule1R0,
+	Index{ symbols.NT_SyntaxRule,1,1 }: SyntaxRule1R1,
+	Index{ symbols.NT_SyntaxRule,1,2 }: SyntaxRule1R2,
+	Index{ symbols.NT_SyntaxRule,1,3 }: SyntaxRule1R3,
+	Index{ symbols.NT_SyntaxRule,1,4 }: SyntaxRule1R4,
+	Index{ symbols.NT_SyntaxRule,1,5 }: SyntaxRule1R5,
+	Index{ symbols.NT_SyntaxRule,1,6 }: SyntaxRule1R6,
+	Index{ symbols.NT_SyntaxRule,1,7 }: SyntaxRule1R7,
 	Index{ symbols.NT_SyntaxSymbol,0,0 }: SyntaxSymbol0R0,
 	Index{ symbols.NT_SyntaxSymbol,0,1 }: SyntaxSymbol0R1,
 	Index{ symbols.NT_SyntaxSymbol,1,0 }: SyntaxSymbol1R0,
 	Index{ symbols.NT_SyntaxSymbol,1,1 }: SyntaxSymbol1R1,
 	Index{ symbols.NT_SyntaxSymbol,2,0 }: SyntaxSymbol2R0,
 	Index{ symbols.NT_SyntaxSymbol,2,1 }: SyntaxSymbol2R1,
+	Index{ symbols.NT_SyntaxSymbol,3,0 }: SyntaxSymbol3R0,
+	Index{ symbols.NT_SyntaxSymbol,3,1 }: SyntaxSymbol3R1,
+	Index{ symbols.NT_SyntaxSymbol,4,0 }: SyntaxSymbol4R0,
+	Index{ symbols.NT_SyntaxSymbol,4,1 }: SyntaxSymbol4R1,
+	Index{ symbols.NT_SyntaxSymbol,4,2 }: SyntaxSymbol4R2,
+	Index{ symbols.NT_SyntaxSymbol,4,3 }: SyntaxSymbol4R3,
+	Index{ symbols.NT_SyntaxSymbol,4,4 }: SyntaxSymbol4R4,
 	Index{ symbols.NT_SyntaxSymbols,0,0 }: SyntaxSymbols0R0,
 	Index{ symbols.NT_SyntaxSymbols,0,1 }: SyntaxSymbols0R1,
 	Index{ symbols.NT_SyntaxSymbols,1,0 }: SyntaxSymbols1R0,
 	Index{ symbols.NT_SyntaxSymbols,1,1 }: SyntaxSymbols1R1,
 	Index{ symbols.NT_SyntaxSymbols,1,2 }: SyntaxSymbols1R2,
+	Index{ symbols.NT_TemplateArgs,0,0 }: TemplateArgs0R0,
+	Index{ symbols.NT_TemplateArgs,0,1 }: TemplateArgs0R1,
+	Index{ symbols.NT_TemplateArgs,1,0 }: TemplateArgs1R0,
+	Index{ symbols.NT_TemplateArgs,1,1 }: TemplateArgs1R1,
+	Index{ symbols.NT_TemplateArgs,1,2 }: TemplateArgs1R2,
+	Index{ symbols.NT_TemplateArgs,1,3 }: TemplateArgs1R3,
+	Index{ symbols.NT_TemplateParams,0,0 }: TemplateParams0R0,
+	Index{ symbols.NT_TemplateParams,0,1 }: TemplateParams0R1,
+	Index{ symbols.NT_TemplateParams,1,0 }: TemplateParams1R0,
+	Index{ symbols.NT_TemplateParams,1,1 }: TemplateParams1R1,
+	Index{ symbols.NT_TemplateParams,1,2 }: TemplateParams1R2,
+	Index{ symbols.NT_TemplateParams,1,3 }: TemplateParams1R3,
+	Index{ symbols.NT_TokID,0,0 }: TokID0R0,
+	Index{ symbols.NT_TokID,0,1 }: TokID0R1,
+	Index{ symbols.NT_TokID,1,0 }: TokID1R0,
+	Index{ symbols.NT_TokID,1,1 }: TokID1R1,
+	Index{ symbols.NT_TokID,2,0 }: TokID2R0,
+	Index{ symbols.NT_TokID,2,1 }: TokID2R1,
+	Index{ symbols.NT_TokID,3,0 }: TokID3R0,
+	Index{ symbols.NT_TokID,3,1 }: TokID3R1,
+	Index{ symbols.NT_TokID,4,0 }: TokID4R0,
+	Index{ symbols.NT_TokID,4,1 }: TokID4R1,
+	Index{ symbols.NT_TokID,5,0 }: TokID5R0,
+	Index{ symbols.NT_TokID,5,1 }: TokID5R1,
+	Index{ symbols.NT_TokID,6,0 }: TokID6R0,
+	Index{ symbols.NT_TokID,6,1 }: TokID6R1,
 	Index{ symbols.NT_UnicodeCategory,0,0 }: UnicodeCategory0R0,
 	Index{ symbols.NT_UnicodeCategory,0,1 }: UnicodeCategory0R1,
 	Index{ symbols.NT_UnicodeCategory,1,0 }: UnicodeCategory1R0,
@@ -2956,10 +4336,18 @@ var slotIndex = map[Index]Label {
 	Index{ symbols.NT_UnicodeSet,0,2 }: UnicodeSet0R2,
 	Index{ symbols.NT_UnicodeSet,0,3 }: UnicodeSet0R3,
 	Index{ symbols.NT_UnicodeSet,0,4 }: UnicodeSet0R4,
+	Index{ symbols.NT_UnicodeSet,1,0 }: UnicodeSet1R0,
+	Index{ symbols.NT_UnicodeSet,1,1 }: UnicodeSet1R1,
+	Index{ symbols.NT_UnicodeSet,1,2 }: UnicodeSet1R2,
+	Index{ symbols.NT_UnicodeSet,1,3 }: UnicodeSet1R3,
+	Index{ symbols.NT_UnicodeSet,1,4 }: UnicodeSet1R4,
+	Index{ symbols.NT_UnicodeSet,1,5 }: UnicodeSet1R5,
 	Index{ symbols.NT_UnicodeSetSpec,0,0 }: UnicodeSetSpec0R0,
 	Index{ symbols.NT_UnicodeSetSpec,0,1 }: UnicodeSetSpec0R1,
 	Index{ symbols.NT_UnicodeSetSpec,1,0 }: UnicodeSetSpec1R0,
 	Index{ symbols.NT_UnicodeSetSpec,1,1 }: UnicodeSetSpec1R1,
+	Index{ symbols.NT_UnicodeSetSpec,2,0 }: UnicodeSetSpec2R0,
+	Index{ symbols.NT_UnicodeSetSpec,2,1 }: UnicodeSetSpec2R1,
 	Index{ symbols.NT_UnicodeSetSpecs,0,0 }: UnicodeSetSpecs0R0,
 	Index{ symbols.NT_UnicodeSetSpecs,1,0 }: UnicodeSetSpecs1R0,
 	Index{ symbols.NT_UnicodeSetSpecs,1,1 }: UnicodeSetSpecs1R1,
@@ -2971,14 +4359,20 @@ var slotIndex = map[Index]Label {
 }
 
 var alternates = map[symbols.NT][]Label{ 
-	symbols.NT_GoGLL:[]Label{ GoGLL0R0 },
-	symbols.NT_Package:[]Label{ Package0R0 },
+	symbols.NT_GoGLL:[]Label{ GoGLL0R0,GoGLL1R0 },
+	symbols.NT_Package:[]Label{ Package0R0,Package1R0 },
 	symbols.NT_Rules:[]Label{ Rules0R0,Rules1R0 },
-	symbols.NT_Rule:[]Label{ Rule0R0,Rule1R0 },
-	symbols.NT_LexSymbol:[]Label{ LexSymbol0R0,LexSymbol1R0,LexSymbol2R0,LexSymbol3R0,LexSymbol4R0,LexSymbol5R0,LexSymbol6R0 },
+	symbols.NT_Rule:[]Label{ Rule0R0,Rule1R0,Rule2R0,Rule3R0 },
+	symbols.NT_Start:[]Label{ Start0R0 },
+	symbols.NT_StartSymbols:[]Label{ StartSymbols0R0,StartSymbols1R0 },
+	symbols.NT_Import:[]Label{ Import0R0,Import1R0,Import2R0 },
+	symbols.NT_Renames:[]Label{ Renames0R0,Renames1R0 },
+	symbols.NT_Rename:[]Label{ Rename0R0,Rename1R0 },
+	symbols.NT_LexSymbol:[]Label{ LexSymbol0R0,LexSymbol1R0,LexSymbol2R0,LexSymbol3R0,LexSymbol4R0,LexSymbol5R0,LexSymbol6R0,LexSymbol7R0 },
 	symbols.NT_UnicodeClass:[]Label{ UnicodeClass0R0,UnicodeClass1R0,UnicodeClass2R0,UnicodeClass3R0 },
-	symbols.NT_UnicodeSet:[]Label{ UnicodeSet0R0 },
-	symbols.NT_UnicodeSetSpec:[]Label{ UnicodeSetSpec0R0,UnicodeSetSpec1R0 },
+	symbols.NT_UnicodeSet:[]Label{ UnicodeSet0R0,UnicodeSet1R0 },
+	symbols.NT_CharRange:[]Label{ CharRange0R0 },
+	symbols.NT_UnicodeSetSpec:[]Label{ UnicodeSetSpec0R0,UnicodeSetSpec1R0,UnicodeSetSpec2R0 },
 	symbols.NT_UnicodeSetSpecs:[]Label{ UnicodeSetSpecs0R0,UnicodeSetSpecs1R0 },
 	symbols.NT_UnicodeSpecList:[]Label{ UnicodeSpecList0R0,UnicodeSpecList1R0 },
 	symbols.NT_PlusOrMinUnicodeSet:[]Label{ PlusOrMinUnicodeSet0R0,PlusOrMinUnicodeSet1R0 },
@@ -2991,11 +4385,15 @@ var alternates = map[symbols.NT][]Label{
 	symbols.NT_LexOneOrMore:[]Label{ LexOneOrMore0R0 },
 	symbols.NT_LexAlternates:[]Label{ LexAlternates0R0,LexAlternates1R0 },
 	symbols.NT_RegExp:[]Label{ RegExp0R0,RegExp1R0,RegExp2R0,RegExp3R0 },
-	symbols.NT_LexRule:[]Label{ LexRule0R0,LexRule1R0 },
-	symbols.NT_SyntaxRule:[]Label{ SyntaxRule0R0 },
+	symbols.NT_LexRule:[]Label{ LexRule0R0,LexRule1R0,LexRule2R0 },
+	symbols.NT_TokID:[]Label{ TokID0R0,TokID1R0,TokID2R0,TokID3R0,TokID4R0,TokID5R0,TokID6R0 },
+	symbols.NT_SyntaxRule:[]Label{ SyntaxRule0R0,SyntaxRule1R0 },
+	symbols.NT_TemplateParams:[]Label{ TemplateParams0R0,TemplateParams1R0 },
 	symbols.NT_SyntaxAlternates:[]Label{ SyntaxAlternates0R0,SyntaxAlternates1R0 },
-	symbols.NT_SyntaxAlternate:[]Label{ SyntaxAlternate0R0,SyntaxAlternate1R0 },
+	symbols.NT_SyntaxAlternate:[]Label{ SyntaxAlternate0R0,SyntaxAlternate1R0,SyntaxAlternate2R0,SyntaxAlternate3R0 },
 	symbols.NT_SyntaxSymbols:[]Label{ SyntaxSymbols0R0,SyntaxSymbols1R0 },
-	symbols.NT_SyntaxSymbol:[]Label{ SyntaxSymbol0R0,SyntaxSymbol1R0,SyntaxSymbol2R0 },
+	symbols.NT_LabelledSymbol:[]Label{ LabelledSymbol0R0,LabelledSymbol1R0 },
+	symbols.NT_SyntaxSymbol:[]Label{ SyntaxSymbol0R0,SyntaxSymbol1R0,SyntaxSymbol2R0,SyntaxSymbol3R0,SyntaxSymbol4R0 },
+	symbols.NT_TemplateArgs:[]Label{ TemplateArgs0R0,TemplateArgs1R0 },
 }
 
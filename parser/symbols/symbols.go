@@ -19,7 +19,10 @@ func (T) isSymbol() {}
 // NT is the type of non-terminals symbols
 type NT int
 const( 
-	NT_GoGLL NT = iota
+	NT_CharRange NT = iota
+	NT_GoGLL 
+	NT_Import 
+	NT_LabelledSymbol 
 	NT_LexAlternates 
 	NT_LexBracket 
 	NT_LexGroup 
@@ -31,13 +34,20 @@ const(
 	NT_Package 
 	NT_PlusOrMinUnicodeSet 
 	NT_RegExp 
+	NT_Rename 
+	NT_Renames 
 	NT_Rule 
 	NT_Rules 
+	NT_Start 
+	NT_StartSymbols 
 	NT_SyntaxAlternate 
 	NT_SyntaxAlternates 
 	NT_SyntaxRule 
 	NT_SyntaxSymbol 
 	NT_SyntaxSymbols 
+	NT_TemplateArgs 
+	NT_TemplateParams 
+	NT_TokID 
 	NT_UnicodeCategory 
 	NT_UnicodeClass 
 	NT_UnicodeProperty 
@@ -51,115 +61,126 @@ const(
 type T int
 const( 
 	T_0 T = iota // ! 
-	T_1  // '[ 
-	T_2  // ( 
-	T_3  // ) 
-	T_4  // - 
-	T_5  // . 
-	T_6  // : 
-	T_7  // ; 
-	T_8  // < 
-	T_9  // > 
-	T_10  // [ 
-	T_11  // \\p{ASCII_Hex_Digit} 
-	T_12  // \\p{Bidi_Control} 
-	T_13  // \\p{Cc} 
-	T_14  // \\p{Cf} 
-	T_15  // \\p{Co} 
-	T_16  // \\p{Cs} 
-	T_17  // \\p{C} 
-	T_18  // \\p{Dash} 
-	T_19  // \\p{Deprecated} 
-	T_20  // \\p{Diacritic} 
-	T_21  // \\p{Digit} 
-	T_22  // \\p{Extender} 
-	T_23  // \\p{Hex_Digit} 
-	T_24  // \\p{Hyphen} 
-	T_25  // \\p{IDS_Binary_Operator} 
-	T_26  // \\p{IDS_Trinary_Operator} 
-	T_27  // \\p{Ideographic} 
-	T_28  // \\p{Join_Control} 
-	T_29  // \\p{Letter} 
-	T_30  // \\p{Ll} 
-	T_31  // \\p{Lm} 
-	T_32  // \\p{Logical_Order_Exception} 
-	T_33  // \\p{Lower} 
-	T_34  // \\p{Lo} 
-	T_35  // \\p{Lt} 
-	T_36  // \\p{Lu} 
-	T_37  // \\p{L} 
-	T_38  // \\p{Mark} 
-	T_39  // \\p{Mc} 
-	T_40  // \\p{Me} 
-	T_41  // \\p{Mn} 
-	T_42  // \\p{M} 
-	T_43  // \\p{Nd} 
-	T_44  // \\p{Nl} 
-	T_45  // \\p{Noncharacter_Code_Point} 
-	T_46  // \\p{No} 
-	T_47  // \\p{Number} 
-	T_48  // \\p{N} 
-	T_49  // \\p{Other_Alphabetic} 
-	T_50  // \\p{Other_Default_Ignorable_Code_Point} 
-	T_51  // \\p{Other_Grapheme_Extend} 
-	T_52  // \\p{Other_ID_Continue} 
-	T_53  // \\p{Other_ID_Start} 
-	T_54  // \\p{Other_Lowercase} 
-	T_55  // \\p{Other_Math} 
-	T_56  // \\p{Other_Uppercase} 
-	T_57  // \\p{Other} 
-	T_58  // \\p{Pattern_Syntax} 
-	T_59  // \\p{Pattern_White_Space} 
-	T_60  // \\p{Pc} 
-	T_61  // \\p{Pd} 
-	T_62  // \\p{Pe} 
-	T_63  // \\p{Pf} 
-	T_64  // \\p{Pi} 
-	T_65  // \\p{Po} 
-	T_66  // \\p{Prepended_Concatenation_Mark} 
-	T_67  // \\p{Ps} 
-	T_68  // \\p{Punct} 
-	T_69  // \\p{P} 
-	T_70  // \\p{Quotation_Mark} 
-	T_71  // \\p{Radical} 
-	T_72  // \\p{Regional_Indicator} 
-	T_73  // \\p{STerm} 
-	T_74  // \\p{Sc} 
-	T_75  // \\p{Sentence_Terminal} 
-	T_76  // \\p{Sk} 
-	T_77  // \\p{Sm} 
-	T_78  // \\p{Soft_Dotted} 
-	T_79  // \\p{So} 
-	T_80  // \\p{Space} 
-	T_81  // \\p{Symbol} 
-	T_82  // \\p{S} 
-	T_83  // \\p{Terminal_Punctuation} 
-	T_84  // \\p{Title} 
-	T_85  // \\p{Unified_Ideograph} 
-	T_86  // \\p{Upper} 
-	T_87  // \\p{Variation_Selector} 
-	T_88  // \\p{White_Space} 
-	T_89  // \\p{Zl} 
-	T_90  // \\p{Zp} 
-	T_91  // \\p{Zs} 
-	T_92  // \\p{Z} 
-	T_93  // ] 
-	T_94  // ]' 
-	T_95  // any 
-	T_96  // char_lit 
-	T_97  // empty 
-	T_98  // letter 
-	T_99  // lowcase 
-	T_100  // not 
-	T_101  // nt 
-	T_102  // number 
-	T_103  // package 
-	T_104  // string_lit 
-	T_105  // tokid 
-	T_106  // upcase 
-	T_107  // { 
-	T_108  // | 
-	T_109  // } 
+	T_1  // # 
+	T_2  // '[ 
+	T_3  // ( 
+	T_4  // ) 
+	T_5  // , 
+	T_6  // - 
+	T_7  // . 
+	T_8  // : 
+	T_9  // ; 
+	T_10  // < 
+	T_11  // > 
+	T_12  // @ 
+	T_13  // [ 
+	T_14  // \\p{ASCII_Hex_Digit} 
+	T_15  // \\p{Bidi_Control} 
+	T_16  // \\p{Cc} 
+	T_17  // \\p{Cf} 
+	T_18  // \\p{Co} 
+	T_19  // \\p{Cs} 
+	T_20  // \\p{C} 
+	T_21  // \\p{Dash} 
+	T_22  // \\p{Deprecated} 
+	T_23  // \\p{Diacritic} 
+	T_24  // \\p{Digit} 
+	T_25  // \\p{Extender} 
+	T_26  // \\p{Hex_Digit} 
+	T_27  // \\p{Hyphen} 
+	T_28  // \\p{IDS_Binary_Operator} 
+	T_29  // \\p{IDS_Trinary_Operator} 
+	T_30  // \\p{Ideographic} 
+	T_31  // \\p{Join_Control} 
+	T_32  // \\p{Letter} 
+	T_33  // \\p{Ll} 
+	T_34  // \\p{Lm} 
+	T_35  // \\p{Logical_Order_Exception} 
+	T_36  // \\p{Lower} 
+	T_37  // \\p{Lo} 
+	T_38  // \\p{Lt} 
+	T_39  // \\p{Lu} 
+	T_40  // \\p{L} 
+	T_41  // \\p{Mark} 
+	T_42  // \\p{Mc} 
+	T_43  // \\p{Me} 
+	T_44  // \\p{Mn} 
+	T_45  // \\p{M} 
+	T_46  // \\p{Nd} 
+	T_47  // \\p{Nl} 
+	T_48  // \\p{Noncharacter_Code_Point} 
+	T_49  // \\p{No} 
+	T_50  // \\p{Number} 
+	T_51  // \\p{N} 
+	T_52  // \\p{Other_Alphabetic} 
+	T_53  // \\p{Other_Default_Ignorable_Code_Point} 
+	T_54  // \\p{Other_Grapheme_Extend} 
+	T_55  // \\p{Other_ID_Continue} 
+	T_56  // \\p{Other_ID_Start} 
+	T_57  // \\p{Other_Lowercase} 
+	T_58  // \\p{Other_Math} 
+	T_59  // \\p{Other_Uppercase} 
+	T_60  // \\p{Other} 
+	T_61  // \\p{Pattern_Syntax} 
+	T_62  // \\p{Pattern_White_Space} 
+	T_63  // \\p{Pc} 
+	T_64  // \\p{Pd} 
+	T_65  // \\p{Pe} 
+	T_66  // \\p{Pf} 
+	T_67  // \\p{Pi} 
+	T_68  // \\p{Po} 
+	T_69  // \\p{Prepended_Concatenation_Mark} 
+	T_70  // \\p{Ps} 
+	T_71  // \\p{Punct} 
+	T_72  // \\p{P} 
+	T_73  // \\p{Quotation_Mark} 
+	T_74  // \\p{Radical} 
+	T_75  // \\p{Regional_Indicator} 
+	T_76  // \\p{STerm} 
+	T_77  // \\p{Sc} 
+	T_78  // \\p{Sentence_Terminal} 
+	T_79  // \\p{Sk} 
+	T_80  // \\p{Sm} 
+	T_81  // \\p{Soft_Dotted} 
+	T_82  // \\p{So} 
+	T_83  // \\p{Space} 
+	T_84  // \\p{Symbol} 
+	T_85  // \\p{S} 
+	T_86  // \\p{Terminal_Punctuation} 
+	T_87  // \\p{Title} 
+	T_88  // \\p{Unified_Ideograph} 
+	T_89  // \\p{Upper} 
+	T_90  // \\p{Variation_Selector} 
+	T_91  // \\p{White_Space} 
+	T_92  // \\p{Zl} 
+	T_93  // \\p{Zp} 
+	T_94  // \\p{Zs} 
+	T_95  // \\p{Z} 
+	T_96  // ] 
+	T_97  // ]' 
+	T_98  // any 
+	T_99  // as 
+	T_100  // case_insensitive 
+	T_101  // char_lit 
+	T_102  // empty 
+	T_103  // import 
+	T_104  // istring_lit 
+	T_105  // letter 
+	T_106  // lowcase 
+	T_107  // not 
+	T_108  // nt 
+	T_109  // number 
+	T_110  // package 
+	T_111  // prefix 
+	T_112  // rename 
+	T_113  // set_char_lit 
+	T_114  // start 
+	T_115  // string_lit 
+	T_116  // tokid 
+	T_117  // upcase 
+	T_118  // { 
+	T_119  // | 
+	T_120  // } 
 )
 
 type Symbols []Symbol
@@ -227,7 +248,10 @@ func ToNT(sym string) NT {
 }
 
 var ntToString = []string { 
+	"CharRange", /* NT_CharRange */
 	"GoGLL", /* NT_GoGLL */
+	"Import", /* NT_Import */
+	"LabelledSymbol", /* NT_LabelledSymbol */
 	"LexAlternates", /* NT_LexAlternates */
 	"LexBracket", /* NT_LexBracket */
 	"LexGroup", /* NT_LexGroup */
@@ -239,13 +263,20 @@ var ntToString = []string {
 	"Package", /* NT_Package */
 	"PlusOrMinUnicodeSet", /* NT_PlusOrMinUnicodeSet */
 	"RegExp", /* NT_RegExp */
+	"Rename", /* NT_Rename */
+	"Renames", /* NT_Renames */
 	"Rule", /* NT_Rule */
 	"Rules", /* NT_Rules */
+	"Start", /* NT_Start */
+	"StartSymbols", /* NT_StartSymbols */
 	"SyntaxAlternate", /* NT_SyntaxAlternate */
 	"SyntaxAlternates", /* NT_SyntaxAlternates */
 	"SyntaxRule", /* NT_SyntaxRule */
 	"SyntaxSymbol", /* NT_SyntaxSymbol */
 	"SyntaxSymbols", /* NT_SyntaxSymbols */
+	"TemplateArgs", /* NT_TemplateArgs */
+	"TemplateParams", /* NT_TemplateParams */
+	"TokID", /* NT_TokID */
 	"UnicodeCategory", /* NT_UnicodeCategory */
 	"UnicodeClass", /* NT_UnicodeClass */
 	"UnicodeProperty", /* NT_UnicodeProperty */
@@ -257,119 +288,133 @@ var ntToString = []string {
 
 var tToString = []string { 
 	"!", /* T_0 */
-	"'[", /* T_1 */
-	"(", /* T_2 */
-	")", /* T_3 */
-	"-", /* T_4 */
-	".", /* T_5 */
-	":", /* T_6 */
-	";", /* T_7 */
-	"<", /* T_8 */
-	">", /* T_9 */
-	"[", /* T_10 */
-	"\\p{ASCII_Hex_Digit}", /* T_11 */
-	"\\p{Bidi_Control}", /* T_12 */
-	"\\p{Cc}", /* T_13 */
-	"\\p{Cf}", /* T_14 */
-	"\\p{Co}", /* T_15 */
-	"\\p{Cs}", /* T_16 */
-	"\\p{C}", /* T_17 */
-	"\\p{Dash}", /* T_18 */
-	"\\p{Deprecated}", /* T_19 */
-	"\\p{Diacritic}", /* T_20 */
-	"\\p{Digit}", /* T_21 */
-	"\\p{Extender}", /* T_22 */
-	"\\p{Hex_Digit}", /* T_23 */
-	"\\p{Hyphen}", /* T_24 */
-	"\\p{IDS_Binary_Operator}", /* T_25 */
-	"\\p{IDS_Trinary_Operator}", /* T_26 */
-	"\\p{Ideographic}", /* T_27 */
-	"\\p{Join_Control}", /* T_28 */
-	"\\p{Letter}", /* T_29 */
-	"\\p{Ll}", /* T_30 */
-	"\\p{Lm}", /* T_31 */
-	"\\p{Logical_Order_Exception}", /* T_32 */
-	"\\p{Lower}", /* T_33 */
-	"\\p{Lo}", /* T_34 */
-	"\\p{Lt}", /* T_35 */
-	"\\p{Lu}", /* T_36 */
-	"\\p{L}", /* T_37 */
-	"\\p{Mark}", /* T_38 */
-	"\\p{Mc}", /* T_39 */
-	"\\p{Me}", /* T_40 */
-	"\\p{Mn}", /* T_41 */
-	"\\p{M}", /* T_42 */
-	"\\p{Nd}", /* T_43 */
-	"\\p{Nl}", /* T_44 */
-	"\\p{Noncharacter_Code_Point}", /* T_45 */
-	"\\p{No}", /* T_46 */
-	"\\p{Number}", /* T_47 */
-	"\\p{N}", /* T_48 */
-	"\\p{Other_Alphabetic}", /* T_49 */
-	"\\p{Other_Default_Ignorable_Code_Point}", /* T_50 */
-	"\\p{Other_Grapheme_Extend}", /* T_51 */
-	"\\p{Other_ID_Continue}", /* T_52 */
-	"\\p{Other_ID_Start}", /* T_53 */
-	"\\p{Other_Lowercase}", /* T_54 */
-	"\\p{Other_Math}", /* T_55 */
-	"\\p{Other_Uppercase}", /* T_56 */
-	"\\p{Other}", /* T_57 */
-	"\\p{Pattern_Syntax}", /* T_58 */
-	"\\p{Pattern_White_Space}", /* T_59 */
-	"\\p{Pc}", /* T_60 */
-	"\\p{Pd}", /* T_61 */
-	"\\p{Pe}", /* T_62 */
-	"\\p{Pf}", /* T_63 */
-	"\\p{Pi}", /* T_64 */
-	"\\p{Po}", /* T_65 */
-	"\\p{Prepended_Concatenation_Mark}", /* T_66 */
-	"\\p{Ps}", /* T_67 */
-	"\\p{Punct}", /* T_68 */
-	"\\p{P}", /* T_69 */
-	"\\p{Quotation_Mark}", /* T_70 */
-	"\\p{Radical}", /* T_71 */
-	"\\p{Regional_Indicator}", /* T_72 */
-	"\\p{STerm}", /* T_73 */
-	"\\p{Sc}", /* T_74 */
-	"\\p{Sentence_Terminal}", /* T_75 */
-	"\\p{Sk}", /* T_76 */
-	"\\p{Sm}", /* T_77 */
-	"\\p{Soft_Dotted}", /* T_78 */
-	"\\p{So}", /* T_79 */
-	"\\p{Space}", /* T_80 */
-	"\\p{Symbol}", /* T_81 */
-	"\\p{S}", /* T_82 */
-	"\\p{Terminal_Punctuation}", /* T_83 */
-	"\\p{Title}", /* T_84 */
-	"\\p{Unified_Ideograph}", /* T_85 */
-	"\\p{Upper}", /* T_86 */
-	"\\p{Variation_Selector}", /* T_87 */
-	"\\p{White_Space}", /* T_88 */
-	"\\p{Zl}", /* T_89 */
-	"\\p{Zp}", /* T_90 */
-	"\\p{Zs}", /* T_91 */
-	"\\p{Z}", /* T_92 */
-	"]", /* T_93 */
-	"]'", /* T_94 */
-	"any", /* T_95 */
-	"char_lit", /* T_96 */
-	"empty", /* T_97 */
-	"letter", /* T_98 */
-	"lowcase", /* T_99 */
-	"not", /* T_100 */
-	"nt", /* T_101 */
-	"number", /* T_102 */
-	"package", /* T_103 */
-	"string_lit", /* T_104 */
-	"tokid", /* T_105 */
-	"upcase", /* T_106 */
-	"{", /* T_107 */
-	"|", /* T_108 */
-	"}", /* T_109 */ 
+	"#", /* T_1 */
+	"'[", /* T_2 */
+	"(", /* T_3 */
+	")", /* T_4 */
+	",", /* T_5 */
+	"-", /* T_6 */
+	".", /* T_7 */
+	":", /* T_8 */
+	";", /* T_9 */
+	"<", /* T_10 */
+	">", /* T_11 */
+	"@", /* T_12 */
+	"[", /* T_13 */
+	"\\p{ASCII_Hex_Digit}", /* T_14 */
+	"\\p{Bidi_Control}", /* T_15 */
+	"\\p{Cc}", /* T_16 */
+	"\\p{Cf}", /* T_17 */
+	"\\p{Co}", /* T_18 */
+	"\\p{Cs}", /* T_19 */
+	"\\p{C}", /* T_20 */
+	"\\p{Dash}", /* T_21 */
+	"\\p{Deprecated}", /* T_22 */
+	"\\p{Diacritic}", /* T_23 */
+	"\\p{Digit}", /* T_24 */
+	"\\p{Extender}", /* T_25 */
+	"\\p{Hex_Digit}", /* T_26 */
+	"\\p{Hyphen}", /* T_27 */
+	"\\p{IDS_Binary_Operator}", /* T_28 */
+	"\\p{IDS_Trinary_Operator}", /* T_29 */
+	"\\p{Ideographic}", /* T_30 */
+	"\\p{Join_Control}", /* T_31 */
+	"\\p{Letter}", /* T_32 */
+	"\\p{Ll}", /* T_33 */
+	"\\p{Lm}", /* T_34 */
+	"\\p{Logical_Order_Exception}", /* T_35 */
+	"\\p{Lower}", /* T_36 */
+	"\\p{Lo}", /* T_37 */
+	"\\p{Lt}", /* T_38 */
+	"\\p{Lu}", /* T_39 */
+	"\\p{L}", /* T_40 */
+	"\\p{Mark}", /* T_41 */
+	"\\p{Mc}", /* T_42 */
+	"\\p{Me}", /* T_43 */
+	"\\p{Mn}", /* T_44 */
+	"\\p{M}", /* T_45 */
+	"\\p{Nd}", /* T_46 */
+	"\\p{Nl}", /* T_47 */
+	"\\p{Noncharacter_Code_Point}", /* T_48 */
+	"\\p{No}", /* T_49 */
+	"\\p{Number}", /* T_50 */
+	"\\p{N}", /* T_51 */
+	"\\p{Other_Alphabetic}", /* T_52 */
+	"\\p{Other_Default_Ignorable_Code_Point}", /* T_53 */
+	"\\p{Other_Grapheme_Extend}", /* T_54 */
+	"\\p{Other_ID_Continue}", /* T_55 */
+	"\\p{Other_ID_Start}", /* T_56 */
+	"\\p{Other_Lowercase}", /* T_57 */
+	"\\p{Other_Math}", /* T_58 */
+	"\\p{Other_Uppercase}", /* T_59 */
+	"\\p{Other}", /* T_60 */
+	"\\p{Pattern_Syntax}", /* T_61 */
+	"\\p{Pattern_White_Space}", /* T_62 */
+	"\\p{Pc}", /* T_63 */
+	"\\p{Pd}", /* T_64 */
+	"\\p{Pe}", /* T_65 */
+	"\\p{Pf}", /* T_66 */
+	"\\p{Pi}", /* T_67 */
+	"\\p{Po}", /* T_68 */
+	"\\p{Prepended_Concatenation_Mark}", /* T_69 */
+	"\\p{Ps}", /* T_70 */
+	"\\p{Punct}", /* T_71 */
+	"\\p{P}", /* T_72 */
+	"\\p{Quotation_Mark}", /* T_73 */
+	"\\p{Radical}", /* T_74 */
+	"\\p{Regional_Indicator}", /* T_75 */
+	"\\p{STerm}", /* T_76 */
+	"\\p{Sc}", /* T_77 */
+	"\\p{Sentence_Terminal}", /* T_78 */
+	"\\p{Sk}", /* T_79 */
+	"\\p{Sm}", /* T_80 */
+	"\\p{Soft_Dotted}", /* T_81 */
+	"\\p{So}", /* T_82 */
+	"\\p{Space}", /* T_83 */
+	"\\p{Symbol}", /* T_84 */
+	"\\p{S}", /* T_85 */
+	"\\p{Terminal_Punctuation}", /* T_86 */
+	"\\p{Title}", /* T_87 */
+	"\\p{Unified_Ideograph}", /* T_88 */
+	"\\p{Upper}", /* T_89 */
+	"\\p{Variation_Selector}", /* T_90 */
+	"\\p{White_Space}", /* T_91 */
+	"\\p{Zl}", /* T_92 */
+	"\\p{Zp}", /* T_93 */
+	"\\p{Zs}", /* T_94 */
+	"\\p{Z}", /* T_95 */
+	"]", /* T_96 */
+	"]'", /* T_97 */
+	"any", /* T_98 */
+	"as", /* T_99 */
+	"case_insensitive", /* T_100 */
+	"char_lit", /* T_101 */
+	"empty", /* T_102 */
+	"import", /* T_103 */
+	"istring_lit", /* T_104 */
+	"letter", /* T_105 */
+	"lowcase", /* T_106 */
+	"not", /* T_107 */
+	"nt", /* T_108 */
+	"number", /* T_109 */
+	"package", /* T_110 */
+	"prefix", /* T_111 */
+	"rename", /* T_112 */
+	"set_char_lit", /* T_113 */
+	"start", /* T_114 */
+	"string_lit", /* T_115 */
+	"tokid", /* T_116 */
+	"upcase", /* T_117 */
+	"{", /* T_118 */
+	"|", /* T_119 */
+	"}", /* T_120 */ 
 }
 
 var stringNT = map[string]NT{ 
+	"CharRange":NT_CharRange,
 	"GoGLL":NT_GoGLL,
+	"Import":NT_Import,
+	"LabelledSymbol":NT_LabelledSymbol,
 	"LexAlternates":NT_LexAlternates,
 	"LexBracket":NT_LexBracket,
 	"LexGroup":NT_LexGroup,
@@ -381,13 +426,20 @@ var stringNT = map[string]NT{
 	"Package":NT_Package,
 	"PlusOrMinUnicodeSet":NT_PlusOrMinUnicodeSet,
 	"RegExp":NT_RegExp,
+	"Rename":NT_Rename,
+	"Renames":NT_Renames,
 	"Rule":NT_Rule,
 	"Rules":NT_Rules,
+	"Start":NT_Start,
+	"StartSymbols":NT_StartSymbols,
 	"SyntaxAlternate":NT_SyntaxAlternate,
 	"SyntaxAlternates":NT_SyntaxAlternates,
 	"SyntaxRule":NT_SyntaxRule,
 	"SyntaxSymbol":NT_SyntaxSymbol,
 	"SyntaxSymbols":NT_SyntaxSymbols,
+	"TemplateArgs":NT_TemplateArgs,
+	"TemplateParams":NT_TemplateParams,
+	"TokID":NT_TokID,
 	"UnicodeCategory":NT_UnicodeCategory,
 	"UnicodeClass":NT_UnicodeClass,
 	"UnicodeProperty":NT_UnicodeProperty,
@@ -0,0 +1,2750 @@
+// Package visitor is generated by gogll. Do not edit.
+
+/*
+Package visitor walks an unambiguous BSR set.
+
+Walk calls the Enter and Exit methods of the nonterminal and of the alternate of
+every NT BSR. The Enter methods are called in pre-order and the Exit methods in
+post-order:
+
+	EnterExpr, EnterExpr_Alt0, <walk the NT children of the BSR>, ExitExpr_Alt0, ExitExpr
+
+BaseVisitor implements Visitor with methods that do nothing. It can be embedded
+in a visitor that only needs some of the methods.
+*/
+package visitor
+
+import (
+	"fmt"
+
+	"github.com/goccmack/gogll/v3/parser/bsr"
+	"github.com/goccmack/gogll/v3/parser/symbols"
+)
+
+// Visitor has Enter and Exit methods for each nonterminal and each alternate
+// of the grammar. If an Enter method returns false the children of the BSR
+// are not walked. The matching Exit method is always called.
+type Visitor interface {
+	EnterGoGLL(b bsr.BSR) bool
+	ExitGoGLL(b bsr.BSR)
+
+	// GoGLL : Package Rules
+	EnterGoGLL_Alt0(b bsr.BSR) bool
+	ExitGoGLL_Alt0(b bsr.BSR)
+
+	// GoGLL : Rules
+	EnterGoGLL_Alt1(b bsr.BSR) bool
+	ExitGoGLL_Alt1(b bsr.BSR)
+
+	EnterPackage(b bsr.BSR) bool
+	ExitPackage(b bsr.BSR)
+
+	// Package : "package" string_lit
+	EnterPackage_Alt0(b bsr.BSR) bool
+	ExitPackage_Alt0(b bsr.BSR)
+
+	// Package : "package" string_lit "case_insensitive"
+	EnterPackage_Alt1(b bsr.BSR) bool
+	ExitPackage_Alt1(b bsr.BSR)
+
+	EnterRules(b bsr.BSR) bool
+	ExitRules(b bsr.BSR)
+
+	// Rules : Rule
+	EnterRules_Alt0(b bsr.BSR) bool
+	ExitRules_Alt0(b bsr.BSR)
+
+	// Rules : Rule Rules
+	EnterRules_Alt1(b bsr.BSR) bool
+	ExitRules_Alt1(b bsr.BSR)
+
+	EnterRule(b bsr.BSR) bool
+	ExitRule(b bsr.BSR)
+
+	// Rule : LexRule
+	EnterRule_Alt0(b bsr.BSR) bool
+	ExitRule_Alt0(b bsr.BSR)
+
+	// Rule : SyntaxRule
+	EnterRule_Alt1(b bsr.BSR) bool
+	ExitRule_Alt1(b bsr.BSR)
+
+	// Rule : Import
+	EnterRule_Alt2(b bsr.BSR) bool
+	ExitRule_Alt2(b bsr.BSR)
+
+	// Rule : Start
+	EnterRule_Alt3(b bsr.BSR) bool
+	ExitRule_Alt3(b bsr.BSR)
+
+	EnterStart(b bsr.BSR) bool
+	ExitStart(b bsr.BSR)
+
+	// Start : "start" StartSymbols ";"
+	EnterStart_Alt0(b bsr.BSR) bool
+	ExitStart_Alt0(b bsr.BSR)
+
+	EnterStartSymbols(b bsr.BSR) bool
+	ExitStartSymbols(b bsr.BSR)
+
+	// StartSymbols : nt
+	EnterStartSymbols_Alt0(b bsr.BSR) bool
+	ExitStartSymbols_Alt0(b bsr.BSR)
+
+	// StartSymbols : nt "," StartSymbols
+	EnterStartSymbols_Alt1(b bsr.BSR) bool
+	ExitStartSymbols_Alt1(b bsr.BSR)
+
+	EnterImport(b bsr.BSR) bool
+	ExitImport(b bsr.BSR)
+
+	// Import : "import" string_lit ";"
+	EnterImport_Alt0(b bsr.BSR) bool
+	ExitImport_Alt0(b bsr.BSR)
+
+	// Import : "import" string_lit "prefix" nt ";"
+	EnterImport_Alt1(b bsr.BSR) bool
+	ExitImport_Alt1(b bsr.BSR)
+
+	// Import : "import" string_lit "rename" Renames ";"
+	EnterImport_Alt2(b bsr.BSR) bool
+	ExitImport_Alt2(b bsr.BSR)
+
+	EnterRenames(b bsr.BSR) bool
+	ExitRenames(b bsr.BSR)
+
+	// Renames : Rename
+	EnterRenames_Alt0(b bsr.BSR) bool
+	ExitRenames_Alt0(b bsr.BSR)
+
+	// Renames : Rename "," Renames
+	EnterRenames_Alt1(b bsr.BSR) bool
+	ExitRenames_Alt1(b bsr.BSR)
+
+	EnterRename(b bsr.BSR) bool
+	ExitRename(b bsr.BSR)
+
+	// Rename : nt "as" nt
+	EnterRename_Alt0(b bsr.BSR) bool
+	ExitRename_Alt0(b bsr.BSR)
+
+	// Rename : TokID "as" TokID
+	EnterRename_Alt1(b bsr.BSR) bool
+	ExitRename_Alt1(b bsr.BSR)
+
+	EnterLexSymbol(b bsr.BSR) bool
+	ExitLexSymbol(b bsr.BSR)
+
+	// LexSymbol : "."
+	EnterLexSymbol_Alt0(b bsr.BSR) bool
+	ExitLexSymbol_Alt0(b bsr.BSR)
+
+	// LexSymbol : "any" string_lit
+	EnterLexSymbol_Alt1(b bsr.BSR) bool
+	ExitLexSymbol_Alt1(b bsr.BSR)
+
+	// LexSymbol : char_lit
+	EnterLexSymbol_Alt2(b bsr.BSR) bool
+	ExitLexSymbol_Alt2(b bsr.BSR)
+
+	// LexSymbol : LexBracket
+	EnterLexSymbol_Alt3(b bsr.BSR) bool
+	ExitLexSymbol_Alt3(b bsr.BSR)
+
+	// LexSymbol : "not" string_lit
+	EnterLexSymbol_Alt4(b bsr.BSR) bool
+	ExitLexSymbol_Alt4(b bsr.BSR)
+
+	// LexSymbol : UnicodeClass
+	EnterLexSymbol_Alt5(b bsr.BSR) bool
+	ExitLexSymbol_Alt5(b bsr.BSR)
+
+	// LexSymbol : UnicodeSet
+	EnterLexSymbol_Alt6(b bsr.BSR) bool
+	ExitLexSymbol_Alt6(b bsr.BSR)
+
+	// LexSymbol : CharRange
+	EnterLexSymbol_Alt7(b bsr.BSR) bool
+	ExitLexSymbol_Alt7(b bsr.BSR)
+
+	EnterUnicodeClass(b bsr.BSR) bool
+	ExitUnicodeClass(b bsr.BSR)
+
+	// UnicodeClass : "letter"
+	EnterUnicodeClass_Alt0(b bsr.BSR) bool
+	ExitUnicodeClass_Alt0(b bsr.BSR)
+
+	// UnicodeClass : "upcase"
+	EnterUnicodeClass_Alt1(b bsr.BSR) bool
+	ExitUnicodeClass_Alt1(b bsr.BSR)
+
+	// UnicodeClass : "lowcase"
+	EnterUnicodeClass_Alt2(b bsr.BSR) bool
+	ExitUnicodeClass_Alt2(b bsr.BSR)
+
+	// UnicodeClass : "number"
+	EnterUnicodeClass_Alt3(b bsr.BSR) bool
+	ExitUnicodeClass_Alt3(b bsr.BSR)
+
+	EnterUnicodeSet(b bsr.BSR) bool
+	ExitUnicodeSet(b bsr.BSR)
+
+	// UnicodeSet : "'[" UnicodeSetSpec UnicodeSetSpecs "]'"
+	EnterUnicodeSet_Alt0(b bsr.BSR) bool
+	ExitUnicodeSet_Alt0(b bsr.BSR)
+
+	// UnicodeSet : set_char_lit "-" char_lit UnicodeSetSpecs "]'"
+	EnterUnicodeSet_Alt1(b bsr.BSR) bool
+	ExitUnicodeSet_Alt1(b bsr.BSR)
+
+	EnterCharRange(b bsr.BSR) bool
+	ExitCharRange(b bsr.BSR)
+
+	// CharRange : char_lit "-" char_lit
+	EnterCharRange_Alt0(b bsr.BSR) bool
+	ExitCharRange_Alt0(b bsr.BSR)
+
+	EnterUnicodeSetSpec(b bsr.BSR) bool
+	ExitUnicodeSetSpec(b bsr.BSR)
+
+	// UnicodeSetSpec : UnicodeCategory
+	EnterUnicodeSetSpec_Alt0(b bsr.BSR) bool
+	ExitUnicodeSetSpec_Alt0(b bsr.BSR)
+
+	// UnicodeSetSpec : UnicodeProperty
+	EnterUnicodeSetSpec_Alt1(b bsr.BSR) bool
+	ExitUnicodeSetSpec_Alt1(b bsr.BSR)
+
+	// UnicodeSetSpec : CharRange
+	EnterUnicodeSetSpec_Alt2(b bsr.BSR) bool
+	ExitUnicodeSetSpec_Alt2(b bsr.BSR)
+
+	EnterUnicodeSetSpecs(b bsr.BSR) bool
+	ExitUnicodeSetSpecs(b bsr.BSR)
+
+	// UnicodeSetSpecs : empty
+	EnterUnicodeSetSpecs_Alt0(b bsr.BSR) bool
+	ExitUnicodeSetSpecs_Alt0(b bsr.BSR)
+
+	// UnicodeSetSpecs : UnicodeSpecList
+	EnterUnicodeSetSpecs_Alt1(b bsr.BSR) bool
+	ExitUnicodeSetSpecs_Alt1(b bsr.BSR)
+
+	EnterUnicodeSpecList(b bsr.BSR) bool
+	ExitUnicodeSpecList(b bsr.BSR)
+
+	// UnicodeSpecList : PlusOrMinUnicodeSet
+	EnterUnicodeSpecList_Alt0(b bsr.BSR) bool
+	ExitUnicodeSpecList_Alt0(b bsr.BSR)
+
+	// UnicodeSpecList : PlusOrMinUnicodeSet UnicodeSpecList
+	EnterUnicodeSpecList_Alt1(b bsr.BSR) bool
+	ExitUnicodeSpecList_Alt1(b bsr.BSR)
+
+	EnterPlusOrMinUnicodeSet(b bsr.BSR) bool
+	ExitPlusOrMinUnicodeSet(b bsr.BSR)
+
+	// PlusOrMinUnicodeSet : UnicodeSetSpec
+	EnterPlusOrMinUnicodeSet_Alt0(b bsr.BSR) bool
+	ExitPlusOrMinUnicodeSet_Alt0(b bsr.BSR)
+
+	// PlusOrMinUnicodeSet : "-" UnicodeSetSpec
+	EnterPlusOrMinUnicodeSet_Alt1(b bsr.BSR) bool
+	ExitPlusOrMinUnicodeSet_Alt1(b bsr.BSR)
+
+	EnterUnicodeCategory(b bsr.BSR) bool
+	ExitUnicodeCategory(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Cc}"
+	EnterUnicodeCategory_Alt0(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt0(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Cf}"
+	EnterUnicodeCategory_Alt1(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt1(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Co}"
+	EnterUnicodeCategory_Alt2(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt2(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Cs}"
+	EnterUnicodeCategory_Alt3(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt3(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Digit}"
+	EnterUnicodeCategory_Alt4(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt4(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Nd}"
+	EnterUnicodeCategory_Alt5(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt5(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Letter}"
+	EnterUnicodeCategory_Alt6(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt6(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{L}"
+	EnterUnicodeCategory_Alt7(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt7(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Lm}"
+	EnterUnicodeCategory_Alt8(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt8(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Lo}"
+	EnterUnicodeCategory_Alt9(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt9(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Lower}"
+	EnterUnicodeCategory_Alt10(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt10(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Ll}"
+	EnterUnicodeCategory_Alt11(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt11(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Mark}"
+	EnterUnicodeCategory_Alt12(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt12(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{M}"
+	EnterUnicodeCategory_Alt13(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt13(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Mc}"
+	EnterUnicodeCategory_Alt14(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt14(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Me}"
+	EnterUnicodeCategory_Alt15(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt15(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Mn}"
+	EnterUnicodeCategory_Alt16(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt16(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Nl}"
+	EnterUnicodeCategory_Alt17(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt17(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{No}"
+	EnterUnicodeCategory_Alt18(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt18(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Number}"
+	EnterUnicodeCategory_Alt19(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt19(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{N}"
+	EnterUnicodeCategory_Alt20(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt20(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Other}"
+	EnterUnicodeCategory_Alt21(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt21(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{C}"
+	EnterUnicodeCategory_Alt22(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt22(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Pc}"
+	EnterUnicodeCategory_Alt23(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt23(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Pd}"
+	EnterUnicodeCategory_Alt24(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt24(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Pe}"
+	EnterUnicodeCategory_Alt25(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt25(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Pf}"
+	EnterUnicodeCategory_Alt26(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt26(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Pi}"
+	EnterUnicodeCategory_Alt27(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt27(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Po}"
+	EnterUnicodeCategory_Alt28(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt28(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Ps}"
+	EnterUnicodeCategory_Alt29(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt29(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Punct}"
+	EnterUnicodeCategory_Alt30(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt30(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{P}"
+	EnterUnicodeCategory_Alt31(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt31(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Sc}"
+	EnterUnicodeCategory_Alt32(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt32(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Sk}"
+	EnterUnicodeCategory_Alt33(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt33(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Sm}"
+	EnterUnicodeCategory_Alt34(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt34(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{So}"
+	EnterUnicodeCategory_Alt35(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt35(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Space}"
+	EnterUnicodeCategory_Alt36(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt36(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Z}"
+	EnterUnicodeCategory_Alt37(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt37(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Symbol}"
+	EnterUnicodeCategory_Alt38(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt38(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{S}"
+	EnterUnicodeCategory_Alt39(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt39(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Title}"
+	EnterUnicodeCategory_Alt40(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt40(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Lt}"
+	EnterUnicodeCategory_Alt41(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt41(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Upper}"
+	EnterUnicodeCategory_Alt42(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt42(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Lu}"
+	EnterUnicodeCategory_Alt43(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt43(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Zl}"
+	EnterUnicodeCategory_Alt44(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt44(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Zp}"
+	EnterUnicodeCategory_Alt45(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt45(b bsr.BSR)
+
+	// UnicodeCategory : "\\p{Zs}"
+	EnterUnicodeCategory_Alt46(b bsr.BSR) bool
+	ExitUnicodeCategory_Alt46(b bsr.BSR)
+
+	EnterUnicodeProperty(b bsr.BSR) bool
+	ExitUnicodeProperty(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{ASCII_Hex_Digit}"
+	EnterUnicodeProperty_Alt0(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt0(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Bidi_Control}"
+	EnterUnicodeProperty_Alt1(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt1(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Dash}"
+	EnterUnicodeProperty_Alt2(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt2(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Deprecated}"
+	EnterUnicodeProperty_Alt3(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt3(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Diacritic}"
+	EnterUnicodeProperty_Alt4(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt4(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Extender}"
+	EnterUnicodeProperty_Alt5(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt5(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Hex_Digit}"
+	EnterUnicodeProperty_Alt6(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt6(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Hyphen}"
+	EnterUnicodeProperty_Alt7(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt7(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{IDS_Binary_Operator}"
+	EnterUnicodeProperty_Alt8(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt8(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{IDS_Trinary_Operator}"
+	EnterUnicodeProperty_Alt9(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt9(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Ideographic}"
+	EnterUnicodeProperty_Alt10(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt10(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Join_Control}"
+	EnterUnicodeProperty_Alt11(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt11(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Logical_Order_Exception}"
+	EnterUnicodeProperty_Alt12(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt12(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Noncharacter_Code_Point}"
+	EnterUnicodeProperty_Alt13(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt13(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Other_Alphabetic}"
+	EnterUnicodeProperty_Alt14(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt14(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Other_Default_Ignorable_Code_Point}"
+	EnterUnicodeProperty_Alt15(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt15(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Other_Grapheme_Extend}"
+	EnterUnicodeProperty_Alt16(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt16(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Other_ID_Continue}"
+	EnterUnicodeProperty_Alt17(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt17(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Other_ID_Start}"
+	EnterUnicodeProperty_Alt18(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt18(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Other_Lowercase}"
+	EnterUnicodeProperty_Alt19(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt19(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Other_Math}"
+	EnterUnicodeProperty_Alt20(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt20(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Other_Uppercase}"
+	EnterUnicodeProperty_Alt21(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt21(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Pattern_Syntax}"
+	EnterUnicodeProperty_Alt22(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt22(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Pattern_White_Space}"
+	EnterUnicodeProperty_Alt23(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt23(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Prepended_Concatenation_Mark}"
+	EnterUnicodeProperty_Alt24(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt24(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Quotation_Mark}"
+	EnterUnicodeProperty_Alt25(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt25(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Radical}"
+	EnterUnicodeProperty_Alt26(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt26(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Regional_Indicator}"
+	EnterUnicodeProperty_Alt27(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt27(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{STerm}"
+	EnterUnicodeProperty_Alt28(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt28(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Sentence_Terminal}"
+	EnterUnicodeProperty_Alt29(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt29(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Soft_Dotted}"
+	EnterUnicodeProperty_Alt30(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt30(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Terminal_Punctuation}"
+	EnterUnicodeProperty_Alt31(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt31(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Unified_Ideograph}"
+	EnterUnicodeProperty_Alt32(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt32(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{Variation_Selector}"
+	EnterUnicodeProperty_Alt33(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt33(b bsr.BSR)
+
+	// UnicodeProperty : "\\p{White_Space}"
+	EnterUnicodeProperty_Alt34(b bsr.BSR) bool
+	ExitUnicodeProperty_Alt34(b bsr.BSR)
+
+	EnterLexBracket(b bsr.BSR) bool
+	ExitLexBracket(b bsr.BSR)
+
+	// LexBracket : LexGroup
+	EnterLexBracket_Alt0(b bsr.BSR) bool
+	ExitLexBracket_Alt0(b bsr.BSR)
+
+	// LexBracket : LexOptional
+	EnterLexBracket_Alt1(b bsr.BSR) bool
+	ExitLexBracket_Alt1(b bsr.BSR)
+
+	// LexBracket : LexZeroOrMore
+	EnterLexBracket_Alt2(b bsr.BSR) bool
+	ExitLexBracket_Alt2(b bsr.BSR)
+
+	// LexBracket : LexOneOrMore
+	EnterLexBracket_Alt3(b bsr.BSR) bool
+	ExitLexBracket_Alt3(b bsr.BSR)
+
+	EnterLexGroup(b bsr.BSR) bool
+	ExitLexGroup(b bsr.BSR)
+
+	// LexGroup : "(" LexAlternates ")"
+	EnterLexGroup_Alt0(b bsr.BSR) bool
+	ExitLexGroup_Alt0(b bsr.BSR)
+
+	EnterLexOptional(b bsr.BSR) bool
+	ExitLexOptional(b bsr.BSR)
+
+	// LexOptional : "[" LexAlternates "]"
+	EnterLexOptional_Alt0(b bsr.BSR) bool
+	ExitLexOptional_Alt0(b bsr.BSR)
+
+	EnterLexZeroOrMore(b bsr.BSR) bool
+	ExitLexZeroOrMore(b bsr.BSR)
+
+	// LexZeroOrMore : "{" LexAlternates "}"
+	EnterLexZeroOrMore_Alt0(b bsr.BSR) bool
+	ExitLexZeroOrMore_Alt0(b bsr.BSR)
+
+	EnterLexOneOrMore(b bsr.BSR) bool
+	ExitLexOneOrMore(b bsr.BSR)
+
+	// LexOneOrMore : "<" LexAlternates ">"
+	EnterLexOneOrMore_Alt0(b bsr.BSR) bool
+	ExitLexOneOrMore_Alt0(b bsr.BSR)
+
+	EnterLexAlternates(b bsr.BSR) bool
+	ExitLexAlternates(b bsr.BSR)
+
+	// LexAlternates : RegExp
+	EnterLexAlternates_Alt0(b bsr.BSR) bool
+	ExitLexAlternates_Alt0(b bsr.BSR)
+
+	// LexAlternates : RegExp "|" LexAlternates
+	EnterLexAlternates_Alt1(b bsr.BSR) bool
+	ExitLexAlternates_Alt1(b bsr.BSR)
+
+	EnterRegExp(b bsr.BSR) bool
+	ExitRegExp(b bsr.BSR)
+
+	// RegExp : LexSymbol
+	EnterRegExp_Alt0(b bsr.BSR) bool
+	ExitRegExp_Alt0(b bsr.BSR)
+
+	// RegExp : TokID
+	EnterRegExp_Alt1(b bsr.BSR) bool
+	ExitRegExp_Alt1(b bsr.BSR)
+
+	// RegExp : LexSymbol RegExp
+	EnterRegExp_Alt2(b bsr.BSR) bool
+	ExitRegExp_Alt2(b bsr.BSR)
+
+	// RegExp : TokID RegExp
+	EnterRegExp_Alt3(b bsr.BSR) bool
+	ExitRegExp_Alt3(b bsr.BSR)
+
+	EnterLexRule(b bsr.BSR) bool
+	ExitLexRule(b bsr.BSR)
+
+	// LexRule : TokID ":" RegExp ";"
+	EnterLexRule_Alt0(b bsr.BSR) bool
+	ExitLexRule_Alt0(b bsr.BSR)
+
+	// LexRule : "!" TokID ":" RegExp ";"
+	EnterLexRule_Alt1(b bsr.BSR) bool
+	ExitLexRule_Alt1(b bsr.BSR)
+
+	// LexRule : "@" TokID ":" RegExp ";"
+	EnterLexRule_Alt2(b bsr.BSR) bool
+	ExitLexRule_Alt2(b bsr.BSR)
+
+	EnterTokID(b bsr.BSR) bool
+	ExitTokID(b bsr.BSR)
+
+	// TokID : tokid
+	EnterTokID_Alt0(b bsr.BSR) bool
+	ExitTokID_Alt0(b bsr.BSR)
+
+	// TokID : "as"
+	EnterTokID_Alt1(b bsr.BSR) bool
+	ExitTokID_Alt1(b bsr.BSR)
+
+	// TokID : "case_insensitive"
+	EnterTokID_Alt2(b bsr.BSR) bool
+	ExitTokID_Alt2(b bsr.BSR)
+
+	// TokID : "import"
+	EnterTokID_Alt3(b bsr.BSR) bool
+	ExitTokID_Alt3(b bsr.BSR)
+
+	// TokID : "prefix"
+	EnterTokID_Alt4(b bsr.BSR) bool
+	ExitTokID_Alt4(b bsr.BSR)
+
+	// TokID : "rename"
+	EnterTokID_Alt5(b bsr.BSR) bool
+	ExitTokID_Alt5(b bsr.BSR)
+
+	// TokID : "start"
+	EnterTokID_Alt6(b bsr.BSR) bool
+	ExitTokID_Alt6(b bsr.BSR)
+
+	EnterSyntaxRule(b bsr.BSR) bool
+	ExitSyntaxRule(b bsr.BSR)
+
+	// SyntaxRule : nt ":" SyntaxAlternates ";"
+	EnterSyntaxRule_Alt0(b bsr.BSR) bool
+	ExitSyntaxRule_Alt0(b bsr.BSR)
+
+	// SyntaxRule : nt "<" TemplateParams ">" ":" SyntaxAlternates ";"
+	EnterSyntaxRule_Alt1(b bsr.BSR) bool
+	ExitSyntaxRule_Alt1(b bsr.BSR)
+
+	EnterTemplateParams(b bsr.BSR) bool
+	ExitTemplateParams(b bsr.BSR)
+
+	// TemplateParams : nt
+	EnterTemplateParams_Alt0(b bsr.BSR) bool
+	ExitTemplateParams_Alt0(b bsr.BSR)
+
+	// TemplateParams : nt "," TemplateParams
+	EnterTemplateParams_Alt1(b bsr.BSR) bool
+	ExitTemplateParams_Alt1(b bsr.BSR)
+
+	EnterSyntaxAlternates(b bsr.BSR) bool
+	ExitSyntaxAlternates(b bsr.BSR)
+
+	// SyntaxAlternates : SyntaxAlternate
+	EnterSyntaxAlternates_Alt0(b bsr.BSR) bool
+	ExitSyntaxAlternates_Alt0(b bsr.BSR)
+
+	// SyntaxAlternates : SyntaxAlternate "|" SyntaxAlternates
+	EnterSyntaxAlternates_Alt1(b bsr.BSR) bool
+	ExitSyntaxAlternates_Alt1(b bsr.BSR)
+
+	EnterSyntaxAlternate(b bsr.BSR) bool
+	ExitSyntaxAlternate(b bsr.BSR)
+
+	// SyntaxAlternate : SyntaxSymbols
+	EnterSyntaxAlternate_Alt0(b bsr.BSR) bool
+	ExitSyntaxAlternate_Alt0(b bsr.BSR)
+
+	// SyntaxAlternate : "empty"
+	EnterSyntaxAlternate_Alt1(b bsr.BSR) bool
+	ExitSyntaxAlternate_Alt1(b bsr.BSR)
+
+	// SyntaxAlternate : SyntaxSymbols "#" nt
+	EnterSyntaxAlternate_Alt2(b bsr.BSR) bool
+	ExitSyntaxAlternate_Alt2(b bsr.BSR)
+
+	// SyntaxAlternate : "empty" "#" nt
+	EnterSyntaxAlternate_Alt3(b bsr.BSR) bool
+	ExitSyntaxAlternate_Alt3(b bsr.BSR)
+
+	EnterSyntaxSymbols(b bsr.BSR) bool
+	ExitSyntaxSymbols(b bsr.BSR)
+
+	// SyntaxSymbols : LabelledSymbol
+	EnterSyntaxSymbols_Alt0(b bsr.BSR) bool
+	ExitSyntaxSymbols_Alt0(b bsr.BSR)
+
+	// SyntaxSymbols : LabelledSymbol SyntaxSymbols
+	EnterSyntaxSymbols_Alt1(b bsr.BSR) bool
+	ExitSyntaxSymbols_Alt1(b bsr.BSR)
+
+	EnterLabelledSymbol(b bsr.BSR) bool
+	ExitLabelledSymbol(b bsr.BSR)
+
+	// LabelledSymbol : SyntaxSymbol
+	EnterLabelledSymbol_Alt0(b bsr.BSR) bool
+	ExitLabelledSymbol_Alt0(b bsr.BSR)
+
+	// LabelledSymbol : TokID ":" SyntaxSymbol
+	EnterLabelledSymbol_Alt1(b bsr.BSR) bool
+	ExitLabelledSymbol_Alt1(b bsr.BSR)
+
+	EnterSyntaxSymbol(b bsr.BSR) bool
+	ExitSyntaxSymbol(b bsr.BSR)
+
+	// SyntaxSymbol : nt
+	EnterSyntaxSymbol_Alt0(b bsr.BSR) bool
+	ExitSyntaxSymbol_Alt0(b bsr.BSR)
+
+	// SyntaxSymbol : TokID
+	EnterSyntaxSymbol_Alt1(b bsr.BSR) bool
+	ExitSyntaxSymbol_Alt1(b bsr.BSR)
+
+	// SyntaxSymbol : string_lit
+	EnterSyntaxSymbol_Alt2(b bsr.BSR) bool
+	ExitSyntaxSymbol_Alt2(b bsr.BSR)
+
+	// SyntaxSymbol : istring_lit
+	EnterSyntaxSymbol_Alt3(b bsr.BSR) bool
+	ExitSyntaxSymbol_Alt3(b bsr.BSR)
+
+	// SyntaxSymbol : nt "<" TemplateArgs ">"
+	EnterSyntaxSymbol_Alt4(b bsr.BSR) bool
+	ExitSyntaxSymbol_Alt4(b bsr.BSR)
+
+	EnterTemplateArgs(b bsr.BSR) bool
+	ExitTemplateArgs(b bsr.BSR)
+
+	// TemplateArgs : SyntaxSymbol
+	EnterTemplateArgs_Alt0(b bsr.BSR) bool
+	ExitTemplateArgs_Alt0(b bsr.BSR)
+
+	// TemplateArgs : SyntaxSymbol "," TemplateArgs
+	EnterTemplateArgs_Alt1(b bsr.BSR) bool
+	ExitTemplateArgs_Alt1(b bsr.BSR)
+}
+
+// Walk walks the BSR b and its NT children, calling the methods of v.
+// Walk panics if b has ambiguous children.
+func Walk(b bsr.BSR, v Visitor) {
+	switch b.Label.Head() {
+	case symbols.NT_GoGLL:
+		if v.EnterGoGLL(b) {
+			walkGoGLL(b, v)
+		}
+		v.ExitGoGLL(b)
+	case symbols.NT_Package:
+		if v.EnterPackage(b) {
+			walkPackage(b, v)
+		}
+		v.ExitPackage(b)
+	case symbols.NT_Rules:
+		if v.EnterRules(b) {
+			walkRules(b, v)
+		}
+		v.ExitRules(b)
+	case symbols.NT_Rule:
+		if v.EnterRule(b) {
+			walkRule(b, v)
+		}
+		v.ExitRule(b)
+	case symbols.NT_Start:
+		if v.EnterStart(b) {
+			walkStart(b, v)
+		}
+		v.ExitStart(b)
+	case symbols.NT_StartSymbols:
+		if v.EnterStartSymbols(b) {
+			walkStartSymbols(b, v)
+		}
+		v.ExitStartSymbols(b)
+	case symbols.NT_Import:
+		if v.EnterImport(b) {
+			walkImport(b, v)
+		}
+		v.ExitImport(b)
+	case symbols.NT_Renames:
+		if v.EnterRenames(b) {
+			walkRenames(b, v)
+		}
+		v.ExitRenames(b)
+	case symbols.NT_Rename:
+		if v.EnterRename(b) {
+			walkRename(b, v)
+		}
+		v.ExitRename(b)
+	case symbols.NT_LexSymbol:
+		if v.EnterLexSymbol(b) {
+			walkLexSymbol(b, v)
+		}
+		v.ExitLexSymbol(b)
+	case symbols.NT_UnicodeClass:
+		if v.EnterUnicodeClass(b) {
+			walkUnicodeClass(b, v)
+		}
+		v.ExitUnicodeClass(b)
+	case symbols.NT_UnicodeSet:
+		if v.EnterUnicodeSet(b) {
+			walkUnicodeSet(b, v)
+		}
+		v.ExitUnicodeSet(b)
+	case symbols.NT_CharRange:
+		if v.EnterCharRange(b) {
+			walkCharRange(b, v)
+		}
+		v.ExitCharRange(b)
+	case symbols.NT_UnicodeSetSpec:
+		if v.EnterUnicodeSetSpec(b) {
+			walkUnicodeSetSpec(b, v)
+		}
+		v.ExitUnicodeSetSpec(b)
+	case symbols.NT_UnicodeSetSpecs:
+		if v.EnterUnicodeSetSpecs(b) {
+			walkUnicodeSetSpecs(b, v)
+		}
+		v.ExitUnicodeSetSpecs(b)
+	case symbols.NT_UnicodeSpecList:
+		if v.EnterUnicodeSpecList(b) {
+			walkUnicodeSpecList(b, v)
+		}
+		v.ExitUnicodeSpecList(b)
+	case symbols.NT_PlusOrMinUnicodeSet:
+		if v.EnterPlusOrMinUnicodeSet(b) {
+			walkPlusOrMinUnicodeSet(b, v)
+		}
+		v.ExitPlusOrMinUnicodeSet(b)
+	case symbols.NT_UnicodeCategory:
+		if v.EnterUnicodeCategory(b) {
+			walkUnicodeCategory(b, v)
+		}
+		v.ExitUnicodeCategory(b)
+	case symbols.NT_UnicodeProperty:
+		if v.EnterUnicodeProperty(b) {
+			walkUnicodeProperty(b, v)
+		}
+		v.ExitUnicodeProperty(b)
+	case symbols.NT_LexBracket:
+		if v.EnterLexBracket(b) {
+			walkLexBracket(b, v)
+		}
+		v.ExitLexBracket(b)
+	case symbols.NT_LexGroup:
+		if v.EnterLexGroup(b) {
+			walkLexGroup(b, v)
+		}
+		v.ExitLexGroup(b)
+	case symbols.NT_LexOptional:
+		if v.EnterLexOptional(b) {
+			walkLexOptional(b, v)
+		}
+		v.ExitLexOptional(b)
+	case symbols.NT_LexZeroOrMore:
+		if v.EnterLexZeroOrMore(b) {
+			walkLexZeroOrMore(b, v)
+		}
+		v.ExitLexZeroOrMore(b)
+	case symbols.NT_LexOneOrMore:
+		if v.EnterLexOneOrMore(b) {
+			walkLexOneOrMore(b, v)
+		}
+		v.ExitLexOneOrMore(b)
+	case symbols.NT_LexAlternates:
+		if v.EnterLexAlternates(b) {
+			walkLexAlternates(b, v)
+		}
+		v.ExitLexAlternates(b)
+	case symbols.NT_RegExp:
+		if v.EnterRegExp(b) {
+			walkRegExp(b, v)
+		}
+		v.ExitRegExp(b)
+	case symbols.NT_LexRule:
+		if v.EnterLexRule(b) {
+			walkLexRule(b, v)
+		}
+		v.ExitLexRule(b)
+	case symbols.NT_TokID:
+		if v.EnterTokID(b) {
+			walkTokID(b, v)
+		}
+		v.ExitTokID(b)
+	case symbols.NT_SyntaxRule:
+		if v.EnterSyntaxRule(b) {
+			walkSyntaxRule(b, v)
+		}
+		v.ExitSyntaxRule(b)
+	case symbols.NT_TemplateParams:
+		if v.EnterTemplateParams(b) {
+			walkTemplateParams(b, v)
+		}
+		v.ExitTemplateParams(b)
+	case symbols.NT_SyntaxAlternates:
+		if v.EnterSyntaxAlternates(b) {
+			walkSyntaxAlternates(b, v)
+		}
+		v.ExitSyntaxAlternates(b)
+	case symbols.NT_SyntaxAlternate:
+		if v.EnterSyntaxAlternate(b) {
+			walkSyntaxAlternate(b, v)
+		}
+		v.ExitSyntaxAlternate(b)
+	case symbols.NT_SyntaxSymbols:
+		if v.EnterSyntaxSymbols(b) {
+			walkSyntaxSymbols(b, v)
+		}
+		v.ExitSyntaxSymbols(b)
+	case symbols.NT_LabelledSymbol:
+		if v.EnterLabelledSymbol(b) {
+			walkLabelledSymbol(b, v)
+		}
+		v.ExitLabelledSymbol(b)
+	case symbols.NT_SyntaxSymbol:
+		if v.EnterSyntaxSymbol(b) {
+			walkSyntaxSymbol(b, v)
+		}
+		v.ExitSyntaxSymbol(b)
+	case symbols.NT_TemplateArgs:
+		if v.EnterTemplateArgs(b) {
+			walkTemplateArgs(b, v)
+		}
+		v.ExitTemplateArgs(b)
+	default:
+		panic(fmt.Sprintf("invalid NT %s", b.Label.Head()))
+	}
+}
+
+func walkGoGLL(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterGoGLL_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitGoGLL_Alt0(b)
+	case 1:
+		if v.EnterGoGLL_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitGoGLL_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of GoGLL", b.Alternate()))
+	}
+}
+
+func walkPackage(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterPackage_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitPackage_Alt0(b)
+	case 1:
+		if v.EnterPackage_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitPackage_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Package", b.Alternate()))
+	}
+}
+
+func walkRules(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterRules_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitRules_Alt0(b)
+	case 1:
+		if v.EnterRules_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitRules_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Rules", b.Alternate()))
+	}
+}
+
+func walkRule(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterRule_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitRule_Alt0(b)
+	case 1:
+		if v.EnterRule_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitRule_Alt1(b)
+	case 2:
+		if v.EnterRule_Alt2(b) {
+			walkChildren(b, v)
+		}
+		v.ExitRule_Alt2(b)
+	case 3:
+		if v.EnterRule_Alt3(b) {
+			walkChildren(b, v)
+		}
+		v.ExitRule_Alt3(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Rule", b.Alternate()))
+	}
+}
+
+func walkStart(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterStart_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitStart_Alt0(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Start", b.Alternate()))
+	}
+}
+
+func walkStartSymbols(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterStartSymbols_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitStartSymbols_Alt0(b)
+	case 1:
+		if v.EnterStartSymbols_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitStartSymbols_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of StartSymbols", b.Alternate()))
+	}
+}
+
+func walkImport(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterImport_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitImport_Alt0(b)
+	case 1:
+		if v.EnterImport_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitImport_Alt1(b)
+	case 2:
+		if v.EnterImport_Alt2(b) {
+			walkChildren(b, v)
+		}
+		v.ExitImport_Alt2(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Import", b.Alternate()))
+	}
+}
+
+func walkRenames(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterRenames_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitRenames_Alt0(b)
+	case 1:
+		if v.EnterRenames_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitRenames_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Renames", b.Alternate()))
+	}
+}
+
+func walkRename(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterRename_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitRename_Alt0(b)
+	case 1:
+		if v.EnterRename_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitRename_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Rename", b.Alternate()))
+	}
+}
+
+func walkLexSymbol(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterLexSymbol_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexSymbol_Alt0(b)
+	case 1:
+		if v.EnterLexSymbol_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexSymbol_Alt1(b)
+	case 2:
+		if v.EnterLexSymbol_Alt2(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexSymbol_Alt2(b)
+	case 3:
+		if v.EnterLexSymbol_Alt3(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexSymbol_Alt3(b)
+	case 4:
+		if v.EnterLexSymbol_Alt4(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexSymbol_Alt4(b)
+	case 5:
+		if v.EnterLexSymbol_Alt5(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexSymbol_Alt5(b)
+	case 6:
+		if v.EnterLexSymbol_Alt6(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexSymbol_Alt6(b)
+	case 7:
+		if v.EnterLexSymbol_Alt7(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexSymbol_Alt7(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of LexSymbol", b.Alternate()))
+	}
+}
+
+func walkUnicodeClass(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterUnicodeClass_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeClass_Alt0(b)
+	case 1:
+		if v.EnterUnicodeClass_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeClass_Alt1(b)
+	case 2:
+		if v.EnterUnicodeClass_Alt2(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeClass_Alt2(b)
+	case 3:
+		if v.EnterUnicodeClass_Alt3(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeClass_Alt3(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of UnicodeClass", b.Alternate()))
+	}
+}
+
+func walkUnicodeSet(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterUnicodeSet_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeSet_Alt0(b)
+	case 1:
+		if v.EnterUnicodeSet_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeSet_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of UnicodeSet", b.Alternate()))
+	}
+}
+
+func walkCharRange(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterCharRange_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitCharRange_Alt0(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of CharRange", b.Alternate()))
+	}
+}
+
+func walkUnicodeSetSpec(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterUnicodeSetSpec_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeSetSpec_Alt0(b)
+	case 1:
+		if v.EnterUnicodeSetSpec_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeSetSpec_Alt1(b)
+	case 2:
+		if v.EnterUnicodeSetSpec_Alt2(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeSetSpec_Alt2(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of UnicodeSetSpec", b.Alternate()))
+	}
+}
+
+func walkUnicodeSetSpecs(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterUnicodeSetSpecs_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeSetSpecs_Alt0(b)
+	case 1:
+		if v.EnterUnicodeSetSpecs_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeSetSpecs_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of UnicodeSetSpecs", b.Alternate()))
+	}
+}
+
+func walkUnicodeSpecList(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterUnicodeSpecList_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeSpecList_Alt0(b)
+	case 1:
+		if v.EnterUnicodeSpecList_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeSpecList_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of UnicodeSpecList", b.Alternate()))
+	}
+}
+
+func walkPlusOrMinUnicodeSet(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterPlusOrMinUnicodeSet_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitPlusOrMinUnicodeSet_Alt0(b)
+	case 1:
+		if v.EnterPlusOrMinUnicodeSet_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitPlusOrMinUnicodeSet_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of PlusOrMinUnicodeSet", b.Alternate()))
+	}
+}
+
+func walkUnicodeCategory(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterUnicodeCategory_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt0(b)
+	case 1:
+		if v.EnterUnicodeCategory_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt1(b)
+	case 2:
+		if v.EnterUnicodeCategory_Alt2(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt2(b)
+	case 3:
+		if v.EnterUnicodeCategory_Alt3(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt3(b)
+	case 4:
+		if v.EnterUnicodeCategory_Alt4(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt4(b)
+	case 5:
+		if v.EnterUnicodeCategory_Alt5(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt5(b)
+	case 6:
+		if v.EnterUnicodeCategory_Alt6(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt6(b)
+	case 7:
+		if v.EnterUnicodeCategory_Alt7(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt7(b)
+	case 8:
+		if v.EnterUnicodeCategory_Alt8(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt8(b)
+	case 9:
+		if v.EnterUnicodeCategory_Alt9(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt9(b)
+	case 10:
+		if v.EnterUnicodeCategory_Alt10(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt10(b)
+	case 11:
+		if v.EnterUnicodeCategory_Alt11(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt11(b)
+	case 12:
+		if v.EnterUnicodeCategory_Alt12(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt12(b)
+	case 13:
+		if v.EnterUnicodeCategory_Alt13(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt13(b)
+	case 14:
+		if v.EnterUnicodeCategory_Alt14(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt14(b)
+	case 15:
+		if v.EnterUnicodeCategory_Alt15(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt15(b)
+	case 16:
+		if v.EnterUnicodeCategory_Alt16(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt16(b)
+	case 17:
+		if v.EnterUnicodeCategory_Alt17(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt17(b)
+	case 18:
+		if v.EnterUnicodeCategory_Alt18(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt18(b)
+	case 19:
+		if v.EnterUnicodeCategory_Alt19(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt19(b)
+	case 20:
+		if v.EnterUnicodeCategory_Alt20(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt20(b)
+	case 21:
+		if v.EnterUnicodeCategory_Alt21(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt21(b)
+	case 22:
+		if v.EnterUnicodeCategory_Alt22(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt22(b)
+	case 23:
+		if v.EnterUnicodeCategory_Alt23(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt23(b)
+	case 24:
+		if v.EnterUnicodeCategory_Alt24(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt24(b)
+	case 25:
+		if v.EnterUnicodeCategory_Alt25(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt25(b)
+	case 26:
+		if v.EnterUnicodeCategory_Alt26(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt26(b)
+	case 27:
+		if v.EnterUnicodeCategory_Alt27(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt27(b)
+	case 28:
+		if v.EnterUnicodeCategory_Alt28(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt28(b)
+	case 29:
+		if v.EnterUnicodeCategory_Alt29(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt29(b)
+	case 30:
+		if v.EnterUnicodeCategory_Alt30(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt30(b)
+	case 31:
+		if v.EnterUnicodeCategory_Alt31(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt31(b)
+	case 32:
+		if v.EnterUnicodeCategory_Alt32(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt32(b)
+	case 33:
+		if v.EnterUnicodeCategory_Alt33(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt33(b)
+	case 34:
+		if v.EnterUnicodeCategory_Alt34(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt34(b)
+	case 35:
+		if v.EnterUnicodeCategory_Alt35(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt35(b)
+	case 36:
+		if v.EnterUnicodeCategory_Alt36(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt36(b)
+	case 37:
+		if v.EnterUnicodeCategory_Alt37(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt37(b)
+	case 38:
+		if v.EnterUnicodeCategory_Alt38(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt38(b)
+	case 39:
+		if v.EnterUnicodeCategory_Alt39(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt39(b)
+	case 40:
+		if v.EnterUnicodeCategory_Alt40(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt40(b)
+	case 41:
+		if v.EnterUnicodeCategory_Alt41(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt41(b)
+	case 42:
+		if v.EnterUnicodeCategory_Alt42(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt42(b)
+	case 43:
+		if v.EnterUnicodeCategory_Alt43(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt43(b)
+	case 44:
+		if v.EnterUnicodeCategory_Alt44(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt44(b)
+	case 45:
+		if v.EnterUnicodeCategory_Alt45(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt45(b)
+	case 46:
+		if v.EnterUnicodeCategory_Alt46(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeCategory_Alt46(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of UnicodeCategory", b.Alternate()))
+	}
+}
+
+func walkUnicodeProperty(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterUnicodeProperty_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt0(b)
+	case 1:
+		if v.EnterUnicodeProperty_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt1(b)
+	case 2:
+		if v.EnterUnicodeProperty_Alt2(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt2(b)
+	case 3:
+		if v.EnterUnicodeProperty_Alt3(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt3(b)
+	case 4:
+		if v.EnterUnicodeProperty_Alt4(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt4(b)
+	case 5:
+		if v.EnterUnicodeProperty_Alt5(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt5(b)
+	case 6:
+		if v.EnterUnicodeProperty_Alt6(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt6(b)
+	case 7:
+		if v.EnterUnicodeProperty_Alt7(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt7(b)
+	case 8:
+		if v.EnterUnicodeProperty_Alt8(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt8(b)
+	case 9:
+		if v.EnterUnicodeProperty_Alt9(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt9(b)
+	case 10:
+		if v.EnterUnicodeProperty_Alt10(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt10(b)
+	case 11:
+		if v.EnterUnicodeProperty_Alt11(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt11(b)
+	case 12:
+		if v.EnterUnicodeProperty_Alt12(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt12(b)
+	case 13:
+		if v.EnterUnicodeProperty_Alt13(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt13(b)
+	case 14:
+		if v.EnterUnicodeProperty_Alt14(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt14(b)
+	case 15:
+		if v.EnterUnicodeProperty_Alt15(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt15(b)
+	case 16:
+		if v.EnterUnicodeProperty_Alt16(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt16(b)
+	case 17:
+		if v.EnterUnicodeProperty_Alt17(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt17(b)
+	case 18:
+		if v.EnterUnicodeProperty_Alt18(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt18(b)
+	case 19:
+		if v.EnterUnicodeProperty_Alt19(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt19(b)
+	case 20:
+		if v.EnterUnicodeProperty_Alt20(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt20(b)
+	case 21:
+		if v.EnterUnicodeProperty_Alt21(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt21(b)
+	case 22:
+		if v.EnterUnicodeProperty_Alt22(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt22(b)
+	case 23:
+		if v.EnterUnicodeProperty_Alt23(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt23(b)
+	case 24:
+		if v.EnterUnicodeProperty_Alt24(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt24(b)
+	case 25:
+		if v.EnterUnicodeProperty_Alt25(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt25(b)
+	case 26:
+		if v.EnterUnicodeProperty_Alt26(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt26(b)
+	case 27:
+		if v.EnterUnicodeProperty_Alt27(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt27(b)
+	case 28:
+		if v.EnterUnicodeProperty_Alt28(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt28(b)
+	case 29:
+		if v.EnterUnicodeProperty_Alt29(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt29(b)
+	case 30:
+		if v.EnterUnicodeProperty_Alt30(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt30(b)
+	case 31:
+		if v.EnterUnicodeProperty_Alt31(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt31(b)
+	case 32:
+		if v.EnterUnicodeProperty_Alt32(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt32(b)
+	case 33:
+		if v.EnterUnicodeProperty_Alt33(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt33(b)
+	case 34:
+		if v.EnterUnicodeProperty_Alt34(b) {
+			walkChildren(b, v)
+		}
+		v.ExitUnicodeProperty_Alt34(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of UnicodeProperty", b.Alternate()))
+	}
+}
+
+func walkLexBracket(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterLexBracket_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexBracket_Alt0(b)
+	case 1:
+		if v.EnterLexBracket_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexBracket_Alt1(b)
+	case 2:
+		if v.EnterLexBracket_Alt2(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexBracket_Alt2(b)
+	case 3:
+		if v.EnterLexBracket_Alt3(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexBracket_Alt3(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of LexBracket", b.Alternate()))
+	}
+}
+
+func walkLexGroup(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterLexGroup_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexGroup_Alt0(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of LexGroup", b.Alternate()))
+	}
+}
+
+func walkLexOptional(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterLexOptional_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexOptional_Alt0(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of LexOptional", b.Alternate()))
+	}
+}
+
+func walkLexZeroOrMore(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterLexZeroOrMore_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexZeroOrMore_Alt0(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of LexZeroOrMore", b.Alternate()))
+	}
+}
+
+func walkLexOneOrMore(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterLexOneOrMore_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexOneOrMore_Alt0(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of LexOneOrMore", b.Alternate()))
+	}
+}
+
+func walkLexAlternates(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterLexAlternates_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexAlternates_Alt0(b)
+	case 1:
+		if v.EnterLexAlternates_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexAlternates_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of LexAlternates", b.Alternate()))
+	}
+}
+
+func walkRegExp(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterRegExp_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitRegExp_Alt0(b)
+	case 1:
+		if v.EnterRegExp_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitRegExp_Alt1(b)
+	case 2:
+		if v.EnterRegExp_Alt2(b) {
+			walkChildren(b, v)
+		}
+		v.ExitRegExp_Alt2(b)
+	case 3:
+		if v.EnterRegExp_Alt3(b) {
+			walkChildren(b, v)
+		}
+		v.ExitRegExp_Alt3(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of RegExp", b.Alternate()))
+	}
+}
+
+func walkLexRule(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterLexRule_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexRule_Alt0(b)
+	case 1:
+		if v.EnterLexRule_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexRule_Alt1(b)
+	case 2:
+		if v.EnterLexRule_Alt2(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLexRule_Alt2(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of LexRule", b.Alternate()))
+	}
+}
+
+func walkTokID(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterTokID_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitTokID_Alt0(b)
+	case 1:
+		if v.EnterTokID_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitTokID_Alt1(b)
+	case 2:
+		if v.EnterTokID_Alt2(b) {
+			walkChildren(b, v)
+		}
+		v.ExitTokID_Alt2(b)
+	case 3:
+		if v.EnterTokID_Alt3(b) {
+			walkChildren(b, v)
+		}
+		v.ExitTokID_Alt3(b)
+	case 4:
+		if v.EnterTokID_Alt4(b) {
+			walkChildren(b, v)
+		}
+		v.ExitTokID_Alt4(b)
+	case 5:
+		if v.EnterTokID_Alt5(b) {
+			walkChildren(b, v)
+		}
+		v.ExitTokID_Alt5(b)
+	case 6:
+		if v.EnterTokID_Alt6(b) {
+			walkChildren(b, v)
+		}
+		v.ExitTokID_Alt6(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of TokID", b.Alternate()))
+	}
+}
+
+func walkSyntaxRule(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterSyntaxRule_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitSyntaxRule_Alt0(b)
+	case 1:
+		if v.EnterSyntaxRule_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitSyntaxRule_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of SyntaxRule", b.Alternate()))
+	}
+}
+
+func walkTemplateParams(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterTemplateParams_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitTemplateParams_Alt0(b)
+	case 1:
+		if v.EnterTemplateParams_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitTemplateParams_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of TemplateParams", b.Alternate()))
+	}
+}
+
+func walkSyntaxAlternates(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterSyntaxAlternates_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitSyntaxAlternates_Alt0(b)
+	case 1:
+		if v.EnterSyntaxAlternates_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitSyntaxAlternates_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of SyntaxAlternates", b.Alternate()))
+	}
+}
+
+func walkSyntaxAlternate(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterSyntaxAlternate_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitSyntaxAlternate_Alt0(b)
+	case 1:
+		if v.EnterSyntaxAlternate_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitSyntaxAlternate_Alt1(b)
+	case 2:
+		if v.EnterSyntaxAlternate_Alt2(b) {
+			walkChildren(b, v)
+		}
+		v.ExitSyntaxAlternate_Alt2(b)
+	case 3:
+		if v.EnterSyntaxAlternate_Alt3(b) {
+			walkChildren(b, v)
+		}
+		v.ExitSyntaxAlternate_Alt3(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of SyntaxAlternate", b.Alternate()))
+	}
+}
+
+func walkSyntaxSymbols(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterSyntaxSymbols_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitSyntaxSymbols_Alt0(b)
+	case 1:
+		if v.EnterSyntaxSymbols_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitSyntaxSymbols_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of SyntaxSymbols", b.Alternate()))
+	}
+}
+
+func walkLabelledSymbol(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterLabelledSymbol_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLabelledSymbol_Alt0(b)
+	case 1:
+		if v.EnterLabelledSymbol_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitLabelledSymbol_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of LabelledSymbol", b.Alternate()))
+	}
+}
+
+func walkSyntaxSymbol(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterSyntaxSymbol_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitSyntaxSymbol_Alt0(b)
+	case 1:
+		if v.EnterSyntaxSymbol_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitSyntaxSymbol_Alt1(b)
+	case 2:
+		if v.EnterSyntaxSymbol_Alt2(b) {
+			walkChildren(b, v)
+		}
+		v.ExitSyntaxSymbol_Alt2(b)
+	case 3:
+		if v.EnterSyntaxSymbol_Alt3(b) {
+			walkChildren(b, v)
+		}
+		v.ExitSyntaxSymbol_Alt3(b)
+	case 4:
+		if v.EnterSyntaxSymbol_Alt4(b) {
+			walkChildren(b, v)
+		}
+		v.ExitSyntaxSymbol_Alt4(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of SyntaxSymbol", b.Alternate()))
+	}
+}
+
+func walkTemplateArgs(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterTemplateArgs_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitTemplateArgs_Alt0(b)
+	case 1:
+		if v.EnterTemplateArgs_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitTemplateArgs_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of TemplateArgs", b.Alternate()))
+	}
+}
+
+func walkChildren(b bsr.BSR, v Visitor) {
+	for i, s := range b.Label.Symbols() {
+		if s.IsNonTerminal() {
+			Walk(b.GetNTChildI(i), v)
+		}
+	}
+}
+
+// BaseVisitor implements Visitor. Its Enter methods return true and its Exit
+// methods do nothing.
+type BaseVisitor struct{}
+
+var _ Visitor = BaseVisitor{}
+
+func (BaseVisitor) EnterGoGLL(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitGoGLL(b bsr.BSR) {}
+
+func (BaseVisitor) EnterGoGLL_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitGoGLL_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterGoGLL_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitGoGLL_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterPackage(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitPackage(b bsr.BSR) {}
+
+func (BaseVisitor) EnterPackage_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitPackage_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterPackage_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitPackage_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterRules(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitRules(b bsr.BSR) {}
+
+func (BaseVisitor) EnterRules_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitRules_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterRules_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitRules_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterRule(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitRule(b bsr.BSR) {}
+
+func (BaseVisitor) EnterRule_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitRule_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterRule_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitRule_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterRule_Alt2(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitRule_Alt2(b bsr.BSR) {}
+
+func (BaseVisitor) EnterRule_Alt3(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitRule_Alt3(b bsr.BSR) {}
+
+func (BaseVisitor) EnterStart(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStart(b bsr.BSR) {}
+
+func (BaseVisitor) EnterStart_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStart_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterStartSymbols(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStartSymbols(b bsr.BSR) {}
+
+func (BaseVisitor) EnterStartSymbols_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStartSymbols_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterStartSymbols_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStartSymbols_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterImport(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitImport(b bsr.BSR) {}
+
+func (BaseVisitor) EnterImport_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitImport_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterImport_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitImport_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterImport_Alt2(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitImport_Alt2(b bsr.BSR) {}
+
+func (BaseVisitor) EnterRenames(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitRenames(b bsr.BSR) {}
+
+func (BaseVisitor) EnterRenames_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitRenames_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterRenames_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitRenames_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterRename(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitRename(b bsr.BSR) {}
+
+func (BaseVisitor) EnterRename_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitRename_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterRename_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitRename_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexSymbol(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexSymbol(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexSymbol_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexSymbol_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexSymbol_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexSymbol_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexSymbol_Alt2(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexSymbol_Alt2(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexSymbol_Alt3(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexSymbol_Alt3(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexSymbol_Alt4(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexSymbol_Alt4(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexSymbol_Alt5(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexSymbol_Alt5(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexSymbol_Alt6(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexSymbol_Alt6(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexSymbol_Alt7(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexSymbol_Alt7(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeClass(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeClass(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeClass_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeClass_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeClass_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeClass_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeClass_Alt2(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeClass_Alt2(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeClass_Alt3(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeClass_Alt3(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeSet(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeSet(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeSet_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeSet_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeSet_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeSet_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterCharRange(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitCharRange(b bsr.BSR) {}
+
+func (BaseVisitor) EnterCharRange_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitCharRange_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeSetSpec(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeSetSpec(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeSetSpec_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeSetSpec_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeSetSpec_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeSetSpec_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeSetSpec_Alt2(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeSetSpec_Alt2(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeSetSpecs(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeSetSpecs(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeSetSpecs_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeSetSpecs_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeSetSpecs_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeSetSpecs_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeSpecList(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeSpecList(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeSpecList_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeSpecList_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeSpecList_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeSpecList_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterPlusOrMinUnicodeSet(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitPlusOrMinUnicodeSet(b bsr.BSR) {}
+
+func (BaseVisitor) EnterPlusOrMinUnicodeSet_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitPlusOrMinUnicodeSet_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterPlusOrMinUnicodeSet_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitPlusOrMinUnicodeSet_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt2(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt2(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt3(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt3(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt4(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt4(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt5(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt5(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt6(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt6(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt7(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt7(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt8(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt8(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt9(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt9(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt10(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt10(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt11(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt11(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt12(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt12(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt13(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt13(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt14(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt14(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt15(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt15(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt16(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt16(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt17(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt17(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt18(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt18(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt19(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt19(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt20(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt20(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt21(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt21(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt22(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt22(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt23(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt23(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt24(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt24(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt25(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt25(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt26(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt26(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt27(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt27(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt28(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt28(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt29(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt29(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt30(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt30(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt31(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt31(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt32(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt32(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt33(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt33(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt34(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt34(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt35(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt35(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt36(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt36(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt37(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt37(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt38(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt38(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt39(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt39(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt40(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt40(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt41(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt41(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt42(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt42(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt43(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt43(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt44(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt44(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt45(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt45(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeCategory_Alt46(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeCategory_Alt46(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt2(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt2(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt3(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt3(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt4(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt4(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt5(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt5(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt6(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt6(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt7(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt7(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt8(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt8(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt9(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt9(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt10(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt10(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt11(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt11(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt12(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt12(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt13(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt13(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt14(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt14(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt15(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt15(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt16(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt16(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt17(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt17(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt18(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt18(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt19(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt19(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt20(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt20(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt21(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt21(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt22(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt22(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt23(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt23(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt24(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt24(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt25(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt25(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt26(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt26(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt27(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt27(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt28(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt28(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt29(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt29(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt30(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt30(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt31(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt31(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt32(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt32(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt33(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt33(b bsr.BSR) {}
+
+func (BaseVisitor) EnterUnicodeProperty_Alt34(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitUnicodeProperty_Alt34(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexBracket(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexBracket(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexBracket_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexBracket_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexBracket_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexBracket_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexBracket_Alt2(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexBracket_Alt2(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexBracket_Alt3(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexBracket_Alt3(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexGroup(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexGroup(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexGroup_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexGroup_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexOptional(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexOptional(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexOptional_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexOptional_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexZeroOrMore(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexZeroOrMore(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexZeroOrMore_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexZeroOrMore_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexOneOrMore(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexOneOrMore(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexOneOrMore_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexOneOrMore_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexAlternates(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexAlternates(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexAlternates_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexAlternates_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexAlternates_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexAlternates_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterRegExp(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitRegExp(b bsr.BSR) {}
+
+func (BaseVisitor) EnterRegExp_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitRegExp_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterRegExp_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitRegExp_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterRegExp_Alt2(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitRegExp_Alt2(b bsr.BSR) {}
+
+func (BaseVisitor) EnterRegExp_Alt3(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitRegExp_Alt3(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexRule(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexRule(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexRule_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexRule_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexRule_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexRule_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLexRule_Alt2(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLexRule_Alt2(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTokID(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTokID(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTokID_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTokID_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTokID_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTokID_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTokID_Alt2(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTokID_Alt2(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTokID_Alt3(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTokID_Alt3(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTokID_Alt4(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTokID_Alt4(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTokID_Alt5(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTokID_Alt5(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTokID_Alt6(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTokID_Alt6(b bsr.BSR) {}
+
+func (BaseVisitor) EnterSyntaxRule(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitSyntaxRule(b bsr.BSR) {}
+
+func (BaseVisitor) EnterSyntaxRule_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitSyntaxRule_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterSyntaxRule_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitSyntaxRule_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTemplateParams(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTemplateParams(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTemplateParams_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTemplateParams_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTemplateParams_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTemplateParams_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterSyntaxAlternates(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitSyntaxAlternates(b bsr.BSR) {}
+
+func (BaseVisitor) EnterSyntaxAlternates_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitSyntaxAlternates_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterSyntaxAlternates_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitSyntaxAlternates_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterSyntaxAlternate(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitSyntaxAlternate(b bsr.BSR) {}
+
+func (BaseVisitor) EnterSyntaxAlternate_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitSyntaxAlternate_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterSyntaxAlternate_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitSyntaxAlternate_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterSyntaxAlternate_Alt2(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitSyntaxAlternate_Alt2(b bsr.BSR) {}
+
+func (BaseVisitor) EnterSyntaxAlternate_Alt3(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitSyntaxAlternate_Alt3(b bsr.BSR) {}
+
+func (BaseVisitor) EnterSyntaxSymbols(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitSyntaxSymbols(b bsr.BSR) {}
+
+func (BaseVisitor) EnterSyntaxSymbols_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitSyntaxSymbols_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterSyntaxSymbols_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitSyntaxSymbols_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLabelledSymbol(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLabelledSymbol(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLabelledSymbol_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLabelledSymbol_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterLabelledSymbol_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitLabelledSymbol_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterSyntaxSymbol(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitSyntaxSymbol(b bsr.BSR) {}
+
+func (BaseVisitor) EnterSyntaxSymbol_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitSyntaxSymbol_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterSyntaxSymbol_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitSyntaxSymbol_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterSyntaxSymbol_Alt2(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitSyntaxSymbol_Alt2(b bsr.BSR) {}
+
+func (BaseVisitor) EnterSyntaxSymbol_Alt3(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitSyntaxSymbol_Alt3(b bsr.BSR) {}
+
+func (BaseVisitor) EnterSyntaxSymbol_Alt4(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitSyntaxSymbol_Alt4(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTemplateArgs(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTemplateArgs(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTemplateArgs_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTemplateArgs_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTemplateArgs_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTemplateArgs_Alt1(b bsr.BSR) {}
@@ -6,35 +6,42 @@ import (
 	"os"
 
 	"github.com/goccmack/gogll/v3/ast"
-	"github.com/goccmack/gogll/v3/lexer"
 )
 
-func Go(g *ast.GoGLL, l *lexer.Lexer) {
-	checkNTRefs(g, l)
+// Go prints the first semantic error of g and exits if g has semantic errors
+func Go(g *ast.GoGLL) {
+	if errs := Errors(g); len(errs) > 0 {
+		pos := errs[0].Pos
+		fmt.Printf("Semantic Error at %s line %d col %d: %s\n", pos.File, pos.Line, pos.Column, errs[0].Err)
+		os.Exit(1)
+	}
+}
+
+// Errors returns the semantic errors of g
+func Errors(g *ast.GoGLL) []*ast.Error {
+	return checkNTRefs(g)
 }
 
-func checkNTRefs(g *ast.GoGLL, l *lexer.Lexer) {
+func checkNTRefs(g *ast.GoGLL) (errs []*ast.Error) {
 	for _, r := range g.SyntaxRules {
 		for _, alt := range r.Alternates {
 			for _, sym := range alt.Symbols {
 				switch s := sym.(type) {
 				case *ast.NT:
 					if nil == g.GetSyntaxRule(s.ID()) {
-						fail(l, sym.Lext(), "No declaration of syntax rule %s", s.ID())
+						errs = append(errs, newError(r.SymbolPosition(sym), "No declaration of syntax rule %s", s.ID()))
 					}
 				case *ast.TokID:
 					if nil == g.GetLexRule(s.ID()) {
-						fail(l, sym.Lext(), "No declaration of lex rule %s", s.ID())
+						errs = append(errs, newError(r.SymbolPosition(sym), "No declaration of lex rule %s", s.ID()))
 					}
 				}
 			}
 		}
 	}
+	return
 }
 
-func fail(l *lexer.Lexer, pos int, format string, params ...interface{}) {
-	ln, col := l.GetLineColumn(pos)
-	msg := fmt.Sprintf(format, params...)
-	fmt.Printf("Semantic Error at line %d col %d: %s\n", ln, col, msg)
-	os.Exit(1)
+func newError(pos *ast.Position, format string, params ...interface{}) *ast.Error {
+	return &ast.Error{Pos: pos, Err: fmt.Errorf(format, params...)}
 }
@@ -0,0 +1,50 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package sentences
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+/*
+WriteCorpus writes sentences to dir as the seed corpus of a Go fuzz test with
+a single string argument, e.g.: for
+
+	func FuzzParse(f *testing.F) {
+		f.Fuzz(func(t *testing.T, input string) { ... })
+	}
+
+dir is testdata/fuzz/FuzzParse in the directory of the test. Each sentence is
+written to a file named after the hash of the sentence, so that writing the
+same sentence again does not add a file.
+*/
+func WriteCorpus(dir string, sentences []string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for _, s := range sentences {
+		name := fmt.Sprintf("%x", sha256.Sum256([]byte(s)))[:16]
+		content := "go test fuzz v1\nstring(" + strconv.Quote(s) + ")\n"
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
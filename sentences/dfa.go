@@ -0,0 +1,207 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package sentences
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"unicode"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/lex/items"
+	"github.com/goccmack/gogll/v3/lex/items/event"
+	"github.com/goccmack/gogll/v3/util/runeset"
+)
+
+const (
+	// maxTokenLen is the length after which a sampled token is completed by
+	// the shortest path to an accepting state
+	maxTokenLen = 12
+
+	// maxTries is the number of literals sampled for a token before it is
+	// given up because all the literals are keywords
+	maxTries = 100
+
+	noPath = -1
+)
+
+// The runes preferred when sampling: printable ASCII
+var ascii = runeset.NewRanges(runeset.Range{Lo: 0x20, Hi: 0x7e})
+
+// Runes that are not sampled: control characters and surrogates
+var excluded = runeset.NewRanges(
+	runeset.Range{Lo: 0, Hi: 0x08},
+	runeset.Range{Lo: 0x0b, Hi: 0x1f},
+	runeset.Range{Lo: 0x7f, Hi: 0x9f},
+	runeset.Range{Lo: 0xd800, Hi: 0xdfff},
+)
+
+type dfa struct {
+	accept      []string
+	transitions [][]transition
+	keywords    []*items.Keyword
+
+	// dist[tok][s] is the length of the shortest path from state s to a
+	// state accepting tok, or noPath
+	dist map[string][]int
+}
+
+type transition struct {
+	// runes is the set of runes of the transition that are not matched by
+	// a transition with higher precedence
+	runes runeset.Ranges
+	to    int
+}
+
+func newDFA(g *ast.GoGLL, ls *items.Sets) *dfa {
+	d := &dfa{
+		keywords: items.Keywords(g),
+		dist:     make(map[string][]int),
+	}
+	slits := g.GetStringLiteralsSet()
+	for _, set := range ls.Sets() {
+		d.accept = append(d.accept, set.Accept(slits))
+		var trans []transition
+		matched := runeset.Ranges{}
+		for _, t := range set.Transitions {
+			rs := event.Ranges(t.Event)
+			trans = append(trans, transition{rs.Difference(matched), t.To.No})
+			matched = matched.Union(rs)
+		}
+		d.transitions = append(d.transitions, trans)
+	}
+	return d
+}
+
+// sample returns a random literal scanned as token tok by the lexer DFA,
+// which is not a keyword
+func (d *dfa) sample(tok string, rnd *rand.Rand) (string, error) {
+	dist := d.getDist(tok)
+	if dist[0] == noPath {
+		return "", fmt.Errorf("the lexer cannot scan token %s", tok)
+	}
+	for i := 0; i < maxTries; i++ {
+		if lit := d.walk(tok, dist, rnd); !d.isKeyword(tok, lit) {
+			return lit, nil
+		}
+	}
+	return "", fmt.Errorf("cannot generate a literal of token %s that is not a keyword", tok)
+}
+
+// walk returns the runes of a random path from S0 to a state accepting tok
+func (d *dfa) walk(tok string, dist []int, rnd *rand.Rand) string {
+	var lit []rune
+	for s := 0; ; {
+		if s != 0 && d.accept[s] == tok &&
+			(len(lit) >= maxTokenLen || rnd.Intn(3) == 0) {
+			return string(lit)
+		}
+		var next []transition
+		for _, t := range d.transitions[s] {
+			if t.runes.Empty() || dist[t.to] == noPath {
+				continue
+			}
+			if s == 0 && t.runes.Difference(whitespace).Empty() {
+				continue
+			}
+			if len(lit) < maxTokenLen || dist[t.to] < dist[s] {
+				next = append(next, t)
+			}
+		}
+		if len(next) == 0 {
+			// s accepts tok
+			return string(lit)
+		}
+		t := next[rnd.Intn(len(next))]
+		rs := t.runes
+		if s == 0 {
+			// The lexer skips whitespace before a token
+			rs = rs.Difference(whitespace)
+		}
+		lit = append(lit, randRune(rs, rnd))
+		s = t.to
+	}
+}
+
+var whitespace = runeset.FromRangeTable(unicode.White_Space)
+
+// randRune returns a random rune from rs, preferably printable ASCII.
+// rs is not empty.
+func randRune(rs runeset.Ranges, rnd *rand.Rand) rune {
+	if a := rs.Intersection(ascii); !a.Empty() && rnd.Intn(10) > 0 {
+		rs = a
+	} else if r := rs.Difference(excluded); !r.Empty() {
+		rs = r
+	}
+	n := 0
+	for _, r := range rs {
+		n += int(r.Hi-r.Lo) + 1
+	}
+	i := rnd.Intn(n)
+	for _, r := range rs {
+		if i <= int(r.Hi-r.Lo) {
+			return r.Lo + rune(i)
+		}
+		i -= int(r.Hi-r.Lo) + 1
+	}
+	panic("unreachable")
+}
+
+// getDist returns the length of the shortest path from every state to a
+// state accepting tok
+func (d *dfa) getDist(tok string) []int {
+	if dist, exist := d.dist[tok]; exist {
+		return dist
+	}
+	dist := make([]int, len(d.accept))
+	for s := range dist {
+		if s != 0 && d.accept[s] == tok {
+			dist[s] = 0
+		} else {
+			dist[s] = noPath
+		}
+	}
+	for again := true; again; {
+		again = false
+		for s, trans := range d.transitions {
+			for _, t := range trans {
+				if t.runes.Empty() || dist[t.to] == noPath {
+					continue
+				}
+				if dist[s] == noPath || dist[t.to]+1 < dist[s] {
+					dist[s] = dist[t.to] + 1
+					again = true
+				}
+			}
+		}
+	}
+	d.dist[tok] = dist
+	return dist
+}
+
+// isKeyword returns true if the lexer scans lit, scanned as token tok, as a
+// keyword
+func (d *dfa) isKeyword(tok, lit string) bool {
+	for _, kw := range d.keywords {
+		if kw.Class.ID() != tok {
+			continue
+		}
+		if kw.ID() == lit || kw.CaseInsensitive && kw.Key() == strings.ToLower(lit) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,158 @@
+//  Copyright 2020 Marius Ackerman
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+/*
+Package sentences generates random sentences of a grammar.
+
+The syntax rules are expanded top down from a start symbol. The depth of the
+derivation tree is bounded by choosing only alternates whose shortest
+derivation (frstflw.MinDepth) fits in the remaining depth. The tokens are
+sampled from the lexer DFA (lex/items) of the grammar and separated by a space.
+
+Sampling the lexer DFA does not take the longest match rule into account
+across token boundaries, so a generated sentence should be checked with a
+parser before it is used.
+*/
+package sentences
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"unicode"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/frstflw"
+	"github.com/goccmack/gogll/v3/lex/items"
+)
+
+// Generator generates random sentences of a grammar
+type Generator struct {
+	g    *ast.GoGLL
+	ff   *frstflw.FF
+	dfa  *dfa
+	rand *rand.Rand
+}
+
+// New returns a Generator for g. ls is the lexer DFA of g. The sentences
+// generated for the same seed are the same.
+func New(g *ast.GoGLL, ff *frstflw.FF, ls *items.Sets, seed int64) *Generator {
+	return &Generator{
+		g:    g,
+		ff:   ff,
+		dfa:  newDFA(g, ls),
+		rand: rand.New(rand.NewSource(seed)),
+	}
+}
+
+/*
+Sentence returns a random sentence derived from nonterminal start with a
+derivation tree of at most maxDepth levels, or of the depth of the shortest
+derivation of start if that is deeper.
+
+If the grammar has no syntax rules start is ignored and Sentence returns a
+sequence of at most maxDepth random tokens.
+*/
+func (gen *Generator) Sentence(start string, maxDepth int) (string, error) {
+	var toks []string
+	if len(gen.g.SyntaxRules) == 0 {
+		lexRules := gen.tokenRules()
+		if len(lexRules) == 0 {
+			return "", fmt.Errorf("the grammar has no tokens")
+		}
+		n := 1
+		if maxDepth > 1 {
+			n += gen.rand.Intn(maxDepth)
+		}
+		for i := 0; i < n; i++ {
+			toks = append(toks, lexRules[gen.rand.Intn(len(lexRules))])
+		}
+	} else {
+		if gen.g.GetSyntaxRule(start) == nil {
+			return "", fmt.Errorf("invalid start symbol %s", start)
+		}
+		if gen.ff.MinDepth(start) == frstflw.NoDerivation {
+			return "", fmt.Errorf("%s derives no string of tokens", start)
+		}
+		if d := gen.ff.MinDepth(start); d > maxDepth {
+			maxDepth = d
+		}
+		toks = gen.derive(start, maxDepth, nil)
+	}
+
+	lits := make([]string, len(toks))
+	for i, tok := range toks {
+		lit, err := gen.Token(tok)
+		if err != nil {
+			return "", err
+		}
+		lits[i] = lit
+	}
+	return strings.Join(lits, " "), nil
+}
+
+// tokenRules returns the IDs of the lex rules that are not suppressed
+func (gen *Generator) tokenRules() (ids []string) {
+	for _, r := range gen.g.LexRules {
+		if !r.Suppress {
+			ids = append(ids, r.TokID.ID())
+		}
+	}
+	return
+}
+
+// derive appends the terminals of a random derivation of nt with at most
+// depth levels to toks. depth >= MinDepth(nt).
+func (gen *Generator) derive(nt string, depth int, toks []string) []string {
+	var alts []*ast.SyntaxAlternate
+	for _, a := range gen.g.GetSyntaxRule(nt).Alternates {
+		if gen.ff.MinDepthOfString(a.GetSymbols()) < depth {
+			alts = append(alts, a)
+		}
+	}
+	a := alts[gen.rand.Intn(len(alts))]
+	for _, sym := range a.GetSymbols() {
+		if gen.g.Terminals.Contain(sym) {
+			toks = append(toks, sym)
+		} else {
+			toks = gen.derive(sym, depth-1, toks)
+		}
+	}
+	return toks
+}
+
+/*
+Token returns a random literal of the token with ID tok. The literal of a
+string literal token is the string literal, with random case if the string
+literal is case-insensitive. The literal of a lex rule token is sampled from
+the lexer DFA and is not a keyword.
+*/
+func (gen *Generator) Token(tok string) (string, error) {
+	if sl, exist := gen.g.StringLiterals[tok]; exist {
+		lit := sl.Value()
+		if !sl.CaseInsensitive {
+			return string(lit), nil
+		}
+		rs := make([]rune, len(lit))
+		for i, r := range lit {
+			if gen.rand.Intn(2) == 0 {
+				rs[i] = unicode.ToUpper(r)
+			} else {
+				rs[i] = unicode.ToLower(r)
+			}
+		}
+		return string(rs), nil
+	}
+	return gen.dfa.sample(tok, gen.rand)
+}
@@ -0,0 +1,115 @@
+package sentences_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/goccmack/gogll/v3/ast"
+	"github.com/goccmack/gogll/v3/frstflw"
+	"github.com/goccmack/gogll/v3/internal/loader"
+	"github.com/goccmack/gogll/v3/interp"
+	"github.com/goccmack/gogll/v3/lex/items"
+	"github.com/goccmack/gogll/v3/sentences"
+)
+
+const src = `package "sentences"
+
+Query : i"select" Columns i"from" id Where ;
+
+Columns : "*" | Column | Column "," Columns ;
+
+Column : id | id "." id ;
+
+Where : empty | i"where" Cond ;
+
+Cond : Cond "and" Cond | "(" Cond ")" | Column "=" Value ;
+
+Value : num | string ;
+
+@id : letter {letter | number | '_'} ;
+
+num : number {number} ;
+
+string : '\'' {not "'"} '\'' ;
+`
+
+func load(t *testing.T) (*ast.GoGLL, *frstflw.FF, *interp.Grammar, *items.Sets) {
+	gr, errs := loader.Source("test.md", src)
+	if errs != nil {
+		t.Fatal(errs[0])
+	}
+	ls := gr.LexSets()
+	return gr.AST, gr.FF, interp.New(gr.AST, gr.FF, gr.GSlots(), ls), ls
+}
+
+func TestMinDepth(t *testing.T) {
+	_, ff, _, _ := load(t)
+	for sym, exp := range map[string]int{"Query": 2, "Cond": 2, "Column": 1, "id": 0} {
+		if d := ff.MinDepth(sym); d != exp {
+			t.Errorf("MinDepth(%s): expected %d, got %d", sym, exp, d)
+		}
+	}
+}
+
+func TestSentence(t *testing.T) {
+	g, ff, gr, ls := load(t)
+	gen := sentences.New(g, ff, ls, 1)
+	for i := 0; i < 50; i++ {
+		snt, err := gen.Sentence("Query", 6)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lex := gr.NewLexer([]rune(snt))
+		if len(lex.Errors) > 0 {
+			t.Fatalf("%q: %s", snt, lex.Errors[0])
+		}
+		if _, errs := gr.Parse("Query", lex); errs != nil {
+			t.Fatalf("%q: %s", snt, errs[0])
+		}
+	}
+
+	// The shortest derivation is used if maxDepth is too small
+	snt, err := gen.Sentence("Query", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(strings.ToLower(snt), "where") || strings.Contains(snt, ",") {
+		t.Fatalf("expected a query without a condition and with one column, got %q", snt)
+	}
+}
+
+func TestSameSeed(t *testing.T) {
+	g, ff, _, ls := load(t)
+	gen1, gen2 := sentences.New(g, ff, ls, 42), sentences.New(g, ff, ls, 42)
+	for i := 0; i < 10; i++ {
+		s1, _ := gen1.Sentence("Query", 8)
+		s2, _ := gen2.Sentence("Query", 8)
+		if s1 != s2 {
+			t.Fatalf("%q != %q", s1, s2)
+		}
+	}
+}
+
+func TestWriteCorpus(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "FuzzParse")
+	if err := sentences.WriteCorpus(dir, []string{"select * from t where x = '1'", "select * from t where x = '1'"}); err != nil {
+		t.Fatal(err)
+	}
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fis) != 1 {
+		t.Fatalf("expected 1 corpus file, got %d", len(fis))
+	}
+	buf, err := ioutil.ReadFile(filepath.Join(dir, fis[0].Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := "go test fuzz v1\nstring(\"select * from t where x = '1'\")\n"
+	if string(buf) != exp {
+		t.Fatalf("expected:\n%s\ngot:\n%s", exp, buf)
+	}
+}
@@ -77,7 +77,7 @@ func (n *SymbolNode) Label() string {
 }
 
 func (n *PackedNode) Label() string {
-	return fmt.Sprintf("\"%s,%d\"", slotString(n.NT, n.Body, n.Pos), n.Pivot)
+	return fmt.Sprintf("\"%s,%d,%d,%d\"", slotString(n.NT, n.Body, n.Pos), n.Lext, n.Pivot, n.Rext)
 }
 
 func (n *IntermediateNode) String() string {
@@ -95,18 +95,40 @@ func (n *PackedNode) String() string {
 //---- Dot ----
 
 type dotBuilder struct {
-	iNodes map[*IntermediateNode]bool
-	pNodes map[string]bool // index PN Label
-	sNodes map[*SymbolNode]bool
-	w      *bytes.Buffer
+	nodes map[string]bool // index = node.Label()
+	w     *bytes.Buffer
 }
 
+func (bld *dotBuilder) add(n Node) {
+	// fmt.Printf("dotBuilder.add: %s\n", n.Label())
+	if bld.done(n) {
+		panic(fmt.Sprintf("duplicate %s", n.Label()))
+	}
+	// fmt.Println(" Before:")
+	// bld.dumpNodes()
+
+	bld.nodes[n.Label()] = true
+
+	// fmt.Println(" After:")
+	// bld.dumpNodes()
+	// fmt.Println()
+}
+
+func (bld *dotBuilder) done(n Node) bool {
+	return bld.nodes[n.Label()]
+}
+
+func (bld *dotBuilder) dumpNodes() {
+	for n, t := range bld.nodes {
+		fmt.Printf("  %s = %t\n", n, t)
+	}
+}
+
+// DotFile writes a graph representation of the SPPF in dot notation to file
 func (root *SymbolNode) DotFile(file string) {
 	bld := &dotBuilder{
-		iNodes: make(map[*IntermediateNode]bool),
-		pNodes: make(map[string]bool),
-		sNodes: make(map[*SymbolNode]bool),
-		w:      new(bytes.Buffer),
+		nodes: make(map[string]bool),
+		w:     new(bytes.Buffer),
 	}
 	fmt.Fprintln(bld.w, "digraph SPPF {")
 	root.dot(bld)
@@ -117,23 +139,60 @@ func (root *SymbolNode) DotFile(file string) {
 func (n *IntermediateNode) dot(bld *dotBuilder) {
 	// fmt.Println("in.dot", n.Label())
 
+	if bld.done(n) {
+		return
+	}
+	bld.add(n)
+
 	fmt.Fprintf(bld.w, "%s [shape=box]\n", n.Label())
 
 	for _, c := range n.Children {
 		fmt.Fprintf(bld.w, "%s -> %s\n", n.Label(), c.Label())
-		if !bld.pNodes[c.Label()] {
+		if !bld.done(c) {
 			c.dot(bld)
 		}
 	}
 }
 
+func (n *PackedNode) dot(bld *dotBuilder) {
+	// fmt.Println("pn.dot", n.Label(), "exist", bld.nodes[n.Label()])
+
+	if bld.done(n) {
+		return
+	}
+	bld.add(n)
+
+	fmt.Fprintf(bld.w, "%s [shape=box,style=rounded,penwidth=3]\n", n.Label())
+	if n.LeftChild != nil {
+		if !bld.done(n.LeftChild) {
+			n.LeftChild.dot(bld)
+		}
+		fmt.Fprintf(bld.w, "%s -> %s\n", n.Label(), n.LeftChild.Label())
+	}
+	if n.RightChild != nil {
+		if !bld.done(n.RightChild) {
+			n.RightChild.dot(bld)
+		}
+		fmt.Fprintf(bld.w, "%s -> %s\n", n.Label(), n.RightChild.Label())
+	}
+	if n.LeftChild != nil && n.RightChild != nil {
+		fmt.Fprintf(bld.w, "%s,%s\n", n.LeftChild.Label(), n.RightChild.Label())
+	}
+}
+
 func (n *SymbolNode) dot(bld *dotBuilder) {
-	// fmt.Println("sn.dot", n.Label())
+	// fmt.Println("sn.dot", n.Label(), "done=", bld.done(n))
+
+	if bld.done(n) {
+		return
+	}
+	bld.add(n)
 
 	fmt.Fprintln(bld.w, n.Label())
 	for _, pn := range n.Children {
+		// fmt.Printf("  child: %s\n", pn.Label())
 		fmt.Fprintf(bld.w, "%s -> %s\n", n.Label(), pn.Label())
-		if !bld.pNodes[pn.Label()] {
+		if !bld.done(pn) {
 			pn.dot(bld)
 		}
 	}
@@ -147,21 +206,3 @@ func (n *SymbolNode) dot(bld *dotBuilder) {
 
 }
 
-func (n *PackedNode) dot(bld *dotBuilder) {
-	// fmt.Println("pn.dot", n.Label(), "exist", bld.pNodes[n.Label()])
-
-	fmt.Fprintf(bld.w, "%s [shape=box,style=rounded]\n", n.Label())
-	if n.LeftChild != nil {
-		n.LeftChild.dot(bld)
-		fmt.Fprintf(bld.w, "%s -> %s\n", n.Label(), n.LeftChild.Label())
-	}
-	if n.RightChild != nil {
-		n.RightChild.dot(bld)
-		fmt.Fprintf(bld.w, "%s -> %s\n", n.Label(), n.RightChild.Label())
-	}
-	if n.LeftChild != nil && n.RightChild != nil {
-		fmt.Fprintf(bld.w, "%s,%s\n", n.LeftChild.Label(), n.RightChild.Label())
-	}
-	bld.pNodes[n.Label()] = true
-}
-
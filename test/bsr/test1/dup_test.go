@@ -0,0 +1,35 @@
+package example01
+
+import (
+	"testing"
+
+	"github.com/goccmack/gogll/v3/test/bsr/test1/lexer"
+	"github.com/goccmack/gogll/v3/test/bsr/test1/parser"
+	"github.com/goccmack/gogll/v3/test/bsr/test1/parser/bsr"
+)
+
+// Adding a BSR that is already in the set must not add it again to the
+// children of its parent.
+func TestAddTwice(t *testing.T) {
+	pf, errs := parser.Parse(lexer.New([]rune("a b c a b c")))
+	if errs != nil {
+		t.Fatal(errs[0])
+	}
+	for _, b := range pf.GetAll() {
+		pf.Add(b.Label, b.LeftExtent(), b.Pivot(), b.RightExtent())
+	}
+	for _, b := range pf.GetAll() {
+		for i, sym := range b.Label.Symbols() {
+			if !sym.IsNonTerminal() {
+				continue
+			}
+			children := map[bsr.BSR]bool{}
+			for _, c := range b.GetNTChildrenI(i) {
+				if children[c] {
+					t.Errorf("duplicate child %d %s of %s", i, c, b)
+				}
+				children[c] = true
+			}
+		}
+	}
+}
@@ -3,7 +3,8 @@
 package lexer
 
 import (
-	// "fmt"
+	"bytes"
+	"fmt"
 	"io/ioutil"
 	"strings"
 	"unicode"
@@ -113,8 +114,51 @@ type Lexer struct {
 
 	// Tokens is the slice of tokens constructed by the lexer from I
 	Tokens []*token.Token
+
+	// Errors contains the lexical errors in I in order of occurrence
+	Errors []*LexError
+
+	// trivia is true if the lexer attaches trivia to the tokens
+	trivia bool
+}
+
+// LexError is a lexical error: the lexer could not scan a token from the input.
+type LexError struct {
+	// Token is the Error token in Lexer.Tokens, which contains the input 
+	// skipped by the lexer.
+	Token *token.Token
+
+	// Pos is the position of the offending rune in the input. 
+	// If the lexer reached the end of the input Pos == len(input)
+	Pos int
+
+	// The line and column of the offending rune in the input
+	Line, Column int
+
+	// Rune is the offending rune. It is -1 at the end of the input.
+	Rune rune
+
+	// Partial contains the token types that were partially matched when
+	// the error occurred. It is empty if no token matches the first rune.
+	Partial []token.Type
 }
 
+// Recovery is the strategy used by the lexer to continue after a lexical error
+type Recovery int
+
+const (
+	// SkipRune continues scanning at the rune after the offending rune
+	SkipRune Recovery = iota
+
+	// SkipToSpace continues scanning at the first whitespace after the
+	// offending rune
+	SkipToSpace
+)
+
+// ErrorRecovery is the error recovery strategy of the lexers created 
+// after it is set. The default is SkipRune.
+var ErrorRecovery = SkipRune
+
 /*
 NewFile constructs a Lexer created from the input file, fname. 
 
@@ -125,6 +169,16 @@ If the input file is a normal text file NewFile treats all text in the inputfile
 as input text.
 */
 func NewFile(fname string) *Lexer {
+	return New(readFile(fname))
+}
+
+// NewFileWithTrivia is like NewFile but attaches trivia to the tokens, 
+// like NewWithTrivia.
+func NewFileWithTrivia(fname string) *Lexer {
+	return NewWithTrivia(readFile(fname))
+}
+
+func readFile(fname string) []rune {
 	buf, err := ioutil.ReadFile(fname)
 	if err != nil {
 		panic(err)
@@ -133,7 +187,7 @@ func NewFile(fname string) *Lexer {
 	if strings.HasSuffix(fname, ".md") {
 		loadMd(input)
 	}
-	return New(input)
+	return input
 }
 
 func loadMd(input []rune) {
@@ -170,21 +224,123 @@ func New(input []rune) *Lexer {
 		I:      input,
 		Tokens: make([]*token.Token, 0, 2048),
 	}
-	lext := 0
-	for lext < len(lex.I) {
-		for lext < len(lex.I) && unicode.IsSpace(lex.I[lext]) {
+	lex.Tokens = append(lex.Tokens, lex.scanTokens(0, nil, nil)...)
+	return lex
+}
+
+/*
+NewWithTrivia constructs a Lexer from a slice of runes, like New, but keeps
+the whitespace and suppressed tokens between the tokens as trivia attached
+to the neighbouring tokens.
+
+The trailing trivia of a token extends up to and including the end of its line.
+The rest of the trivia between two tokens is the leading trivia of the second
+token. Trivia at the end of the input is the leading trivia of the EOF token.
+The input can be reproduced by concatenating Token.FullLiteral() of all the
+tokens.
+*/
+func NewWithTrivia(input []rune) *Lexer {
+	lex := &Lexer{
+		I:      input,
+		Tokens: make([]*token.Token, 0, 2048),
+		trivia: true,
+	}
+	lex.Tokens = append(lex.Tokens, lex.scanTokens(0, nil, nil)...)
+	return lex
+}
+
+/*
+scanTokens scans the tokens from position lext in the input up to and 
+including the EOF token, or the first token for which stop returns true.
+prev is the token before lext. Its trailing trivia is set if l keeps trivia.
+*/
+func (l *Lexer) scanTokens(lext int, prev *token.Token, stop func(*token.Token) bool) (toks []*token.Token) {
+	for {
+		var tok *token.Token
+		if l.trivia {
+			tok = l.scanWithTrivia(lext, prev)
+		} else {
+			tok = l.scanToken(lext)
+		}
+		toks = append(toks, tok)
+		if tok.Type() == token.EOF || (stop != nil && stop(tok)) {
+			return
+		}
+		lext, prev = tok.Rext(), tok
+	}
+}
+
+// scanToken returns the first token after position lext in the input that
+// is not suppressed.
+func (l *Lexer) scanToken(lext int) *token.Token {
+	for {
+		for lext < len(l.I) && unicode.IsSpace(l.I[lext]) {
 			lext++
 		}
-		if lext < len(lex.I) {
-			tok := lex.scan(lext)
-			lext = tok.Rext()
-			if !tok.Suppress() {
-				lex.addToken(tok)
+		if lext >= len(l.I) {
+			return token.New(token.EOF, len(l.I), len(l.I), l.I)
+		}
+		tok := l.scan(lext)
+		if tok.Type() == token.Error {
+			tok = l.lexError(tok)
+		}
+		if !tok.Suppress() {
+			return tok
+		}
+		lext = tok.Rext()
+	}
+}
+
+// scanWithTrivia returns the first token after position lext in the input
+// that is not suppressed. It attaches the trivia from lext to the token 
+// to prev and the token.
+func (l *Lexer) scanWithTrivia(lext int, prev *token.Token) *token.Token {
+	triviaLext, suppressed := lext, []*token.Token{}
+	var tok *token.Token
+	for tok == nil {
+		for lext < len(l.I) && unicode.IsSpace(l.I[lext]) {
+			lext++
+		}
+		if lext >= len(l.I) {
+			tok = token.New(token.EOF, len(l.I), len(l.I), l.I)
+		} else if t := l.scan(lext); t.Suppress() {
+			suppressed = append(suppressed, t)
+			lext = t.Rext()
+		} else {
+			if t.Type() == token.Error {
+				t = l.lexError(t)
 			}
+			tok = t
 		}
 	}
-	lex.add(token.EOF, len(input), len(input))
-	return lex
+	split, numTrailing := triviaLext, 0
+	if prev != nil {
+		split = l.endOfLine(triviaLext, tok.Lext(), suppressed)
+		for numTrailing < len(suppressed) && suppressed[numTrailing].Lext() < split {
+			numTrailing++
+		}
+		prev.SetTrailingTrivia(split, suppressed[:numTrailing])
+	}
+	tok.SetLeadingTrivia(split, suppressed[numTrailing:])
+	return tok
+}
+
+// endOfLine returns the position after the first newline in the trivia from
+// lext to rext, that is not part of a suppressed token. It returns rext if
+// there is no such newline.
+func (l *Lexer) endOfLine(lext, rext int, suppressed []*token.Token) int {
+	for i, j := lext, 0; i < rext; {
+		if j < len(suppressed) && suppressed[j].Lext() == i {
+			i = suppressed[j].Rext()
+			j++
+			continue
+		}
+		if l.I[i] == '\n' {
+			return i + 1
+		}
+		i++
+	}
+	return rext
 }
 
 func (l *Lexer) scan(i int) *token.Token {
@@ -211,6 +367,187 @@ func (l *Lexer) scan(i int) *token.Token {
 	return tok
 }
 
+/*
+Edit describes how an edit of the input by Lexer.Edit changed the tokens.
+
+Tokens[First:First+NumOld] before the edit were replaced by the re-lexed
+Tokens[First:First+NumNew]. The index of every token after them changed by
+NumNew-NumOld.
+*/
+type Edit struct {
+	// The edit of the input: Deleted runes at Offset were replaced by 
+	// Inserted runes.
+	Offset, Deleted, Inserted int
+
+	// The re-lexed window of tokens
+	First, NumOld, NumNew int
+}
+
+/*
+Edit replaces the deleted runes at offset in the input with inserted and 
+re-lexes only the tokens damaged by the edit. The tokens after the damaged 
+window are moved and their indices shifted. Lexical errors are updated.
+*/
+func (l *Lexer) Edit(offset, deleted int, inserted []rune) *Edit {
+	input := make([]rune, 0, len(l.I)-deleted+len(inserted))
+	input = append(input, l.I[:offset]...)
+	input = append(input, inserted...)
+	input = append(input, l.I[offset+deleted:]...)
+	delta := len(inserted) - deleted
+
+	// The first damaged token is the first token that ends at or after offset,
+	// because the lexer reads the rune after a token to find its end.
+	first := 0
+	for first < len(l.Tokens)-1 && l.Tokens[first].Rext() < offset {
+		first++
+	}
+
+	old, oldErrors := l.Tokens, l.Errors
+	l.I, l.Errors = input, nil
+	l.Tokens = make([]*token.Token, 0, len(old)+len(inserted))
+	for _, tok := range old[:first] {
+		l.Tokens = append(l.Tokens, tok.Shift(0, input))
+	}
+
+	lext, prev := 0, (*token.Token)(nil)
+	if first > 0 {
+		prev = l.Tokens[first-1]
+		lext = prev.Rext()
+	}
+	// Re-lex until a token is the same as an old token after the edit.
+	j := first
+	relexed := l.scanTokens(lext, prev, func(tok *token.Token) bool {
+		for j < len(old) && 
+			(old[j].Lext() < offset+deleted || old[j].Lext()+delta < tok.Lext()) {
+			j++
+		}
+		return j < len(old) && old[j].Type() == tok.Type() &&
+			old[j].Lext()+delta == tok.Lext() && old[j].Rext()+delta == tok.Rext()
+	})
+	numOld := len(old) - first
+	if last := relexed[len(relexed)-1]; last.Type() != token.EOF {
+		numOld = j - first + 1
+		if l.trivia {
+			tok := old[j].Shift(delta, input)
+			last.SetTrailingTrivia(tok.Rext()+len(tok.TrailingTrivia()), tok.TrailingSuppressed())
+		}
+	}
+	l.Tokens = append(l.Tokens, relexed...)
+	for _, tok := range old[first+numOld:] {
+		l.Tokens = append(l.Tokens, tok.Shift(delta, input))
+	}
+
+	l.updateErrors(old, oldErrors, first, numOld, len(relexed), delta)
+
+	return &Edit{
+		Offset:   offset,
+		Deleted:  deleted,
+		Inserted: len(inserted),
+		First:    first,
+		NumOld:   numOld,
+		NumNew:   len(relexed),
+	}
+}
+
+// updateErrors moves the lexical errors, oldErrors, before and after the 
+// re-lexed tokens to the new tokens. l.Errors contains the errors of the
+// re-lexed tokens.
+func (l *Lexer) updateErrors(old []*token.Token, oldErrors []*LexError, 
+	first, numOld, numNew, delta int) {
+
+	index := make(map[*token.Token]int, len(oldErrors))
+	for i, tok := range old {
+		index[tok] = i
+	}
+	var before, after []*LexError
+	for _, err := range oldErrors {
+		err1 := *err
+		switch i := index[err.Token]; {
+		case i < first:
+			err1.Token = l.Tokens[i]
+			before = append(before, &err1)
+		case i >= first+numOld:
+			err1.Token = l.Tokens[i+numNew-numOld]
+			err1.Pos += delta
+			err1.Line, err1.Column = l.GetLineColumn(err1.Pos)
+			after = append(after, &err1)
+		}
+	}
+	l.Errors = append(append(before, l.Errors...), after...)
+}
+
+/*
+Unchanged returns the token extents before the edit, e, of the tokens from 
+lext to rext after the edit. ok is false if the tokens from lext up to and 
+including rext overlap the re-lexed tokens. 
+
+A subtree of the parse forest with unchanged extents is not changed by the edit.
+*/
+func (e *Edit) Unchanged(lext, rext int) (oldLext, oldRext int, ok bool) {
+	switch {
+	case rext < e.First:
+		return lext, rext, true
+	case lext >= e.First+e.NumNew:
+		return lext - e.NumNew + e.NumOld, rext - e.NumNew + e.NumOld, true
+	}
+	return 0, 0, false
+}
+
+/*
+lexError records the LexError for the Error token, tok, and returns the Error
+token extended by the error recovery strategy, ErrorRecovery.
+*/
+func (l *Lexer) lexError(tok *token.Token) *token.Token {
+	s, pos := state(0), tok.Lext()
+	for pos < len(l.I) {
+		next := nextState[s](l.I[pos])
+		if next == nullState {
+			break
+		}
+		s, pos = next, pos+1
+	}
+	if ErrorRecovery == SkipToSpace {
+		rext := tok.Rext()
+		for rext < len(l.I) && !unicode.IsSpace(l.I[rext]) {
+			rext++
+		}
+		tok = token.New(token.Error, tok.Lext(), rext, l.I)
+	}
+	err := &LexError{
+		Token:   tok,
+		Pos:     pos,
+		Rune:    -1,
+		Partial: partial[s],
+	}
+	if pos < len(l.I) {
+		err.Rune = l.I[pos]
+	}
+	err.Line, err.Column = l.GetLineColumn(pos)
+	l.Errors = append(l.Errors, err)
+	return tok
+}
+
+func (e *LexError) Error() string {
+	w := new(bytes.Buffer)
+	fmt.Fprintf(w, "Lexical error at line %d col %d: ", e.Line, e.Column)
+	if e.Rune == -1 {
+		fmt.Fprint(w, "unexpected end of input")
+	} else {
+		fmt.Fprintf(w, "unexpected %q", e.Rune)
+	}
+	if len(e.Partial) > 0 {
+		fmt.Fprint(w, " while scanning one of [")
+		for i, t := range e.Partial {
+			if i > 0 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprint(w, t.ID())
+		}
+		fmt.Fprint(w, "]")
+	}
+	return w.String()
+}
+
 func escape(r rune) string {
 	switch r {
 	case '"':
@@ -288,6 +625,13 @@ var accept = []token.Type{
 	token.T_2, 
 }
 
+var partial = [][]token.Type{ 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ }, 
+}
+
 var nextState = []func(r rune) state{ 
 	// Set0
 	func(r rune) state {
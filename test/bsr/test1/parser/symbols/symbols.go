@@ -35,6 +35,18 @@ const(
 
 type Symbols []Symbol
 
+func (ss Symbols) Equal(ss1 Symbols) bool {
+	if len(ss) != len(ss1) {
+		return false
+	}
+	for i, s := range ss {
+		if s.String() != ss1[i].String() {
+			return false
+		}
+	}
+	return true
+}
+
 func (ss Symbols) String() string {
 	w := new(bytes.Buffer)
 	for i, s := range ss {
@@ -70,6 +82,21 @@ func (t T) String() string {
 	return tToString[t]
 }
 
+// IsNT returns true iff sym is a non-terminal symbol of the grammar
+func IsNT(sym string) bool {
+	_, exist := stringNT[sym]
+	return exist
+}
+
+// ToNT returns the NT value of sym or panics if sym is not a non-terminal of the grammar
+func ToNT(sym string) NT {
+	nt, exist := stringNT[sym]
+	if !exist {
+		panic(fmt.Sprintf("No NT: %s", sym))
+	}
+	return nt
+}
+
 var ntToString = []string { 
 	"A", /* NT_A */
 	"B", /* NT_B */
@@ -0,0 +1,302 @@
+
+// Package slot is generated by gogll. Do not edit. 
+package slot
+
+import(
+	"bytes"
+	"fmt"
+	
+	"github.com/goccmack/gogll/v3/test/bsr/test2/parser/symbols"
+)
+
+type Label int
+
+const(
+	Expr0R0 Label = iota
+	Expr0R1
+	Expr1R0
+	Expr1R1
+	Expr2R0
+	Expr2R1
+	Expr2R2
+	Expr2R3
+	Stmt0R0
+	Stmt0R1
+	Stmt0R2
+	Stmt0R3
+	Stmt0R4
+	Stmts0R0
+	Stmts0R1
+	Stmts1R0
+	Stmts1R1
+	Stmts1R2
+)
+
+type Slot struct {
+	NT      symbols.NT
+	Alt     int
+	Pos     int
+	Symbols symbols.Symbols
+	Label 	Label
+}
+
+type Index struct {
+	NT      symbols.NT
+	Alt     int
+	Pos     int
+}
+
+func GetAlternates(nt symbols.NT) []Label {
+	alts, exist := alternates[nt]
+	if !exist {
+		panic(fmt.Sprintf("Invalid NT %s", nt))
+	}
+	return alts
+}
+
+func GetLabel(nt symbols.NT, alt, pos int) Label {
+	l, exist := slotIndex[Index{nt,alt,pos}]
+	if exist {
+		return l
+	}
+	panic(fmt.Sprintf("Error: no slot label for NT=%s, alt=%d, pos=%d", nt, alt, pos))
+}
+
+func (l Label) EoR() bool {
+	return l.Slot().EoR()
+}
+
+func (l Label) Head() symbols.NT {
+	return l.Slot().NT
+}
+
+func (l Label) Index() Index {
+	s := l.Slot()
+	return Index{s.NT, s.Alt, s.Pos}
+}
+
+func (l Label) Alternate() int {
+	return l.Slot().Alt
+}
+
+func (l Label) Pos() int {
+	return l.Slot().Pos
+}
+
+func (l Label) Slot() *Slot {
+	s, exist := slots[l]
+	if !exist {
+		panic(fmt.Sprintf("Invalid slot label %d", l))
+	}
+	return s
+}
+
+func (l Label) String() string {
+	return l.Slot().String()
+}
+
+func (l Label) Symbols() symbols.Symbols {
+	return l.Slot().Symbols
+}
+
+func (s *Slot) EoR() bool {
+	return s.Pos >= len(s.Symbols)
+}
+
+func (s *Slot) String() string {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "%s : ", s.NT)
+	for i, sym := range s.Symbols {
+		if i == s.Pos {
+			fmt.Fprintf(buf, "∙")
+		}
+		fmt.Fprintf(buf, "%s ", sym)
+	}
+	if s.Pos >= len(s.Symbols) {
+		fmt.Fprintf(buf, "∙")
+	}
+	return buf.String()
+}
+
+var slots = map[Label]*Slot{ 
+	Expr0R0: {
+		symbols.NT_Expr, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_4,
+		}, 
+		Expr0R0, 
+	},
+	Expr0R1: {
+		symbols.NT_Expr, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_4,
+		}, 
+		Expr0R1, 
+	},
+	Expr1R0: {
+		symbols.NT_Expr, 1, 0, 
+		symbols.Symbols{  
+			symbols.T_5,
+		}, 
+		Expr1R0, 
+	},
+	Expr1R1: {
+		symbols.NT_Expr, 1, 1, 
+		symbols.Symbols{  
+			symbols.T_5,
+		}, 
+		Expr1R1, 
+	},
+	Expr2R0: {
+		symbols.NT_Expr, 2, 0, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.NT_Expr, 
+			symbols.T_1,
+		}, 
+		Expr2R0, 
+	},
+	Expr2R1: {
+		symbols.NT_Expr, 2, 1, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.NT_Expr, 
+			symbols.T_1,
+		}, 
+		Expr2R1, 
+	},
+	Expr2R2: {
+		symbols.NT_Expr, 2, 2, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.NT_Expr, 
+			symbols.T_1,
+		}, 
+		Expr2R2, 
+	},
+	Expr2R3: {
+		symbols.NT_Expr, 2, 3, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.NT_Expr, 
+			symbols.T_1,
+		}, 
+		Expr2R3, 
+	},
+	Stmt0R0: {
+		symbols.NT_Stmt, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_4, 
+			symbols.T_3, 
+			symbols.NT_Expr, 
+			symbols.T_2,
+		}, 
+		Stmt0R0, 
+	},
+	Stmt0R1: {
+		symbols.NT_Stmt, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_4, 
+			symbols.T_3, 
+			symbols.NT_Expr, 
+			symbols.T_2,
+		}, 
+		Stmt0R1, 
+	},
+	Stmt0R2: {
+		symbols.NT_Stmt, 0, 2, 
+		symbols.Symbols{  
+			symbols.T_4, 
+			symbols.T_3, 
+			symbols.NT_Expr, 
+			symbols.T_2,
+		}, 
+		Stmt0R2, 
+	},
+	Stmt0R3: {
+		symbols.NT_Stmt, 0, 3, 
+		symbols.Symbols{  
+			symbols.T_4, 
+			symbols.T_3, 
+			symbols.NT_Expr, 
+			symbols.T_2,
+		}, 
+		Stmt0R3, 
+	},
+	Stmt0R4: {
+		symbols.NT_Stmt, 0, 4, 
+		symbols.Symbols{  
+			symbols.T_4, 
+			symbols.T_3, 
+			symbols.NT_Expr, 
+			symbols.T_2,
+		}, 
+		Stmt0R4, 
+	},
+	Stmts0R0: {
+		symbols.NT_Stmts, 0, 0, 
+		symbols.Symbols{  
+			symbols.NT_Stmt,
+		}, 
+		Stmts0R0, 
+	},
+	Stmts0R1: {
+		symbols.NT_Stmts, 0, 1, 
+		symbols.Symbols{  
+			symbols.NT_Stmt,
+		}, 
+		Stmts0R1, 
+	},
+	Stmts1R0: {
+		symbols.NT_Stmts, 1, 0, 
+		symbols.Symbols{  
+			symbols.NT_Stmt, 
+			symbols.NT_Stmts,
+		}, 
+		Stmts1R0, 
+	},
+	Stmts1R1: {
+		symbols.NT_Stmts, 1, 1, 
+		symbols.Symbols{  
+			symbols.NT_Stmt, 
+			symbols.NT_Stmts,
+		}, 
+		Stmts1R1, 
+	},
+	Stmts1R2: {
+		symbols.NT_Stmts, 1, 2, 
+		symbols.Symbols{  
+			symbols.NT_Stmt, 
+			symbols.NT_Stmts,
+		}, 
+		Stmts1R2, 
+	},
+}
+
+var slotIndex = map[Index]Label { 
+	Index{ symbols.NT_Expr,0,0 }: Expr0R0,
+	Index{ symbols.NT_Expr,0,1 }: Expr0R1,
+	Index{ symbols.NT_Expr,1,0 }: Expr1R0,
+	Index{ symbols.NT_Expr,1,1 }: Expr1R1,
+	Index{ symbols.NT_Expr,2,0 }: Expr2R0,
+	Index{ symbols.NT_Expr,2,1 }: Expr2R1,
+	Index{ symbols.NT_Expr,2,2 }: Expr2R2,
+	Index{ symbols.NT_Expr,2,3 }: Expr2R3,
+	Index{ symbols.NT_Stmt,0,0 }: Stmt0R0,
+	Index{ symbols.NT_Stmt,0,1 }: Stmt0R1,
+	Index{ symbols.NT_Stmt,0,2 }: Stmt0R2,
+	Index{ symbols.NT_Stmt,0,3 }: Stmt0R3,
+	Index{ symbols.NT_Stmt,0,4 }: Stmt0R4,
+	Index{ symbols.NT_Stmts,0,0 }: Stmts0R0,
+	Index{ symbols.NT_Stmts,0,1 }: Stmts0R1,
+	Index{ symbols.NT_Stmts,1,0 }: Stmts1R0,
+	Index{ symbols.NT_Stmts,1,1 }: Stmts1R1,
+	Index{ symbols.NT_Stmts,1,2 }: Stmts1R2,
+}
+
+var alternates = map[symbols.NT][]Label{ 
+	symbols.NT_Stmts:[]Label{ Stmts0R0,Stmts1R0 },
+	symbols.NT_Stmt:[]Label{ Stmt0R0 },
+	symbols.NT_Expr:[]Label{ Expr0R0,Expr1R0,Expr2R0 },
+}
+
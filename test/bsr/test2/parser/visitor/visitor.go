@@ -0,0 +1,176 @@
+// Package visitor is generated by gogll. Do not edit.
+
+/*
+Package visitor walks an unambiguous BSR set.
+
+Walk calls the Enter and Exit methods of the nonterminal and of the alternate of
+every NT BSR. The Enter methods are called in pre-order and the Exit methods in
+post-order:
+
+	EnterExpr, EnterExpr_Alt0, <walk the NT children of the BSR>, ExitExpr_Alt0, ExitExpr
+
+BaseVisitor implements Visitor with methods that do nothing. It can be embedded
+in a visitor that only needs some of the methods.
+*/
+package visitor
+
+import (
+	"fmt"
+
+	"github.com/goccmack/gogll/v3/test/bsr/test2/parser/bsr"
+	"github.com/goccmack/gogll/v3/test/bsr/test2/parser/symbols"
+)
+
+// Visitor has Enter and Exit methods for each nonterminal and each alternate
+// of the grammar. If an Enter method returns false the children of the BSR
+// are not walked. The matching Exit method is always called.
+type Visitor interface {
+	EnterStmts(b bsr.BSR) bool
+	ExitStmts(b bsr.BSR)
+
+	// Stmts : Stmt
+	EnterStmts_Alt0(b bsr.BSR) bool
+	ExitStmts_Alt0(b bsr.BSR)
+
+	// Stmts : Stmt Stmts
+	EnterStmts_Alt1(b bsr.BSR) bool
+	ExitStmts_Alt1(b bsr.BSR)
+
+	EnterStmt(b bsr.BSR) bool
+	ExitStmt(b bsr.BSR)
+
+	// Stmt : id "=" Expr ";"
+	EnterStmt_Alt0(b bsr.BSR) bool
+	ExitStmt_Alt0(b bsr.BSR)
+
+	EnterExpr(b bsr.BSR) bool
+	ExitExpr(b bsr.BSR)
+
+	// Expr : id
+	EnterExpr_Alt0(b bsr.BSR) bool
+	ExitExpr_Alt0(b bsr.BSR)
+
+	// Expr : num
+	EnterExpr_Alt1(b bsr.BSR) bool
+	ExitExpr_Alt1(b bsr.BSR)
+
+	// Expr : "(" Expr ")"
+	EnterExpr_Alt2(b bsr.BSR) bool
+	ExitExpr_Alt2(b bsr.BSR)
+}
+
+// Walk walks the BSR b and its NT children, calling the methods of v.
+// Walk panics if b has ambiguous children.
+func Walk(b bsr.BSR, v Visitor) {
+	switch b.Label.Head() {
+	case symbols.NT_Stmts:
+		if v.EnterStmts(b) {
+			walkStmts(b, v)
+		}
+		v.ExitStmts(b)
+	case symbols.NT_Stmt:
+		if v.EnterStmt(b) {
+			walkStmt(b, v)
+		}
+		v.ExitStmt(b)
+	case symbols.NT_Expr:
+		if v.EnterExpr(b) {
+			walkExpr(b, v)
+		}
+		v.ExitExpr(b)
+	default:
+		panic(fmt.Sprintf("invalid NT %s", b.Label.Head()))
+	}
+}
+
+func walkStmts(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterStmts_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitStmts_Alt0(b)
+	case 1:
+		if v.EnterStmts_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitStmts_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Stmts", b.Alternate()))
+	}
+}
+
+func walkStmt(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterStmt_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitStmt_Alt0(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Stmt", b.Alternate()))
+	}
+}
+
+func walkExpr(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterExpr_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitExpr_Alt0(b)
+	case 1:
+		if v.EnterExpr_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitExpr_Alt1(b)
+	case 2:
+		if v.EnterExpr_Alt2(b) {
+			walkChildren(b, v)
+		}
+		v.ExitExpr_Alt2(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Expr", b.Alternate()))
+	}
+}
+
+func walkChildren(b bsr.BSR, v Visitor) {
+	for i, s := range b.Label.Symbols() {
+		if s.IsNonTerminal() {
+			Walk(b.GetNTChildI(i), v)
+		}
+	}
+}
+
+// BaseVisitor implements Visitor. Its Enter methods return true and its Exit
+// methods do nothing.
+type BaseVisitor struct{}
+
+var _ Visitor = BaseVisitor{}
+
+func (BaseVisitor) EnterStmts(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStmts(b bsr.BSR) {}
+
+func (BaseVisitor) EnterStmts_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStmts_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterStmts_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStmts_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterStmt(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStmt(b bsr.BSR) {}
+
+func (BaseVisitor) EnterStmt_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStmt_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr_Alt2(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr_Alt2(b bsr.BSR) {}
@@ -0,0 +1,120 @@
+package test2
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/goccmack/gogll/v3/test/bsr/test2/lexer"
+	"github.com/goccmack/gogll/v3/test/bsr/test2/parser"
+	"github.com/goccmack/gogll/v3/test/bsr/test2/parser/bsr"
+	"github.com/goccmack/gogll/v3/test/bsr/test2/sppf"
+)
+
+const src = `a = 1 ; b = ( c ) ; d = 2 ;`
+
+func TestEdit(t *testing.T) {
+	l := lexer.New([]rune(src))
+	oldSet := parse(t, l)
+	oldSPPF := oldSet.ToSPPF()
+	oldA := getNode(oldSPPF, "Stmt", 0)
+	oldD := getNode(oldSPPF, "Stmt", 10)
+
+	// Replace "c" with "( e )"
+	e := l.Edit(14, 1, []rune("( e )"))
+	if e.First != 7 || e.NumOld != 2 || e.NumNew != 4 {
+		t.Fatalf("unexpected edit %#v", e)
+	}
+	newSet := parse(t, l)
+
+	// Stmt a is before the edit and Stmt d after it
+	a := newSet.GetRoot().GetNTChildI(0)
+	if _, ok := a.Old(oldSet, e); !ok {
+		t.Errorf("%s not reused", a)
+	}
+	stmts := newSet.GetRoot().GetNTChildI(1)
+	if _, ok := stmts.GetNTChildI(0).Old(oldSet, e); ok {
+		t.Errorf("edited %s reused", stmts.GetNTChildI(0))
+	}
+	d := stmts.GetNTChildI(1).GetNTChildI(0)
+	if ob, ok := d.Old(oldSet, e); !ok || ob.LeftExtent() != 10 {
+		t.Errorf("%s not reused", d)
+	}
+
+	newSPPF := newSet.ToSPPFEdit(oldSPPF, e)
+	if getNode(newSPPF, "Stmt", 0) != oldA {
+		t.Error("SPPF node of Stmt a not reused")
+	}
+	if getNode(newSPPF, "Stmt", 12) != oldD {
+		t.Error("SPPF node of Stmt d not reused")
+	}
+	if got, exp := labels(newSPPF), labels(parse(t, lexer.New(l.I)).ToSPPF()); got != exp {
+		t.Errorf("SPPF after edit:\n%s\nexpected:\n%s", got, exp)
+	}
+}
+
+func parse(t *testing.T, l *lexer.Lexer) *bsr.Set {
+	t.Helper()
+	set, errs := parser.Parse(l)
+	if errs != nil {
+		t.Fatalf("%s", errs[0])
+	}
+	return set
+}
+
+// getNode returns the symbol node of nt with left extent lext
+func getNode(root *sppf.SymbolNode, nt string, lext int) (node *sppf.SymbolNode) {
+	walk(root, map[sppf.Node]bool{}, func(n sppf.Node) {
+		if sn, ok := n.(*sppf.SymbolNode); ok && sn.Symbol == nt && sn.Lext == lext {
+			node = sn
+		}
+	})
+	return
+}
+
+// labels returns the sorted edges of the SPPF
+func labels(root *sppf.SymbolNode) string {
+	edges := []string{}
+	walk(root, map[sppf.Node]bool{}, func(n sppf.Node) {
+		switch n1 := n.(type) {
+		case *sppf.SymbolNode:
+			for _, c := range n1.Children {
+				edges = append(edges, fmt.Sprintf("%s -> %s", n1.Label(), c.Label()))
+			}
+		case *sppf.IntermediateNode:
+			for _, c := range n1.Children {
+				edges = append(edges, fmt.Sprintf("%s -> %s", n1.Label(), c.Label()))
+			}
+		case *sppf.PackedNode:
+			if n1.LeftChild != nil {
+				edges = append(edges, fmt.Sprintf("%s -> %s", n1.Label(), n1.LeftChild.Label()))
+			}
+			edges = append(edges, fmt.Sprintf("%s -> %s", n1.Label(), n1.RightChild.Label()))
+		}
+	})
+	sort.Strings(edges)
+	return fmt.Sprint(edges)
+}
+
+func walk(n sppf.Node, done map[sppf.Node]bool, f func(sppf.Node)) {
+	if done[n] {
+		return
+	}
+	done[n] = true
+	f(n)
+	switch n1 := n.(type) {
+	case *sppf.SymbolNode:
+		for _, c := range n1.Children {
+			walk(c, done, f)
+		}
+	case *sppf.IntermediateNode:
+		for _, c := range n1.Children {
+			walk(c, done, f)
+		}
+	case *sppf.PackedNode:
+		if n1.LeftChild != nil {
+			walk(n1.LeftChild, done, f)
+		}
+		walk(n1.RightChild, done, f)
+	}
+}
@@ -0,0 +1,738 @@
+
+// Package lexer is generated by GoGLL. Do not edit.
+package lexer
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"unicode"
+
+	"github.com/goccmack/gogll/v3/test/coverage/cov1/coverage"
+	"github.com/goccmack/gogll/v3/test/coverage/cov1/token"
+)
+
+type state int
+
+const nullState state = -1
+
+// Unicode categories
+var (
+	_Cc     = unicode.Cc     // Cc is the set of Unicode characters in category Cc (Other, control).
+	_Cf     = unicode.Cf     // Cf is the set of Unicode characters in category Cf (Other, format).
+	_Co     = unicode.Co     // Co is the set of Unicode characters in category Co (Other, private use).
+	_Cs     = unicode.Cs     // Cs is the set of Unicode characters in category Cs (Other, surrogate).
+	_Digit  = unicode.Digit  // Digit is the set of Unicode characters with the "decimal digit" property.
+	_Nd     = unicode.Nd     // Nd is the set of Unicode characters in category Nd (Number, decimal digit).
+	_Letter = unicode.Letter // Letter/L is the set of Unicode letters, category L.
+	_L      = unicode.L
+	_Lm     = unicode.Lm    // Lm is the set of Unicode characters in category Lm (Letter, modifier).
+	_Lo     = unicode.Lo    // Lo is the set of Unicode characters in category Lo (Letter, other).
+	_Lower  = unicode.Lower // Lower is the set of Unicode lower case letters.
+	_Ll     = unicode.Ll    // Ll is the set of Unicode characters in category Ll (Letter, lowercase).
+	_Mark   = unicode.Mark  // Mark/M is the set of Unicode mark characters, category M.
+	_M      = unicode.M
+	_Mc     = unicode.Mc     // Mc is the set of Unicode characters in category Mc (Mark, spacing combining).
+	_Me     = unicode.Me     // Me is the set of Unicode characters in category Me (Mark, enclosing).
+	_Mn     = unicode.Mn     // Mn is the set of Unicode characters in category Mn (Mark, nonspacing).
+	_Nl     = unicode.Nl     // Nl is the set of Unicode characters in category Nl (Number, letter).
+	_No     = unicode.No     // No is the set of Unicode characters in category No (Number, other).
+	_Number = unicode.Number // Number/N is the set of Unicode number characters, category N.
+	_N      = unicode.N
+	_Other  = unicode.Other // Other/C is the set of Unicode control and special characters, category C.
+	_C      = unicode.C
+	_Pc     = unicode.Pc    // Pc is the set of Unicode characters in category Pc (Punctuation, connector).
+	_Pd     = unicode.Pd    // Pd is the set of Unicode characters in category Pd (Punctuation, dash).
+	_Pe     = unicode.Pe    // Pe is the set of Unicode characters in category Pe (Punctuation, close).
+	_Pf     = unicode.Pf    // Pf is the set of Unicode characters in category Pf (Punctuation, final quote).
+	_Pi     = unicode.Pi    // Pi is the set of Unicode characters in category Pi (Punctuation, initial quote).
+	_Po     = unicode.Po    // Po is the set of Unicode characters in category Po (Punctuation, other).
+	_Ps     = unicode.Ps    // Ps is the set of Unicode characters in category Ps (Punctuation, open).
+	_Punct  = unicode.Punct // Punct/P is the set of Unicode punctuation characters, category P.
+	_P      = unicode.P
+	_Sc     = unicode.Sc    // Sc is the set of Unicode characters in category Sc (Symbol, currency).
+	_Sk     = unicode.Sk    // Sk is the set of Unicode characters in category Sk (Symbol, modifier).
+	_Sm     = unicode.Sm    // Sm is the set of Unicode characters in category Sm (Symbol, math).
+	_So     = unicode.So    // So is the set of Unicode characters in category So (Symbol, other).
+	_Space  = unicode.Space // Space/Z is the set of Unicode space characters, category Z.
+	_Z      = unicode.Z
+	_Symbol = unicode.Symbol // Symbol/S is the set of Unicode symbol characters, category S.
+	_S      = unicode.S
+	_Title  = unicode.Title // Title is the set of Unicode title case letters.
+	_Lt     = unicode.Lt    // Lt is the set of Unicode characters in category Lt (Letter, titlecase).
+	_Upper  = unicode.Upper // Upper is the set of Unicode upper case letters.
+	_Lu     = unicode.Lu    // Lu is the set of Unicode characters in category Lu (Letter, uppercase).
+	_Zl     = unicode.Zl    // Zl is the set of Unicode characters in category Zl (Separator, line).
+	_Zp     = unicode.Zp    // Zp is the set of Unicode characters in category Zp (Separator, paragraph).
+	_Zs     = unicode.Zs    // Zs is the set of Unicode characters in category Zs (Separator, space).
+)
+
+// Unicode properties
+var (
+	_ASCII_Hex_Digit                    = unicode.ASCII_Hex_Digit                    // ASCII_Hex_Digit is the set of Unicode characters with property ASCII_Hex_Digit.
+	_Bidi_Control                       = unicode.Bidi_Control                       // Bidi_Control is the set of Unicode characters with property Bidi_Control.
+	_Dash                               = unicode.Dash                               // Dash is the set of Unicode characters with property Dash.
+	_Deprecated                         = unicode.Deprecated                         // Deprecated is the set of Unicode characters with property Deprecated.
+	_Diacritic                          = unicode.Diacritic                          // Diacritic is the set of Unicode characters with property Diacritic.
+	_Extender                           = unicode.Extender                           // Extender is the set of Unicode characters with property Extender.
+	_Hex_Digit                          = unicode.Hex_Digit                          // Hex_Digit is the set of Unicode characters with property Hex_Digit.
+	_Hyphen                             = unicode.Hyphen                             // Hyphen is the set of Unicode characters with property Hyphen.
+	_IDS_Binary_Operator                = unicode.IDS_Binary_Operator                // IDS_Binary_Operator is the set of Unicode characters with property IDS_Binary_Operator.
+	_IDS_Trinary_Operator               = unicode.IDS_Trinary_Operator               // IDS_Trinary_Operator is the set of Unicode characters with property IDS_Trinary_Operator.
+	_Ideographic                        = unicode.Ideographic                        // Ideographic is the set of Unicode characters with property Ideographic.
+	_Join_Control                       = unicode.Join_Control                       // Join_Control is the set of Unicode characters with property Join_Control.
+	_Logical_Order_Exception            = unicode.Logical_Order_Exception            // Logical_Order_Exception is the set of Unicode characters with property Logical_Order_Exception.
+	_Noncharacter_Code_Point            = unicode.Noncharacter_Code_Point            // Noncharacter_Code_Point is the set of Unicode characters with property Noncharacter_Code_Point.
+	_Other_Alphabetic                   = unicode.Other_Alphabetic                   // Other_Alphabetic is the set of Unicode characters with property Other_Alphabetic.
+	_Other_Default_Ignorable_Code_Point = unicode.Other_Default_Ignorable_Code_Point // Other_Default_Ignorable_Code_Point is the set of Unicode characters with property Other_Default_Ignorable_Code_Point.
+	_Other_Grapheme_Extend              = unicode.Other_Grapheme_Extend              // Other_Grapheme_Extend is the set of Unicode characters with property Other_Grapheme_Extend.
+	_Other_ID_Continue                  = unicode.Other_ID_Continue                  // Other_ID_Continue is the set of Unicode characters with property Other_ID_Continue.
+	_Other_ID_Start                     = unicode.Other_ID_Start                     // Other_ID_Start is the set of Unicode characters with property Other_ID_Start.
+	_Other_Lowercase                    = unicode.Other_Lowercase                    // Other_Lowercase is the set of Unicode characters with property Other_Lowercase.
+	_Other_Math                         = unicode.Other_Math                         // Other_Math is the set of Unicode characters with property Other_Math.
+	_Other_Uppercase                    = unicode.Other_Uppercase                    // Other_Uppercase is the set of Unicode characters with property Other_Uppercase.
+	_Pattern_Syntax                     = unicode.Pattern_Syntax                     // Pattern_Syntax is the set of Unicode characters with property Pattern_Syntax.
+	_Pattern_White_Space                = unicode.Pattern_White_Space                // Pattern_White_Space is the set of Unicode characters with property Pattern_White_Space.
+	_Prepended_Concatenation_Mark       = unicode.Prepended_Concatenation_Mark       // Prepended_Concatenation_Mark is the set of Unicode characters with property Prepended_Concatenation_Mark.
+	_Quotation_Mark                     = unicode.Quotation_Mark                     // Quotation_Mark is the set of Unicode characters with property Quotation_Mark.
+	_Radical                            = unicode.Radical                            // Radical is the set of Unicode characters with property Radical.
+	_Regional_Indicator                 = unicode.Regional_Indicator                 // Regional_Indicator is the set of Unicode characters with property Regional_Indicator.
+	_STerm                              = unicode.STerm                              // STerm is an alias for Sentence_Terminal.
+	_Sentence_Terminal                  = unicode.Sentence_Terminal                  // Sentence_Terminal is the set of Unicode characters with property Sentence_Terminal.
+	_Soft_Dotted                        = unicode.Soft_Dotted                        // Soft_Dotted is the set of Unicode characters with property Soft_Dotted.
+	_Terminal_Punctuation               = unicode.Terminal_Punctuation               // Terminal_Punctuation is the set of Unicode characters with property Terminal_Punctuation.
+	_Unified_Ideograph                  = unicode.Unified_Ideograph                  // Unified_Ideograph is the set of Unicode characters with property Unified_Ideograph.
+	_Variation_Selector                 = unicode.Variation_Selector                 // Variation_Selector is the set of Unicode characters with property Variation_Selector.
+	_White_Space                        = unicode.White_Space                        // White_Space is the set of Unicode characters with property White_Space.
+)
+
+// Lexer contains both the input slice of runes and the slice of tokens
+// parsed from the input
+type Lexer struct {
+	// I is the input slice of runes
+	I      []rune
+
+	// Tokens is the slice of tokens constructed by the lexer from I
+	Tokens []*token.Token
+
+	// Errors contains the lexical errors in I in order of occurrence
+	Errors []*LexError
+
+	// trivia is true if the lexer attaches trivia to the tokens
+	trivia bool
+}
+
+// LexError is a lexical error: the lexer could not scan a token from the input.
+type LexError struct {
+	// Token is the Error token in Lexer.Tokens, which contains the input 
+	// skipped by the lexer.
+	Token *token.Token
+
+	// Pos is the position of the offending rune in the input. 
+	// If the lexer reached the end of the input Pos == len(input)
+	Pos int
+
+	// The line and column of the offending rune in the input
+	Line, Column int
+
+	// Rune is the offending rune. It is -1 at the end of the input.
+	Rune rune
+
+	// Partial contains the token types that were partially matched when
+	// the error occurred. It is empty if no token matches the first rune.
+	Partial []token.Type
+}
+
+// Recovery is the strategy used by the lexer to continue after a lexical error
+type Recovery int
+
+const (
+	// SkipRune continues scanning at the rune after the offending rune
+	SkipRune Recovery = iota
+
+	// SkipToSpace continues scanning at the first whitespace after the
+	// offending rune
+	SkipToSpace
+)
+
+// ErrorRecovery is the error recovery strategy of the lexers created 
+// after it is set. The default is SkipRune.
+var ErrorRecovery = SkipRune
+
+/*
+NewFile constructs a Lexer created from the input file, fname. 
+
+If the input file is a markdown file NewFile process treats all text outside
+code blocks as whitespace. All text inside code blocks are treated as input text.
+
+If the input file is a normal text file NewFile treats all text in the inputfile
+as input text.
+*/
+func NewFile(fname string) *Lexer {
+	return New(readFile(fname))
+}
+
+// NewFileWithTrivia is like NewFile but attaches trivia to the tokens, 
+// like NewWithTrivia.
+func NewFileWithTrivia(fname string) *Lexer {
+	return NewWithTrivia(readFile(fname))
+}
+
+func readFile(fname string) []rune {
+	buf, err := ioutil.ReadFile(fname)
+	if err != nil {
+		panic(err)
+	}
+	input := []rune(string(buf))
+	if strings.HasSuffix(fname, ".md") {
+		loadMd(input)
+	}
+	return input
+}
+
+func loadMd(input []rune) {
+	i := 0
+	text := true
+	for i < len(input) {
+		if i <= len(input)-3 && input[i] == '`' && input[i+1] == '`' && input[i+2] == '`' {
+			text = !text
+			for j := 0; j < 3; j++ {
+				input[i+j] = ' '
+			}
+			i += 3
+		}
+		if i < len(input) {
+			if text {
+				if input[i] == '\n' {
+					input[i] = '\n'
+				} else {
+					input[i] = ' '
+				}
+			}
+			i += 1
+		}
+	}
+}
+
+/*
+New constructs a Lexer from a slice of runes. 
+
+All contents of the input slice are treated as input text.
+*/
+func New(input []rune) *Lexer {
+	lex := &Lexer{
+		I:      input,
+		Tokens: make([]*token.Token, 0, 2048),
+	}
+	lex.Tokens = append(lex.Tokens, lex.scanTokens(0, nil, nil)...)
+	return lex
+}
+
+/*
+NewWithTrivia constructs a Lexer from a slice of runes, like New, but keeps
+the whitespace and suppressed tokens between the tokens as trivia attached
+to the neighbouring tokens.
+
+The trailing trivia of a token extends up to and including the end of its line.
+The rest of the trivia between two tokens is the leading trivia of the second
+token. Trivia at the end of the input is the leading trivia of the EOF token.
+The input can be reproduced by concatenating Token.FullLiteral() of all the
+tokens.
+*/
+func NewWithTrivia(input []rune) *Lexer {
+	lex := &Lexer{
+		I:      input,
+		Tokens: make([]*token.Token, 0, 2048),
+		trivia: true,
+	}
+	lex.Tokens = append(lex.Tokens, lex.scanTokens(0, nil, nil)...)
+	return lex
+}
+
+/*
+scanTokens scans the tokens from position lext in the input up to and 
+including the EOF token, or the first token for which stop returns true.
+prev is the token before lext. Its trailing trivia is set if l keeps trivia.
+*/
+func (l *Lexer) scanTokens(lext int, prev *token.Token, stop func(*token.Token) bool) (toks []*token.Token) {
+	for {
+		var tok *token.Token
+		if l.trivia {
+			tok = l.scanWithTrivia(lext, prev)
+		} else {
+			tok = l.scanToken(lext)
+		}
+		toks = append(toks, tok)
+		if tok.Type() == token.EOF || (stop != nil && stop(tok)) {
+			return
+		}
+		lext, prev = tok.Rext(), tok
+	}
+}
+
+// scanToken returns the first token after position lext in the input that
+// is not suppressed.
+func (l *Lexer) scanToken(lext int) *token.Token {
+	for {
+		for lext < len(l.I) && unicode.IsSpace(l.I[lext]) {
+			lext++
+		}
+		if lext >= len(l.I) {
+			return token.New(token.EOF, len(l.I), len(l.I), l.I)
+		}
+		tok := l.scan(lext)
+		if tok.Type() == token.Error {
+			tok = l.lexError(tok)
+		}
+		if !tok.Suppress() {
+			return tok
+		}
+		lext = tok.Rext()
+	}
+}
+
+// scanWithTrivia returns the first token after position lext in the input
+// that is not suppressed. It attaches the trivia from lext to the token 
+// to prev and the token.
+func (l *Lexer) scanWithTrivia(lext int, prev *token.Token) *token.Token {
+	triviaLext, suppressed := lext, []*token.Token{}
+	var tok *token.Token
+	for tok == nil {
+		for lext < len(l.I) && unicode.IsSpace(l.I[lext]) {
+			lext++
+		}
+		if lext >= len(l.I) {
+			tok = token.New(token.EOF, len(l.I), len(l.I), l.I)
+		} else if t := l.scan(lext); t.Suppress() {
+			suppressed = append(suppressed, t)
+			lext = t.Rext()
+		} else {
+			if t.Type() == token.Error {
+				t = l.lexError(t)
+			}
+			tok = t
+		}
+	}
+	split, numTrailing := triviaLext, 0
+	if prev != nil {
+		split = l.endOfLine(triviaLext, tok.Lext(), suppressed)
+		for numTrailing < len(suppressed) && suppressed[numTrailing].Lext() < split {
+			numTrailing++
+		}
+		prev.SetTrailingTrivia(split, suppressed[:numTrailing])
+	}
+	tok.SetLeadingTrivia(split, suppressed[numTrailing:])
+	return tok
+}
+
+// endOfLine returns the position after the first newline in the trivia from
+// lext to rext, that is not part of a suppressed token. It returns rext if
+// there is no such newline.
+func (l *Lexer) endOfLine(lext, rext int, suppressed []*token.Token) int {
+	for i, j := lext, 0; i < rext; {
+		if j < len(suppressed) && suppressed[j].Lext() == i {
+			i = suppressed[j].Rext()
+			j++
+			continue
+		}
+		if l.I[i] == '\n' {
+			return i + 1
+		}
+		i++
+	}
+	return rext
+}
+
+func (l *Lexer) scan(i int) *token.Token {
+	// fmt.Printf("lexer.scan(%d)\n", i)
+	s, typ, rext := nullState, token.Error, i+1
+	if i < len(l.I) {
+		// fmt.Printf("  rext %d, i %d\n", rext, i)
+		s = nextState[0](l.I[i])
+	}
+	for s != nullState {
+		if rext >= len(l.I) {
+			typ = accept[s]
+			s = nullState
+		} else {
+			typ = accept[s]
+			s = nextState[s](l.I[rext])
+			if s != nullState || typ == token.Error {
+				rext++
+			}
+		}
+	}
+	if typ != token.Error {
+		coverage.Token(typ.ID())
+	}
+	tok := token.New(typ, i, rext, l.I)
+	// fmt.Printf("  %s\n", tok)
+	return tok
+}
+
+/*
+Edit describes how an edit of the input by Lexer.Edit changed the tokens.
+
+Tokens[First:First+NumOld] before the edit were replaced by the re-lexed
+Tokens[First:First+NumNew]. The index of every token after them changed by
+NumNew-NumOld.
+*/
+type Edit struct {
+	// The edit of the input: Deleted runes at Offset were replaced by 
+	// Inserted runes.
+	Offset, Deleted, Inserted int
+
+	// The re-lexed window of tokens
+	First, NumOld, NumNew int
+}
+
+/*
+Edit replaces the deleted runes at offset in the input with inserted and 
+re-lexes only the tokens damaged by the edit. The tokens after the damaged 
+window are moved and their indices shifted. Lexical errors are updated.
+*/
+func (l *Lexer) Edit(offset, deleted int, inserted []rune) *Edit {
+	input := make([]rune, 0, len(l.I)-deleted+len(inserted))
+	input = append(input, l.I[:offset]...)
+	input = append(input, inserted...)
+	input = append(input, l.I[offset+deleted:]...)
+	delta := len(inserted) - deleted
+
+	// The first damaged token is the first token that ends at or after offset,
+	// because the lexer reads the rune after a token to find its end.
+	first := 0
+	for first < len(l.Tokens)-1 && l.Tokens[first].Rext() < offset {
+		first++
+	}
+
+	old, oldErrors := l.Tokens, l.Errors
+	l.I, l.Errors = input, nil
+	l.Tokens = make([]*token.Token, 0, len(old)+len(inserted))
+	for _, tok := range old[:first] {
+		l.Tokens = append(l.Tokens, tok.Shift(0, input))
+	}
+
+	lext, prev := 0, (*token.Token)(nil)
+	if first > 0 {
+		prev = l.Tokens[first-1]
+		lext = prev.Rext()
+	}
+	// Re-lex until a token is the same as an old token after the edit.
+	j := first
+	relexed := l.scanTokens(lext, prev, func(tok *token.Token) bool {
+		for j < len(old) && 
+			(old[j].Lext() < offset+deleted || old[j].Lext()+delta < tok.Lext()) {
+			j++
+		}
+		return j < len(old) && old[j].Type() == tok.Type() &&
+			old[j].Lext()+delta == tok.Lext() && old[j].Rext()+delta == tok.Rext()
+	})
+	numOld := len(old) - first
+	if last := relexed[len(relexed)-1]; last.Type() != token.EOF {
+		numOld = j - first + 1
+		if l.trivia {
+			tok := old[j].Shift(delta, input)
+			last.SetTrailingTrivia(tok.Rext()+len(tok.TrailingTrivia()), tok.TrailingSuppressed())
+		}
+	}
+	l.Tokens = append(l.Tokens, relexed...)
+	for _, tok := range old[first+numOld:] {
+		l.Tokens = append(l.Tokens, tok.Shift(delta, input))
+	}
+
+	l.updateErrors(old, oldErrors, first, numOld, len(relexed), delta)
+
+	return &Edit{
+		Offset:   offset,
+		Deleted:  deleted,
+		Inserted: len(inserted),
+		First:    first,
+		NumOld:   numOld,
+		NumNew:   len(relexed),
+	}
+}
+
+// updateErrors moves the lexical errors, oldErrors, before and after the 
+// re-lexed tokens to the new tokens. l.Errors contains the errors of the
+// re-lexed tokens.
+func (l *Lexer) updateErrors(old []*token.Token, oldErrors []*LexError, 
+	first, numOld, numNew, delta int) {
+
+	index := make(map[*token.Token]int, len(oldErrors))
+	for i, tok := range old {
+		index[tok] = i
+	}
+	var before, after []*LexError
+	for _, err := range oldErrors {
+		err1 := *err
+		switch i := index[err.Token]; {
+		case i < first:
+			err1.Token = l.Tokens[i]
+			before = append(before, &err1)
+		case i >= first+numOld:
+			err1.Token = l.Tokens[i+numNew-numOld]
+			err1.Pos += delta
+			err1.Line, err1.Column = l.GetLineColumn(err1.Pos)
+			after = append(after, &err1)
+		}
+	}
+	l.Errors = append(append(before, l.Errors...), after...)
+}
+
+/*
+Unchanged returns the token extents before the edit, e, of the tokens from 
+lext to rext after the edit. ok is false if the tokens from lext up to and 
+including rext overlap the re-lexed tokens. 
+
+A subtree of the parse forest with unchanged extents is not changed by the edit.
+*/
+func (e *Edit) Unchanged(lext, rext int) (oldLext, oldRext int, ok bool) {
+	switch {
+	case rext < e.First:
+		return lext, rext, true
+	case lext >= e.First+e.NumNew:
+		return lext - e.NumNew + e.NumOld, rext - e.NumNew + e.NumOld, true
+	}
+	return 0, 0, false
+}
+
+/*
+lexError records the LexError for the Error token, tok, and returns the Error
+token extended by the error recovery strategy, ErrorRecovery.
+*/
+func (l *Lexer) lexError(tok *token.Token) *token.Token {
+	s, pos := state(0), tok.Lext()
+	for pos < len(l.I) {
+		next := nextState[s](l.I[pos])
+		if next == nullState {
+			break
+		}
+		s, pos = next, pos+1
+	}
+	if ErrorRecovery == SkipToSpace {
+		rext := tok.Rext()
+		for rext < len(l.I) && !unicode.IsSpace(l.I[rext]) {
+			rext++
+		}
+		tok = token.New(token.Error, tok.Lext(), rext, l.I)
+	}
+	err := &LexError{
+		Token:   tok,
+		Pos:     pos,
+		Rune:    -1,
+		Partial: partial[s],
+	}
+	if pos < len(l.I) {
+		err.Rune = l.I[pos]
+	}
+	err.Line, err.Column = l.GetLineColumn(pos)
+	l.Errors = append(l.Errors, err)
+	return tok
+}
+
+func (e *LexError) Error() string {
+	w := new(bytes.Buffer)
+	fmt.Fprintf(w, "Lexical error at line %d col %d: ", e.Line, e.Column)
+	if e.Rune == -1 {
+		fmt.Fprint(w, "unexpected end of input")
+	} else {
+		fmt.Fprintf(w, "unexpected %q", e.Rune)
+	}
+	if len(e.Partial) > 0 {
+		fmt.Fprint(w, " while scanning one of [")
+		for i, t := range e.Partial {
+			if i > 0 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprint(w, t.ID())
+		}
+		fmt.Fprint(w, "]")
+	}
+	return w.String()
+}
+
+func escape(r rune) string {
+	switch r {
+	case '"':
+		return "\""
+	case '\\':
+		return "\\\\"
+	case '\r':
+		return "\\r"
+	case '\n':
+		return "\\n"
+	case '\t':
+		return "\\t"
+	}
+	return string(r)
+}
+
+// GetLineColumn returns the line and column of rune[i] in the input
+func (l *Lexer) GetLineColumn(i int) (line, col int) {
+	line, col = 1, 1
+	for j := 0; j < i; j++ {
+		switch l.I[j] {
+		case '\n':
+			line++
+			col = 1
+		case '\t':
+			col += 4
+		default:
+			col++
+		}
+	}
+	return
+}
+
+// GetLineColumnOfToken returns the line and column of token[i] in the imput
+func (l *Lexer) GetLineColumnOfToken(i int) (line, col int) {
+	return l.GetLineColumn(l.Tokens[i].Lext())
+}
+
+// GetString returns the input string from the left extent of Token[lext] to
+// the right extent of Token[rext]
+func (l *Lexer) GetString(lext, rext int) string {
+	return string(l.I[l.Tokens[lext].Lext():l.Tokens[rext].Rext()])
+}
+
+func (l *Lexer) add(t token.Type, lext, rext int) {
+	l.addToken(token.New(t, lext, rext, l.I))
+}
+
+func (l *Lexer) addToken(tok *token.Token) {
+	l.Tokens = append(l.Tokens, tok)
+}
+
+func any(r rune, set []rune) bool {
+	for _, r1 := range set {
+		if r == r1 {
+			return true
+		}
+	}
+	return false
+}
+
+func not(r rune, set []rune) bool {
+	for _, r1 := range set {
+		if r == r1 {
+			return false
+		}
+	}
+	return true
+}
+
+var accept = []token.Type{ 
+	token.Error, 
+	token.T_0, 
+	token.T_1, 
+	token.T_2, 
+	token.Error, 
+	token.T_3, 
+	token.T_4, 
+	token.T_6, 
+	token.T_7, 
+	token.T_5, 
+}
+
+var partial = [][]token.Type{ 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ }, 
+	{ token.T_5, }, 
+	{ }, 
+	{ }, 
+	{ token.T_6, }, 
+	{ token.T_7, }, 
+	{ token.T_5, }, 
+}
+
+var nextState = []func(r rune) state{ 
+	// Set0
+	func(r rune) state {
+		switch { 
+		case r == '(':
+			return 1 
+		case r == ')':
+			return 2 
+		case r == '+':
+			return 3 
+		case r == '/':
+			return 4 
+		case r == ';':
+			return 5 
+		case r == '=':
+			return 6 
+		case unicode.IsLetter(r):
+			return 7 
+		case unicode.IsNumber(r):
+			return 8 
+		}
+		return nullState
+	}, 
+	// Set1
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set2
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set3
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set4
+	func(r rune) state {
+		switch { 
+		case r == '/':
+			return 9 
+		}
+		return nullState
+	}, 
+	// Set5
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set6
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set7
+	func(r rune) state {
+		switch { 
+		case unicode.IsLetter(r):
+			return 7 
+		case unicode.IsNumber(r):
+			return 7 
+		}
+		return nullState
+	}, 
+	// Set8
+	func(r rune) state {
+		switch { 
+		case unicode.IsNumber(r):
+			return 8 
+		}
+		return nullState
+	}, 
+	// Set9
+	func(r rune) state {
+		switch { 
+		case not(r, []rune{'\n'}):
+			return 9 
+		}
+		return nullState
+	}, 
+}
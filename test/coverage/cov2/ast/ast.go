@@ -0,0 +1,48 @@
+// Package ast discards the parsed values: the test only checks the coverage
+// recorded by the parser.
+package ast
+
+// G0 : Stmts ;
+func G00(p0 interface{}) (interface{}, error) {
+	return nil, nil
+}
+
+// Stmts : Stmt ;
+func Stmts0(p0 interface{}) (interface{}, error) {
+	return nil, nil
+}
+
+// Stmts : Stmt Stmts ;
+func Stmts1(p0, p1 interface{}) (interface{}, error) {
+	return nil, nil
+}
+
+// Stmt : id = Expr ; ;
+func Stmt0(p0, p1, p2, p3 interface{}) (interface{}, error) {
+	return nil, nil
+}
+
+// Expr : Term ;
+func Expr0(p0 interface{}) (interface{}, error) {
+	return nil, nil
+}
+
+// Expr : Term + Expr ;
+func Expr1(p0, p1, p2 interface{}) (interface{}, error) {
+	return nil, nil
+}
+
+// Term : id ;
+func Term0(p0 interface{}) (interface{}, error) {
+	return nil, nil
+}
+
+// Term : num ;
+func Term1(p0 interface{}) (interface{}, error) {
+	return nil, nil
+}
+
+// Term : ( Expr ) ;
+func Term2(p0, p1, p2 interface{}) (interface{}, error) {
+	return nil, nil
+}
@@ -0,0 +1,39 @@
+package cov2
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/goccmack/gogll/v3/test/coverage/cov2/coverage"
+	"github.com/goccmack/gogll/v3/test/coverage/cov2/lexer"
+	"github.com/goccmack/gogll/v3/test/coverage/cov2/parser"
+)
+
+func TestCoverage(t *testing.T) {
+	coverage.Reset()
+	if _, err := parser.New(lexer.New([]rune("a = b + 1 ; // c\nd = a ;"))).Parse(); err != nil {
+		t.Fatal(err)
+	}
+	w := new(bytes.Buffer)
+	if err := coverage.Write(w); err != nil {
+		t.Fatal(err)
+	}
+	exp := `gogll coverage v1
+alt Expr 0 2
+alt Expr 1 1
+alt Stmt 0 2
+alt Stmts 0 1
+alt Stmts 1 1
+alt Term 0 2
+alt Term 1 1
+tok "+" 1
+tok ";" 2
+tok "=" 2
+tok "comment" 1
+tok "id" 4
+tok "num" 1
+`
+	if w.String() != exp {
+		t.Errorf("expected:\n%s\ngot:\n%s", exp, w)
+	}
+}
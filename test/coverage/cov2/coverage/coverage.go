@@ -0,0 +1,97 @@
+// Package coverage is generated by gogll. Do not edit.
+
+/*
+Package coverage records the grammar alternates completed by the parser and
+the tokens scanned by the lexer. Recording is always on.
+
+WriteFile writes the coverage profile, which is reported by:
+
+	gogll coverage -g <grammar file> <profile>...
+*/
+package coverage
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+)
+
+type alternate struct {
+	nt    string
+	index int
+}
+
+var (
+	mu         sync.Mutex
+	alternates = map[alternate]int{}
+	tokens     = map[string]int{}
+)
+
+// Alternate records the completion of alternate index of the syntax rule of nt
+func Alternate(nt string, index int) {
+	mu.Lock()
+	alternates[alternate{nt, index}]++
+	mu.Unlock()
+}
+
+// Token records the scanning of the token with ID id
+func Token(id string) {
+	mu.Lock()
+	tokens[id]++
+	mu.Unlock()
+}
+
+// Reset clears the recorded coverage
+func Reset() {
+	mu.Lock()
+	alternates = map[alternate]int{}
+	tokens = map[string]int{}
+	mu.Unlock()
+}
+
+// Write writes the coverage profile to w
+func Write(w io.Writer) error {
+	mu.Lock()
+	defer mu.Unlock()
+	alts := make([]alternate, 0, len(alternates))
+	for alt := range alternates {
+		alts = append(alts, alt)
+	}
+	sort.Slice(alts, func(i, j int) bool {
+		if alts[i].nt != alts[j].nt {
+			return alts[i].nt < alts[j].nt
+		}
+		return alts[i].index < alts[j].index
+	})
+	toks := make([]string, 0, len(tokens))
+	for id := range tokens {
+		toks = append(toks, id)
+	}
+	sort.Strings(toks)
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "gogll coverage v1")
+	for _, alt := range alts {
+		fmt.Fprintf(bw, "alt %s %d %d\n", alt.nt, alt.index, alternates[alt])
+	}
+	for _, id := range toks {
+		fmt.Fprintf(bw, "tok %q %d\n", id, tokens[id])
+	}
+	return bw.Flush()
+}
+
+// WriteFile writes the coverage profile to file fname
+func WriteFile(fname string) error {
+	f, err := os.Create(fname)
+	if err != nil {
+		return err
+	}
+	if err := Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
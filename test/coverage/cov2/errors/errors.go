@@ -0,0 +1,40 @@
+
+package errors
+
+import(
+	"bytes"
+	"fmt"
+	"github.com/goccmack/gogll/v3/test/coverage/cov2/token"
+)
+
+type ErrorSymbol interface {
+}
+
+type Error struct {
+	Err            error
+	ErrorToken     *token.Token
+	ErrorSymbols   []ErrorSymbol
+	ExpectedTokens []string
+}
+
+func (E *Error) String() string {
+	w := new(bytes.Buffer)
+	fmt.Fprintf(w, "Error")
+	if E.Err != nil {
+		fmt.Fprintf(w, " %s\n", E.Err)
+	} else {
+		fmt.Fprintf(w, "\n")
+	}
+	fmt.Fprintf(w, "Token: type=%d, lit=%s\n", E.ErrorToken.Type(), string(E.ErrorToken.Literal()))
+	ln, col := E.ErrorToken.GetLineColumn()
+	fmt.Fprintf(w, "Pos: offset=%d, line=%d, column=%d\n", E.ErrorToken.Lext(), ln, col)
+	fmt.Fprintf(w, "Expected one of: ")
+	for _, sym := range E.ExpectedTokens {
+		fmt.Fprintf(w, "%s ", sym)
+	}
+	fmt.Fprintf(w, "ErrorSymbol:\n")
+	for _, sym := range E.ErrorSymbols {
+		fmt.Fprintf(w, "%v\n", sym)
+	}
+	return w.String()
+}
@@ -0,0 +1,145 @@
+
+package parser
+
+import "github.com/goccmack/gogll/v3/test/coverage/cov2/token"
+
+type(
+    actionTable [numStates]actionRow
+    actionRow struct {
+        canRecover bool
+        actions map[token.Type]action
+    }
+)
+
+var actionTab = actionTable{ 
+	actionRow{ // S0
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_6:shift(3),		/* id */
+        },
+
+	},
+	actionRow{ // S1
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:reduce(1),		/* $, reduce: Stmts */
+			token.T_6:shift(3),		/* id */
+        },
+
+	},
+	actionRow{ // S2
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:accept(true),		/* $ */
+        },
+
+	},
+	actionRow{ // S3
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_4:shift(5),		/* = */
+        },
+
+	},
+	actionRow{ // S4
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:reduce(2),		/* $, reduce: Stmts */
+        },
+
+	},
+	actionRow{ // S5
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_0:shift(8),		/* ( */
+			token.T_6:shift(9),		/* id */
+			token.T_7:shift(10),		/* num */
+        },
+
+	},
+	actionRow{ // S6
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_3:shift(11),		/* ; */
+        },
+
+	},
+	actionRow{ // S7
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_1:reduce(4),		/* ), reduce: Expr */
+			token.T_2:shift(12),		/* + */
+			token.T_3:reduce(4),		/* ;, reduce: Expr */
+        },
+
+	},
+	actionRow{ // S8
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_0:shift(8),		/* ( */
+			token.T_6:shift(9),		/* id */
+			token.T_7:shift(10),		/* num */
+        },
+
+	},
+	actionRow{ // S9
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_1:reduce(6),		/* ), reduce: Term */
+			token.T_2:reduce(6),		/* +, reduce: Term */
+			token.T_3:reduce(6),		/* ;, reduce: Term */
+        },
+
+	},
+	actionRow{ // S10
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_1:reduce(7),		/* ), reduce: Term */
+			token.T_2:reduce(7),		/* +, reduce: Term */
+			token.T_3:reduce(7),		/* ;, reduce: Term */
+        },
+
+	},
+	actionRow{ // S11
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:reduce(3),		/* $, reduce: Stmt */
+			token.T_6:reduce(3),		/* id, reduce: Stmt */
+        },
+
+	},
+	actionRow{ // S12
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_0:shift(8),		/* ( */
+			token.T_6:shift(9),		/* id */
+			token.T_7:shift(10),		/* num */
+        },
+
+	},
+	actionRow{ // S13
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_1:shift(15),		/* ) */
+        },
+
+	},
+	actionRow{ // S14
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_1:reduce(5),		/* ), reduce: Expr */
+			token.T_3:reduce(5),		/* ;, reduce: Expr */
+        },
+
+	},
+	actionRow{ // S15
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_1:reduce(8),		/* ), reduce: Term */
+			token.T_2:reduce(8),		/* +, reduce: Term */
+			token.T_3:reduce(8),		/* ;, reduce: Term */
+        },
+
+	},
+}
+
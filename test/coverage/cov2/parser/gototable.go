@@ -0,0 +1,126 @@
+
+/*
+*/
+package parser
+
+const numNTSymbols = 4
+type(
+	gotoTable [numStates]gotoRow
+	gotoRow	[numNTSymbols] int
+)
+
+var gotoTab = gotoTable{
+	gotoRow{ // S0
+		-1, // Expr
+        1, // Stmt
+        2, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S1
+		-1, // Expr
+        1, // Stmt
+        4, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S2
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S3
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S4
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S5
+		6, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        7, // Term
+        
+	},
+	gotoRow{ // S6
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S7
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S8
+		13, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        7, // Term
+        
+	},
+	gotoRow{ // S9
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S10
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S11
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S12
+		14, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        7, // Term
+        
+	},
+	gotoRow{ // S13
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S14
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S15
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	
+}
@@ -0,0 +1,124 @@
+
+package parser
+
+import(
+    "github.com/goccmack/gogll/v3/test/coverage/cov2/ast"
+)
+
+type (
+	//TODO: change type and variable names to be consistent with other tables
+	ProdTab      [numProductions]ProdTabEntry
+	ProdTabEntry struct {
+		String     string
+		Id         string
+		// Alternate is the index of the alternate in the syntax rule of Id
+		Alternate  int
+		NTType     int
+		Index int
+		NumSymbols int
+		ReduceFunc func([]interface{}) (interface{}, error)
+	}
+)
+
+var productionsTable = ProdTab {
+	ProdTabEntry{
+		String: `G0 : Stmts ;`,
+		Id: "G0",
+		Alternate: 0,
+		NTType: 0,
+		Index: 0,
+		NumSymbols: 1,
+		ReduceFunc: func(X []interface{}) (interface{}, error) {
+            return ast.G00(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Stmts : Stmt ;`,
+		Id: "Stmts",
+		Alternate: 0,
+		NTType: 2,
+		Index: 1,
+		NumSymbols: 1,
+		ReduceFunc: func(X []interface{}) (interface{}, error) {
+            return ast.Stmts0(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Stmts : Stmt Stmts ;`,
+		Id: "Stmts",
+		Alternate: 1,
+		NTType: 2,
+		Index: 2,
+		NumSymbols: 2,
+		ReduceFunc: func(X []interface{}) (interface{}, error) {
+            return ast.Stmts1(X[0],X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Stmt : id = Expr ; ;`,
+		Id: "Stmt",
+		Alternate: 0,
+		NTType: 1,
+		Index: 3,
+		NumSymbols: 4,
+		ReduceFunc: func(X []interface{}) (interface{}, error) {
+            return ast.Stmt0(X[0],X[1],X[2],X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr : Term ;`,
+		Id: "Expr",
+		Alternate: 0,
+		NTType: 0,
+		Index: 4,
+		NumSymbols: 1,
+		ReduceFunc: func(X []interface{}) (interface{}, error) {
+            return ast.Expr0(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr : Term + Expr ;`,
+		Id: "Expr",
+		Alternate: 1,
+		NTType: 0,
+		Index: 5,
+		NumSymbols: 3,
+		ReduceFunc: func(X []interface{}) (interface{}, error) {
+            return ast.Expr1(X[0],X[1],X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Term : id ;`,
+		Id: "Term",
+		Alternate: 0,
+		NTType: 3,
+		Index: 6,
+		NumSymbols: 1,
+		ReduceFunc: func(X []interface{}) (interface{}, error) {
+            return ast.Term0(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Term : num ;`,
+		Id: "Term",
+		Alternate: 1,
+		NTType: 3,
+		Index: 7,
+		NumSymbols: 1,
+		ReduceFunc: func(X []interface{}) (interface{}, error) {
+            return ast.Term1(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Term : ( Expr ) ;`,
+		Id: "Term",
+		Alternate: 2,
+		NTType: 3,
+		Index: 8,
+		NumSymbols: 3,
+		ReduceFunc: func(X []interface{}) (interface{}, error) {
+            return ast.Term2(X[0],X[1],X[2])
+		},
+	},
+	
+}
@@ -0,0 +1,284 @@
+
+// Package token is generated by GoGLL. Do not edit
+package token
+
+import(
+    "fmt"
+)
+
+// Token is returned by the lexer for every scanned lexical token
+type Token struct {
+    typ        Type
+    lext, rext int
+    input      []rune
+
+    // Trivia attached to the token by lexer.NewWithTrivia
+    leadLext, trailRext int
+    leading, trailing   []*Token
+}
+
+/*
+New returns a new token.
+lext is the left extent and rext the right extent of the token in the input.
+input is the input slice scanned by the lexer.
+*/
+func New(t Type, lext, rext int, input []rune) *Token {
+    return &Token{
+        typ:   t,
+        lext:  lext,
+        rext:  rext,
+        input: input,
+        leadLext:  lext,
+        trailRext: rext,
+    }
+}
+
+// FullLiteral returns the leading trivia, literal and trailing trivia of t
+func (t *Token) FullLiteral() []rune {
+    return t.input[t.leadLext:t.trailRext]
+}
+
+// GetLineColumn returns the line and column of the left extent of t
+func (t *Token) GetLineColumn() (line, col int) {
+    line, col = 1, 1
+    for j := 0; j < t.lext; j++ {
+        switch t.input[j] {
+        case '\n':
+            line++
+            col = 1
+        case '\t':
+            col += 4
+        default:
+            col++
+        }
+    }
+    return
+}
+
+// GetInput returns the input from which t was parsed.
+func (t *Token) GetInput() []rune {
+    return t.input
+}
+
+// LeadingSuppressed returns the suppressed tokens in the leading trivia of t
+func (t *Token) LeadingSuppressed() []*Token {
+    return t.leading
+}
+
+// LeadingTrivia returns the whitespace and suppressed tokens preceding t,
+// which are attached to t by lexer.NewWithTrivia
+func (t *Token) LeadingTrivia() []rune {
+    return t.input[t.leadLext:t.lext]
+}
+
+// Lext returns the left extent of t in the input stream of runes
+func (t *Token) Lext() int {
+    return t.lext
+}
+
+// Literal returns the literal runes of t scanned by the lexer
+func (t *Token) Literal() []rune {
+    return t.input[t.lext:t.rext]
+}
+
+// LiteralString returns string(t.Literal())
+func (t *Token) LiteralString() string {
+    return string(t.Literal())
+}
+
+// LiteralStripEscape returns the literal runes of t scanned by the lexer
+func (t *Token) LiteralStripEscape() []rune {
+	lit := t.Literal()
+	strip := make([]rune, 0, len(lit))
+	for i := 0; i < len(lit); i++ {
+		if lit[i] == '\\' {
+			i++
+			switch lit[i] {
+			case 't':
+				strip = append(strip, '\t')
+			case 'r':
+				strip = append(strip, '\r')
+			case 'n':
+				strip = append(strip, '\r')
+			default:
+				strip = append(strip, lit[i])
+			}
+		} else {
+			strip = append(strip, lit[i])
+		}
+	}
+	return strip
+}
+
+// LiteralStringStripEscape returns string(t.LiteralStripEscape())
+func (t *Token) LiteralStringStripEscape() string {
+	return string(t.LiteralStripEscape())
+}
+
+// Rext returns the right extent of t in the input stream of runes
+func (t *Token) Rext() int {
+    return t.rext
+}
+
+/*
+SetLeadingTrivia sets the leading trivia of t to the input from lext to the
+left extent of t. suppressed contains the suppressed tokens in the trivia.
+*/
+func (t *Token) SetLeadingTrivia(lext int, suppressed []*Token) {
+    t.leadLext, t.leading = lext, suppressed
+}
+
+/*
+SetTrailingTrivia sets the trailing trivia of t to the input from the right
+extent of t to rext. suppressed contains the suppressed tokens in the trivia.
+*/
+func (t *Token) SetTrailingTrivia(rext int, suppressed []*Token) {
+    t.trailRext, t.trailing = rext, suppressed
+}
+
+/*
+Shift returns a copy of t, including its trivia, moved by delta runes in the
+new input. It is used by the lexer to move tokens after an edit of the input.
+*/
+func (t *Token) Shift(delta int, input []rune) *Token {
+    t1 := &Token{
+        typ:       t.typ,
+        lext:      t.lext + delta,
+        rext:      t.rext + delta,
+        input:     input,
+        leadLext:  t.leadLext + delta,
+        trailRext: t.trailRext + delta,
+    }
+    for _, s := range t.leading {
+        t1.leading = append(t1.leading, s.Shift(delta, input))
+    }
+    for _, s := range t.trailing {
+        t1.trailing = append(t1.trailing, s.Shift(delta, input))
+    }
+    return t1
+}
+
+func (t *Token) String() string {
+    return fmt.Sprintf("%s (%d,%d) %s",
+        t.TypeID(), t.lext, t.rext, t.LiteralString())
+}
+
+// Suppress returns true iff t is suppressed by the lexer
+func (t *Token) Suppress() bool {
+	return Suppress[t.typ]
+}
+
+// TrailingSuppressed returns the suppressed tokens in the trailing trivia of t
+func (t *Token) TrailingSuppressed() []*Token {
+    return t.trailing
+}
+
+// TrailingTrivia returns the whitespace and suppressed tokens following t,
+// up to and including the end of the line, which are attached to t by
+// lexer.NewWithTrivia
+func (t *Token) TrailingTrivia() []rune {
+    return t.input[t.rext:t.trailRext]
+}
+
+// Type returns the token Type of t
+func (t *Token) Type() Type {
+    return t.typ
+}
+
+// TypeID returns the token Type ID of t. 
+// This may be different from the literal of token t.
+func (t *Token) TypeID() string {
+    return t.Type().ID()
+}
+
+// Type is the token type
+type Type int
+
+func (t Type) String() string {
+    return TypeToString[t]
+}
+
+// ID returns the token type ID of token Type t
+func (t Type) ID() string {
+    return TypeToID[t]
+}
+
+
+const(
+    Error  Type = iota  // Error 
+    EOF  // $ 
+    T_0  // ( 
+    T_1  // ) 
+    T_2  // + 
+    T_3  // ; 
+    T_4  // = 
+    T_5  // comment 
+    T_6  // id 
+    T_7  // num 
+)
+
+var TypeToString = []string{ 
+    "Error",
+    "EOF",
+    "T_0",
+    "T_1",
+    "T_2",
+    "T_3",
+    "T_4",
+    "T_5",
+    "T_6",
+    "T_7",
+}
+
+var StringToType = map[string] Type { 
+    "Error" : Error, 
+    "EOF" : EOF, 
+    "T_0" : T_0, 
+    "T_1" : T_1, 
+    "T_2" : T_2, 
+    "T_3" : T_3, 
+    "T_4" : T_4, 
+    "T_5" : T_5, 
+    "T_6" : T_6, 
+    "T_7" : T_7, 
+}
+
+var TypeToID = []string { 
+    "Error", 
+    "$", 
+    "(", 
+    ")", 
+    "+", 
+    ";", 
+    "=", 
+    "comment", 
+    "id", 
+    "num", 
+}
+
+var IDToType = map[string]Type { 
+    "Error": 0, 
+    "$": 1, 
+    "(": 2, 
+    ")": 3, 
+    "+": 4, 
+    ";": 5, 
+    "=": 6, 
+    "comment": 7, 
+    "id": 8, 
+    "num": 9, 
+}
+
+var Suppress = []bool { 
+    false, 
+    false, 
+    false, 
+    false, 
+    false, 
+    false, 
+    false, 
+    true, 
+    false, 
+    false, 
+}
+
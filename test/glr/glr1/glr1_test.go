@@ -0,0 +1,88 @@
+package glr1
+
+import (
+	"testing"
+
+	"github.com/goccmack/gogll/v3/test/glr/glr1/lexer"
+	"github.com/goccmack/gogll/v3/test/glr/glr1/parser"
+	"github.com/goccmack/gogll/v3/test/glr/glr1/parser/bsr"
+	"github.com/goccmack/gogll/v3/test/glr/glr1/parser/symbols"
+	"github.com/goccmack/gogll/v3/test/glr/glr1/token"
+)
+
+func TestParse(t *testing.T) {
+	bs, errs := parser.Parse(lexer.New([]rune("a = - b ; c = ( a ) ;")))
+	if errs != nil {
+		t.Fatalf("parse errors: %v", errs)
+	}
+	if bs.IsAmbiguous() {
+		t.Error("unexpected ambiguous parse")
+	}
+	root := bs.GetRoot()
+	if root.Label.Head() != symbols.NT_Stmts || root.Alternate() != 1 {
+		t.Errorf("unexpected root %s", root)
+	}
+	// Sign : empty
+	if sign := root.GetNTChildI(0).GetNTChildI(2).GetNTChildI(0); sign.Alternate() != 0 {
+		t.Errorf("expected Sign : \"-\", got %s", sign)
+	}
+	if sign := root.GetNTChildI(1).GetNTChildI(0).GetNTChildI(2).GetNTChildI(0); sign.Alternate() != 1 {
+		t.Errorf("expected Sign : empty, got %s", sign)
+	}
+}
+
+func TestAmbiguous(t *testing.T) {
+	bs, errs := parser.ParseFrom(symbols.NT_Expr, lexer.New([]rune("a + b * c")))
+	if errs != nil {
+		t.Fatalf("parse errors: %v", errs)
+	}
+	if !bs.IsAmbiguous() {
+		t.Error("expected an ambiguous parse")
+	}
+	// Expr : Expr Op Expr with pivot after a + and after a + b
+	if roots := bs.GetRoots(); len(roots) != 2 {
+		t.Errorf("expected 2 roots, got %d", len(roots))
+	}
+	if sppf := bs.ToSPPF(); len(sppf.Children) != 2 {
+		t.Errorf("expected 2 packed nodes of the root, got %d", len(sppf.Children))
+	}
+}
+
+// The GLR parser reduces the same BSR on each stack path that reaches it.
+// The children of a BSR must not contain the same BSR twice.
+func TestNoDuplicates(t *testing.T) {
+	bs, errs := parser.ParseFrom(symbols.NT_Expr, lexer.New([]rune("a + b * c + d")))
+	if errs != nil {
+		t.Fatalf("parse errors: %v", errs)
+	}
+	for _, b := range bs.GetAll() {
+		for i, sym := range b.Label.Symbols() {
+			if !sym.IsNonTerminal() {
+				continue
+			}
+			children := map[bsr.BSR]bool{}
+			for _, c := range b.GetNTChildrenI(i) {
+				if children[c] {
+					t.Errorf("duplicate child %d %s of %s", i, c, b)
+				}
+				children[c] = true
+			}
+		}
+	}
+}
+
+func TestError(t *testing.T) {
+	_, errs := parser.Parse(lexer.New([]rune("a = b ; c = ;")))
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d", len(errs))
+	}
+	err := errs[0]
+	if err.Token.LiteralString() != ";" || err.Line != 1 || err.Column != 13 {
+		t.Errorf("unexpected error %s", err)
+	}
+	for _, typ := range []token.Type{token.T_0, token.T_4, token.T_7, token.T_8} {
+		if _, exist := err.Expected[typ]; !exist {
+			t.Errorf("expected token %s is missing in %s", typ.ID(), err)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+// Package parser is generated by gogll. Do not edit.
+
+package parser
+
+import (
+	"github.com/goccmack/gogll/v3/test/glr/glr1/parser/slot"
+	"github.com/goccmack/gogll/v3/test/glr/glr1/parser/symbols"
+	"github.com/goccmack/gogll/v3/test/glr/glr1/token"
+)
+
+type (
+	action interface{}
+	accept bool
+	reduce int
+	shift  int
+)
+
+const numStates = 22
+
+// actionTab contains all the actions of each state for each token. The
+// actions of the LR(1) conflicts of a state have more than one entry.
+var actionTab = [numStates]map[token.Type][]action{
+	{ // S0
+		token.T_7: {shift(4)}, // id
+	},
+	{ // S1
+		token.T_0: {reduce(8)}, // (
+		token.T_4: {shift(7)},  // -
+		token.T_7: {reduce(8)}, // id
+		token.T_8: {reduce(8)}, // num
+	},
+	{ // S2
+		token.EOF: {reduce(2)}, // $
+		token.T_7: {shift(4)},  // id
+	},
+	{ // S3
+		token.EOF: {accept(true)}, // $
+	},
+	{ // S4
+		token.T_6: {shift(9)}, // =
+	},
+	{ // S5
+		token.EOF: {accept(true)}, // $
+		token.T_2: {shift(11)},    // *
+		token.T_3: {shift(12)},    // +
+	},
+	{ // S6
+		token.T_0: {shift(14)}, // (
+		token.T_7: {shift(15)}, // id
+		token.T_8: {shift(16)}, // num
+	},
+	{ // S7
+		token.T_0: {reduce(7)}, // (
+		token.T_7: {reduce(7)}, // id
+		token.T_8: {reduce(7)}, // num
+	},
+	{ // S8
+		token.EOF: {reduce(3)}, // $
+	},
+	{ // S9
+		token.T_0: {reduce(8)}, // (
+		token.T_4: {shift(7)},  // -
+		token.T_7: {reduce(8)}, // id
+		token.T_8: {reduce(8)}, // num
+	},
+	{ // S10
+		token.T_0: {reduce(8)}, // (
+		token.T_4: {shift(7)},  // -
+		token.T_7: {reduce(8)}, // id
+		token.T_8: {reduce(8)}, // num
+	},
+	{ // S11
+		token.T_0: {reduce(10)}, // (
+		token.T_4: {reduce(10)}, // -
+		token.T_7: {reduce(10)}, // id
+		token.T_8: {reduce(10)}, // num
+	},
+	{ // S12
+		token.T_0: {reduce(9)}, // (
+		token.T_4: {reduce(9)}, // -
+		token.T_7: {reduce(9)}, // id
+		token.T_8: {reduce(9)}, // num
+	},
+	{ // S13
+		token.EOF: {reduce(6)}, // $
+		token.T_1: {reduce(6)}, // )
+		token.T_2: {reduce(6)}, // *
+		token.T_3: {reduce(6)}, // +
+		token.T_5: {reduce(6)}, // ;
+	},
+	{ // S14
+		token.T_0: {reduce(8)}, // (
+		token.T_4: {shift(7)},  // -
+		token.T_7: {reduce(8)}, // id
+		token.T_8: {reduce(8)}, // num
+	},
+	{ // S15
+		token.EOF: {reduce(11)}, // $
+		token.T_1: {reduce(11)}, // )
+		token.T_2: {reduce(11)}, // *
+		token.T_3: {reduce(11)}, // +
+		token.T_5: {reduce(11)}, // ;
+	},
+	{ // S16
+		token.EOF: {reduce(12)}, // $
+		token.T_1: {reduce(12)}, // )
+		token.T_2: {reduce(12)}, // *
+		token.T_3: {reduce(12)}, // +
+		token.T_5: {reduce(12)}, // ;
+	},
+	{ // S17
+		token.T_2: {shift(11)}, // *
+		token.T_3: {shift(12)}, // +
+		token.T_5: {shift(20)}, // ;
+	},
+	{ // S18
+		token.EOF: {reduce(5)},            // $
+		token.T_1: {reduce(5)},            // )
+		token.T_2: {shift(11), reduce(5)}, // *
+		token.T_3: {shift(12), reduce(5)}, // +
+		token.T_5: {reduce(5)},            // ;
+	},
+	{ // S19
+		token.T_1: {shift(21)}, // )
+		token.T_2: {shift(11)}, // *
+		token.T_3: {shift(12)}, // +
+	},
+	{ // S20
+		token.EOF: {reduce(4)}, // $
+		token.T_7: {reduce(4)}, // id
+	},
+	{ // S21
+		token.EOF: {reduce(13)}, // $
+		token.T_1: {reduce(13)}, // )
+		token.T_2: {reduce(13)}, // *
+		token.T_3: {reduce(13)}, // +
+		token.T_5: {reduce(13)}, // ;
+	},
+}
+
+var gotoTab = [numStates]map[symbols.NT]int{
+	{ // S0
+		symbols.NT_Stmt:  2,
+		symbols.NT_Stmts: 3,
+	},
+	{ // S1
+		symbols.NT_Expr: 5,
+		symbols.NT_Sign: 6,
+	},
+	{ // S2
+		symbols.NT_Stmt:  2,
+		symbols.NT_Stmts: 8,
+	},
+	{ // S3
+	},
+	{ // S4
+	},
+	{ // S5
+		symbols.NT_Op: 10,
+	},
+	{ // S6
+		symbols.NT_Term: 13,
+	},
+	{ // S7
+	},
+	{ // S8
+	},
+	{ // S9
+		symbols.NT_Expr: 17,
+		symbols.NT_Sign: 6,
+	},
+	{ // S10
+		symbols.NT_Expr: 18,
+		symbols.NT_Sign: 6,
+	},
+	{ // S11
+	},
+	{ // S12
+	},
+	{ // S13
+	},
+	{ // S14
+		symbols.NT_Expr: 19,
+		symbols.NT_Sign: 6,
+	},
+	{ // S15
+	},
+	{ // S16
+	},
+	{ // S17
+		symbols.NT_Op: 10,
+	},
+	{ // S18
+		symbols.NT_Op: 10,
+	},
+	{ // S19
+		symbols.NT_Op: 10,
+	},
+	{ // S20
+	},
+	{ // S21
+	},
+}
+
+// production is a basic production of the grammar. labels[i] is the grammar
+// slot after symbol i of the body.
+type production struct {
+	nt     symbols.NT
+	labels []slot.Label
+}
+
+// productions contains the basic productions of the grammar. The augmented
+// start productions are accepted and not reduced.
+var productions = []*production{
+	// G0 : Stmts ;
+	nil,
+	// G1 : Expr ;
+	nil,
+	// Stmts : Stmt ;
+	{symbols.NT_Stmts, []slot.Label{slot.Stmts0R0, slot.Stmts0R1}},
+	// Stmts : Stmt Stmts ;
+	{symbols.NT_Stmts, []slot.Label{slot.Stmts1R0, slot.Stmts1R1, slot.Stmts1R2}},
+	// Stmt : id = Expr ; ;
+	{symbols.NT_Stmt, []slot.Label{slot.Stmt0R0, slot.Stmt0R1, slot.Stmt0R2, slot.Stmt0R3, slot.Stmt0R4}},
+	// Expr : Expr Op Expr ;
+	{symbols.NT_Expr, []slot.Label{slot.Expr0R0, slot.Expr0R1, slot.Expr0R2, slot.Expr0R3}},
+	// Expr : Sign Term ;
+	{symbols.NT_Expr, []slot.Label{slot.Expr1R0, slot.Expr1R1, slot.Expr1R2}},
+	// Sign : - ;
+	{symbols.NT_Sign, []slot.Label{slot.Sign0R0, slot.Sign0R1}},
+	// Sign :  ;
+	{symbols.NT_Sign, []slot.Label{slot.Sign1R0}},
+	// Op : + ;
+	{symbols.NT_Op, []slot.Label{slot.Op0R0, slot.Op0R1}},
+	// Op : * ;
+	{symbols.NT_Op, []slot.Label{slot.Op1R0, slot.Op1R1}},
+	// Term : id ;
+	{symbols.NT_Term, []slot.Label{slot.Term0R0, slot.Term0R1}},
+	// Term : num ;
+	{symbols.NT_Term, []slot.Label{slot.Term1R0, slot.Term1R1}},
+	// Term : ( Expr ) ;
+	{symbols.NT_Term, []slot.Label{slot.Term2R0, slot.Term2R1, slot.Term2R2, slot.Term2R3}},
+}
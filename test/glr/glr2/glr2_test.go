@@ -0,0 +1,30 @@
+package glr2
+
+import (
+	"testing"
+
+	"github.com/goccmack/gogll/v3/test/glr/glr2/lexer"
+	"github.com/goccmack/gogll/v3/test/glr/glr2/parser"
+	"github.com/goccmack/gogll/v3/test/glr/glr2/parser/symbols"
+)
+
+func TestHiddenLeftRecursion(t *testing.T) {
+	for _, src := range []string{"x", "xb", "xbb", "axbb", "aaxbbb"} {
+		bs, errs := parser.Parse(lexer.New([]rune(src)))
+		if errs != nil {
+			t.Errorf("%s: parse errors: %v", src, errs)
+			continue
+		}
+		if root := bs.GetRoot(); root.Label.Head() != symbols.NT_S {
+			t.Errorf("%s: unexpected root %s", src, root)
+		}
+	}
+}
+
+func TestError(t *testing.T) {
+	for _, src := range []string{"ab", "xx", "axbbx"} {
+		if _, errs := parser.Parse(lexer.New([]rune(src))); errs == nil {
+			t.Errorf("%s: expected parse errors", src)
+		}
+	}
+}
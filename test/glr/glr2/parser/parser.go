@@ -0,0 +1,313 @@
+// Package parser is generated by gogll. Do not edit.
+
+/*
+Package parser implements a GLR parser, which is driven by the LR(1) tables of
+the grammar. In states with LR(1) conflicts the parser follows all the
+conflicting actions on a graph structured stack (GSS). Every reduction adds
+the BSRs of its path in the GSS to the BSR set, which contains the same parse
+forest as the BSR set of the GLL parser of the grammar.
+*/
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/goccmack/gogll/v3/test/glr/glr2/lexer"
+	"github.com/goccmack/gogll/v3/test/glr/glr2/parser/bsr"
+	"github.com/goccmack/gogll/v3/test/glr/glr2/parser/symbols"
+	"github.com/goccmack/gogll/v3/test/glr/glr2/token"
+)
+
+type parser struct {
+	lex         *lexer.Lexer
+	parseErrors []*Error
+
+	start      symbols.NT
+	startState int
+	bsrSet     *bsr.Set
+}
+
+// StartSymbols contains the start symbols of the grammar, which can be parsed
+// by ParseFrom. The first is the default start symbol, which is parsed by Parse.
+var StartSymbols = []symbols.NT{
+	symbols.NT_S,
+}
+
+func newParser(start symbols.NT, startState int, l *lexer.Lexer) *parser {
+	return &parser{
+		lex:        l,
+		start:      start,
+		startState: startState,
+		bsrSet:     bsr.New(start, l),
+	}
+}
+
+// Parse returns the BSR set containing the parse forest of the default start
+// symbol.
+// If the parse was successfull []*Error is nil.
+// If the lexer found lexical errors they are returned first in []*Error.
+func Parse(l *lexer.Lexer) (*bsr.Set, []*Error) {
+	return ParseFrom(symbols.NT_S, l)
+}
+
+// ParseFrom is like Parse but parses start symbol nt, which must be one of
+// StartSymbols.
+func ParseFrom(nt symbols.NT, l *lexer.Lexer) (*bsr.Set, []*Error) {
+	for i, start := range StartSymbols {
+		if start == nt {
+			return newParser(nt, i, l).parse()
+		}
+	}
+	panic(fmt.Sprintf("%s is not a start symbol", nt))
+}
+
+func (p *parser) parse() (*bsr.Set, []*Error) {
+	m := len(p.lex.Tokens) - 1
+	U := newLevel(0)
+	U.getNode(p.startState)
+	for i := 0; ; i++ {
+		tok := p.lex.Tokens[i].Type()
+		p.reduce(U, tok)
+		if i == m {
+			if !p.accept(U) {
+				p.parseError(U, i)
+			}
+			break
+		}
+		next := p.shift(U, tok)
+		if len(next.nodes) == 0 {
+			p.parseError(U, i)
+			break
+		}
+		U = next
+	}
+	if len(p.lex.Errors) > 0 || !p.bsrSet.Contain(p.start, 0, m) {
+		p.sortParseErrors()
+		return nil, append(p.lexErrors(), p.parseErrors...)
+	}
+	return p.bsrSet, nil
+}
+
+// accept returns true if a node of U accepts the input
+func (p *parser) accept(U *gssLevel) bool {
+	for _, v := range U.nodes {
+		for _, a := range actionTab[v.state][token.EOF] {
+			if _, ok := a.(accept); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// shift returns the next level of the GSS after shifting tok from the nodes
+// of U.
+func (p *parser) shift(U *gssLevel, tok token.Type) *gssLevel {
+	next := newLevel(U.level + 1)
+	for _, v := range U.nodes {
+		for _, a := range actionTab[v.state][tok] {
+			if s, ok := a.(shift); ok {
+				w, _ := next.getNode(int(s))
+				w.addEdge(v)
+			}
+		}
+	}
+	return next
+}
+
+/*
+reduce performs all the reductions of the nodes of U with lookahead tok.
+When a reduction adds an edge to an existing node of U the reductions of U
+are repeated until no more edges are added, which also performs the
+reductions along the new edge.
+*/
+func (p *parser) reduce(U *gssLevel, tok token.Type) {
+	for changed := true; changed; {
+		changed = false
+		for i := 0; i < len(U.nodes); i++ {
+			v := U.nodes[i]
+			for _, a := range actionTab[v.state][tok] {
+				if r, ok := a.(reduce); ok && p.reducePaths(U, v, productions[r]) {
+					changed = true
+				}
+			}
+		}
+	}
+}
+
+// reducePaths reduces production r along every path of length |body of r|
+// from v. It returns true if an edge was added to an existing node of U.
+func (p *parser) reducePaths(U *gssLevel, v *gssNode, r *production) (changed bool) {
+	// ext contains the levels of the nodes on the path: the extents of the
+	// symbols of the body.
+	ext := make([]int, len(r.labels))
+	var walk func(u *gssNode, k int)
+	walk = func(u *gssNode, k int) {
+		ext[k] = u.level
+		if k > 0 {
+			for _, w := range u.edges {
+				walk(w, k-1)
+			}
+			return
+		}
+		p.addBSRs(r, ext)
+		if p.goTo(U, u, r.nt) {
+			changed = true
+		}
+	}
+	walk(v, len(r.labels)-1)
+	return
+}
+
+// addBSRs adds the BSRs of reduction r with the extents ext of the symbols of
+// the body of r.
+func (p *parser) addBSRs(r *production, ext []int) {
+	if len(ext) == 1 {
+		p.bsrSet.AddEmpty(r.labels[0], ext[0])
+		return
+	}
+	for k := 1; k < len(ext); k++ {
+		p.bsrSet.Add(r.labels[k], ext[0], ext[k-1], ext[k])
+	}
+}
+
+// goTo adds an edge from the node of the goto state of u and nt in U to u. It
+// returns true if the edge was added to an existing node.
+func (p *parser) goTo(U *gssLevel, u *gssNode, nt symbols.NT) bool {
+	w, exist := U.getNode(gotoTab[u.state][nt])
+	return w.addEdge(u) && exist
+}
+
+/*** Graph Structured Stack ***/
+
+// gssNode is the LR(1) state of a stack of the parser after the tokens before
+// level have been parsed.
+type gssNode struct {
+	state int
+	level int
+	// edges point to the predecessors of the node on its stacks
+	edges []*gssNode
+}
+
+// gssLevel contains the nodes of the GSS at one level
+type gssLevel struct {
+	level  int
+	nodes  []*gssNode
+	states map[int]*gssNode
+}
+
+func newLevel(level int) *gssLevel {
+	return &gssLevel{
+		level:  level,
+		states: make(map[int]*gssNode),
+	}
+}
+
+// getNode returns the node of state in l. If the node does not exist it is
+// created and exist is false.
+func (l *gssLevel) getNode(state int) (nd *gssNode, exist bool) {
+	if nd, exist = l.states[state]; !exist {
+		nd = &gssNode{state: state, level: l.level}
+		l.states[state] = nd
+		l.nodes = append(l.nodes, nd)
+	}
+	return
+}
+
+// addEdge adds an edge from u to v. It returns false if the edge exists.
+func (u *gssNode) addEdge(v *gssNode) bool {
+	for _, w := range u.edges {
+		if w == v {
+			return false
+		}
+	}
+	u.edges = append(u.edges, v)
+	return true
+}
+
+func (u *gssNode) String() string {
+	return fmt.Sprintf("(S%d,%d)", u.state, u.level)
+}
+
+/*** Errors ***/
+
+/*
+Error is returned by Parse at the input position at which all the stacks of
+the parser failed.
+*/
+type Error struct {
+	// Index of token that caused the error.
+	cI int
+
+	// The token at which the error occurred.
+	Token *token.Token
+
+	// The line and column in the input text at which the error occurred
+	Line, Column int
+
+	// The tokens expected at the point where the error occurred
+	Expected map[token.Type]string
+
+	// LexError is not nil if the error is a lexical error
+	LexError *lexer.LexError
+}
+
+func (pe *Error) String() string {
+	if pe.LexError != nil {
+		return pe.LexError.Error()
+	}
+	w := new(bytes.Buffer)
+	fmt.Fprintf(w, "Parse Error: I[%d]=%s at line %d col %d\n",
+		pe.cI, pe.Token, pe.Line, pe.Column)
+	exp := []string{}
+	for _, e := range pe.Expected {
+		exp = append(exp, e)
+	}
+	sort.Strings(exp)
+	fmt.Fprintf(w, "Expected one of: [%s]", strings.Join(exp, ","))
+	return w.String()
+}
+
+// parseError adds the error at token i, which is expected by none of the
+// nodes of U.
+func (p *parser) parseError(U *gssLevel, i int) {
+	expected := map[token.Type]string{}
+	for _, v := range U.nodes {
+		for t := range actionTab[v.state] {
+			expected[t] = t.ID()
+		}
+	}
+	pe := &Error{cI: i, Token: p.lex.Tokens[i], Expected: expected}
+	p.parseErrors = append(p.parseErrors, pe)
+}
+
+// lexErrors returns an Error for every lexical error in the input
+func (p *parser) lexErrors() (errs []*Error) {
+	for _, le := range p.lex.Errors {
+		for i, tok := range p.lex.Tokens {
+			if tok == le.Token {
+				errs = append(errs, &Error{
+					cI:       i,
+					Token:    tok,
+					Line:     le.Line,
+					Column:   le.Column,
+					LexError: le,
+				})
+			}
+		}
+	}
+	return
+}
+
+func (p *parser) sortParseErrors() {
+	sort.Slice(p.parseErrors,
+		func(i, j int) bool {
+			return p.parseErrors[j].Token.Lext() < p.parseErrors[i].Token.Lext()
+		})
+	for _, pe := range p.parseErrors {
+		pe.Line, pe.Column = p.lex.GetLineColumn(pe.Token.Lext())
+	}
+}
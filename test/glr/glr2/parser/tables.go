@@ -0,0 +1,92 @@
+// Package parser is generated by gogll. Do not edit.
+
+package parser
+
+import (
+	"github.com/goccmack/gogll/v3/test/glr/glr2/parser/slot"
+	"github.com/goccmack/gogll/v3/test/glr/glr2/parser/symbols"
+	"github.com/goccmack/gogll/v3/test/glr/glr2/token"
+)
+
+type (
+	action interface{}
+	accept bool
+	reduce int
+	shift  int
+)
+
+const numStates = 7
+
+// actionTab contains all the actions of each state for each token. The
+// actions of the LR(1) conflicts of a state have more than one entry.
+var actionTab = [numStates]map[token.Type][]action{
+	{ // S0
+		token.T_0: {shift(3), reduce(4)}, // a
+		token.T_2: {shift(4), reduce(4)}, // x
+	},
+	{ // S1
+		token.T_0: {shift(3), reduce(4)}, // a
+		token.T_2: {shift(4), reduce(4)}, // x
+	},
+	{ // S2
+		token.EOF: {accept(true)}, // $
+	},
+	{ // S3
+		token.T_0: {reduce(3)}, // a
+		token.T_2: {reduce(3)}, // x
+	},
+	{ // S4
+		token.EOF: {reduce(2)}, // $
+		token.T_1: {reduce(2)}, // b
+	},
+	{ // S5
+		token.T_1: {shift(6)}, // b
+	},
+	{ // S6
+		token.EOF: {reduce(1)}, // $
+		token.T_1: {reduce(1)}, // b
+	},
+}
+
+var gotoTab = [numStates]map[symbols.NT]int{
+	{ // S0
+		symbols.NT_A: 1,
+		symbols.NT_S: 2,
+	},
+	{ // S1
+		symbols.NT_A: 1,
+		symbols.NT_S: 5,
+	},
+	{ // S2
+	},
+	{ // S3
+	},
+	{ // S4
+	},
+	{ // S5
+	},
+	{ // S6
+	},
+}
+
+// production is a basic production of the grammar. labels[i] is the grammar
+// slot after symbol i of the body.
+type production struct {
+	nt     symbols.NT
+	labels []slot.Label
+}
+
+// productions contains the basic productions of the grammar. The augmented
+// start productions are accepted and not reduced.
+var productions = []*production{
+	// G0 : S ;
+	nil,
+	// S : A S b ;
+	{symbols.NT_S, []slot.Label{slot.S0R0, slot.S0R1, slot.S0R2, slot.S0R3}},
+	// S : x ;
+	{symbols.NT_S, []slot.Label{slot.S1R0, slot.S1R1}},
+	// A : a ;
+	{symbols.NT_A, []slot.Label{slot.A0R0, slot.A0R1}},
+	// A :  ;
+	{symbols.NT_A, []slot.Label{slot.A1R0}},
+}
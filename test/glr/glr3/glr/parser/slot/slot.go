@@ -0,0 +1,361 @@
+
+// Package slot is generated by gogll. Do not edit. 
+package slot
+
+import(
+	"bytes"
+	"fmt"
+	
+	"github.com/goccmack/gogll/v3/test/glr/glr3/glr/parser/symbols"
+)
+
+type Label int
+
+const(
+	E0R0 Label = iota
+	E0R1
+	E0R2
+	E0R3
+	E1R0
+	E1R1
+	E1R2
+	E1R3
+	E2R0
+	E2R1
+	E2R2
+	E3R0
+	E3R1
+	E3R2
+	E4R0
+	E4R1
+	E4R2
+	E5R0
+	E5R1
+	E5R2
+	E5R3
+	P0R0
+	P0R1
+	P1R0
+)
+
+type Slot struct {
+	NT      symbols.NT
+	Alt     int
+	Pos     int
+	Symbols symbols.Symbols
+	Label 	Label
+}
+
+type Index struct {
+	NT      symbols.NT
+	Alt     int
+	Pos     int
+}
+
+func GetAlternates(nt symbols.NT) []Label {
+	alts, exist := alternates[nt]
+	if !exist {
+		panic(fmt.Sprintf("Invalid NT %s", nt))
+	}
+	return alts
+}
+
+func GetLabel(nt symbols.NT, alt, pos int) Label {
+	l, exist := slotIndex[Index{nt,alt,pos}]
+	if exist {
+		return l
+	}
+	panic(fmt.Sprintf("Error: no slot label for NT=%s, alt=%d, pos=%d", nt, alt, pos))
+}
+
+func (l Label) EoR() bool {
+	return l.Slot().EoR()
+}
+
+func (l Label) Head() symbols.NT {
+	return l.Slot().NT
+}
+
+func (l Label) Index() Index {
+	s := l.Slot()
+	return Index{s.NT, s.Alt, s.Pos}
+}
+
+func (l Label) Alternate() int {
+	return l.Slot().Alt
+}
+
+func (l Label) Pos() int {
+	return l.Slot().Pos
+}
+
+func (l Label) Slot() *Slot {
+	s, exist := slots[l]
+	if !exist {
+		panic(fmt.Sprintf("Invalid slot label %d", l))
+	}
+	return s
+}
+
+func (l Label) String() string {
+	return l.Slot().String()
+}
+
+func (l Label) Symbols() symbols.Symbols {
+	return l.Slot().Symbols
+}
+
+func (s *Slot) EoR() bool {
+	return s.Pos >= len(s.Symbols)
+}
+
+func (s *Slot) String() string {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "%s : ", s.NT)
+	for i, sym := range s.Symbols {
+		if i == s.Pos {
+			fmt.Fprintf(buf, "∙")
+		}
+		fmt.Fprintf(buf, "%s ", sym)
+	}
+	if s.Pos >= len(s.Symbols) {
+		fmt.Fprintf(buf, "∙")
+	}
+	return buf.String()
+}
+
+var slots = map[Label]*Slot{ 
+	E0R0: {
+		symbols.NT_E, 0, 0, 
+		symbols.Symbols{  
+			symbols.NT_E, 
+			symbols.T_3, 
+			symbols.NT_E,
+		}, 
+		E0R0, 
+	},
+	E0R1: {
+		symbols.NT_E, 0, 1, 
+		symbols.Symbols{  
+			symbols.NT_E, 
+			symbols.T_3, 
+			symbols.NT_E,
+		}, 
+		E0R1, 
+	},
+	E0R2: {
+		symbols.NT_E, 0, 2, 
+		symbols.Symbols{  
+			symbols.NT_E, 
+			symbols.T_3, 
+			symbols.NT_E,
+		}, 
+		E0R2, 
+	},
+	E0R3: {
+		symbols.NT_E, 0, 3, 
+		symbols.Symbols{  
+			symbols.NT_E, 
+			symbols.T_3, 
+			symbols.NT_E,
+		}, 
+		E0R3, 
+	},
+	E1R0: {
+		symbols.NT_E, 1, 0, 
+		symbols.Symbols{  
+			symbols.NT_E, 
+			symbols.T_2, 
+			symbols.NT_E,
+		}, 
+		E1R0, 
+	},
+	E1R1: {
+		symbols.NT_E, 1, 1, 
+		symbols.Symbols{  
+			symbols.NT_E, 
+			symbols.T_2, 
+			symbols.NT_E,
+		}, 
+		E1R1, 
+	},
+	E1R2: {
+		symbols.NT_E, 1, 2, 
+		symbols.Symbols{  
+			symbols.NT_E, 
+			symbols.T_2, 
+			symbols.NT_E,
+		}, 
+		E1R2, 
+	},
+	E1R3: {
+		symbols.NT_E, 1, 3, 
+		symbols.Symbols{  
+			symbols.NT_E, 
+			symbols.T_2, 
+			symbols.NT_E,
+		}, 
+		E1R3, 
+	},
+	E2R0: {
+		symbols.NT_E, 2, 0, 
+		symbols.Symbols{  
+			symbols.T_4, 
+			symbols.NT_E,
+		}, 
+		E2R0, 
+	},
+	E2R1: {
+		symbols.NT_E, 2, 1, 
+		symbols.Symbols{  
+			symbols.T_4, 
+			symbols.NT_E,
+		}, 
+		E2R1, 
+	},
+	E2R2: {
+		symbols.NT_E, 2, 2, 
+		symbols.Symbols{  
+			symbols.T_4, 
+			symbols.NT_E,
+		}, 
+		E2R2, 
+	},
+	E3R0: {
+		symbols.NT_E, 3, 0, 
+		symbols.Symbols{  
+			symbols.NT_E, 
+			symbols.NT_E,
+		}, 
+		E3R0, 
+	},
+	E3R1: {
+		symbols.NT_E, 3, 1, 
+		symbols.Symbols{  
+			symbols.NT_E, 
+			symbols.NT_E,
+		}, 
+		E3R1, 
+	},
+	E3R2: {
+		symbols.NT_E, 3, 2, 
+		symbols.Symbols{  
+			symbols.NT_E, 
+			symbols.NT_E,
+		}, 
+		E3R2, 
+	},
+	E4R0: {
+		symbols.NT_E, 4, 0, 
+		symbols.Symbols{  
+			symbols.NT_P, 
+			symbols.T_6,
+		}, 
+		E4R0, 
+	},
+	E4R1: {
+		symbols.NT_E, 4, 1, 
+		symbols.Symbols{  
+			symbols.NT_P, 
+			symbols.T_6,
+		}, 
+		E4R1, 
+	},
+	E4R2: {
+		symbols.NT_E, 4, 2, 
+		symbols.Symbols{  
+			symbols.NT_P, 
+			symbols.T_6,
+		}, 
+		E4R2, 
+	},
+	E5R0: {
+		symbols.NT_E, 5, 0, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.NT_E, 
+			symbols.T_1,
+		}, 
+		E5R0, 
+	},
+	E5R1: {
+		symbols.NT_E, 5, 1, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.NT_E, 
+			symbols.T_1,
+		}, 
+		E5R1, 
+	},
+	E5R2: {
+		symbols.NT_E, 5, 2, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.NT_E, 
+			symbols.T_1,
+		}, 
+		E5R2, 
+	},
+	E5R3: {
+		symbols.NT_E, 5, 3, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.NT_E, 
+			symbols.T_1,
+		}, 
+		E5R3, 
+	},
+	P0R0: {
+		symbols.NT_P, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_5,
+		}, 
+		P0R0, 
+	},
+	P0R1: {
+		symbols.NT_P, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_5,
+		}, 
+		P0R1, 
+	},
+	P1R0: {
+		symbols.NT_P, 1, 0, 
+		symbols.Symbols{ 
+		}, 
+		P1R0, 
+	},
+}
+
+var slotIndex = map[Index]Label { 
+	Index{ symbols.NT_E,0,0 }: E0R0,
+	Index{ symbols.NT_E,0,1 }: E0R1,
+	Index{ symbols.NT_E,0,2 }: E0R2,
+	Index{ symbols.NT_E,0,3 }: E0R3,
+	Index{ symbols.NT_E,1,0 }: E1R0,
+	Index{ symbols.NT_E,1,1 }: E1R1,
+	Index{ symbols.NT_E,1,2 }: E1R2,
+	Index{ symbols.NT_E,1,3 }: E1R3,
+	Index{ symbols.NT_E,2,0 }: E2R0,
+	Index{ symbols.NT_E,2,1 }: E2R1,
+	Index{ symbols.NT_E,2,2 }: E2R2,
+	Index{ symbols.NT_E,3,0 }: E3R0,
+	Index{ symbols.NT_E,3,1 }: E3R1,
+	Index{ symbols.NT_E,3,2 }: E3R2,
+	Index{ symbols.NT_E,4,0 }: E4R0,
+	Index{ symbols.NT_E,4,1 }: E4R1,
+	Index{ symbols.NT_E,4,2 }: E4R2,
+	Index{ symbols.NT_E,5,0 }: E5R0,
+	Index{ symbols.NT_E,5,1 }: E5R1,
+	Index{ symbols.NT_E,5,2 }: E5R2,
+	Index{ symbols.NT_E,5,3 }: E5R3,
+	Index{ symbols.NT_P,0,0 }: P0R0,
+	Index{ symbols.NT_P,0,1 }: P0R1,
+	Index{ symbols.NT_P,1,0 }: P1R0,
+}
+
+var alternates = map[symbols.NT][]Label{ 
+	symbols.NT_E:[]Label{ E0R0,E1R0,E2R0,E3R0,E4R0,E5R0 },
+	symbols.NT_P:[]Label{ P0R0,P1R0 },
+}
+
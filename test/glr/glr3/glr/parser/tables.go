@@ -0,0 +1,222 @@
+// Package parser is generated by gogll. Do not edit.
+
+package parser
+
+import (
+	"github.com/goccmack/gogll/v3/test/glr/glr3/glr/parser/slot"
+	"github.com/goccmack/gogll/v3/test/glr/glr3/glr/parser/symbols"
+	"github.com/goccmack/gogll/v3/test/glr/glr3/glr/token"
+)
+
+type (
+	action interface{}
+	accept bool
+	reduce int
+	shift  int
+)
+
+const numStates = 15
+
+// actionTab contains all the actions of each state for each token. The
+// actions of the LR(1) conflicts of a state have more than one entry.
+var actionTab = [numStates]map[token.Type][]action{
+	{ // S0
+		token.T_0: {shift(3)},  // (
+		token.T_4: {shift(4)},  // -
+		token.T_5: {shift(5)},  // p
+		token.T_6: {reduce(8)}, // x
+	},
+	{ // S1
+		token.EOF: {accept(true)}, // $
+		token.T_0: {shift(3)},     // (
+		token.T_2: {shift(7)},     // *
+		token.T_3: {shift(8)},     // +
+		token.T_4: {shift(4)},     // -
+		token.T_5: {shift(5)},     // p
+		token.T_6: {reduce(8)},    // x
+	},
+	{ // S2
+		token.T_6: {shift(9)}, // x
+	},
+	{ // S3
+		token.T_0: {shift(3)},  // (
+		token.T_4: {shift(4)},  // -
+		token.T_5: {shift(5)},  // p
+		token.T_6: {reduce(8)}, // x
+	},
+	{ // S4
+		token.T_0: {shift(3)},  // (
+		token.T_4: {shift(4)},  // -
+		token.T_5: {shift(5)},  // p
+		token.T_6: {reduce(8)}, // x
+	},
+	{ // S5
+		token.T_6: {reduce(7)}, // x
+	},
+	{ // S6
+		token.EOF: {reduce(4)},            // $
+		token.T_0: {shift(3), reduce(4)},  // (
+		token.T_1: {reduce(4)},            // )
+		token.T_2: {shift(7), reduce(4)},  // *
+		token.T_3: {shift(8), reduce(4)},  // +
+		token.T_4: {shift(4), reduce(4)},  // -
+		token.T_5: {shift(5), reduce(4)},  // p
+		token.T_6: {reduce(4), reduce(8)}, // x
+	},
+	{ // S7
+		token.T_0: {shift(3)},  // (
+		token.T_4: {shift(4)},  // -
+		token.T_5: {shift(5)},  // p
+		token.T_6: {reduce(8)}, // x
+	},
+	{ // S8
+		token.T_0: {shift(3)},  // (
+		token.T_4: {shift(4)},  // -
+		token.T_5: {shift(5)},  // p
+		token.T_6: {reduce(8)}, // x
+	},
+	{ // S9
+		token.EOF: {reduce(5)}, // $
+		token.T_0: {reduce(5)}, // (
+		token.T_1: {reduce(5)}, // )
+		token.T_2: {reduce(5)}, // *
+		token.T_3: {reduce(5)}, // +
+		token.T_4: {reduce(5)}, // -
+		token.T_5: {reduce(5)}, // p
+		token.T_6: {reduce(5)}, // x
+	},
+	{ // S10
+		token.T_0: {shift(3)},  // (
+		token.T_1: {shift(14)}, // )
+		token.T_2: {shift(7)},  // *
+		token.T_3: {shift(8)},  // +
+		token.T_4: {shift(4)},  // -
+		token.T_5: {shift(5)},  // p
+		token.T_6: {reduce(8)}, // x
+	},
+	{ // S11
+		token.EOF: {reduce(3)},            // $
+		token.T_0: {shift(3), reduce(3)},  // (
+		token.T_1: {reduce(3)},            // )
+		token.T_2: {shift(7), reduce(3)},  // *
+		token.T_3: {shift(8), reduce(3)},  // +
+		token.T_4: {shift(4), reduce(3)},  // -
+		token.T_5: {shift(5), reduce(3)},  // p
+		token.T_6: {reduce(3), reduce(8)}, // x
+	},
+	{ // S12
+		token.EOF: {reduce(2)},            // $
+		token.T_0: {shift(3), reduce(2)},  // (
+		token.T_1: {reduce(2)},            // )
+		token.T_2: {shift(7), reduce(2)},  // *
+		token.T_3: {shift(8), reduce(2)},  // +
+		token.T_4: {shift(4), reduce(2)},  // -
+		token.T_5: {shift(5), reduce(2)},  // p
+		token.T_6: {reduce(2), reduce(8)}, // x
+	},
+	{ // S13
+		token.EOF: {reduce(1)},            // $
+		token.T_0: {shift(3), reduce(1)},  // (
+		token.T_1: {reduce(1)},            // )
+		token.T_2: {shift(7), reduce(1)},  // *
+		token.T_3: {shift(8), reduce(1)},  // +
+		token.T_4: {shift(4), reduce(1)},  // -
+		token.T_5: {shift(5), reduce(1)},  // p
+		token.T_6: {reduce(1), reduce(8)}, // x
+	},
+	{ // S14
+		token.EOF: {reduce(6)}, // $
+		token.T_0: {reduce(6)}, // (
+		token.T_1: {reduce(6)}, // )
+		token.T_2: {reduce(6)}, // *
+		token.T_3: {reduce(6)}, // +
+		token.T_4: {reduce(6)}, // -
+		token.T_5: {reduce(6)}, // p
+		token.T_6: {reduce(6)}, // x
+	},
+}
+
+var gotoTab = [numStates]map[symbols.NT]int{
+	{ // S0
+		symbols.NT_E: 1,
+		symbols.NT_P: 2,
+	},
+	{ // S1
+		symbols.NT_E: 6,
+		symbols.NT_P: 2,
+	},
+	{ // S2
+	},
+	{ // S3
+		symbols.NT_E: 10,
+		symbols.NT_P: 2,
+	},
+	{ // S4
+		symbols.NT_E: 11,
+		symbols.NT_P: 2,
+	},
+	{ // S5
+	},
+	{ // S6
+		symbols.NT_E: 6,
+		symbols.NT_P: 2,
+	},
+	{ // S7
+		symbols.NT_E: 12,
+		symbols.NT_P: 2,
+	},
+	{ // S8
+		symbols.NT_E: 13,
+		symbols.NT_P: 2,
+	},
+	{ // S9
+	},
+	{ // S10
+		symbols.NT_E: 6,
+		symbols.NT_P: 2,
+	},
+	{ // S11
+		symbols.NT_E: 6,
+		symbols.NT_P: 2,
+	},
+	{ // S12
+		symbols.NT_E: 6,
+		symbols.NT_P: 2,
+	},
+	{ // S13
+		symbols.NT_E: 6,
+		symbols.NT_P: 2,
+	},
+	{ // S14
+	},
+}
+
+// production is a basic production of the grammar. labels[i] is the grammar
+// slot after symbol i of the body.
+type production struct {
+	nt     symbols.NT
+	labels []slot.Label
+}
+
+// productions contains the basic productions of the grammar. The augmented
+// start productions are accepted and not reduced.
+var productions = []*production{
+	// G0 : E ;
+	nil,
+	// E : E + E ;
+	{symbols.NT_E, []slot.Label{slot.E0R0, slot.E0R1, slot.E0R2, slot.E0R3}},
+	// E : E * E ;
+	{symbols.NT_E, []slot.Label{slot.E1R0, slot.E1R1, slot.E1R2, slot.E1R3}},
+	// E : - E ;
+	{symbols.NT_E, []slot.Label{slot.E2R0, slot.E2R1, slot.E2R2}},
+	// E : E E ;
+	{symbols.NT_E, []slot.Label{slot.E3R0, slot.E3R1, slot.E3R2}},
+	// E : P x ;
+	{symbols.NT_E, []slot.Label{slot.E4R0, slot.E4R1, slot.E4R2}},
+	// E : ( E ) ;
+	{symbols.NT_E, []slot.Label{slot.E5R0, slot.E5R1, slot.E5R2, slot.E5R3}},
+	// P : p ;
+	{symbols.NT_P, []slot.Label{slot.P0R0, slot.P0R1}},
+	// P :  ;
+	{symbols.NT_P, []slot.Label{slot.P1R0}},
+}
@@ -0,0 +1,182 @@
+// Package visitor is generated by gogll. Do not edit.
+
+/*
+Package visitor walks an unambiguous BSR set.
+
+Walk calls the Enter and Exit methods of the nonterminal and of the alternate of
+every NT BSR. The Enter methods are called in pre-order and the Exit methods in
+post-order:
+
+	EnterExpr, EnterExpr_Alt0, <walk the NT children of the BSR>, ExitExpr_Alt0, ExitExpr
+
+BaseVisitor implements Visitor with methods that do nothing. It can be embedded
+in a visitor that only needs some of the methods.
+*/
+package visitor
+
+import (
+	"fmt"
+
+	"github.com/goccmack/gogll/v3/test/glr/glr3/glr/parser/bsr"
+	"github.com/goccmack/gogll/v3/test/glr/glr3/glr/parser/symbols"
+)
+
+// Visitor has Enter and Exit methods for each nonterminal and each alternate
+// of the grammar. If an Enter method returns false the children of the BSR
+// are not walked. The matching Exit method is always called.
+type Visitor interface {
+	EnterE(b bsr.BSR) bool
+	ExitE(b bsr.BSR)
+
+	// E : E "+" E
+	EnterE_Alt0(b bsr.BSR) bool
+	ExitE_Alt0(b bsr.BSR)
+
+	// E : E "*" E
+	EnterE_Alt1(b bsr.BSR) bool
+	ExitE_Alt1(b bsr.BSR)
+
+	// E : "-" E
+	EnterE_Alt2(b bsr.BSR) bool
+	ExitE_Alt2(b bsr.BSR)
+
+	// E : E E
+	EnterE_Alt3(b bsr.BSR) bool
+	ExitE_Alt3(b bsr.BSR)
+
+	// E : P "x"
+	EnterE_Alt4(b bsr.BSR) bool
+	ExitE_Alt4(b bsr.BSR)
+
+	// E : "(" E ")"
+	EnterE_Alt5(b bsr.BSR) bool
+	ExitE_Alt5(b bsr.BSR)
+
+	EnterP(b bsr.BSR) bool
+	ExitP(b bsr.BSR)
+
+	// P : "p"
+	EnterP_Alt0(b bsr.BSR) bool
+	ExitP_Alt0(b bsr.BSR)
+
+	// P : empty
+	EnterP_Alt1(b bsr.BSR) bool
+	ExitP_Alt1(b bsr.BSR)
+}
+
+// Walk walks the BSR b and its NT children, calling the methods of v.
+// Walk panics if b has ambiguous children.
+func Walk(b bsr.BSR, v Visitor) {
+	switch b.Label.Head() {
+	case symbols.NT_E:
+		if v.EnterE(b) {
+			walkE(b, v)
+		}
+		v.ExitE(b)
+	case symbols.NT_P:
+		if v.EnterP(b) {
+			walkP(b, v)
+		}
+		v.ExitP(b)
+	default:
+		panic(fmt.Sprintf("invalid NT %s", b.Label.Head()))
+	}
+}
+
+func walkE(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterE_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitE_Alt0(b)
+	case 1:
+		if v.EnterE_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitE_Alt1(b)
+	case 2:
+		if v.EnterE_Alt2(b) {
+			walkChildren(b, v)
+		}
+		v.ExitE_Alt2(b)
+	case 3:
+		if v.EnterE_Alt3(b) {
+			walkChildren(b, v)
+		}
+		v.ExitE_Alt3(b)
+	case 4:
+		if v.EnterE_Alt4(b) {
+			walkChildren(b, v)
+		}
+		v.ExitE_Alt4(b)
+	case 5:
+		if v.EnterE_Alt5(b) {
+			walkChildren(b, v)
+		}
+		v.ExitE_Alt5(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of E", b.Alternate()))
+	}
+}
+
+func walkP(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterP_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitP_Alt0(b)
+	case 1:
+		if v.EnterP_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitP_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of P", b.Alternate()))
+	}
+}
+
+func walkChildren(b bsr.BSR, v Visitor) {
+	for i, s := range b.Label.Symbols() {
+		if s.IsNonTerminal() {
+			Walk(b.GetNTChildI(i), v)
+		}
+	}
+}
+
+// BaseVisitor implements Visitor. Its Enter methods return true and its Exit
+// methods do nothing.
+type BaseVisitor struct{}
+
+var _ Visitor = BaseVisitor{}
+
+func (BaseVisitor) EnterE(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitE(b bsr.BSR) {}
+
+func (BaseVisitor) EnterE_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitE_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterE_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitE_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterE_Alt2(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitE_Alt2(b bsr.BSR) {}
+
+func (BaseVisitor) EnterE_Alt3(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitE_Alt3(b bsr.BSR) {}
+
+func (BaseVisitor) EnterE_Alt4(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitE_Alt4(b bsr.BSR) {}
+
+func (BaseVisitor) EnterE_Alt5(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitE_Alt5(b bsr.BSR) {}
+
+func (BaseVisitor) EnterP(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitP(b bsr.BSR) {}
+
+func (BaseVisitor) EnterP_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitP_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterP_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitP_Alt1(b bsr.BSR) {}
@@ -0,0 +1,106 @@
+package glr3
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	glllexer "github.com/goccmack/gogll/v3/test/glr/glr3/gll/lexer"
+	gllparser "github.com/goccmack/gogll/v3/test/glr/glr3/gll/parser"
+	gllbsr "github.com/goccmack/gogll/v3/test/glr/glr3/gll/parser/bsr"
+	glrlexer "github.com/goccmack/gogll/v3/test/glr/glr3/glr/lexer"
+	glrparser "github.com/goccmack/gogll/v3/test/glr/glr3/glr/parser"
+	glrbsr "github.com/goccmack/gogll/v3/test/glr/glr3/glr/parser/bsr"
+)
+
+var inputs = []string{
+	"x",
+	"px",
+	"x + x",
+	"x + px * x",
+	"x + x + x + x",
+	"- x * x",
+	"x x x",
+	"x - x",
+	"( x + x ) px",
+	"- - x + ( x x * px )",
+	"x + * x",
+	"p",
+	"",
+}
+
+// TestDifferential parses each input with the GLL and the GLR parser of the
+// same grammar and compares the parse forests reachable from the roots.
+func TestDifferential(t *testing.T) {
+	for _, in := range inputs {
+		gllSet, gllErrs := gllparser.Parse(glllexer.New([]rune(in)))
+		glrSet, glrErrs := glrparser.Parse(glrlexer.New([]rune(in)))
+		if (gllErrs == nil) != (glrErrs == nil) {
+			t.Errorf("%q: GLL errors %v, GLR errors %v", in, gllErrs, glrErrs)
+			continue
+		}
+		if gllErrs != nil {
+			continue
+		}
+		if gllSet.IsAmbiguous() != glrSet.IsAmbiguous() {
+			t.Errorf("%q: GLL ambiguous %t, GLR ambiguous %t", in, gllSet.IsAmbiguous(), glrSet.IsAmbiguous())
+		}
+		gllForest, glrForest := gllForest(gllSet.GetRoots()), glrForest(glrSet.GetRoots())
+		if strings.Join(gllForest, "\n") != strings.Join(glrForest, "\n") {
+			t.Errorf("%q: GLL forest:\n  %s\nGLR forest:\n  %s", in,
+				strings.Join(gllForest, "\n  "), strings.Join(glrForest, "\n  "))
+		}
+	}
+}
+
+// gllForest returns the sorted BSRs reachable from roots
+func gllForest(roots []gllbsr.BSR) []string {
+	visited := map[gllbsr.BSR]bool{}
+	var walk func(b gllbsr.BSR)
+	walk = func(b gllbsr.BSR) {
+		if visited[b] {
+			return
+		}
+		visited[b] = true
+		for _, children := range b.GetAllNTChildren() {
+			for _, c := range children {
+				walk(c)
+			}
+		}
+	}
+	for _, r := range roots {
+		walk(r)
+	}
+	var forest []string
+	for b := range visited {
+		forest = append(forest, b.String())
+	}
+	sort.Strings(forest)
+	return forest
+}
+
+// glrForest returns the sorted BSRs reachable from roots
+func glrForest(roots []glrbsr.BSR) []string {
+	visited := map[glrbsr.BSR]bool{}
+	var walk func(b glrbsr.BSR)
+	walk = func(b glrbsr.BSR) {
+		if visited[b] {
+			return
+		}
+		visited[b] = true
+		for _, children := range b.GetAllNTChildren() {
+			for _, c := range children {
+				walk(c)
+			}
+		}
+	}
+	for _, r := range roots {
+		walk(r)
+	}
+	var forest []string
+	for b := range visited {
+		forest = append(forest, b.String())
+	}
+	sort.Strings(forest)
+	return forest
+}
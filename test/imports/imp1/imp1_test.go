@@ -0,0 +1,41 @@
+package imp1
+
+import (
+	"testing"
+
+	"github.com/goccmack/gogll/v3/test/imports/imp1/lexer"
+	"github.com/goccmack/gogll/v3/test/imports/imp1/parser"
+	"github.com/goccmack/gogll/v3/test/imports/imp1/parser/symbols"
+)
+
+func TestImport(t *testing.T) {
+	bs, errs := parser.Parse(lexer.New([]rune("a = b + 1 ; print ( c + 2 ) + d ;")))
+	if errs != nil {
+		t.Fatalf("parse errors: %v", errs)
+	}
+	if bs.IsAmbiguous() {
+		t.Fatal("ambiguous parse")
+	}
+
+	root := bs.GetRoot()
+	assign := root.GetNTChild(symbols.NT_Stmt, 0).GetNTChild(symbols.NT_Assign, 0)
+	if assign.GetTChildI(0).LiteralString() != "a" {
+		t.Errorf("unexpected Assign %s", assign)
+	}
+	if expr := assign.GetNTChild(symbols.NT_Expr, 0); expr.Alternate() != 1 {
+		t.Errorf("unexpected Expr %s", expr)
+	}
+
+	print := root.GetNTChild(symbols.NT_Stmts, 0).GetNTChild(symbols.NT_Stmt, 0)
+	term := print.GetNTChild(symbols.NT_ArithExpr, 0).GetNTChild(symbols.NT_ArithTerm, 0)
+	if term.Alternate() != 2 {
+		t.Errorf("unexpected ArithTerm %s", term)
+	}
+}
+
+func TestImportSyntaxError(t *testing.T) {
+	// Stmt was renamed to Assign, so an assignment is not an ArithExpr
+	if _, errs := parser.Parse(lexer.New([]rune("print a = 1 ;"))); errs == nil {
+		t.Error("expected a parse error")
+	}
+}
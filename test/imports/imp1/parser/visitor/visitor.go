@@ -0,0 +1,356 @@
+// Package visitor is generated by gogll. Do not edit.
+
+/*
+Package visitor walks an unambiguous BSR set.
+
+Walk calls the Enter and Exit methods of the nonterminal and of the alternate of
+every NT BSR. The Enter methods are called in pre-order and the Exit methods in
+post-order:
+
+	EnterExpr, EnterExpr_Alt0, <walk the NT children of the BSR>, ExitExpr_Alt0, ExitExpr
+
+BaseVisitor implements Visitor with methods that do nothing. It can be embedded
+in a visitor that only needs some of the methods.
+*/
+package visitor
+
+import (
+	"fmt"
+
+	"github.com/goccmack/gogll/v3/test/imports/imp1/parser/bsr"
+	"github.com/goccmack/gogll/v3/test/imports/imp1/parser/symbols"
+)
+
+// Visitor has Enter and Exit methods for each nonterminal and each alternate
+// of the grammar. If an Enter method returns false the children of the BSR
+// are not walked. The matching Exit method is always called.
+type Visitor interface {
+	EnterStmts(b bsr.BSR) bool
+	ExitStmts(b bsr.BSR)
+
+	// Stmts : Stmt
+	EnterStmts_Alt0(b bsr.BSR) bool
+	ExitStmts_Alt0(b bsr.BSR)
+
+	// Stmts : Stmt Stmts
+	EnterStmts_Alt1(b bsr.BSR) bool
+	ExitStmts_Alt1(b bsr.BSR)
+
+	EnterStmt(b bsr.BSR) bool
+	ExitStmt(b bsr.BSR)
+
+	// Stmt : Assign
+	EnterStmt_Alt0(b bsr.BSR) bool
+	ExitStmt_Alt0(b bsr.BSR)
+
+	// Stmt : "print" ArithExpr ";"
+	EnterStmt_Alt1(b bsr.BSR) bool
+	ExitStmt_Alt1(b bsr.BSR)
+
+	EnterExpr(b bsr.BSR) bool
+	ExitExpr(b bsr.BSR)
+
+	// Expr : Term
+	EnterExpr_Alt0(b bsr.BSR) bool
+	ExitExpr_Alt0(b bsr.BSR)
+
+	// Expr : Term "+" Expr
+	EnterExpr_Alt1(b bsr.BSR) bool
+	ExitExpr_Alt1(b bsr.BSR)
+
+	EnterTerm(b bsr.BSR) bool
+	ExitTerm(b bsr.BSR)
+
+	// Term : id
+	EnterTerm_Alt0(b bsr.BSR) bool
+	ExitTerm_Alt0(b bsr.BSR)
+
+	// Term : num
+	EnterTerm_Alt1(b bsr.BSR) bool
+	ExitTerm_Alt1(b bsr.BSR)
+
+	// Term : "(" Expr ")"
+	EnterTerm_Alt2(b bsr.BSR) bool
+	ExitTerm_Alt2(b bsr.BSR)
+
+	EnterAssign(b bsr.BSR) bool
+	ExitAssign(b bsr.BSR)
+
+	// Assign : id "=" Expr ";"
+	EnterAssign_Alt0(b bsr.BSR) bool
+	ExitAssign_Alt0(b bsr.BSR)
+
+	EnterArithExpr(b bsr.BSR) bool
+	ExitArithExpr(b bsr.BSR)
+
+	// ArithExpr : ArithTerm
+	EnterArithExpr_Alt0(b bsr.BSR) bool
+	ExitArithExpr_Alt0(b bsr.BSR)
+
+	// ArithExpr : ArithTerm "+" ArithExpr
+	EnterArithExpr_Alt1(b bsr.BSR) bool
+	ExitArithExpr_Alt1(b bsr.BSR)
+
+	EnterArithTerm(b bsr.BSR) bool
+	ExitArithTerm(b bsr.BSR)
+
+	// ArithTerm : id
+	EnterArithTerm_Alt0(b bsr.BSR) bool
+	ExitArithTerm_Alt0(b bsr.BSR)
+
+	// ArithTerm : num
+	EnterArithTerm_Alt1(b bsr.BSR) bool
+	ExitArithTerm_Alt1(b bsr.BSR)
+
+	// ArithTerm : "(" ArithExpr ")"
+	EnterArithTerm_Alt2(b bsr.BSR) bool
+	ExitArithTerm_Alt2(b bsr.BSR)
+}
+
+// Walk walks the BSR b and its NT children, calling the methods of v.
+// Walk panics if b has ambiguous children.
+func Walk(b bsr.BSR, v Visitor) {
+	switch b.Label.Head() {
+	case symbols.NT_Stmts:
+		if v.EnterStmts(b) {
+			walkStmts(b, v)
+		}
+		v.ExitStmts(b)
+	case symbols.NT_Stmt:
+		if v.EnterStmt(b) {
+			walkStmt(b, v)
+		}
+		v.ExitStmt(b)
+	case symbols.NT_Expr:
+		if v.EnterExpr(b) {
+			walkExpr(b, v)
+		}
+		v.ExitExpr(b)
+	case symbols.NT_Term:
+		if v.EnterTerm(b) {
+			walkTerm(b, v)
+		}
+		v.ExitTerm(b)
+	case symbols.NT_Assign:
+		if v.EnterAssign(b) {
+			walkAssign(b, v)
+		}
+		v.ExitAssign(b)
+	case symbols.NT_ArithExpr:
+		if v.EnterArithExpr(b) {
+			walkArithExpr(b, v)
+		}
+		v.ExitArithExpr(b)
+	case symbols.NT_ArithTerm:
+		if v.EnterArithTerm(b) {
+			walkArithTerm(b, v)
+		}
+		v.ExitArithTerm(b)
+	default:
+		panic(fmt.Sprintf("invalid NT %s", b.Label.Head()))
+	}
+}
+
+func walkStmts(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterStmts_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitStmts_Alt0(b)
+	case 1:
+		if v.EnterStmts_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitStmts_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Stmts", b.Alternate()))
+	}
+}
+
+func walkStmt(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterStmt_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitStmt_Alt0(b)
+	case 1:
+		if v.EnterStmt_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitStmt_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Stmt", b.Alternate()))
+	}
+}
+
+func walkExpr(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterExpr_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitExpr_Alt0(b)
+	case 1:
+		if v.EnterExpr_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitExpr_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Expr", b.Alternate()))
+	}
+}
+
+func walkTerm(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterTerm_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitTerm_Alt0(b)
+	case 1:
+		if v.EnterTerm_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitTerm_Alt1(b)
+	case 2:
+		if v.EnterTerm_Alt2(b) {
+			walkChildren(b, v)
+		}
+		v.ExitTerm_Alt2(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Term", b.Alternate()))
+	}
+}
+
+func walkAssign(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterAssign_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitAssign_Alt0(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Assign", b.Alternate()))
+	}
+}
+
+func walkArithExpr(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterArithExpr_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitArithExpr_Alt0(b)
+	case 1:
+		if v.EnterArithExpr_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitArithExpr_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of ArithExpr", b.Alternate()))
+	}
+}
+
+func walkArithTerm(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterArithTerm_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitArithTerm_Alt0(b)
+	case 1:
+		if v.EnterArithTerm_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitArithTerm_Alt1(b)
+	case 2:
+		if v.EnterArithTerm_Alt2(b) {
+			walkChildren(b, v)
+		}
+		v.ExitArithTerm_Alt2(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of ArithTerm", b.Alternate()))
+	}
+}
+
+func walkChildren(b bsr.BSR, v Visitor) {
+	for i, s := range b.Label.Symbols() {
+		if s.IsNonTerminal() {
+			Walk(b.GetNTChildI(i), v)
+		}
+	}
+}
+
+// BaseVisitor implements Visitor. Its Enter methods return true and its Exit
+// methods do nothing.
+type BaseVisitor struct{}
+
+var _ Visitor = BaseVisitor{}
+
+func (BaseVisitor) EnterStmts(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStmts(b bsr.BSR) {}
+
+func (BaseVisitor) EnterStmts_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStmts_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterStmts_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStmts_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterStmt(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStmt(b bsr.BSR) {}
+
+func (BaseVisitor) EnterStmt_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStmt_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterStmt_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStmt_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTerm(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTerm(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTerm_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTerm_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTerm_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTerm_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTerm_Alt2(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTerm_Alt2(b bsr.BSR) {}
+
+func (BaseVisitor) EnterAssign(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitAssign(b bsr.BSR) {}
+
+func (BaseVisitor) EnterAssign_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitAssign_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterArithExpr(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitArithExpr(b bsr.BSR) {}
+
+func (BaseVisitor) EnterArithExpr_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitArithExpr_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterArithExpr_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitArithExpr_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterArithTerm(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitArithTerm(b bsr.BSR) {}
+
+func (BaseVisitor) EnterArithTerm_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitArithTerm_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterArithTerm_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitArithTerm_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterArithTerm_Alt2(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitArithTerm_Alt2(b bsr.BSR) {}
@@ -0,0 +1,34 @@
+package imp2
+
+import (
+	"testing"
+
+	"github.com/goccmack/gogll/v3/test/imports/imp2/lexer"
+	"github.com/goccmack/gogll/v3/test/imports/imp2/parser"
+)
+
+// The lex rules prefix, start, import, rename and as, which is renamed
+// keyword, have the names of the keywords of the declarations
+func TestContextKeywords(t *testing.T) {
+	lex := lexer.New([]rune("let abc ; num 12 ; @ as % ;"))
+	var types []string
+	for _, tok := range lex.Tokens {
+		types = append(types, tok.TypeID())
+	}
+	exp := []string{"let", "prefix", ";", "num", "start", ";", "import", "keyword", "rename", ";", "$"}
+	if len(types) != len(exp) {
+		t.Fatalf("expected tokens %v, got %v", exp, types)
+	}
+	for i, typ := range types {
+		if typ != exp[i] {
+			t.Errorf("token %d: expected %s, got %s", i, exp[i], typ)
+		}
+	}
+	bs, errs := parser.Parse(lex)
+	if errs != nil {
+		t.Fatalf("parse errors: %v", errs)
+	}
+	if bs.IsAmbiguous() {
+		t.Fatal("ambiguous parse")
+	}
+}
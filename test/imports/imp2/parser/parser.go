@@ -0,0 +1,621 @@
+// Package parser is generated by gogll. Do not edit.
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/goccmack/gogll/v3/test/imports/imp2/lexer"
+	"github.com/goccmack/gogll/v3/test/imports/imp2/parser/bsr"
+	"github.com/goccmack/gogll/v3/test/imports/imp2/parser/slot"
+	"github.com/goccmack/gogll/v3/test/imports/imp2/parser/symbols"
+	"github.com/goccmack/gogll/v3/test/imports/imp2/token"
+)
+
+type parser struct {
+	cI int
+
+	R *descriptors
+	U *descriptors
+
+	popped   map[poppedNode]bool
+	crf      map[clusterNode][]*crfNode
+	crfNodes map[crfNode]*crfNode
+
+	lex         *lexer.Lexer
+	parseErrors []*Error
+
+	start  symbols.NT
+	bsrSet *bsr.Set
+}
+
+// StartSymbols contains the start symbols of the grammar, which can be parsed
+// by ParseFrom. The first is the default start symbol, which is parsed by Parse.
+var StartSymbols = []symbols.NT{
+	symbols.NT_Stmts,
+}
+
+func newParser(start symbols.NT, l *lexer.Lexer) *parser {
+	return &parser{
+		cI:     0,
+		lex:    l,
+		R:      &descriptors{},
+		U:      &descriptors{},
+		popped: make(map[poppedNode]bool),
+		crf: map[clusterNode][]*crfNode{
+			{start, 0}: {},
+		},
+		crfNodes:    map[crfNode]*crfNode{},
+		start:       start,
+		bsrSet:      bsr.New(start, l),
+		parseErrors: nil,
+	}
+}
+
+// Parse returns the BSR set containing the parse forest of the default start
+// symbol.
+// If the parse was successfull []*Error is nil.
+// If the lexer found lexical errors they are returned first in []*Error.
+func Parse(l *lexer.Lexer) (*bsr.Set, []*Error) {
+	return ParseFrom(symbols.NT_Stmts, l)
+}
+
+// ParseFrom is like Parse but parses start symbol nt, which must be one of
+// StartSymbols.
+func ParseFrom(nt symbols.NT, l *lexer.Lexer) (*bsr.Set, []*Error) {
+	for _, start := range StartSymbols {
+		if start == nt {
+			return newParser(nt, l).parse()
+		}
+	}
+	panic(fmt.Sprintf("%s is not a start symbol", nt))
+}
+
+func (p *parser) parse() (*bsr.Set, []*Error) {
+	var L slot.Label
+	m, cU := len(p.lex.Tokens)-1, 0
+	p.ntAdd(p.start, 0)
+	// p.DumpDescriptors()
+	for !p.R.empty() {
+		L, cU, p.cI = p.R.remove()
+
+		// fmt.Println()
+		// fmt.Printf("L:%s, cI:%d, I[p.cI]:%s, cU:%d\n", L, p.cI, p.lex.Tokens[p.cI], cU)
+		// p.DumpDescriptors()
+
+		switch L {
+		case slot.Stmt0R0: // Stmt : ∙let prefix ;
+
+			p.bsrSet.Add(slot.Stmt0R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Stmt0R1) {
+				p.parseError(slot.Stmt0R1, p.cI, first[slot.Stmt0R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.Stmt0R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Stmt0R2) {
+				p.parseError(slot.Stmt0R2, p.cI, first[slot.Stmt0R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.Stmt0R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_Stmt) {
+				p.rtn(symbols.NT_Stmt, cU, p.cI)
+			} else {
+				p.parseError(slot.Stmt0R0, p.cI, followSets[symbols.NT_Stmt])
+			}
+		case slot.Stmt1R0: // Stmt : ∙num start ;
+
+			p.bsrSet.Add(slot.Stmt1R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Stmt1R1) {
+				p.parseError(slot.Stmt1R1, p.cI, first[slot.Stmt1R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.Stmt1R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Stmt1R2) {
+				p.parseError(slot.Stmt1R2, p.cI, first[slot.Stmt1R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.Stmt1R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_Stmt) {
+				p.rtn(symbols.NT_Stmt, cU, p.cI)
+			} else {
+				p.parseError(slot.Stmt1R0, p.cI, followSets[symbols.NT_Stmt])
+			}
+		case slot.Stmt2R0: // Stmt : ∙import keyword rename ;
+
+			p.bsrSet.Add(slot.Stmt2R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Stmt2R1) {
+				p.parseError(slot.Stmt2R1, p.cI, first[slot.Stmt2R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.Stmt2R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Stmt2R2) {
+				p.parseError(slot.Stmt2R2, p.cI, first[slot.Stmt2R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.Stmt2R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Stmt2R3) {
+				p.parseError(slot.Stmt2R3, p.cI, first[slot.Stmt2R3])
+				break
+			}
+
+			p.bsrSet.Add(slot.Stmt2R4, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_Stmt) {
+				p.rtn(symbols.NT_Stmt, cU, p.cI)
+			} else {
+				p.parseError(slot.Stmt2R0, p.cI, followSets[symbols.NT_Stmt])
+			}
+		case slot.Stmts0R0: // Stmts : ∙Stmt
+
+			p.call(slot.Stmts0R1, cU, p.cI)
+		case slot.Stmts0R1: // Stmts : Stmt ∙
+
+			if p.follow(symbols.NT_Stmts) {
+				p.rtn(symbols.NT_Stmts, cU, p.cI)
+			} else {
+				p.parseError(slot.Stmts0R0, p.cI, followSets[symbols.NT_Stmts])
+			}
+		case slot.Stmts1R0: // Stmts : ∙Stmt Stmts
+
+			p.call(slot.Stmts1R1, cU, p.cI)
+		case slot.Stmts1R1: // Stmts : Stmt ∙Stmts
+
+			if !p.testSelect(slot.Stmts1R1) {
+				p.parseError(slot.Stmts1R1, p.cI, first[slot.Stmts1R1])
+				break
+			}
+
+			p.call(slot.Stmts1R2, cU, p.cI)
+		case slot.Stmts1R2: // Stmts : Stmt Stmts ∙
+
+			if p.follow(symbols.NT_Stmts) {
+				p.rtn(symbols.NT_Stmts, cU, p.cI)
+			} else {
+				p.parseError(slot.Stmts1R0, p.cI, followSets[symbols.NT_Stmts])
+			}
+
+		default:
+			panic("This must not happen")
+		}
+	}
+	if len(p.lex.Errors) > 0 || !p.bsrSet.Contain(p.start, 0, m) {
+		p.sortParseErrors()
+		return nil, append(p.lexErrors(), p.parseErrors...)
+	}
+	return p.bsrSet, nil
+}
+
+func (p *parser) ntAdd(nt symbols.NT, j int) {
+	// fmt.Printf("p.ntAdd(%s, %d)\n", nt, j)
+	failed := true
+	expected := map[token.Type]string{}
+	for _, l := range slot.GetAlternates(nt) {
+		if p.testSelect(l) {
+			p.dscAdd(l, j, j)
+			failed = false
+		} else {
+			for k, v := range first[l] {
+				expected[k] = v
+			}
+		}
+	}
+	if failed {
+		for _, l := range slot.GetAlternates(nt) {
+			p.parseError(l, j, expected)
+		}
+	}
+}
+
+/*** Call Return Forest ***/
+
+type poppedNode struct {
+	X    symbols.NT
+	k, j int
+}
+
+type clusterNode struct {
+	X symbols.NT
+	k int
+}
+
+type crfNode struct {
+	L slot.Label
+	i int
+}
+
+/*
+suppose that L is Y ::=αX ·β
+if there is no CRF node labelled (L,i)
+
+	create one let u be the CRF node labelled (L,i)
+
+if there is no CRF node labelled (X, j) {
+
+		create a CRF node v labelled (X, j)
+		create an edge from v to u
+		ntAdd(X, j)
+	} else {
+
+		let v be the CRF node labelled (X, j)
+		if there is not an edge from v to u {
+			create an edge from v to u
+			for all ((X, j,h)∈P) {
+				dscAdd(L, i, h);
+				bsrAdd(L, i, j, h)
+			}
+		}
+	}
+*/
+func (p *parser) call(L slot.Label, i, j int) {
+	// fmt.Printf("p.call(%s,%d,%d)\n", L,i,j)
+	u, exist := p.crfNodes[crfNode{L, i}]
+	// fmt.Printf("  u exist=%t\n", exist)
+	if !exist {
+		u = &crfNode{L, i}
+		p.crfNodes[*u] = u
+	}
+	X := L.Symbols()[L.Pos()-1].(symbols.NT)
+	ndV := clusterNode{X, j}
+	v, exist := p.crf[ndV]
+	if !exist {
+		// fmt.Println("  v !exist")
+		p.crf[ndV] = []*crfNode{u}
+		p.ntAdd(X, j)
+	} else {
+		// fmt.Println("  v exist")
+		if !existEdge(v, u) {
+			// fmt.Printf("  !existEdge(%v)\n", u)
+			p.crf[ndV] = append(v, u)
+			// fmt.Printf("|popped|=%d\n", len(popped))
+			for pnd := range p.popped {
+				if pnd.X == X && pnd.k == j {
+					p.dscAdd(L, i, pnd.j)
+					p.bsrSet.Add(L, i, j, pnd.j)
+				}
+			}
+		}
+	}
+}
+
+func existEdge(nds []*crfNode, nd *crfNode) bool {
+	for _, nd1 := range nds {
+		if nd1 == nd {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) rtn(X symbols.NT, k, j int) {
+	// fmt.Printf("p.rtn(%s,%d,%d)\n", X,k,j)
+	pn := poppedNode{X, k, j}
+	if _, exist := p.popped[pn]; !exist {
+		p.popped[pn] = true
+		for _, nd := range p.crf[clusterNode{X, k}] {
+			p.dscAdd(nd.L, nd.i, j)
+			p.bsrSet.Add(nd.L, nd.i, k, j)
+		}
+	}
+}
+
+// func CRFString() string {
+// 	buf := new(bytes.Buffer)
+// 	buf.WriteString("CRF: {")
+// 	for cn, nds := range crf{
+// 		for _, nd := range nds {
+// 			fmt.Fprintf(buf, "%s->%s, ", cn, nd)
+// 		}
+// 	}
+// 	buf.WriteString("}")
+// 	return buf.String()
+// }
+
+func (cn clusterNode) String() string {
+	return fmt.Sprintf("(%s,%d)", cn.X, cn.k)
+}
+
+func (n crfNode) String() string {
+	return fmt.Sprintf("(%s,%d)", n.L.String(), n.i)
+}
+
+// func PoppedString() string {
+// 	buf := new(bytes.Buffer)
+// 	buf.WriteString("Popped: {")
+// 	for p, _ := range popped {
+// 		fmt.Fprintf(buf, "(%s,%d,%d) ", p.X, p.k, p.j)
+// 	}
+// 	buf.WriteString("}")
+// 	return buf.String()
+// }
+
+/*** descriptors ***/
+
+type descriptors struct {
+	set []*descriptor
+}
+
+func (ds *descriptors) contain(d *descriptor) bool {
+	for _, d1 := range ds.set {
+		if d1 == d {
+			return true
+		}
+	}
+	return false
+}
+
+func (ds *descriptors) empty() bool {
+	return len(ds.set) == 0
+}
+
+func (ds *descriptors) String() string {
+	buf := new(bytes.Buffer)
+	buf.WriteString("{")
+	for i, d := range ds.set {
+		if i > 0 {
+			buf.WriteString("; ")
+		}
+		fmt.Fprintf(buf, "%s", d)
+	}
+	buf.WriteString("}")
+	return buf.String()
+}
+
+type descriptor struct {
+	L slot.Label
+	k int
+	i int
+}
+
+func (d *descriptor) String() string {
+	return fmt.Sprintf("%s,%d,%d", d.L, d.k, d.i)
+}
+
+func (p *parser) dscAdd(L slot.Label, k, i int) {
+	// fmt.Printf("p.dscAdd(%s,%d,%d)\n", L, k, i)
+	d := &descriptor{L, k, i}
+	if !p.U.contain(d) {
+		p.R.set = append(p.R.set, d)
+		p.U.set = append(p.U.set, d)
+	}
+}
+
+func (ds *descriptors) remove() (L slot.Label, k, i int) {
+	d := ds.set[len(ds.set)-1]
+	ds.set = ds.set[:len(ds.set)-1]
+	// fmt.Printf("remove: %s,%d,%d\n", d.L, d.k, d.i)
+	return d.L, d.k, d.i
+}
+
+func (p *parser) DumpDescriptors() {
+	p.DumpR()
+	p.DumpU()
+}
+
+func (p *parser) DumpR() {
+	fmt.Println("R:")
+	for _, d := range p.R.set {
+		fmt.Printf(" %s\n", d)
+	}
+}
+
+func (p *parser) DumpU() {
+	fmt.Println("U:")
+	for _, d := range p.U.set {
+		fmt.Printf(" %s\n", d)
+	}
+}
+
+/*** TestSelect ***/
+
+func (p *parser) follow(nt symbols.NT) bool {
+	_, exist := followSets[nt][p.lex.Tokens[p.cI].Type()]
+	return exist
+}
+
+func (p *parser) testSelect(l slot.Label) bool {
+	_, exist := first[l][p.lex.Tokens[p.cI].Type()]
+	// fmt.Printf("testSelect(%s) = %t\n", l, exist)
+	return exist
+}
+
+var first = []map[token.Type]string{
+	// Stmt : ∙let prefix ;
+	{
+		token.T_3: "let",
+	},
+	// Stmt : let ∙prefix ;
+	{
+		token.T_5: "prefix",
+	},
+	// Stmt : let prefix ∙;
+	{
+		token.T_0: ";",
+	},
+	// Stmt : let prefix ; ∙
+	{
+		token.EOF: "$",
+		token.T_1: "import",
+		token.T_3: "let",
+		token.T_4: "num",
+	},
+	// Stmt : ∙num start ;
+	{
+		token.T_4: "num",
+	},
+	// Stmt : num ∙start ;
+	{
+		token.T_7: "start",
+	},
+	// Stmt : num start ∙;
+	{
+		token.T_0: ";",
+	},
+	// Stmt : num start ; ∙
+	{
+		token.EOF: "$",
+		token.T_1: "import",
+		token.T_3: "let",
+		token.T_4: "num",
+	},
+	// Stmt : ∙import keyword rename ;
+	{
+		token.T_1: "import",
+	},
+	// Stmt : import ∙keyword rename ;
+	{
+		token.T_2: "keyword",
+	},
+	// Stmt : import keyword ∙rename ;
+	{
+		token.T_6: "rename",
+	},
+	// Stmt : import keyword rename ∙;
+	{
+		token.T_0: ";",
+	},
+	// Stmt : import keyword rename ; ∙
+	{
+		token.EOF: "$",
+		token.T_1: "import",
+		token.T_3: "let",
+		token.T_4: "num",
+	},
+	// Stmts : ∙Stmt
+	{
+		token.T_1: "import",
+		token.T_3: "let",
+		token.T_4: "num",
+	},
+	// Stmts : Stmt ∙
+	{
+		token.EOF: "$",
+	},
+	// Stmts : ∙Stmt Stmts
+	{
+		token.T_1: "import",
+		token.T_3: "let",
+		token.T_4: "num",
+	},
+	// Stmts : Stmt ∙Stmts
+	{
+		token.T_1: "import",
+		token.T_3: "let",
+		token.T_4: "num",
+	},
+	// Stmts : Stmt Stmts ∙
+	{
+		token.EOF: "$",
+	},
+}
+
+var followSets = []map[token.Type]string{
+	// Stmt
+	{
+		token.EOF: "$",
+		token.T_1: "import",
+		token.T_3: "let",
+		token.T_4: "num",
+	},
+	// Stmts
+	{
+		token.EOF: "$",
+	},
+}
+
+/*** Errors ***/
+
+/*
+Error is returned by Parse at every point at which the parser fails to parse
+a grammar production. For non-LL-1 grammars there will be an error for each
+alternate attempted by the parser.
+
+The errors are sorted in descending order of input position (index of token in
+the stream of tokens).
+
+Normally the error of interest is the one that has parsed the largest number of
+tokens.
+*/
+type Error struct {
+	// Index of token that caused the error.
+	cI int
+
+	// Grammar slot at which the error occured.
+	Slot slot.Label
+
+	// The token at which the error occurred.
+	Token *token.Token
+
+	// The line and column in the input text at which the error occurred
+	Line, Column int
+
+	// The tokens expected at the point where the error occurred
+	Expected map[token.Type]string
+
+	// LexError is not nil if the error is a lexical error
+	LexError *lexer.LexError
+}
+
+func (pe *Error) String() string {
+	if pe.LexError != nil {
+		return pe.LexError.Error()
+	}
+	w := new(bytes.Buffer)
+	fmt.Fprintf(w, "Parse Error: %s I[%d]=%s at line %d col %d\n",
+		pe.Slot, pe.cI, pe.Token, pe.Line, pe.Column)
+	exp := []string{}
+	for _, e := range pe.Expected {
+		exp = append(exp, e)
+	}
+	fmt.Fprintf(w, "Expected one of: [%s]", strings.Join(exp, ","))
+	return w.String()
+}
+
+func (p *parser) parseError(slot slot.Label, i int, expected map[token.Type]string) {
+	pe := &Error{cI: i, Slot: slot, Token: p.lex.Tokens[i], Expected: expected}
+	p.parseErrors = append(p.parseErrors, pe)
+}
+
+// lexErrors returns an Error for every lexical error in the input
+func (p *parser) lexErrors() (errs []*Error) {
+	for _, le := range p.lex.Errors {
+		for i, tok := range p.lex.Tokens {
+			if tok == le.Token {
+				errs = append(errs, &Error{
+					cI:       i,
+					Token:    tok,
+					Line:     le.Line,
+					Column:   le.Column,
+					LexError: le,
+				})
+			}
+		}
+	}
+	return
+}
+
+func (p *parser) sortParseErrors() {
+	sort.Slice(p.parseErrors,
+		func(i, j int) bool {
+			return p.parseErrors[j].Token.Lext() < p.parseErrors[i].Token.Lext()
+		})
+	for _, pe := range p.parseErrors {
+		pe.Line, pe.Column = p.lex.GetLineColumn(pe.Token.Lext())
+	}
+}
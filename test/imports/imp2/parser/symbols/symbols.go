@@ -0,0 +1,122 @@
+
+// Package symbols is generated by gogll. Do not edit.
+package symbols
+
+import(
+	"bytes"
+	"fmt"
+)
+
+type Symbol interface{
+	isSymbol()
+	IsNonTerminal() bool
+	String() string
+}
+
+func (NT) isSymbol() {}
+func (T) isSymbol() {}
+
+// NT is the type of non-terminals symbols
+type NT int
+const( 
+	NT_Stmt NT = iota
+	NT_Stmts 
+)
+
+// T is the type of terminals symbols
+type T int
+const( 
+	T_0 T = iota // ; 
+	T_1  // import 
+	T_2  // keyword 
+	T_3  // let 
+	T_4  // num 
+	T_5  // prefix 
+	T_6  // rename 
+	T_7  // start 
+)
+
+type Symbols []Symbol
+
+func (ss Symbols) Equal(ss1 Symbols) bool {
+	if len(ss) != len(ss1) {
+		return false
+	}
+	for i, s := range ss {
+		if s.String() != ss1[i].String() {
+			return false
+		}
+	}
+	return true
+}
+
+func (ss Symbols) String() string {
+	w := new(bytes.Buffer)
+	for i, s := range ss {
+		if i > 0 {
+			fmt.Fprint(w, " ")
+		}
+		fmt.Fprintf(w, "%s", s)
+	}
+	return w.String()
+}
+
+func (ss Symbols) Strings() []string {
+	strs := make([]string, len(ss))
+	for i, s := range ss {
+		strs[i] = s.String()
+	}
+	return strs
+}
+
+func (NT) IsNonTerminal() bool {
+	return true
+}
+
+func (T) IsNonTerminal() bool {
+	return false
+}
+
+func (nt NT) String() string {
+	return ntToString[nt]
+}
+
+func (t T) String() string {
+	return tToString[t]
+}
+
+// IsNT returns true iff sym is a non-terminal symbol of the grammar
+func IsNT(sym string) bool {
+	_, exist := stringNT[sym]
+	return exist
+}
+
+// ToNT returns the NT value of sym or panics if sym is not a non-terminal of the grammar
+func ToNT(sym string) NT {
+	nt, exist := stringNT[sym]
+	if !exist {
+		panic(fmt.Sprintf("No NT: %s", sym))
+	}
+	return nt
+}
+
+var ntToString = []string { 
+	"Stmt", /* NT_Stmt */
+	"Stmts", /* NT_Stmts */ 
+}
+
+var tToString = []string { 
+	";", /* T_0 */
+	"import", /* T_1 */
+	"keyword", /* T_2 */
+	"let", /* T_3 */
+	"num", /* T_4 */
+	"prefix", /* T_5 */
+	"rename", /* T_6 */
+	"start", /* T_7 */ 
+}
+
+var stringNT = map[string]NT{ 
+	"Stmt":NT_Stmt,
+	"Stmts":NT_Stmts,
+}
@@ -0,0 +1,48 @@
+package labels1
+
+import (
+	"testing"
+
+	"github.com/goccmack/gogll/v3/test/labels/labels1/lexer"
+	"github.com/goccmack/gogll/v3/test/labels/labels1/parser"
+	"github.com/goccmack/gogll/v3/test/labels/labels1/parser/bsr"
+)
+
+func TestLabels(t *testing.T) {
+	bs, errs := parser.Parse(lexer.New([]rune("x = a + ( 1 + b )")))
+	if errs != nil {
+		t.Fatalf("parse errors: %v", errs)
+	}
+	stmt := bs.GetRoot().Stmt()
+	if stmt.Name().LiteralString() != "x" {
+		t.Errorf("unexpected Stmt name %s", stmt.Name())
+	}
+
+	value := stmt.Value()
+	if !value.IsBinary() || value.Alternate() != bsr.Expr_Binary || value.IsSingle() {
+		t.Fatalf("expected Binary, got %s", value)
+	}
+	bin := value.Binary()
+	if bin.Lhs().Var().Name().LiteralString() != "a" || bin.Op().LiteralString() != "+" {
+		t.Errorf("unexpected Binary %s", bin)
+	}
+
+	paren := bin.Rhs().Single().GetNTChildI(0).Paren()
+	inner := paren.Inner().Binary()
+	if inner.Lhs().Num().Val().LiteralString() != "1" || !inner.Rhs().IsSingle() {
+		t.Errorf("unexpected Paren %s", paren)
+	}
+}
+
+func TestWrongAlternate(t *testing.T) {
+	bs, errs := parser.Parse(lexer.New([]rune("x = a")))
+	if errs != nil {
+		t.Fatalf("parse errors: %v", errs)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("expected Binary to panic for alternate #Single")
+		}
+	}()
+	bs.GetRoot().Stmt().Value().Binary()
+}
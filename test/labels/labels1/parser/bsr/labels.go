@@ -0,0 +1,180 @@
+// Package bsr is generated by gogll. Do not edit.
+
+package bsr
+
+import (
+	"fmt"
+
+	"github.com/goccmack/gogll/v3/test/labels/labels1/parser/symbols"
+	"github.com/goccmack/gogll/v3/test/labels/labels1/token"
+)
+
+// Named alternates
+const (
+	// Stmt : name:id "=" value:Expr
+	Stmt_Stmt = 0
+	// Expr : lhs:Term op:"+" rhs:Expr
+	Expr_Binary = 0
+	// Expr : Term
+	Expr_Single = 1
+	// Term : name:id
+	Term_Var = 0
+	// Term : val:num
+	Term_Num = 1
+	// Term : "(" inner:Expr ")"
+	Term_Paren = 2
+)
+
+// Stmt is alternate #Stmt: Stmt : name:id "=" value:Expr
+type Stmt struct {
+	BSR
+}
+
+// IsStmt returns true if b is alternate #Stmt of Stmt
+func (b BSR) IsStmt() bool {
+	return b.Label.Head() == symbols.NT_Stmt && b.Alternate() == Stmt_Stmt
+}
+
+// Stmt returns b as alternate #Stmt of Stmt.
+// Stmt panics if b is not alternate #Stmt.
+func (b BSR) Stmt() Stmt {
+	if !b.IsStmt() {
+		panic(fmt.Sprintf("%s is not alternate #Stmt of Stmt", b))
+	}
+	return Stmt{b}
+}
+
+// Name returns the symbol labelled name
+func (a Stmt) Name() *token.Token {
+	return a.GetTChildI(0)
+}
+
+// Value returns the symbol labelled value
+func (a Stmt) Value() BSR {
+	return a.GetNTChildI(2)
+}
+
+// Binary is alternate #Binary: Expr : lhs:Term op:"+" rhs:Expr
+type Binary struct {
+	BSR
+}
+
+// IsBinary returns true if b is alternate #Binary of Expr
+func (b BSR) IsBinary() bool {
+	return b.Label.Head() == symbols.NT_Expr && b.Alternate() == Expr_Binary
+}
+
+// Binary returns b as alternate #Binary of Expr.
+// Binary panics if b is not alternate #Binary.
+func (b BSR) Binary() Binary {
+	if !b.IsBinary() {
+		panic(fmt.Sprintf("%s is not alternate #Binary of Expr", b))
+	}
+	return Binary{b}
+}
+
+// Lhs returns the symbol labelled lhs
+func (a Binary) Lhs() BSR {
+	return a.GetNTChildI(0)
+}
+
+// Op returns the symbol labelled op
+func (a Binary) Op() *token.Token {
+	return a.GetTChildI(1)
+}
+
+// Rhs returns the symbol labelled rhs
+func (a Binary) Rhs() BSR {
+	return a.GetNTChildI(2)
+}
+
+// Single is alternate #Single: Expr : Term
+type Single struct {
+	BSR
+}
+
+// IsSingle returns true if b is alternate #Single of Expr
+func (b BSR) IsSingle() bool {
+	return b.Label.Head() == symbols.NT_Expr && b.Alternate() == Expr_Single
+}
+
+// Single returns b as alternate #Single of Expr.
+// Single panics if b is not alternate #Single.
+func (b BSR) Single() Single {
+	if !b.IsSingle() {
+		panic(fmt.Sprintf("%s is not alternate #Single of Expr", b))
+	}
+	return Single{b}
+}
+
+// Var is alternate #Var: Term : name:id
+type Var struct {
+	BSR
+}
+
+// IsVar returns true if b is alternate #Var of Term
+func (b BSR) IsVar() bool {
+	return b.Label.Head() == symbols.NT_Term && b.Alternate() == Term_Var
+}
+
+// Var returns b as alternate #Var of Term.
+// Var panics if b is not alternate #Var.
+func (b BSR) Var() Var {
+	if !b.IsVar() {
+		panic(fmt.Sprintf("%s is not alternate #Var of Term", b))
+	}
+	return Var{b}
+}
+
+// Name returns the symbol labelled name
+func (a Var) Name() *token.Token {
+	return a.GetTChildI(0)
+}
+
+// Num is alternate #Num: Term : val:num
+type Num struct {
+	BSR
+}
+
+// IsNum returns true if b is alternate #Num of Term
+func (b BSR) IsNum() bool {
+	return b.Label.Head() == symbols.NT_Term && b.Alternate() == Term_Num
+}
+
+// Num returns b as alternate #Num of Term.
+// Num panics if b is not alternate #Num.
+func (b BSR) Num() Num {
+	if !b.IsNum() {
+		panic(fmt.Sprintf("%s is not alternate #Num of Term", b))
+	}
+	return Num{b}
+}
+
+// Val returns the symbol labelled val
+func (a Num) Val() *token.Token {
+	return a.GetTChildI(0)
+}
+
+// Paren is alternate #Paren: Term : "(" inner:Expr ")"
+type Paren struct {
+	BSR
+}
+
+// IsParen returns true if b is alternate #Paren of Term
+func (b BSR) IsParen() bool {
+	return b.Label.Head() == symbols.NT_Term && b.Alternate() == Term_Paren
+}
+
+// Paren returns b as alternate #Paren of Term.
+// Paren panics if b is not alternate #Paren.
+func (b BSR) Paren() Paren {
+	if !b.IsParen() {
+		panic(fmt.Sprintf("%s is not alternate #Paren of Term", b))
+	}
+	return Paren{b}
+}
+
+// Inner returns the symbol labelled inner
+func (a Paren) Inner() BSR {
+	return a.GetNTChildI(1)
+}
@@ -0,0 +1,300 @@
+
+// Package slot is generated by gogll. Do not edit. 
+package slot
+
+import(
+	"bytes"
+	"fmt"
+	
+	"github.com/goccmack/gogll/v3/test/labels/labels1/parser/symbols"
+)
+
+type Label int
+
+const(
+	Expr0R0 Label = iota
+	Expr0R1
+	Expr0R2
+	Expr0R3
+	Expr1R0
+	Expr1R1
+	Stmt0R0
+	Stmt0R1
+	Stmt0R2
+	Stmt0R3
+	Term0R0
+	Term0R1
+	Term1R0
+	Term1R1
+	Term2R0
+	Term2R1
+	Term2R2
+	Term2R3
+)
+
+type Slot struct {
+	NT      symbols.NT
+	Alt     int
+	Pos     int
+	Symbols symbols.Symbols
+	Label 	Label
+}
+
+type Index struct {
+	NT      symbols.NT
+	Alt     int
+	Pos     int
+}
+
+func GetAlternates(nt symbols.NT) []Label {
+	alts, exist := alternates[nt]
+	if !exist {
+		panic(fmt.Sprintf("Invalid NT %s", nt))
+	}
+	return alts
+}
+
+func GetLabel(nt symbols.NT, alt, pos int) Label {
+	l, exist := slotIndex[Index{nt,alt,pos}]
+	if exist {
+		return l
+	}
+	panic(fmt.Sprintf("Error: no slot label for NT=%s, alt=%d, pos=%d", nt, alt, pos))
+}
+
+func (l Label) EoR() bool {
+	return l.Slot().EoR()
+}
+
+func (l Label) Head() symbols.NT {
+	return l.Slot().NT
+}
+
+func (l Label) Index() Index {
+	s := l.Slot()
+	return Index{s.NT, s.Alt, s.Pos}
+}
+
+func (l Label) Alternate() int {
+	return l.Slot().Alt
+}
+
+func (l Label) Pos() int {
+	return l.Slot().Pos
+}
+
+func (l Label) Slot() *Slot {
+	s, exist := slots[l]
+	if !exist {
+		panic(fmt.Sprintf("Invalid slot label %d", l))
+	}
+	return s
+}
+
+func (l Label) String() string {
+	return l.Slot().String()
+}
+
+func (l Label) Symbols() symbols.Symbols {
+	return l.Slot().Symbols
+}
+
+func (s *Slot) EoR() bool {
+	return s.Pos >= len(s.Symbols)
+}
+
+func (s *Slot) String() string {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "%s : ", s.NT)
+	for i, sym := range s.Symbols {
+		if i == s.Pos {
+			fmt.Fprintf(buf, "∙")
+		}
+		fmt.Fprintf(buf, "%s ", sym)
+	}
+	if s.Pos >= len(s.Symbols) {
+		fmt.Fprintf(buf, "∙")
+	}
+	return buf.String()
+}
+
+var slots = map[Label]*Slot{ 
+	Expr0R0: {
+		symbols.NT_Expr, 0, 0, 
+		symbols.Symbols{  
+			symbols.NT_Term, 
+			symbols.T_2, 
+			symbols.NT_Expr,
+		}, 
+		Expr0R0, 
+	},
+	Expr0R1: {
+		symbols.NT_Expr, 0, 1, 
+		symbols.Symbols{  
+			symbols.NT_Term, 
+			symbols.T_2, 
+			symbols.NT_Expr,
+		}, 
+		Expr0R1, 
+	},
+	Expr0R2: {
+		symbols.NT_Expr, 0, 2, 
+		symbols.Symbols{  
+			symbols.NT_Term, 
+			symbols.T_2, 
+			symbols.NT_Expr,
+		}, 
+		Expr0R2, 
+	},
+	Expr0R3: {
+		symbols.NT_Expr, 0, 3, 
+		symbols.Symbols{  
+			symbols.NT_Term, 
+			symbols.T_2, 
+			symbols.NT_Expr,
+		}, 
+		Expr0R3, 
+	},
+	Expr1R0: {
+		symbols.NT_Expr, 1, 0, 
+		symbols.Symbols{  
+			symbols.NT_Term,
+		}, 
+		Expr1R0, 
+	},
+	Expr1R1: {
+		symbols.NT_Expr, 1, 1, 
+		symbols.Symbols{  
+			symbols.NT_Term,
+		}, 
+		Expr1R1, 
+	},
+	Stmt0R0: {
+		symbols.NT_Stmt, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_4, 
+			symbols.T_3, 
+			symbols.NT_Expr,
+		}, 
+		Stmt0R0, 
+	},
+	Stmt0R1: {
+		symbols.NT_Stmt, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_4, 
+			symbols.T_3, 
+			symbols.NT_Expr,
+		}, 
+		Stmt0R1, 
+	},
+	Stmt0R2: {
+		symbols.NT_Stmt, 0, 2, 
+		symbols.Symbols{  
+			symbols.T_4, 
+			symbols.T_3, 
+			symbols.NT_Expr,
+		}, 
+		Stmt0R2, 
+	},
+	Stmt0R3: {
+		symbols.NT_Stmt, 0, 3, 
+		symbols.Symbols{  
+			symbols.T_4, 
+			symbols.T_3, 
+			symbols.NT_Expr,
+		}, 
+		Stmt0R3, 
+	},
+	Term0R0: {
+		symbols.NT_Term, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_4,
+		}, 
+		Term0R0, 
+	},
+	Term0R1: {
+		symbols.NT_Term, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_4,
+		}, 
+		Term0R1, 
+	},
+	Term1R0: {
+		symbols.NT_Term, 1, 0, 
+		symbols.Symbols{  
+			symbols.T_5,
+		}, 
+		Term1R0, 
+	},
+	Term1R1: {
+		symbols.NT_Term, 1, 1, 
+		symbols.Symbols{  
+			symbols.T_5,
+		}, 
+		Term1R1, 
+	},
+	Term2R0: {
+		symbols.NT_Term, 2, 0, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.NT_Expr, 
+			symbols.T_1,
+		}, 
+		Term2R0, 
+	},
+	Term2R1: {
+		symbols.NT_Term, 2, 1, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.NT_Expr, 
+			symbols.T_1,
+		}, 
+		Term2R1, 
+	},
+	Term2R2: {
+		symbols.NT_Term, 2, 2, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.NT_Expr, 
+			symbols.T_1,
+		}, 
+		Term2R2, 
+	},
+	Term2R3: {
+		symbols.NT_Term, 2, 3, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.NT_Expr, 
+			symbols.T_1,
+		}, 
+		Term2R3, 
+	},
+}
+
+var slotIndex = map[Index]Label { 
+	Index{ symbols.NT_Expr,0,0 }: Expr0R0,
+	Index{ symbols.NT_Expr,0,1 }: Expr0R1,
+	Index{ symbols.NT_Expr,0,2 }: Expr0R2,
+	Index{ symbols.NT_Expr,0,3 }: Expr0R3,
+	Index{ symbols.NT_Expr,1,0 }: Expr1R0,
+	Index{ symbols.NT_Expr,1,1 }: Expr1R1,
+	Index{ symbols.NT_Stmt,0,0 }: Stmt0R0,
+	Index{ symbols.NT_Stmt,0,1 }: Stmt0R1,
+	Index{ symbols.NT_Stmt,0,2 }: Stmt0R2,
+	Index{ symbols.NT_Stmt,0,3 }: Stmt0R3,
+	Index{ symbols.NT_Term,0,0 }: Term0R0,
+	Index{ symbols.NT_Term,0,1 }: Term0R1,
+	Index{ symbols.NT_Term,1,0 }: Term1R0,
+	Index{ symbols.NT_Term,1,1 }: Term1R1,
+	Index{ symbols.NT_Term,2,0 }: Term2R0,
+	Index{ symbols.NT_Term,2,1 }: Term2R1,
+	Index{ symbols.NT_Term,2,2 }: Term2R2,
+	Index{ symbols.NT_Term,2,3 }: Term2R3,
+}
+
+var alternates = map[symbols.NT][]Label{ 
+	symbols.NT_Stmt:[]Label{ Stmt0R0 },
+	symbols.NT_Expr:[]Label{ Expr0R0,Expr1R0 },
+	symbols.NT_Term:[]Label{ Term0R0,Term1R0,Term2R0 },
+}
+
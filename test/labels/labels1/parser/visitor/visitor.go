@@ -0,0 +1,176 @@
+// Package visitor is generated by gogll. Do not edit.
+
+/*
+Package visitor walks an unambiguous BSR set.
+
+Walk calls the Enter and Exit methods of the nonterminal and of the alternate of
+every NT BSR. The Enter methods are called in pre-order and the Exit methods in
+post-order:
+
+	EnterExpr, EnterExpr_Alt0, <walk the NT children of the BSR>, ExitExpr_Alt0, ExitExpr
+
+BaseVisitor implements Visitor with methods that do nothing. It can be embedded
+in a visitor that only needs some of the methods.
+*/
+package visitor
+
+import (
+	"fmt"
+
+	"github.com/goccmack/gogll/v3/test/labels/labels1/parser/bsr"
+	"github.com/goccmack/gogll/v3/test/labels/labels1/parser/symbols"
+)
+
+// Visitor has Enter and Exit methods for each nonterminal and each alternate
+// of the grammar. If an Enter method returns false the children of the BSR
+// are not walked. The matching Exit method is always called.
+type Visitor interface {
+	EnterStmt(b bsr.BSR) bool
+	ExitStmt(b bsr.BSR)
+
+	// Stmt : name:id "=" value:Expr
+	EnterStmt_Stmt(b bsr.BSR) bool
+	ExitStmt_Stmt(b bsr.BSR)
+
+	EnterExpr(b bsr.BSR) bool
+	ExitExpr(b bsr.BSR)
+
+	// Expr : lhs:Term op:"+" rhs:Expr
+	EnterExpr_Binary(b bsr.BSR) bool
+	ExitExpr_Binary(b bsr.BSR)
+
+	// Expr : Term
+	EnterExpr_Single(b bsr.BSR) bool
+	ExitExpr_Single(b bsr.BSR)
+
+	EnterTerm(b bsr.BSR) bool
+	ExitTerm(b bsr.BSR)
+
+	// Term : name:id
+	EnterTerm_Var(b bsr.BSR) bool
+	ExitTerm_Var(b bsr.BSR)
+
+	// Term : val:num
+	EnterTerm_Num(b bsr.BSR) bool
+	ExitTerm_Num(b bsr.BSR)
+
+	// Term : "(" inner:Expr ")"
+	EnterTerm_Paren(b bsr.BSR) bool
+	ExitTerm_Paren(b bsr.BSR)
+}
+
+// Walk walks the BSR b and its NT children, calling the methods of v.
+// Walk panics if b has ambiguous children.
+func Walk(b bsr.BSR, v Visitor) {
+	switch b.Label.Head() {
+	case symbols.NT_Stmt:
+		if v.EnterStmt(b) {
+			walkStmt(b, v)
+		}
+		v.ExitStmt(b)
+	case symbols.NT_Expr:
+		if v.EnterExpr(b) {
+			walkExpr(b, v)
+		}
+		v.ExitExpr(b)
+	case symbols.NT_Term:
+		if v.EnterTerm(b) {
+			walkTerm(b, v)
+		}
+		v.ExitTerm(b)
+	default:
+		panic(fmt.Sprintf("invalid NT %s", b.Label.Head()))
+	}
+}
+
+func walkStmt(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterStmt_Stmt(b) {
+			walkChildren(b, v)
+		}
+		v.ExitStmt_Stmt(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Stmt", b.Alternate()))
+	}
+}
+
+func walkExpr(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterExpr_Binary(b) {
+			walkChildren(b, v)
+		}
+		v.ExitExpr_Binary(b)
+	case 1:
+		if v.EnterExpr_Single(b) {
+			walkChildren(b, v)
+		}
+		v.ExitExpr_Single(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Expr", b.Alternate()))
+	}
+}
+
+func walkTerm(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterTerm_Var(b) {
+			walkChildren(b, v)
+		}
+		v.ExitTerm_Var(b)
+	case 1:
+		if v.EnterTerm_Num(b) {
+			walkChildren(b, v)
+		}
+		v.ExitTerm_Num(b)
+	case 2:
+		if v.EnterTerm_Paren(b) {
+			walkChildren(b, v)
+		}
+		v.ExitTerm_Paren(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Term", b.Alternate()))
+	}
+}
+
+func walkChildren(b bsr.BSR, v Visitor) {
+	for i, s := range b.Label.Symbols() {
+		if s.IsNonTerminal() {
+			Walk(b.GetNTChildI(i), v)
+		}
+	}
+}
+
+// BaseVisitor implements Visitor. Its Enter methods return true and its Exit
+// methods do nothing.
+type BaseVisitor struct{}
+
+var _ Visitor = BaseVisitor{}
+
+func (BaseVisitor) EnterStmt(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStmt(b bsr.BSR) {}
+
+func (BaseVisitor) EnterStmt_Stmt(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStmt_Stmt(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr_Binary(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr_Binary(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr_Single(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr_Single(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTerm(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTerm(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTerm_Var(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTerm_Var(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTerm_Num(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTerm_Num(b bsr.BSR) {}
+
+func (BaseVisitor) EnterTerm_Paren(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitTerm_Paren(b bsr.BSR) {}
@@ -0,0 +1,43 @@
+// Package ast builds the string representation of the parsed statement.
+package ast
+
+import (
+	"fmt"
+
+	"github.com/goccmack/gogll/v3/test/labels/labels2/token"
+)
+
+// G0 : Stmt ;
+func G00(p0 interface{}) (interface{}, error) {
+	return p0, nil
+}
+
+// Stmt : id = Expr ;
+func Stmt_Stmt(name, p1, value interface{}) (interface{}, error) {
+	return fmt.Sprintf("%s=%s", name.(*token.Token).LiteralString(), value), nil
+}
+
+// Expr : Term + Expr ;
+func Expr_Binary(lhs, op, rhs interface{}) (interface{}, error) {
+	return fmt.Sprintf("(%s%s%s)", lhs, op.(*token.Token).LiteralString(), rhs), nil
+}
+
+// Expr : Term ;
+func Expr_Single(p0 interface{}) (interface{}, error) {
+	return p0, nil
+}
+
+// Term : id ;
+func Term_Var(name interface{}) (interface{}, error) {
+	return name.(*token.Token).LiteralString(), nil
+}
+
+// Term : num ;
+func Term_Num(val interface{}) (interface{}, error) {
+	return val.(*token.Token).LiteralString(), nil
+}
+
+// Term : ( Expr ) ;
+func Term_Paren(p0, inner, p2 interface{}) (interface{}, error) {
+	return inner, nil
+}
@@ -0,0 +1,18 @@
+package labels2
+
+import (
+	"testing"
+
+	"github.com/goccmack/gogll/v3/test/labels/labels2/lexer"
+	"github.com/goccmack/gogll/v3/test/labels/labels2/parser"
+)
+
+func TestReduceFuncs(t *testing.T) {
+	res, err := parser.New(lexer.New([]rune("x = a + ( 1 + b )"))).Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != "x=(a+(1+b))" {
+		t.Errorf("unexpected result %v", res)
+	}
+}
@@ -0,0 +1,122 @@
+
+package parser
+
+import "github.com/goccmack/gogll/v3/test/labels/labels2/token"
+
+type(
+    actionTable [numStates]actionRow
+    actionRow struct {
+        canRecover bool
+        actions map[token.Type]action
+    }
+)
+
+var actionTab = actionTable{ 
+	actionRow{ // S0
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_4:shift(2),		/* id */
+        },
+
+	},
+	actionRow{ // S1
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:accept(true),		/* $ */
+        },
+
+	},
+	actionRow{ // S2
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_3:shift(3),		/* = */
+        },
+
+	},
+	actionRow{ // S3
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_0:shift(6),		/* ( */
+			token.T_4:shift(7),		/* id */
+			token.T_5:shift(8),		/* num */
+        },
+
+	},
+	actionRow{ // S4
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:reduce(1),		/* $, reduce: Stmt */
+        },
+
+	},
+	actionRow{ // S5
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:reduce(3),		/* $, reduce: Expr */
+			token.T_1:reduce(3),		/* ), reduce: Expr */
+			token.T_2:shift(9),		/* + */
+        },
+
+	},
+	actionRow{ // S6
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_0:shift(6),		/* ( */
+			token.T_4:shift(7),		/* id */
+			token.T_5:shift(8),		/* num */
+        },
+
+	},
+	actionRow{ // S7
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:reduce(4),		/* $, reduce: Term */
+			token.T_1:reduce(4),		/* ), reduce: Term */
+			token.T_2:reduce(4),		/* +, reduce: Term */
+        },
+
+	},
+	actionRow{ // S8
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:reduce(5),		/* $, reduce: Term */
+			token.T_1:reduce(5),		/* ), reduce: Term */
+			token.T_2:reduce(5),		/* +, reduce: Term */
+        },
+
+	},
+	actionRow{ // S9
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_0:shift(6),		/* ( */
+			token.T_4:shift(7),		/* id */
+			token.T_5:shift(8),		/* num */
+        },
+
+	},
+	actionRow{ // S10
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_1:shift(12),		/* ) */
+        },
+
+	},
+	actionRow{ // S11
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:reduce(2),		/* $, reduce: Expr */
+			token.T_1:reduce(2),		/* ), reduce: Expr */
+        },
+
+	},
+	actionRow{ // S12
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:reduce(6),		/* $, reduce: Term */
+			token.T_1:reduce(6),		/* ), reduce: Term */
+			token.T_2:reduce(6),		/* +, reduce: Term */
+        },
+
+	},
+}
+
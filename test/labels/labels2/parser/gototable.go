@@ -0,0 +1,92 @@
+
+/*
+*/
+package parser
+
+const numNTSymbols = 3
+type(
+	gotoTable [numStates]gotoRow
+	gotoRow	[numNTSymbols] int
+)
+
+var gotoTab = gotoTable{
+	gotoRow{ // S0
+		-1, // Expr
+        1, // Stmt
+        -1, // Term
+        
+	},
+	gotoRow{ // S1
+		-1, // Expr
+        -1, // Stmt
+        -1, // Term
+        
+	},
+	gotoRow{ // S2
+		-1, // Expr
+        -1, // Stmt
+        -1, // Term
+        
+	},
+	gotoRow{ // S3
+		4, // Expr
+        -1, // Stmt
+        5, // Term
+        
+	},
+	gotoRow{ // S4
+		-1, // Expr
+        -1, // Stmt
+        -1, // Term
+        
+	},
+	gotoRow{ // S5
+		-1, // Expr
+        -1, // Stmt
+        -1, // Term
+        
+	},
+	gotoRow{ // S6
+		10, // Expr
+        -1, // Stmt
+        5, // Term
+        
+	},
+	gotoRow{ // S7
+		-1, // Expr
+        -1, // Stmt
+        -1, // Term
+        
+	},
+	gotoRow{ // S8
+		-1, // Expr
+        -1, // Stmt
+        -1, // Term
+        
+	},
+	gotoRow{ // S9
+		11, // Expr
+        -1, // Stmt
+        5, // Term
+        
+	},
+	gotoRow{ // S10
+		-1, // Expr
+        -1, // Stmt
+        -1, // Term
+        
+	},
+	gotoRow{ // S11
+		-1, // Expr
+        -1, // Stmt
+        -1, // Term
+        
+	},
+	gotoRow{ // S12
+		-1, // Expr
+        -1, // Stmt
+        -1, // Term
+        
+	},
+	
+}
@@ -0,0 +1,102 @@
+
+package parser
+
+import(
+    "github.com/goccmack/gogll/v3/test/labels/labels2/ast"
+)
+
+type (
+	//TODO: change type and variable names to be consistent with other tables
+	ProdTab      [numProductions]ProdTabEntry
+	ProdTabEntry struct {
+		String     string
+		Id         string
+		// Alternate is the index of the alternate in the syntax rule of Id
+		Alternate  int
+		NTType     int
+		Index int
+		NumSymbols int
+		ReduceFunc func([]interface{}) (interface{}, error)
+	}
+)
+
+var productionsTable = ProdTab {
+	ProdTabEntry{
+		String: `G0 : Stmt ;`,
+		Id: "G0",
+		Alternate: 0,
+		NTType: 0,
+		Index: 0,
+		NumSymbols: 1,
+		ReduceFunc: func(X []interface{}) (interface{}, error) {
+            return ast.G00(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Stmt : id = Expr ;`,
+		Id: "Stmt",
+		Alternate: 0,
+		NTType: 1,
+		Index: 1,
+		NumSymbols: 3,
+		ReduceFunc: func(X []interface{}) (interface{}, error) {
+            return ast.Stmt_Stmt(X[0],X[1],X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr : Term + Expr ;`,
+		Id: "Expr",
+		Alternate: 0,
+		NTType: 0,
+		Index: 2,
+		NumSymbols: 3,
+		ReduceFunc: func(X []interface{}) (interface{}, error) {
+            return ast.Expr_Binary(X[0],X[1],X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr : Term ;`,
+		Id: "Expr",
+		Alternate: 1,
+		NTType: 0,
+		Index: 3,
+		NumSymbols: 1,
+		ReduceFunc: func(X []interface{}) (interface{}, error) {
+            return ast.Expr_Single(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Term : id ;`,
+		Id: "Term",
+		Alternate: 0,
+		NTType: 2,
+		Index: 4,
+		NumSymbols: 1,
+		ReduceFunc: func(X []interface{}) (interface{}, error) {
+            return ast.Term_Var(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Term : num ;`,
+		Id: "Term",
+		Alternate: 1,
+		NTType: 2,
+		Index: 5,
+		NumSymbols: 1,
+		ReduceFunc: func(X []interface{}) (interface{}, error) {
+            return ast.Term_Num(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Term : ( Expr ) ;`,
+		Id: "Term",
+		Alternate: 2,
+		NTType: 2,
+		Index: 6,
+		NumSymbols: 3,
+		ReduceFunc: func(X []interface{}) (interface{}, error) {
+            return ast.Term_Paren(X[0],X[1],X[2])
+		},
+	},
+	
+}
@@ -0,0 +1,24 @@
+package lex10
+
+import (
+	"testing"
+
+	"github.com/goccmack/gogll/v3/test/lex/lex10/lexer"
+	"github.com/goccmack/gogll/v3/test/lex/lex10/parser"
+)
+
+func TestCaseInsensitiveGrammar(t *testing.T) {
+	l := lexer.New([]rune("BEGIN print x PRINT y End"))
+	exp := []string{"begin", "Print", "id", "Print", "id", "end", "$"}
+	if len(l.Tokens) != len(exp) {
+		t.Fatalf("expected %d tokens, got %s", len(exp), l.Tokens)
+	}
+	for i, tok := range l.Tokens {
+		if tok.TypeID() != exp[i] {
+			t.Errorf("token %d: expected %s, got %s", i, exp[i], tok)
+		}
+	}
+	if _, errs := parser.Parse(l); errs != nil {
+		t.Errorf("parse error: %s", errs[0])
+	}
+}
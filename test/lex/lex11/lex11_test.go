@@ -0,0 +1,53 @@
+package lex11
+
+import (
+	"testing"
+
+	"github.com/goccmack/gogll/v3/test/lex/lex11/lexer"
+	"github.com/goccmack/gogll/v3/test/lex/lex11/parser"
+)
+
+const src = `
+if x then 
+    iffy := x + 1 ; 
+else 
+    while y do PRINT y ; end
+    Print ifx ;
+end
+`
+
+func TestKeywords(t *testing.T) {
+	l := lexer.New([]rune(src))
+	exp := []string{
+		"if", "id", "then",
+		"id", ":=", "id", "+", "num", ";",
+		"else",
+		"while", "id", "do", "print", "id", ";", "end",
+		"print", "id", ";",
+		"end", "$",
+	}
+	if len(l.Tokens) != len(exp) {
+		t.Fatalf("expected %d tokens, got %s", len(exp), l.Tokens)
+	}
+	for i, tok := range l.Tokens {
+		if tok.TypeID() != exp[i] {
+			t.Errorf("token %d: expected %s, got %s", i, exp[i], tok)
+		}
+	}
+	if _, errs := parser.Parse(l); errs != nil {
+		t.Errorf("parse error: %s", errs[0])
+	}
+}
+
+func TestKeywordPrefix(t *testing.T) {
+	l := lexer.New([]rune("ifx if i"))
+	exp := []string{"id", "if", "id", "$"}
+	if len(l.Tokens) != len(exp) {
+		t.Fatalf("expected %d tokens, got %s", len(exp), l.Tokens)
+	}
+	for i, tok := range l.Tokens {
+		if tok.TypeID() != exp[i] {
+			t.Errorf("token %d: expected %s, got %s", i, exp[i], tok)
+		}
+	}
+}
@@ -27,10 +27,17 @@ type parser struct {
 	lex         *lexer.Lexer
 	parseErrors []*Error
 
+	start  symbols.NT
 	bsrSet *bsr.Set
 }
 
-func newParser(l *lexer.Lexer) *parser {
+// StartSymbols contains the start symbols of the grammar, which can be parsed
+// by ParseFrom. The first is the default start symbol, which is parsed by Parse.
+var StartSymbols = []symbols.NT{
+	symbols.NT_Aa,
+}
+
+func newParser(start symbols.NT, l *lexer.Lexer) *parser {
 	return &parser{
 		cI:     0,
 		lex:    l,
@@ -38,24 +45,38 @@ func newParser(l *lexer.Lexer) *parser {
 		U:      &descriptors{},
 		popped: make(map[poppedNode]bool),
 		crf: map[clusterNode][]*crfNode{
-			{symbols.NT_Aa, 0}: {},
+			{start, 0}: {},
 		},
 		crfNodes:    map[crfNode]*crfNode{},
-		bsrSet:      bsr.New(symbols.NT_Aa, l),
+		start:       start,
+		bsrSet:      bsr.New(start, l),
 		parseErrors: nil,
 	}
 }
 
-// Parse returns the BSR set containing the parse forest.
-// If the parse was successfull []*Error is nil
+// Parse returns the BSR set containing the parse forest of the default start
+// symbol.
+// If the parse was successfull []*Error is nil.
+// If the lexer found lexical errors they are returned first in []*Error.
 func Parse(l *lexer.Lexer) (*bsr.Set, []*Error) {
-	return newParser(l).parse()
+	return ParseFrom(symbols.NT_Aa, l)
+}
+
+// ParseFrom is like Parse but parses start symbol nt, which must be one of
+// StartSymbols.
+func ParseFrom(nt symbols.NT, l *lexer.Lexer) (*bsr.Set, []*Error) {
+	for _, start := range StartSymbols {
+		if start == nt {
+			return newParser(nt, l).parse()
+		}
+	}
+	panic(fmt.Sprintf("%s is not a start symbol", nt))
 }
 
 func (p *parser) parse() (*bsr.Set, []*Error) {
 	var L slot.Label
 	m, cU := len(p.lex.Tokens)-1, 0
-	p.ntAdd(symbols.NT_Aa, 0)
+	p.ntAdd(p.start, 0)
 	// p.DumpDescriptors()
 	for !p.R.empty() {
 		L, cU, p.cI = p.R.remove()
@@ -88,9 +109,9 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			panic("This must not happen")
 		}
 	}
-	if !p.bsrSet.Contain(symbols.NT_Aa, 0, m) {
+	if len(p.lex.Errors) > 0 || !p.bsrSet.Contain(p.start, 0, m) {
 		p.sortParseErrors()
-		return nil, p.parseErrors
+		return nil, append(p.lexErrors(), p.parseErrors...)
 	}
 	return p.bsrSet, nil
 }
@@ -382,9 +403,15 @@ type Error struct {
 
 	// The tokens expected at the point where the error occurred
 	Expected map[token.Type]string
+
+	// LexError is not nil if the error is a lexical error
+	LexError *lexer.LexError
 }
 
 func (pe *Error) String() string {
+	if pe.LexError != nil {
+		return pe.LexError.Error()
+	}
 	w := new(bytes.Buffer)
 	fmt.Fprintf(w, "Parse Error: %s I[%d]=%s at line %d col %d\n",
 		pe.Slot, pe.cI, pe.Token, pe.Line, pe.Column)
@@ -401,6 +428,24 @@ func (p *parser) parseError(slot slot.Label, i int, expected map[token.Type]stri
 	p.parseErrors = append(p.parseErrors, pe)
 }
 
+// lexErrors returns an Error for every lexical error in the input
+func (p *parser) lexErrors() (errs []*Error) {
+	for _, le := range p.lex.Errors {
+		for i, tok := range p.lex.Tokens {
+			if tok == le.Token {
+				errs = append(errs, &Error{
+					cI:       i,
+					Token:    tok,
+					Line:     le.Line,
+					Column:   le.Column,
+					LexError: le,
+				})
+			}
+		}
+	}
+	return
+}
+
 func (p *parser) sortParseErrors() {
 	sort.Slice(p.parseErrors,
 		func(i, j int) bool {
@@ -0,0 +1,92 @@
+// Package visitor is generated by gogll. Do not edit.
+
+/*
+Package visitor walks an unambiguous BSR set.
+
+Walk calls the Enter and Exit methods of the nonterminal and of the alternate of
+every NT BSR. The Enter methods are called in pre-order and the Exit methods in
+post-order:
+
+	EnterExpr, EnterExpr_Alt0, <walk the NT children of the BSR>, ExitExpr_Alt0, ExitExpr
+
+BaseVisitor implements Visitor with methods that do nothing. It can be embedded
+in a visitor that only needs some of the methods.
+*/
+package visitor
+
+import (
+	"fmt"
+
+	"github.com/goccmack/gogll/v3/test/lex/lex5/parser/bsr"
+	"github.com/goccmack/gogll/v3/test/lex/lex5/parser/symbols"
+)
+
+// Visitor has Enter and Exit methods for each nonterminal and each alternate
+// of the grammar. If an Enter method returns false the children of the BSR
+// are not walked. The matching Exit method is always called.
+type Visitor interface {
+	EnterAa(b bsr.BSR) bool
+	ExitAa(b bsr.BSR)
+
+	// Aa : "\\"
+	EnterAa_Alt0(b bsr.BSR) bool
+	ExitAa_Alt0(b bsr.BSR)
+
+	// Aa : "\""
+	EnterAa_Alt1(b bsr.BSR) bool
+	ExitAa_Alt1(b bsr.BSR)
+}
+
+// Walk walks the BSR b and its NT children, calling the methods of v.
+// Walk panics if b has ambiguous children.
+func Walk(b bsr.BSR, v Visitor) {
+	switch b.Label.Head() {
+	case symbols.NT_Aa:
+		if v.EnterAa(b) {
+			walkAa(b, v)
+		}
+		v.ExitAa(b)
+	default:
+		panic(fmt.Sprintf("invalid NT %s", b.Label.Head()))
+	}
+}
+
+func walkAa(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterAa_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitAa_Alt0(b)
+	case 1:
+		if v.EnterAa_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitAa_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Aa", b.Alternate()))
+	}
+}
+
+func walkChildren(b bsr.BSR, v Visitor) {
+	for i, s := range b.Label.Symbols() {
+		if s.IsNonTerminal() {
+			Walk(b.GetNTChildI(i), v)
+		}
+	}
+}
+
+// BaseVisitor implements Visitor. Its Enter methods return true and its Exit
+// methods do nothing.
+type BaseVisitor struct{}
+
+var _ Visitor = BaseVisitor{}
+
+func (BaseVisitor) EnterAa(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitAa(b bsr.BSR) {}
+
+func (BaseVisitor) EnterAa_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitAa_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterAa_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitAa_Alt1(b bsr.BSR) {}
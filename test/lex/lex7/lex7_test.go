@@ -0,0 +1,163 @@
+package lex7
+
+import (
+	"testing"
+
+	"github.com/goccmack/gogll/v3/test/lex/lex7/lexer"
+)
+
+const src = `// leading comment
+name1 /* block */ name2 // trailing comment
+  /* comment before name3 */
+name3
+// comment at end
+`
+
+func TestTrivia(t *testing.T) {
+	l := lexer.NewWithTrivia([]rune(src))
+	if len(l.Tokens) != 4 {
+		t.Fatalf("expected 4 tokens, got %s", l.Tokens)
+	}
+
+	full := ""
+	for _, tok := range l.Tokens {
+		full += string(tok.FullLiteral())
+	}
+	if full != src {
+		t.Errorf("expected %q, got %q", src, full)
+	}
+
+	name1, name2, name3, eof := l.Tokens[0], l.Tokens[1], l.Tokens[2], l.Tokens[3]
+	if got := string(name1.LeadingTrivia()); got != "// leading comment\n" {
+		t.Errorf("name1 leading trivia: %q", got)
+	}
+	if got := string(name1.TrailingTrivia()); got != " /* block */ " {
+		t.Errorf("name1 trailing trivia: %q", got)
+	}
+	if len(name2.LeadingTrivia()) != 0 {
+		t.Errorf("name2 leading trivia: %q", string(name2.LeadingTrivia()))
+	}
+	if got := string(name2.TrailingTrivia()); got != " // trailing comment\n" {
+		t.Errorf("name2 trailing trivia: %q", got)
+	}
+	if len(name2.TrailingSuppressed()) != 1 ||
+		name2.TrailingSuppressed()[0].TypeID() != "line_comment" {
+		t.Errorf("name2 trailing suppressed: %s", name2.TrailingSuppressed())
+	}
+	if len(name3.LeadingSuppressed()) != 1 ||
+		name3.LeadingSuppressed()[0].LiteralString() != "/* comment before name3 */" {
+		t.Errorf("name3 leading suppressed: %s", name3.LeadingSuppressed())
+	}
+	if got := string(eof.LeadingTrivia()); got != "// comment at end\n" {
+		t.Errorf("EOF leading trivia: %q", got)
+	}
+}
+
+func TestNoTrivia(t *testing.T) {
+	l := lexer.New([]rune(src))
+	if len(l.Tokens) != 4 {
+		t.Fatalf("expected 4 tokens, got %s", l.Tokens)
+	}
+	for _, tok := range l.Tokens {
+		if len(tok.LeadingTrivia()) != 0 || len(tok.TrailingTrivia()) != 0 {
+			t.Errorf("unexpected trivia on %s", tok)
+		}
+	}
+}
+
+func TestLexError(t *testing.T) {
+	l := lexer.New([]rune("name1 /x name2"))
+	if len(l.Errors) != 1 {
+		t.Fatalf("expected 1 error, got %v", l.Errors)
+	}
+	err := l.Errors[0]
+	if err.Rune != 'x' || err.Pos != 7 || err.Line != 1 || err.Column != 8 {
+		t.Errorf("unexpected error %#v", err)
+	}
+	if len(err.Partial) != 2 {
+		t.Errorf("expected line_comment and block_comment to be partially matched, got %v", err.Partial)
+	}
+	if l.Tokens[1] != err.Token || err.Token.LiteralString() != "/x" {
+		t.Errorf("unexpected error token %s", err.Token)
+	}
+
+	l = lexer.New([]rune("name1 /*"))
+	if len(l.Errors) != 1 || l.Errors[0].Rune != -1 {
+		t.Errorf("expected error at end of input, got %v", l.Errors)
+	}
+}
+
+func TestLexErrorRecovery(t *testing.T) {
+	defer func() { lexer.ErrorRecovery = lexer.SkipRune }()
+
+	const src = "name1 #$% name2"
+	if l := lexer.New([]rune(src)); len(l.Errors) != 3 || len(l.Tokens) != 6 {
+		t.Errorf("SkipRune: unexpected errors %v, tokens %s", l.Errors, l.Tokens)
+	}
+
+	lexer.ErrorRecovery = lexer.SkipToSpace
+	l := lexer.New([]rune(src))
+	if len(l.Errors) != 1 || len(l.Tokens) != 4 {
+		t.Fatalf("SkipToSpace: unexpected errors %v, tokens %s", l.Errors, l.Tokens)
+	}
+	if l.Tokens[1].LiteralString() != "#$%" {
+		t.Errorf("SkipToSpace: unexpected error token %s", l.Tokens[1])
+	}
+}
+
+func TestEdit(t *testing.T) {
+	edits := []struct {
+		offset, deleted int
+		inserted        string
+	}{
+		{0, 0, "name0 "},
+		{6, 0, "x"},
+		{7, 3, ""},
+		{9, 2, "/* "},
+		{18, 0, "*/"},
+		{25, 4, "#"},
+		{1, 0, "\n// c\n"},
+		{37, 8, ""},
+		{0, 5, "b"},
+	}
+	for _, newLexer := range []func([]rune) *lexer.Lexer{lexer.New, lexer.NewWithTrivia} {
+		input := []rune(src)
+		l := newLexer(input)
+		for i, e := range edits {
+			if e.offset+e.deleted > len(input) {
+				t.Fatalf("edit %d is outside the input", i)
+			}
+			l.Edit(e.offset, e.deleted, []rune(e.inserted))
+			input = append(append(append([]rune{}, input[:e.offset]...), []rune(e.inserted)...),
+				input[e.offset+e.deleted:]...)
+			checkEdit(t, i, l, newLexer(input))
+		}
+	}
+}
+
+func checkEdit(t *testing.T, i int, edited, expected *lexer.Lexer) {
+	t.Helper()
+	if string(edited.I) != string(expected.I) {
+		t.Fatalf("edit %d: input %q, expected %q", i, string(edited.I), string(expected.I))
+	}
+	if len(edited.Tokens) != len(expected.Tokens) {
+		t.Fatalf("edit %d: tokens %s, expected %s", i, edited.Tokens, expected.Tokens)
+	}
+	for j, tok := range edited.Tokens {
+		exp := expected.Tokens[j]
+		if tok.Type() != exp.Type() || tok.Lext() != exp.Lext() || tok.Rext() != exp.Rext() ||
+			string(tok.FullLiteral()) != string(exp.FullLiteral()) ||
+			len(tok.LeadingSuppressed()) != len(exp.LeadingSuppressed()) ||
+			len(tok.TrailingSuppressed()) != len(exp.TrailingSuppressed()) {
+			t.Errorf("edit %d: token %d is %s, expected %s", i, j, tok, exp)
+		}
+	}
+	if len(edited.Errors) != len(expected.Errors) {
+		t.Fatalf("edit %d: errors %v, expected %v", i, edited.Errors, expected.Errors)
+	}
+	for j, err := range edited.Errors {
+		if err.Error() != expected.Errors[j].Error() || err.Token.Lext() != expected.Errors[j].Token.Lext() {
+			t.Errorf("edit %d: error %s, expected %s", i, err, expected.Errors[j])
+		}
+	}
+}
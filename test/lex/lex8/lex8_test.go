@@ -0,0 +1,57 @@
+package lex8
+
+import (
+	"testing"
+
+	"github.com/goccmack/gogll/v3/test/lex/lex8/lexer"
+	"github.com/goccmack/gogll/v3/test/lex/lex8/parser"
+	"github.com/goccmack/gogll/v3/test/lex/lex8/token"
+)
+
+func TestRanges(t *testing.T) {
+	src := "0x1aF snake_case aé7 ABC € $ 😀 [ ] @"
+	l := lexer.New([]rune(src))
+	exp := []struct {
+		typ string
+		lit string
+	}{
+		{"hex", "0x1aF"},
+		{"id", "snake_case"},
+		{"id", "aé7"},
+		{"upper", "ABC"},
+		{"euro", "€"},
+		{"euro", "$"},
+		{"smile", "😀"},
+		{"brackets", "[ ]"},
+		{"@", "@"},
+		{"$", ""},
+	}
+	if len(l.Tokens) != len(exp) {
+		t.Fatalf("expected %d tokens, got %s", len(exp), l.Tokens)
+	}
+	for i, tok := range l.Tokens {
+		if tok.TypeID() != exp[i].typ || tok.LiteralString() != exp[i].lit {
+			t.Errorf("token %d: expected %s %q, got %s", i, exp[i].typ, exp[i].lit, tok)
+		}
+	}
+	if _, errs := parser.Parse(l); errs != nil {
+		t.Errorf("parse error: %s", errs[0])
+	}
+}
+
+func TestSetDifference(t *testing.T) {
+	// Upper case letters are excluded from the tail of id
+	l := lexer.New([]rune("abC"))
+	if len(l.Tokens) != 3 ||
+		l.Tokens[0].TypeID() != "id" || l.Tokens[0].LiteralString() != "ab" ||
+		l.Tokens[1].TypeID() != "upper" || l.Tokens[1].LiteralString() != "C" {
+		t.Errorf("unexpected tokens %s", l.Tokens)
+	}
+}
+
+func TestRangeError(t *testing.T) {
+	l := lexer.New([]rune("0xg"))
+	if l.Tokens[0].Type() != token.Error {
+		t.Errorf("expected error token, got %s", l.Tokens)
+	}
+}
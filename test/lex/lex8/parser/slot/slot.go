@@ -0,0 +1,287 @@
+
+// Package slot is generated by gogll. Do not edit. 
+package slot
+
+import(
+	"bytes"
+	"fmt"
+	
+	"github.com/goccmack/gogll/v3/test/lex/lex8/parser/symbols"
+)
+
+type Label int
+
+const(
+	Expr0R0 Label = iota
+	Expr0R1
+	Expr1R0
+	Expr1R1
+	Expr2R0
+	Expr2R1
+	Expr3R0
+	Expr3R1
+	Expr4R0
+	Expr4R1
+	Expr5R0
+	Expr5R1
+	Expr6R0
+	Expr6R1
+	Exprs0R0
+	Exprs0R1
+	Exprs1R0
+	Exprs1R1
+	Exprs1R2
+)
+
+type Slot struct {
+	NT      symbols.NT
+	Alt     int
+	Pos     int
+	Symbols symbols.Symbols
+	Label 	Label
+}
+
+type Index struct {
+	NT      symbols.NT
+	Alt     int
+	Pos     int
+}
+
+func GetAlternates(nt symbols.NT) []Label {
+	alts, exist := alternates[nt]
+	if !exist {
+		panic(fmt.Sprintf("Invalid NT %s", nt))
+	}
+	return alts
+}
+
+func GetLabel(nt symbols.NT, alt, pos int) Label {
+	l, exist := slotIndex[Index{nt,alt,pos}]
+	if exist {
+		return l
+	}
+	panic(fmt.Sprintf("Error: no slot label for NT=%s, alt=%d, pos=%d", nt, alt, pos))
+}
+
+func (l Label) EoR() bool {
+	return l.Slot().EoR()
+}
+
+func (l Label) Head() symbols.NT {
+	return l.Slot().NT
+}
+
+func (l Label) Index() Index {
+	s := l.Slot()
+	return Index{s.NT, s.Alt, s.Pos}
+}
+
+func (l Label) Alternate() int {
+	return l.Slot().Alt
+}
+
+func (l Label) Pos() int {
+	return l.Slot().Pos
+}
+
+func (l Label) Slot() *Slot {
+	s, exist := slots[l]
+	if !exist {
+		panic(fmt.Sprintf("Invalid slot label %d", l))
+	}
+	return s
+}
+
+func (l Label) String() string {
+	return l.Slot().String()
+}
+
+func (l Label) Symbols() symbols.Symbols {
+	return l.Slot().Symbols
+}
+
+func (s *Slot) EoR() bool {
+	return s.Pos >= len(s.Symbols)
+}
+
+func (s *Slot) String() string {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "%s : ", s.NT)
+	for i, sym := range s.Symbols {
+		if i == s.Pos {
+			fmt.Fprintf(buf, "∙")
+		}
+		fmt.Fprintf(buf, "%s ", sym)
+	}
+	if s.Pos >= len(s.Symbols) {
+		fmt.Fprintf(buf, "∙")
+	}
+	return buf.String()
+}
+
+var slots = map[Label]*Slot{ 
+	Expr0R0: {
+		symbols.NT_Expr, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_3,
+		}, 
+		Expr0R0, 
+	},
+	Expr0R1: {
+		symbols.NT_Expr, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_3,
+		}, 
+		Expr0R1, 
+	},
+	Expr1R0: {
+		symbols.NT_Expr, 1, 0, 
+		symbols.Symbols{  
+			symbols.T_4,
+		}, 
+		Expr1R0, 
+	},
+	Expr1R1: {
+		symbols.NT_Expr, 1, 1, 
+		symbols.Symbols{  
+			symbols.T_4,
+		}, 
+		Expr1R1, 
+	},
+	Expr2R0: {
+		symbols.NT_Expr, 2, 0, 
+		symbols.Symbols{  
+			symbols.T_6,
+		}, 
+		Expr2R0, 
+	},
+	Expr2R1: {
+		symbols.NT_Expr, 2, 1, 
+		symbols.Symbols{  
+			symbols.T_6,
+		}, 
+		Expr2R1, 
+	},
+	Expr3R0: {
+		symbols.NT_Expr, 3, 0, 
+		symbols.Symbols{  
+			symbols.T_2,
+		}, 
+		Expr3R0, 
+	},
+	Expr3R1: {
+		symbols.NT_Expr, 3, 1, 
+		symbols.Symbols{  
+			symbols.T_2,
+		}, 
+		Expr3R1, 
+	},
+	Expr4R0: {
+		symbols.NT_Expr, 4, 0, 
+		symbols.Symbols{  
+			symbols.T_5,
+		}, 
+		Expr4R0, 
+	},
+	Expr4R1: {
+		symbols.NT_Expr, 4, 1, 
+		symbols.Symbols{  
+			symbols.T_5,
+		}, 
+		Expr4R1, 
+	},
+	Expr5R0: {
+		symbols.NT_Expr, 5, 0, 
+		symbols.Symbols{  
+			symbols.T_1,
+		}, 
+		Expr5R0, 
+	},
+	Expr5R1: {
+		symbols.NT_Expr, 5, 1, 
+		symbols.Symbols{  
+			symbols.T_1,
+		}, 
+		Expr5R1, 
+	},
+	Expr6R0: {
+		symbols.NT_Expr, 6, 0, 
+		symbols.Symbols{  
+			symbols.T_0,
+		}, 
+		Expr6R0, 
+	},
+	Expr6R1: {
+		symbols.NT_Expr, 6, 1, 
+		symbols.Symbols{  
+			symbols.T_0,
+		}, 
+		Expr6R1, 
+	},
+	Exprs0R0: {
+		symbols.NT_Exprs, 0, 0, 
+		symbols.Symbols{  
+			symbols.NT_Expr,
+		}, 
+		Exprs0R0, 
+	},
+	Exprs0R1: {
+		symbols.NT_Exprs, 0, 1, 
+		symbols.Symbols{  
+			symbols.NT_Expr,
+		}, 
+		Exprs0R1, 
+	},
+	Exprs1R0: {
+		symbols.NT_Exprs, 1, 0, 
+		symbols.Symbols{  
+			symbols.NT_Expr, 
+			symbols.NT_Exprs,
+		}, 
+		Exprs1R0, 
+	},
+	Exprs1R1: {
+		symbols.NT_Exprs, 1, 1, 
+		symbols.Symbols{  
+			symbols.NT_Expr, 
+			symbols.NT_Exprs,
+		}, 
+		Exprs1R1, 
+	},
+	Exprs1R2: {
+		symbols.NT_Exprs, 1, 2, 
+		symbols.Symbols{  
+			symbols.NT_Expr, 
+			symbols.NT_Exprs,
+		}, 
+		Exprs1R2, 
+	},
+}
+
+var slotIndex = map[Index]Label { 
+	Index{ symbols.NT_Expr,0,0 }: Expr0R0,
+	Index{ symbols.NT_Expr,0,1 }: Expr0R1,
+	Index{ symbols.NT_Expr,1,0 }: Expr1R0,
+	Index{ symbols.NT_Expr,1,1 }: Expr1R1,
+	Index{ symbols.NT_Expr,2,0 }: Expr2R0,
+	Index{ symbols.NT_Expr,2,1 }: Expr2R1,
+	Index{ symbols.NT_Expr,3,0 }: Expr3R0,
+	Index{ symbols.NT_Expr,3,1 }: Expr3R1,
+	Index{ symbols.NT_Expr,4,0 }: Expr4R0,
+	Index{ symbols.NT_Expr,4,1 }: Expr4R1,
+	Index{ symbols.NT_Expr,5,0 }: Expr5R0,
+	Index{ symbols.NT_Expr,5,1 }: Expr5R1,
+	Index{ symbols.NT_Expr,6,0 }: Expr6R0,
+	Index{ symbols.NT_Expr,6,1 }: Expr6R1,
+	Index{ symbols.NT_Exprs,0,0 }: Exprs0R0,
+	Index{ symbols.NT_Exprs,0,1 }: Exprs0R1,
+	Index{ symbols.NT_Exprs,1,0 }: Exprs1R0,
+	Index{ symbols.NT_Exprs,1,1 }: Exprs1R1,
+	Index{ symbols.NT_Exprs,1,2 }: Exprs1R2,
+}
+
+var alternates = map[symbols.NT][]Label{ 
+	symbols.NT_Exprs:[]Label{ Exprs0R0,Exprs1R0 },
+	symbols.NT_Expr:[]Label{ Expr0R0,Expr1R0,Expr2R0,Expr3R0,Expr4R0,Expr5R0,Expr6R0 },
+}
+
@@ -0,0 +1,194 @@
+// Package visitor is generated by gogll. Do not edit.
+
+/*
+Package visitor walks an unambiguous BSR set.
+
+Walk calls the Enter and Exit methods of the nonterminal and of the alternate of
+every NT BSR. The Enter methods are called in pre-order and the Exit methods in
+post-order:
+
+	EnterExpr, EnterExpr_Alt0, <walk the NT children of the BSR>, ExitExpr_Alt0, ExitExpr
+
+BaseVisitor implements Visitor with methods that do nothing. It can be embedded
+in a visitor that only needs some of the methods.
+*/
+package visitor
+
+import (
+	"fmt"
+
+	"github.com/goccmack/gogll/v3/test/lex/lex8/parser/bsr"
+	"github.com/goccmack/gogll/v3/test/lex/lex8/parser/symbols"
+)
+
+// Visitor has Enter and Exit methods for each nonterminal and each alternate
+// of the grammar. If an Enter method returns false the children of the BSR
+// are not walked. The matching Exit method is always called.
+type Visitor interface {
+	EnterExprs(b bsr.BSR) bool
+	ExitExprs(b bsr.BSR)
+
+	// Exprs : Expr
+	EnterExprs_Alt0(b bsr.BSR) bool
+	ExitExprs_Alt0(b bsr.BSR)
+
+	// Exprs : Expr Exprs
+	EnterExprs_Alt1(b bsr.BSR) bool
+	ExitExprs_Alt1(b bsr.BSR)
+
+	EnterExpr(b bsr.BSR) bool
+	ExitExpr(b bsr.BSR)
+
+	// Expr : hex
+	EnterExpr_Alt0(b bsr.BSR) bool
+	ExitExpr_Alt0(b bsr.BSR)
+
+	// Expr : id
+	EnterExpr_Alt1(b bsr.BSR) bool
+	ExitExpr_Alt1(b bsr.BSR)
+
+	// Expr : upper
+	EnterExpr_Alt2(b bsr.BSR) bool
+	ExitExpr_Alt2(b bsr.BSR)
+
+	// Expr : euro
+	EnterExpr_Alt3(b bsr.BSR) bool
+	ExitExpr_Alt3(b bsr.BSR)
+
+	// Expr : smile
+	EnterExpr_Alt4(b bsr.BSR) bool
+	ExitExpr_Alt4(b bsr.BSR)
+
+	// Expr : brackets
+	EnterExpr_Alt5(b bsr.BSR) bool
+	ExitExpr_Alt5(b bsr.BSR)
+
+	// Expr : "@"
+	EnterExpr_Alt6(b bsr.BSR) bool
+	ExitExpr_Alt6(b bsr.BSR)
+}
+
+// Walk walks the BSR b and its NT children, calling the methods of v.
+// Walk panics if b has ambiguous children.
+func Walk(b bsr.BSR, v Visitor) {
+	switch b.Label.Head() {
+	case symbols.NT_Exprs:
+		if v.EnterExprs(b) {
+			walkExprs(b, v)
+		}
+		v.ExitExprs(b)
+	case symbols.NT_Expr:
+		if v.EnterExpr(b) {
+			walkExpr(b, v)
+		}
+		v.ExitExpr(b)
+	default:
+		panic(fmt.Sprintf("invalid NT %s", b.Label.Head()))
+	}
+}
+
+func walkExprs(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterExprs_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitExprs_Alt0(b)
+	case 1:
+		if v.EnterExprs_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitExprs_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Exprs", b.Alternate()))
+	}
+}
+
+func walkExpr(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterExpr_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitExpr_Alt0(b)
+	case 1:
+		if v.EnterExpr_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitExpr_Alt1(b)
+	case 2:
+		if v.EnterExpr_Alt2(b) {
+			walkChildren(b, v)
+		}
+		v.ExitExpr_Alt2(b)
+	case 3:
+		if v.EnterExpr_Alt3(b) {
+			walkChildren(b, v)
+		}
+		v.ExitExpr_Alt3(b)
+	case 4:
+		if v.EnterExpr_Alt4(b) {
+			walkChildren(b, v)
+		}
+		v.ExitExpr_Alt4(b)
+	case 5:
+		if v.EnterExpr_Alt5(b) {
+			walkChildren(b, v)
+		}
+		v.ExitExpr_Alt5(b)
+	case 6:
+		if v.EnterExpr_Alt6(b) {
+			walkChildren(b, v)
+		}
+		v.ExitExpr_Alt6(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Expr", b.Alternate()))
+	}
+}
+
+func walkChildren(b bsr.BSR, v Visitor) {
+	for i, s := range b.Label.Symbols() {
+		if s.IsNonTerminal() {
+			Walk(b.GetNTChildI(i), v)
+		}
+	}
+}
+
+// BaseVisitor implements Visitor. Its Enter methods return true and its Exit
+// methods do nothing.
+type BaseVisitor struct{}
+
+var _ Visitor = BaseVisitor{}
+
+func (BaseVisitor) EnterExprs(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExprs(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExprs_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExprs_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExprs_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExprs_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr_Alt2(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr_Alt2(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr_Alt3(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr_Alt3(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr_Alt4(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr_Alt4(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr_Alt5(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr_Alt5(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr_Alt6(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr_Alt6(b bsr.BSR) {}
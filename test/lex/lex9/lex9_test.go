@@ -0,0 +1,31 @@
+package lex9
+
+import (
+	"testing"
+
+	"github.com/goccmack/gogll/v3/test/lex/lex9/lexer"
+	"github.com/goccmack/gogll/v3/test/lex/lex9/parser"
+)
+
+func TestCaseInsensitive(t *testing.T) {
+	l := lexer.New([]rune("SeLeCt a FROM b"))
+	exp := []string{"select", "id", "from", "id", "$"}
+	if len(l.Tokens) != len(exp) {
+		t.Fatalf("expected %d tokens, got %s", len(exp), l.Tokens)
+	}
+	for i, tok := range l.Tokens {
+		if tok.TypeID() != exp[i] {
+			t.Errorf("token %d: expected %s, got %s", i, exp[i], tok)
+		}
+	}
+	if _, errs := parser.Parse(l); errs != nil {
+		t.Errorf("parse error: %s", errs[0])
+	}
+}
+
+func TestCaseSensitive(t *testing.T) {
+	l := lexer.New([]rune("Insert insert"))
+	if l.Tokens[0].TypeID() != "Insert" || l.Tokens[1].TypeID() != "id" {
+		t.Errorf("unexpected tokens %s", l.Tokens)
+	}
+}
@@ -0,0 +1,202 @@
+
+// Package slot is generated by gogll. Do not edit. 
+package slot
+
+import(
+	"bytes"
+	"fmt"
+	
+	"github.com/goccmack/gogll/v3/test/lex/lex9/parser/symbols"
+)
+
+type Label int
+
+const(
+	Stmt0R0 Label = iota
+	Stmt0R1
+	Stmt0R2
+	Stmt0R3
+	Stmt0R4
+	Stmt1R0
+	Stmt1R1
+	Stmt1R2
+)
+
+type Slot struct {
+	NT      symbols.NT
+	Alt     int
+	Pos     int
+	Symbols symbols.Symbols
+	Label 	Label
+}
+
+type Index struct {
+	NT      symbols.NT
+	Alt     int
+	Pos     int
+}
+
+func GetAlternates(nt symbols.NT) []Label {
+	alts, exist := alternates[nt]
+	if !exist {
+		panic(fmt.Sprintf("Invalid NT %s", nt))
+	}
+	return alts
+}
+
+func GetLabel(nt symbols.NT, alt, pos int) Label {
+	l, exist := slotIndex[Index{nt,alt,pos}]
+	if exist {
+		return l
+	}
+	panic(fmt.Sprintf("Error: no slot label for NT=%s, alt=%d, pos=%d", nt, alt, pos))
+}
+
+func (l Label) EoR() bool {
+	return l.Slot().EoR()
+}
+
+func (l Label) Head() symbols.NT {
+	return l.Slot().NT
+}
+
+func (l Label) Index() Index {
+	s := l.Slot()
+	return Index{s.NT, s.Alt, s.Pos}
+}
+
+func (l Label) Alternate() int {
+	return l.Slot().Alt
+}
+
+func (l Label) Pos() int {
+	return l.Slot().Pos
+}
+
+func (l Label) Slot() *Slot {
+	s, exist := slots[l]
+	if !exist {
+		panic(fmt.Sprintf("Invalid slot label %d", l))
+	}
+	return s
+}
+
+func (l Label) String() string {
+	return l.Slot().String()
+}
+
+func (l Label) Symbols() symbols.Symbols {
+	return l.Slot().Symbols
+}
+
+func (s *Slot) EoR() bool {
+	return s.Pos >= len(s.Symbols)
+}
+
+func (s *Slot) String() string {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "%s : ", s.NT)
+	for i, sym := range s.Symbols {
+		if i == s.Pos {
+			fmt.Fprintf(buf, "∙")
+		}
+		fmt.Fprintf(buf, "%s ", sym)
+	}
+	if s.Pos >= len(s.Symbols) {
+		fmt.Fprintf(buf, "∙")
+	}
+	return buf.String()
+}
+
+var slots = map[Label]*Slot{ 
+	Stmt0R0: {
+		symbols.NT_Stmt, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_3, 
+			symbols.T_2, 
+			symbols.T_1, 
+			symbols.T_2,
+		}, 
+		Stmt0R0, 
+	},
+	Stmt0R1: {
+		symbols.NT_Stmt, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_3, 
+			symbols.T_2, 
+			symbols.T_1, 
+			symbols.T_2,
+		}, 
+		Stmt0R1, 
+	},
+	Stmt0R2: {
+		symbols.NT_Stmt, 0, 2, 
+		symbols.Symbols{  
+			symbols.T_3, 
+			symbols.T_2, 
+			symbols.T_1, 
+			symbols.T_2,
+		}, 
+		Stmt0R2, 
+	},
+	Stmt0R3: {
+		symbols.NT_Stmt, 0, 3, 
+		symbols.Symbols{  
+			symbols.T_3, 
+			symbols.T_2, 
+			symbols.T_1, 
+			symbols.T_2,
+		}, 
+		Stmt0R3, 
+	},
+	Stmt0R4: {
+		symbols.NT_Stmt, 0, 4, 
+		symbols.Symbols{  
+			symbols.T_3, 
+			symbols.T_2, 
+			symbols.T_1, 
+			symbols.T_2,
+		}, 
+		Stmt0R4, 
+	},
+	Stmt1R0: {
+		symbols.NT_Stmt, 1, 0, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.T_2,
+		}, 
+		Stmt1R0, 
+	},
+	Stmt1R1: {
+		symbols.NT_Stmt, 1, 1, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.T_2,
+		}, 
+		Stmt1R1, 
+	},
+	Stmt1R2: {
+		symbols.NT_Stmt, 1, 2, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.T_2,
+		}, 
+		Stmt1R2, 
+	},
+}
+
+var slotIndex = map[Index]Label { 
+	Index{ symbols.NT_Stmt,0,0 }: Stmt0R0,
+	Index{ symbols.NT_Stmt,0,1 }: Stmt0R1,
+	Index{ symbols.NT_Stmt,0,2 }: Stmt0R2,
+	Index{ symbols.NT_Stmt,0,3 }: Stmt0R3,
+	Index{ symbols.NT_Stmt,0,4 }: Stmt0R4,
+	Index{ symbols.NT_Stmt,1,0 }: Stmt1R0,
+	Index{ symbols.NT_Stmt,1,1 }: Stmt1R1,
+	Index{ symbols.NT_Stmt,1,2 }: Stmt1R2,
+}
+
+var alternates = map[symbols.NT][]Label{ 
+	symbols.NT_Stmt:[]Label{ Stmt0R0,Stmt1R0 },
+}
+
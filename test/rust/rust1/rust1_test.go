@@ -0,0 +1,17 @@
+package rust1
+
+import (
+	"os/exec"
+	"testing"
+)
+
+// TestCargo runs the Rust tests in src/main.rs
+func TestCargo(t *testing.T) {
+	cargo, err := exec.LookPath("cargo")
+	if err != nil {
+		t.Skip("cargo is not installed")
+	}
+	if out, err := exec.Command(cargo, "test", "--offline").CombinedOutput(); err != nil {
+		t.Fatalf("%s\n%s", err, out)
+	}
+}
@@ -0,0 +1,134 @@
+mod lexer;
+mod parser;
+mod token;
+
+use lexer::Lexer;
+
+use std::rc::Rc;
+
+fn main() {
+    let input_file = &std::env::args().collect::<Vec<String>>()[1];
+    let lex = Lexer::new_file(&input_file).unwrap();
+    let (bsr_set, errs) = parser::parse(lex);
+    if errs.len() > 0 {
+        println!("Parse Error: {}", errs[0]);
+        std::process::exit(1);
+    }
+    println!("{} BSRs", bsr_set.get_all().len());
+}
+
+#[allow(dead_code)]
+fn parse(src: &str) -> Box<parser::bsr::Set> {
+    let lex = Lexer::new(Rc::new(src.chars().collect()));
+    let (bsr_set, errs) = parser::parse(lex);
+    if errs.len() > 0 {
+        panic!("{}", errs[0]);
+    }
+    bsr_set
+}
+
+#[cfg(test)]
+mod tests {
+    use super::parse;
+    use crate::parser::bsr::{self, Add, Assign, Paren, Print, Quoted, Var, BSR};
+    use crate::parser::visitor::{self, Visitor};
+
+    use std::rc::Rc;
+
+    const SRC: &str = "x = a + (b); // comment\nprint x;";
+
+    #[test]
+    fn labels() {
+        let set = parse(SRC);
+        let stmts = set.get_root();
+        let stmt = set.get_nt_child_i(stmts.clone(), 0);
+        assert!(stmt.is_assign());
+        assert_eq!(stmt.label.alternate(), bsr::STMT_ASSIGN);
+        let assign = Assign::new(&set, stmt);
+        assert_eq!(assign.lhs().literal_string(), "x");
+
+        let add = Add::new(&set, assign.rhs());
+        let var = Var::new(&set, add.left());
+        assert_eq!(var.name().literal_string(), "a");
+        let right = set.get_nt_child_i(add.right(), 0);
+        assert!(right.is_paren());
+        assert!(!right.is_var());
+        Paren::new(&set, right);
+
+        let stmt = set.get_nt_child_i(set.get_nt_child_i(stmts, 1), 0);
+        let print = Print::new(&set, stmt);
+        assert!(!print.type_().is_add());
+        let var = Var::new(&set, set.get_nt_child_i(print.type_(), 0));
+        assert_eq!(var.name().literal_string(), "x");
+    }
+
+    #[test]
+    fn escaped_string_literal() {
+        let set = parse("print \"abc\";");
+        let stmt = set.get_nt_child_i(set.get_root(), 0);
+        let term = set.get_nt_child_i(Print::new(&set, stmt).type_(), 0);
+        let quoted = Quoted::new(&set, term);
+        assert_eq!(quoted.text().literal_string(), "abc");
+        assert_eq!(set.get_t_child_i(quoted.bsr.clone(), 0).id(), "\"");
+    }
+
+    #[test]
+    #[should_panic(expected = "is not alternate #Print of Stmt")]
+    fn wrong_alternate() {
+        let set = parse(SRC);
+        Print::new(&set, set.get_nt_child_i(set.get_root(), 0));
+    }
+
+    #[derive(Default)]
+    struct Trace {
+        calls: Vec<String>,
+    }
+
+    impl Visitor for Trace {
+        fn enter_stmt_assign(&mut self, set: &bsr::Set, b: &Rc<BSR>) -> bool {
+            let assign = Assign::new(set, b.clone());
+            self.calls.push(format!("assign {}", assign.lhs().literal_string()));
+            true
+        }
+        fn exit_stmt_assign(&mut self, _set: &bsr::Set, _b: &Rc<BSR>) {
+            self.calls.push("/assign".to_string());
+        }
+        fn enter_stmt_print(&mut self, _set: &bsr::Set, _b: &Rc<BSR>) -> bool {
+            self.calls.push("print".to_string());
+            false
+        }
+        fn exit_stmt_print(&mut self, _set: &bsr::Set, _b: &Rc<BSR>) {
+            self.calls.push("/print".to_string());
+        }
+        fn enter_expr_add(&mut self, _set: &bsr::Set, _b: &Rc<BSR>) -> bool {
+            self.calls.push("add".to_string());
+            true
+        }
+        fn enter_expr_alt_1(&mut self, _set: &bsr::Set, _b: &Rc<BSR>) -> bool {
+            self.calls.push("term".to_string());
+            true
+        }
+        fn enter_term_var(&mut self, set: &bsr::Set, b: &Rc<BSR>) -> bool {
+            self.calls.push(format!("var {}", Var::new(set, b.clone()).name().literal_string()));
+            true
+        }
+        fn enter_term_paren(&mut self, _set: &bsr::Set, _b: &Rc<BSR>) -> bool {
+            self.calls.push("paren".to_string());
+            true
+        }
+    }
+
+    #[test]
+    fn walk() {
+        let set = parse(SRC);
+        let mut v = Trace::default();
+        visitor::walk(&set, set.get_root(), &mut v);
+        assert_eq!(
+            v.calls,
+            vec![
+                "assign x", "add", "var a", "term", "paren", "term", "var b", "/assign",
+                "print", "/print",
+            ]
+        );
+    }
+}
@@ -0,0 +1,588 @@
+//! Module slot is generated by gogll. Do not edit. 
+
+extern crate lazy_static;
+
+use lazy_static::lazy_static;
+
+use super::symbols::{Symbol, NT, T};
+use std::collections::HashMap;
+use std::fmt;
+
+#[derive(Hash, Eq, PartialEq, Clone, Copy, Debug)]
+pub enum Label { 
+    Expr0R0,
+    Expr0R1,
+    Expr0R2,
+    Expr0R3,
+    Expr1R0,
+    Expr1R1,
+    Stmt0R0,
+    Stmt0R1,
+    Stmt0R2,
+    Stmt0R3,
+    Stmt0R4,
+    Stmt1R0,
+    Stmt1R1,
+    Stmt1R2,
+    Stmt1R3,
+    Stmts0R0,
+    Stmts0R1,
+    Stmts0R2,
+    Stmts1R0,
+    Term0R0,
+    Term0R1,
+    Term1R0,
+    Term1R1,
+    Term1R2,
+    Term1R3,
+    Term2R0,
+    Term2R1,
+    Term2R2,
+    Term2R3,
+}
+
+#[allow(dead_code)]
+pub struct Slot {
+    nt:      NT,
+    alt:     usize,
+    pos:     usize,
+    symbols: Vec<Symbol>,
+    label: 	 Label,
+}
+
+#[derive(Hash, Eq, PartialEq)]
+pub struct Index {
+    pub nt:      NT,
+    pub alt:     usize,
+    pub pos:     usize,
+}
+
+#[allow(dead_code)]
+pub fn get_alternates(nt: &NT) -> &'static Vec<Label> {
+    if let Some(alts) = ALTERNATES.get(nt) {
+        return alts
+    }
+    panic!("{} has no alternates", nt)
+}
+
+#[allow(dead_code)]
+pub fn get_label(nt: &NT, alt: usize, pos: usize) -> Label {
+    if let Some(l) = LABELS.get(&Index{nt: nt.clone(), alt: alt, pos: pos}) {
+        return l.clone()
+    }
+    panic!("No label for {} alt {} pos {}", nt, alt, pos)
+}
+
+impl <'a>Label {
+    #[allow(dead_code)]
+    pub fn eor(&self) -> bool {
+        self.slot().eor()
+    }
+    
+    #[allow(dead_code)]
+    pub fn head(&self) -> &'static NT {
+        &self.slot().nt
+    }
+    
+    pub fn index(&self) -> Index {
+        let s = self.slot();
+        Index{nt: s.nt, alt: s.alt, pos: s.pos}
+    }
+    
+    #[allow(dead_code)]
+    pub fn alternate(&self) -> usize {
+        self.slot().alt
+    }
+    
+    #[allow(dead_code)]
+    pub fn pos(&self) -> usize {
+        self.slot().pos
+    }
+    
+    #[allow(dead_code)]
+    pub fn slot(&self) -> &'static Slot {
+        if let Some(s) = SLOTS.get(self) {
+            return s
+        }
+        panic!("Invalid slot label {}", self)
+    }
+
+    #[allow(dead_code)]
+    pub fn symbols(&self) -> &'a Vec<Symbol> {
+        &self.slot().symbols
+    }
+}
+/*** end of impl Label***/
+
+impl fmt::Display for Label {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        let slt = self.slot();
+        let mut s = format!("{} :", slt.nt);
+        for (i, sym) in slt.symbols.iter().enumerate() {
+            if i == slt.pos {
+                s.push_str("•")
+            }
+            s.push_str(&format!("{} ", sym));
+        }
+        write!(f, "{}", s)
+    }
+}
+
+impl Slot {
+    #[allow(dead_code)]
+    pub fn eor(&self) -> bool {
+        self.pos >= self.symbols.len()
+    }    
+} /*** impl Slot ***/
+
+
+impl fmt::Display for Slot {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        let mut s = format!("{} : ", self.nt);
+        for (i, sym) in self.symbols.iter().enumerate() {
+            if i == self.pos {
+                s.push_str("•")
+            }
+            s.push_str(&format!("{} ", sym));
+        }
+        if self.pos >= self.symbols.len() {
+            s.push_str("•")
+        }
+        write!(f, "{}", s)
+    }
+}
+
+lazy_static! {
+    static ref ALTERNATES: HashMap<NT, Vec<Label>> = {
+        let mut m = HashMap::new(); 
+        m.insert(NT::Stmts, 
+            vec![ 
+                Label::Stmts0R0,
+                Label::Stmts1R0,
+            ]);
+        m.insert(NT::Stmt, 
+            vec![ 
+                Label::Stmt0R0,
+                Label::Stmt1R0,
+            ]);
+        m.insert(NT::Expr, 
+            vec![ 
+                Label::Expr0R0,
+                Label::Expr1R0,
+            ]);
+        m.insert(NT::Term, 
+            vec![ 
+                Label::Term0R0,
+                Label::Term1R0,
+                Label::Term2R0,
+            ]);
+        m
+     };
+
+    static ref LABELS: HashMap<Index, Label> = { 
+        let mut m = HashMap::new(); 
+        m.insert(Index{nt:NT::Expr, alt:0, pos:0}, Label::Expr0R0); 
+        m.insert(Index{nt:NT::Expr, alt:0, pos:1}, Label::Expr0R1); 
+        m.insert(Index{nt:NT::Expr, alt:0, pos:2}, Label::Expr0R2); 
+        m.insert(Index{nt:NT::Expr, alt:0, pos:3}, Label::Expr0R3); 
+        m.insert(Index{nt:NT::Expr, alt:1, pos:0}, Label::Expr1R0); 
+        m.insert(Index{nt:NT::Expr, alt:1, pos:1}, Label::Expr1R1); 
+        m.insert(Index{nt:NT::Stmt, alt:0, pos:0}, Label::Stmt0R0); 
+        m.insert(Index{nt:NT::Stmt, alt:0, pos:1}, Label::Stmt0R1); 
+        m.insert(Index{nt:NT::Stmt, alt:0, pos:2}, Label::Stmt0R2); 
+        m.insert(Index{nt:NT::Stmt, alt:0, pos:3}, Label::Stmt0R3); 
+        m.insert(Index{nt:NT::Stmt, alt:0, pos:4}, Label::Stmt0R4); 
+        m.insert(Index{nt:NT::Stmt, alt:1, pos:0}, Label::Stmt1R0); 
+        m.insert(Index{nt:NT::Stmt, alt:1, pos:1}, Label::Stmt1R1); 
+        m.insert(Index{nt:NT::Stmt, alt:1, pos:2}, Label::Stmt1R2); 
+        m.insert(Index{nt:NT::Stmt, alt:1, pos:3}, Label::Stmt1R3); 
+        m.insert(Index{nt:NT::Stmts, alt:0, pos:0}, Label::Stmts0R0); 
+        m.insert(Index{nt:NT::Stmts, alt:0, pos:1}, Label::Stmts0R1); 
+        m.insert(Index{nt:NT::Stmts, alt:0, pos:2}, Label::Stmts0R2); 
+        m.insert(Index{nt:NT::Stmts, alt:1, pos:0}, Label::Stmts1R0); 
+        m.insert(Index{nt:NT::Term, alt:0, pos:0}, Label::Term0R0); 
+        m.insert(Index{nt:NT::Term, alt:0, pos:1}, Label::Term0R1); 
+        m.insert(Index{nt:NT::Term, alt:1, pos:0}, Label::Term1R0); 
+        m.insert(Index{nt:NT::Term, alt:1, pos:1}, Label::Term1R1); 
+        m.insert(Index{nt:NT::Term, alt:1, pos:2}, Label::Term1R2); 
+        m.insert(Index{nt:NT::Term, alt:1, pos:3}, Label::Term1R3); 
+        m.insert(Index{nt:NT::Term, alt:2, pos:0}, Label::Term2R0); 
+        m.insert(Index{nt:NT::Term, alt:2, pos:1}, Label::Term2R1); 
+        m.insert(Index{nt:NT::Term, alt:2, pos:2}, Label::Term2R2); 
+        m.insert(Index{nt:NT::Term, alt:2, pos:3}, Label::Term2R3); 
+        m
+    };
+
+    static ref SLOTS: HashMap<Label, Slot> = {
+        let mut m = HashMap::new(); 
+        // Expr : ∙Term + Expr 
+        m.insert(Label::Expr0R0, 
+            Slot{
+                nt: NT::Expr,
+                alt: 0,
+                pos: 0,
+                symbols: vec![ 
+                    Symbol::NT(NT::Term), 
+                    Symbol::T(T::T3), 
+                    Symbol::NT(NT::Expr), 
+                ],
+                label: Label::Expr0R0,
+            });
+        // Expr : Term ∙+ Expr 
+        m.insert(Label::Expr0R1, 
+            Slot{
+                nt: NT::Expr,
+                alt: 0,
+                pos: 1,
+                symbols: vec![ 
+                    Symbol::NT(NT::Term), 
+                    Symbol::T(T::T3), 
+                    Symbol::NT(NT::Expr), 
+                ],
+                label: Label::Expr0R1,
+            });
+        // Expr : Term + ∙Expr 
+        m.insert(Label::Expr0R2, 
+            Slot{
+                nt: NT::Expr,
+                alt: 0,
+                pos: 2,
+                symbols: vec![ 
+                    Symbol::NT(NT::Term), 
+                    Symbol::T(T::T3), 
+                    Symbol::NT(NT::Expr), 
+                ],
+                label: Label::Expr0R2,
+            });
+        // Expr : Term + Expr ∙
+        m.insert(Label::Expr0R3, 
+            Slot{
+                nt: NT::Expr,
+                alt: 0,
+                pos: 3,
+                symbols: vec![ 
+                    Symbol::NT(NT::Term), 
+                    Symbol::T(T::T3), 
+                    Symbol::NT(NT::Expr), 
+                ],
+                label: Label::Expr0R3,
+            });
+        // Expr : ∙Term 
+        m.insert(Label::Expr1R0, 
+            Slot{
+                nt: NT::Expr,
+                alt: 1,
+                pos: 0,
+                symbols: vec![ 
+                    Symbol::NT(NT::Term), 
+                ],
+                label: Label::Expr1R0,
+            });
+        // Expr : Term ∙
+        m.insert(Label::Expr1R1, 
+            Slot{
+                nt: NT::Expr,
+                alt: 1,
+                pos: 1,
+                symbols: vec![ 
+                    Symbol::NT(NT::Term), 
+                ],
+                label: Label::Expr1R1,
+            });
+        // Stmt : ∙id = Expr ; 
+        m.insert(Label::Stmt0R0, 
+            Slot{
+                nt: NT::Stmt,
+                alt: 0,
+                pos: 0,
+                symbols: vec![ 
+                    Symbol::T(T::T7), 
+                    Symbol::T(T::T5), 
+                    Symbol::NT(NT::Expr), 
+                    Symbol::T(T::T4), 
+                ],
+                label: Label::Stmt0R0,
+            });
+        // Stmt : id ∙= Expr ; 
+        m.insert(Label::Stmt0R1, 
+            Slot{
+                nt: NT::Stmt,
+                alt: 0,
+                pos: 1,
+                symbols: vec![ 
+                    Symbol::T(T::T7), 
+                    Symbol::T(T::T5), 
+                    Symbol::NT(NT::Expr), 
+                    Symbol::T(T::T4), 
+                ],
+                label: Label::Stmt0R1,
+            });
+        // Stmt : id = ∙Expr ; 
+        m.insert(Label::Stmt0R2, 
+            Slot{
+                nt: NT::Stmt,
+                alt: 0,
+                pos: 2,
+                symbols: vec![ 
+                    Symbol::T(T::T7), 
+                    Symbol::T(T::T5), 
+                    Symbol::NT(NT::Expr), 
+                    Symbol::T(T::T4), 
+                ],
+                label: Label::Stmt0R2,
+            });
+        // Stmt : id = Expr ∙; 
+        m.insert(Label::Stmt0R3, 
+            Slot{
+                nt: NT::Stmt,
+                alt: 0,
+                pos: 3,
+                symbols: vec![ 
+                    Symbol::T(T::T7), 
+                    Symbol::T(T::T5), 
+                    Symbol::NT(NT::Expr), 
+                    Symbol::T(T::T4), 
+                ],
+                label: Label::Stmt0R3,
+            });
+        // Stmt : id = Expr ; ∙
+        m.insert(Label::Stmt0R4, 
+            Slot{
+                nt: NT::Stmt,
+                alt: 0,
+                pos: 4,
+                symbols: vec![ 
+                    Symbol::T(T::T7), 
+                    Symbol::T(T::T5), 
+                    Symbol::NT(NT::Expr), 
+                    Symbol::T(T::T4), 
+                ],
+                label: Label::Stmt0R4,
+            });
+        // Stmt : ∙print Expr ; 
+        m.insert(Label::Stmt1R0, 
+            Slot{
+                nt: NT::Stmt,
+                alt: 1,
+                pos: 0,
+                symbols: vec![ 
+                    Symbol::T(T::T8), 
+                    Symbol::NT(NT::Expr), 
+                    Symbol::T(T::T4), 
+                ],
+                label: Label::Stmt1R0,
+            });
+        // Stmt : print ∙Expr ; 
+        m.insert(Label::Stmt1R1, 
+            Slot{
+                nt: NT::Stmt,
+                alt: 1,
+                pos: 1,
+                symbols: vec![ 
+                    Symbol::T(T::T8), 
+                    Symbol::NT(NT::Expr), 
+                    Symbol::T(T::T4), 
+                ],
+                label: Label::Stmt1R1,
+            });
+        // Stmt : print Expr ∙; 
+        m.insert(Label::Stmt1R2, 
+            Slot{
+                nt: NT::Stmt,
+                alt: 1,
+                pos: 2,
+                symbols: vec![ 
+                    Symbol::T(T::T8), 
+                    Symbol::NT(NT::Expr), 
+                    Symbol::T(T::T4), 
+                ],
+                label: Label::Stmt1R2,
+            });
+        // Stmt : print Expr ; ∙
+        m.insert(Label::Stmt1R3, 
+            Slot{
+                nt: NT::Stmt,
+                alt: 1,
+                pos: 3,
+                symbols: vec![ 
+                    Symbol::T(T::T8), 
+                    Symbol::NT(NT::Expr), 
+                    Symbol::T(T::T4), 
+                ],
+                label: Label::Stmt1R3,
+            });
+        // Stmts : ∙Stmt Stmts 
+        m.insert(Label::Stmts0R0, 
+            Slot{
+                nt: NT::Stmts,
+                alt: 0,
+                pos: 0,
+                symbols: vec![ 
+                    Symbol::NT(NT::Stmt), 
+                    Symbol::NT(NT::Stmts), 
+                ],
+                label: Label::Stmts0R0,
+            });
+        // Stmts : Stmt ∙Stmts 
+        m.insert(Label::Stmts0R1, 
+            Slot{
+                nt: NT::Stmts,
+                alt: 0,
+                pos: 1,
+                symbols: vec![ 
+                    Symbol::NT(NT::Stmt), 
+                    Symbol::NT(NT::Stmts), 
+                ],
+                label: Label::Stmts0R1,
+            });
+        // Stmts : Stmt Stmts ∙
+        m.insert(Label::Stmts0R2, 
+            Slot{
+                nt: NT::Stmts,
+                alt: 0,
+                pos: 2,
+                symbols: vec![ 
+                    Symbol::NT(NT::Stmt), 
+                    Symbol::NT(NT::Stmts), 
+                ],
+                label: Label::Stmts0R2,
+            });
+        // Stmts : ∙
+        m.insert(Label::Stmts1R0, 
+            Slot{
+                nt: NT::Stmts,
+                alt: 1,
+                pos: 0,
+                symbols: vec![ 
+                ],
+                label: Label::Stmts1R0,
+            });
+        // Term : ∙id 
+        m.insert(Label::Term0R0, 
+            Slot{
+                nt: NT::Term,
+                alt: 0,
+                pos: 0,
+                symbols: vec![ 
+                    Symbol::T(T::T7), 
+                ],
+                label: Label::Term0R0,
+            });
+        // Term : id ∙
+        m.insert(Label::Term0R1, 
+            Slot{
+                nt: NT::Term,
+                alt: 0,
+                pos: 1,
+                symbols: vec![ 
+                    Symbol::T(T::T7), 
+                ],
+                label: Label::Term0R1,
+            });
+        // Term : ∙( Expr ) 
+        m.insert(Label::Term1R0, 
+            Slot{
+                nt: NT::Term,
+                alt: 1,
+                pos: 0,
+                symbols: vec![ 
+                    Symbol::T(T::T1), 
+                    Symbol::NT(NT::Expr), 
+                    Symbol::T(T::T2), 
+                ],
+                label: Label::Term1R0,
+            });
+        // Term : ( ∙Expr ) 
+        m.insert(Label::Term1R1, 
+            Slot{
+                nt: NT::Term,
+                alt: 1,
+                pos: 1,
+                symbols: vec![ 
+                    Symbol::T(T::T1), 
+                    Symbol::NT(NT::Expr), 
+                    Symbol::T(T::T2), 
+                ],
+                label: Label::Term1R1,
+            });
+        // Term : ( Expr ∙) 
+        m.insert(Label::Term1R2, 
+            Slot{
+                nt: NT::Term,
+                alt: 1,
+                pos: 2,
+                symbols: vec![ 
+                    Symbol::T(T::T1), 
+                    Symbol::NT(NT::Expr), 
+                    Symbol::T(T::T2), 
+                ],
+                label: Label::Term1R2,
+            });
+        // Term : ( Expr ) ∙
+        m.insert(Label::Term1R3, 
+            Slot{
+                nt: NT::Term,
+                alt: 1,
+                pos: 3,
+                symbols: vec![ 
+                    Symbol::T(T::T1), 
+                    Symbol::NT(NT::Expr), 
+                    Symbol::T(T::T2), 
+                ],
+                label: Label::Term1R3,
+            });
+        // Term : ∙" id " 
+        m.insert(Label::Term2R0, 
+            Slot{
+                nt: NT::Term,
+                alt: 2,
+                pos: 0,
+                symbols: vec![ 
+                    Symbol::T(T::T0), 
+                    Symbol::T(T::T7), 
+                    Symbol::T(T::T0), 
+                ],
+                label: Label::Term2R0,
+            });
+        // Term : " ∙id " 
+        m.insert(Label::Term2R1, 
+            Slot{
+                nt: NT::Term,
+                alt: 2,
+                pos: 1,
+                symbols: vec![ 
+                    Symbol::T(T::T0), 
+                    Symbol::T(T::T7), 
+                    Symbol::T(T::T0), 
+                ],
+                label: Label::Term2R1,
+            });
+        // Term : " id ∙" 
+        m.insert(Label::Term2R2, 
+            Slot{
+                nt: NT::Term,
+                alt: 2,
+                pos: 2,
+                symbols: vec![ 
+                    Symbol::T(T::T0), 
+                    Symbol::T(T::T7), 
+                    Symbol::T(T::T0), 
+                ],
+                label: Label::Term2R2,
+            });
+        // Term : " id " ∙
+        m.insert(Label::Term2R3, 
+            Slot{
+                nt: NT::Term,
+                alt: 2,
+                pos: 3,
+                symbols: vec![ 
+                    Symbol::T(T::T0), 
+                    Symbol::T(T::T7), 
+                    Symbol::T(T::T0), 
+                ],
+                label: Label::Term2R3,
+            });
+        m
+	};
+}
@@ -0,0 +1,92 @@
+// Module symbols is generated by gogll. Do not edit.
+
+use std::fmt;
+
+#[allow(dead_code)]
+#[derive(Hash, Eq, PartialEq)]
+pub enum Symbol {
+    NT(NT),
+    T(T)
+}
+
+// NT is the type of non-terminals symbols
+#[allow(dead_code)]
+#[derive(Hash, Eq, PartialEq, Clone, Copy, Debug)]
+pub enum NT { 
+    Expr,
+    Stmt,
+    Stmts,
+    Term,
+}
+
+// T is the type of terminals symbols
+#[allow(dead_code)]
+#[derive(Hash, Eq, PartialEq)]
+pub enum T { 
+    T0, // " 
+    T1, // ( 
+    T2, // ) 
+    T3, // + 
+    T4, // ; 
+    T5, // = 
+    T6, // comment 
+    T7, // id 
+    T8, // print 	
+}
+
+/// Format a &Vec<Symbol> into a String
+#[allow(dead_code)]
+pub fn to_string(symbols: &Vec<Symbol>) -> String {
+    let mut st: String = "".to_string();
+    for sym in symbols.iter() {
+        st.push_str(&format!("{} ",sym));
+    }
+    st
+}
+
+impl Symbol {
+    #[allow(dead_code)]
+    pub fn is_nt(&self) -> bool {
+        match self {
+            Symbol::NT(_) => return true,
+            Symbol::T(_) => return false,
+        }
+    }
+}
+
+impl fmt::Display for NT {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        match self {  
+            NT::Expr => write!(f, "Expr"), 
+            NT::Stmt => write!(f, "Stmt"), 
+            NT::Stmts => write!(f, "Stmts"), 
+            NT::Term => write!(f, "Term"),
+        }
+    }
+}
+
+impl fmt::Display for T {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        match self {  
+            T::T0 => write!(f, "\""), 
+            T::T1 => write!(f, "("), 
+            T::T2 => write!(f, ")"), 
+            T::T3 => write!(f, "+"), 
+            T::T4 => write!(f, ";"), 
+            T::T5 => write!(f, "="), 
+            T::T6 => write!(f, "comment"), 
+            T::T7 => write!(f, "id"), 
+            T::T8 => write!(f, "print"),
+        }
+    }
+}
+
+impl fmt::Display for Symbol {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        match self { 
+            Symbol::NT(nt) => write!(f, "{}", nt),
+            Symbol::T(t) => write!(f, "{}", t)
+        }
+    }
+}
+
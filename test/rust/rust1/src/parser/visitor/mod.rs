@@ -0,0 +1,191 @@
+//! Module visitor is generated by gogll. Do not edit.
+
+/*!
+Module visitor walks an unambiguous BSR set.
+
+walk calls the enter and exit methods of the nonterminal and of the alternate
+of every NT BSR. The enter methods are called in pre-order and the exit methods
+in post-order:
+
+    enter_expr, enter_expr_alt_0, <walk the NT children of the BSR>, exit_expr_alt_0, exit_expr
+
+The methods of Visitor have default implementations, which do nothing, so a
+visitor only implements the methods it needs.
+*/
+
+use crate::parser::bsr::{Set, BSR};
+use crate::parser::symbols::NT;
+
+use std::rc::Rc;
+
+/// Visitor has enter and exit methods for each nonterminal and each alternate
+/// of the grammar. If an enter method returns false the children of the BSR
+/// are not walked. The matching exit method is always called.
+#[allow(unused_variables)]
+pub trait Visitor {
+    fn enter_stmts(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_stmts(&mut self, set: &Set, b: &Rc<BSR>) {}
+
+    /// Stmts : Stmt Stmts
+    fn enter_stmts_alt_0(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_stmts_alt_0(&mut self, set: &Set, b: &Rc<BSR>) {}
+
+    /// Stmts : empty
+    fn enter_stmts_alt_1(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_stmts_alt_1(&mut self, set: &Set, b: &Rc<BSR>) {}
+
+    fn enter_stmt(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_stmt(&mut self, set: &Set, b: &Rc<BSR>) {}
+
+    /// Stmt : lhs:id "=" rhs:Expr ";"
+    fn enter_stmt_assign(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_stmt_assign(&mut self, set: &Set, b: &Rc<BSR>) {}
+
+    /// Stmt : "print" type:Expr ";"
+    fn enter_stmt_print(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_stmt_print(&mut self, set: &Set, b: &Rc<BSR>) {}
+
+    fn enter_expr(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_expr(&mut self, set: &Set, b: &Rc<BSR>) {}
+
+    /// Expr : left:Term "+" right:Expr
+    fn enter_expr_add(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_expr_add(&mut self, set: &Set, b: &Rc<BSR>) {}
+
+    /// Expr : Term
+    fn enter_expr_alt_1(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_expr_alt_1(&mut self, set: &Set, b: &Rc<BSR>) {}
+
+    fn enter_term(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_term(&mut self, set: &Set, b: &Rc<BSR>) {}
+
+    /// Term : name:id
+    fn enter_term_var(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_term_var(&mut self, set: &Set, b: &Rc<BSR>) {}
+
+    /// Term : "(" Expr ")"
+    fn enter_term_paren(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_term_paren(&mut self, set: &Set, b: &Rc<BSR>) {}
+
+    /// Term : "\"" text:id "\""
+    fn enter_term_quoted(&mut self, set: &Set, b: &Rc<BSR>) -> bool { true }
+    fn exit_term_quoted(&mut self, set: &Set, b: &Rc<BSR>) {}
+}
+
+/// Walks the BSR b of set and its NT children, calling the methods of v.
+/// Panics if b has ambiguous children.
+#[allow(dead_code)]
+pub fn walk(set: &Set, b: Rc<BSR>, v: &mut dyn Visitor) {
+    match b.label.head() {
+        NT::Stmts => {
+            if v.enter_stmts(set, &b) {
+                walk_stmts(set, &b, v);
+            }
+            v.exit_stmts(set, &b);
+        },
+        NT::Stmt => {
+            if v.enter_stmt(set, &b) {
+                walk_stmt(set, &b, v);
+            }
+            v.exit_stmt(set, &b);
+        },
+        NT::Expr => {
+            if v.enter_expr(set, &b) {
+                walk_expr(set, &b, v);
+            }
+            v.exit_expr(set, &b);
+        },
+        NT::Term => {
+            if v.enter_term(set, &b) {
+                walk_term(set, &b, v);
+            }
+            v.exit_term(set, &b);
+        },
+    }
+}
+
+fn walk_stmts(set: &Set, b: &Rc<BSR>, v: &mut dyn Visitor) {
+    match b.label.alternate() {
+        0 => {
+            if v.enter_stmts_alt_0(set, b) {
+                walk_children(set, b, v);
+            }
+            v.exit_stmts_alt_0(set, b);
+        },
+        1 => {
+            if v.enter_stmts_alt_1(set, b) {
+                walk_children(set, b, v);
+            }
+            v.exit_stmts_alt_1(set, b);
+        },
+        n => panic!("invalid alternate {} of Stmts", n),
+    }
+}
+
+fn walk_stmt(set: &Set, b: &Rc<BSR>, v: &mut dyn Visitor) {
+    match b.label.alternate() {
+        0 => {
+            if v.enter_stmt_assign(set, b) {
+                walk_children(set, b, v);
+            }
+            v.exit_stmt_assign(set, b);
+        },
+        1 => {
+            if v.enter_stmt_print(set, b) {
+                walk_children(set, b, v);
+            }
+            v.exit_stmt_print(set, b);
+        },
+        n => panic!("invalid alternate {} of Stmt", n),
+    }
+}
+
+fn walk_expr(set: &Set, b: &Rc<BSR>, v: &mut dyn Visitor) {
+    match b.label.alternate() {
+        0 => {
+            if v.enter_expr_add(set, b) {
+                walk_children(set, b, v);
+            }
+            v.exit_expr_add(set, b);
+        },
+        1 => {
+            if v.enter_expr_alt_1(set, b) {
+                walk_children(set, b, v);
+            }
+            v.exit_expr_alt_1(set, b);
+        },
+        n => panic!("invalid alternate {} of Expr", n),
+    }
+}
+
+fn walk_term(set: &Set, b: &Rc<BSR>, v: &mut dyn Visitor) {
+    match b.label.alternate() {
+        0 => {
+            if v.enter_term_var(set, b) {
+                walk_children(set, b, v);
+            }
+            v.exit_term_var(set, b);
+        },
+        1 => {
+            if v.enter_term_paren(set, b) {
+                walk_children(set, b, v);
+            }
+            v.exit_term_paren(set, b);
+        },
+        2 => {
+            if v.enter_term_quoted(set, b) {
+                walk_children(set, b, v);
+            }
+            v.exit_term_quoted(set, b);
+        },
+        n => panic!("invalid alternate {} of Term", n),
+    }
+}
+
+fn walk_children(set: &Set, b: &Rc<BSR>, v: &mut dyn Visitor) {
+    for (i, s) in b.label.symbols().iter().enumerate() {
+        if s.is_nt() {
+            walk(set, set.get_nt_child_i(b.clone(), i), v);
+        }
+    }
+}
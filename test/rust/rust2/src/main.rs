@@ -0,0 +1,114 @@
+mod lexer;
+mod parser;
+mod token;
+
+use lexer::Lexer;
+
+fn main() {
+    let input_file = &std::env::args().collect::<Vec<String>>()[1];
+    let lex = Lexer::new_file(&input_file).unwrap();
+    let (bsr_set, errs) = parser::parse(lex);
+    if errs.len() > 0 {
+        println!("Parse Error: {}", errs[0]);
+        std::process::exit(1);
+    }
+    let sppf = bsr_set.to_sppf();
+    println!("{} SPPF nodes, ambiguous: {}", sppf.nodes.len(), bsr_set.is_ambiguous());
+}
+
+#[cfg(test)]
+mod tests {
+    use crate::lexer::Lexer;
+    use crate::parser;
+    use crate::parser::sppf::{Node, SPPF};
+
+    use std::rc::Rc;
+
+    fn to_sppf(src: &str) -> (bool, SPPF) {
+        let lex = Lexer::new(Rc::new(src.chars().collect()));
+        let (set, errs) = parser::parse(lex);
+        if errs.len() > 0 {
+            panic!("{}", errs[0]);
+        }
+        (set.is_ambiguous(), set.to_sppf())
+    }
+
+    // edges returns the sorted edges of the SPPF: "node -> child"
+    fn edges(sppf: &SPPF) -> Vec<String> {
+        let mut edges = Vec::new();
+        for n in sppf.nodes.iter() {
+            let children = match n {
+                Node::Symbol(sn) => sn.children.clone(),
+                Node::Intermediate(inode) => inode.children.clone(),
+                Node::Packed(pn) => pn.left_child.iter().chain(pn.right_child.iter()).cloned().collect(),
+            };
+            for c in children {
+                edges.push(format!("{} -> {}", n.label(), sppf.node(c).label()));
+            }
+        }
+        edges.sort();
+        edges
+    }
+
+    #[test]
+    fn ambiguous() {
+        let (ambiguous, sppf) = to_sppf("a+a+a");
+        assert!(ambiguous);
+        let exp = vec![
+            r#""E,0,1" -> "E: a•,0,0,1""#,
+            r#""E,0,3" -> "E: E + E•,0,2,3""#,
+            r#""E,0,5" -> "E: E + E•,0,2,5""#,
+            r#""E,0,5" -> "E: E + E•,0,4,5""#,
+            r#""E,2,3" -> "E: a•,2,2,3""#,
+            r#""E,2,5" -> "E: E + E•,2,4,5""#,
+            r#""E,4,5" -> "E: a•,4,4,5""#,
+            r#""E: E + E•,0,2,3" -> "E,2,3""#,
+            r#""E: E + E•,0,2,3" -> "E: E + •E:,0,2""#,
+            r#""E: E + E•,0,2,5" -> "E,2,5""#,
+            r#""E: E + E•,0,2,5" -> "E: E + •E:,0,2""#,
+            r#""E: E + E•,0,4,5" -> "E,4,5""#,
+            r#""E: E + E•,0,4,5" -> "E: E + •E:,0,4""#,
+            r#""E: E + E•,2,4,5" -> "E,4,5""#,
+            r#""E: E + E•,2,4,5" -> "E: E + •E:,2,4""#,
+            r#""E: E + •E,0,1,2" -> "+,1,2""#,
+            r#""E: E + •E,0,1,2" -> "E,0,1""#,
+            r#""E: E + •E,0,3,4" -> "+,3,4""#,
+            r#""E: E + •E,0,3,4" -> "E,0,3""#,
+            r#""E: E + •E,2,3,4" -> "+,3,4""#,
+            r#""E: E + •E,2,3,4" -> "E,2,3""#,
+            r#""E: E + •E:,0,2" -> "E: E + •E,0,1,2""#,
+            r#""E: E + •E:,0,4" -> "E: E + •E,0,3,4""#,
+            r#""E: E + •E:,2,4" -> "E: E + •E,2,3,4""#,
+            r#""E: a•,0,0,1" -> "a,0,1""#,
+            r#""E: a•,2,2,3" -> "a,2,3""#,
+            r#""E: a•,4,4,5" -> "a,4,5""#,
+        ];
+        assert_eq!(edges(&sppf), exp);
+    }
+
+    #[test]
+    fn unambiguous() {
+        let (ambiguous, sppf) = to_sppf("a+a");
+        assert!(!ambiguous);
+        match sppf.node(sppf.root) {
+            Node::Symbol(sn) => assert_eq!(sn.children.len(), 1),
+            n => panic!("root {} is not a symbol node", n),
+        }
+    }
+
+    // E(i,j) with n > 1 operands has a packed node for each of its n-1
+    // operators, and each intermediate node E : E "+" •E has one packed node.
+    #[test]
+    fn packed_nodes() {
+        let (_, sppf) = to_sppf(&vec!["a"; 12].join("+"));
+        for n in sppf.nodes.iter() {
+            match n {
+                Node::Symbol(sn) if sn.nt.is_some() && sn.rext - sn.lext > 1 => {
+                    assert_eq!(sn.children.len(), (sn.rext - sn.lext - 1) / 2, "{}", n)
+                }
+                Node::Intermediate(inode) => assert_eq!(inode.children.len(), 1, "{}", n),
+                _ => (),
+            }
+        }
+    }
+}
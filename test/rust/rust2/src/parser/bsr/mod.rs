@@ -0,0 +1,564 @@
+
+// Module bsr is generated by gogll. Do not edit.
+
+/*
+Module bsr implements a Binary Subtree Representation set as defined in
+
+    Scott et al
+    Derivation representation using binary subtree sets,
+    Science of Computer Programming 175 (2019)
+
+*/
+
+use crate::lexer;
+use crate::parser::{slot, symbols};
+use crate::parser::sppf::{self, Node, NodeId, SPPF};
+use crate::parser::symbols::{NT, Symbol};
+use crate::token::{Token};
+
+use std::cmp::Ordering;
+use std::cmp::Ordering::{Less, Greater};
+use std::collections::HashMap;
+use std::rc::Rc;
+use std::fmt;
+
+// The kind of BSR added.
+enum Kind {
+    NT(Rc<BSR>),
+    Str(Rc<BSR>),
+}
+
+/**
+Set contains the set of Binary Subtree Representations (BSR).
+*/
+#[allow(dead_code)]
+pub struct Set {
+    slot_entries: HashMap<Rc<BSR>, bool>,
+    nt_slot_entries: HashMap<NTSlot, Vec<Rc<BSR>>>,
+    string_entries: HashMap<StrSlot, Vec<Rc<BSR>>>,
+    pub rext: usize,
+    lex: Rc<lexer::Lexer>,
+
+    start_sym: NT,
+}
+
+#[derive(Hash, Eq, PartialEq)]
+struct NTSlot {
+    nt: NT,
+    lext: usize,
+    rext: usize,
+}
+
+// StrSlot is the key of the string BSRs of a grammar slot with the same extents
+#[derive(Hash, Eq, PartialEq)]
+struct StrSlot {
+    label: slot::Label,
+    lext: usize,
+    rext: usize,
+}
+
+/// BSR is the binary subtree representation of a parsed nonterminal
+#[derive(Hash, Eq, PartialEq)]
+pub struct BSR {
+    pub label: slot::Label,
+    pub lext: usize,
+    pub pivot: usize,
+    pub rext: usize,
+}
+
+impl BSR {
+    fn cmp(&self, other: &Self) -> Ordering {
+        if self.lext < other.lext {
+            return Less;
+        }
+        if self.lext > other.lext {
+            return Greater;
+        }
+        // self.lext == other.lext
+        if self.rext > other.rext {
+            return Less;
+        }
+        if self.rext < other.rext {
+            return Greater;
+        }
+        // self.rext == other.rext
+        if self.pivot < other.pivot {
+            return Less;
+        }
+        Greater
+    }
+}
+
+impl Set {
+    /// New returns a new initialised BSR Set
+    #[allow(dead_code)]
+    pub fn new(start_symbol: NT, l: Rc<lexer::Lexer>) -> Box<Set> {
+        Box::new(Set {
+            slot_entries: HashMap::with_capacity(1024),
+            nt_slot_entries: HashMap::with_capacity(1024),
+            string_entries: HashMap::with_capacity(1024),
+            rext: 0,
+            lex: l.clone(),
+            start_sym: start_symbol,
+        })
+    }
+
+    /// Add a BSR to the set. (i,j) is the extent. k is the pivot.
+    #[allow(dead_code)]
+    pub fn add(&mut self, l: slot::Label, i: usize, k: usize, j: usize) {
+        let b = Rc::new(BSR {
+            label: l,
+            lext: i,
+            pivot: k,
+            rext: j,
+        });
+        if l.eor() {
+            self.insert(Kind::NT(b))
+        } else {
+            if l.pos() > 1 {
+                self.insert(Kind::Str(b))
+            }
+        }
+    }
+
+    /// Returns the index of the grammar rule alternate.
+    #[allow(dead_code)]
+    pub fn alternate(&self, b: Rc<BSR>) -> usize {
+    	return b.label.alternate()
+    }
+
+    fn insert(&mut self, bsr: Kind) {
+        if bsr.rext() > self.rext {
+            self.rext = bsr.rext()
+        }
+        match bsr {
+            Kind::NT(b) => {
+                self.slot_entries.insert(b.clone(), true);
+                let nt_slot = NTSlot::new(b.label.head(), b.lext, b.rext);
+                match self.nt_slot_entries.get_mut(&nt_slot) {
+                    None => {
+                        self.nt_slot_entries.insert(nt_slot, vec![b.clone()]);
+                    },
+                    Some(bsrs) => bsrs.push(b.clone())
+                }
+            }
+            Kind::Str(b) => {
+                let str_slot = StrSlot{label: b.label, lext: b.lext, rext: b.rext};
+                match self.string_entries.get_mut(&str_slot) {
+                    None => {
+                        self.string_entries.insert(str_slot, vec![b.clone()]);
+                    },
+                    Some(bsrs) => if !bsrs.contains(&b) {
+                        bsrs.push(b.clone())
+                    }
+                }
+            }
+        };
+    }
+
+    /// AddEmpty adds a grammar slot: X : ϵ•
+    #[allow(dead_code)]
+    pub fn add_empty(&mut self, l: slot::Label, i: usize) {
+        self.insert(Kind::NT(Rc::new(BSR {
+            label: l,
+            lext: i,
+            pivot: i,
+            rext: i,
+        })))
+    }
+
+    /**
+    contain returns true iff the BSR Set contains the NT symbol with left and
+    right extent.
+    */
+    #[allow(dead_code)]
+    pub fn contain(&self, nt: &NT, left: usize, right: usize) -> bool {
+        for e in self.slot_entries.keys() {
+            if e.label.head() == nt && e.lext == left && e.rext == right {
+                return true;
+            }
+        }
+        return false;
+    }
+
+    /// Returns all the NT BSR entries. Used for debugging.
+    #[allow(dead_code)]
+    pub fn get_all(&self) -> Vec<Rc<BSR>> {
+        let mut bsrs: Vec<Rc<BSR>> = Vec::with_capacity(128);
+        for b in self.slot_entries.keys() {
+            bsrs.push(b.clone());
+        }
+        bsrs.sort_by(|a, b| a.cmp(b));
+        bsrs
+    }
+
+    // get_root returns the root of the parse tree of an unambiguous parse.
+    // get_root fails if the parse was ambiguous. Use get_roots() for ambiguous parses.
+    #[allow(dead_code)]
+    pub fn get_root(&self) -> Rc<BSR> {
+        let rts = self.get_roots();
+        if rts.len() != 1 {
+            fail(format!("{} parse trees exist for start symbol {}", 
+            rts.len(), self.start_sym))
+        }
+        return rts[0].clone()
+    }
+
+    // get_roots returns all the roots of parse trees of the start symbol of the grammar.
+    #[allow(dead_code)]
+    pub fn get_roots(&self) -> Vec<Rc<BSR>> {
+        let mut roots: Vec<Rc<BSR>> = Vec::with_capacity(128);
+        for b in self.slot_entries.keys() {
+            if b.label.head() == &self.start_sym && b.lext == 0 && b.rext == self.rext {
+                roots.push(b.clone())
+            }
+        }
+        roots
+    }
+
+    /// Return the (line, column) of the left extent of token i.
+    fn get_line_column(&self, i: usize) -> (usize, usize) {
+    	return self.lex.get_line_column_of_token(i)
+    }
+
+    // get_nt_child_i returns the BSR of NT symbol[i] in the BSR set.
+    // get_nt_child_i fails if the BSR set has ambiguous subtrees of NT i.
+    #[allow(dead_code)]
+    pub fn get_nt_child_i(&self, b: Rc<BSR>, i: usize) -> Rc<BSR> {
+        let bsrs = self.get_nt_children_i(b.clone(), i);
+        if bsrs.len() != 1 {
+            panic!("NT {} is ambiguous in {}", i, b.clone());
+        }
+        return bsrs[0].clone()
+    }
+
+    // get_nt_children_i returns all the BSRs of NT symbol[i] in s
+    #[allow(dead_code)]
+    pub fn get_nt_children_i(&self, b: Rc<BSR>, i: usize) -> &Vec<Rc<BSR>> {
+        if i >= b.label.symbols().len() {
+            fail(format!("Error: cannot get NT child {} of {}", i, b))
+        }
+        if b.label.symbols().len() == 1 {
+            return self.get_nt_slot(&b.label.symbols()[i], b.pivot, b.rext)
+        }
+        if b.label.symbols().len() == 2 {
+            if i == 0 {
+                return self.get_nt_slot(&b.label.symbols()[i], b.lext, b.pivot)
+            }
+            return self.get_nt_slot(&b.label.symbols()[i], b.pivot, b.rext)
+        }
+        let mut idx = b.label.index();
+        let mut str_bsr = Rc::new(BSR{label: b.label, lext: b.lext, pivot: b.pivot, rext: b.rext});
+        while idx.pos > i+1 && idx.pos > 2 {
+            idx.pos -= 1;
+            str_bsr = self.get_string(slot::get_label(&idx.nt, idx.alt, idx.pos), 
+                str_bsr.lext, str_bsr.pivot);
+        }
+        if i == 0 {
+            return self.get_nt_slot(&b.label.symbols()[i], str_bsr.lext, str_bsr.pivot)
+        }
+        return self.get_nt_slot(&b.label.symbols()[i], str_bsr.pivot, str_bsr.rext)
+    }
+
+    fn get_nt_slot(&self, sym: &Symbol, lext: usize, rext: usize) -> &Vec<Rc<BSR>> {
+        if let Symbol::NT(nt) = sym {
+            if let Some(bsrs) = self.nt_slot_entries.get(&NTSlot::new(&nt, lext, rext)) {
+                return bsrs
+            }
+            panic!("{} ({},{}) has no slot entry", nt, lext, rext)
+        }
+        let (line, col) = self.get_line_column(lext);
+        panic!("{} is not an NT at line {} col {}", sym, line, col);
+    }
+    
+    fn get_string(&self, l: slot::Label, lext: usize, rext: usize) -> Rc<BSR> {
+        if let Some(strs) = self.string_entries.get(&StrSlot{label: l, lext, rext}) {
+            return strs[0].clone()
+        }
+        panic!("Error: no string BSR {} left extent={} right extent={} pos={}",
+            symbols::to_string(l.symbols()), lext, rext, l.pos())
+    }
+
+    /**
+    GetTChildI returns the terminal symbol at position i in b.   
+    GetTChildI panics if symbol i is not a valid terminal
+    */
+    #[allow(dead_code)]
+    pub fn get_t_child_i(&self, b: Rc<BSR>, i: usize) -> Rc<Token> {
+		let symbols = b.label.symbols();
+
+        if i >= symbols.len() {
+            panic!("{} has no T child {}", b, i);
+        }
+        if symbols[i].is_nt() {
+            panic!("symbol {} in {} is an NT", i, b);
+		}
+		
+		let mut lext: usize = b.lext;
+		for j in 0..i {
+			if symbols[j].is_nt() {
+				let nt = self.get_nt_child_i(b.clone(), j);
+				lext += nt.rext - nt.lext;
+			} else {
+				lext += 1;
+			}
+		}
+
+        self.lex.tokens[lext].clone()
+    }
+
+    /// Returns true if the BSR set does not have exactly one root, or
+    /// if any BSR in the set has an NT symbol, which does not have exactly one
+    /// sub-tree.
+    #[allow(dead_code)]
+    pub fn is_ambiguous(&self) -> bool {
+        if self.get_roots().len() != 1 {
+            return true
+        }
+        self.is_ambiguous_bsr(self.get_root())
+    }
+
+    /// Returns true if b or any of its NT children is ambiguous.
+    /// A BSR is ambigous if any of its NT symbols does not have exactly one
+    /// subtrees (children).
+    fn is_ambiguous_bsr(&self, b: Rc<BSR>) -> bool {
+        for (i, s) in b.label.symbols().iter().enumerate() {
+            if s.is_nt() {
+                if self.get_nt_children_i(b.clone(), i).len() != 1 {
+                    return true
+                }
+                for b1 in self.get_nt_children_i(b.clone(), i).iter() {
+                    if self.is_ambiguous_bsr(b1.clone()) {
+                        return true
+                    }
+                }
+            }
+        }
+        return false
+    }
+
+    /// Prints the ambiguous subtrees of the parse forest
+    #[allow(dead_code)]
+    pub fn report_ambiguous(&self) {
+        println!("Ambiguous BSR Subtrees:");
+        let rts = self.get_roots();
+        if rts.len() != 1 {
+            println!("BSR has {} ambigous roots", rts.len());
+        }
+        for (i, b) in rts.iter().enumerate() {
+            println!("In root {}", i);
+            if !self.report(b.clone()) {
+                println!("No ambiguous BSRs");
+            }
+        }
+    }
+
+    /// Returns true iff at least one ambiguous BSR was found
+    fn report(&self, b: Rc<BSR>) -> bool {
+        let mut ambiguous = false;
+        for (i, sym) in b.label.symbols().iter().enumerate() {
+            let (ln, col) = self.get_line_column(b.lext);
+            if sym.is_nt() {
+                let children = self.get_nt_children_i(b.clone(), i);
+                if children.len() != 1 {
+                    ambiguous = true;
+                    println!("  Ambigous: in {}: NT {} ({}) at line {} col {} ",
+                        b, sym, i, ln, col);
+                    println!("   Children:");
+                    for c in children.iter() {
+                        println!("     {}", c);
+                    }
+                }
+                for b1 in children.iter() {
+                    self.report(b1.clone());
+                }
+            }
+        }
+        ambiguous
+    }
+
+    /// Returns the Shared Packed Parse Forest of the BSR set
+    #[allow(dead_code)]
+    pub fn to_sppf(&self) -> SPPF {
+        let rt = self.get_roots()[0].clone();
+        let mut bld = BldSPPF{
+            sppf: SPPF{ nodes: Vec::new(), root: 0 },
+            ext_leaf_nodes: Vec::new(),
+            i_nodes: HashMap::new(),
+            p_nodes: HashMap::new(),
+            s_nodes: HashMap::new(),
+        };
+        bld.sppf.root = bld.mk_sn(rt.label.head().to_string(), Some(*rt.label.head()), 
+            rt.lext, rt.rext);
+
+        // let w = (μ, i, j) be an extendable leaf node of G
+        while let Some(w) = bld.ext_leaf_nodes.pop() {
+            let mut children: Vec<NodeId> = Vec::new();
+            match &bld.sppf.nodes[w] {
+                // μ is a nonterminal X in Γ
+                Node::Symbol(sn) => {
+                    let bsts = self.get_nt_slot(&Symbol::NT(sn.nt.unwrap()), sn.lext, sn.rext);
+                    // for each (X ::=γ,i,k, j)∈Υ { mkPN(X ::=γ·,i,k, j,G) }
+                    for bst in bsts.clone().iter() {
+                        children.push(bld.mk_pn(bst.label, bst.lext, bst.pivot, bst.rext));
+                    }
+                },
+                // w is an intermediate node. Suppose μ is X ::=α·δ
+                Node::Intermediate(inode) => {
+                    let (label, lext, rext) = (inode.slot, inode.lext, inode.rext);
+                    if label.pos() == 1 {
+                        children.push(bld.mk_pn(label, lext, lext, rext));
+                    } else {
+                        // for each (α,i,k, j)∈Υ { mkPN(X ::=α·δ,i,k, j,G) }
+                        let mut strs: Vec<Rc<BSR>> = self.string_entries
+                            .get(&StrSlot{label, lext, rext})
+                            .cloned()
+                            .unwrap_or_default();
+                        strs.sort_by_key(|s| s.pivot);
+                        for s in strs {
+                            children.push(bld.mk_pn(label, s.lext, s.pivot, s.rext));
+                        }
+                    }
+                },
+                Node::Packed(_) => panic!("packed node {} is not extendable", w),
+            }
+            match &mut bld.sppf.nodes[w] {
+                Node::Symbol(sn) => sn.children = children,
+                Node::Intermediate(inode) => inode.children = children,
+                Node::Packed(_) => (),
+            }
+        }
+        bld.sppf
+    }
+
+} // impl Set
+
+//---- SPPF ----
+
+struct BldSPPF {
+    sppf: SPPF,
+    ext_leaf_nodes: Vec<NodeId>,
+    i_nodes: HashMap<(slot::Label, usize, usize), NodeId>,
+    p_nodes: HashMap<(slot::Label, usize, usize, usize), NodeId>,
+    s_nodes: HashMap<(String, usize, usize), NodeId>,
+}
+
+impl BldSPPF {
+    fn add(&mut self, n: Node) -> NodeId {
+        self.sppf.nodes.push(n);
+        self.sppf.nodes.len() - 1
+    }
+
+    fn mk_in(&mut self, label: slot::Label, lext: usize, rext: usize) -> NodeId {
+        if let Some(&id) = self.i_nodes.get(&(label, lext, rext)) {
+            return id
+        }
+        let id = self.add(Node::Intermediate(sppf::IntermediateNode{
+            slot: label,
+            lext: lext,
+            rext: rext,
+            children: Vec::new(),
+        }));
+        self.i_nodes.insert((label, lext, rext), id);
+        self.ext_leaf_nodes.push(id);
+        id
+    }
+
+    fn mk_pn(&mut self, label: slot::Label, lext: usize, pivot: usize, rext: usize) -> NodeId {
+        // X ::= ⍺ • β, k
+        if let Some(&id) = self.p_nodes.get(&(label, lext, pivot, rext)) {
+            return id
+        }
+        let id = self.add(Node::Packed(sppf::PackedNode{
+            slot: label,
+            lext: lext,
+            pivot: pivot,
+            rext: rext,
+            left_child: None,
+            right_child: None,
+        }));
+        self.p_nodes.insert((label, lext, pivot, rext), id);
+
+        let (body, pos) = (label.symbols(), label.pos());
+        let (mut left, right);
+        if body.len() == 0 { // ⍺ = ϵ
+            left = None;
+            right = self.mk_sn("ϵ".to_string(), None, lext, lext);
+        } else { // if ( α=βx, where |x|=1) {
+            // mkN(x,k, j, y,G)
+            right = self.mk_sym_sn(&body[pos-1], pivot, rext);
+            left = None;
+            // if (|β|=1) mkN(β,i,k,y,G)
+            if pos == 2 {
+                left = Some(self.mk_sym_sn(&body[0], lext, pivot));
+            }
+            // if (|β|>1) mkN(X ::=β·xδ,i,k,y,G)
+            if pos > 2 {
+                let l = slot::get_label(label.head(), label.alternate(), pos-1);
+                left = Some(self.mk_in(l, lext, pivot));
+            }
+        }
+        if let Node::Packed(pn) = &mut self.sppf.nodes[id] {
+            pn.left_child = left;
+            pn.right_child = Some(right);
+        }
+        id
+    }
+
+    fn mk_sym_sn(&mut self, sym: &Symbol, lext: usize, rext: usize) -> NodeId {
+        match sym {
+            Symbol::NT(nt) => self.mk_sn(sym.to_string(), Some(*nt), lext, rext),
+            Symbol::T(_) => self.mk_sn(sym.to_string(), None, lext, rext),
+        }
+    }
+
+    fn mk_sn(&mut self, symbol: String, nt: Option<NT>, lext: usize, rext: usize) -> NodeId {
+        let key = (symbol, lext, rext);
+        if let Some(&id) = self.s_nodes.get(&key) {
+            return id
+        }
+        let id = self.add(Node::Symbol(sppf::SymbolNode{
+            symbol: key.0.clone(),
+            nt: nt,
+            lext: lext,
+            rext: rext,
+            children: Vec::new(),
+        }));
+        self.s_nodes.insert(key, id);
+        if nt.is_some() {
+            self.ext_leaf_nodes.push(id);
+        }
+        id
+    }
+}
+
+impl Kind {
+    fn rext(&self) -> usize {
+        match self {
+            Kind::NT(b) => b.rext,
+            Kind::Str(b) => b.rext,
+        }
+    }
+}
+
+impl NTSlot {
+    fn new(nt: &NT, lext: usize, rext: usize) -> NTSlot {
+        NTSlot{
+            nt: nt.clone(), 
+            lext: lext,
+            rext: rext,
+        }
+    }
+}
+
+impl fmt::Display for BSR {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        write!(f, "({} ({},{},{})", self.label, self.lext, self.pivot, self.rext)
+    }
+}
+
+fn fail(msg: String) {
+	panic!("Error in BSR: {}", msg)
+}
+
+
@@ -0,0 +1,622 @@
+
+//! Module lexer is generated by GoGLL. Do not edit.
+
+use crate::token;
+
+use std::{fs, io};
+use std::cell::Cell;
+use std::collections::HashMap;
+use std::fmt;
+use std::rc::Rc;
+
+type State = usize;
+
+const NULL_STATE: State = usize::MAX ;
+
+/**
+Lexer contains both the input Vec<char> and the Vec<token::Token>
+parsed from the input
+*/
+pub struct Lexer {
+	/// i is the input vector of char
+	i: Rc<Vec<char>>,
+
+	/// tokens is the vector of tokens constructed by the lexer from I
+	pub tokens: Vec<Rc<token::Token>>,
+
+	/// errors contains the lexical errors in the input in order of occurrence
+	pub errors: Vec<LexError>,
+
+	/// trivia is true if the lexer attaches trivia to the tokens
+	trivia: bool,
+}
+
+/// LexError is a lexical error: the lexer could not scan a token from the input.
+#[derive(Clone)]
+pub struct LexError {
+	/// token is the Error token in Lexer.tokens, which contains the input
+	/// skipped by the lexer.
+	pub token: Rc<token::Token>,
+
+	/// pos is the position of the offending char in the input.
+	/// If the lexer reached the end of the input pos == input.len()
+	pub pos: usize,
+
+	/// The line of the offending char in the input
+	pub line: usize,
+
+	/// The column of the offending char in the input
+	pub column: usize,
+
+	/// ch is the offending char. It is None at the end of the input.
+	pub ch: Option<char>,
+
+	/// partial contains the token types that were partially matched when
+	/// the error occurred. It is empty if no token matches the first char.
+	pub partial: Vec<token::Type>,
+}
+
+/// Recovery is the strategy used by the lexer to continue after a lexical error
+#[derive(Clone, Copy, PartialEq, Eq, Debug)]
+pub enum Recovery {
+	/// SkipChar continues scanning at the char after the offending char
+	SkipChar,
+
+	/// SkipToSpace continues scanning at the first whitespace after the
+	/// offending char
+	SkipToSpace,
+}
+
+thread_local! {
+	static ERROR_RECOVERY: Cell<Recovery> = Cell::new(Recovery::SkipChar);
+}
+
+/// set_error_recovery sets the error recovery strategy of the lexers created
+/// on the current thread after it is set. The default is Recovery::SkipChar.
+#[allow(dead_code)]
+pub fn set_error_recovery(r: Recovery) {
+	ERROR_RECOVERY.with(|rec| rec.set(r))
+}
+
+/// error_recovery returns the error recovery strategy of the current thread
+pub fn error_recovery() -> Recovery {
+	ERROR_RECOVERY.with(|rec| rec.get())
+}
+
+/**
+Edit describes how an edit of the input by Lexer::edit changed the tokens.
+
+tokens[first..first+num_old] before the edit were replaced by the re-lexed
+tokens[first..first+num_new]. The index of every token after them changed by
+num_new-num_old.
+*/
+#[derive(Clone, Copy, PartialEq, Eq, Debug)]
+pub struct Edit {
+	/// The edit of the input: deleted chars at offset were replaced by
+	/// inserted chars.
+	pub offset: usize,
+	pub deleted: usize,
+	pub inserted: usize,
+
+	/// The re-lexed window of tokens
+	pub first: usize,
+	pub num_old: usize,
+	pub num_new: usize,
+}
+
+impl Lexer {
+	/**
+	new_file constructs a Lexer created from the input file, fname. 
+
+	If the input file is a markdown file new_file process treats all text outside
+	code blocks as whitespace. All text inside code blocks are treated as input text.
+
+	If the input file is a normal text file new_file treats all text in the inputfile
+	as input text.
+	*/
+	#[allow(dead_code)]
+	pub fn new_file(fname: &String) -> io::Result<Rc<Lexer>> {
+		let i = Rc::new(load_file(fname)?);
+		Ok(Lexer::new(i))
+	}
+
+	/// new_file_with_trivia is like new_file but attaches trivia to the tokens,
+	/// like new_with_trivia.
+	#[allow(dead_code)]
+	pub fn new_file_with_trivia(fname: &String) -> io::Result<Rc<Lexer>> {
+		let i = Rc::new(load_file(fname)?);
+		Ok(Lexer::new_with_trivia(i))
+	}
+
+	/**
+	new constructs a Lexer from a Vec<char>. 
+	
+	All contents of the input are treated as input text.
+	*/
+	pub fn new(input: Rc<Vec<char>>) -> Rc<Lexer> {
+		Rc::new(Lexer::lex(input, false))
+	}
+
+	/**
+	new_with_trivia constructs a Lexer from a Vec<char>, like new, but keeps
+	the whitespace and suppressed tokens between the tokens as trivia attached
+	to the neighbouring tokens.
+
+	The trailing trivia of a token extends up to and including the end of its line.
+	The rest of the trivia between two tokens is the leading trivia of the second
+	token. Trivia at the end of the input is the leading trivia of the EOF token.
+	The input can be reproduced by concatenating token::Token::full_literal() of
+	all the tokens.
+	*/
+	#[allow(dead_code)]
+	pub fn new_with_trivia(input: Rc<Vec<char>>) -> Rc<Lexer> {
+		Rc::new(Lexer::lex(input, true))
+	}
+
+	fn lex(input: Rc<Vec<char>>, trivia: bool) -> Lexer {
+		let mut lex = Lexer{
+			i:      input,
+			tokens: Vec::new(),
+			errors: Vec::new(),
+			trivia: trivia,
+		};
+		lex.tokens = lex.scan_tokens(0, None, &mut |_| false);
+		lex
+	}
+
+	/**
+	scan_tokens scans the tokens from position lext in the input up to and 
+	including the EOF token, or the first token for which stop returns true.
+	prev is the token before lext. Its trailing trivia is set if the lexer
+	keeps trivia.
+	*/
+	fn scan_tokens(&mut self, mut lext: usize, mut prev: Option<Rc<token::Token>>,
+		stop: &mut dyn FnMut(&token::Token) -> bool) -> Vec<Rc<token::Token>> {
+
+		let mut toks = Vec::new();
+		loop {
+			let tok = if self.trivia {
+				self.scan_with_trivia(lext, prev)
+			} else {
+				self.scan_token(lext)
+			};
+			toks.push(tok.clone());
+			if tok.typ == token::Type::EOF || stop(&tok) {
+				return toks
+			}
+			lext = tok.rext;
+			prev = Some(tok);
+		}
+	}
+
+	/// scan_token returns the first token after position lext in the input
+	/// that is not suppressed.
+	fn scan_token(&mut self, mut lext: usize) -> Rc<token::Token> {
+		loop {
+			while lext < self.i.len() && self.i[lext].is_whitespace() {
+				lext += 1
+			}
+			if lext >= self.i.len() {
+				return token::new(token::Type::EOF, self.i.len(), self.i.len(), &self.i)
+			}
+			let mut tok = self.scan(lext);
+			if tok.typ == token::Type::Error {
+				tok = self.lex_error(tok)
+			}
+			if !tok.suppress() {
+				return tok
+			}
+			lext = tok.rext
+		}
+	}
+
+	/// scan_with_trivia returns the first token after position lext in the input
+	/// that is not suppressed. It attaches the trivia from lext to the token
+	/// to prev and the token.
+	fn scan_with_trivia(&mut self, mut lext: usize, prev: Option<Rc<token::Token>>) 
+		-> Rc<token::Token> {
+
+		let trivia_lext = lext;
+		let mut suppressed: Vec<Rc<token::Token>> = Vec::new();
+		let tok = loop {
+			while lext < self.i.len() && self.i[lext].is_whitespace() {
+				lext += 1
+			}
+			if lext >= self.i.len() {
+				break token::new(token::Type::EOF, self.i.len(), self.i.len(), &self.i)
+			}
+			let t = self.scan(lext);
+			if t.suppress() {
+				lext = t.rext;
+				suppressed.push(t)
+			} else if t.typ == token::Type::Error {
+				break self.lex_error(t)
+			} else {
+				break t
+			}
+		};
+		let mut split = trivia_lext;
+		let mut num_trailing = 0;
+		if let Some(prev) = prev {
+			split = self.end_of_line(trivia_lext, tok.lext, &suppressed);
+			while num_trailing < suppressed.len() && suppressed[num_trailing].lext < split {
+				num_trailing += 1
+			}
+			prev.set_trailing_trivia(split, suppressed[..num_trailing].to_vec())
+		}
+		tok.set_leading_trivia(split, suppressed[num_trailing..].to_vec());
+		tok
+	}
+
+	/// end_of_line returns the position after the first newline in the trivia
+	/// from lext to rext, that is not part of a suppressed token. It returns rext
+	/// if there is no such newline.
+	fn end_of_line(&self, lext: usize, rext: usize, suppressed: &[Rc<token::Token>]) -> usize {
+		let (mut i, mut j) = (lext, 0);
+		while i < rext {
+			if j < suppressed.len() && suppressed[j].lext == i {
+				i = suppressed[j].rext;
+				j += 1;
+				continue
+			}
+			if self.i[i] == '\n' {
+				return i + 1
+			}
+			i += 1
+		}
+		rext
+	}
+
+	fn scan(&mut self, i: usize) -> Rc<token::Token> {
+		let mut s: State = 0;
+		let mut typ = token::Type::Error;
+		let mut rext = i;
+
+		while s != NULL_STATE {
+			if rext >= self.i.len() {
+				typ = ACCEPT[s];
+				s = NULL_STATE
+			} else {
+				typ = ACCEPT[s];
+				s = NEXT_STATE[s](self.i[rext]);
+				if s != NULL_STATE || typ == token::Type::Error {
+					rext += 1
+				}
+			}
+		}
+		return token::new(typ, i, rext, &self.i)
+	}
+
+	/**
+	lex_error records the LexError for the Error token, tok, and returns the
+	Error token extended by the error recovery strategy, error_recovery().
+	*/
+	fn lex_error(&mut self, tok: Rc<token::Token>) -> Rc<token::Token> {
+		let (mut s, mut pos) = (0, tok.lext);
+		while pos < self.i.len() {
+			let next = NEXT_STATE[s](self.i[pos]);
+			if next == NULL_STATE {
+				break
+			}
+			s = next;
+			pos += 1
+		}
+		let mut tok = tok;
+		if error_recovery() == Recovery::SkipToSpace {
+			let mut rext = tok.rext;
+			while rext < self.i.len() && !self.i[rext].is_whitespace() {
+				rext += 1
+			}
+			tok = token::new(token::Type::Error, tok.lext, rext, &self.i)
+		}
+		let (line, column) = self.get_line_column(pos);
+		self.errors.push(LexError{
+			token: tok.clone(),
+			pos: pos,
+			line: line,
+			column: column,
+			ch: self.i.get(pos).cloned(),
+			partial: PARTIAL[s].to_vec(),
+		});
+		tok
+	}
+
+	/**
+	edit replaces the deleted chars at offset in the input with inserted and
+	re-lexes only the tokens damaged by the edit. It returns the lexer of the
+	edited input, whose tokens after the damaged window are moved and their
+	indices shifted, and whose lexical errors are updated. The lexer is not
+	changed.
+	*/
+	#[allow(dead_code)]
+	pub fn edit(&self, offset: usize, deleted: usize, inserted: &[char]) -> (Rc<Lexer>, Edit) {
+		let mut input = Vec::with_capacity(self.i.len() - deleted + inserted.len());
+		input.extend_from_slice(&self.i[..offset]);
+		input.extend_from_slice(inserted);
+		input.extend_from_slice(&self.i[offset+deleted..]);
+		let input = Rc::new(input);
+		let delta = inserted.len() as isize - deleted as isize;
+		let mv = |i: usize| (i as isize + delta) as usize;
+
+		// The first damaged token is the first token that ends at or after offset,
+		// because the lexer reads the char after a token to find its end.
+		let old = &self.tokens;
+		let mut first = 0;
+		while first < old.len()-1 && old[first].rext < offset {
+			first += 1
+		}
+
+		let mut lex = Lexer{
+			i:      input.clone(),
+			tokens: old[..first].iter().map(|tok| tok.shift(0, &input)).collect(),
+			errors: Vec::new(),
+			trivia: self.trivia,
+		};
+
+		let (lext, prev) = match lex.tokens.last() {
+			Some(prev) => (prev.rext, Some(prev.clone())),
+			None => (0, None),
+		};
+		// Re-lex until a token is the same as an old token after the edit.
+		let mut j = first;
+		let relexed = lex.scan_tokens(lext, prev, &mut |tok| {
+			while j < old.len() &&
+				(old[j].lext < offset+deleted || mv(old[j].lext) < tok.lext) {
+				j += 1
+			}
+			j < old.len() && old[j].typ == tok.typ &&
+				mv(old[j].lext) == tok.lext && mv(old[j].rext) == tok.rext
+		});
+		let mut num_old = old.len() - first;
+		let last = &relexed[relexed.len()-1];
+		if last.typ != token::Type::EOF {
+			num_old = j - first + 1;
+			if lex.trivia {
+				let tok = old[j].shift(delta, &input);
+				last.set_trailing_trivia(tok.rext + tok.trailing_trivia().len(), 
+					tok.trailing_suppressed())
+			}
+		}
+		let num_new = relexed.len();
+		lex.tokens.extend(relexed);
+		for tok in old[first+num_old..].iter() {
+			lex.tokens.push(tok.shift(delta, &input))
+		}
+
+		lex.update_errors(old, &self.errors, first, num_old, num_new, delta);
+
+		(Rc::new(lex), Edit{
+			offset: offset,
+			deleted: deleted,
+			inserted: inserted.len(),
+			first: first,
+			num_old: num_old,
+			num_new: num_new,
+		})
+	}
+
+	// update_errors moves the lexical errors, old_errors, before and after the 
+	// re-lexed tokens to the new tokens. self.errors contains the errors of the
+	// re-lexed tokens.
+	fn update_errors(&mut self, old: &[Rc<token::Token>], old_errors: &[LexError],
+		first: usize, num_old: usize, num_new: usize, delta: isize) {
+
+		let index: HashMap<*const token::Token, usize> = old.iter().enumerate()
+			.map(|(i, tok)| (Rc::as_ptr(tok), i)).collect();
+		let mut before = Vec::new();
+		let mut after = Vec::new();
+		for err in old_errors.iter() {
+			let i = index[&Rc::as_ptr(&err.token)];
+			let mut err1 = err.clone();
+			if i < first {
+				err1.token = self.tokens[i].clone();
+				before.push(err1)
+			} else if i >= first+num_old {
+				err1.token = self.tokens[i+num_new-num_old].clone();
+				err1.pos = (err.pos as isize + delta) as usize;
+				let (line, column) = self.get_line_column(err1.pos);
+				err1.line = line;
+				err1.column = column;
+				after.push(err1)
+			}
+		}
+		before.append(&mut self.errors);
+		before.append(&mut after);
+		self.errors = before
+	}
+
+	/// get_line_column returns the (line, column) of char[i] in the input
+	#[allow(dead_code)]
+	pub fn get_line_column(&self, i: usize) -> (usize, usize) {
+		let mut line = 1;
+		let mut col = 1;
+		let mut j = 0;
+		while j < i {
+			match self.i[j] {
+			'\n' => {
+				line += 1;
+				col = 1
+			},
+			'\t' => col += 4,
+			_ => col += 1
+			}
+			j += 1
+		}
+		(line, col)
+	}
+	
+	/// get_line_column_of_token returns the (line, column) of token[i] 
+	/// in the input
+	#[allow(dead_code)]
+	pub fn get_line_column_of_token(&self, i: usize) -> (usize, usize) {
+		self.get_line_column(self.tokens[i].lext)
+	}
+
+	// get_string returns the input string from the left extent of Token[lext] to
+	// the right extent of Token[rext]
+	#[allow(dead_code)]
+	pub fn get_string(&self, lext: usize, rext: usize) -> String {
+		let lext = self.tokens[lext].lext;
+		let rext = self.tokens[rext].rext;
+		self.i[lext..rext].iter().collect::<String>()
+	}
+	
+	}
+/*** End of Lexer implementation ***/
+
+impl Edit {
+	/**
+	unchanged returns the token extents before the edit of the tokens from
+	lext to rext after the edit. It returns None if the tokens from lext up to
+	and including rext overlap the re-lexed tokens.
+
+	A subtree of the parse forest with unchanged extents is not changed by the edit.
+	*/
+	#[allow(dead_code)]
+	pub fn unchanged(&self, lext: usize, rext: usize) -> Option<(usize, usize)> {
+		if rext < self.first {
+			Some((lext, rext))
+		} else if lext >= self.first + self.num_new {
+			Some((lext - self.num_new + self.num_old, rext - self.num_new + self.num_old))
+		} else {
+			None
+		}
+	}
+}
+
+impl fmt::Display for LexError {
+	fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+		write!(f, "Lexical error at line {} col {}: ", self.line, self.column)?;
+		match self.ch {
+			None => write!(f, "unexpected end of input")?,
+			Some(c) => write!(f, "unexpected {:?}", c)?,
+		}
+		if self.partial.len() > 0 {
+			let ids: Vec<&str> = self.partial.iter().map(|t| t.id()).collect();
+			write!(f, " while scanning one of [{}]", ids.join(","))?
+		}
+		Ok(())
+	}
+}
+
+
+fn load_file(fname: &String) -> io::Result<Vec<char>> {
+	let input = fs::read_to_string(fname)?;
+	let mut input: Vec<char> = input.chars().collect();
+	if fname.ends_with(".md") {
+        load_md(&mut input)?;
+        Ok(input)
+	} else {
+		Ok(input)
+	}
+}
+
+fn load_md(input: &mut Vec<char>) -> io::Result<()> {
+    let mut i = 0;
+    let mut text = true;
+    while i < input.len() {
+        if i <= input.len() - 3 && 
+        || -> bool { input[i] == '`' && input[i+1] == '`' && input[i+2] == '`' }()
+        {
+            text = !text;
+            for j in i..i+3 {
+                input[j] = ' ';
+            }
+            i += 3;
+        }
+        if i < input.len() {
+            if text {
+                match input[i] {
+                    '\n' => input[i] = '\n',
+                    _ => input[i] = ' ',
+                }
+            }
+            i += 1;
+        }
+    }
+    Ok(())
+}
+
+#[allow(dead_code)]
+fn any(r: char, set: &'static [char]) -> bool {
+	for r1 in set.iter() {
+		if &r == r1 {
+			return true
+		}
+	}
+	return false
+}
+
+#[allow(dead_code)]
+fn not(r: char, set: &'static [char]) -> bool {
+	for r1 in set.iter() {
+		if &r == r1 {
+			return false
+		}
+	}
+	return true
+}
+
+static ACCEPT: [token::Type; 7] = [ 
+    token::Type::Error, 
+    token::Type::Error, 
+    token::Type::T_2, 
+    token::Type::Error, 
+    token::Type::T_1, 
+    token::Type::Error, 
+    token::Type::T_0, 
+];
+
+pub type NextFun = dyn Fn(char) -> State + Sync;
+
+static PARTIAL: [&[token::Type]; 7] = [ 
+    &[ ], 
+    &[ token::Type::T_0, token::Type::T_1, ], 
+    &[ token::Type::T_2, ], 
+    &[ token::Type::T_0, ], 
+    &[ token::Type::T_1, ], 
+    &[ token::Type::T_0, ], 
+    &[ ], 
+];
+
+static NEXT_STATE: &'static [&NextFun; 7] = &[  
+	// Set0 
+	&|c| -> State {  
+        if c == '/' { return 1 }; 
+        if c.is_alphabetic() { return 2 }; 
+        NULL_STATE
+	}, 
+	// Set1 
+	&|c| -> State {  
+        if c == '*' { return 3 }; 
+        if c == '/' { return 4 }; 
+        NULL_STATE
+	}, 
+	// Set2 
+	&|c| -> State {  
+        if c.is_alphabetic() { return 2 }; 
+        if c.is_numeric() { return 2 }; 
+        NULL_STATE
+	}, 
+	// Set3 
+	&|c| -> State {  
+        if c == '*' { return 5 }; 
+        if not(c, &['*']) { return 3 }; 
+        NULL_STATE
+	}, 
+	// Set4 
+	&|c| -> State {  
+        if not(c, &['\n']) { return 4 }; 
+        NULL_STATE
+	}, 
+	// Set5 
+	&|c| -> State {  
+        if c == '/' { return 6 }; 
+        if not(c, &['/']) { return 3 }; 
+        NULL_STATE
+	}, 
+	// Set6 
+	&|_| -> State {  
+        NULL_STATE
+	}, 
+];
@@ -0,0 +1,156 @@
+mod lexer;
+mod token;
+
+use lexer::Lexer;
+
+fn main() {
+    let input_file = &std::env::args().collect::<Vec<String>>()[1];
+    let lex = Lexer::new_file_with_trivia(&input_file).unwrap();
+    for err in lex.errors.iter() {
+        println!("{}", err);
+    }
+    println!("{} tokens", lex.tokens.len());
+}
+
+#[cfg(test)]
+mod tests {
+    use crate::lexer::{self, Lexer, Recovery};
+    use crate::token::Type;
+
+    use std::rc::Rc;
+
+    const SRC: &str = "// leading comment
+name1 /* block */ name2 // trailing comment
+  /* comment before name3 */
+name3
+// comment at end
+";
+
+    fn chars(s: &str) -> Rc<Vec<char>> {
+        Rc::new(s.chars().collect())
+    }
+
+    fn string(cs: Vec<char>) -> String {
+        cs.iter().collect()
+    }
+
+    #[test]
+    fn trivia() {
+        let l = Lexer::new_with_trivia(chars(SRC));
+        assert_eq!(l.tokens.len(), 4);
+
+        let full: String = l.tokens.iter().map(|tok| string(tok.full_literal())).collect();
+        assert_eq!(full, SRC);
+
+        let (name1, name2, name3, eof) = (&l.tokens[0], &l.tokens[1], &l.tokens[2], &l.tokens[3]);
+        assert_eq!(string(name1.leading_trivia()), "// leading comment\n");
+        assert_eq!(string(name1.trailing_trivia()), " /* block */ ");
+        assert_eq!(string(name2.leading_trivia()), "");
+        assert_eq!(string(name2.trailing_trivia()), " // trailing comment\n");
+        let suppressed = name2.trailing_suppressed();
+        assert_eq!(suppressed.len(), 1);
+        assert_eq!(suppressed[0].id(), "line_comment");
+        let suppressed = name3.leading_suppressed();
+        assert_eq!(suppressed.len(), 1);
+        assert_eq!(suppressed[0].literal_string(), "/* comment before name3 */");
+        assert_eq!(string(eof.leading_trivia()), "// comment at end\n");
+    }
+
+    #[test]
+    fn no_trivia() {
+        let l = Lexer::new(chars(SRC));
+        assert_eq!(l.tokens.len(), 4);
+        for tok in l.tokens.iter() {
+            assert!(tok.leading_trivia().is_empty(), "unexpected trivia on {}", tok);
+            assert!(tok.trailing_trivia().is_empty(), "unexpected trivia on {}", tok);
+        }
+    }
+
+    #[test]
+    fn lex_error() {
+        let l = Lexer::new(chars("name1 /x name2"));
+        assert_eq!(l.errors.len(), 1);
+        let err = &l.errors[0];
+        assert_eq!((err.ch, err.pos, err.line, err.column), (Some('x'), 7, 1, 8));
+        assert_eq!(err.partial.len(), 2);
+        assert!(Rc::ptr_eq(&l.tokens[1], &err.token));
+        assert_eq!(err.token.literal_string(), "/x");
+        assert_eq!(
+            err.to_string(),
+            "Lexical error at line 1 col 8: unexpected 'x' while scanning one of [block_comment,line_comment]"
+        );
+
+        let l = Lexer::new(chars("name1 /*"));
+        assert_eq!(l.errors.len(), 1);
+        assert_eq!(l.errors[0].ch, None);
+    }
+
+    #[test]
+    fn lex_error_recovery() {
+        const SRC: &str = "name1 #$% name2";
+        let l = Lexer::new(chars(SRC));
+        assert_eq!((l.errors.len(), l.tokens.len()), (3, 6));
+
+        lexer::set_error_recovery(Recovery::SkipToSpace);
+        let l = Lexer::new(chars(SRC));
+        lexer::set_error_recovery(Recovery::SkipChar);
+        assert_eq!((l.errors.len(), l.tokens.len()), (1, 4));
+        assert!(l.tokens[1].typ == Type::Error);
+        assert_eq!(l.tokens[1].literal_string(), "#$%");
+    }
+
+    #[test]
+    fn edit() {
+        let edits: [(usize, usize, &str); 9] = [
+            (0, 0, "name0 "),
+            (6, 0, "x"),
+            (7, 3, ""),
+            (9, 2, "/* "),
+            (18, 0, "*/"),
+            (25, 4, "#"),
+            (1, 0, "\n// c\n"),
+            (37, 8, ""),
+            (0, 5, "b"),
+        ];
+        for &new_lexer in [Lexer::new, Lexer::new_with_trivia].iter() {
+            let mut input: Vec<char> = SRC.chars().collect();
+            let mut l = new_lexer(Rc::new(input.clone()));
+            for (i, &(offset, deleted, inserted)) in edits.iter().enumerate() {
+                assert!(offset + deleted <= input.len(), "edit {} is outside the input", i);
+                let inserted: Vec<char> = inserted.chars().collect();
+                l = l.edit(offset, deleted, &inserted).0;
+                input.splice(offset..offset + deleted, inserted);
+                check_edit(i, &l, &new_lexer(Rc::new(input.clone())));
+            }
+        }
+    }
+
+    fn check_edit(i: usize, edited: &Lexer, expected: &Lexer) {
+        assert_eq!(edited.tokens.len(), expected.tokens.len(), "edit {}", i);
+        for (tok, exp) in edited.tokens.iter().zip(expected.tokens.iter()) {
+            assert!(
+                tok.typ == exp.typ && tok.lext == exp.lext && tok.rext == exp.rext
+                    && tok.full_literal() == exp.full_literal()
+                    && tok.leading_suppressed().len() == exp.leading_suppressed().len()
+                    && tok.trailing_suppressed().len() == exp.trailing_suppressed().len(),
+                "edit {}: token {}, expected {}", i, tok, exp
+            );
+        }
+        assert_eq!(edited.errors.len(), expected.errors.len(), "edit {}", i);
+        for (err, exp) in edited.errors.iter().zip(expected.errors.iter()) {
+            assert_eq!(err.to_string(), exp.to_string(), "edit {}", i);
+            assert_eq!(err.token.lext, exp.token.lext, "edit {}", i);
+        }
+    }
+
+    #[test]
+    fn edit_window() {
+        let l = Lexer::new(chars("a b c d e"));
+        let (l, e) = l.edit(4, 1, &['x', 'y', ' ', 'z']);
+        assert_eq!(l.get_string(0, l.tokens.len() - 2), "a b xy z d e");
+        assert_eq!((e.first, e.num_old, e.num_new), (2, 2, 3));
+        assert_eq!(e.unchanged(0, 1), Some((0, 1)));
+        assert_eq!(e.unchanged(5, 6), Some((4, 5)));
+        assert_eq!(e.unchanged(1, 3), None);
+    }
+}
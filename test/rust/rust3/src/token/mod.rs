@@ -0,0 +1,245 @@
+
+//! Module token is generated by GoGLL. Do not edit
+
+extern crate lazy_static;
+
+use std::cell::{Cell, RefCell};
+use std::rc::Rc;
+use std::fmt;
+use lazy_static::lazy_static;
+use std::collections::HashMap;
+
+/// Token is returned by the lexer for every scanned lexical token
+pub struct Token {
+	pub typ: Type,
+	pub lext: usize, 
+	pub rext: usize,
+	
+	input: Rc<Vec<char>>,
+
+	// Trivia attached to the token by lexer::Lexer::new_with_trivia
+	lead_lext: Cell<usize>,
+	trail_rext: Cell<usize>,
+	leading: RefCell<Vec<Rc<Token>>>,
+	trailing: RefCell<Vec<Rc<Token>>>,
+}
+
+#[derive(PartialEq, Eq, Hash, Clone, Copy)]
+pub enum Type {	
+	Error, // "Error"
+	EOF, // "$"
+	T_0, // "block_comment"
+	T_1, // "line_comment"
+	T_2, // "name"
+}
+
+/**
+New returns a new token.  
+lext is the left extent and rext the right extent of the token in the input.  
+input is the input slice scanned by the lexer.
+*/
+pub fn new<'a>(t: Type, lext: usize, rext: usize, input: &Rc<Vec<char>>) -> Rc<Token> {
+	Rc::new(Token{
+		typ:   t,
+		lext:  lext,
+		rext:  rext,
+		input: input.clone(),
+		lead_lext: Cell::new(lext),
+		trail_rext: Cell::new(rext),
+		leading: RefCell::new(Vec::new()),
+		trailing: RefCell::new(Vec::new()),
+	})
+}
+
+impl Token {
+	/// full_literal returns the leading trivia, literal and trailing trivia of the token
+	#[allow(dead_code)]
+	pub fn full_literal(&self) -> Vec<char> {
+		self.input[self.lead_lext.get()..self.trail_rext.get()].to_vec()
+	}
+
+	/// get_line_column returns the (line, column) of the left extent of the token
+	pub fn get_line_column(&self) -> (usize, usize) {
+		let mut line = 1;
+		let mut col = 1;
+		let mut j = 0;
+		while j < self.lext {
+			match self.input[j] {
+			'\n' => {
+				line += 1;
+				col = 1
+			},
+			'\t' => col += 4,
+			_ => col += 1
+			}
+			j += 1
+		}
+		(line, col)
+	}
+
+	/// leading_suppressed returns the suppressed tokens in the leading trivia of the token
+	#[allow(dead_code)]
+	pub fn leading_suppressed(&self) -> Vec<Rc<Token>> {
+		self.leading.borrow().clone()
+	}
+
+	/// leading_trivia returns the whitespace and suppressed tokens preceding the
+	/// token, which are attached to it by lexer::Lexer::new_with_trivia
+	#[allow(dead_code)]
+	pub fn leading_trivia(&self) -> Vec<char> {
+		self.input[self.lead_lext.get()..self.lext].to_vec()
+	}
+
+	/// returns the id of the token
+	#[allow(dead_code)]
+	pub fn id(&self) -> &'static str {
+		TYPE_TO_ID[&self.typ]
+	}
+	
+	/// literal returns the literal runes of t scanned by the lexer
+	pub fn literal(&self) -> Vec<char> {
+		self.input[self.lext..self.rext].to_vec()
+	}
+	
+    /// literal_string returns the literal string of t scanned by the lexer
+    #[allow(dead_code)]
+	pub fn literal_string(&self) -> String {
+		self.literal().iter().collect::<String>()
+	}
+	
+	/**
+	set_leading_trivia sets the leading trivia of the token to the input from
+	lext to the left extent of the token. suppressed contains the suppressed
+	tokens in the trivia.
+	*/
+	pub fn set_leading_trivia(&self, lext: usize, suppressed: Vec<Rc<Token>>) {
+		self.lead_lext.set(lext);
+		*self.leading.borrow_mut() = suppressed
+	}
+
+	/**
+	set_trailing_trivia sets the trailing trivia of the token to the input from
+	the right extent of the token to rext. suppressed contains the suppressed
+	tokens in the trivia.
+	*/
+	pub fn set_trailing_trivia(&self, rext: usize, suppressed: Vec<Rc<Token>>) {
+		self.trail_rext.set(rext);
+		*self.trailing.borrow_mut() = suppressed
+	}
+
+	/**
+	shift returns a copy of the token, including its trivia, moved by delta
+	chars in the new input. It is used by the lexer to move tokens after an
+	edit of the input.
+	*/
+	pub fn shift(&self, delta: isize, input: &Rc<Vec<char>>) -> Rc<Token> {
+		let mv = |i: usize| (i as isize + delta) as usize;
+		Rc::new(Token{
+			typ: self.typ,
+			lext: mv(self.lext),
+			rext: mv(self.rext),
+			input: input.clone(),
+			lead_lext: Cell::new(mv(self.lead_lext.get())),
+			trail_rext: Cell::new(mv(self.trail_rext.get())),
+			leading: RefCell::new(self.leading.borrow().iter()
+				.map(|s| s.shift(delta, input)).collect()),
+			trailing: RefCell::new(self.trailing.borrow().iter()
+				.map(|s| s.shift(delta, input)).collect()),
+		})
+	}
+
+	/// returns true iff this token is suppressed by the lexer
+	#[allow(dead_code)]
+	pub fn suppress(&self) -> bool {
+		SUPPRESS[&self.typ]
+	}
+
+	/// trailing_suppressed returns the suppressed tokens in the trailing trivia
+	/// of the token
+	#[allow(dead_code)]
+	pub fn trailing_suppressed(&self) -> Vec<Rc<Token>> {
+		self.trailing.borrow().clone()
+	}
+
+	/// trailing_trivia returns the whitespace and suppressed tokens following the
+	/// token, up to and including the end of the line, which are attached to it
+	/// by lexer::Lexer::new_with_trivia
+	#[allow(dead_code)]
+	pub fn trailing_trivia(&self) -> Vec<char> {
+		self.input[self.rext..self.trail_rext.get()].to_vec()
+	}
+
+} // impl Token
+
+impl <'a>fmt::Display for Token {
+	fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+		let (ln, col) = self.get_line_column();
+		write!(f, "({}, ({},{}) {})", 
+			self.typ, ln, col, self.literal().iter().collect::<String>())
+	}
+
+}
+
+impl <'a>Type {
+	/// id returns the token type ID of token Type t
+	#[allow(dead_code)]
+	pub fn id(&self) -> &'a str {
+		TYPE_TO_ID[self]
+	}
+	
+}
+
+impl <'a>fmt::Display for Type {
+	fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+		write!(f, "{}", TYPE_TO_ID[self])
+	}
+
+}
+
+lazy_static! {
+    static ref TYPE_TO_ID: HashMap<Type, &'static str> = {
+        let mut m = HashMap::new(); 
+		m.insert(Type::Error, "Error");
+		m.insert(Type::EOF, "$");
+		m.insert(Type::T_0, "block_comment");
+		m.insert(Type::T_1, "line_comment");
+		m.insert(Type::T_2, "name");
+        m
+    };
+}
+
+lazy_static! {
+	static ref ID_TO_TYPE: HashMap<&'static str, Type> = {
+		let mut m = HashMap::new(); 
+		m.insert("Error", Type::Error); 
+		m.insert("$", Type::EOF); 
+		m.insert("block_comment", Type::T_0); 
+		m.insert("line_comment", Type::T_1); 
+		m.insert("name", Type::T_2); 
+		m
+	};
+}
+
+lazy_static! {
+	static ref STRING_TO_TYPE: HashMap<&'static str, Type> = {
+		let mut m = HashMap::new(); 
+		m.insert("Error", Type::Error); 
+		m.insert("EOF", Type::EOF); 
+		m.insert("T_0", Type::T_0); 
+		m.insert("T_1", Type::T_1); 
+		m.insert("T_2", Type::T_2); 
+		m
+	};
+}
+
+lazy_static! {
+    static ref SUPPRESS: HashMap<Type, bool> = {
+        let mut m = HashMap::new(); 
+		m.insert(Type::Error, false);
+		m.insert(Type::EOF, false);
+		m.insert(Type::T_0, true);
+		m.insert(Type::T_1, true);
+		m.insert(Type::T_2, false);
+        m
+    };
+}
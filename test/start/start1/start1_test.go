@@ -0,0 +1,44 @@
+package start1
+
+import (
+	"testing"
+
+	"github.com/goccmack/gogll/v3/test/start/start1/lexer"
+	"github.com/goccmack/gogll/v3/test/start/start1/parser"
+	"github.com/goccmack/gogll/v3/test/start/start1/parser/symbols"
+)
+
+func TestParse(t *testing.T) {
+	bs, errs := parser.Parse(lexer.New([]rune("a = b + 1 ; c = ( a ) ;")))
+	if errs != nil {
+		t.Fatalf("parse errors: %v", errs)
+	}
+	if root := bs.GetRoot(); root.Label.Head() != symbols.NT_Stmts {
+		t.Errorf("unexpected root %s", root)
+	}
+}
+
+func TestParseFrom(t *testing.T) {
+	bs, errs := parser.ParseFrom(symbols.NT_Expr, lexer.New([]rune("b + ( c + 1 )")))
+	if errs != nil {
+		t.Fatalf("parse errors: %v", errs)
+	}
+	root := bs.GetRoot()
+	if root.Label.Head() != symbols.NT_Expr || root.Alternate() != 1 {
+		t.Errorf("unexpected root %s", root)
+	}
+
+	if _, errs := parser.ParseFrom(symbols.NT_Expr, lexer.New([]rune("a = 1 ;"))); errs == nil {
+		t.Error("expected errors for a statement parsed as Expr")
+	}
+	if _, errs := parser.ParseFrom(symbols.NT_Stmts, lexer.New([]rune("b + 1"))); errs == nil {
+		t.Error("expected errors for an expression parsed as Stmts")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected ParseFrom to panic for Term, which is not a start symbol")
+		}
+	}()
+	parser.ParseFrom(symbols.NT_Term, lexer.New([]rune("b")))
+}
@@ -0,0 +1,59 @@
+// Package ast builds the string representation of the parsed statements and
+// expressions.
+package ast
+
+import (
+	"fmt"
+
+	"github.com/goccmack/gogll/v3/test/start/start2/token"
+)
+
+// G0 : Stmts ;
+func G00(p0 interface{}) (interface{}, error) {
+	return p0, nil
+}
+
+// G1 : Expr ;
+func G10(p0 interface{}) (interface{}, error) {
+	return p0, nil
+}
+
+// Stmts : Stmt ;
+func Stmts0(p0 interface{}) (interface{}, error) {
+	return []string{p0.(string)}, nil
+}
+
+// Stmts : Stmt Stmts ;
+func Stmts1(p0, p1 interface{}) (interface{}, error) {
+	return append([]string{p0.(string)}, p1.([]string)...), nil
+}
+
+// Stmt : id = Expr ; ;
+func Stmt0(p0, p1, p2, p3 interface{}) (interface{}, error) {
+	return fmt.Sprintf("%s=%s", p0.(*token.Token).LiteralString(), p2), nil
+}
+
+// Expr : Term ;
+func Expr0(p0 interface{}) (interface{}, error) {
+	return p0, nil
+}
+
+// Expr : Term + Expr ;
+func Expr1(p0, p1, p2 interface{}) (interface{}, error) {
+	return fmt.Sprintf("(%s+%s)", p0, p2), nil
+}
+
+// Term : id ;
+func Term0(p0 interface{}) (interface{}, error) {
+	return p0.(*token.Token).LiteralString(), nil
+}
+
+// Term : num ;
+func Term1(p0 interface{}) (interface{}, error) {
+	return p0.(*token.Token).LiteralString(), nil
+}
+
+// Term : ( Expr ) ;
+func Term2(p0, p1, p2 interface{}) (interface{}, error) {
+	return p1, nil
+}
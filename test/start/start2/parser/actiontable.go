@@ -0,0 +1,166 @@
+
+package parser
+
+import "github.com/goccmack/gogll/v3/test/start/start2/token"
+
+type(
+    actionTable [numStates]actionRow
+    actionRow struct {
+        canRecover bool
+        actions map[token.Type]action
+    }
+)
+
+var actionTab = actionTable{ 
+	actionRow{ // S0
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_5:shift(4),		/* id */
+        },
+
+	},
+	actionRow{ // S1
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_0:shift(7),		/* ( */
+			token.T_5:shift(8),		/* id */
+			token.T_6:shift(9),		/* num */
+        },
+
+	},
+	actionRow{ // S2
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:reduce(2),		/* $, reduce: Stmts */
+			token.T_5:shift(4),		/* id */
+        },
+
+	},
+	actionRow{ // S3
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:accept(true),		/* $ */
+        },
+
+	},
+	actionRow{ // S4
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_4:shift(11),		/* = */
+        },
+
+	},
+	actionRow{ // S5
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:accept(true),		/* $ */
+        },
+
+	},
+	actionRow{ // S6
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:reduce(5),		/* $, reduce: Expr */
+			token.T_1:reduce(5),		/* ), reduce: Expr */
+			token.T_2:shift(12),		/* + */
+			token.T_3:reduce(5),		/* ;, reduce: Expr */
+        },
+
+	},
+	actionRow{ // S7
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_0:shift(7),		/* ( */
+			token.T_5:shift(8),		/* id */
+			token.T_6:shift(9),		/* num */
+        },
+
+	},
+	actionRow{ // S8
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:reduce(7),		/* $, reduce: Term */
+			token.T_1:reduce(7),		/* ), reduce: Term */
+			token.T_2:reduce(7),		/* +, reduce: Term */
+			token.T_3:reduce(7),		/* ;, reduce: Term */
+        },
+
+	},
+	actionRow{ // S9
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:reduce(8),		/* $, reduce: Term */
+			token.T_1:reduce(8),		/* ), reduce: Term */
+			token.T_2:reduce(8),		/* +, reduce: Term */
+			token.T_3:reduce(8),		/* ;, reduce: Term */
+        },
+
+	},
+	actionRow{ // S10
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:reduce(3),		/* $, reduce: Stmts */
+        },
+
+	},
+	actionRow{ // S11
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_0:shift(7),		/* ( */
+			token.T_5:shift(8),		/* id */
+			token.T_6:shift(9),		/* num */
+        },
+
+	},
+	actionRow{ // S12
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_0:shift(7),		/* ( */
+			token.T_5:shift(8),		/* id */
+			token.T_6:shift(9),		/* num */
+        },
+
+	},
+	actionRow{ // S13
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_1:shift(16),		/* ) */
+        },
+
+	},
+	actionRow{ // S14
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.T_3:shift(17),		/* ; */
+        },
+
+	},
+	actionRow{ // S15
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:reduce(6),		/* $, reduce: Expr */
+			token.T_1:reduce(6),		/* ), reduce: Expr */
+			token.T_3:reduce(6),		/* ;, reduce: Expr */
+        },
+
+	},
+	actionRow{ // S16
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:reduce(9),		/* $, reduce: Term */
+			token.T_1:reduce(9),		/* ), reduce: Term */
+			token.T_2:reduce(9),		/* +, reduce: Term */
+			token.T_3:reduce(9),		/* ;, reduce: Term */
+        },
+
+	},
+	actionRow{ // S17
+        canRecover: false,
+		actions: map[token.Type]action{ 
+			token.EOF:reduce(4),		/* $, reduce: Stmt */
+			token.T_5:reduce(4),		/* id, reduce: Stmt */
+        },
+
+	},
+}
+
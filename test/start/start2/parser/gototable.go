@@ -0,0 +1,140 @@
+
+/*
+*/
+package parser
+
+const numNTSymbols = 4
+type(
+	gotoTable [numStates]gotoRow
+	gotoRow	[numNTSymbols] int
+)
+
+var gotoTab = gotoTable{
+	gotoRow{ // S0
+		-1, // Expr
+        2, // Stmt
+        3, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S1
+		5, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        6, // Term
+        
+	},
+	gotoRow{ // S2
+		-1, // Expr
+        2, // Stmt
+        10, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S3
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S4
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S5
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S6
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S7
+		13, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        6, // Term
+        
+	},
+	gotoRow{ // S8
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S9
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S10
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S11
+		14, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        6, // Term
+        
+	},
+	gotoRow{ // S12
+		15, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        6, // Term
+        
+	},
+	gotoRow{ // S13
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S14
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S15
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S16
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	gotoRow{ // S17
+		-1, // Expr
+        -1, // Stmt
+        -1, // Stmts
+        -1, // Term
+        
+	},
+	
+}
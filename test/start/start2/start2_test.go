@@ -0,0 +1,43 @@
+package start2
+
+import (
+	"testing"
+
+	"github.com/goccmack/gogll/v3/test/start/start2/lexer"
+	"github.com/goccmack/gogll/v3/test/start/start2/parser"
+)
+
+func TestParse(t *testing.T) {
+	res, err := parser.New(lexer.New([]rune("a = b + 1 ; c = ( a + b ) + 2 ;"))).Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmts := res.([]string)
+	if len(stmts) != 2 || stmts[0] != "a=(b+1)" || stmts[1] != "c=((a+b)+2)" {
+		t.Errorf("unexpected result %v", stmts)
+	}
+}
+
+func TestParseFrom(t *testing.T) {
+	res, err := parser.NewFrom("Expr", lexer.New([]rune("b + ( c + 1 )"))).Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != "(b+(c+1))" {
+		t.Errorf("unexpected result %v", res)
+	}
+
+	if _, err := parser.NewFrom("Expr", lexer.New([]rune("a = 1 ;"))).Parse(); err == nil {
+		t.Error("expected an error for a statement parsed as Expr")
+	}
+	if _, err := parser.NewFrom("Stmts", lexer.New([]rune("b + 1"))).Parse(); err == nil {
+		t.Error("expected an error for an expression parsed as Stmts")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected NewFrom to panic for Term, which is not a start symbol")
+		}
+	}()
+	parser.NewFrom("Term", lexer.New([]rune("b")))
+}
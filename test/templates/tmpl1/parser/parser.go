@@ -0,0 +1,823 @@
+// Package parser is generated by gogll. Do not edit.
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/goccmack/gogll/v3/test/templates/tmpl1/lexer"
+	"github.com/goccmack/gogll/v3/test/templates/tmpl1/parser/bsr"
+	"github.com/goccmack/gogll/v3/test/templates/tmpl1/parser/slot"
+	"github.com/goccmack/gogll/v3/test/templates/tmpl1/parser/symbols"
+	"github.com/goccmack/gogll/v3/test/templates/tmpl1/token"
+)
+
+type parser struct {
+	cI int
+
+	R *descriptors
+	U *descriptors
+
+	popped   map[poppedNode]bool
+	crf      map[clusterNode][]*crfNode
+	crfNodes map[crfNode]*crfNode
+
+	lex         *lexer.Lexer
+	parseErrors []*Error
+
+	start  symbols.NT
+	bsrSet *bsr.Set
+}
+
+// StartSymbols contains the start symbols of the grammar, which can be parsed
+// by ParseFrom. The first is the default start symbol, which is parsed by Parse.
+var StartSymbols = []symbols.NT{
+	symbols.NT_Stmts,
+}
+
+func newParser(start symbols.NT, l *lexer.Lexer) *parser {
+	return &parser{
+		cI:     0,
+		lex:    l,
+		R:      &descriptors{},
+		U:      &descriptors{},
+		popped: make(map[poppedNode]bool),
+		crf: map[clusterNode][]*crfNode{
+			{start, 0}: {},
+		},
+		crfNodes:    map[crfNode]*crfNode{},
+		start:       start,
+		bsrSet:      bsr.New(start, l),
+		parseErrors: nil,
+	}
+}
+
+// Parse returns the BSR set containing the parse forest of the default start
+// symbol.
+// If the parse was successfull []*Error is nil.
+// If the lexer found lexical errors they are returned first in []*Error.
+func Parse(l *lexer.Lexer) (*bsr.Set, []*Error) {
+	return ParseFrom(symbols.NT_Stmts, l)
+}
+
+// ParseFrom is like Parse but parses start symbol nt, which must be one of
+// StartSymbols.
+func ParseFrom(nt symbols.NT, l *lexer.Lexer) (*bsr.Set, []*Error) {
+	for _, start := range StartSymbols {
+		if start == nt {
+			return newParser(nt, l).parse()
+		}
+	}
+	panic(fmt.Sprintf("%s is not a start symbol", nt))
+}
+
+func (p *parser) parse() (*bsr.Set, []*Error) {
+	var L slot.Label
+	m, cU := len(p.lex.Tokens)-1, 0
+	p.ntAdd(p.start, 0)
+	// p.DumpDescriptors()
+	for !p.R.empty() {
+		L, cU, p.cI = p.R.remove()
+
+		// fmt.Println()
+		// fmt.Printf("L:%s, cI:%d, I[p.cI]:%s, cU:%d\n", L, p.cI, p.lex.Tokens[p.cI], cU)
+		// p.DumpDescriptors()
+
+		switch L {
+		case slot.Expr0R0: // Expr : ∙id
+
+			p.bsrSet.Add(slot.Expr0R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_Expr) {
+				p.rtn(symbols.NT_Expr, cU, p.cI)
+			} else {
+				p.parseError(slot.Expr0R0, p.cI, followSets[symbols.NT_Expr])
+			}
+		case slot.Expr1R0: // Expr : ∙num
+
+			p.bsrSet.Add(slot.Expr1R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_Expr) {
+				p.rtn(symbols.NT_Expr, cU, p.cI)
+			} else {
+				p.parseError(slot.Expr1R0, p.cI, followSets[symbols.NT_Expr])
+			}
+		case slot.Expr2R0: // Expr : ∙Parens_Expr
+
+			p.call(slot.Expr2R1, cU, p.cI)
+		case slot.Expr2R1: // Expr : Parens_Expr ∙
+
+			if p.follow(symbols.NT_Expr) {
+				p.rtn(symbols.NT_Expr, cU, p.cI)
+			} else {
+				p.parseError(slot.Expr2R0, p.cI, followSets[symbols.NT_Expr])
+			}
+		case slot.List_Expr_x2c0R0: // List_Expr_x2c : ∙Expr
+
+			p.call(slot.List_Expr_x2c0R1, cU, p.cI)
+		case slot.List_Expr_x2c0R1: // List_Expr_x2c : Expr ∙
+
+			if p.follow(symbols.NT_List_Expr_x2c) {
+				p.rtn(symbols.NT_List_Expr_x2c, cU, p.cI)
+			} else {
+				p.parseError(slot.List_Expr_x2c0R0, p.cI, followSets[symbols.NT_List_Expr_x2c])
+			}
+		case slot.List_Expr_x2c1R0: // List_Expr_x2c : ∙Expr , List_Expr_x2c
+
+			p.call(slot.List_Expr_x2c1R1, cU, p.cI)
+		case slot.List_Expr_x2c1R1: // List_Expr_x2c : Expr ∙, List_Expr_x2c
+
+			if !p.testSelect(slot.List_Expr_x2c1R1) {
+				p.parseError(slot.List_Expr_x2c1R1, p.cI, first[slot.List_Expr_x2c1R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.List_Expr_x2c1R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.List_Expr_x2c1R2) {
+				p.parseError(slot.List_Expr_x2c1R2, p.cI, first[slot.List_Expr_x2c1R2])
+				break
+			}
+
+			p.call(slot.List_Expr_x2c1R3, cU, p.cI)
+		case slot.List_Expr_x2c1R3: // List_Expr_x2c : Expr , List_Expr_x2c ∙
+
+			if p.follow(symbols.NT_List_Expr_x2c) {
+				p.rtn(symbols.NT_List_Expr_x2c, cU, p.cI)
+			} else {
+				p.parseError(slot.List_Expr_x2c1R0, p.cI, followSets[symbols.NT_List_Expr_x2c])
+			}
+		case slot.List_Stmt_x3b0R0: // List_Stmt_x3b : ∙Stmt
+
+			p.call(slot.List_Stmt_x3b0R1, cU, p.cI)
+		case slot.List_Stmt_x3b0R1: // List_Stmt_x3b : Stmt ∙
+
+			if p.follow(symbols.NT_List_Stmt_x3b) {
+				p.rtn(symbols.NT_List_Stmt_x3b, cU, p.cI)
+			} else {
+				p.parseError(slot.List_Stmt_x3b0R0, p.cI, followSets[symbols.NT_List_Stmt_x3b])
+			}
+		case slot.List_Stmt_x3b1R0: // List_Stmt_x3b : ∙Stmt ; List_Stmt_x3b
+
+			p.call(slot.List_Stmt_x3b1R1, cU, p.cI)
+		case slot.List_Stmt_x3b1R1: // List_Stmt_x3b : Stmt ∙; List_Stmt_x3b
+
+			if !p.testSelect(slot.List_Stmt_x3b1R1) {
+				p.parseError(slot.List_Stmt_x3b1R1, p.cI, first[slot.List_Stmt_x3b1R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.List_Stmt_x3b1R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.List_Stmt_x3b1R2) {
+				p.parseError(slot.List_Stmt_x3b1R2, p.cI, first[slot.List_Stmt_x3b1R2])
+				break
+			}
+
+			p.call(slot.List_Stmt_x3b1R3, cU, p.cI)
+		case slot.List_Stmt_x3b1R3: // List_Stmt_x3b : Stmt ; List_Stmt_x3b ∙
+
+			if p.follow(symbols.NT_List_Stmt_x3b) {
+				p.rtn(symbols.NT_List_Stmt_x3b, cU, p.cI)
+			} else {
+				p.parseError(slot.List_Stmt_x3b1R0, p.cI, followSets[symbols.NT_List_Stmt_x3b])
+			}
+		case slot.Parens_Expr0R0: // Parens_Expr : ∙( Expr )
+
+			p.bsrSet.Add(slot.Parens_Expr0R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Parens_Expr0R1) {
+				p.parseError(slot.Parens_Expr0R1, p.cI, first[slot.Parens_Expr0R1])
+				break
+			}
+
+			p.call(slot.Parens_Expr0R2, cU, p.cI)
+		case slot.Parens_Expr0R2: // Parens_Expr : ( Expr ∙)
+
+			if !p.testSelect(slot.Parens_Expr0R2) {
+				p.parseError(slot.Parens_Expr0R2, p.cI, first[slot.Parens_Expr0R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.Parens_Expr0R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_Parens_Expr) {
+				p.rtn(symbols.NT_Parens_Expr, cU, p.cI)
+			} else {
+				p.parseError(slot.Parens_Expr0R0, p.cI, followSets[symbols.NT_Parens_Expr])
+			}
+		case slot.Parens_List_Expr_x2c0R0: // Parens_List_Expr_x2c : ∙( List_Expr_x2c )
+
+			p.bsrSet.Add(slot.Parens_List_Expr_x2c0R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Parens_List_Expr_x2c0R1) {
+				p.parseError(slot.Parens_List_Expr_x2c0R1, p.cI, first[slot.Parens_List_Expr_x2c0R1])
+				break
+			}
+
+			p.call(slot.Parens_List_Expr_x2c0R2, cU, p.cI)
+		case slot.Parens_List_Expr_x2c0R2: // Parens_List_Expr_x2c : ( List_Expr_x2c ∙)
+
+			if !p.testSelect(slot.Parens_List_Expr_x2c0R2) {
+				p.parseError(slot.Parens_List_Expr_x2c0R2, p.cI, first[slot.Parens_List_Expr_x2c0R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.Parens_List_Expr_x2c0R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_Parens_List_Expr_x2c) {
+				p.rtn(symbols.NT_Parens_List_Expr_x2c, cU, p.cI)
+			} else {
+				p.parseError(slot.Parens_List_Expr_x2c0R0, p.cI, followSets[symbols.NT_Parens_List_Expr_x2c])
+			}
+		case slot.Stmt0R0: // Stmt : ∙id = Expr
+
+			p.bsrSet.Add(slot.Stmt0R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Stmt0R1) {
+				p.parseError(slot.Stmt0R1, p.cI, first[slot.Stmt0R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.Stmt0R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Stmt0R2) {
+				p.parseError(slot.Stmt0R2, p.cI, first[slot.Stmt0R2])
+				break
+			}
+
+			p.call(slot.Stmt0R3, cU, p.cI)
+		case slot.Stmt0R3: // Stmt : id = Expr ∙
+
+			if p.follow(symbols.NT_Stmt) {
+				p.rtn(symbols.NT_Stmt, cU, p.cI)
+			} else {
+				p.parseError(slot.Stmt0R0, p.cI, followSets[symbols.NT_Stmt])
+			}
+		case slot.Stmt1R0: // Stmt : ∙print Parens_List_Expr_x2c
+
+			p.bsrSet.Add(slot.Stmt1R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.Stmt1R1) {
+				p.parseError(slot.Stmt1R1, p.cI, first[slot.Stmt1R1])
+				break
+			}
+
+			p.call(slot.Stmt1R2, cU, p.cI)
+		case slot.Stmt1R2: // Stmt : print Parens_List_Expr_x2c ∙
+
+			if p.follow(symbols.NT_Stmt) {
+				p.rtn(symbols.NT_Stmt, cU, p.cI)
+			} else {
+				p.parseError(slot.Stmt1R0, p.cI, followSets[symbols.NT_Stmt])
+			}
+		case slot.Stmts0R0: // Stmts : ∙List_Stmt_x3b
+
+			p.call(slot.Stmts0R1, cU, p.cI)
+		case slot.Stmts0R1: // Stmts : List_Stmt_x3b ∙
+
+			if p.follow(symbols.NT_Stmts) {
+				p.rtn(symbols.NT_Stmts, cU, p.cI)
+			} else {
+				p.parseError(slot.Stmts0R0, p.cI, followSets[symbols.NT_Stmts])
+			}
+
+		default:
+			panic("This must not happen")
+		}
+	}
+	if len(p.lex.Errors) > 0 || !p.bsrSet.Contain(p.start, 0, m) {
+		p.sortParseErrors()
+		return nil, append(p.lexErrors(), p.parseErrors...)
+	}
+	return p.bsrSet, nil
+}
+
+func (p *parser) ntAdd(nt symbols.NT, j int) {
+	// fmt.Printf("p.ntAdd(%s, %d)\n", nt, j)
+	failed := true
+	expected := map[token.Type]string{}
+	for _, l := range slot.GetAlternates(nt) {
+		if p.testSelect(l) {
+			p.dscAdd(l, j, j)
+			failed = false
+		} else {
+			for k, v := range first[l] {
+				expected[k] = v
+			}
+		}
+	}
+	if failed {
+		for _, l := range slot.GetAlternates(nt) {
+			p.parseError(l, j, expected)
+		}
+	}
+}
+
+/*** Call Return Forest ***/
+
+type poppedNode struct {
+	X    symbols.NT
+	k, j int
+}
+
+type clusterNode struct {
+	X symbols.NT
+	k int
+}
+
+type crfNode struct {
+	L slot.Label
+	i int
+}
+
+/*
+suppose that L is Y ::=αX ·β
+if there is no CRF node labelled (L,i)
+
+	create one let u be the CRF node labelled (L,i)
+
+if there is no CRF node labelled (X, j) {
+
+		create a CRF node v labelled (X, j)
+		create an edge from v to u
+		ntAdd(X, j)
+	} else {
+
+		let v be the CRF node labelled (X, j)
+		if there is not an edge from v to u {
+			create an edge from v to u
+			for all ((X, j,h)∈P) {
+				dscAdd(L, i, h);
+				bsrAdd(L, i, j, h)
+			}
+		}
+	}
+*/
+func (p *parser) call(L slot.Label, i, j int) {
+	// fmt.Printf("p.call(%s,%d,%d)\n", L,i,j)
+	u, exist := p.crfNodes[crfNode{L, i}]
+	// fmt.Printf("  u exist=%t\n", exist)
+	if !exist {
+		u = &crfNode{L, i}
+		p.crfNodes[*u] = u
+	}
+	X := L.Symbols()[L.Pos()-1].(symbols.NT)
+	ndV := clusterNode{X, j}
+	v, exist := p.crf[ndV]
+	if !exist {
+		// fmt.Println("  v !exist")
+		p.crf[ndV] = []*crfNode{u}
+		p.ntAdd(X, j)
+	} else {
+		// fmt.Println("  v exist")
+		if !existEdge(v, u) {
+			// fmt.Printf("  !existEdge(%v)\n", u)
+			p.crf[ndV] = append(v, u)
+			// fmt.Printf("|popped|=%d\n", len(popped))
+			for pnd := range p.popped {
+				if pnd.X == X && pnd.k == j {
+					p.dscAdd(L, i, pnd.j)
+					p.bsrSet.Add(L, i, j, pnd.j)
+				}
+			}
+		}
+	}
+}
+
+func existEdge(nds []*crfNode, nd *crfNode) bool {
+	for _, nd1 := range nds {
+		if nd1 == nd {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) rtn(X symbols.NT, k, j int) {
+	// fmt.Printf("p.rtn(%s,%d,%d)\n", X,k,j)
+	pn := poppedNode{X, k, j}
+	if _, exist := p.popped[pn]; !exist {
+		p.popped[pn] = true
+		for _, nd := range p.crf[clusterNode{X, k}] {
+			p.dscAdd(nd.L, nd.i, j)
+			p.bsrSet.Add(nd.L, nd.i, k, j)
+		}
+	}
+}
+
+// func CRFString() string {
+// 	buf := new(bytes.Buffer)
+// 	buf.WriteString("CRF: {")
+// 	for cn, nds := range crf{
+// 		for _, nd := range nds {
+// 			fmt.Fprintf(buf, "%s->%s, ", cn, nd)
+// 		}
+// 	}
+// 	buf.WriteString("}")
+// 	return buf.String()
+// }
+
+func (cn clusterNode) String() string {
+	return fmt.Sprintf("(%s,%d)", cn.X, cn.k)
+}
+
+func (n crfNode) String() string {
+	return fmt.Sprintf("(%s,%d)", n.L.String(), n.i)
+}
+
+// func PoppedString() string {
+// 	buf := new(bytes.Buffer)
+// 	buf.WriteString("Popped: {")
+// 	for p, _ := range popped {
+// 		fmt.Fprintf(buf, "(%s,%d,%d) ", p.X, p.k, p.j)
+// 	}
+// 	buf.WriteString("}")
+// 	return buf.String()
+// }
+
+/*** descriptors ***/
+
+type descriptors struct {
+	set []*descriptor
+}
+
+func (ds *descriptors) contain(d *descriptor) bool {
+	for _, d1 := range ds.set {
+		if d1 == d {
+			return true
+		}
+	}
+	return false
+}
+
+func (ds *descriptors) empty() bool {
+	return len(ds.set) == 0
+}
+
+func (ds *descriptors) String() string {
+	buf := new(bytes.Buffer)
+	buf.WriteString("{")
+	for i, d := range ds.set {
+		if i > 0 {
+			buf.WriteString("; ")
+		}
+		fmt.Fprintf(buf, "%s", d)
+	}
+	buf.WriteString("}")
+	return buf.String()
+}
+
+type descriptor struct {
+	L slot.Label
+	k int
+	i int
+}
+
+func (d *descriptor) String() string {
+	return fmt.Sprintf("%s,%d,%d", d.L, d.k, d.i)
+}
+
+func (p *parser) dscAdd(L slot.Label, k, i int) {
+	// fmt.Printf("p.dscAdd(%s,%d,%d)\n", L, k, i)
+	d := &descriptor{L, k, i}
+	if !p.U.contain(d) {
+		p.R.set = append(p.R.set, d)
+		p.U.set = append(p.U.set, d)
+	}
+}
+
+func (ds *descriptors) remove() (L slot.Label, k, i int) {
+	d := ds.set[len(ds.set)-1]
+	ds.set = ds.set[:len(ds.set)-1]
+	// fmt.Printf("remove: %s,%d,%d\n", d.L, d.k, d.i)
+	return d.L, d.k, d.i
+}
+
+func (p *parser) DumpDescriptors() {
+	p.DumpR()
+	p.DumpU()
+}
+
+func (p *parser) DumpR() {
+	fmt.Println("R:")
+	for _, d := range p.R.set {
+		fmt.Printf(" %s\n", d)
+	}
+}
+
+func (p *parser) DumpU() {
+	fmt.Println("U:")
+	for _, d := range p.U.set {
+		fmt.Printf(" %s\n", d)
+	}
+}
+
+/*** TestSelect ***/
+
+func (p *parser) follow(nt symbols.NT) bool {
+	_, exist := followSets[nt][p.lex.Tokens[p.cI].Type()]
+	return exist
+}
+
+func (p *parser) testSelect(l slot.Label) bool {
+	_, exist := first[l][p.lex.Tokens[p.cI].Type()]
+	// fmt.Printf("testSelect(%s) = %t\n", l, exist)
+	return exist
+}
+
+var first = []map[token.Type]string{
+	// Expr : ∙id
+	{
+		token.T_5: "id",
+	},
+	// Expr : id ∙
+	{
+		token.EOF: "$",
+		token.T_1: ")",
+		token.T_2: ",",
+		token.T_3: ";",
+	},
+	// Expr : ∙num
+	{
+		token.T_6: "num",
+	},
+	// Expr : num ∙
+	{
+		token.EOF: "$",
+		token.T_1: ")",
+		token.T_2: ",",
+		token.T_3: ";",
+	},
+	// Expr : ∙Parens_Expr
+	{
+		token.T_0: "(",
+	},
+	// Expr : Parens_Expr ∙
+	{
+		token.EOF: "$",
+		token.T_1: ")",
+		token.T_2: ",",
+		token.T_3: ";",
+	},
+	// List_Expr_x2c : ∙Expr
+	{
+		token.T_0: "(",
+		token.T_5: "id",
+		token.T_6: "num",
+	},
+	// List_Expr_x2c : Expr ∙
+	{
+		token.T_1: ")",
+	},
+	// List_Expr_x2c : ∙Expr , List_Expr_x2c
+	{
+		token.T_0: "(",
+		token.T_5: "id",
+		token.T_6: "num",
+	},
+	// List_Expr_x2c : Expr ∙, List_Expr_x2c
+	{
+		token.T_2: ",",
+	},
+	// List_Expr_x2c : Expr , ∙List_Expr_x2c
+	{
+		token.T_0: "(",
+		token.T_5: "id",
+		token.T_6: "num",
+	},
+	// List_Expr_x2c : Expr , List_Expr_x2c ∙
+	{
+		token.T_1: ")",
+	},
+	// List_Stmt_x3b : ∙Stmt
+	{
+		token.T_5: "id",
+		token.T_7: "print",
+	},
+	// List_Stmt_x3b : Stmt ∙
+	{
+		token.EOF: "$",
+	},
+	// List_Stmt_x3b : ∙Stmt ; List_Stmt_x3b
+	{
+		token.T_5: "id",
+		token.T_7: "print",
+	},
+	// List_Stmt_x3b : Stmt ∙; List_Stmt_x3b
+	{
+		token.T_3: ";",
+	},
+	// List_Stmt_x3b : Stmt ; ∙List_Stmt_x3b
+	{
+		token.T_5: "id",
+		token.T_7: "print",
+	},
+	// List_Stmt_x3b : Stmt ; List_Stmt_x3b ∙
+	{
+		token.EOF: "$",
+	},
+	// Parens_Expr : ∙( Expr )
+	{
+		token.T_0: "(",
+	},
+	// Parens_Expr : ( ∙Expr )
+	{
+		token.T_0: "(",
+		token.T_5: "id",
+		token.T_6: "num",
+	},
+	// Parens_Expr : ( Expr ∙)
+	{
+		token.T_1: ")",
+	},
+	// Parens_Expr : ( Expr ) ∙
+	{
+		token.EOF: "$",
+		token.T_1: ")",
+		token.T_2: ",",
+		token.T_3: ";",
+	},
+	// Parens_List_Expr_x2c : ∙( List_Expr_x2c )
+	{
+		token.T_0: "(",
+	},
+	// Parens_List_Expr_x2c : ( ∙List_Expr_x2c )
+	{
+		token.T_0: "(",
+		token.T_5: "id",
+		token.T_6: "num",
+	},
+	// Parens_List_Expr_x2c : ( List_Expr_x2c ∙)
+	{
+		token.T_1: ")",
+	},
+	// Parens_List_Expr_x2c : ( List_Expr_x2c ) ∙
+	{
+		token.EOF: "$",
+		token.T_3: ";",
+	},
+	// Stmt : ∙id = Expr
+	{
+		token.T_5: "id",
+	},
+	// Stmt : id ∙= Expr
+	{
+		token.T_4: "=",
+	},
+	// Stmt : id = ∙Expr
+	{
+		token.T_0: "(",
+		token.T_5: "id",
+		token.T_6: "num",
+	},
+	// Stmt : id = Expr ∙
+	{
+		token.EOF: "$",
+		token.T_3: ";",
+	},
+	// Stmt : ∙print Parens_List_Expr_x2c
+	{
+		token.T_7: "print",
+	},
+	// Stmt : print ∙Parens_List_Expr_x2c
+	{
+		token.T_0: "(",
+	},
+	// Stmt : print Parens_List_Expr_x2c ∙
+	{
+		token.EOF: "$",
+		token.T_3: ";",
+	},
+	// Stmts : ∙List_Stmt_x3b
+	{
+		token.T_5: "id",
+		token.T_7: "print",
+	},
+	// Stmts : List_Stmt_x3b ∙
+	{
+		token.EOF: "$",
+	},
+}
+
+var followSets = []map[token.Type]string{
+	// Expr
+	{
+		token.EOF: "$",
+		token.T_1: ")",
+		token.T_2: ",",
+		token.T_3: ";",
+	},
+	// List_Expr_x2c
+	{
+		token.T_1: ")",
+	},
+	// List_Stmt_x3b
+	{
+		token.EOF: "$",
+	},
+	// Parens_Expr
+	{
+		token.EOF: "$",
+		token.T_1: ")",
+		token.T_2: ",",
+		token.T_3: ";",
+	},
+	// Parens_List_Expr_x2c
+	{
+		token.EOF: "$",
+		token.T_3: ";",
+	},
+	// Stmt
+	{
+		token.EOF: "$",
+		token.T_3: ";",
+	},
+	// Stmts
+	{
+		token.EOF: "$",
+	},
+}
+
+/*** Errors ***/
+
+/*
+Error is returned by Parse at every point at which the parser fails to parse
+a grammar production. For non-LL-1 grammars there will be an error for each
+alternate attempted by the parser.
+
+The errors are sorted in descending order of input position (index of token in
+the stream of tokens).
+
+Normally the error of interest is the one that has parsed the largest number of
+tokens.
+*/
+type Error struct {
+	// Index of token that caused the error.
+	cI int
+
+	// Grammar slot at which the error occured.
+	Slot slot.Label
+
+	// The token at which the error occurred.
+	Token *token.Token
+
+	// The line and column in the input text at which the error occurred
+	Line, Column int
+
+	// The tokens expected at the point where the error occurred
+	Expected map[token.Type]string
+
+	// LexError is not nil if the error is a lexical error
+	LexError *lexer.LexError
+}
+
+func (pe *Error) String() string {
+	if pe.LexError != nil {
+		return pe.LexError.Error()
+	}
+	w := new(bytes.Buffer)
+	fmt.Fprintf(w, "Parse Error: %s I[%d]=%s at line %d col %d\n",
+		pe.Slot, pe.cI, pe.Token, pe.Line, pe.Column)
+	exp := []string{}
+	for _, e := range pe.Expected {
+		exp = append(exp, e)
+	}
+	fmt.Fprintf(w, "Expected one of: [%s]", strings.Join(exp, ","))
+	return w.String()
+}
+
+func (p *parser) parseError(slot slot.Label, i int, expected map[token.Type]string) {
+	pe := &Error{cI: i, Slot: slot, Token: p.lex.Tokens[i], Expected: expected}
+	p.parseErrors = append(p.parseErrors, pe)
+}
+
+// lexErrors returns an Error for every lexical error in the input
+func (p *parser) lexErrors() (errs []*Error) {
+	for _, le := range p.lex.Errors {
+		for i, tok := range p.lex.Tokens {
+			if tok == le.Token {
+				errs = append(errs, &Error{
+					cI:       i,
+					Token:    tok,
+					Line:     le.Line,
+					Column:   le.Column,
+					LexError: le,
+				})
+			}
+		}
+	}
+	return
+}
+
+func (p *parser) sortParseErrors() {
+	sort.Slice(p.parseErrors,
+		func(i, j int) bool {
+			return p.parseErrors[j].Token.Lext() < p.parseErrors[i].Token.Lext()
+		})
+	for _, pe := range p.parseErrors {
+		pe.Line, pe.Column = p.lex.GetLineColumn(pe.Token.Lext())
+	}
+}
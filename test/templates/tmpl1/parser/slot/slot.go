@@ -0,0 +1,476 @@
+
+// Package slot is generated by gogll. Do not edit. 
+package slot
+
+import(
+	"bytes"
+	"fmt"
+	
+	"github.com/goccmack/gogll/v3/test/templates/tmpl1/parser/symbols"
+)
+
+type Label int
+
+const(
+	Expr0R0 Label = iota
+	Expr0R1
+	Expr1R0
+	Expr1R1
+	Expr2R0
+	Expr2R1
+	List_Expr_x2c0R0
+	List_Expr_x2c0R1
+	List_Expr_x2c1R0
+	List_Expr_x2c1R1
+	List_Expr_x2c1R2
+	List_Expr_x2c1R3
+	List_Stmt_x3b0R0
+	List_Stmt_x3b0R1
+	List_Stmt_x3b1R0
+	List_Stmt_x3b1R1
+	List_Stmt_x3b1R2
+	List_Stmt_x3b1R3
+	Parens_Expr0R0
+	Parens_Expr0R1
+	Parens_Expr0R2
+	Parens_Expr0R3
+	Parens_List_Expr_x2c0R0
+	Parens_List_Expr_x2c0R1
+	Parens_List_Expr_x2c0R2
+	Parens_List_Expr_x2c0R3
+	Stmt0R0
+	Stmt0R1
+	Stmt0R2
+	Stmt0R3
+	Stmt1R0
+	Stmt1R1
+	Stmt1R2
+	Stmts0R0
+	Stmts0R1
+)
+
+type Slot struct {
+	NT      symbols.NT
+	Alt     int
+	Pos     int
+	Symbols symbols.Symbols
+	Label 	Label
+}
+
+type Index struct {
+	NT      symbols.NT
+	Alt     int
+	Pos     int
+}
+
+func GetAlternates(nt symbols.NT) []Label {
+	alts, exist := alternates[nt]
+	if !exist {
+		panic(fmt.Sprintf("Invalid NT %s", nt))
+	}
+	return alts
+}
+
+func GetLabel(nt symbols.NT, alt, pos int) Label {
+	l, exist := slotIndex[Index{nt,alt,pos}]
+	if exist {
+		return l
+	}
+	panic(fmt.Sprintf("Error: no slot label for NT=%s, alt=%d, pos=%d", nt, alt, pos))
+}
+
+func (l Label) EoR() bool {
+	return l.Slot().EoR()
+}
+
+func (l Label) Head() symbols.NT {
+	return l.Slot().NT
+}
+
+func (l Label) Index() Index {
+	s := l.Slot()
+	return Index{s.NT, s.Alt, s.Pos}
+}
+
+func (l Label) Alternate() int {
+	return l.Slot().Alt
+}
+
+func (l Label) Pos() int {
+	return l.Slot().Pos
+}
+
+func (l Label) Slot() *Slot {
+	s, exist := slots[l]
+	if !exist {
+		panic(fmt.Sprintf("Invalid slot label %d", l))
+	}
+	return s
+}
+
+func (l Label) String() string {
+	return l.Slot().String()
+}
+
+func (l Label) Symbols() symbols.Symbols {
+	return l.Slot().Symbols
+}
+
+func (s *Slot) EoR() bool {
+	return s.Pos >= len(s.Symbols)
+}
+
+func (s *Slot) String() string {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "%s : ", s.NT)
+	for i, sym := range s.Symbols {
+		if i == s.Pos {
+			fmt.Fprintf(buf, "∙")
+		}
+		fmt.Fprintf(buf, "%s ", sym)
+	}
+	if s.Pos >= len(s.Symbols) {
+		fmt.Fprintf(buf, "∙")
+	}
+	return buf.String()
+}
+
+var slots = map[Label]*Slot{ 
+	Expr0R0: {
+		symbols.NT_Expr, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_5,
+		}, 
+		Expr0R0, 
+	},
+	Expr0R1: {
+		symbols.NT_Expr, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_5,
+		}, 
+		Expr0R1, 
+	},
+	Expr1R0: {
+		symbols.NT_Expr, 1, 0, 
+		symbols.Symbols{  
+			symbols.T_6,
+		}, 
+		Expr1R0, 
+	},
+	Expr1R1: {
+		symbols.NT_Expr, 1, 1, 
+		symbols.Symbols{  
+			symbols.T_6,
+		}, 
+		Expr1R1, 
+	},
+	Expr2R0: {
+		symbols.NT_Expr, 2, 0, 
+		symbols.Symbols{  
+			symbols.NT_Parens_Expr,
+		}, 
+		Expr2R0, 
+	},
+	Expr2R1: {
+		symbols.NT_Expr, 2, 1, 
+		symbols.Symbols{  
+			symbols.NT_Parens_Expr,
+		}, 
+		Expr2R1, 
+	},
+	List_Expr_x2c0R0: {
+		symbols.NT_List_Expr_x2c, 0, 0, 
+		symbols.Symbols{  
+			symbols.NT_Expr,
+		}, 
+		List_Expr_x2c0R0, 
+	},
+	List_Expr_x2c0R1: {
+		symbols.NT_List_Expr_x2c, 0, 1, 
+		symbols.Symbols{  
+			symbols.NT_Expr,
+		}, 
+		List_Expr_x2c0R1, 
+	},
+	List_Expr_x2c1R0: {
+		symbols.NT_List_Expr_x2c, 1, 0, 
+		symbols.Symbols{  
+			symbols.NT_Expr, 
+			symbols.T_2, 
+			symbols.NT_List_Expr_x2c,
+		}, 
+		List_Expr_x2c1R0, 
+	},
+	List_Expr_x2c1R1: {
+		symbols.NT_List_Expr_x2c, 1, 1, 
+		symbols.Symbols{  
+			symbols.NT_Expr, 
+			symbols.T_2, 
+			symbols.NT_List_Expr_x2c,
+		}, 
+		List_Expr_x2c1R1, 
+	},
+	List_Expr_x2c1R2: {
+		symbols.NT_List_Expr_x2c, 1, 2, 
+		symbols.Symbols{  
+			symbols.NT_Expr, 
+			symbols.T_2, 
+			symbols.NT_List_Expr_x2c,
+		}, 
+		List_Expr_x2c1R2, 
+	},
+	List_Expr_x2c1R3: {
+		symbols.NT_List_Expr_x2c, 1, 3, 
+		symbols.Symbols{  
+			symbols.NT_Expr, 
+			symbols.T_2, 
+			symbols.NT_List_Expr_x2c,
+		}, 
+		List_Expr_x2c1R3, 
+	},
+	List_Stmt_x3b0R0: {
+		symbols.NT_List_Stmt_x3b, 0, 0, 
+		symbols.Symbols{  
+			symbols.NT_Stmt,
+		}, 
+		List_Stmt_x3b0R0, 
+	},
+	List_Stmt_x3b0R1: {
+		symbols.NT_List_Stmt_x3b, 0, 1, 
+		symbols.Symbols{  
+			symbols.NT_Stmt,
+		}, 
+		List_Stmt_x3b0R1, 
+	},
+	List_Stmt_x3b1R0: {
+		symbols.NT_List_Stmt_x3b, 1, 0, 
+		symbols.Symbols{  
+			symbols.NT_Stmt, 
+			symbols.T_3, 
+			symbols.NT_List_Stmt_x3b,
+		}, 
+		List_Stmt_x3b1R0, 
+	},
+	List_Stmt_x3b1R1: {
+		symbols.NT_List_Stmt_x3b, 1, 1, 
+		symbols.Symbols{  
+			symbols.NT_Stmt, 
+			symbols.T_3, 
+			symbols.NT_List_Stmt_x3b,
+		}, 
+		List_Stmt_x3b1R1, 
+	},
+	List_Stmt_x3b1R2: {
+		symbols.NT_List_Stmt_x3b, 1, 2, 
+		symbols.Symbols{  
+			symbols.NT_Stmt, 
+			symbols.T_3, 
+			symbols.NT_List_Stmt_x3b,
+		}, 
+		List_Stmt_x3b1R2, 
+	},
+	List_Stmt_x3b1R3: {
+		symbols.NT_List_Stmt_x3b, 1, 3, 
+		symbols.Symbols{  
+			symbols.NT_Stmt, 
+			symbols.T_3, 
+			symbols.NT_List_Stmt_x3b,
+		}, 
+		List_Stmt_x3b1R3, 
+	},
+	Parens_Expr0R0: {
+		symbols.NT_Parens_Expr, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.NT_Expr, 
+			symbols.T_1,
+		}, 
+		Parens_Expr0R0, 
+	},
+	Parens_Expr0R1: {
+		symbols.NT_Parens_Expr, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.NT_Expr, 
+			symbols.T_1,
+		}, 
+		Parens_Expr0R1, 
+	},
+	Parens_Expr0R2: {
+		symbols.NT_Parens_Expr, 0, 2, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.NT_Expr, 
+			symbols.T_1,
+		}, 
+		Parens_Expr0R2, 
+	},
+	Parens_Expr0R3: {
+		symbols.NT_Parens_Expr, 0, 3, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.NT_Expr, 
+			symbols.T_1,
+		}, 
+		Parens_Expr0R3, 
+	},
+	Parens_List_Expr_x2c0R0: {
+		symbols.NT_Parens_List_Expr_x2c, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.NT_List_Expr_x2c, 
+			symbols.T_1,
+		}, 
+		Parens_List_Expr_x2c0R0, 
+	},
+	Parens_List_Expr_x2c0R1: {
+		symbols.NT_Parens_List_Expr_x2c, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.NT_List_Expr_x2c, 
+			symbols.T_1,
+		}, 
+		Parens_List_Expr_x2c0R1, 
+	},
+	Parens_List_Expr_x2c0R2: {
+		symbols.NT_Parens_List_Expr_x2c, 0, 2, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.NT_List_Expr_x2c, 
+			symbols.T_1,
+		}, 
+		Parens_List_Expr_x2c0R2, 
+	},
+	Parens_List_Expr_x2c0R3: {
+		symbols.NT_Parens_List_Expr_x2c, 0, 3, 
+		symbols.Symbols{  
+			symbols.T_0, 
+			symbols.NT_List_Expr_x2c, 
+			symbols.T_1,
+		}, 
+		Parens_List_Expr_x2c0R3, 
+	},
+	Stmt0R0: {
+		symbols.NT_Stmt, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_5, 
+			symbols.T_4, 
+			symbols.NT_Expr,
+		}, 
+		Stmt0R0, 
+	},
+	Stmt0R1: {
+		symbols.NT_Stmt, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_5, 
+			symbols.T_4, 
+			symbols.NT_Expr,
+		}, 
+		Stmt0R1, 
+	},
+	Stmt0R2: {
+		symbols.NT_Stmt, 0, 2, 
+		symbols.Symbols{  
+			symbols.T_5, 
+			symbols.T_4, 
+			symbols.NT_Expr,
+		}, 
+		Stmt0R2, 
+	},
+	Stmt0R3: {
+		symbols.NT_Stmt, 0, 3, 
+		symbols.Symbols{  
+			symbols.T_5, 
+			symbols.T_4, 
+			symbols.NT_Expr,
+		}, 
+		Stmt0R3, 
+	},
+	Stmt1R0: {
+		symbols.NT_Stmt, 1, 0, 
+		symbols.Symbols{  
+			symbols.T_7, 
+			symbols.NT_Parens_List_Expr_x2c,
+		}, 
+		Stmt1R0, 
+	},
+	Stmt1R1: {
+		symbols.NT_Stmt, 1, 1, 
+		symbols.Symbols{  
+			symbols.T_7, 
+			symbols.NT_Parens_List_Expr_x2c,
+		}, 
+		Stmt1R1, 
+	},
+	Stmt1R2: {
+		symbols.NT_Stmt, 1, 2, 
+		symbols.Symbols{  
+			symbols.T_7, 
+			symbols.NT_Parens_List_Expr_x2c,
+		}, 
+		Stmt1R2, 
+	},
+	Stmts0R0: {
+		symbols.NT_Stmts, 0, 0, 
+		symbols.Symbols{  
+			symbols.NT_List_Stmt_x3b,
+		}, 
+		Stmts0R0, 
+	},
+	Stmts0R1: {
+		symbols.NT_Stmts, 0, 1, 
+		symbols.Symbols{  
+			symbols.NT_List_Stmt_x3b,
+		}, 
+		Stmts0R1, 
+	},
+}
+
+var slotIndex = map[Index]Label { 
+	Index{ symbols.NT_Expr,0,0 }: Expr0R0,
+	Index{ symbols.NT_Expr,0,1 }: Expr0R1,
+	Index{ symbols.NT_Expr,1,0 }: Expr1R0,
+	Index{ symbols.NT_Expr,1,1 }: Expr1R1,
+	Index{ symbols.NT_Expr,2,0 }: Expr2R0,
+	Index{ symbols.NT_Expr,2,1 }: Expr2R1,
+	Index{ symbols.NT_List_Expr_x2c,0,0 }: List_Expr_x2c0R0,
+	Index{ symbols.NT_List_Expr_x2c,0,1 }: List_Expr_x2c0R1,
+	Index{ symbols.NT_List_Expr_x2c,1,0 }: List_Expr_x2c1R0,
+	Index{ symbols.NT_List_Expr_x2c,1,1 }: List_Expr_x2c1R1,
+	Index{ symbols.NT_List_Expr_x2c,1,2 }: List_Expr_x2c1R2,
+	Index{ symbols.NT_List_Expr_x2c,1,3 }: List_Expr_x2c1R3,
+	Index{ symbols.NT_List_Stmt_x3b,0,0 }: List_Stmt_x3b0R0,
+	Index{ symbols.NT_List_Stmt_x3b,0,1 }: List_Stmt_x3b0R1,
+	Index{ symbols.NT_List_Stmt_x3b,1,0 }: List_Stmt_x3b1R0,
+	Index{ symbols.NT_List_Stmt_x3b,1,1 }: List_Stmt_x3b1R1,
+	Index{ symbols.NT_List_Stmt_x3b,1,2 }: List_Stmt_x3b1R2,
+	Index{ symbols.NT_List_Stmt_x3b,1,3 }: List_Stmt_x3b1R3,
+	Index{ symbols.NT_Parens_Expr,0,0 }: Parens_Expr0R0,
+	Index{ symbols.NT_Parens_Expr,0,1 }: Parens_Expr0R1,
+	Index{ symbols.NT_Parens_Expr,0,2 }: Parens_Expr0R2,
+	Index{ symbols.NT_Parens_Expr,0,3 }: Parens_Expr0R3,
+	Index{ symbols.NT_Parens_List_Expr_x2c,0,0 }: Parens_List_Expr_x2c0R0,
+	Index{ symbols.NT_Parens_List_Expr_x2c,0,1 }: Parens_List_Expr_x2c0R1,
+	Index{ symbols.NT_Parens_List_Expr_x2c,0,2 }: Parens_List_Expr_x2c0R2,
+	Index{ symbols.NT_Parens_List_Expr_x2c,0,3 }: Parens_List_Expr_x2c0R3,
+	Index{ symbols.NT_Stmt,0,0 }: Stmt0R0,
+	Index{ symbols.NT_Stmt,0,1 }: Stmt0R1,
+	Index{ symbols.NT_Stmt,0,2 }: Stmt0R2,
+	Index{ symbols.NT_Stmt,0,3 }: Stmt0R3,
+	Index{ symbols.NT_Stmt,1,0 }: Stmt1R0,
+	Index{ symbols.NT_Stmt,1,1 }: Stmt1R1,
+	Index{ symbols.NT_Stmt,1,2 }: Stmt1R2,
+	Index{ symbols.NT_Stmts,0,0 }: Stmts0R0,
+	Index{ symbols.NT_Stmts,0,1 }: Stmts0R1,
+}
+
+var alternates = map[symbols.NT][]Label{ 
+	symbols.NT_Stmts:[]Label{ Stmts0R0 },
+	symbols.NT_Stmt:[]Label{ Stmt0R0,Stmt1R0 },
+	symbols.NT_Expr:[]Label{ Expr0R0,Expr1R0,Expr2R0 },
+	symbols.NT_List_Stmt_x3b:[]Label{ List_Stmt_x3b0R0,List_Stmt_x3b1R0 },
+	symbols.NT_List_Expr_x2c:[]Label{ List_Expr_x2c0R0,List_Expr_x2c1R0 },
+	symbols.NT_Parens_List_Expr_x2c:[]Label{ Parens_List_Expr_x2c0R0 },
+	symbols.NT_Parens_Expr:[]Label{ Parens_Expr0R0 },
+}
+
@@ -0,0 +1,320 @@
+// Package visitor is generated by gogll. Do not edit.
+
+/*
+Package visitor walks an unambiguous BSR set.
+
+Walk calls the Enter and Exit methods of the nonterminal and of the alternate of
+every NT BSR. The Enter methods are called in pre-order and the Exit methods in
+post-order:
+
+	EnterExpr, EnterExpr_Alt0, <walk the NT children of the BSR>, ExitExpr_Alt0, ExitExpr
+
+BaseVisitor implements Visitor with methods that do nothing. It can be embedded
+in a visitor that only needs some of the methods.
+*/
+package visitor
+
+import (
+	"fmt"
+
+	"github.com/goccmack/gogll/v3/test/templates/tmpl1/parser/bsr"
+	"github.com/goccmack/gogll/v3/test/templates/tmpl1/parser/symbols"
+)
+
+// Visitor has Enter and Exit methods for each nonterminal and each alternate
+// of the grammar. If an Enter method returns false the children of the BSR
+// are not walked. The matching Exit method is always called.
+type Visitor interface {
+	EnterStmts(b bsr.BSR) bool
+	ExitStmts(b bsr.BSR)
+
+	// Stmts : List_Stmt_x3b
+	EnterStmts_Alt0(b bsr.BSR) bool
+	ExitStmts_Alt0(b bsr.BSR)
+
+	EnterStmt(b bsr.BSR) bool
+	ExitStmt(b bsr.BSR)
+
+	// Stmt : id "=" Expr
+	EnterStmt_Alt0(b bsr.BSR) bool
+	ExitStmt_Alt0(b bsr.BSR)
+
+	// Stmt : "print" Parens_List_Expr_x2c
+	EnterStmt_Alt1(b bsr.BSR) bool
+	ExitStmt_Alt1(b bsr.BSR)
+
+	EnterExpr(b bsr.BSR) bool
+	ExitExpr(b bsr.BSR)
+
+	// Expr : id
+	EnterExpr_Alt0(b bsr.BSR) bool
+	ExitExpr_Alt0(b bsr.BSR)
+
+	// Expr : num
+	EnterExpr_Alt1(b bsr.BSR) bool
+	ExitExpr_Alt1(b bsr.BSR)
+
+	// Expr : Parens_Expr
+	EnterExpr_Alt2(b bsr.BSR) bool
+	ExitExpr_Alt2(b bsr.BSR)
+
+	EnterList_Stmt_x3b(b bsr.BSR) bool
+	ExitList_Stmt_x3b(b bsr.BSR)
+
+	// List_Stmt_x3b : Stmt
+	EnterList_Stmt_x3b_Alt0(b bsr.BSR) bool
+	ExitList_Stmt_x3b_Alt0(b bsr.BSR)
+
+	// List_Stmt_x3b : Stmt ";" List_Stmt_x3b
+	EnterList_Stmt_x3b_Alt1(b bsr.BSR) bool
+	ExitList_Stmt_x3b_Alt1(b bsr.BSR)
+
+	EnterList_Expr_x2c(b bsr.BSR) bool
+	ExitList_Expr_x2c(b bsr.BSR)
+
+	// List_Expr_x2c : Expr
+	EnterList_Expr_x2c_Alt0(b bsr.BSR) bool
+	ExitList_Expr_x2c_Alt0(b bsr.BSR)
+
+	// List_Expr_x2c : Expr "," List_Expr_x2c
+	EnterList_Expr_x2c_Alt1(b bsr.BSR) bool
+	ExitList_Expr_x2c_Alt1(b bsr.BSR)
+
+	EnterParens_List_Expr_x2c(b bsr.BSR) bool
+	ExitParens_List_Expr_x2c(b bsr.BSR)
+
+	// Parens_List_Expr_x2c : "(" List_Expr_x2c ")"
+	EnterParens_List_Expr_x2c_Alt0(b bsr.BSR) bool
+	ExitParens_List_Expr_x2c_Alt0(b bsr.BSR)
+
+	EnterParens_Expr(b bsr.BSR) bool
+	ExitParens_Expr(b bsr.BSR)
+
+	// Parens_Expr : "(" Expr ")"
+	EnterParens_Expr_Alt0(b bsr.BSR) bool
+	ExitParens_Expr_Alt0(b bsr.BSR)
+}
+
+// Walk walks the BSR b and its NT children, calling the methods of v.
+// Walk panics if b has ambiguous children.
+func Walk(b bsr.BSR, v Visitor) {
+	switch b.Label.Head() {
+	case symbols.NT_Stmts:
+		if v.EnterStmts(b) {
+			walkStmts(b, v)
+		}
+		v.ExitStmts(b)
+	case symbols.NT_Stmt:
+		if v.EnterStmt(b) {
+			walkStmt(b, v)
+		}
+		v.ExitStmt(b)
+	case symbols.NT_Expr:
+		if v.EnterExpr(b) {
+			walkExpr(b, v)
+		}
+		v.ExitExpr(b)
+	case symbols.NT_List_Stmt_x3b:
+		if v.EnterList_Stmt_x3b(b) {
+			walkList_Stmt_x3b(b, v)
+		}
+		v.ExitList_Stmt_x3b(b)
+	case symbols.NT_List_Expr_x2c:
+		if v.EnterList_Expr_x2c(b) {
+			walkList_Expr_x2c(b, v)
+		}
+		v.ExitList_Expr_x2c(b)
+	case symbols.NT_Parens_List_Expr_x2c:
+		if v.EnterParens_List_Expr_x2c(b) {
+			walkParens_List_Expr_x2c(b, v)
+		}
+		v.ExitParens_List_Expr_x2c(b)
+	case symbols.NT_Parens_Expr:
+		if v.EnterParens_Expr(b) {
+			walkParens_Expr(b, v)
+		}
+		v.ExitParens_Expr(b)
+	default:
+		panic(fmt.Sprintf("invalid NT %s", b.Label.Head()))
+	}
+}
+
+func walkStmts(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterStmts_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitStmts_Alt0(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Stmts", b.Alternate()))
+	}
+}
+
+func walkStmt(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterStmt_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitStmt_Alt0(b)
+	case 1:
+		if v.EnterStmt_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitStmt_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Stmt", b.Alternate()))
+	}
+}
+
+func walkExpr(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterExpr_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitExpr_Alt0(b)
+	case 1:
+		if v.EnterExpr_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitExpr_Alt1(b)
+	case 2:
+		if v.EnterExpr_Alt2(b) {
+			walkChildren(b, v)
+		}
+		v.ExitExpr_Alt2(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Expr", b.Alternate()))
+	}
+}
+
+func walkList_Stmt_x3b(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterList_Stmt_x3b_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitList_Stmt_x3b_Alt0(b)
+	case 1:
+		if v.EnterList_Stmt_x3b_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitList_Stmt_x3b_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of List_Stmt_x3b", b.Alternate()))
+	}
+}
+
+func walkList_Expr_x2c(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterList_Expr_x2c_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitList_Expr_x2c_Alt0(b)
+	case 1:
+		if v.EnterList_Expr_x2c_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitList_Expr_x2c_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of List_Expr_x2c", b.Alternate()))
+	}
+}
+
+func walkParens_List_Expr_x2c(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterParens_List_Expr_x2c_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitParens_List_Expr_x2c_Alt0(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Parens_List_Expr_x2c", b.Alternate()))
+	}
+}
+
+func walkParens_Expr(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterParens_Expr_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitParens_Expr_Alt0(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of Parens_Expr", b.Alternate()))
+	}
+}
+
+func walkChildren(b bsr.BSR, v Visitor) {
+	for i, s := range b.Label.Symbols() {
+		if s.IsNonTerminal() {
+			Walk(b.GetNTChildI(i), v)
+		}
+	}
+}
+
+// BaseVisitor implements Visitor. Its Enter methods return true and its Exit
+// methods do nothing.
+type BaseVisitor struct{}
+
+var _ Visitor = BaseVisitor{}
+
+func (BaseVisitor) EnterStmts(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStmts(b bsr.BSR) {}
+
+func (BaseVisitor) EnterStmts_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStmts_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterStmt(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStmt(b bsr.BSR) {}
+
+func (BaseVisitor) EnterStmt_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStmt_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterStmt_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitStmt_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterExpr_Alt2(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitExpr_Alt2(b bsr.BSR) {}
+
+func (BaseVisitor) EnterList_Stmt_x3b(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitList_Stmt_x3b(b bsr.BSR) {}
+
+func (BaseVisitor) EnterList_Stmt_x3b_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitList_Stmt_x3b_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterList_Stmt_x3b_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitList_Stmt_x3b_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterList_Expr_x2c(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitList_Expr_x2c(b bsr.BSR) {}
+
+func (BaseVisitor) EnterList_Expr_x2c_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitList_Expr_x2c_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterList_Expr_x2c_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitList_Expr_x2c_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterParens_List_Expr_x2c(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitParens_List_Expr_x2c(b bsr.BSR) {}
+
+func (BaseVisitor) EnterParens_List_Expr_x2c_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitParens_List_Expr_x2c_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterParens_Expr(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitParens_Expr(b bsr.BSR) {}
+
+func (BaseVisitor) EnterParens_Expr_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitParens_Expr_Alt0(b bsr.BSR) {}
@@ -0,0 +1,46 @@
+package tmpl1
+
+import (
+	"testing"
+
+	"github.com/goccmack/gogll/v3/test/templates/tmpl1/lexer"
+	"github.com/goccmack/gogll/v3/test/templates/tmpl1/parser"
+	"github.com/goccmack/gogll/v3/test/templates/tmpl1/parser/bsr"
+	"github.com/goccmack/gogll/v3/test/templates/tmpl1/parser/symbols"
+)
+
+func TestTemplates(t *testing.T) {
+	bs, errs := parser.Parse(lexer.New([]rune("a = ( ( b ) ) ; print ( a , ( b ) , 3 )")))
+	if errs != nil {
+		t.Fatalf("parse errors: %v", errs)
+	}
+	if bs.IsAmbiguous() {
+		t.Fatal("ambiguous parse")
+	}
+
+	stmts := bs.GetRoot().GetNTChild(symbols.NT_List_Stmt_x3b, 0)
+	if stmts.Alternate() != 1 {
+		t.Fatalf("expected 2 statements, got %s", stmts)
+	}
+	assign := stmts.GetNTChild(symbols.NT_Stmt, 0)
+	inner := assign.GetNTChild(symbols.NT_Expr, 0).GetNTChild(symbols.NT_Parens_Expr, 0).
+		GetNTChild(symbols.NT_Expr, 0).GetNTChild(symbols.NT_Parens_Expr, 0)
+	if inner.GetNTChild(symbols.NT_Expr, 0).GetTChildI(0).LiteralString() != "b" {
+		t.Errorf("unexpected expression %s", inner)
+	}
+
+	print := stmts.GetNTChild(symbols.NT_List_Stmt_x3b, 0).GetNTChild(symbols.NT_Stmt, 0)
+	args := print.GetNTChild(symbols.NT_Parens_List_Expr_x2c, 0).GetNTChild(symbols.NT_List_Expr_x2c, 0)
+	if n := listLen(args, symbols.NT_List_Expr_x2c); n != 3 {
+		t.Errorf("expected 3 arguments, got %d", n)
+	}
+}
+
+func listLen(b bsr.BSR, nt symbols.NT) int {
+	n := 1
+	for b.Alternate() == 1 {
+		b = b.GetNTChild(nt, 0)
+		n++
+	}
+	return n
+}
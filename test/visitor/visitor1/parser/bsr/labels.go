@@ -0,0 +1,76 @@
+// Package bsr is generated by gogll. Do not edit.
+
+package bsr
+
+import (
+	"fmt"
+
+	"github.com/goccmack/gogll/v3/test/visitor/visitor1/parser/symbols"
+)
+
+// Named alternates
+const (
+	// Expr : Term "+" Expr
+	Expr_Add = 0
+	// Term : id
+	Term_Var = 0
+	// Term : "(" Expr ")"
+	Term_Paren = 1
+)
+
+// Add is alternate #Add: Expr : Term "+" Expr
+type Add struct {
+	BSR
+}
+
+// IsAdd returns true if b is alternate #Add of Expr
+func (b BSR) IsAdd() bool {
+	return b.Label.Head() == symbols.NT_Expr && b.Alternate() == Expr_Add
+}
+
+// Add returns b as alternate #Add of Expr.
+// Add panics if b is not alternate #Add.
+func (b BSR) Add() Add {
+	if !b.IsAdd() {
+		panic(fmt.Sprintf("%s is not alternate #Add of Expr", b))
+	}
+	return Add{b}
+}
+
+// Var is alternate #Var: Term : id
+type Var struct {
+	BSR
+}
+
+// IsVar returns true if b is alternate #Var of Term
+func (b BSR) IsVar() bool {
+	return b.Label.Head() == symbols.NT_Term && b.Alternate() == Term_Var
+}
+
+// Var returns b as alternate #Var of Term.
+// Var panics if b is not alternate #Var.
+func (b BSR) Var() Var {
+	if !b.IsVar() {
+		panic(fmt.Sprintf("%s is not alternate #Var of Term", b))
+	}
+	return Var{b}
+}
+
+// Paren is alternate #Paren: Term : "(" Expr ")"
+type Paren struct {
+	BSR
+}
+
+// IsParen returns true if b is alternate #Paren of Term
+func (b BSR) IsParen() bool {
+	return b.Label.Head() == symbols.NT_Term && b.Alternate() == Term_Paren
+}
+
+// Paren returns b as alternate #Paren of Term.
+// Paren panics if b is not alternate #Paren.
+func (b BSR) Paren() Paren {
+	if !b.IsParen() {
+		panic(fmt.Sprintf("%s is not alternate #Paren of Term", b))
+	}
+	return Paren{b}
+}
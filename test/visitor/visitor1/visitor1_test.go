@@ -0,0 +1,99 @@
+package visitor1
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goccmack/gogll/v3/test/visitor/visitor1/lexer"
+	"github.com/goccmack/gogll/v3/test/visitor/visitor1/parser"
+	"github.com/goccmack/gogll/v3/test/visitor/visitor1/parser/bsr"
+	"github.com/goccmack/gogll/v3/test/visitor/visitor1/parser/visitor"
+)
+
+// trace records the Enter and Exit calls of the Expr and Term alternates
+type trace struct {
+	visitor.BaseVisitor
+	calls []string
+}
+
+func (t *trace) EnterExpr_Add(b bsr.BSR) bool {
+	t.calls = append(t.calls, "+Add")
+	return true
+}
+
+func (t *trace) ExitExpr_Add(b bsr.BSR) {
+	t.calls = append(t.calls, "-Add")
+}
+
+func (t *trace) EnterTerm_Var(b bsr.BSR) bool {
+	t.calls = append(t.calls, b.GetTChildI(0).LiteralString())
+	return true
+}
+
+// EnterTerm_Paren skips the children of parenthesised expressions
+func (t *trace) EnterTerm_Paren(b bsr.BSR) bool {
+	t.calls = append(t.calls, "+Paren")
+	return false
+}
+
+func (t *trace) ExitTerm_Paren(b bsr.BSR) {
+	t.calls = append(t.calls, "-Paren")
+}
+
+func (t *trace) ExitStmt_Alt0(b bsr.BSR) {
+	t.calls = append(t.calls, ";")
+}
+
+const src = `x = a + b ; y = (a + b) + c ;`
+
+func TestWalk(t *testing.T) {
+	bs, errs := parser.Parse(lexer.New([]rune(src)))
+	if errs != nil {
+		t.Fatalf("parse errors: %v", errs)
+	}
+	tr := &trace{}
+	visitor.Walk(bs.GetRoot(), tr)
+	got := strings.Join(tr.calls, " ")
+	exp := "+Add a b -Add ; +Add +Paren -Paren c -Add ;"
+	if got != exp {
+		t.Errorf("expected %q, got %q", exp, got)
+	}
+}
+
+// count counts the NT and alternate calls of the whole visitor
+type count struct {
+	visitor.BaseVisitor
+	enter, exit int
+}
+
+func (c *count) EnterExpr(b bsr.BSR) bool {
+	c.enter++
+	return true
+}
+
+func (c *count) ExitExpr(b bsr.BSR) {
+	c.exit++
+}
+
+func (c *count) EnterExpr_Alt1(b bsr.BSR) bool {
+	c.enter++
+	return true
+}
+
+func (c *count) ExitExpr_Alt1(b bsr.BSR) {
+	c.exit++
+}
+
+func TestPrePostOrder(t *testing.T) {
+	bs, errs := parser.Parse(lexer.New([]rune(src)))
+	if errs != nil {
+		t.Fatalf("parse errors: %v", errs)
+	}
+	c := &count{}
+	visitor.Walk(bs.GetRoot(), c)
+	// Expr BSRs: b, a + b, c, (a + b) + c, a + b in the parens, b in the parens
+	// Expr_Alt1 BSRs: b, c, b in the parens
+	if c.enter != 9 || c.exit != 9 {
+		t.Errorf("expected 9 Enter and Exit calls, got %d, %d", c.enter, c.exit)
+	}
+}
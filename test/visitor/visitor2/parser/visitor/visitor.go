@@ -0,0 +1,152 @@
+// Package visitor is generated by gogll. Do not edit.
+
+/*
+Package visitor walks an unambiguous BSR set.
+
+Walk calls the Enter and Exit methods of the nonterminal and of the alternate of
+every NT BSR. The Enter methods are called in pre-order and the Exit methods in
+post-order:
+
+	EnterExpr, EnterExpr_Alt0, <walk the NT children of the BSR>, ExitExpr_Alt0, ExitExpr
+
+BaseVisitor implements Visitor with methods that do nothing. It can be embedded
+in a visitor that only needs some of the methods.
+*/
+package visitor
+
+import (
+	"fmt"
+
+	"github.com/goccmack/gogll/v3/test/visitor/visitor2/parser/bsr"
+	"github.com/goccmack/gogll/v3/test/visitor/visitor2/parser/symbols"
+)
+
+// Visitor has Enter and Exit methods for each nonterminal and each alternate
+// of the grammar. If an Enter method returns false the children of the BSR
+// are not walked. The matching Exit method is always called.
+type Visitor interface {
+	EnterS(b bsr.BSR) bool
+	ExitS(b bsr.BSR)
+
+	// S : A A1
+	EnterS_Alt0(b bsr.BSR) bool
+	ExitS_Alt0(b bsr.BSR)
+
+	EnterA(b bsr.BSR) bool
+	ExitA(b bsr.BSR)
+
+	// A : "x"
+	EnterA_Alt0(b bsr.BSR) bool
+	ExitA_Alt0(b bsr.BSR)
+
+	// A : "y"
+	EnterA_Alt1(b bsr.BSR) bool
+	ExitA_Alt1(b bsr.BSR)
+
+	EnterA1(b bsr.BSR) bool
+	ExitA1(b bsr.BSR)
+
+	// A1 : "z"
+	EnterA1_Alt0(b bsr.BSR) bool
+	ExitA1_Alt0(b bsr.BSR)
+}
+
+// Walk walks the BSR b and its NT children, calling the methods of v.
+// Walk panics if b has ambiguous children.
+func Walk(b bsr.BSR, v Visitor) {
+	switch b.Label.Head() {
+	case symbols.NT_S:
+		if v.EnterS(b) {
+			walkS(b, v)
+		}
+		v.ExitS(b)
+	case symbols.NT_A:
+		if v.EnterA(b) {
+			walkA(b, v)
+		}
+		v.ExitA(b)
+	case symbols.NT_A1:
+		if v.EnterA1(b) {
+			walkA1(b, v)
+		}
+		v.ExitA1(b)
+	default:
+		panic(fmt.Sprintf("invalid NT %s", b.Label.Head()))
+	}
+}
+
+func walkS(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterS_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitS_Alt0(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of S", b.Alternate()))
+	}
+}
+
+func walkA(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterA_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitA_Alt0(b)
+	case 1:
+		if v.EnterA_Alt1(b) {
+			walkChildren(b, v)
+		}
+		v.ExitA_Alt1(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of A", b.Alternate()))
+	}
+}
+
+func walkA1(b bsr.BSR, v Visitor) {
+	switch b.Alternate() {
+	case 0:
+		if v.EnterA1_Alt0(b) {
+			walkChildren(b, v)
+		}
+		v.ExitA1_Alt0(b)
+	default:
+		panic(fmt.Sprintf("invalid alternate %d of A1", b.Alternate()))
+	}
+}
+
+func walkChildren(b bsr.BSR, v Visitor) {
+	for i, s := range b.Label.Symbols() {
+		if s.IsNonTerminal() {
+			Walk(b.GetNTChildI(i), v)
+		}
+	}
+}
+
+// BaseVisitor implements Visitor. Its Enter methods return true and its Exit
+// methods do nothing.
+type BaseVisitor struct{}
+
+var _ Visitor = BaseVisitor{}
+
+func (BaseVisitor) EnterS(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitS(b bsr.BSR) {}
+
+func (BaseVisitor) EnterS_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitS_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterA(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitA(b bsr.BSR) {}
+
+func (BaseVisitor) EnterA_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitA_Alt0(b bsr.BSR) {}
+
+func (BaseVisitor) EnterA_Alt1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitA_Alt1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterA1(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitA1(b bsr.BSR) {}
+
+func (BaseVisitor) EnterA1_Alt0(b bsr.BSR) bool { return true }
+func (BaseVisitor) ExitA1_Alt0(b bsr.BSR) {}
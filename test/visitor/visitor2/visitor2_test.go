@@ -0,0 +1,51 @@
+package visitor2
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goccmack/gogll/v3/test/visitor/visitor2/lexer"
+	"github.com/goccmack/gogll/v3/test/visitor/visitor2/parser"
+	"github.com/goccmack/gogll/v3/test/visitor/visitor2/parser/bsr"
+	"github.com/goccmack/gogll/v3/test/visitor/visitor2/parser/visitor"
+)
+
+// trace records the Enter calls of A, A1 and their alternates, whose IDs
+// would be the same without the Alt separator.
+type trace struct {
+	visitor.BaseVisitor
+	calls []string
+}
+
+func (t *trace) EnterA(b bsr.BSR) bool {
+	t.calls = append(t.calls, "A")
+	return true
+}
+
+func (t *trace) EnterA_Alt1(b bsr.BSR) bool {
+	t.calls = append(t.calls, "A_Alt1")
+	return true
+}
+
+func (t *trace) EnterA1(b bsr.BSR) bool {
+	t.calls = append(t.calls, "A1")
+	return true
+}
+
+func (t *trace) EnterA1_Alt0(b bsr.BSR) bool {
+	t.calls = append(t.calls, "A1_Alt0")
+	return true
+}
+
+func TestWalk(t *testing.T) {
+	bs, errs := parser.Parse(lexer.New([]rune("y z")))
+	if errs != nil {
+		t.Fatalf("parse errors: %v", errs)
+	}
+	tr := &trace{}
+	visitor.Walk(bs.GetRoot(), tr)
+	got := strings.Join(tr.calls, " ")
+	if exp := "A A_Alt1 A1 A1_Alt0"; got != exp {
+		t.Errorf("expected %q, got %q", exp, got)
+	}
+}
@@ -11,6 +11,10 @@ type Token struct {
     typ        Type
     lext, rext int
     input      []rune
+
+    // Trivia attached to the token by lexer.NewWithTrivia
+    leadLext, trailRext int
+    leading, trailing   []*Token
 }
 
 /*
@@ -24,9 +28,16 @@ func New(t Type, lext, rext int, input []rune) *Token {
         lext:  lext,
         rext:  rext,
         input: input,
+        leadLext:  lext,
+        trailRext: rext,
     }
 }
 
+// FullLiteral returns the leading trivia, literal and trailing trivia of t
+func (t *Token) FullLiteral() []rune {
+    return t.input[t.leadLext:t.trailRext]
+}
+
 // GetLineColumn returns the line and column of the left extent of t
 func (t *Token) GetLineColumn() (line, col int) {
     line, col = 1, 1
@@ -49,7 +60,18 @@ func (t *Token) GetInput() []rune {
     return t.input
 }
 
-// Lext returns the left extent of t
+// LeadingSuppressed returns the suppressed tokens in the leading trivia of t
+func (t *Token) LeadingSuppressed() []*Token {
+    return t.leading
+}
+
+// LeadingTrivia returns the whitespace and suppressed tokens preceding t,
+// which are attached to t by lexer.NewWithTrivia
+func (t *Token) LeadingTrivia() []rune {
+    return t.input[t.leadLext:t.lext]
+}
+
+// Lext returns the left extent of t in the input stream of runes
 func (t *Token) Lext() int {
     return t.lext
 }
@@ -93,11 +115,49 @@ func (t *Token) LiteralStringStripEscape() string {
 	return string(t.LiteralStripEscape())
 }
 
-// Rext returns the right extent of t in the input
+// Rext returns the right extent of t in the input stream of runes
 func (t *Token) Rext() int {
     return t.rext
 }
 
+/*
+SetLeadingTrivia sets the leading trivia of t to the input from lext to the
+left extent of t. suppressed contains the suppressed tokens in the trivia.
+*/
+func (t *Token) SetLeadingTrivia(lext int, suppressed []*Token) {
+    t.leadLext, t.leading = lext, suppressed
+}
+
+/*
+SetTrailingTrivia sets the trailing trivia of t to the input from the right
+extent of t to rext. suppressed contains the suppressed tokens in the trivia.
+*/
+func (t *Token) SetTrailingTrivia(rext int, suppressed []*Token) {
+    t.trailRext, t.trailing = rext, suppressed
+}
+
+/*
+Shift returns a copy of t, including its trivia, moved by delta runes in the
+new input. It is used by the lexer to move tokens after an edit of the input.
+*/
+func (t *Token) Shift(delta int, input []rune) *Token {
+    t1 := &Token{
+        typ:       t.typ,
+        lext:      t.lext + delta,
+        rext:      t.rext + delta,
+        input:     input,
+        leadLext:  t.leadLext + delta,
+        trailRext: t.trailRext + delta,
+    }
+    for _, s := range t.leading {
+        t1.leading = append(t1.leading, s.Shift(delta, input))
+    }
+    for _, s := range t.trailing {
+        t1.trailing = append(t1.trailing, s.Shift(delta, input))
+    }
+    return t1
+}
+
 func (t *Token) String() string {
     return fmt.Sprintf("%s (%d,%d) %s",
         t.TypeID(), t.lext, t.rext, t.LiteralString())
@@ -108,6 +168,18 @@ func (t *Token) Suppress() bool {
 	return Suppress[t.typ]
 }
 
+// TrailingSuppressed returns the suppressed tokens in the trailing trivia of t
+func (t *Token) TrailingSuppressed() []*Token {
+    return t.trailing
+}
+
+// TrailingTrivia returns the whitespace and suppressed tokens following t,
+// up to and including the end of the line, which are attached to t by
+// lexer.NewWithTrivia
+func (t *Token) TrailingTrivia() []rune {
+    return t.input[t.rext:t.trailRext]
+}
+
 // Type returns the token Type of t
 func (t *Token) Type() Type {
     return t.typ
@@ -136,115 +208,126 @@ const(
     Error  Type = iota  // Error 
     EOF  // $ 
     T_0  // ! 
-    T_1  // '[ 
-    T_2  // ( 
-    T_3  // ) 
-    T_4  // - 
-    T_5  // . 
-    T_6  // : 
-    T_7  // ; 
-    T_8  // < 
-    T_9  // > 
-    T_10  // [ 
-    T_11  // \\p{ASCII_Hex_Digit} 
-    T_12  // \\p{Bidi_Control} 
-    T_13  // \\p{Cc} 
-    T_14  // \\p{Cf} 
-    T_15  // \\p{Co} 
-    T_16  // \\p{Cs} 
-    T_17  // \\p{C} 
-    T_18  // \\p{Dash} 
-    T_19  // \\p{Deprecated} 
-    T_20  // \\p{Diacritic} 
-    T_21  // \\p{Digit} 
-    T_22  // \\p{Extender} 
-    T_23  // \\p{Hex_Digit} 
-    T_24  // \\p{Hyphen} 
-    T_25  // \\p{IDS_Binary_Operator} 
-    T_26  // \\p{IDS_Trinary_Operator} 
-    T_27  // \\p{Ideographic} 
-    T_28  // \\p{Join_Control} 
-    T_29  // \\p{Letter} 
-    T_30  // \\p{Ll} 
-    T_31  // \\p{Lm} 
-    T_32  // \\p{Logical_Order_Exception} 
-    T_33  // \\p{Lower} 
-    T_34  // \\p{Lo} 
-    T_35  // \\p{Lt} 
-    T_36  // \\p{Lu} 
-    T_37  // \\p{L} 
-    T_38  // \\p{Mark} 
-    T_39  // \\p{Mc} 
-    T_40  // \\p{Me} 
-    T_41  // \\p{Mn} 
-    T_42  // \\p{M} 
-    T_43  // \\p{Nd} 
-    T_44  // \\p{Nl} 
-    T_45  // \\p{Noncharacter_Code_Point} 
-    T_46  // \\p{No} 
-    T_47  // \\p{Number} 
-    T_48  // \\p{N} 
-    T_49  // \\p{Other_Alphabetic} 
-    T_50  // \\p{Other_Default_Ignorable_Code_Point} 
-    T_51  // \\p{Other_Grapheme_Extend} 
-    T_52  // \\p{Other_ID_Continue} 
-    T_53  // \\p{Other_ID_Start} 
-    T_54  // \\p{Other_Lowercase} 
-    T_55  // \\p{Other_Math} 
-    T_56  // \\p{Other_Uppercase} 
-    T_57  // \\p{Other} 
-    T_58  // \\p{Pattern_Syntax} 
-    T_59  // \\p{Pattern_White_Space} 
-    T_60  // \\p{Pc} 
-    T_61  // \\p{Pd} 
-    T_62  // \\p{Pe} 
-    T_63  // \\p{Pf} 
-    T_64  // \\p{Pi} 
-    T_65  // \\p{Po} 
-    T_66  // \\p{Prepended_Concatenation_Mark} 
-    T_67  // \\p{Ps} 
-    T_68  // \\p{Punct} 
-    T_69  // \\p{P} 
-    T_70  // \\p{Quotation_Mark} 
-    T_71  // \\p{Radical} 
-    T_72  // \\p{Regional_Indicator} 
-    T_73  // \\p{STerm} 
-    T_74  // \\p{Sc} 
-    T_75  // \\p{Sentence_Terminal} 
-    T_76  // \\p{Sk} 
-    T_77  // \\p{Sm} 
-    T_78  // \\p{Soft_Dotted} 
-    T_79  // \\p{So} 
-    T_80  // \\p{Space} 
-    T_81  // \\p{Symbol} 
-    T_82  // \\p{S} 
-    T_83  // \\p{Terminal_Punctuation} 
-    T_84  // \\p{Title} 
-    T_85  // \\p{Unified_Ideograph} 
-    T_86  // \\p{Upper} 
-    T_87  // \\p{Variation_Selector} 
-    T_88  // \\p{White_Space} 
-    T_89  // \\p{Zl} 
-    T_90  // \\p{Zp} 
-    T_91  // \\p{Zs} 
-    T_92  // \\p{Z} 
-    T_93  // ] 
-    T_94  // ]' 
-    T_95  // any 
-    T_96  // char_lit 
-    T_97  // empty 
-    T_98  // letter 
-    T_99  // lowcase 
-    T_100  // not 
-    T_101  // nt 
-    T_102  // number 
-    T_103  // package 
-    T_104  // string_lit 
-    T_105  // tokid 
-    T_106  // upcase 
-    T_107  // { 
-    T_108  // | 
-    T_109  // } 
+    T_1  // # 
+    T_2  // '[ 
+    T_3  // ( 
+    T_4  // ) 
+    T_5  // , 
+    T_6  // - 
+    T_7  // . 
+    T_8  // : 
+    T_9  // ; 
+    T_10  // < 
+    T_11  // > 
+    T_12  // @ 
+    T_13  // [ 
+    T_14  // \\p{ASCII_Hex_Digit} 
+    T_15  // \\p{Bidi_Control} 
+    T_16  // \\p{Cc} 
+    T_17  // \\p{Cf} 
+    T_18  // \\p{Co} 
+    T_19  // \\p{Cs} 
+    T_20  // \\p{C} 
+    T_21  // \\p{Dash} 
+    T_22  // \\p{Deprecated} 
+    T_23  // \\p{Diacritic} 
+    T_24  // \\p{Digit} 
+    T_25  // \\p{Extender} 
+    T_26  // \\p{Hex_Digit} 
+    T_27  // \\p{Hyphen} 
+    T_28  // \\p{IDS_Binary_Operator} 
+    T_29  // \\p{IDS_Trinary_Operator} 
+    T_30  // \\p{Ideographic} 
+    T_31  // \\p{Join_Control} 
+    T_32  // \\p{Letter} 
+    T_33  // \\p{Ll} 
+    T_34  // \\p{Lm} 
+    T_35  // \\p{Logical_Order_Exception} 
+    T_36  // \\p{Lower} 
+    T_37  // \\p{Lo} 
+    T_38  // \\p{Lt} 
+    T_39  // \\p{Lu} 
+    T_40  // \\p{L} 
+    T_41  // \\p{Mark} 
+    T_42  // \\p{Mc} 
+    T_43  // \\p{Me} 
+    T_44  // \\p{Mn} 
+    T_45  // \\p{M} 
+    T_46  // \\p{Nd} 
+    T_47  // \\p{Nl} 
+    T_48  // \\p{Noncharacter_Code_Point} 
+    T_49  // \\p{No} 
+    T_50  // \\p{Number} 
+    T_51  // \\p{N} 
+    T_52  // \\p{Other_Alphabetic} 
+    T_53  // \\p{Other_Default_Ignorable_Code_Point} 
+    T_54  // \\p{Other_Grapheme_Extend} 
+    T_55  // \\p{Other_ID_Continue} 
+    T_56  // \\p{Other_ID_Start} 
+    T_57  // \\p{Other_Lowercase} 
+    T_58  // \\p{Other_Math} 
+    T_59  // \\p{Other_Uppercase} 
+    T_60  // \\p{Other} 
+    T_61  // \\p{Pattern_Syntax} 
+    T_62  // \\p{Pattern_White_Space} 
+    T_63  // \\p{Pc} 
+    T_64  // \\p{Pd} 
+    T_65  // \\p{Pe} 
+    T_66  // \\p{Pf} 
+    T_67  // \\p{Pi} 
+    T_68  // \\p{Po} 
+    T_69  // \\p{Prepended_Concatenation_Mark} 
+    T_70  // \\p{Ps} 
+    T_71  // \\p{Punct} 
+    T_72  // \\p{P} 
+    T_73  // \\p{Quotation_Mark} 
+    T_74  // \\p{Radical} 
+    T_75  // \\p{Regional_Indicator} 
+    T_76  // \\p{STerm} 
+    T_77  // \\p{Sc} 
+    T_78  // \\p{Sentence_Terminal} 
+    T_79  // \\p{Sk} 
+    T_80  // \\p{Sm} 
+    T_81  // \\p{Soft_Dotted} 
+    T_82  // \\p{So} 
+    T_83  // \\p{Space} 
+    T_84  // \\p{Symbol} 
+    T_85  // \\p{S} 
+    T_86  // \\p{Terminal_Punctuation} 
+    T_87  // \\p{Title} 
+    T_88  // \\p{Unified_Ideograph} 
+    T_89  // \\p{Upper} 
+    T_90  // \\p{Variation_Selector} 
+    T_91  // \\p{White_Space} 
+    T_92  // \\p{Zl} 
+    T_93  // \\p{Zp} 
+    T_94  // \\p{Zs} 
+    T_95  // \\p{Z} 
+    T_96  // ] 
+    T_97  // ]' 
+    T_98  // any 
+    T_99  // as 
+    T_100  // case_insensitive 
+    T_101  // char_lit 
+    T_102  // empty 
+    T_103  // import 
+    T_104  // istring_lit 
+    T_105  // letter 
+    T_106  // lowcase 
+    T_107  // not 
+    T_108  // nt 
+    T_109  // number 
+    T_110  // package 
+    T_111  // prefix 
+    T_112  // rename 
+    T_113  // set_char_lit 
+    T_114  // start 
+    T_115  // string_lit 
+    T_116  // tokid 
+    T_117  // upcase 
+    T_118  // { 
+    T_119  // | 
+    T_120  // } 
 )
 
 var TypeToString = []string{ 
@@ -360,6 +443,17 @@ var TypeToString = []string{
     "T_107",
     "T_108",
     "T_109",
+    "T_110",
+    "T_111",
+    "T_112",
+    "T_113",
+    "T_114",
+    "T_115",
+    "T_116",
+    "T_117",
+    "T_118",
+    "T_119",
+    "T_120",
 }
 
 var StringToType = map[string] Type { 
@@ -475,21 +569,35 @@ var StringToType = map[string] Type {
     "T_107" : T_107, 
     "T_108" : T_108, 
     "T_109" : T_109, 
+    "T_110" : T_110, 
+    "T_111" : T_111, 
+    "T_112" : T_112, 
+    "T_113" : T_113, 
+    "T_114" : T_114, 
+    "T_115" : T_115, 
+    "T_116" : T_116, 
+    "T_117" : T_117, 
+    "T_118" : T_118, 
+    "T_119" : T_119, 
+    "T_120" : T_120, 
 }
 
 var TypeToID = []string { 
     "Error", 
     "$", 
     "!", 
+    "#", 
     "'[", 
     "(", 
     ")", 
+    ",", 
     "-", 
     ".", 
     ":", 
     ";", 
     "<", 
     ">", 
+    "@", 
     "[", 
     "\\p{ASCII_Hex_Digit}", 
     "\\p{Bidi_Control}", 
@@ -576,14 +684,22 @@ var TypeToID = []string {
     "]", 
     "]'", 
     "any", 
+    "as", 
+    "case_insensitive", 
     "char_lit", 
     "empty", 
+    "import", 
+    "istring_lit", 
     "letter", 
     "lowcase", 
     "not", 
     "nt", 
     "number", 
     "package", 
+    "prefix", 
+    "rename", 
+    "set_char_lit", 
+    "start", 
     "string_lit", 
     "tokid", 
     "upcase", 
@@ -596,115 +712,126 @@ var IDToType = map[string]Type {
     "Error": 0, 
     "$": 1, 
     "!": 2, 
-    "'[": 3, 
-    "(": 4, 
-    ")": 5, 
-    "-": 6, 
-    ".": 7, 
-    ":": 8, 
-    ";": 9, 
-    "<": 10, 
-    ">": 11, 
-    "[": 12, 
-    "\\p{ASCII_Hex_Digit}": 13, 
-    "\\p{Bidi_Control}": 14, 
-    "\\p{Cc}": 15, 
-    "\\p{Cf}": 16, 
-    "\\p{Co}": 17, 
-    "\\p{Cs}": 18, 
-    "\\p{C}": 19, 
-    "\\p{Dash}": 20, 
-    "\\p{Deprecated}": 21, 
-    "\\p{Diacritic}": 22, 
-    "\\p{Digit}": 23, 
-    "\\p{Extender}": 24, 
-    "\\p{Hex_Digit}": 25, 
-    "\\p{Hyphen}": 26, 
-    "\\p{IDS_Binary_Operator}": 27, 
-    "\\p{IDS_Trinary_Operator}": 28, 
-    "\\p{Ideographic}": 29, 
-    "\\p{Join_Control}": 30, 
-    "\\p{Letter}": 31, 
-    "\\p{Ll}": 32, 
-    "\\p{Lm}": 33, 
-    "\\p{Logical_Order_Exception}": 34, 
-    "\\p{Lower}": 35, 
-    "\\p{Lo}": 36, 
-    "\\p{Lt}": 37, 
-    "\\p{Lu}": 38, 
-    "\\p{L}": 39, 
-    "\\p{Mark}": 40, 
-    "\\p{Mc}": 41, 
-    "\\p{Me}": 42, 
-    "\\p{Mn}": 43, 
-    "\\p{M}": 44, 
-    "\\p{Nd}": 45, 
-    "\\p{Nl}": 46, 
-    "\\p{Noncharacter_Code_Point}": 47, 
-    "\\p{No}": 48, 
-    "\\p{Number}": 49, 
-    "\\p{N}": 50, 
-    "\\p{Other_Alphabetic}": 51, 
-    "\\p{Other_Default_Ignorable_Code_Point}": 52, 
-    "\\p{Other_Grapheme_Extend}": 53, 
-    "\\p{Other_ID_Continue}": 54, 
-    "\\p{Other_ID_Start}": 55, 
-    "\\p{Other_Lowercase}": 56, 
-    "\\p{Other_Math}": 57, 
-    "\\p{Other_Uppercase}": 58, 
-    "\\p{Other}": 59, 
-    "\\p{Pattern_Syntax}": 60, 
-    "\\p{Pattern_White_Space}": 61, 
-    "\\p{Pc}": 62, 
-    "\\p{Pd}": 63, 
-    "\\p{Pe}": 64, 
-    "\\p{Pf}": 65, 
-    "\\p{Pi}": 66, 
-    "\\p{Po}": 67, 
-    "\\p{Prepended_Concatenation_Mark}": 68, 
-    "\\p{Ps}": 69, 
-    "\\p{Punct}": 70, 
-    "\\p{P}": 71, 
-    "\\p{Quotation_Mark}": 72, 
-    "\\p{Radical}": 73, 
-    "\\p{Regional_Indicator}": 74, 
-    "\\p{STerm}": 75, 
-    "\\p{Sc}": 76, 
-    "\\p{Sentence_Terminal}": 77, 
-    "\\p{Sk}": 78, 
-    "\\p{Sm}": 79, 
-    "\\p{Soft_Dotted}": 80, 
-    "\\p{So}": 81, 
-    "\\p{Space}": 82, 
-    "\\p{Symbol}": 83, 
-    "\\p{S}": 84, 
-    "\\p{Terminal_Punctuation}": 85, 
-    "\\p{Title}": 86, 
-    "\\p{Unified_Ideograph}": 87, 
-    "\\p{Upper}": 88, 
-    "\\p{Variation_Selector}": 89, 
-    "\\p{White_Space}": 90, 
-    "\\p{Zl}": 91, 
-    "\\p{Zp}": 92, 
-    "\\p{Zs}": 93, 
-    "\\p{Z}": 94, 
-    "]": 95, 
-    "]'": 96, 
-    "any": 97, 
-    "char_lit": 98, 
-    "empty": 99, 
-    "letter": 100, 
-    "lowcase": 101, 
-    "not": 102, 
-    "nt": 103, 
-    "number": 104, 
-    "package": 105, 
-    "string_lit": 106, 
-    "tokid": 107, 
-    "upcase": 108, 
-    "{": 109, 
-    "|": 110, 
-    "}": 111, 
+    "#": 3, 
+    "'[": 4, 
+    "(": 5, 
+    ")": 6, 
+    ",": 7, 
+    "-": 8, 
+    ".": 9, 
+    ":": 10, 
+    ";": 11, 
+    "<": 12, 
+    ">": 13, 
+    "@": 14, 
+    "[": 15, 
+    "\\p{ASCII_Hex_Digit}": 16, 
+    "\\p{Bidi_Control}": 17, 
+    "\\p{Cc}": 18, 
+    "\\p{Cf}": 19, 
+    "\\p{Co}": 20, 
+    "\\p{Cs}": 21, 
+    "\\p{C}": 22, 
+    "\\p{Dash}": 23, 
+    "\\p{Deprecated}": 24, 
+    "\\p{Diacritic}": 25, 
+    "\\p{Digit}": 26, 
+    "\\p{Extender}": 27, 
+    "\\p{Hex_Digit}": 28, 
+    "\\p{Hyphen}": 29, 
+    "\\p{IDS_Binary_Operator}": 30, 
+    "\\p{IDS_Trinary_Operator}": 31, 
+    "\\p{Ideographic}": 32, 
+    "\\p{Join_Control}": 33, 
+    "\\p{Letter}": 34, 
+    "\\p{Ll}": 35, 
+    "\\p{Lm}": 36, 
+    "\\p{Logical_Order_Exception}": 37, 
+    "\\p{Lower}": 38, 
+    "\\p{Lo}": 39, 
+    "\\p{Lt}": 40, 
+    "\\p{Lu}": 41, 
+    "\\p{L}": 42, 
+    "\\p{Mark}": 43, 
+    "\\p{Mc}": 44, 
+    "\\p{Me}": 45, 
+    "\\p{Mn}": 46, 
+    "\\p{M}": 47, 
+    "\\p{Nd}": 48, 
+    "\\p{Nl}": 49, 
+    "\\p{Noncharacter_Code_Point}": 50, 
+    "\\p{No}": 51, 
+    "\\p{Number}": 52, 
+    "\\p{N}": 53, 
+    "\\p{Other_Alphabetic}": 54, 
+    "\\p{Other_Default_Ignorable_Code_Point}": 55, 
+    "\\p{Other_Grapheme_Extend}": 56, 
+    "\\p{Other_ID_Continue}": 57, 
+    "\\p{Other_ID_Start}": 58, 
+    "\\p{Other_Lowercase}": 59, 
+    "\\p{Other_Math}": 60, 
+    "\\p{Other_Uppercase}": 61, 
+    "\\p{Other}": 62, 
+    "\\p{Pattern_Syntax}": 63, 
+    "\\p{Pattern_White_Space}": 64, 
+    "\\p{Pc}": 65, 
+    "\\p{Pd}": 66, 
+    "\\p{Pe}": 67, 
+    "\\p{Pf}": 68, 
+    "\\p{Pi}": 69, 
+    "\\p{Po}": 70, 
+    "\\p{Prepended_Concatenation_Mark}": 71, 
+    "\\p{Ps}": 72, 
+    "\\p{Punct}": 73, 
+    "\\p{P}": 74, 
+    "\\p{Quotation_Mark}": 75, 
+    "\\p{Radical}": 76, 
+    "\\p{Regional_Indicator}": 77, 
+    "\\p{STerm}": 78, 
+    "\\p{Sc}": 79, 
+    "\\p{Sentence_Terminal}": 80, 
+    "\\p{Sk}": 81, 
+    "\\p{Sm}": 82, 
+    "\\p{Soft_Dotted}": 83, 
+    "\\p{So}": 84, 
+    "\\p{Space}": 85, 
+    "\\p{Symbol}": 86, 
+    "\\p{S}": 87, 
+    "\\p{Terminal_Punctuation}": 88, 
+    "\\p{Title}": 89, 
+    "\\p{Unified_Ideograph}": 90, 
+    "\\p{Upper}": 91, 
+    "\\p{Variation_Selector}": 92, 
+    "\\p{White_Space}": 93, 
+    "\\p{Zl}": 94, 
+    "\\p{Zp}": 95, 
+    "\\p{Zs}": 96, 
+    "\\p{Z}": 97, 
+    "]": 98, 
+    "]'": 99, 
+    "any": 100, 
+    "as": 101, 
+    "case_insensitive": 102, 
+    "char_lit": 103, 
+    "empty": 104, 
+    "import": 105, 
+    "istring_lit": 106, 
+    "letter": 107, 
+    "lowcase": 108, 
+    "not": 109, 
+    "nt": 110, 
+    "number": 111, 
+    "package": 112, 
+    "prefix": 113, 
+    "rename": 114, 
+    "set_char_lit": 115, 
+    "start": 116, 
+    "string_lit": 117, 
+    "tokid": 118, 
+    "upcase": 119, 
+    "{": 120, 
+    "|": 121, 
+    "}": 122, 
 }
 
 var Suppress = []bool { 
@@ -820,5 +947,16 @@ var Suppress = []bool {
     false, 
     false, 
     false, 
+    false, 
+    false, 
+    false, 
+    false, 
+    false, 
+    false, 
+    false, 
+    false, 
+    false, 
+    false, 
+    false, 
 }
 
@@ -0,0 +1,178 @@
+/*
+Copyright 2020 Marius Ackerman
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runeset
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"unicode"
+)
+
+// Range is the closed interval of runes Lo..Hi
+type Range struct {
+	Lo, Hi rune
+}
+
+// Ranges is a set of runes stored as sorted, non-overlapping ranges.
+// The zero value is the empty set.
+type Ranges []Range
+
+// All is the set of all runes
+var All = Ranges{{0, unicode.MaxRune}}
+
+// NewRanges returns the union of rngs
+func NewRanges(rngs ...Range) Ranges {
+	rs := make(Ranges, 0, len(rngs))
+	for _, r := range rngs {
+		if r.Lo <= r.Hi {
+			rs = append(rs, r)
+		}
+	}
+	return rs.normalise()
+}
+
+// FromRangeTable returns the runes of the Unicode range table tab
+func FromRangeTable(tab *unicode.RangeTable) Ranges {
+	rs := Ranges{}
+	for _, r := range tab.R16 {
+		rs = rs.addStrided(rune(r.Lo), rune(r.Hi), rune(r.Stride))
+	}
+	for _, r := range tab.R32 {
+		rs = rs.addStrided(rune(r.Lo), rune(r.Hi), rune(r.Stride))
+	}
+	return rs.normalise()
+}
+
+// Ranges returns the elements of rs as Ranges
+func (rs *RuneSet) Ranges() Ranges {
+	rngs := Ranges{}
+	for _, r := range rs.Elements() {
+		rngs = append(rngs, Range{r, r})
+	}
+	return rngs.normalise()
+}
+
+// Complement returns all runes that are not in rs
+func (rs Ranges) Complement() Ranges {
+	return All.Difference(rs)
+}
+
+// Contains returns true iff r is in rs
+func (rs Ranges) Contains(r rune) bool {
+	i := sort.Search(len(rs), func(i int) bool { return rs[i].Hi >= r })
+	return i < len(rs) && rs[i].Lo <= r
+}
+
+// Difference returns the runes in rs that are not in rs1
+func (rs Ranges) Difference(rs1 Ranges) Ranges {
+	diff := Ranges{}
+	for _, r := range rs {
+		lo := r.Lo
+		for _, r1 := range rs1 {
+			if r1.Hi < lo || r1.Lo > r.Hi {
+				continue
+			}
+			if r1.Lo > lo {
+				diff = append(diff, Range{lo, r1.Lo - 1})
+			}
+			lo = r1.Hi + 1
+		}
+		if lo <= r.Hi {
+			diff = append(diff, Range{lo, r.Hi})
+		}
+	}
+	return diff
+}
+
+// Empty returns true iff rs has no elements
+func (rs Ranges) Empty() bool {
+	return len(rs) == 0
+}
+
+// Equal returns true iff rs and rs1 have the same elements
+func (rs Ranges) Equal(rs1 Ranges) bool {
+	if len(rs) != len(rs1) {
+		return false
+	}
+	for i := range rs {
+		if rs[i] != rs1[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Intersection returns the runes in both rs and rs1
+func (rs Ranges) Intersection(rs1 Ranges) Ranges {
+	return rs.Difference(rs.Difference(rs1))
+}
+
+// Subset returns true iff every element of rs is in rs1
+func (rs Ranges) Subset(rs1 Ranges) bool {
+	return rs.Difference(rs1).Empty()
+}
+
+// Union returns the runes in rs or rs1
+func (rs Ranges) Union(rs1 Ranges) Ranges {
+	u := make(Ranges, 0, len(rs)+len(rs1))
+	u = append(u, rs...)
+	return append(u, rs1...).normalise()
+}
+
+func (rs Ranges) String() string {
+	w := new(bytes.Buffer)
+	fmt.Fprint(w, "[")
+	for i, r := range rs {
+		if i > 0 {
+			fmt.Fprint(w, ",")
+		}
+		if r.Lo == r.Hi {
+			fmt.Fprintf(w, "%q", r.Lo)
+		} else {
+			fmt.Fprintf(w, "%q-%q", r.Lo, r.Hi)
+		}
+	}
+	fmt.Fprint(w, "]")
+	return w.String()
+}
+
+func (rs Ranges) addStrided(lo, hi, stride rune) Ranges {
+	if stride == 1 {
+		return append(rs, Range{lo, hi})
+	}
+	for r := lo; r <= hi; r += stride {
+		rs = append(rs, Range{r, r})
+	}
+	return rs
+}
+
+// normalise sorts rs and merges overlapping and adjacent ranges
+func (rs Ranges) normalise() Ranges {
+	sort.Slice(rs, func(i, j int) bool { return rs[i].Lo < rs[j].Lo })
+	norm := Ranges{}
+	for _, r := range rs {
+		if n := len(norm); n > 0 && r.Lo <= norm[n-1].Hi+1 {
+			if r.Hi > norm[n-1].Hi {
+				norm[n-1].Hi = r.Hi
+			}
+		} else {
+			norm = append(norm, r)
+		}
+	}
+	return norm
+}
@@ -0,0 +1,139 @@
+package runeset
+
+import (
+	"testing"
+	"unicode"
+)
+
+func rng(lo, hi rune) Range {
+	return Range{lo, hi}
+}
+
+func TestNewRanges(t *testing.T) {
+	for _, tst := range []struct {
+		in  []Range
+		exp Ranges
+	}{
+		{nil, Ranges{}},
+		{[]Range{rng('b', 'a')}, Ranges{}},
+		{[]Range{rng('a', 'c')}, Ranges{rng('a', 'c')}},
+		{[]Range{rng('x', 'z'), rng('a', 'c')}, Ranges{rng('a', 'c'), rng('x', 'z')}},
+		{[]Range{rng('a', 'c'), rng('d', 'f')}, Ranges{rng('a', 'f')}},
+		{[]Range{rng('a', 'm'), rng('c', 'z')}, Ranges{rng('a', 'z')}},
+		{[]Range{rng('a', 'z'), rng('c', 'd')}, Ranges{rng('a', 'z')}},
+		{[]Range{rng('a', 'a'), rng('c', 'c'), rng('b', 'b')}, Ranges{rng('a', 'c')}},
+	} {
+		if got := NewRanges(tst.in...); !got.Equal(tst.exp) {
+			t.Errorf("NewRanges(%v): expected %s, got %s", tst.in, tst.exp, got)
+		}
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	var (
+		empty = Ranges{}
+		af    = NewRanges(rng('a', 'f'))
+		dk    = NewRanges(rng('d', 'k'))
+		az    = NewRanges(rng('a', 'z'))
+		acxz  = NewRanges(rng('a', 'c'), rng('x', 'z'))
+	)
+	for _, tst := range []struct {
+		a, b                            Ranges
+		union, difference, intersection Ranges
+		subset                          bool
+	}{
+		{empty, empty, empty, empty, empty, true},
+		{empty, af, af, empty, empty, true},
+		{af, empty, af, af, empty, false},
+		{af, af, af, empty, af, true},
+		{af, dk, NewRanges(rng('a', 'k')), NewRanges(rng('a', 'c')), NewRanges(rng('d', 'f')), false},
+		{dk, af, NewRanges(rng('a', 'k')), NewRanges(rng('g', 'k')), NewRanges(rng('d', 'f')), false},
+		{af, az, az, empty, af, true},
+		{az, af, az, NewRanges(rng('g', 'z')), af, false},
+		{az, dk, az, NewRanges(rng('a', 'c'), rng('l', 'z')), dk, false},
+		{acxz, dk, NewRanges(rng('a', 'k'), rng('x', 'z')), acxz, empty, false},
+		{acxz, af, NewRanges(rng('a', 'f'), rng('x', 'z')), NewRanges(rng('x', 'z')), NewRanges(rng('a', 'c')), false},
+		{acxz, az, az, empty, acxz, true},
+		{All, af, All, NewRanges(rng(0, 'a'-1), rng('g', unicode.MaxRune)), af, false},
+	} {
+		if got := tst.a.Union(tst.b); !got.Equal(tst.union) {
+			t.Errorf("%s.Union(%s): expected %s, got %s", tst.a, tst.b, tst.union, got)
+		}
+		if got := tst.a.Difference(tst.b); !got.Equal(tst.difference) {
+			t.Errorf("%s.Difference(%s): expected %s, got %s", tst.a, tst.b, tst.difference, got)
+		}
+		if got := tst.a.Intersection(tst.b); !got.Equal(tst.intersection) {
+			t.Errorf("%s.Intersection(%s): expected %s, got %s", tst.a, tst.b, tst.intersection, got)
+		}
+		if got := tst.a.Subset(tst.b); got != tst.subset {
+			t.Errorf("%s.Subset(%s): expected %t, got %t", tst.a, tst.b, tst.subset, got)
+		}
+	}
+}
+
+func TestComplement(t *testing.T) {
+	for _, tst := range []struct {
+		rs, exp Ranges
+	}{
+		{Ranges{}, All},
+		{All, Ranges{}},
+		{NewRanges(rng(0, 'a')), NewRanges(rng('b', unicode.MaxRune))},
+		{NewRanges(rng('a', unicode.MaxRune)), NewRanges(rng(0, 'a'-1))},
+		{NewRanges(rng('a', 'c'), rng('x', 'z')),
+			NewRanges(rng(0, 'a'-1), rng('d', 'w'), rng('z'+1, unicode.MaxRune))},
+	} {
+		got := tst.rs.Complement()
+		if !got.Equal(tst.exp) {
+			t.Errorf("%s.Complement(): expected %s, got %s", tst.rs, tst.exp, got)
+		}
+		if !got.Complement().Equal(tst.rs) {
+			t.Errorf("%s.Complement().Complement(): got %s", tst.rs, got.Complement())
+		}
+	}
+}
+
+func TestContains(t *testing.T) {
+	rs := NewRanges(rng('a', 'c'), rng('x', 'x'))
+	for _, tst := range []struct {
+		r   rune
+		exp bool
+	}{
+		{'a' - 1, false},
+		{'a', true},
+		{'b', true},
+		{'c', true},
+		{'d', false},
+		{'w', false},
+		{'x', true},
+		{'y', false},
+	} {
+		if got := rs.Contains(tst.r); got != tst.exp {
+			t.Errorf("%s.Contains(%q): expected %t, got %t", rs, tst.r, tst.exp, got)
+		}
+	}
+	if (Ranges{}).Contains('a') {
+		t.Error("the empty set contains 'a'")
+	}
+}
+
+func TestFromRangeTable(t *testing.T) {
+	tab := &unicode.RangeTable{
+		R16: []unicode.Range16{{Lo: 'a', Hi: 'e', Stride: 2}, {Lo: 'f', Hi: 'h', Stride: 1}},
+	}
+	exp := NewRanges(rng('a', 'a'), rng('c', 'c'), rng('e', 'h'))
+	if got := FromRangeTable(tab); !got.Equal(exp) {
+		t.Errorf("expected %s, got %s", exp, got)
+	}
+	for _, r := range []rune{'A', 'z', 'é', 'Ж'} {
+		if !FromRangeTable(unicode.Letter).Contains(r) {
+			t.Errorf("\\p{L} does not contain %q", r)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	rs := NewRanges(rng('a', 'a'), rng('x', 'z'))
+	if exp := "['a','x'-'z']"; rs.String() != exp {
+		t.Errorf("expected %s, got %s", exp, rs)
+	}
+}